@@ -29,17 +29,19 @@ import (
 )
 
 var rawCRDs = [][]byte{
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec<]o#9r\xef\xfe\x15\x05\xe7a\xee\x00K\xbeŽ\x04~\x9b\xf5x\x11a7sƎ\xe3<\x04y\xa0\xbaK\x12\xcf\xddd\x87dˣ\x04\xf9\xefA\x15\xc9\xfeR\u007fP^\xcf\xdd^0|\x99q\x8b,\x16\xab\x8a\xf5\xc5\"\xafV\xabՕ\xa8\xe43\x1a+\xb5\xba\x03QI\xfc\xeaP\xd1_v\xfd\xf2\xcfv-\xf5\xed\xf1\x87\xab\x17\xa9\xf2;\xb8\xaf\xad\xd3\xe5\xafhum2\xfc\x84;\xa9\xa4\x93Z]\x95\xe8D.\x9c\xb8\xbb\x02\x10Ji'賥?\x012\xad\x9c\xd1E\x81f\xb5G\xb5~\xa9\xb7\xb8\xade\x91\xa3a\xe0q\xea\xe3\x9f\xd6\u007f^\xff\xe9\n 3\xc8ßd\x89։\xb2\xba\x03U\x17\xc5\x15\x80\x12%\xde\xc1Vd/ue\xd7G,\xd0\xe8\xb5\xd4W\xb6\u008c\xe6\xda\x1b]Ww\xd0\xfe\xe0\x87\x04<\xfc\x1a~\xe4\xd1\xfc\xa1\x90\xd6\xfd\xdc\xf9\xf8\x8b\xb4\x8e\u007f\xa8\x8aڈ\xa2\x99\x89\xbfY\xa9\xf6u!L\xfcz\x05`3]\xe1\x1d|\xa6)*\x91a~\x05\x10\x96\xc3S\xae\x02\xc2\xc7\x1f<\x84쀥\xf0\xb8\x00\xe8\n\xd5\xc7\xc7\xcd\xf3\x9f\xbf\xf4>\x03\xe4h3#+\xc7D\U00048074 \xe0\x99\x97\x05&\x90\x1f\xdcA80X\x19\xb4\xa8\x9c\x05w@\xc8D\xe5j\x83\xa0w\xf0s\xbdE\xa3Сm@\x03dEm\x1d\x1a\xb0N8\x04\xe1@@\xa5\xa5r \x158Y\"\xfc\xe1\xe3\xe3\x06\xf4\xf6\xaf\x989\vB\xe5 \xacՙ\x14\x0es8\xea\xa2.я\xfd㺁Z\x19]\xa1q2\xd2ٷ\x8eTu\xbe\x0e\x96\xf7\x81(\xe0{AN\xe2\x84~\x19\x81\x8a\x98\a\xa2\xd1z\xdcA\xdav\xb9,!=\xc0@\x9d\x84\nȯ\xe1\v\x1a\x02\x03\xf6\xa0\xeb\"')<\xa2!\x82ez\xaf\xe4\u007f7\xb0-8͓\x16\xc2a\x10\x80\xb6I\xe5\xd0(Q\xc0Q\x145\xde0IJq\x02\x834\vԪ\x03\x8f\xbb\xd85\xfc\xab6\bR\xed\xf4\x1d\x1c\x9c\xab\xec\xdd\xed\xed^\xba\xb8\x9b2]\x96\xb5\x92\xeet\xcb\x1bCnk\xa7\x8d\xbd\xcd\xf1\x88ŭ\x95\xfb\x950\xd9A:̈\x91\xb7\xa2\x92+F]\xf1\x8eZ\x97\xf9?E\x01\xb0\x1fz\xb8\xba\x13\t\xa3uF\xaa}\xe7\a\x96\xfa\x19\x0e\xd0\x06\xf0\xf2\xe5\x87\xfaU\xb4\x84\xa6OD\x9d_\x1f\xbe<ueO\xda!\xf5\x99\xee\x1d\x81lY@\x04\x93j\x87\xc63qgt\xc90Q\xe5^\xfaXt\v\x89jH~[oK\xe9\x88\xef\xffU\xa3%!\xd7k\xb8g\x15\x03[\x84\xba\xcaI2װQp/J,\xee\x85\xc5o\xce\x00\xa2\xb4]\x11a\xd3X\xd0Վ\xc3Ξj\x9d\x1f\xa2.\x9b\xe0\x97W\b_*\xccz\x1b\x86Fɝ\xccx[\xc0N\x9bV_xu\xb5\xee\x81\x1c߲~\xb2\x9d\xa8\v\xf7\xcc[\xdd>\xe9_\xd1:\x99\r{\r\x90\xfa4:(\"\x85\x16^\x0f\xe8\x0ehH~\xf8\aޒg0\x81Yj1\xe7\x1d)^\x10D\xc0\x9e\xb7vQ@\xa5\xa3\x16\xb2\xb0=Ed\xd7g\x90<m\xb7Z\x17(\x86Z\x02\xbffE\x9dcި\xed3\x1a\fV\xf7p6\x80M\x9a\x90\x8av\r\x19\x11BO\xb5\xbf\x92b\x1eY\x9c0\b$\xb7Ryx\xacs\x0f8\xca j\xd2a9\x82ۤ\x98\xf9F\xa6Rl\v\xbc\x03gj\x9c\xa0\x8c0F\x9c&\xe8\x12\xcd{*Y\x9a\xfeA\x8b\x142c\xfb\xd3\xe8\n\xa6\x8c\xb7V\u009cc\x04\xbfg\xa2\x1c\xb4~Y\"ĿP\x9fV\xefA\xc6^\x12l\xf1 \x8eR\x9b\xb0\xf4`\x86\xb6\b\xf8\x15\xb3\xda\xe1\x98\xfc\v\a\xb9\xdc\xed\xd0\x10\x9c\xea ,Zo\xfa\xa6\t2\xbd\x95\xa9\x99if\x9e\xad\xa3e$I*\xaf|\nu\xda\xd0\xc3}\x15\x1b!JF\x83\xdc\x16\x95ˣ\xcckQ\x80T\xd6\t\x95\xf9\xf5\x88\x06\xaf\xf3\xf5\xc0\x1c\x93\xcfp\xf6\xea0bN\x9c\xe8\xa9F\xad\x10\xb4\x81\x92\xec\xc1yס\x05k\xdbԲ\xb7\x82\xb4\x93\xf6\"j\xea\x02m\x98*g\x9d\xdbꀛI\xd0\rG\xbc/Q\x88-\x16`\xb1\xc0\xcci3N\x8e%&\xfb\x96\xa2\xd7&\xa88\xa2\xe1Z\xddMKm\x176\x03\x12Hm\xbf\x1edv\xf0f\x9e$\x88\xe1@\xae\xd1\xf2.\x17UU\x9c\xa6\x16\tK\x9c\x0f\x93\xccm\xf4\xb6-l\xf9!\xbc\xb1\xcd߶\x04\xddض\x05-٧l#\x0e\xe0\xf4\xec\xb2\xff\u007f\x126\xaa\xfd7\b\xed\xe6l\xe8\xfb\n-\x91T\x92;\xbf\xd9\x01\x96\x95;݀t\xf1\xeb\x12DrV\xda\xf9\xff\x81\x19s\xb9\xc4o\x86#\xdfU\xe2g\xb9\xb2\x04\x91\xb8\xd2L\xff\x0f\xc8\x146\x16_\x82\xadHf\xc8/\xddQ7 w\rC\xf2\x1b\xd8\xc9¡\x19p\xe67\xed\x97\xf7 F\x8a\xbd\xa3V\n\x97\x1d\x1e\xbe\x92\xe7eۄS\"]\x86\x83\xbd\xff\x1a\xfd\xf9\xbea^\x80\v\x1c\xa0J\x83\xa5\x0f|\x9f\x98\x9a\xed\x17\xf6\xa8>~\xfe\x84\xf9\x1cy M\xf2\xce\x16\xf2q\x80lw\xea\xe0\x94\xa7.#\xb8>M|\xe3S\x1a7 \xe0\x05O\xdec\x11\n\x889\x82&\x9a\x88tΉù\x15\x16\xb2\x17<1\x98\x90,Y\x1c\x9d*\n\xbe\xbd\xe0)\xa5ۀ\x80\x84\x93\xb4!\tD\x94\xa4\x0fL\b\x8e\xadӉ\a\x9c\xf8\x8a\xbahyq\x90\xaeHb\x8b\xb4\u007f\xc32\x1b\xb6u\x92\x86\xcc\xd8\x0fֳ\x88v\xc1AV\x89\v%3\a\x16y\xb7\xc4\xd4׳(d\xdeL\xe4\xe5~\xa3\xa6\xbd\xe1~\xfb\xac\xddF\xdd\xc0\xc3WiC\xf6\xf1\x93F\xfbY;\xfe\xf2M\xc8\xe9\x11\u007f\x031\xfd@\xde^ʫm\xa2C7\x87\x96 ܾm|\x84װGZ\xd8(\x8a[\x02=8#\ua9db\xb7\x0f\xfdV֖\x93dJ\xab\x15\x9b\xca\xf5\xd8L\x9e؉ \xb5\xe9q\xe4\x1c\xb5fR?a\"\xd8'\xb2$~\xbc\xcf\xf1\x16\"\xc3\x1c\xf2\x9a\x89əI\xe1p/3(\xd1\xec\xe7\fG\xb7U\xa4\xdf\xd3PHԺ\xbe](ai\xa6=\xb6\xa0\xba\xf3edV\xb4s\x13zEf/v\x9dHHNw]^\x11\x9bX\xf6?\x16\xa9+\xf2\x9cϒD\xf1x\x81ƿ\x80\x17\xe7\xb6\xdf#\xe6-d)8\xc9\xf8?d\xe6X\xa0\xff\x17*!M\xc2\x1e\xfe\xc8GC\x05\xf6Ɔ,Vw\x1a\x9aAZ \xfe\x1eEq\x9e\xea\x1eY\x9c&݂\x857\xe4zw\xe6\xb1\xdc\xc0\xebA[oSw\x12GS\xaa\xfd&-\\\xbf\xe0\xe9\xfa\xe6L\x0f\\oԵ7\xf0\x17\xab\x9b\xc6[Ъ8\xc15\x8f\xbd\xfe-NP\xa2$&u\xe3#\xb8TW\x99b\xc9\xe8\t\xd0\xc0\xe6܉\xdc\xdc9\xac\x93\xe4\xb0\xd2\xd6%\xa3\xf2\xa8\xad\xf3\x99Ş[zI\x16\v\xbc\f\x85\xec\x15\x88\x9d?\xf9\xd3&\x9e\xe9\x90\xda\x1b$\\\x89kv^\xc3\x12\x1b\x9b\x8c\x98\aJ\x81\xd5u\xbb\x83\xbd>\xbd\xf6\a=<\x89\xc8عX\x84[\x19\x9d\xa1\xb5\xf3\"\x92\xa0\xad\x17\x92\x84M\x82P\xf8\x00\xc6\x1f\x98\xcc'%cKwH\x89H\x17\xba\xf2\x0f_;\xd9K\xda\xfc\xf4\xf7\x92\xf0]\x8a\x17\xf0\x9e-K1<\x19LB\xf1ޏ\x8c\xdb$\x00\xf2\xa1\x81\xd9\u05fc\xd5\xd3=\xc8 H\xbf\a3]J\xb5\xe1\t\xe0\x87w7덒ķ8\xee\xf7qlK\xf4\xe6\x03\xef\xdeT\x8fHs\xe6\xde`\x8fs\xe7ynr\x14\x13A*\xed\xba\xe9\x04\x82[\xe9\xfc\x83\x85\x9d4\xd6u\x11M\x15\x8aza\xf7\xb7\xed\xd2\xc8I=\x18\xf3\xa6\xc0\xe9/~d'\x91uЯ\xf1|u\xf20s\xac\xf1\xa1\x10\x82܁t\x80*ӵ\xe2\xf4\vmu\x9e³\xc0+\xe8d\x92\xa5)\bj\xa8\xea2\x8d\x00+\x96:\xa9f\xf34\xdd\xee?\tY|\v\xb69Y\xa2\xaeg\rg\xdbzl{\xf2#{\a\xe5\xa5\xf8*˺\x04Q\x12\xe9SÞ\x9d/\x8e\xe9q\x1c^\x85tl9\b.\x9b\x11\xa7iSU\x05\xba\xd4\x1d\xb9ŝ6\xbc\x9f\xaḏ1\xccA\n\xb4\x02\x01;!\x8b\xda$jȋh{I\xac\x11\x94\xc5\xfb\x05\x11i\x93\xaf\x98\x14\t\x89\xd8Dgq^[W&\xddU|4\x98\xe6\x9e-%\xa5\xa3{V\x19I\xb2\xa4\xdf\xdbC\v\"&\xd4黋v־\xbbh\v\xed\xbb\x8b6پ\xbbh\xcb\xed\xbb\x8b\x16\xdaw\x17-\xb6\xef.\xdaw\x17m\xaeۜ\xb6^\xc2\xc8W\xdcO\xfc\xb8\x88E\xc2\xf1\xf4\x1c\x8a3\xf0C5Ž\xaf\xbeO\xad\xb0܌\x8f\x1a\xa9\xab\re\xfd+\xbe\x910&\x01m\xd1EkJ\x9a\x92K\xda Q\xbc}\x01\xf1B\x11fR9\xe5x\xf5mJ\xc1\xcfR\x99O\xbfδ)\xb3\x89\x85\xa6:N2B\x87x\xb3\x81\xdc\xden\rI\xbf^\x87\xfd܈\xe9߽\x065\xa1\x14g\xa1\x00g\xbe0w\x8e^\x83УO0\xd3+\x18\xfd\xdd\xd0k\xa1Jf\xba6&\x9c\x04\xa1\x13\xc7\x1f\xd6\xfd_\x9c\x0e\x952\xf0*\xddad)\xaf\aT|\x86\xa5\xf6ݲ\xd7(o\xe1\x8aɐ\x8e\xa0\r(Y09g\xa4\xb5G^\xf8K\xe5C\xb8\x8b\xf7\xe5|\xf8\x91VK\xf3\xe6\n\x9a~\x85̄\x8a\xbe\xf4\xc8(\xbdP8\xbdFf\xbe\xa8\xe5\x92ʘa\xdd\xcb$\xd0\xe5z\x98\x94\xc8q\xa1\xf6\xe5\r\x15/\x89Վ\xbf\xf9`,\xa5\xa6\xe5M\x95,\x8b\x05\x81\x89\xf5+\xfdʔy\x90\x17T\xad$\x11g\xb9B\xe5⺔P\a2\xbb\x8e\xe4j\x94\x91:\x93Y\xc0\x935(s\xd5%\vY\xa9\xf3ʓ\xf4\x9a\x92Y\xd0\\o\xb2\\I\xf2~\xf5\xa2\xef\xe1\x03O\xab\x9a\xc5j\x90E\x1fy\x1e\xbf\xc5z\x8fK\xaa<\x16)\xf6Ɗ\x8e\xa6bcb\xdeK\xeb8\xfau\x1a\x13@S\xaa7&\xaa3& \xce\xd6l\xa4\xd6dL\xc0^0\xbb\xb3R2\xf3\xe3\xf8EHX\xb4o\xc5\xdfJ\xa2\u07ba0mr4\xb3\x1ez*\x9a\xb3(\xf63^\x839\ae\xf6\xf1\xe2$\xf5\xeaz\xfdc,\xd7MIx\x06?K\x95{9!A\xef\xf8\t|Q\x98\x8bb\x1aw\xa5\xf5\xf7Ɓ\x0e\"\r\x8b\x950|\x93|{\xf2\xd9\n\xbb\x86\a\x91\x1d\xfa\x1d\xe1 ,Ť\xe5\xa8\x1bv݄i\xb7q\x14}\xb9^\x03\xfc\xa4\x9bH\xb8{\xcd\xcaʲ*NP[\x84\xeb\xfe\x90\xb7\xc5\x1c\xa3\x12`\x95\xa8\xecA\xc7;\xb0\vaǗ~\uf448>ހ\xcd\n]\xe7\r\xf4\t\xe6\tu\x82\xc7g\xf6}\xf8\xee`\xd6ޣ\f\xfeM\x8c$\x86\xd7,\u007f|\xff\b\xdf:m\xc4\x1e\u007f\xd1\xfe2\xf2\x12%\xfa\xbd{7у\x0e\x8b\x19\xb7X\x90%F\x88\x10\xaeE\x0f\x80\xb5\x89\xf4\xb0\x1b\xda\xe4\aa9\xa6\xdef\xf6\x9fs\xc5\xc2b\x9e\x9e~\xf1\vp\xb2\xc4\xf5\xa7\xdagSV\x950\x16\x89\x9aqa~Ж\xfe{Яc\nO\x875\xff8\xc4\xdb '\xeb9is\x11\xf6\xfe\xdat\x14\xbcH\xa2%A}\x1e\x1f\xd5\t\xf4:L\xf2\xbb\\\x8f\x9dKL\xc1\xe9\xbc.A\x81\xb5/\xb6{\u07fb\xbfS\x1e\xcb\xd4\xfd{'\\m\x97o\xe0s\xb7\xf8\xdeF8\xf2\xa9\r_\xdc\xf5 \xfcE\xd77]\xc2\x0f\x19\xea\xde\x1b(\xf3|\xba?\x1f\xc1/]\x98ܣƹ\xf1\xe66\xfd\xab\xb0M\x16|\xd4ķ\xe0\xfcH\xf6\xa0\t\x1a\xe6\x80GT\xa0\x15'\xbd\xf9J\xac\u007f\x8de8f,\x99ԁ\x12\xb2\xeauUh\x91\xc7\x1d\x1emVx\xc1\xe3\x89\xf5\x979\xa2\xf9`g`\xf2\x8b\x01;mƈp\xae0\xbda\xb9\x83\\8\\\x8d\x02M\xd2}\xa3\xc2\xc6\xe7C\x8b\xf7湓\xdfA|\xb8\x14\x1f7\xf0\xa7K%Z+\xf6\xf1\xc2\xfc+)\xb0=*d\xbb9\xb2\xfe\x10ϴ\xa7\x11\xfd\xeb\xe2>\xa5\"2W\x8b0A\xcc&uz}\x183+\x85\xde\xc3N\x16\xdc5<\xed\x114\xfb\x94ڑ\xca\xe1\x1e\x87\xee*~\xad\xa4I\xb1\x04\x0fMG\xa2\r\xe7\xd3X\x1b\xb4O\xe0`!\xf7\x92\xd4(1{/\xccV\xecq\x95\xe9\xa2@.]8\xc7\xeb[\xf2\xda\xc3\x1e}\xe2\xe6li?u\xfbF\u007f*\b\xbb\x87\x13_\xbc\xb9\t\x16z\xdcA-\xc5_\xb5\xb9\x81R*\xfa\x87\xdc0\x8eL\xe3\xe0\x8b\xec\x01\xbf.\xb0\x80\xf7#\xf5i\x0e\xb4;\xda\r\xa3\x98M\xf9\x0f㇘+\xf8\x8c\xe7\xe6ΟKbι\x97\xb1w}\xa8\xcbF=\x1a\xbd\xa7\xd0i\xe4\xc7\xfb\xa8\xcbF~{\x14\xc6IQ\x14'?\xc9\xe4\xec#?|B\xd2&\x93&e\x9c\xac\x01\xcb%ʆnm\x9c&\x95\x97\x04>Q\xdc\xea\xda\xf56h\xbb\xc1G\xc4\"ι\x86\xcf\xdaaL\xe3\xc9>L\xb2\xabh\xdd\nw;m\x9c\x0f\vW+\x90\xbb`\xa2F\xe0\x92\x86\xe7Ե\u007ff\a\xa4k\xd3'\xad\xf4\xb2\xf7iPX\x96^Ǐ\xfd\xf0\xe9\x92\xc82\xf2\x80\xf0\xd6:Q\x8ch\x8dߔ\xadf_\x80\xa4\x0f\xf3\u007f\x1b1\x8eg\x04\xdft\xfb7%\xfeu\xb9\xf5\xd1\x11\x83\xf3\x94\xe3\xd3\u007f\xaf1\x8b\xa9<\xca\x16Q\xc1\xab\x91Α\x96\xea\xe6\xf6\xc1\x91^*\n\xb0\x1avb\xe2A\x879}ɿk'\x8a\xcdtN\xa9\xeft6\x9d\xe3\xb2x\xf8\xf9\xe24\xb1e\xcb$\x98X\x96\xaf.\x936\x8e%Vf\a\xa1\xf6$TF\xd7\xfbC\x94\xcb\t{3\x95\x89\xaf\t)\xa8\x8azO\xa2\x1er\xe3\xae6\xaa\x13\x17\x87ly\xdeAWd/\x93\x98\x86\xec`|\xea\xed6<Ѱ\xda\x19]\xae\x02/8op\x13\xe2U#59e\x14]M\x00m\xefB\xb3\x18T\x15*\x106\xe0\x93P\xfa6\xcfֹ\xe0\xd1\t\xe3R]\xbd/\xbd\xce\v^\x1eC\x1e\xc7\xf7K\x88\xc6}\t\xe0\xfd\xf0\xd1=\x8a\x9bU|e\xceG\xfb^\x14,9\u007f\x069\x80\x1a=\xaf8s\xdbzNZ\x1f\xfd\xbf\xad\u007fvl,\xccC\x8a\xa7\xf6<\xe8>8G\xa5]\xdeB\f\xde\xd5\b=\xfe w\xfe %#\xac\xff\xf8w?\x1f=&\xf9,\x1ff\xdd\x15\xf6D\x1a\xbf\x03>ae0\xa3\xdd;\xb6\x8c\xc7\x02ɏ\xb0\x88}O\xe8\xc3E\x8ed?\x80\xb5\x1f\x9dò\x1a\x9dq&\x82m\x87M)K\x11;\x8c,$>\x80\x18\x81\x85Ң\x99\x90\xf5\x82\x055N\xcce\vj\x86M-\xc8\xd6\x19)\xad]=nΚ8\xf0\x9dW\xf7*\x8c\x92j\xbf\xb4\xc7\xfe=t\x1b\x89\x87\x02\x84\x91\x88hd\x19M\x8c\xb4\x18\x11u\x02\xa2\x88\xe3Ļd\x83 \xe9\x9dB\xa2Q;p\xf6\x91\x15h\xde\xd9\xdba\xa6\xf0\xa5\xcdR\x88,C\x12\xd7\xcfÇN\xaf\xaf\xf9\x8f\xf8\x96)\xff\x99i\xe5ͭ\xbd\x83\xff\xf8\xcf+\bi\xb0\xe7\xf8h)}\xfc\xbf\x00\x00\x00\xff\xff\bB\tb\x14V\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4YK\x8f\xe3\xb8\x11\xbe\xfbW\x14f\x0f}\x19˳\xd9K\xe0K\xe0\xf1$\xc0 =ۍq\xa7sH\x02,M\x96lnS\xa4BR\xf6:A\xfe{P|H\xb2$\xb7݃d\x97\x97n\xf3Q\xac\xfa\xeaM\xcd\xe6\xf3\xf9\x8c\xd5\xf2\x19\xad\x93F/\x81\xd5\x12\u007f\xf1\xa8\xe9\x97+^~\xef\ni\x16\x87\xefg/R\x8b%\xac\x1b\xe7M\xf5\x15\x9di,\xc7OXJ-\xbd4zV\xa1g\x82y\xb6\x9c\x010\xad\x8dg4\xed\xe8'\x007\xda[\xa3\x14\xda\xf9\x0eu\xf1\xd2lq\xdbH%\xd0\x06\xe2\xf9\xeaÇ\xe2\x87\xe2\xc3\f\x80[\fǟd\x85γ\xaa^\x82n\x94\x9a\x01hV\xe1\x12\xb6\x8c\xbf4\xb5\xf3Ʋ\x1d*\xc3\xe3]\xc5\x01\x15ZSH3s5r\xbazgMS/\xa1[\x88\x14\x12[Q\xa4\x8f\x81\xd8&\x12\xbbO\xc4º\x92\xce\xff\xf9\xf2\x9e{\xe9|\xd8W\xab\xc62u\x89\xad\xb0\xc5\xed\x8d\xf5?vW\xcfa\xebT\\\x91z\xd7(f/\x1c\x9f\x018nj\\B8]3\x8eb\x06\x900\v\xd4\xe6\xc0\x84\bZ`\xea\xd1J\xedѮ\x8dj*\xdd\xde%\xd0q+k\x1fP\x8e\xb2@\x12\x06\xb24\xe0<\xf3\x8d\x03\xd7\xf0=0\a\xab\x03\x93\x8am\x15.\xfe\xa2Y\xfe?\xd0\x03\xf8\xd9\x19\xfd\xc8\xfc~\tE<U\xd4{\xe6\xf2j\xd4\xd1coƟH\x00\xe7\xadԻ)\x96\xee\x99\xf3\xcfLI\xd1j\x1d\xa4\x03\xbfGP\xccy\xf04A\xbf\"B@\x10!d\x84\xe0\xc8\\\xba\a\xe0\x10\xa9\x04\x8c\xa69U\xa3\xbb\xce\xd8&V\xe0y@%\xf2O3\x89\xfb\x1e\xd9l\xf8\xc5\xc8h\xcf\xe8\xaevx\x89\xd8\x19\x14\x9f\xb0d\x8d\xf2}QIK\xaao\x97\xe7b\xd5\xc8\v\x11O\x9d\xdd\xf8\xe9l.\u07ba5F!\x8bT\xe2\xae\xc3\xf7\xd1\n\xf9\x1e+\xb6L\x9bM\x8dz\xf5\xf8\xf9\xf9\x87\xcd\xd94L\x19\xd2\xc0)Hq\xac\xa7\x9b=Z\x84\xe7\xe0\u007fQo.\x89\xd6\xd2\x040۟\x91\xfbN\x89\xb555Z/\xb3\xb3\xc4\xd1\vR\xbd\xd9\x01Ow\xc4v\xdc\x05\x82\xa2\x13F;J\xfe\x82\"I\n\xa6\x04\xbf\x97\x0e,\xd6\x16\x1dj߇\xb7e\xac\x04\xa6\x13{\x05l\xd0\x12\x19\xf2\xe5F\t\nj\a\xb4\x1e,r\xb3\xd3\xf2_-m\a\xde$\xe3\xf5\xe8\xfc\x80f\xf0O\xcd\x14\x99j\x83\xef\x81i\x01\x15;\x81E\xba\x05\x1aݣ\x17\xb6\xb8\x02\xbe\x90\xbdK]\x9a%콯\xddr\xb1\xd8I\x9f\x8337U\xd5h\xe9O\x8b\x10g\xe5\xb6\xf1ƺ\x85\xc0\x03\xaa\x85\x93\xbb9\xb3|/=r\xdfX\\\xb0Z\xce\x03\xeb:\x06\xcdJ|gS8wwg\xbc\x8e\xbc6\x8e\x105_\xd1\x00E\xcch\x05\xf1h\x94\xa2\x03\x9a\xa6\b\x9d\xaf\u007f\xdc<A\xbe:(c\x88~\xc0\xbd;\xe8:\x15\x10`R\x97h\xa3\x12Kk\xaa@\x13\xb5\xa8\x8d\xd4>\xfc\xe0J\xa2\x1e\xc2\xef\x9am%=\xe9\xfd\x9f\r:O\xba*`\x1d2\x16l\x11\x9a:\xf8}\x01\x9f5\xacY\x85j\xcd\x1c\xfe\xdf\x15@H\xbb9\x01{\x9b\n\xfa\xc9v\xb89\xa2\xd6[ȹ\xf0\x82\xbe&\xbdxS#?\xf3\x1f\x81NZ\xb2p\xcf<\x06\xbf\x18\xe0\x9a\\\xfcr2\xcdcڹi0\xceѹ/F\xe0pe\xc0\xf2\xaa\xddx\xc6c\x8d\xb6\x92.\xa4E(\x8d\x1df\f\xd6F\xe0\xfeȑ\xaa\x18\xad\xa1n\xaa1#s\xf8\x8aL<hu\xba\xb0\xf4W+\xfd\xf8\xa2\v\x8a\xa4\x11Yܜ4\u007fD+\x8d\xb8\"\xfc\xc7\xc1\xf6\x16\x82\xbd9B\x19\xccZ{u\xa2\x18\xe4N\x9a\x8f\xa3m\x1e\xab\xc7\xcf9\xf2F\aJ\xfe\x96\xb0*`\x95<ה\xf0\x01\x84tT\x00\xb8@t\f\x16\x95g\xb4\xbe\x04o\x9b7\x89ύ.\xe5n,t\xbf\xa6\xb9d1WH\x0f\x90[\x87\x9b(4\x91u\xd4\xd6\x1c\xa4@;'\xff\x90\xa5䉓\xc6\xc6\xccUJT\u008d%\xbd\xe0eA\x14\x8b\x82\xbc\x9a\xa9+:\\\xb7\x1bCi̤\x8e\x16\xdc\x11\b\xc1\xc6V)\xa5j\x8fZ\xb4\xd5\xc8\x197&D-\x87\x02\x8e\xd2\xefc8TS~\a\xaf\xfa\x1e\x8d\x17<MM\x0fx\u007f\xda#\xed\x8c\t\x14\xc1!\xb7胵\xa1\"\xf3!S*\x00\xbe4.\x04\xd4a\x9c\xc8#\x14j\xf9\xf4\v\x9e\xc6@\xc35\xe5\xa6\x12\xe6:\xcbwT:g\x86-\x96hQ\xfbɠN\x9d\x89\xd5\xe81\xc4ua\xb8\xa3\x90α\xf6na\x0eh\x0f\x12\x8f\x8b\xa3\xb1/R\xef\xe6\x04\xf8<y\xd0\"\xb4\x15\x8b\xef\u009f\v\"?=|zX\xc2J\b0~\x8f\x96\xb4V6*\x1bZ\xaf\xbey\x1fr\xec{h\xa4\xf8\xc3ݷ\xe0b\xea\xe897`\xb3\t\xd6\u007f\xa2B-0E\x10m\xa2V\x8c\x05ʔ\xa4\xec*i3ƚ)C\x9c\xaa0\xfb\x83\x02\x13e\x90\xa9\x88\xfa\x82\xe3`\xfa\x8a\x9b\xa5b\xf7\x8a\x8f\xe5BZj!9\x15b羑\x1b\fqVoO\b6\xac\xc0/E\x84i\xc1\xa3\x00)\x1f^\xe1\xf8\xa1\xbf\xb7k\xcbbxJ9Ρ\xa7\x02ʁFʁ̎\x91\vA\x81\x1b\xad\xc9\x1b\xbd\x01ֆ\xba;7\x8c\xf1o\x8c\x10ۆ\xbf\xe0\x04\xf0#Q>\x86\x8d\x19\xe3x\x8cxi\x1c\x86\xe0{\x8d\r\xb8n㜭\xd1\xde\xc2\xcbzE\x1b\xdb4\xc9`\xbd\x82m\xa3\x85\xc2\xcc\xd1q\x8f\x9a:\x04Y\x9e\xa6\xef\xa2\xf1t\xbfɨ\x86\n#\xd5\xf8\x19\xdbi\x19b\f_\xc2\xf64Q\x13\xdc dm\xb1\x94\xbf\xdc \xe4cؘ\x01\xaf\x99߃\xd4N\n\x046\x01\u007f,\xd6.\b\xda\xe6\xff\x87\x14E\xbeA=\xafy{d\xe7-\x0e\x9f1\xbe\xe2?\x8fi[\x8bB\xfe\x9d\"\xffy-xɏ'%:\xb4\x0f\x06\u007f\x8a\x15\x16\x9fH\x95g\xcc<\x8fO\xbcR\xa9\xe5g\x8b)g\xa6\xba\xc0X\x8b\xae6ZP\xf3t[\x9dֱ\xfc\xbf\xab֦\xd5:?\x8fr\x83\xb5\xac\x85\x9bZ\x95\xf0D\xf3\xe6f%>\\\xf5[\x01\xb3u\xd4)v\xfd\xca@\xc6_\xa5My\xd7\xebS\xa8\x1f\xd6\xd0\xe8P\xa9\x85\x8c_\xc0\xdf5|\xa2ޖ\xb2\x93X\x12\xdfv\xca\x00\xa4\x03m\x8et\xbcG/\x90\x00\xa3c\xbe\xa6n\x8di\x91\x9a\xe1\xb0t\x94JQƶX\x99\xc3dƦBӢ:\x01sd:\x87\xdf\x15\x1f\x8aw\xbfY\x17\xa4\x98\xf3\xd4Ԡ\xf8\x8a\a9~\xe5\x19\xa3{?:\x91\x1d\xbfu\a\xfa\xf1Sn\x96\x176m\xfbi\x02\x8cR*\xaa\x05'\xe2DW1\x8c\xdf#?n\xee\xef\\(\xe1Q\xfb\xa9\xb2\xef\x88\x16CǄ\x82\xaax\x93\xde%\x1a\xe7\xd1N\x18@\xab\xbd\xa0sPF\xef\x06\x8e\x13Gz\xa5\xa0\n-\x1a\x94\xb1 \xd0Sj\xd2;\xe0{\xa6wؽB%\xfe_\xe7\x94\xccg`3\x9d\x85H}\xc9<n\xd2蓜*\xd3G/\xc0\xdd\xe6\xe9\xd7\xdf\xcc}\xd6\xec\xc56\xe7\n\xee\xa3\xfd9K\x13\xa8s߽\bw\xe3\xdb\xdb\xdb\xf1s\xf3\rH\xbc\xf5-\xfc\x95w\r82\u05fd\x8a\xffv8\x84\x0f\x04\xd7\x128\xed\xc9\xd2\xf2\xc6R\x9bֽ8\x05\x87\x9b\x8a۷?ݬ\x06\xdf1\xfak\xe3\xaf\x1c7\xc85\x99\xc7F\x931\x17\xf50K\xa1\xa5?\xd3l\xdbW\xd8\xccyʆ\xf0\xef\xff̺\xc4H٧\xf6(~\x1c~\xc6z\x17\x9d3\u007f\x8b\n?9U\f\xf1;\x1c\xfc\xed\x1f\xb3x1\x8a\xe7\xfc\xf1\x88&\xff\x1b\x00\x00\xff\xff=\xe0\xd66\x01\x1c\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V=s\xe36\x10\xed\xf9+v.\xc55\x11u7\xd7d\xd4%\xbe+<I<\x1e\xfb\xc6M&\x05\x04\xacčI\x00\xd9]\xc8q~}\x06\x00iI\x14}q\x8a\xb0\xe3~\xe1\xe1\xbd\xdd%\x9b\xd5j\u0558H\x0f\xc8B\xc1o\xc0D¿\x14}~\x93\xf6\xf1\ai)\xac\x0f\x1f\x9bG\xf2n\x03WI4\fw(!\xb1\xc5ϸ#OJ\xc17\x03\xaaqFͦ\x010\xde\a5\xd9,\xf9\x15\xc0\x06\xaf\x1c\xfa\x1ey\xb5G\xdf>\xa6-n\x13\xf5\x0e\xb9\x14\x9f\x8e>|h?\xb5\x1f\x1a\x00\xcbXҿҀ\xa2f\x88\x1b\xf0\xa9\xef\x1b\x00o\x06܀\xc3\x1e\x15\xb7\xc6>\xa6\xc8\xf8gBQi\x0f\xd8#\x87\x96B#\x11m>x\xcf!\xc5\r\x1c\x1d5\u007f\x04U/\xf4\xb9\x94\xfa\xa9\x94\xba\xab\xa5\x8a\xb7'џ_\x8b\xf8\x85ƨ\xd8'6\xfd2\xa0\x12 \xe4\xf7\xa97\xbc\x18\xd2\x00\x88\r\x117p\x93aEc\xd15\x00#\x1f\x05\xe6j\xbc\xf1\xe1c-g;\x1cL\xc5\x0f\x10\"\xfa\x1fo\xaf\x1f>ݟ\x99\x01\x1c\x8ae\x8aZX]\xc0\x0f$``D\x01\x1aFp\x10<B`\x18\x02#T\xa4Ҿ\x14\x8d\x1c\"\xb2\xd2\xc4_}NZ\xe7\xc4:\x83\xf0>\xa3\xacQ\xe0rϠ\x80v8\xdd\x14\xddx1\b;Ў\x04\x18#\xa3\xa0\xaf]tV\x18r\x90\xf1\x10\xb6\u007f\xa0\xd5\x16\xee\x91s\x19\x90.\xa4\xde\xe5V; +0ڰ\xf7\xf4\xf7Km\xc9\xf7̇\xf6F'\x91\x8f\x0fyE\xf6\xa6\x87\x83\xe9\x13~\x0f\xc6;\x18\xcc30\xe6S \xf9\x93z%DZ\xf85\xd3D~\x176ЩF٬\xd7{\xd2idl\x18\x86\xe4I\x9fץ\xfbi\x9b4\xb0\xac\x1d\x1e\xb0_\v\xedW\x86mG\x8aV\x13\xe3\xdaDZ\x15辌M;\xb8\xefx\x1c2y\u007f\x86U\x9fsÈ2\xf9\xfd\x89\xa3t\xf37\x14Ƚ\\e\xaf\xa9\xf5\x16G\xa2\xb3)\xb3s\xf7\xe5\xfe+LG\x171\xe6\xec\x17ޏ\x89r\x94 \x13F~\x87\\E\xdcq\x18JM\xf4.\x06\xf2Z^lO\xe8\xe7\xf4K\xda\x0e\xa42\xb5d֪\x85\xab\xb2G`\x8b\x90\xa23\x8a\xae\x85k\x0fWf\xc0\xfe\xca\b\xfe\xef\x02d\xa6e\x95\x89}\x9b\x04\xa7+p\x1e\\Y;qL;\xea\x15\xbd\x16\x86\xf6>\xa2\xcd\nf\x12s6\xedȖ\xf1\x80]`x\xea\xc8v\xd3\xd0\xce\xd8}\x19\xf0\xf6̱<\xd0\xf9\xa9e\xf2R\x9a{^\xbd<\x14\xed\x88qօ\xab\x93bo\xe2E\x8d&\xf9\x8f̔\x9c\x89\x1b\x9b\x98\xd1\xebX\xa9l\x8b\xa5\xa4\xb7r\x81́/\xac3P_JP\xf9\xce\x19\xf2\x02\xc6?\x8f\x89\xa0\x9dQxB\xcec`C\xca{\x06\x1d\xb8t\xc1\xdfHK\x87U\xac,l\xe4`Q\xa4\xbd\x88#\xc5a\x01\xd37\xd4\xc9O\xfe\x86\x9am\x8f\x1bPN\xf8\x8a\xb2\x86\xd9<\xcf|\xb13\xb2\xd0\ng\x14\xdc\xe6\x98%\r\xb0nu\xfcw\x11\n\xdd>\r\x97'\xad\xe0\x06\x9f\x16\xac\xd7\xfe\x96ÞQ\xe6-\x9f\x9d\xb7\x95\xbd\xf2M}#K\x8bMya\x94\xbc\xef\xdc\t\x8b\xa2\x81\xcd~\xe2\xf5\xd8\xc2\xc6Z\x8c\x8a\xeef\xfe\xd7\xf1\xee\xdd\xd9\xefCy\xb5\xc1;\xaa?M\xf0\xdb\xefM\xad\x8a\xeea\xfa\x1b\xc8\xc6\u007f\x02\x00\x00\xff\xff~\x96\x80P\xae\t\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WMo\xdc6\x10\xbd\xebW\f\xd2CZ \xd2&ȥ\xd8[\xeb\xe4\x104\r\x02;\xf5\xa5\xe8\x81K\xcdJ\xac)\x92\xe5\f\xd7q\x8b\xfe\xf7bHi?\xb4Z\xdb9T\xb7%\x87\xc3\xc7\xc7\xf7\x86\xb3U]ו\n\xe6\x16#\x19\xef֠\x82\xc1\xaf\x8cN~Qs\xf7#5Ưvo\xaa;\xe3\xda5\\%b?\\#\xf9\x145\xbeíq\x86\x8dwՀ\xacZ\xc5j]\x01(\xe7<+\x19&\xf9\t\xa0\xbd\xe3\xe8\xad\xc5Xw蚻\xb4\xc1M2\xb6Ř\x93O[\xef^7o\x9b\xd7\x15\x80\x8e\x98\x97\u007f1\x03\x12\xab!\xac\xc1%k+\x00\xa7\x06\\C\xeb\xef\x9d\xf5\xaa\x8d\xf8WBbjvh1\xfa\xc6\xf8\x8a\x02jٴ\x8b>\x855\x1c&\xca\xda\x11P9̻1\xcduI\x93g\xac!\xfeei\xf6\xa3\x19#\x82MQ\xd9s\x10y\x92\x8c\xeb\x92U\xf1l\xba\x02 \xed\x03\xae\xe1\x93\xc0\bJc[\x01\x8cgϰ\xea\xf1t\xbb7%\x95\xeeqP\x05/\x80\x0f\xe8~\xfa\xfc\xe1\xf6\xed\xcd\xc90@\x8b\xa4\xa3\t\x9c\x19\x9ca\x06C\xa0`D\x00\xec\xf7\xa0@9P\x91\xcdVi\x86m\xf4\x03l\x94\xbeKa\x9f\x15\xc0o\xfeD\xcd@\xec\xa3\xea\xf0\x15P\xd2=(\xc9WB\xc1\xfa\x0e\xb6\xc6b\xb3_\x14\xa2\x0f\x18\xd9L,\x97\xefH\\G\xa33\xe0/\xe5l%\nZQ\x15\x12p\x8f\x13?؎t\x80\xdf\x02\xf7\x86 b\x88H\xe8\x8a\xceN\x12\x83\x04)7\x9e\xa0\x81\x1b\x8c\x92\x06\xa8\xf7ɶ\"\xc6\x1dF\x86\x88\xdaw\xce\xfc\xbd\xcfM\u0090lj\x15Or8|\xc61F\xa7,\xec\x94M\xf8\n\x94kaP\x0f\x101\xf3\x94\xdcQ\xbe\x1cB\r\xfc\xea#\x82q[\xbf\x86\x9e9\xd0z\xb5\xea\fO\xa6\xd2~\x18\x923\xfc\xb0\xca\xfe0\x9b\xc4>Ҫ\xc5\x1d\xda\x15\x99\xaeVQ\xf7\x86Qs\x8a\xb8R\xc1\xd4\x19\xba\xcb\xc6j\x86\xf6\xbb8ڐ^\x9e`\xe5\a\x91\x19q4\xae;\x9aȚ\u007f\xe4\x06D\xf5E0ei9Łh\x19\x12v\xae\xdf\xdf|\x81i\xeb|\x19s\xf6\x8br\xf6\v\xe9p\x05B\x98q[\x8c\xe5\x12\xb3\xf2$'\xba6x\xe38\xff\xd0֠\x9b\xd3Oi3\x18\xa6I\xccrW\r\\\xe5J\x03\x1b\x84\x14Z\xc5\xd86\xf0\xc1\xc1\x95\x1a\xd0^)\xc2\xff\xfd\x02\x84i\xaa\x85\xd8\xe7]\xc1q\x91\x9c\a\x17֎&\xa6Jv\xe1\xbefV\xbf\t\xa8\xe5\xf6\x84@Yi\xb6Fgk\xc0\xd6GP\a\xe7\x8f\x046'\x99\x97\x9d\x9b\xc1\xa9\xd8!\xcfGgX\xbe\xe4 \xd9\xfe\xbeW\xa7\x85\xe6{l\xbaFj\x05\x8d@J\xf5\xf8\xa19\xcbx\x19\x03,\xaaw\x11\xc9$b\xa1Ax\x95R E\xea\x18\xd3\xf9\xd6\xf2\xa1K\xc3\xf2\x065\xfc\x9c1\u007f\xf4ݣ\xf3Wޱ\xc8\xfdѠ[oӀ7N\x05\xea\xfd\x13\xb1\xd33\xbb\u007fz\xce\x03\xafQ\n4^\x866\x06\\#%{a\xbb\vb\x9d\xbe\xfc(=ͼ<k\x13\xf3\xb2\xa4Tj\x04y\xec\xa3CF:\x14\x8d{\xc3\xfdbF\x80\xfb\xde\xe8>/\xcc\xd7&\xf5\x88\xc8k\x93\xdd\xfd\xed\xf0E\xed&\xe2\x82t\xea,\xa9\x85a\x01\u007f6|\xc1\xa3\x976\xa8G\xdf<\xcb\xe7\xac8\xd178=\xc7OT\xeb\x14#:\x1e\xb3\xe4\x97o\xbe\xe0\xb9V\x9f\xfc\xf1\xdb\xf5\xc7'\xfc\xfe\xee\x10\x99{;e\\A\x13\"\xd6d:y\xafeN\x1c\x9f\x9dxNF\xf9N\xfb\x87S\xa2\x16o\x14\xbf\x06\x13s]{\x02\xe2\xfb}`)K\xe8ʓ3\xef\x90rB\xa4\xfc\x9ck5o$\xe4\xdb \xb4h\x91\xb1\x85\xcdC\xa9\xaf\x0f\xc48\x9c\xe3\xde\xfa8(^\x83<E5\x9b\x05\x19I\x17\xab6\x16\xd7\xc01]R\xd9\xe2\xc1C\xafh\xc1\x86'g\xfe,1K\xc2؛\xf1Qe\xc0\xc5*X\xc3'\xbc_\x18\xfd\x1c\xbdF\"<\xb7\xd1œ,\x9a\xe0l\x90\xa4_h\x8fX\x1a\xdb\xd0㑴\xd9\xf7?\x13\xe2\xd1J\xf0Ͽ\xd5\xc1UJk\f\x8c\xed\xa7y\xfb\xff\xe2\xc5I?\x9f\u007fj\xefZS\xfe\xb9\xc0\xef\u007fTeclo\xa76]\x06\xff\v\x00\x00\xff\xffj\x11\xef\x043\r\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4YKo#\xb9\x11\xbe\xebW\x14\xbc\ag\x01\xb7\xb4\x8f \bt۱\xb3\x81\x93]\x8f1r\xe62\x98C\xa9Y\xdd͘M2$[\x1a%\xc8\u007f\x0f\x8ad\xeb\xd1jI\xb6\x81\x99\xf4e\xc6d\xb1X\xef\xfa\x8a\x9a\x14E1A+?\x92\xf3\xd2\xe89\xa0\x95\xf4%\x90\xe6\xbf\xfc\xf4\xf9\xcf~*\xcdl\xf5\xe3\xe4Yj1\x87\xdb\xce\a\xd3~ o:W\xd2\x1dUR\xcb \x8d\x9e\xb4\x14P`\xc0\xf9\x04\x00\xb56\x01y\xd9\xf3\x9f\x00\xa5\xd1\xc1\x19\xa5\xc8\x155\xe9\xe9s\xb7\xa4e'\x95 \x17\x99\xf7W\xaf~\x98\xfe<\xfda\x02P:\x8aǟdK>`k\xe7\xa0;\xa5&\x00\x1a[\x9a\x835beT\xd7\xd2\x12\xcb\xe7\xce\xfa\xe9\x8a\x1493\x95f\xe2-\x95|i\xedLg\xe7\xb0\xdbHg\xb3@I\x99G#>F6\xef\"\x9b\xb8\xa3\xa4\x0f\u007f\x1f\xdb\xfdM\xfa\x10)\xac\xea\x1c\xaac!⦗\xba\xee\x14\xba\xa3\xed\t\x80/\x8d\xa59<\xb0\x18\x16K\x12\x13\x80\xac{\x14\xab\xc8ڭ~L\xacʆZL\xf2\x02\x18K\xfa\x97\xc7\xfb\x8f?/\x0e\x96\x01\xac3\x96\\\x90\xbdj\xe9\xdb\xf3\xe8\xde*\x80 _:iC\xb4\xf753LT ؕ\xe4!4\xd4\vE\"\xcb\x00\xa6\x82\xd0H\x0f\x8e\xac#O:9\xf7\x8010\x11j0\xcb\u007fR\x19\xa6\xb0 \xc7l\xc07\xa6S\x82#`E.\x80\xa3\xd2\xd4Z\xfe{\xcb\xdbC0\xf1R\x85\x81\xb2\x85w\x9fԁ\x9cF\x05+T\x1d\xdd\x00j\x01-n\xc0\x11\xdf\x02\x9d\xde\xe3\x17I\xfc\x14~7\x8e@\xea\xca̡\t\xc1\xfa\xf9lV\xcb\xd0Griڶ\xd32lf1(\xe5\xb2\v\xc6\xf9\x99\xa0\x15\xa9\x99\x97u\x81\xaeld\xa02t\x8efhe\x11E\xd71\x9a\xa7\xad\xf8\xce\xe5\xd8\xf7\xd7\a\xb2\x86\r\xfb\xd6\a'u\xbd\xb7\x11\x03\xed\x8c\a8\xd4@z\xc0|4i\xb134/\xb1u>\xfce\xf1\x04\xfd\xd5\xd1\x19C\xebG\xbb\xef\x0e\xfa\x9d\v\xd8`RW\xe4\x92\x13+g\xdaȓ\xb4\xb0F\xea\x10\xff(\x95$=4\xbf\uf5ad\f\xec\xf7\u007fu\xe4\x03\xfbj\n\xb71\xbdaI\xd0Y\x81\x81\xc4\x14\xee5\xdcbK\xea\x16=}u\a\xb0\xa5}\xc1\x86}\x99\v\xf6+Ӑ8Ymo\xa3/\x1f'\xfc5\xa8\t\vK%{\x8f\r\xc8'e%˘\x1aP\x19\a8$\x9f\x1e0\x1eO\\\xfeR\xc5X\x04㰦\xdfLb9$\x1aH\xf6n\xecL/\x1bו\x94Ĕ\x99\x83O\x94GL\x01T\u007fxݐ\xa3xƑ\x0f\xb2\xe4\xe02^\x06\xe36̘9\x90\x98\x1eq8\xe1\x06\xfe\xb4\x11tA\x8f\a#hLl>\n\xa1\xc1\x14\xad\x8f&f\x8d\xeb\xb4>\xbe\x85?\xa3_%\x985\xe2\x82\\\xf9F\x04G\x159Ҝ\x85\xa9pY\x13\xcb[@\xa9\xfblM\x85\x1f\x82\x19\x91l\x99\\@\x02\x86\x01\x01g\x83\x02\xceT\xf5Q\x89\u007fy\xbc\xef+yo\xc4,{8\xbe\xf7\x82}\xf8\xab$)\xf1\x88\xa1y\xc1\xdd\xd7\xf7U\xba,ִ`\x00\xc1J*\xe9\xa0I\x80\xd4>\x10\n0\xd5(G\x06\x12\xc0\x89\xef(\x9f\xb8I\x15,\x97\xca]ka\xdb\x03r\xed\x94\x02\xfe\xb6x\xff0\xfb\xeb\x98\xe9\xb7Z\x00\x96%yf\x84\x81Z\xd2\xe1\x06|W6\x80\x9eՐ\x8eĂw\xa6-jY\x91\x0f\xd3|\a9\xff\xe9\xa7\xcf\xe3\xd6\x03\xf8\xd58\xa0/\xd8ZE7 \x93ŷe\xb9\x0f\x1a\xe9\x939\xb6\x1ca-C#\x87\xcdtk\x01\x0e\xaf\xac\xf6:\xaa\x1b\xf0\x99\xc0du;\x02%\x9fi\x0eW\\~\xf6\xc4\xfc\x0f\xe7\xce\u007f\xafNp\xfdCJ\xed+&\xbaJ\xc2m\xfb\xf0~\xd2\xed\x84L\x99\xe7d]\x93\x8b\xc0e\xec\x8bM\x85K\xf5\xf7`\x1c[@\x9b=\x16\x911{/\x15J\x12GB\u007f\xfa\xe9\xf3I\x89\x0f\xed\x05R\v\xfa\x02?\x81\xd4\xc96ֈ\xef\xa7\xf0\x14\xa3c\xa3\x03~\xe1\x9b\xca\xc6x:eY\xa3ՆunpE\xe0MK\xb0&\xa5\x8a\x84\x83\x04\xacq\xc3V\xe8\x1d\xc7\xf1\x86`х\xb3\xd1ڣ\x9f\xa7\xf7w\xef\xe7I2\x0e\xa8:Vb\ue695d4\xc30&\xf5\xe2\x18\x8dGͼ\xff|\x97\xc2'\x18(\x1b\xd45%}\t\xaa\x8e\xbb\xe3\xf4\xfa-y|\fI\xfao\x04\x9a\f\v\xc7\xff\xad\xb9\xbfP\xb9\x88\xa0_\xa0\xdc\xc3^\x94\x9fU\x8eg\x15\xa7)P\xd4O\x98ҳj%\xd9\xe0gfEn%i=[\x1b\xf7,u]ph\x16)\x06\xfc,\x8e\x1b\xb3\xef\xe2?o\xd6%\x0e\n/U(\x12\u007f\v\xad\xf8\x1e?{\x93R=\x86}y\x1f\xbb^dd5<\xcbi\xb1nd\xd9\xf4\xc3I\xae\xb1'\x92I2\x12\x16\xa94\xa3\xde|\xf5Pf\x83v\x8e%\xda\x14y\x00.P\v\xfe\xbf\x97>\xf0\xfa\x9b,\xd8\xc9\x17\xa5\xef?\xee\xef\xbeM\x80w\xf2M\xb9z\x02\x80\xa7\x18\xb1\xe6^\xb0)+I\xee\x020\xfbp@\xdcC\xc7\x11ĺ\xa5y\x152\fX\x8f@1\x14\">{\xa0z<\v\xd8\xceZ\xe0@\x8d'\xac=\xa0#@hѲ\xe7\x9eiS\xa4\x16oQr\u007f\xe6\x16\x9c1ϒ\x00\xadUr\xb4\x15\xe7F\x9eAh\xc6\xfb<hc\xedO\xe9>\xea\x87\xc4\xe1\x82\xfdӀ3\x06ٳ\x00\t\xdfla{0\xb0\x1cK\xd13\xa0\xf8\xa4\x15y.e\xb4v(b1>@\rhx\xa0\x18,Y#\x06+\x87\x918\xd8L\xfa\xbdh\xaa\f\x18:\xff\x8a\xb92\xd2\xf76MU$d.\x11B\xbfu\xb2,\r\xa3\xd3ç\xb5\xf3\xee\xbd=>\x11\x1fq\x9cH\xc2\x05\xd9r\xcc\xe6([\xa3\xef\xef\x18\x1b\ra\x8f]:\x19\xeb6s#\x11\xa1##\xdb\n\xa5\"\x01\xfd\xdb\xde\xf0\xcc\b\xd7}.K\xaa\xb8\xc8uV\x19\x14\xfd@\x96\xc5\xdb\xc23\x9e\xd7\xe3\xebȵ?ó\xf3$\xe2$?b\x84c\xc8V\x19\xd7b\x98\x83\xc0@\xc5(S\xdd)\x85KEs\b\xae;\xde>S,Z\xf2\x1e\xebK\xa9\xf8{\xa2Jsj>\x02\xb84]\xd8\x0e\xaa\aE\xe1\xda\xe7\x98zݬ<:\x02\x1e\x8632D\xf7\x19\xaa*\x15\xcf\xec\x17\x82݃p\x94jI\xe3\xad\xee-5\x01\xc06\xe8/\x99\xea\x91i\xc6\x12l[\xbd\xcef\x18\u007f\xa4\xbb\xf6\xf8\x96\x02\x1eh=\xb2z\xaf\x1f\x9d\xa9\x1d\xf9\xe3\xc0)\xfa\xf8\x1a\xa9\xe6\x05\xfc\x1a\xb3\xe1U\xfa\xe7\x8b.\x99 \x93AcT\x9f\xcc&\xa0\x02ݵKrl\x87\xe5&\x90?,\xe7c\xaf\x12q\x9aٙq\xef|\xef\xbf\xc4)\x0fh%\xea\xf8z\xc8\xd9\x15\f\b\xe9\xad\xc2\xcd\b\xe3^\x91\x88X8\xb9\xb8\x04\xec\xe2\xb9OjK.n\xbd\xf65%\xcatg\xf4\t|\xdd\xe7\xb3\xd4\xe1O\u007f<\x83o\xa4\x0eT\x0f\x9aC\xdegs\xbe\xe3[\xbe\xce\rgZ\xb7\xd7h}c\xc2\xfd݅(Xl\t\xfbl\xd8\x01\xa5X\xfb\xe2\xdbf&ʡ0\xe6\xaammyU\xaa\xfa\x80.\xbc\xb4\x15-\x0e\x88/t\xa1\xc8y\xbc\a-Ȣ\xe3L\x8f/\xe1\xb7\xc3ߚn\xc0\xcb\xf8\xbc\xc7x+\x01\xb04|{nN\f,\x8d\xa3\x91\x92\t\xc7m堉\x1c\x8a\xff-\xfb\xc7h\x9c\x1c-F\xc9\xc5\x1e\xef\xfcD\x9cWv\x18\x06K\x9e\x0eH<\f\u007fO\xbbJ\xaf7\xfd\x0fd\xf1\xcf\xd2\xe8\x04\x95\xfd\x1c>}\x9e@~6\xfe\xd8\xff\xeeŋ\xff\v\x00\x00\xff\xff~\xe4\xff\xab\x84\x1c\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4YKo\xe3\xc8\x11\xbe\xebW\x14\xbc\ag\x81!\xb5;\x1b\x04\x81n\xbbv6P\xb2\xeb1F\xce\\\x06sh\xb1\x8bb\xc5d7\xd3]\x94\xac\x04\xf9\xefAu\xb3\xf5\xa4\x1e60\x13^l\xf5\xa3\xfa\xab\xaf\x1e]E\x8e\xb2,\x1b\xa9\x96>\xa1\xf3d\xcd\x04TK\xf8\xc2h\xe4\x97ϟ\xff\xecs\xb2\xe3口g2z\x02w\x9dg\xdb|Do;W\xe0=\x96d\x88ɚQ\x83\xac\xb4b5\x19\x01(c,+\x19\xf6\xf2\x13\xa0\xb0\x86\x9d\xadkt\xd9\x02M\xfe\xdc\xcdq\xdeQ\xad\xd1\x05\xe1\xe9\xe8\xe5\x0f\xf9O\xf9\x0f#\x80\xc2a\xd8\xfeD\rzVM;\x01\xd3\xd5\xf5\b\xc0\xa8\x06'\xd0Z\xbd\xb4uנC\xcf֡ϗX\xa3\xb39ّo\xb1\x90S\x17\xcev\xed\x04\xb6\x13qs\x8f(j\xf3h\xf5\xa7 \xe7c\x94\x13\xa6j\xf2\xfc\xf7\xc1\xe9\xdf\xc8sX\xd2֝S\xf5\x00\x8e0\xeb\xc9,\xbaZ\xb9\xe3\xf9\x11\x80/l\x8b\x13x\x10(\xad*P\x8f\x00z\x02\x02\xb4\xacWq\xf9c\x94UTب\x88\x19\xc0\xb6h~~\x9c~\xfai\xb67\f\xd0:ۢcJ\xea\xc5gǬ;\xa3\x00\x1a}\xe1\xa8\xe5@\xfa\xad\b\x8c\xab@\x8b=\xd1\x03W\x98@\xa1\xee1\x80-\x81+\xf2\xe0\xb0u\xe8\xd1D\v\xef\t\x06Y\xa4\f\xd8\xf9?\xb1\xe0\x1cf\xe8D\f\xf8\xcav\xb5\x167X\xa2cpX\u0605\xa1\u007fod{`\x1b\x0e\xad\x15c\xcf\xf1\xf6!\xc3茪a\xa9\xea\x0e߁2\x1a\x1a\xb5\x06\x87r\ntfG^X\xe2s\xf8\xdd:\x042\xa5\x9d@\xc5\xdc\xfa\xc9x\xbc N\xee\\ئ\xe9\f\xf1z\x1c<\x93\xe6\x1d[\xe7\xc7\x1a\x97X\x8f=-2劊\x18\v\xee\x1c\x8eUKY\x80n\x82K\xe7\x8d\xfe\xce\xf5\x01\xe0o\xf7\xb0\xf2Zl\xebّY\xecL\x04g;c\x01\xf16 \x0f\xaa\xdf\x1a\xb5\xd8\x12-C\xc2\xceǿ̞ \x1d\x1d\x8cq\xc8~\xe0}\xbb\xd1oM \x84\x91)\xd1E#\x96\xce6A&\x1a\xddZ2\x1c~\x145\xa19\xa4\xdfw\xf3\x86X\xec\xfe\xaf\x0e=\x8b\xadr\xb8\v1\x0es\x84\xaeՊQ\xe705p\xa7\x1a\xac\xef\x94ǯn\x00a\xdagB\xecu&\xd8MO\x87\x8b#k;\x13)\x85\x9c\xb0\xd7aZ\x98\xb5X\x88\xf9\x84A\xd9J%\x15!6\xa0\xb4\x0e\xd4\xd1\xfa|O\xf4p\xe8\xca3W\xc5s\xd7\xce\xd8:\xb5\xc0\xdfl\x94y\xb8\xe8\x00\xdb/C{\x128\xc9,1\x8c\xb1\x17\x0e>\xae<\x12\nP\xa7ͫ\n\x1d\x86=\x92Ũ\x10\xf7\xb2\x9eغ\xb5\b\x0e*\xe9\xfcH\xc2\tC\x04\x95\xad\xbe\xa0ƣ\xed\x03\xc2a\x89\x0e\x8d\xb8{\xcc\x10\xad\ry\x84\x15\x99\x14\x161\xc5\x02\xdb\x01-\xe6\x11\xf50\xc4\xd3\xd4Ù\xec9\b\xf8\xe7\xc7iʘ\x89\xe1\x1e:\x1f\x9f{\x81\x1eyJ\xc2Z?*\xae\xae8\xfbvZ\xc6\xc3B\xee`\v\nZ\xc2\x02\xf7\x921\x90\xf1\x8cJ\x83-\a%ʭ\r\x12`\x0e\xfb\x1d\xefb\xa6\xe8S\xd26\x85\v\xf5\xa0$G\x91\x86\xbf\xcd><\x8c\xff:\xc4\xfcF\vPE\x81^\x04)\xc6\x06\r\xbf\x03\xdf\x15\x15(/j\x90C=\x93\x99\xbcQ\x86J\xf4\x9c\xf7g\xa0\xf3\x9f\xdf\u007f\x19f\x0f\xe0W\xeb\x00_T\xd3\xd6\xf8\x0e(2\xbeI\u007f\xc9g\xc8G:6\x12aE\\\xd1\u1975a@\xbc\xabW{\x15\xd4e\xf5\x8c`{u;\x84\x9a\x9eq\x027\x12\xe5;0\xff#\x81\xf5ߛ\x13R\xff\x10\x03\xe8F\x16\xddDp\x9b\xfbn7\"\xb7 \xb9R\f\xech\xb1@\x17\n\x84\xa1'$oI\x89߃u\u0080\xb1;\"\x82`\xb1^\xccG\xa8\x8f@\u007f~\xff\xe5$\xe2}\xbe\x80\x8c\xc6\x17x\x0fd\"7\xad\xd5\xdf\xe7\xf0\x14\xbccmX\xbd\xc8IEe=\x9eb֚z-:Wj\x89\xe0m\x83\xb0º\xceb\xbd\xa1a\xa5\xd6\xc2B2\x9c\xf8\x9b\x82V9>뭩\xcax\xfap\xffa\x12\x91\x89C-B\xbe\x93۩$\xa9\x1a\xa4\\\x88w^\xf0ƣK3=\xbe\x8b\xee\xc3\x16\x8aJ\x99\x05F}\x11\xcaNn\xa1\xfc\xf6-q||\xf5\xa7g\xa0\x048L\x1c\xff\xb7K\xf4J\xe5B\xa5z\x85r\x0f;^~V9i\f\x9cAƠ\x9f\xb6\x85\x17\xd5\nlُ\xed\x12ݒp5^Y\xf7Lf\x91\x89kf\xd1\a\xfc8\x94\xf6\xe3\xef\u009f7\xeb\x12\n\xf2k\x15\n\x8b\xbf\x85Vr\x8e\x1f\xbfI\xa9T+^\u007f\x8f\xdd\xce\xfa\x02\xe6p\xaf\x84Ū\xa2\xa2JM@\x9fcO\x04\x13IũcjVf\xfd\xd5]Y\b\xed\x9c Zg}\xb7\x99)\xa3\xe5\u007fO\x9ee\xfcM\fvtU\xf8\xfecz\xffm\x1c\xbc\xa37\xc5\xea\x89B7\xfaHk\xa7Z\xa8,\t݅\xba\xec\xe3\xde\xe2TW\x0eԅ\x9b5\xaf*\f\xbdQ\xad\xaf,O\xef/\xe0\x98m\x16&\f[\x03\xf4\xe5`\x92%\x8e{\xb6\n<\x83'\x8a\xba\x80%\xd6\xf6C5v\x8f$\xd6\x1caD\xeaڀg8X_\x8bPZ2)\xa0\xf6\x11fÝ\xc3\xc1\x9a\xd6ꃑ}O8\x98ܚ\xe6`\"*yU[Ŋ;\xff\x9a\xc6*lH\xcc\xc6\xf8\xe6^L(n\xdf\xdcZ\x15V\n\xc7\xfdWL\xe7\xad|w\xbc#\xbc\xc7p:\xa2cj0\xf4+\x01\a\xac\x94O\x87\fY\x14v\xe4ŭ!\xa7\x8a8ԡ\xac\x93\xaa\xb3TT\xa3\x86\xcdK.x\x92\x0e34\xf4\xb7CUL\x12\xd4yԡ\xf7\x1c\x00}\xbc\xaf\xb4\xaeQ<\x01i\xe33\x11q\xb4\xc2tu\xad\xe65N\x80]w<}&\x80\x1a\xf4^-.E\xd0\xefqU\xec\xf8\xfa-\xa0\xe6\xb6\xe3M\xcbׇROŭ\xef\xbd\xe0umg\xa5\xfc%(\x8f\xb2f\xc8\xe36A}\xde\xe5\xe4A\xd35\xc7\xc7d\xf0\x80\xab\x81ѩytv\xe1\xd0\x1f[&K\x06\x1ch\x022\xf85xǫ\b\xe8\x0f\xba\xc4A\xbf\f*['ﶬj0]3G'D\xcc\u05cc>1\x92R\xc3P\x0f\x1dj\xef-\x93[\t)\xdbEQ}7Q(\x13^)\x89\xff\xb2\x05M\xbe\xad\xd5z@n\xd2$\\\xaf\xe2\xbe\x12G[\x8fIQ(\xe1\x1f\xe6^\xdb\xfb\aP\xf7֜\xa8\x06SȐ\xe1?\xfd\xf1\xccmL\x86qq\x90J\xfby!\xf4\x179\xe5\xeb\x9cp\xe6\xc2\xf7\xac\x1c_\x9b\xf6f{\x8b/e\xbc z8\xdf\xed\xa6\xae\xe3D\xb5\u007f̷\xccQ\x83D\x1d\r\x06\xe4zGv\xffެ\x1f\xd9\xdel\xaa\x90b\x0e\xf5\xc3ᧆ\x9b\x9b\xbd/\a\xe1ga\x8d\xa6\xf8\x99\x04>\u007f\x19A\xff.\xedS\xfa\x1c \x83\xff\v\x00\x00\xff\xff\xb9\xf7H\xe3\xa0\x19\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4W\xc1n\xe36\x10\xbd\xfb+\x06\xdb\xc3^*y\x17{)tk\xd3\x16\b\x9a\x04\vg\x91K\xd1\x03E\x8d\xeci(\x92%\x87Nݯ/\x86\x92bYV\xe2\xcd\x02\xab\x9bə\xc77\xf3f\x86\xf4\xaa(\x8a\x95\xf2\xf4\x80!\x92\xb3\x15(O\xf8/\xa3\x95_\xb1|\xfc)\x96\xe4\xd6\xfb\x8f\xabG\xb2M\x05W)\xb2\xeb6\x18]\n\x1a\u007fŖ,19\xbb\xea\x90U\xa3XU+\x00e\xadc%\xcbQ~\x02hg98c0\x14[\xb4\xe5c\xaa\xb1Nd\x1a\f\x19|<z\xff\xa1\xfcT~X\x01\xe8\x80\xd9\xfd\vu\x18Yu\xbe\x02\x9b\x8cY\x01X\xd5a\x05\x01#\x93\x0e\xe8]$v\x810\x96{4\x18\\In\x15=j9v\x1b\\\xf2\x15\x1c7z\xef\x81R\x1f\xce&\x03mF\xa0C\xde2\x14\xf9\x8f\xc5\xed\x1b\x8a\x9cM\xbcIA\x99%\"y;\x92\xdd&\xa3\u0099\x81\x1c\x10\xb5\xf3X\xc1\x9dp\xf1Jc\xb3\x02\x18R\x90\xb9\x15C\x90\xfb\x8f=\x96\xdea\xa7z\xd2\x00Σ\xfd\xf9\xf3\xf5ç\xfb\x93e\x00\x1f\x9c\xc7\xc04\xc6\xd7\u007f\x13a'\xab\x00\rF\x1d\xc8sN\xfb{\x01쭠\x11E1\x02\xefp$\x85\xcd\xc0\x01\\\v\xbc\xa3\b\x01}\xc0\x88\xb6\xd7\xf8\x04\x18\xc4HYp\xf5ߨ\xb9\x84{\f\x02\x03q\xe7\x92i\xa4\x10\xf6\x18\x18\x02j\xb7\xb5\xf4\xdf3v\x04v\xf9P\xa3\x18\x87$\x1f?\xb2\x8c\xc1*\x03{e\x12\xfe\b\xca6Щ\x03\x04\x94S \xd9\t^6\x89%ܺ\x80@\xb6u\x15\xec\x98}\xac\xd6\xeb-\xf1X\xd0\xdau]\xb2ću\xaeM\xaa\x13\xbb\x10\xd7\r\xeeѬ#m\v\x15\xf4\x8e\x185\xa7\x80k\xe5\xa9\xc8\xd4m.\xea\xb2k~\bC\v\xc4\xf7'\\\xf9 \xdaF\x0ed\xb7\x93\x8d\\m\xaf( \xe5\x06\x14A\r\xae}\x14\xc7D˒dg\xf3\xdb\xfd\x17\x18\x8f\xceb̳\x9f\xf3~t\x8cG\t$ad[\f\xbd\x88mp]\xc6D\xdbxG\x96\xf3\x0fm\b\xed<\xfd1\xd5\x1d\xb1\xe8\xfeO\xc2ȢU\tW\xb9ˡFH\xbeQ\x8cM\t\xd7\x16\xaeT\x87\xe6JE\xfc\xee\x02H\xa6c!\x89\xfd:\t\xa6\x03jn\xdcgm\xb21ΐ\x17\xf4\x9aυ{\x8fZ\xe4\x93\f\x8a+\xb5\xa4so@\xeb\x02\xa83\xfb\xf2\x04z\xb9u嫕~L\xfe\x9e]P[\xbcq=\xe6\xdch\xc6\xed\x97%\x9f\x91\x9cL\x96\xbe\x8dq\xd9\xf0\f\x1b\x80w\x8a'\xfdˊ\xec\xf3\x18X\x8c\xe7\x15\x11\xb2\x10J\xda\xd9*\xab\xf1\xf7\\QV\x1f.\xc4t\xbb\xe0\"!\xed\xdc\x13\xb8\x96\xd1NA\a\xae\v\x91\xd4\b!\xd97\x91\xed\xe7\xf7u#\x85\xd7\x12\x86\vD73\xf31\xefm2f\xc0*\xb4\xeb\xbcb\xaa\r.\x1f)\x9f\x94\r\xf5(\x87\xbe\xf7\xbf=\xdf{gR\x87\xcf\xd7ͅ\b\x1eN\xad\xa7\x85\xd3/\fT$\x14\b\xa7\x17\xe7\xe97\xd4J\x04\uf681\xc4P\xd0Q\xe2{C\f\"9\x05\x9cM\xd0b\xb9=f6K\xd563\x99k<۞\xe5\xef\xab\xc6\a+N\xf1-\x03$;\x8c\xc9\xd6)\x04\xb4<\xc0\xe4\x1b\xf5\x9bG\x88Q\x91'\xed#/\xaa\v\x15ps\xee1\x12\x130`Y\x98\xf6ۓ\x9a\xdfBY\xb4\xa5Nk]\xe8\x14W \x17F!@g\x16\xf2\xceS\xb5\xc1\n8\xa4\xf3\xed\xd7\xe6\nƨ\xb6\x97\xa2\xbb\xed\xad\xfa\xcbvp\x01U\xbb\xc4/\xa4\x9ew\xe7,\xe0\x82\x1c\x17\x98\xfa\x9d\x8a\x97x~\x16\x9b\xa5\x82x\x9eߗ)\xa0M\xdd\xf91\x05\xdc\xe1\xd3\xc2\xea\x06Us\xde\xc7\x05\xdc9^\xdez1\xc2Ů8[\x8c\xf2.i&:Ǿ\x91\x87\x95c\x0f)\xad\xd136w\xf3\xd7\xfb\xbbw'\x8f\xf1\xfcS;\xdbP\xff\xd7\x03\xfe\xfckգb\xf30>\xb0e\xf1\xff\x00\x00\x00\xff\xff\x1b\xa0}D\xf4\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}\xfds#\xb7\x91\xe8\xef\xfe+P\x8a\xebI\xba\x88\x947\xb9\xa4\xee\xb6R/\xa5\xecʎ\xca^\xadj\xa5\xac_\xca\xf1\xf9\xc0\x99&\x89\x13\bL\x00\f%\xde\xf9\xfe\xf7Wh\x00\xf3A\x0e)\x02C\xed\x87=\xb8\xaa\x8b\x97\x9a\xe9\x01\x1a\x8d\xfeF7-\xd8{P\x9aI\xf1\x92Ђ\xc1\xa3\x01a\xff\xa5\xc7\xf7\xff\xa6\xc7L\x9e/_|q\xcfD\xfe\x92\xbc*\xb5\x91\x8bw\xa0e\xa92x\rS&\x98aR|\xb1\x00Csj\xe8\xcb/\b\xa1BHC\xed\xcf\xda\xfe\x93\x90L\n\xa3$\xe7\xa0F3\x10\xe3\xfbr\x02\x93\x92\xf1\x1c\x14\x02\x0f\x9f^~5\xfe\xfd\xf8\xab/\b\xc9\x14\xe0\xebwl\x01\xda\xd0E\U00052212\xf3/\b\x11t\x01/\x89\x02m\xa4\x02=^\x02\a%\xc7L~\xa1\v\xc8\xec\xc7fJ\x96\xc5KR\xff\xc1\xbd\xe3'\xe2\x16\xf1ν\x8e\xbfp\xa6ͷ\xcd_\xbfc\xda\xe0_\n^*\xca\xeb\x8fᏚ\x89Yɩ\xaa~\xfe\x82\x10\x9d\xc9\x02^\x92k\xfb\x99\x82f\x90\u007fA\x88_\x13~v\xe4g\xbd|\xe1@dsXP7\x1fBd\x01\xe2\xe2\xe6\xea\xfd\xefo[?\x13\x92\x83\xce\x14+\fb\xc6ύ0M(y\x8fk\xb3\x13\xc0M fN\rQP(\xd0 \x8c&f\x0e\x84\x16\x05g\x19\"\xb1\x82H\x88\x9cVoi2UrQC\x9b\xd0\xec\xbe,\x88\x91\x84\x12C\xd5\f\f\xf9\xb6\x9c\x80\x12`@\x93\x8c\x97ڀ\x1aW\xb0\n%\vP\x86\x05ĺѠ\xa3Ưkk9\xb6\xcbuO\x91\xdc\x12\x10\xb8){\x94A\xee1dgk\xe6L\xd7K[_\x8e_\x12\x15DN\xfe\v23&\xb7\xa0,\x18\xa2\xe7\xb2乥\xbb%(\x8b\x9cL\xce\x04\xfb\xef\n\xb6\xb6\v\xb5\x1f\xe5Ԁ\xdf\xefz0a@\t\xcaɒ\xf2\x12\xce\b\x159Y\xd0\x15Q`\xbfBJр\x87\x8f\xe81y\x83\xdb#\xa6\xf2%\x99\x1bS\xe8\x97\xe7\xe73f\xc2\xf9\xc9\xe4bQ\nfV\xe7x\x14ؤ4R\xe9\xf3\x1c\x96\xc0\xcf5\x9b\x8d\xa8\xca\xe6\xcc@fJ\x05\xe7\xb4`#\x9c\xba\xc034^俩\xb6\xed\xb85W\xb3\xb2\x94\xa7\x8dbb\xd6\xf8\x03\x92\xf9\x8e\x1d\xb0\x04\xefhɽ\xeaVQ#\xda\xfed\xb1\xf3\xee\xf2\xf6\xaeIgL\xafc\x1f\xf1\xde \xbez\v,\u0098\x98\x82r\x9b\x88\xd4fa\x82\xc8\vɄ\xc1\u007fd\x9c\x81XG\xbf.'\vf\xec\xbe\xff\xb3\x04m\tZ\x8e\xc9+d*d\x02\xa4,rj \x1f\x93+A^\xd1\x05\xf0WTóo\x80Ŵ\x1eY\xc4\xee\xb7\x05M~\xb8\xfe\xb0\xc3Z\xe3\x0f\x81ym\xd9/\u007f\xfao\v\xc8Z'ƾƦ\xfe\x98\x93\xa9T-\xe6`_\x19\xb7\x80v\x1fZ;\xdc\xe9\xb7\x1cl\xfd/kS\xf9K\xf5\xa0\xa5\x1f;\x89R\xb0\u007f\x96\x80,ΝX\xd8`)\x1b I\x98\x1f\x92\xc5x\xe3\xef[pj\a<f\xbc\xcc!\xaf\xb8\xed\xc6Z\xd6f|\xb9\xf1\x02\x8a#ʄ\xa5\u007f\xcb\xfe\xed\xb4E\xfdW\xcbN;fL\x15\x10K\x81L8x\x84\t\\l'\xa6\xed`\x06\x16\x1d\x93۹:\x82r\x8eN8\xbc$F\x95\xb0\x053T)\xbaڂ\x98 \x9b\xf7\xc5K\xf5\xbcg\b\x9ce\xd0\x14\x14\x0e5N\xc8P\xb59#\xf2Ice.\xe5\xfdS\x98\xf8\xab}\xa6\xe6a$C\x1d\x87L`N\x97L*\xbfv/R&@\xe0\x11\xb2Ҡ\x98߀[\";\x95\x8a\x14R\x9b\xedX\xd8~\x12\x89;\x1c۶p'\n7V\xe6\x19G\xd8b\xbb\xd0\x16\x13\x91\x02\xec\\\x17\xf6$\xd6\xcf*Y\xbag\u05f9}\x03\xe1\xdd\x18!\x13\xaa!'\xd2\xd3@\xc9A\xfbo\xe5Ȟ\xeaSv\xb6\x15t\xb5x'w9\x9d\x00'\x1a8dF\xaaML\xee\x83O7\xf6\xe1\x1c[\xf0\xd8\xc1C<\xef\xf5\x9c\xb8^\xd8\x0e\x90\xc4*\x1d\x0fs\x96͝H\xb4\xb4\x89pH.A\xe31\xb2j\xdbj\xdb\"\xc9S{\xef?\xb2\xeb \xd5\xe3\x89#\xb5\x0e\xaf\xebp\xd5c\x0f\xe6S\x8f'\xd8P\x1b\xb3\xb5\xbe\xda)G\xea\xf1\xcbDl\xe0\xab\tD{\xb5\xf1\xeaa\x89\x16M\f\xab\xfa^M\t,\n\xb3:#̄_\x9f\x82H9o|\xff3ޘx\x8a\xbfZ\u007f\xf3\xa0\x14\xbfsW\x9e\x82hw\xa5\xfa\xfcg\xb8)(,n\xbd\xac\xd8{C\xbek\xbeuFشڐ\xfc\x8cL\x197\xa0\xd6v\xa6\xd7y9\x042\xf6\x91wv,\xa8\xc9族V\xb3ѵ;fO\xbc\xac\xbf\xec\x14Ġ1\xb7\x05\xf3\x13p\t\x1asL\xc1\xc2\x19\x89w\x88\xcd\xfa\x17Ԯ/\xae_C\xbe\v=d?\xca\xdbX\xc8\xc5\xdad\x9b\x9f\xf6Z\xef\xbe\xcb\xf0\xaaOeA8\xf3\xff\x8cPr\x0f+\xa7\xb1PA\xec\xe6P\xfb\xa1-\xb6\xc4&r\xd0\x0f\x81Dv\x0f+\x04\xe3\x1d\vO\xbe\xbd/)\xb8q\x0f\xab}\x1e[C\xa0\x9d\x937\xf7\x1c&\xed\x0f\x88\b4C\xf7G\x1eA'Q\xe0EO/\x8e\xec\xcfH\xc2\b\xb8OXf\xb5m\rg\x1an\xec\xb1v[dO\xc1\x9c\x15{.\x14}i\x1a\xf0\xb4\x047\xd1{\xcaY^}\xc8\xd1\xfd\x95خ\r\xb7ǵ4W\xe2\x8c\\>2m\xa7&r\xf2Z\x82\xbe\x96\x06\u007fy\x16t\xba\x89' ӽ\x88\xc7K8\xb6m\xf1\xd0\xf47\xedA\xdcn\\9\xb7B\xb5=L\x93+a\r\x17\x8f\x0f\xf4\x1e\xba\xcf\xed\x96\x0f\xed\xb1(5:\x94\x84\x14#\x14\x95\xe3\xae/9d\xef\tR\xaa֎lN\xad\xfa\xa8\xfb\xe0\x9e`\xef\xac$q\xef;\u007f(\xa7\x19\xe4\xc1\xdaD/\x1e50c\x19Y\x80\x9a\xed\x12\x1c\xcdQX\xfe\xbe\xdf\x14\xf6\xe4\xbanDR\xd8~\xa2=\fϺ\xf3\xa7'3\xb2'w\x8f\xa7\xc2f?\xf9\xe8\x16\xe7\xdd\xf6G\x9f^\x11\x8aX\xd4?\x9e\xc4.\xcds\x8c\xb4P~\x13\xc1\xf1#\xf6bS\xf6\xbb\x899\t\xb9\xa0\x85=\xbf\xffc\xc5\x1c\x12\xf4\xff\x92\x822\xb5\xc7\x19\xbe\xc0\xa0\t\x87ֻ\xdeM\xd4\xfc\x8c\xfd\x02\xd3\xc4\xee\xef\x92\xf2M\xb7p\xc7\xe2\xa4\xe5-\xc0\x9d \x97\xd3\r\x8d\xe5\x8c<̥v2uʀw\xb9lڃirt\x0f\xab\xa3\xb3\r>pt%\x8e\x9c\x80\x8ff7\x95\xb6 \x05_\x91#|\xf7\xa8\x8f\x12\xb4'%\xee\xf5\x98\xe8t\xfa֣E\x16M\xc7o\xed\xf1\xf5j\xee\xaeY\xefE\x87\x85\xd4\xe6\xaf\xdd\x0e\xbb-\xf3\xb9\to\xb4u\xd3\x0e\xbfד:\xbb\xf7aUL\xd5jrS\x03\xca;\xf1\x1c\xa3\r\x16@O\xdb\xe8)']堣\x95\xa3\xdc\"\xf8\t\xaap\x01\x80}\xa6\x18\xa35Z\xbcD\xeaۗ\x8f\r\x1f\xa3=\xa1\xf6\xdfͅ\x1cZ\xab\xcd\xe4bA\xd7C^{M\xf5\x95{3д\a\xe4v_\xcdJ<\x97\xfb\xab{\x81\x860\xd8\xf5\xc0̜\tB\xc3\xf1\a\xe5\t\x8a\x92B>͉ܘSM&\x00\"\xa0\xefI\xd6\xe0\xc6\xf3\xca\xeb\x05\x13W\xf8\x01\xf2\xe2\xe0\xf2\x9d\xd4\xe8J\xda\u0380\xeajC\xab\x1fP\xe2\xec\xab\x1aɜ<\xccAA\x8b*6\x1d\xdeVc\xdc\x13\xa4\x90\xa6\xe9W\xb0p\v\x99\x1fk2eJ\x9b\xe6D\xf7%\xb8R\xefK\x0e\x91;lWw\xc7\x16 K\x93\xb0\a\x97\xf5ۭh\xe5\x82>\xb2E\xb9 t!\xcb=\x84\xbb\x1bV\xbe\xb0E\x15R\xf4;\xf0@\x99Avgᢇ\xc5H\xbbK\x05\a\xb3\xef\x16O`j\xd9Q&\x85f9\xa8\x10\xf2v;ˤ=\xb8S\xcax\xd9\x15\xbe\xe9\x1a\xb1f\xaa\xb8T*\xc9J}\xeb\xdelx\r\xe7\U000a1360\xbdQ0\xa7K lJ\x98! 2\xbb/\xa0\x1c\xcb\xc6Oxd j\xf6&\xcb\xfd\x18\xbc\x1d \xca\xc5~\b\x18\xe1\xc9fb\xa7S\xac\xf9\xf8ה\xf1\xe7\xd86Ky\xe9G\xe3\xfb\xfa\xed\x0fr4*\xa6\xb2\xbf\b\x9b\x00y\a4_\x85\xf3A\x8d\xb1\xa6*Ҁ$\xaa\x14M\x8e\xf8\f'#ƾ\xf3\xb38\xa4\xe1\xc6\x04\xdbcc\xd7\xfc\xf9\xcc4\xb5\x1d\v\xe2Y\xb5\x1d\xfb\x81JХ\xb8f\xaeZ\x00\xac\xa8\f\x8a3ν\xa2\x9a\b\xcdg\x02\xd6@\x85\xdc9\xbd\xac\xf8\xf4z\xb4K\xe4\xd9\x12\x06\xef\\]\x8c\xea\xb2\xe6\xe6\xf5\x86f#\xf9-\xfa\bx\a\xefJ\x96\xe4\x81\n\x13\x88\xbeR\xe6\n\xb9'\xd5\xc7\xee\xaa\x1bT\xcd\"\x9e\xdeȬ\v*kHo\x03a\xd4\nӭ\xf6\x9d\xb4\x1b\xd64\xcdevoՑ\x05\x9d\xc1\xf1\xb1&\xaf\u07bc\xb6\xa4b\xb5\x0e+2\"$\x82\x1b\xccEb\v%\x97,\xb7\xaa\xd3{\xaa\x18\x9dpk\x04OA\x81\xc8@\x93/O\xde_\xbc\xfb\xe9\xfa\xe2\xcd\xe5i\x14pk:\xc3cA\x85\xa5\xc1R\ai^\xed\xbe]\x00\x88%SRX\x04\xc5a\xe3jJ(Y\x86\xd9fU&\x9a5\xb5\xf8\xd2ksQ\x10\xab\x15\aG\b\x13Ei\x82w\xf4\x81qN&q\x10K\x91ͩ\x98Y\xbc\xbe\x96\xa5\x9d\xe7\x97_\"V\x14\xe4e\xe6\x0ff\x14D\u007f\x98\xbe<\xf3\xe1,ʹ|\xd0([@g\xb4\xf08\x8e\x82\xd9\xd8^\xa2W\xc2\xd0Ǘ\x84\x8daL\x8e\xbel\xfc\xe9(\n&b\xabP\xd2.\xd3\xc5#\x1c\x1693\xa0('GM\xc8q\x1b\u007fi\xd7\ty\x93@\xf1k\x02\x96\xa0\x9c\x1d\xe0Hn_\a\xbe\x1b\nfT\xe5\x1c\xb4\xb6<\xf7a\x0ef\x0eʻ\x95<\x91A\x8c\xd7\xd9\r\xa9\xec\xf9\xea̔\xacs#\xa3 \x86<\xca\xfb*\x11x\xcc\xe4y.3}n\xa8\xbe\xd7\xe7LX\x91:ʩ\xa1\xa3\x06\xd3=w\xd2p\xe4\xe5\xf3(Xң\xea8\x9e\xffF\x95B01\x1b\xd1\xea)&Ft\xa4\xe7\xc0\xf9q\xc4,\xa3ą\x1b\xd1\xf6n\xf3\xb5\x98\x00C\xa4c\u008d6G\xbf\xac\x18\xb8\xfb\xf2\x98\\K\xb3+\x03m\xfb\xa8D\x18\xe2x\xdc\xc9\xe3/\xaf\xef\xde\xfd\xfd\xe6\xed\xd5\xf5],ko\x8a\x85\xed\xac>\x8dI\xb6\xc4B\a\xab\x8f\xdb\xfd]b\xa1\xcd\xea\xa3\xe0n\x11\v\x1b\xac>\x0e\xb1\x1dba\x93\xd5Ǳ\xe0M\xb1\xb0\x85\xd5G\x81]\x17\v[Y}\x14ԶX\xd8\xc6\xea\xa3@v\x8b\x85\x0eV\x1f/\x846\xc5B\x9b\xd5\xc7A\xdc.\x16\xd6X}\x14\xd8n\xb10\xb0\xfa\x8e\xd7\xe2X=\x88e2\x9b\xffΛ_\rVT\xedy\x1c\x1d\x1a\x89\x19\aL\xb4\xf9\\\x97V\xf0\xbc\x98o\xbb\x04\xc5\xf2=m\xa7U\x88\xe6b\xa3 \x93\xfa8\x84\x8cm\x14k\x95E\x1b\xc7bR\xac47\x9e\x8a\x9cu\x8f\xcdx\x9a\xbf5\x91\x8e\x0f\xd2\xc0ɘ\xbc\xf1\x19\x06\x94\xbc\xfa\xe9\xea\xf5\xe5\xf5\xdd\xd5\xd7W\x97\xef\xe2\x90B\xd2\xcf\x0e\tI#=Qs\xdca\x1e&\xe0e\xb7\xe6\x10-\x90\xdd(\x14,\x99,5_U\xe9\xed\xfd\x8f\xae\x1b\xeb'ק\x94\xad\x88\x06\xb5dY\xcal;\xa7\xd6G\xd5q\xe3I\x85'}\xf5O\xa8=\t\x80\xb7\xdb\xc4^\xf9I!\xadCZ\xc6\x1e\xe43\xd8\xc7n<a%'@<\xac\x02\u0558\xe5N5*\x01\xe8n\x1b\x9b인\xd8\x1c\xa8~\xbd\x86)-\xb9\xf3\xb6\x1d\x1d\x8dct\x197\xfa\xb2د\x95\xdc3\x80\xd2\x1c-6{\xeb.y\x85\x88\xc1a\x84бO\x8cm\xa9\x1d:\xd2^u\x83\xf9\xdc\xc9`SF\xe5\xcd\xd5#]\xca\x13\x17\x92\x9e\xb2\xd9\x1bZ|\v\xabw0M\x01\xb1\x8ev̙\xf5饱\xa6A=P\xebqSK\xe1\x8a}\xf1Bb2\x8a\xbbF\v'w>\xfb\x19uX\x8b\x9e\xb4%\x91~\a+\x8c4\xed.\x8c\xb6*\xd3P\xf3\x92!V\xfe\x10\xb3\xaf\xe1\x96I\x91Aa\xf4\xb9\\Z\xdd\x01\x1e\xce\x1f\xa4\xba\xb7\x96\xd8\x033\U000d12c7\xe9s\xbc\x86s\xfe\x1b\xfc\x9f\x1e\xb3\xbb{\xfb\xfa\xedKr\x91\xe7D\"\xab-5LK\xee\xd2\xee\xf6\xce\xf4\xed\x1auQ\x813\xbc\xd8~FJ\x96\xff9\x9eنq\x00ڐ\x85\xcb\xc4<\x10}\xdcb$\u007f\x15\xa4T\x0f\\Y\x16^q\x04\"\x15\x86\xdf\xf6I\x83\xdd>B²Wt{\xa2}\"%\a\xba^\xc4a\xbf\xb1\u007fh\xb8k\xec\x97\x0e\xdc5\"\xc2\xc7]\x03O\xc0a\xa4\xc6q-6\xf6Kg\xed\x1e\xde\xe0,d\xfe\x92\xe8\xb2(\xa42\xba*X0\xb6\x8c .\x9eQ\x8f\x16\x10\xbc\xdbwF\xfe\xb3\xfa\x11\xef\x8e\xe8\x1f\x8e\x8f\xff\xf4\xed\xe5\xdf\xff\xef\xf1\xf1\x8f\xff\x99\xfa\x9d\x1af\xa3\xd6\xcc!\x00\xeb\x02\xb2\xb1\x909X\x96}\xe6\xfe\xe9-\xaf\x8b\f\x13d\xae{\xa0G\x1bjJ=\x9eKm\xaen\xce\xc2?\v\x99_\xdd\xf4\x04\x890t\x82\nJ\x0e\xa2\x04l+\xfc\x123Z\x94\x1e\n\xc4\xf4\x16\x9a\xbe\x96\x8c\xa5\xf7\xaf푹\xa1f\xbe\u007f\x8a]\xd7xP\xcc\x18\x10h\xab\x82Zh\"\xa7g\x96;\xa2)ЇwKr\xb4|\x11\x19\xa1l\x01\xe8/ئ\x01E\a\xdaFĶg7}8\x16\t\xaeM\xcb\xfe\x82\x97\xa0ʦ\xec\x01\xf4\xe2\xe6*\x14\x1e\xfa\x88\x88\xef+٪m\xfb\x18\xf2-$\x9c\u007f\xfd,r.@\xef'\xea\xea\x9cbw\ac\xbf\x9b\xbc\xdb\agX\xa1\x88\x8a\xbc\xaeRt\xe2~\x1cgE\x99\xca\xcc=\x84\x05,\xa4Z\x9d\x85\u007fB1\x87\x05(\xcaG\xdaHEg\xc9\xe2'L\x15\xa7X\xff\xcb}.\x95\xf37P\xb09Ӹ$\x9e\x06T\x05$+\x95\xb5v\xf8*\xe8(\x90\u007f4\xf9V\xd1Ow\x89\xa4}G\x9b\xc8\xebd\xf5~\xb6f\xcd?Ѝ\xb3\x94\xbc\\\x80>\xab\xac\x94\x1e\x80ѥ)\x96dI\x95\xfe\xa8\x16WΖL\xef\x9b.\xdd5\xa8X\xbdMdM\x04y\xac[\x04\x13\x06f=L\xb4\xd1!\x90\xd1i>\xfa\xf2\x11=6[\x96\xa6(1yxAM\x15\x95z,d\x9a\xe7.\x8cFA\x9f\xbc\xe90}\x91\xe2\xc6v\xa3\xa0ƀ\x12/\xc9\u007f\x9c\xfc\xe3\xb7?\x8fN\xff|r\xf2\xc3W\xa3\u007f\xff\xf1\xb7'\xff\x18\xe3\u007f\xfc\xcb\xe9\x9fO\u007f\x0e\xff\xf8\xed\xe9\xe9\xc9\xc9\x0f߾\xf9\xe6\xee\xe6\xf2Gv\xfa\xf3\x0f\xa2\\ܻ\u007f\xfd|\xf2\x03\\\xfe\xb8'\x90\xd3\xd3?\u007f\x99<\xe5\xc7Q\xed\xa1\x191aFR\x8d\x1c\x11<Y\xeca\xd7\b\xc8=\x14Oz\x174\x91\xbe\x82\x9745\xb6\x8f\xc8:\xfa\xaaV\xbd\xd0\xd0S\xb3Ґ)0\x9f\x9e\xcf\xd9\xcd+\xa8\xe1\xee\x16Se\xf0\xffb\xdc\xd0\xfdMO\x87\xa6\xdan\xc1b\x87\x04\x03\xf4=\xc0bh\u007f\x89u$\xfc\x17\xee!!\"\x12\xc6\xe0*\x1f\\\xe5\xdb\xc6/\xddU~\xeb\xceO\xed'Gow\xbf\xb39\xf8ɓ\xa4]\xf2\xcbi\xabu5\xb9\xa3^I\x9cab.alh\xbf3\x9f\xb0.$G\nY\x94\x9c\xee};\xb6\x9a\xc7f\xe6\xd0fra\x14L/^\xeb\u00a0u^\xbaKE\x88>\x82\x9b\xb9n\xe4\x82s\u0084\x13\x92\xf8\xb1\a\xc6\xe3\xec`Lkr^\aB]\xbe\xe2Ң\xe1a\x0e\"\xe9n\xa5\x1bL\x13m\xa82L\xcc\xc6\xe4{\v\xcbic>\x17\x85\t\xb2(\xb9aEdBReaU\xb5I\b\xd5Zf\x8c\x1a\x8f\xe1h\x81ʩ6aK0-\xc7\xd0{̸\xcc \a\x91\x01ֲ*#\xe5`\xd8\xf3\xc9\xcab\xf4R,\xdd\xdc(\xc9K\x97R\f\xd1ܧ{n\x1f;\xdd\xd5\x1e_\x9fZSg\xbdF2E\xf4q;\x18rZ\x97\x12\xab\xe2\xbbq\xf0\xd2U\xec*\xfb%\xc9\f\xd9Э\xeb\xf8t\xa5\x19\xa7DU\x95\\|\xe0,\xa0t5w\xab\x8a[+\xaa\x89\xfa§\xa6\xde>\x8bj{H\xb5\xb6\xa7J\xdbO\x9dݥ\xca\xf6\xb0x\xea\x13u\x88d\x8d~\nh\x0f%\xb0P0e\x8f=9̅\xa8\xf6\x88\xb0\x1c\x84aS\x96d'X\x9dIA\x01\x02ӄ\x81fsW\x06S\xb4\x93lRh\xfa\x13\xc8\xd0w\x9e\x83\xc30\xf4\xdb5?\xc7\xc0\xcd\an\xbec\f\xdc|\xf7\xf0\xc7\xe93f\xe5\x1f\xd0Rƛ\xcb\xe9\u05eb_7\xee?#Gx\x8e\xbb\xf2\xd5y\xad\v\xab\x9c\xe3\x17\xe3\x8e%\x16\x81ţg\xad\xc8Jȹb\xfa\xf2\x81\xcc\xd9,\xd6#\xc6a\t\xdc\xeb\xf7dA\x05\x9d\xb9R\x9aF\x86P]\xec\xed\b˒\x14\xcb[\x85\xd7p\xa9VpZ6\xc5%\x8d\xa3\xe5\xbaw\x9c&\x9c\xdd\x03y\r\x05\x97+_1S\xe4\xe4\xd6Pc\xd9\xd2-\x98\xb8\x04\xb8$恫\xb9)9\xbf\x91\x9ceQ1\x876\xe9]!\xcd\x15%\xe7\xa4@Pc\xf2V@lX\xe6\x82?Е>#װ\x04uF\xae\xa6\xd7\xd2\xdc8s\xb3\xbe\x9f\x12wx\xa5\aJؔ\xbct\xfdш\xa13t]T\xf5\xeb\xe2\x88B\xb5&\xe6\x84\xc9\x03\xd3}\xed\xf4h\x81\xb9q\x00\u007f\x83_\xb5\xa2\xd3\xfd\xfb\xd9ɇ\xb3)d\xab\x8c\xa7\xf3\xac\x8b\f\x93]\xeb\xf2\xeb\xf5\xb9\x8ds\x19\xad\xb4\x81E(\x1b\x86\x0e\x14\x86e&\v)4\xb8\x82z)\x1e\xaej\x85\xcea\xa6{\xeeq\xaa\x92WHmn\rU{\x96i\xabG\xfb\x94\xde\x040\x96\xfc3\xca9\xe4\x84-\x16\x903j\x80\xc7\xfb\xc9C\x05\xd0f\xb9G\xd7\xee\xd2\x15\x93L\xd2\x1b\xe6T\xe4\x1c\x14\xd6+\xf4>\xc0\x16|\x03j\xc1\x04\x8d-\x18B\xaa\xf4.tYBNh\x96I\x95\xfbZp\xa1\xb2\x17U\xf1\x1a\u007f\xc5\xf1PWjH\x9e\xf5|\xbdh\xc8\x13.\xb3{MJa\x18\xaf\xcbC\x86ڐ\xbeQc4\xd4$\x16S\xfd\xe7\xa8:\x13#\xecGv\xfe\x9b\xfaO\xf8C\xac\xf2\xdb\xc7\xf2ٯ\x9e\xef\xe6X\xabA\tH\x1a\x98L)\xe3\xc5V\x18\x98\x17,\xad\xfab\x89\xaa\xae\xafZ\t\x9a4#\x03\v\x10\xb7\xbb\xeaPd\x9bX\x17\x8d\xdeGV!q\xa3o\x8eBB-\xa0\xe6\xd8Q\xb689\xd6\x18\n\xdbr&\xa0Y\xbf\x98aM\xd4\xf4\x10f\xf3\x04;~\xe4-\xd4\xf4 0S\xd8cdըm\xe9\xe6\xde'\x99_Ii\xc8\xc9\xf1\xf9\xf1\xe9FP\xeb8\x1d\xea\x94qp\xd2\xd5\x15Yʪ\xcdJ\x06\xa9٢\xe0+ܟ\xe3\x1c;:\xf9밪L\x8b\x11\x13djv\x97CA\xa83\xa2%1\x8a\x86.\x03\xe9s\xb5\xd0,p\xa3J\xaf\xab\x9c\x1c\xff||F\xc0d\xa9\xf9\xc0\x84<Hql\x90\x8c\xc6\xe4N\x92R\xd7\x13O\x86\xb9\x92%\x11\xe0\xea\x00\xc0c\xc1Y\xc6\f_\xa1\x98O\x86)K\xe3\x8a/b\x83D,\xb4u\xf9Ȍ\xbf\xa7\x93\x0evJ\xbe\xc2\xd3\xeeT\x05B\xad1\xb4\x84\xf39Pn\xe6\xe9\xf7\xfb,]\n)F\xff\rJb\x19/\xe1!\xa6zw\x12bg\xcdq\x80\x8c\x93\x147\xc2\xfaۉI\fVA\xf8\x06\xa2UN\xb2ё\xf4\xee\xee\xe6\x1b0m\x11\x96\x84\x0e;\xa3\x90\x9f\x8f.mPS\xa9:Z\xee>=\xfaʿ\xb9\xd4I\x98!\x9b\xfdZ\xb5q\xdd'\x9c\x91\"\xd2|\xcen\x18\xd9NK\xf6\x19\x8d\xe4\xea&=\x11\xebﲴؚ\xd0\t_UUd5\x18rd\xa7\x9e\x9e\xf6\xcc\x04\xee\xe7_\x81\xe6X\xb7Wh\x034QE:\xc0Qk\xcc\xe50J\x8d\xeb\xbb;w {\xech\xb3\x04\x96\xa7\xfd1\x9e\xa9t6\xe9*\xbc((\x1c\xfb\xf5s\xfcHLr\x83W\xb8]\xf0\xbfOz\xe5&\xd2\xd0\xfe\xd8-\xd1\xd7vN,\xde\x11\x06\x138M<\x14=f\xd7?K\x98\xf4\xceV%]\x110\x87\xab^0\xfd\xdd\xcb\xf8\xb4\xb4\xf5q\x90\xfb%\xc9%\xbc\x9a\xe39ф\xd3\xfb\xf8x\xea\x97jI\xd2\x12\x11ۯ\xf7\xc3D\xcf\x1b\n\xa4\xb7\xbe\x85\x97y\x92\xaf\x1bo^66\x92\xd0,K+\x14\xe5\x86\xef^\x8e\fK\x83Z\xc6&8֣7\x89\x152\xde\u007f\x19F\xaf\vo\x87\xb9\xeev\x90\xcbn\x1d\xf5\x12\x15\x11\xe5b҃\x93T\x050\x94\xa9\t\xc6o|\x0fgJUl\xf3\x1a\xa7\x17B\xb8}\xf4=Ta\xa8\x98\x01yag\xfa\xc7?\xfc\xe1\xf7\u007f\x18#\x1a\x92\xa1\x86\xc02\x15\xe4\xea\xe2\xfa\xe2\xa7\xdb\xf7\xaf\xb0\x88[*\x95?\xcb\xcd6,ې,\u007fڑy\x04e\xb1Wj,t\xd6g\x87\xad\xad\xe1\xfd\xdfι\xacS\xe3l\xcda$\xb2\x9b\x8f\xc4g\xfa\b\xb1\x11\x1e\xa2\x0fmg\x9b\xac\xb8\x95\xd9\xfd\x01,\xed\xe3\xbbW7\x0eTml'\xed\x02\x15\xc1\xc5\xcc\xc4R\xf2\xa5\xeb\x17x\xf7\xea\x06\x11\x94\xb6\xb3\xf6m\x8c\x0f\xa0\xaboe\xe7\x18n»Ԝ$\xa8lQ\xf8\x8e\x99\x94(\xa0\x9ci\xc32\xfcV\x15\xa6H\xb4\xef\xe5}J\x16\xcf'\xe3W8~\x1bҁ\xd0Ő|\x9a\xd7\\\x13-\x17C\x1f\x16\xd1pM\xa4^\xe9\x1a4\x92\x83k$N\xd4K\xd5O\x8f\x1f4\x92O[#\xf9\xdcdd\U000ab142[#\x8b\x9eY\x13\x0eȁr&B'\xbamI\r$O\xd8R\xd7;\xfa\xe2\xe6\xaa\xf2\x8e\xcbV\"\x02&\xafDC\xd5e6\x0f\xb1\x19\x01Z\x9fczDY8\xcfWh(\x19\x1f\xb1*\x14`\x17>)Ϊ\x8a\x04\x88\x0e\x10\xeeG0Y\xfciA\x9f\x8c\xcf\x1d\xf1\xf1İ]}\xd302E\xf5\x1c\xb0S\x05<2\xa3C\xb7k\xaa\xa5p!\\\xbf}L\xc6\a0\x99&\x05\xd5\xda\x05\xeeL\xbd\b\xf7\x91\x1b\x99\x1f'Do\x1b\x13\"3E3 \x05(&s\x82U\xffr\xf9\x10?\xcf\t̘Ё~\xedD\xc3\xc1\xb0\xba\x12$E\x84\xebγ\xefZ]\xa4\xb0]yi2\x99\xc0\x87\xfd\xeb\x1e\x8b\xeb\tD\xd1W'q\x9a\xf6\xf8\x94\x94\xf3U}P\xc3MOs\xf8M\xda\xcc$JEB\xbd\xee\xf5L\xa2\xf8h`+\xf3\xc8\x1e\x85:+\xa9\x0f\xf9\xb7\xa8\x93i{\xaa\xb2y\x8f6_$>}yHmzr\f\xa9M{\x8f!\xb5iHm\x1aR\x9b\x86Ԧ!\xb5i/\x10CjS5\xa3!\xb5i\xc7\x18R\x9b\x86Ԧ\xa7ǐ\xda4\xa46\xd5cHm\xdak\f\xa9M{\x8c!\xb5iHm\xda:\x86@\xe2\x90\xda\xf4k\f$\x0e\xa9Mq\xaf\x0f\xa9M\x11cHm\x1aR\x9b\xc2\x18R\x9b\"Ǡ\x91\f\xa9M\xbfF\x8d\xe4s\x93\x91\xbd\xaa\x8bE\xbe\x16\xf2xn\x94\x9c\xf4(3v\x83\xb1z\x96\xf94 9M\xae\xad\xe3\xa63&\xafZ\xe9\x19\xbe\t\xbf\xab\xd2\x12\x05\xd1'\xfa\xd4\xe9I}\xeb\xf5D\xd7d\nE\xc1\xf4y!\xdd\xff\xabs\n\x1a\xc9\x04ο\x16#\x1cR\x85oJ\x16\xc1S\x19\x04I\xbcnw\xf6\x00f\x02D\xc3<d\xe6@\x1f\xed\xa6G\xc6\xc0\x8el\x81\x006\x89\xb9vg\n\xacE\xfc\x93SA|\x96\xc0f\xb4\xbfO\xc2\x05\xa6\xc2mD\xfa\x13!V\xd9\x01ۢ\xfci*\xb9>|\x84\xff\x19\xa2\xfb\x87\x8f\xec\xef\x88ꓕ,\x93`n\x89\xe8\xfb\xc8|\"mvF\xf3CT>\rfw$\xbf\x15\x91O%\xa6^Q\xfc\x1e\xc1\xa9\x9e\xcau\xba'9YS\xf2\xc9\xc6ws\x05z.y4\xafm\xf1\xd97L\xb0E\xb9\xb0lB[\xf6ȖU6s<\x8d\x84<'\xa7u\xb80\x9c\x05\xccr\xc0&\x96\x94\xf1\x94RuXZoN\xd1?\xa1\xcb,\x03ȭ\x9c|]\x87\xc0\xa3a\xfe~\\\xad\xdcu\xd5`\x9a\xbc\x88\xa5<\xd7P\x11\xed\xbb\xdf\xff.i\xf7S,\xc3Ą\x8d\xa7\x935\x10r4&\xfb'j\xf4Q7R\x1d)ϓ\x9c\xb1#1\x83\xfc=Q4\xecH\xca ,M\xcc\x1e(!\xa3\x17\xe7왈\xb1#\t\xc3\xe3(Q\x01\xd9L\xc0XO\xa4HCyz\xf2E\x0f\xd9\xf6\\I\x17\xdb\x13.RI\x92\xf4N\xb6\xe8\x9fhq\xc0n\x87u\xe6@\xef\xee\xf8\xbd\\t\a\xf0\x1c\xf6L\xaax.\xb4\x1c\"\x85\xe0#v\x9fM\xde\xd5>\xc9\x13=\x13'\xfa$M\xa4&L\xecH\x96\xe8\xe3i\xee\x99(ы|R\xc3\x11ɡ\x88\xfea\x88\xde!\x88\x1d\t\x11}z\xc2v\x86\x1eR\xfb\x17\x86\xd1\x0e;\xac\x85\x0f\x12\xd5\xc2f\xc8ᠡ\x83\x83\x87\rғ\x18v'04\x12\x11Rq\xb8\x99\xbc\xd0'\t\xa1\aE\xa72\xff\xa4\xa0J2\xd3f\x82\x19F\xf9k\xe0tu\v\x99\x14y\xb4f\xb4\xd6\x10\xa9:\xafځs\x96y|&D\xeb6\xe6\x9c\xfaΙ\x90\x87\v\xb5!\x1a\x12\xcfZQ}$\x14\xe3\x14v\xf5\xa6}{\xf2\xe3\xc6-\xc8Gs\x19\xb8+\xa5\x87 \x82\xbf\xca\a\"\xa7\x06\x049a\"\xd0A\xbc\x1f\xb5v\x16\xd4\xfe\xa2\xeaXۿ\xbe\xf8*\x9es\xb9\xc9|\xbe\x8e\x1dtmi\xfd|~=\xff\x81\xc3;\xf6<\xe0i\x19\xef\xa3o9\xf7\x9c\x83\xb0\xcdߣ7\xafn\xc3\xf7\x02\xe7\x1d\xb8\tz\xa9}ن\x04\x98\x9f)Q%\xa7\x9d=\x99rF\x12:\x8f\xedJ7\xabSǢ\xc1nI5\xab\xd3\xc6\xe2'\xba-\xcd,)e\xec\xa3{8\xd7\xd2\xc4\xd2\xcd\xcf-)b^=KT\xe2\x93\xd3\xc3\x06;,r\xecH\x03\x1b\xec\xb0O\xc8\x0e\xfb<,\x8cF\xad\x93o\x14\xcd\xe0\xe6`jf`W$/\x15\xf5\"#(xI\xf6\x86e2\x02 w\x9c\xaa*\\\x83\x15W\xa6%O(^U\x16R\xb4\xab?\xb9\x9c\x8af\x11\x97h\xa0>ۥc\xd5^QJ9\xa1\x85\x92N\xed#\xaa\x14\xc2J]\u007f\x96,R\xac\xad\xa4\xd3$d\xb3f\x8ff3\xbb]V\xc5\xc2*8,A\xbe<\xccA\x04-\xd3O\xd8\xcen*U\xc6&|E攧\x84_\x1e\x98\x99\x13J\xee\x19\xe7~\x9acr\v\x86\x989Ӊ\xceT.\xc5\f7\x83\xba\t\xc3c\x01\x99U;2\x0eT\x94E\xda\xfa\xad\xb2\xba\x92\xa5\n\xeb\xf7m\xe3\xc2,S\x926\x04\xe3ga\xab\x8f\xf5\xee\x03\x9b\x80X\x97\xa0Xj\bu\x9a\x1e\x98\x86\xb3>\x98\rmF\xdd9p\xeb.\x94\\\xb2\x1cr2Y\xa5ѿ\xccQk\x1d\x93\xf7\b/\xf0}!\xc5H\xc0\x8cZ\xdb(\xfe\xa4:!\xeeμ\x9b\xa7\xabG!r\x96Q\x93`ci,\xacW\x97\xd3#KF\x11\v\rʍ\x06z\"$\x91\xa8\x14\x97\x82\x99\x15\xc6F\xe7\xa5!\xb9|\x10\xa7cl=\x9b¤(\x99\x80\xa1\xfe^\xabk%\x88\x02K\x13\x10t\xc2S\x94\x13\xccĽ\xeb$P2\x05jʄ\xee~3j\xa0\xd3\x1f\xe0\xe8\xe1\xb0ǁi\x1f\x01\x9d\x92Rh\x88\xbe?Ӱ\x0f\xff\xf8\xaf\x1f\xce>d\v\x90\xa5\xf9\xa4\x1c\x84\x0fs\x96͛\xfe\x06\xb6\x00Md\xd9\xe7ښ\x91䅟V7E<s\xfb\xc8_\x9cW1Ik\x8c\r\xb1wč֫\xf9U\x89\xd3Q릖\x87\xbd\xbe\xbe\xfd黋\xbf\\~7&\x974\x9b7ː\nB\xad܈\x82\x89reN\x97@()\x05\xfbg\xe9:\x8f\x93\x93\xea;\xa7!\a?\nnZ\xbe~\x92\xa5h\x05E\x14\x13hm\xd0wLc\xa3W\x84\xe2\xd3Y\xa5\x062Ur\x11ǔZ\xd6#\xb9\xb4`\x9c\xb7\b-\xcd9( 3\xb6\x8c\x14\xb2\x16\xaao\x8eL\xf3\x90T\x8cG؞\x1e\xab\xc5҉,#M\xa09\x10\x01ƞ\xee*\xc2%\x85nմ-5\xe8\xb8\xfc\xf2I\x89\xa9ԅb\v\xaa\x18_5'i\xd5\xd7k\x19\xfcp\xabXQ\xdbD\xe1뷗\xb7\xe4\xfa\xed\x1d)\x14\x96\xf5t9\xc3\xd1\x16\xa4\xdd^2\x01\xbbAn\xc3\xf31\xb9\x10+\xf7!\xc7\xcb#\xb5\fδ\x01\xb4T\xbc+!4\xad?\xfaj\x8c\xffwdwPņ\x88\xaa\xf4\xf2l㒍\xf3\\\xb0I\xe4=R\\z\x83\x06zޱIH\xf5Z\xbb4\xe1\xd7ucQ\xaf\xa0p}\xd9c\xa5%\xadH\x1a\xb7\x10\x99\xa1=\u007f<٧\x93\xee\x00͚K\x8a\xf7\xbau:\xbc\xa6\x95\xc3\xca\xd1k\x82\xbfG\x8a\x86Yuu\x13\xc8\xd1i\xd4(\t\x12\x80Z:\xb4:\t\xcb\xdd\x04]\x82\xc4\x19\xf9\x8a\xfc\x89<\x92?%@\xfc\xe3\x1f\xfe\xf0\xfb?\xc6\xfb\xb3\xfa\xe8\x13}\x94Q\xe7\xed\xbe\xba\xe9\xb9\xcf\xdf[6f!ٝ1\x92LX\xd2\x1d\x17g\xd9\x1bPVLx\x8a\x89\xc7e\x0f\x8f\xad]\xc2'I\xf6.\v\xe3jZ+_\xce\xe8O\x80X9a\xb7\x10~\x8a/\x96\xfc\xc9\x13\xbe\x9d\xe2_\xa56מ\x9d1ݘq\x12E\xf8\xc3M\x16\xd4d\xf36\xbf\xb5&Dұ\xaf\xcb/\x93\\b,\xcb]\a\x9a\xb3\x84|\xe1\x8fwt\xd3\xd2g[\x94\xbaIQ}X\xe9\x9a[\x1f\xfd\x14^/w\x05\xcb\x13yB!so0\xd8%\xe7\r!\x19k1\xb8\xb1\xc5n\xf0Q\x8a\xb4\xe2/\xf5\xc5|\xcb\v3*\xdcM\xe2)(\x857\xcdRP\xba\u008cI\x96A\x02Y\xf6\xe0\x82\x85\x92Ff\x92\xf7\xa4\xad\x1b\x0f\x06ݬ.\xe0\xfc&\x99\xb6\xfe\xf6\xfa\xe6\x8cܽ\xba9#R\x91\xdbWw7\xfdn#\x11rt\xf7\xea\xe6\xe8\x03\xa25-\xc04j\xabc\x91\uf18d\x8c3\xb0\xfa\x14\x05\x88\xcdUnE\xf1\xac\x112Z\xd0bt\x0f\xab(\xb55\x1dKI8ڜ\xb4[\xfc\x82\xee\u007f\x99K\x01\xcd\xd9'T\x0f\xc13\x9az^݅\x11\x16r\x19\xe9\xf7A\x83-@\a\x91\x17\x92\t\xa3\xbb\xaa%D\x81ݴ\xfa>\x99\xacáZB\xc7\x18\xaa%l\x1dC\xb5\x84\xa1Z\xc2P-a\xa8\x96\xd0=\x86j\tC\xb5\x84\xcf*\xffy\xa8\x96\xd0\x18C\xb5\x84\x841TK\xd86\x86j\t{\x8d\xa1Z\xc2\xe6\x18\xaa%t\x8e\xa1ZB\xc7\x18\xaa%\xec;\x86j\t\xd5\xf8\xe5\xdc\xd2\x19\xaa%|\xaa\xb7t\x86j\t\xfb\x8c\xcf\xe3.\xd3P-a\xa8\x96\x10\xf02TK\x88\x1aC\xb5\x84\xb51TK\xf8\\\x89j\xa8\x960TK\x18\xaa%t\u007f\xf7\xd7n\x87\r\xd5\x12>U;\xec\xf3\xb00\x86j\tC\xb5\x84\xa1Z\xc2P-a\xa8\x960TK\x18\xaa%\f\xd5\x12\x86j\t\xbf\"\xafb\x92֨@\xcbReqvp\x9b\xc8^\xc9EQ\x1a \xef\x02\xa8JY\x8eZ8\xca\x12\xa6\x9b\x97\xf2?l3\xc1L\x8a)\x9byE\xef|A\x05\x9d\xc1\xa8\xc2Ϩ\xbeBw\xfe!r\xe39[\xb0\xb8:\tv\xd4E\anzx8\x12\r\xea\xbe\xe6tOc\xba\xa0ƀ\x12/\xc9\u007f\x9c\xfc\xe3\xb7?\x8fN\xff|r\xf2\xc3W\xa3\u007f\xff\xf1\xb7'\xff\x18\xe3\u007f\xfc\xcb\xe9\x9fO\u007f\x0e\xff\xf8\xed\xe9\xe9\xc9\xc9\x0f߾\xf9\xe6\xee\xe6\xf2Gv\xfa\xf3\x0f\xa2\\ܻ\u007f\xfd|\xf2\x03\\\xfe\xb8'\x90\xd3\xd3?\u007f\x19=\xd5\x03\x1b\xa7\xed\xf3\xf8\x1dRN\x9dR\x84|{A\x1f-\x83\x8d'\x85\x85,\x85q\xb7l\xdc1\xafN\x84KÊ=\x94\xe4S9\x98\xa4\x8f\xa1\xed\xf3ц\xf3\x191\x86\xf3\xe9\xce\xe7;O;\xed\x13\x1a=ǅW\x99v\x9c\xd0h\x98Ap\xa3\xa1[͓i\"\x17\xccXs:\xe5\xa6p\xa3\x16\n^Ii\xba\xa8\x1d\xaf\x8a\xd7\xf2\xa6\xee6\x05\xd3\xcd\v\x1a\x8d{\xdd2ؾ)j)\x15u\x9c\x02y\xce(\x87)\x13\x90;\xf5\xf4\xd7\xc7\xef\x92^Ӑ\x95\x8a\x99\xd5+)\f<F9\xf6\xdb\xe7\xe5\xb6\r\x88\xb8͈?4aBD\x16\xee\xda\xd1Z\x91/\xbc\xf9\x17\xa7\xb2\x02Q\xa5@\u007f\x96+U\x01\xc69w\xd0\fǛ=k\x93\x8f\x02\x1f\\/Σ\xf5ϒ-)\aa\x1a\xd0o\xd08n~\xe094dC\xf5}M\x950\xb2\xa6R\x85\xb7\xf3\x80V\xfc\t\x1e\xcd\aюQ\xf5\xb8Ql\xc98\xcc\xe0Rg\x94\xe3i\xe9g._l\x81\x1a}\xe0-*\x94\xe4\x9a<\xcc\xc1r\"B\x83\v\x11\xeb$\xcchBR\xf6\xc2\xeeU\x11&\xa7\x9d\xaf\xd3*z\x05U\x96*\x82\x8f2\x1a0\x96\x13\x9aH\xc9\xfd\x8dI\xbe\xaa\xe7\xcf\xd2BPB\xfe$\xe0\xe1';[M\xa6\x9c\xce*פ\x06\x93\x9a&Z\x1f\xd5\xca\x1d{\xb0\rc\x1a\xe5:\xa1\xfc\x81\xaet\xed\xf8N\xbbZ\xee \xbe$/N\x91?PM\xaa9\xe6\xe4w\xa7\x98a\xf5\xea\xe2\xe6\xa7ۿ\xdf\xfet\xf1\xfa\xcd\xd5u\x1a\x1f\xb7{\x06\x911\xff\x8c\x16t\xc28KQ<72Ǜ\xc0Pp\xe6\xf9y\xaed\xfc\x95%\xc4w\x88\x85\xd4\xf2\xad\x9fw\xa9Y\xd4\r\xc9nښp\xbc\xefRQa*\xa7w\x83\x1cU)\f[|\xd0K\xdd4\xef\u007f\xa1\xfb\"\xcf!\uf1d2\xc3݅y\x15\xa6\xb1\xaak\xca%A%\xe4\xe6\xed\xed\xd5\xff[\xa3\xcdU\xd1'A\xff#\xdcE%\xc4\x1e\xa4\xde{\xfc\xceկ\x18vy\xe7\xf8\xfcn\x1cWz@\xbf\x9c\xc4w\xa5hW\xa4\xad\xe1&\xe8'9\x8c\xc9M\x15)nAk0\xf5x^\xa7\x80X\x90\xc20\xca\xf9\xaa\xa9\t\x1b\x895\x19⃚bK\xee\xfa\x94r\x1d\xcd\xc8ӥ\xb1Ud\xdeX\xf3\xbd\xd7.VPH\x0eB\x1a\xef\xf1K:\rr\x8aЈ\xf3)4.\v\xb4$^\x92\x92Y\vc\xa6\x03\xceo\xaa\x99c\x84)>\xe5Cú\x19\xe7\x85q\xe5eH\n\xfa+\xa09֔)\xa8\x99\xbb<\xd5\x05\xd5\xf7\x90\xbb\x1f\x12u\xec*$kg\\-\xfdnU@r<\x15uk\x97\xfd\x8bq\xdexol\x8f\xf2^4\u007f+\xf8Ꝕ\xe6몌I/B\xfe\xde[K\xed8P4R\xe6Xy\xdb\xceo\x84\x9b\x88e[\x9a\x95V<\xf5\xa5X\t\x1f\x98A\xa8R\\\xe8o\x94,\xa3U\x81\re\xfd\x9b\xab\xd7\xc8/K\x9f!#\x8cZai\xaa\x14&\xd1>s\x95=\xf67\x9fӔ\x94mS\xb1\x87\x10\xae'o\xe8\x8aP\xae\xa57\x1c\x13\x82\xc1]\x1e\x12\xe2]5)7\xa3'\xd2\xcc\xd7}:\xc8\x1e6\xbf\x13_\xc0\xa8N\xb0\xa9<\x99v\t}\\H\b\x96ރ&\x85\x82\fr\x10Y4\xf5~\x9c4\b\xa4\xfck),{\xe9E\xfbW!\xffǹ\x8c\xfbY\xf5\x98\xa9\xe4mz\x8a\xf9J\xc8\\J\r\xca%\x87\xa9\x12\xd26\xfe\xdbr\x02\x1c\x8cs\x94`\x9dZj\x9c\xe7\x8f-\xe8,\xfe4QS\x89B#\t\b]*\xf0NsCr\x99`\x06\xf8:Rv\xe9\u007f\xbbzM\xbe\"'v\xed\xa7H\xfeS\xcaxJ\xd5\x17\xbc\xfd\xb1\xc6M\xd84LѢ4^'\x10h\xec+Ǫψ\x90D\x97\xd9<\xe04\xc5;\x14\x9cW\xfe\x86\x14^a\x1bX\xd3'\xc0\x9az\nֿiP\xbd\xe5\xea\xdf>\x80\\\xed\xe3\x06\xb3\xbc\xa9\xbdk\xc8P\xc8\x02\fͩ\xa1)\xc1\xb7R4\xaa#\xae\x1d\x85\x14\xda\xdd}\x14\x90\xb4\xa3a\xfeʎ\xc2Ǒ\xd2\x1a\xbec\xa2|t\xb7\x03\xfa;\x94o/\x11\x1c\xf1\xa1\xa4\x14\x892\x01B\x8b\x823W\xbeo\xad\xcb\xcbU\x8bt\xd3\xf6~\xd3\xd4D\xf1@9\x97V\xcdH\b\x8f+*r\xb9\xd8X\xbc5D\x81&Xō\x05w\x1c\xcem\x87-^vׇ\xf3\xd7v\xd8\xfa\xb8\xee9,!\xa1\xd0\xf8z\x1f$\v\xc5\x1a\xa4\x81j\x10l\x92\xf7\x93\xd3\tp\xa7\x1a\xba\x93\xa37ON\xb2W4ѩ\xaa$\xef_\xf2\xe2\x9d\xe4\xe0r\xe3\x03\x92,\xd8_\f\x8e\xf0\xe5\xbe8B\xefS\vG\xc9^\xf4O\x11Ge\x82\x86G\xd6qd\xd5\xc46\x8e,\xd8_\b\x8e\x92C\x10\x1a\xb2L.\x8a\x1b%\xa7,\xfe\xb0n\x88~\x0f\xaeNΉ\x17\xfd\xa5\x86\xae,r\xd4#\x11x\xbcF\xee'CU\xe3\xd2\x135N\xe6\xf9[\\\xd1@\xffOC\x85@\xae}\xb6\xa6W\xf8\xaf\xc6϶\x99/T\xc8<\x00\xfa\xa0\xd2Mf\x94cS\xa14\xba 봱\x0e\xb0\xc7}.\xe2z\xd3y8!\xa7\x0f\xbb\xaa\xe0/\t\x9e\x01\x12\xfa\xf3\xc9\x1c\x1a\xb5\xe3\xdd\x05\xbc;w_\xc6\xc2N\x02\x1c\xae\xc5Y=%$_\xe5!ve\xbf\x986]\xe9Ke\xbf\xa9\xda\"Y\x84\x83\xc8S+A\x15\xd4\xccψ\x02\x8e\x17\xf7\x02C\xbbw\x0e\xad\xe3\xb4}j,8p\x86\xb0q\xa8g3)\xd2.\xb1\xe3\xaa1,\x104\xe2)\xf2@\xcb\xe0\x8f\xbe\vĖ\xd0'\xe5S\x90\xe6Gn%\x95\xc73-\xaaf\xc7=\x13\xb9\xbf7\xd6B\xbew\x85\xa5\x9d=g\x97\xe1\xadO\x967\x99\xebK\U0008fd33Wm\x18\x19m\x1e\xed$\x88Mv\xd0q\xb4\x93`:v\xf0Ι\x8b\xa1\xa6Ҩ\xa7+\x9a8/V3\xd8Y! !\x975\x8c\x8a{\xfdM\xe0\x19\xb4,rD\x84\xec\xc5\xc9j\xce\x18h\xe0\xe8Þ\xaf\xf4\xf2\r\t\xeap\xb2J\xf5\xc0D.\x1f\xf4\xa1\xbc)\xdf;p\xc1t\xce,\xbb3L\xcc\x12R0j\x8f\n引\xa1w\b\x97J\xe0\x04U\xab\xd3M\xd7A\xb2\xcf\xde\x11sۗ\xd8\xdb\u007f\xb0ŽQ\xbb+\xe2\x9d);\xdc\x1b\xce]\x11\r\xf2\xe3\xb87f\vM_)\xfb]\xc3(\xbf-\xe2;\xfc\x90uZ\xfe\xe6\xcd\xedE\x1bd\x1agǼ^\xe5\xd4c\v\x93\xd0|\xc1\xb4fR\x90\a\x98̥\xbcO\x82{\x12R\xe7g\xcc\xcc\xcb\xc98\x93\x8bF\x16\xfdH\xb3\x99>\xf7'{d\xb1\x93\xd6\xe4\x84\t\x1en=8\x0f\xa10:D\f\xecbҴ\xac\n\xabH\x80\xbe٠Op\xddD\xfbuj\x91*\xbc\xb1\xf0\xc1U\xaaMR\xbcN,(\xfe\x049&\xe3\xc5W\x97iT{r\x84Y\xefK\x9a\xb8\xb5{\xe9B?\x1f\xbe\x8d\x803\xd52\xd0\xfd\xdb\b\xfc\xb5\x86Erp\xc5!\x12\xed>6m\xf5\xe4\xae\x15\x12\x17\xd1N\xb4%\x8f\xb1v\x9b\x9f\xe2q\xd3\xe9\x90Tǃ\x84\xa3\x82\xde&^\xcc\xe9\b\x1d\x04(o\xac@K\x82\x18\x8c\x9d\xb9\x14R\xb9\xe3mUz)\x12\xdav\xbb\x81\xfe+\x97o\x864\xeb\x15\x8d\xc6v\xbdJO\xf7\xb4ç\xc3az\x1b\xd6\x06\xb2j\x8bs줗\xa9\u007f`f\x8e\xed\x9b\xe6\xd0\xfa@:f\x15hLX\x12\x04\x94\x92\xca\xdf\x1b\t\x89\x06\xa9u\x95\x9d\xfe\x83\x97[,S\xa0\xf6_ǺO\x06-iu\x80\xb5\x1fЖ\xe3\xc0t\n\x19\x9a썝K\x02\xeeB4'u\xbf1\u007f5\xdc2!\xfb\xcd\xc4\xe3\xb5`\x8f\x16\x03M6\xd0\x13\v\xa1/V7\xc8\xd31!Wi\x86h\xb8\xd8}f9M\x13\xba\xbfY\x94J\n\xa2\xd5\\\x1a7ч\xf3\xd2$\x03`V\xaf\x9dQ\xbadHɷ ͜\x8b\x83\x88a̽\xf0\xc0\xec\x11\xf4L(\xd5'\xb6\x99\xbf\xb1\x91\x8f\x91\xe6\x1c[\xcf\xe1\b\xfe\xb1\xe4\x18\u008e\\\x0e\xc2\xe2ø\xc4\xe5L\x1d4\x9f\x83l\xc9\xe9H\xcfn\"ϝ\xe1D\x9e/\xdaL\x0e\x10q&\x1f%ʓv\xf1\xdbUt\xee\xd9\xe6\xf7\xb6\x01\xa5\xe1Ѵ\xaaG\xd4ڽ8uU\xed\xab\xaa\xd8|\x15\xaa\xf1\xb3\xff\x8e͙ow\x90\x17\xd2\x15\x1bh\x96\xba\xf7}M\xe3ԔR\x18\xc6C\xf0jQpk<\xb6f\x1c\x9d\r\x89\xb0\x1a\xfd\x86\xcf*d\xd4\xed\x8d}\xa1\xff\xb8\xf3\xf2_(\x86\xaa\x96ơ\x9e\xf7M\xf5)\x17\xfd\x88Ԁ}\ay,\xd9fd\x88\xb7\x91\x9cM\xa7\x10n8G\x8a\xbd\x82*\xba\xb0\x86\x83&>\xf5w\x023殙V\xaaUd\x84\xa2*\x12v\xe6\xd4=fȂ\xcd\xe6\xceKC(\x96\xa2\x8c/7i$\xe1\x92\xe6\x04\xb9\xb8T䁪\x85\xb5Xh6\xc7\xfa\x8dT\x90\xbc\x8c>\xf8\xd8In5҆\x1a \xb2\x00WR\xc2\xed\x8dŷ\xab\xae\x15G\xa6C\xf3\xe9\xa1\xf9\xf4\xdech>=4\x9f\x1e\x9aOǎ\xa1\xf9\xf4\xd0|z\xef14\x9f\x1e\x9aO\x0fͧ\xdd\x18\x9aO'\x8c\xa1\xf9\xf4\xb614\x9f\xdek\fͧ7\xc7\xd0|\xbas\fͧ;\xc6\xd0|z\xdf14\x9f\xae\xc6/\xa7\xe9\xd9\xd0|\xfaSmz64\x9f\xdeg|\x1e\xad\xe1\x86\xe6\xd3C\xf3逗\xa1\xf9t\xd4\x18\x9aO\xaf\x8d\xa1\xf9\xf4\xe7JTC\xf3\xe9\xa1\xf9\xf4\xd0|\xba\xfb\xbb\xbfv;lh>\xfd\xa9\xdaa\x9f\x87\x8514\x9f\x1e\x9aO\x0fͧ\x87\xe6\xd3C\xf3\xe9\xa1\xf9\xf4\xd0|zh>=4\x9f\xfe\x15y\x15\x13o\x8a\xe4,\xaae\xdb\x1e\xdd\x02Rj\x9f\x84ڝ\x96\x91\x95\xd3)(\x14\xbe8\xbb\xa0\x1c\xa5]\x02\v\x9d\xa1*\xd1\xed3b\xb1Q\xa0\x02\x9a\xbb\x9a\x17q~\xc1\xcei\x85\"\xa4ؾ\xcc\xddK\x8d\f\x0f\x90˷_\xd7NהV\ai\xb7\x03q=oE\x96~W\xa8&\x84\x8e\xea\xacq\xb8u\t\xf8\x19\x97\xdaߓEdgs*\x04p\xaft\xb38\xccΩ&\x13\x00Ad\x01©-\x94h&f\x1c\b5\x86f\xf3\xb1]A\x9c\xf7\xcc\x13\x81\xefZW\xcfT\x1b\x05t\xe1\x88A\xc1\"\xb6Ϡ\x9d\"\xa1\x99\x92Z\x93E\xc9\r+\xaaI\x12\rX*#2i\xe9jZo0&\x89\xd7\x17PϪUD\xcfѕAk\x9cyC\x959\xc3v\xb0\x8b¬\xdc]\xaa8\xc1\x87];\x956$\xe3\f\x84\xf1\xabv\xf5\x19q\x9eg$67\x1e\xaf\xef\xba]\xd0\x1e\xb5\"G\xafHa\xb4\xbb\xe9\x936Q?Ŝi\xef}\xd3g\x84\x9a (\xa3\x89>\xd0\x12\x92}P\xe0ܬ\xfdO\x89ӬK\xfa\xeb\xfa\xaaY\xcd\f\xa7\x9c\xc6)\x86\x81)\x9d\xb5j9\xd4\xf6!&\xb9#[\x8d\x02\x8be\x87\x1c\x16\xf0\xe0\bXZ\xfe\x01\x19\xb0%\xfa\x83,g\x8c\x82\xb8\xceE\x9f\x9d\x896t\xd77\xa05\x9d\xc1Md\x8a\xcd6\a1f\xd9\xd4\xc4\x15ipa52#\x1b:\\}\x01\xa5m\x81F\x81]\xb85V6\xe7\x83b\xc6\x00\x121v\xae\xc2\xcc\xc3\xc8<\xf0\x8d\xc95\xafǼ\t\x1ft\x1f\x8a\xa5Z\xabO\x89\xdc]\xea\x98\x00\x99(\x06S2e\x82r\u007f\x0f#\xee\xc2\x11\xf6\xb3\xa0ڒ&\xd5\x1a\x14\xfa\\\xbc\xdb)\xe0&\x8e`\xbf\xf7\x884\xaa\x14\x19mt\xb9\xc4\xeawlJfx\xd7#Ҙ\x98SA\xfe\xf5\xab\u007f\xff#\x99\xac\xac\x16\x8cƱ\x91\x86\xf2j\x039\x88Ydm\u007f/\x9e\xdau\xc8*J\xe0l\xc1b\xddB\xd6\x18\xf8\xdd\xfd\xa4\x1dh<\xcfayޠ\xcf\x11\x97\xb38\x9c\xbe\n\xf7+\xab;\x931\x8a|\x92k\xbf\x83\rHβU2#\b\xcds\xc8\\>8W^\xcf\x13[_q,dQr\x97\x92\xf1u\xa8,\x19\x05\xb2\u0530Y\r\xab\x93\x0f\xc6RC\x98\xdaz\x87pwe\xca/%Ni\xf1\x85\xe7|h\xbcꙃ~\xe2\xaf)\xe7\x13\x9a\xdd\xdf\xc9\xef\xe4L\xbf\x15\x97JE\xb6\xd9G\xea\x0f\xf8\xe0\xd4j1\xf3R\xdcc\xcbۺְ\x8c\x93\xb6\xb24Ei\xc2%\xef\xa6{7lft=\xc8JA\v\x9e\xe1zv\xf0h\xcf-\xbag\xe3\u0601/\xbe\xe3\xb8\v\x97\xb3j\xde:0\x83\xd8\x1bA\xbf\xfb\xea_\xffͱ,\"\x15\xf9\xb7\xaf\xf0ʨ>sB\fu\x03\xab\xc8.(\xe7\xb1\xc1\xac&\x83\xb1D?\xee`\x12\xcf\xce#L:;x\x16\x93\xfb\xee\xee\xefho3\xa3\x81O\xcf\\\xed\x91\xe0A\x8c\x02z\x8cJܱ\x97\xb2X\xe4\xe6#\x18\xb4K\xc9\xcb\x05\xbc\x86%\xcb\xe2\"\xfc-T\xb7\xa0\x84H\x10g\xda\x10\x19W\x05b\xc2evOr\x0f\xa8q7c\xbd\x8fu\ff\x12n\xa1l]]}\xff\x84\xc46#ZТ\xa8j9(\xfa\xd0Z,\xf2\x92\xe8\v(45P\x9d\x9e\xd5\xe1\xa6\x1b\xab\xb0\x87w\x1bX\xad\x01\x05\x82)b\xa5\x9f\x1b\xfe\xe6\xf5FC\xaa\xd0A/)\xd7\xc0\xef\x89\xd3\xd3\xec\xce!g\x8e\x0f\xaf\xf7HzH\xbb\xd3\xd3±\xa8r\x05\x16\xd4x\x9b&1\u007f\x06\xa9\xb6\x00\xa5\x99\xb6\n\xcc{<\x13\xaf8e\x8b\xf4\x1b\xfe)\r\tz\xb4\x80M\xc9K\x185\xe84\xf2\xc5hD\xf7\xaa{\x14w\xb7ű4l\xe9\x9b\xce\xf5od\xee\x01!\xabvm\x98\xad)\x1bM\x0e\xdb\n=\xf4R8\xfa\xb2\xfd\xf75\x8e\x9a\\߭3\xfe8\xe3\x01r0=\xb3\xff\x18\xec\x1b'\u007f\x00\xee\x8d|\xdb/\xa3oݹ\xa6\xc3\xc6\x13T\xc3\xf4\xf2>\x92\xb1ˍM\x00o)\xc8O\x8f\x1c\xbf<\xfe\xa0<ܡ[ɂ\xce\xd0\x1a\xe9\x89\xf5up\xfd\n\xcdZ3\x19!V=c\x10.\xe4Um\xf3$\xa0\xeeB}-\x87\x83\xf9\x84\x95\xc7\x12 >\xd0\x15\xa1J\x96\"w\xb1\x87:(\xf5f\r\x1d\xd7R\xa4L\xd9G\xbf}\xa5\xa9\xaa\xa6-\xa6\t0A^\x8c_|\xf5\xb9\t~\\ɚ\xe0O,\xfc\xdc\xe0[\x1f\x14\v\xa1e{OL\xbc\xf1.ֺ\xc3zR\xd9I\x17\x03B \x0f\x8a\x19O\xcd\x0fL\x039\x89\xf5\x9a\x87!U\xb3\x96\xe5iۥ\x97Ի\xbd_\xd1S]N\x9eA28\x86\x9e\x80\x1edB]\xbex\x9d\x0e\xb3C\xac4\x91~\x94\xd2\xe9\xe3\xc4\xcd\xe6\xd8U\xbd:\xfd\xa0\x87\xc4o\xd9\xe5c\x91\xd09\xae\xb5m\x97\x8f\x05E\xaf\u007fQ\xef_\xca9\xa9E\xf8\xf6\xfdK\x80\xbb]-\xf8\v\xcc\xe92I\xfei\xb6`\x9c*\x8e\xa9e\xb7\x0e\x93dR\x1a\x02bɔ\x14I\xb7/\bYRŰڸ\x02\xac\x05\x99\x81&_\x9e\xbc\xbfx\x87\x19\xda)\x85\xbb\\\x99N\xbf?\xa5v\xe5\xc5\xfbb\xb4\xb1\xc8\xf5CP\x93t\x02\\w\b\x02>-e\xa2\x06\x10\xf0K\x13R\x95\bY\x94\xa6\xa4\x1c\v\xb6e\xbc\xd4l\xf9!eQ\xaa\xe5X\xe9ڿ \xc3ї\f|͢\xf8\xcdZyĚ\x91oT \x8cN\xd8@e\xb0\xaepۙV\x13IǡAU3I\xd8;\xd4}\xf5Tw\x85\xd0\xf7|\x8bM]Z\xcb6X\xd0\xd9Gp\xad\xc7\xd2t\x14UF\xd3c\x1c%\xfa\xbc\xcf\xfd\xa6\xdeV\x8bݛ\xbe\xe7\x9a\xf3:.\xe8#&TR<\xae{\xaePNq\x16\xe4=pP2\x88\xa5\a\xcaLuߔ\tf\xa2;K\xa0\xe1\xe4\xea)\xefG\x00Q[\xbf\xf7\xbe\xec\xf9\xe0\xd3\xdb\xf6\x14\x99\xed$\xab'g\xb1\xeb\xfb;^f\"\xe3e\x0e\xafx\xa9\r\xa8w\xa0e\xa9:\xa3\x1fk\xd1\xe5η\x1a\x97J\x1f|\xc0)s\x8f\x8ct&\x8bN\xf6\xa0\xea\x97+}\xc6O*\x0f\x05'\xf0~ru\x93\xc6U\xf6\xd2F*\xd8RY[\x94\x9c\xaf]j\xec\xec\x9b`\x9f\xb3\xdaɖ\xbb]\xbb\xec\x870EkH\xea\x82\ue372\xc6\v.\x01_s\x96\xa1\xc3^\x84?\xb8\xff\xb2\xb3\xf6\x1f\xe9X\xa1\xdbK\x97\x84\x8ayY\x18\x9d=\xc3\xe4\nQ\u007f\xc1UPp\x1f\xde\\\xfeV\xa7\xe0\u0383\xb4\x17Һ\xe80L$\x92\xc8\xea\xe7\xd7\x10\x16(g\x1f|m\x92M\x13c5\r\xfa\xe7&4\xbb/\x8bO\v}\u0601\xfa\x168\xea\x06O\xa0\xee\xbb\xe6\xb3\x0em\v0t\xf9b\xdc\xfe\x8b\xb5\xad\x197\x98\x85ܩ\x9a=\xb8LH\x8b5w\xe1,gK\x96\x97\x94\xb7(\xb0\x81\xb3\x1a\xb5x!\x95\xf1\xae\x04),\x89\xeb\xdfoḺ0\x18}Vw{\x81\xd1\xf1c\xd5o\x9f\n\xdb͂\xdb\xeeŵW\x1c\x16}\x1c\u05f7\x03\xd7\x01\x8f\x9e\xb5[\xfbak\x9a\xed\x9d/H\x18\x9eÕ_\\\xbfަ\xde\xectٷ\xa6z\xb1c:\xfe\xccT\x1b\xbe\xab\v\x83W\xc4\xfc\x9d/}F(\xb9\x87ՙK~\x15\xbe\f\xbd\a\xe2\xba\x06{\xb5\xe1\x1e\xb6\xab*\xf6e\ao\x1bb\xf6q\xe0\xdf\xc3N\xdfW\v\x1d\xf7\xb0\xaa\xc2\xee\x88\x17\xfbC\b\x80֨\xf0m\xdcw\xcb\xfe\x9dQν\U0010d035\xbd\xa7_\xa1Y\x81%>G*v\r\xc7ڷj\x96B\xcfY\xf1Tr\fŔm9\rد\x9a\xf7:\xf0\x8e\xfe\xae\xc4\x19\xb9\x96\xc6\xfe\xcf\xe5#\xd3O\\ȱ{\xf9Z\x82\xbe\x96\x06\x9f\xee\x8d\x1c7\xb5\xbdQ\xe3\x1eG\x92\x16\x8eG\xe2%%\xfcF\xb5̫\xa7\xef\xbfW(f\x9a\\\t˨<\x0e\xaaˊڃo\xde1D\xae\xb6[\x13u\xdfn\xc1wh\xb5\xdfhb\xae\xf9\xa9\xdd(oM\xc3M\xc1y\xb4\xdd_0A\xbb\xe04\x83\xdc\xf7\x99\xb0\x1bo\x1450c\xbb\xdb\x0f,@\xcd0\xd1 \x9b\xefZ\xd5\x1e\xa1\xc3=\x15\xefC\xa8\xc8\xdbYͨB\xfbs\xa8\xd0^\x86\xa0\xf8܂\x8d\xd0I\x8c\xf2\x9b'9ړ\x18۔E\xee\xd3^\x98\xd3\xc2R\xfe\xffX\xf6\x8cD\xf4\xbf\xa4\xa0L\xe91\xb9\xf07T\xb6|\xb7\xf9\x86\xd7u\x9a\xc0-\\\xa6\x89݅%\xe5\xe0\n\x15SA`g\xf9\x159ݐ\x96g\xe4a.5J\x86:\x88tt\x0f\xab\xa3\xb3\xd6\t\xd9\x02\xd1>|%\x8eΪxY\xebPVr\nC\x18G\xf8\xb7\xa3\xf1\x86\x80\xdd\x02\xfb\t\xb1\xbb\x93Jv\xfc\xb1Һ߸Ԧ͝ߗ>v\xd2\xc6F\x05\xc6\xe67[\xc4\xd1T\x8e[fE\xd7'\xa9\x9a\x81\xe92A\xbcƌ\xa9\fcr!V\x1bp\xf1b\\\xa7\xca퍸\x8aΊV\xfb\"li\x89\x19\x12\rP>qIw\x1b\xc2\xf6\xc1\xcd]۱)(@\xd5\x12\xaee\x0e7Ru\xe5w\xb4\xe35\xeb\xcfwX\xb4\r\xa4H\x9ec\x9e=>\xda1_ԍ\xbd^|H\xe3\xd3\u007f\xff\xe6\xfdS\xebyW=\xb8{!\x14{k\xb8\xfd\xeaX\x87}\xdfݵ\x11\xb4\xd0si\xc8I\xb8ԞqY\xe6\xfef\xbf\xea\x88\xf7\xf4X\xa5\xce搗\x1c\xba\x9b\x0en\x94\xa9\f\x8f\x06ݯ\x14\xec\x9fe\xbbEo\xf0P\xf9\xa7\xbb\x0eB\x8d\x93ʴnxT-;\xfa\v\xeeg\xf8\x92\xb7\"=\xe4-\xa9\xf0M\x90\xee,Hm\xf0\x9e\x920\x8d\xa2k\xc1\xec\xcc|{\x0e\xffx\xe75\xbb\xb0\x86mǡ\x83}t\vב\xff\xeaFD|˱r\xb9\xf4m\x18]4w\xebr\xee3Z\x98R\x85\xf6ץ\xc2~`u\v\x13\x1a0\xe7Q\xd4\x02\xbb\xdd0\xf0~A&\xc5\x1d[\x806tQ<A!\xaf6߰\x1b U\xae\xabJ'M\x17A\xddt\xab\xcb*\xa6u\xab\xb7|܀\xed\xc0\xa0NfACN`\t\x82\xf8K>\x18yw\x0e\x88\x0e\xa0wh\x9c\xa8%\xc6\x02\x02\x1c\xcc\xf7\x9dJE\xb0\xab^5\xf5M\x8a\bW\xc7sj`\xd4y\x93p\xaf\x93\xd8)u\\\x8f\xdc'\x10|\xe9\x1a\xe9\xa2\x04\xca0O\xccn/\xe7\xee\xedp\xf3\xc0_\xf5{\x00\x05d\x06¢\xb8\x93\xe3xUֵz\xb2\x88\xf5'\xb8r\xe0ܹ\xeeX%塅/V\xc0\tRe\xab\xcb\f\x1f\xe9\xbce\xb5\xeb\x02\xbd\xbf\xf1\xf1\x0e\xa8\xee\xca[j!\xe2\xeb\xe6\xb3\xdeVq8\xc0\xa5g\xd4\xf5\xc9s\x1dK\x99\x82N\xd2\xf7S\x92\xf8\xe5\x883NH1\xa7\xfa)vyc\x9f\xa9Zr5\x0ee\xc5)\xdfm\x99\x13\x88r\xb1\t|D\xae\xe1\xa1\xe3ׯ\x91\xe8\xdf\xfb\xb6\xca\x1dGiD\xaeč\x923\xd5U%v\x14\x0eV\a\x85\x8c\xc8\rU\x86Q\xceW_ww\xa3\t_\x8f\u009d\x9fʓZ\x82{\xacVD\x99p\xe7\xcfR*\x9d\x84^\xd5~c\x8fuM\xc7\x1d\x9b\x1c>:\xb6F(\x04G\x05k\x03\xc5\x14,mF0\x9dJe\x9c\xe2;\x1a\x116\xf5\xfc\xb3K\xf9\xa0\x8c\xa3\n\xe7\x82h\x84\x99\xda@\xac\x04\x92T\x84\x8a\x15QH\xac\xd8\xfakAW\xce\x05O\xb3\xac\xb4\xc7\xf3\\\x1b\xda%gz9\xe4Ш\xf4D\xb6\xc5\xdak\xfb\x82\x9b\xcfW\xdd\xf7\xab:%\bΡ\x0e;e;ΰ\xb5\x1a\x18\xde\xea\xaftPmOY\xb7%\xf2TQ\r\xbc\x13y\xb5\xdd@n\aܪ\x87\xc3\x02ܕʍeȦ\x8a\xbcݝ\xc8tx\xd5\xeeY6Ǌzf\xaed9\xabڥoc\xa0\xdb܊%^\xfa-x9\xb3d\xed\x1d}\xa6T\xa2a\xbdx\xd7_^Ow\x17\xd0\xdd(ܡ\xbb\xeb\x96\xc4{J\x01l=\xdcO\xb2W\xd7\xdd?]\x89\xbc\xacX\xea\xe5>\xb2\xf9\xfd\xda\xe3k\x81\x14+\xa5k\x88^\x9ev \xe7\x84M\x9d\xd74\xb3\xb3\xdeL\xde\xf9\xc0\x01\x91\a\xaa\x04\x13\xb3\xa7\x16\xff\xbd\u007f\xacC5\xf1\x10:\x94\x93\x8eET\xeaJ\x94r\x12&\xb9%קRXz\xa8'\x9dgh\xe3G$企d\xff%\xffK\xadֻ2\x17>P\xe9p{\xcfD\xfe2$\x04\x16\xbcT\x94\xfb\u007ffR8\xb6\xa0_\x92\x1f~\xfc\",\xe8=(]\xfd\xf8\xff\x03\x00\x00\xff\xff\xe2w\xf4\xcch\xec\x01\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec<\xcdn\xe4\xb8\xd1w?E\xc1\xdfa\xbe\x00\xee\xf6\x0e\xf6\x12\xf86\xeb\xf1\"\xc6Nf\acǗ \a\xb6Tm1\x96H\x85\xa4\xda\xee\x04y\xf7\xa0\x8a\xa2\xfeZjQ=\x1ed\xb30/vKd\xb1\xfeXU,\x96x\xb6Z\xad\xceD)\x1f\xd0X\xa9\xd5\x15\x88R\xe2\x8bCE\xbf\xec\xfa\xe9\x8fv-\xf5\xe5\xee\xfdٓT\xe9\x15\\W\xd6\xe9\xe2+Z]\x99\x04?\xe2V*\xe9\xa4Vg\x05:\x91\n'\xae\xce\x00\x84R\xda\tzl\xe9'@\xa2\x953:\xcfѬ\x1eQ\xad\x9f\xaa\rn*\x99\xa7h\x18x\x98z\xf7\xc3\xfa\xc7\xf5\x0fg\x00\x89A\x1e~/\v\xb4N\x14\xe5\x15\xa8*\xcf\xcf\x00\x94(\xf0\nl\x92aZ\xe5h\xd7;\xcc\xd1\xe8\xb5\xd4g\xb6Ąf{4\xba*\xaf\xa0}\xe1\a\u0558x*\xee\xea\xf1\xfc(\x97\xd6\xfd\xd2{\xfcIZǯʼ2\"\xef\xcc\xc7O\xadT\x8fU.L\xfb\xfc\f\xc0&\xba\xc4+\xf8LS\x95\"\xc1\xf4\f\xa0&\x8c\xa7^ը\xef\xde{\x18I\x86\x85\xf08\x01\xe8\x12Շ/\xb7\x0f?\xde\xf5\x1e\x03\xa4h\x13#K\xc7\xec\t聴 \xe0\x81\t\x04S\x8b\x02\\&\x1c\x18,\rZT\x8ez\x94\x06W\x01ô\x01\t\xa0\r\x94h\xa4Ne\x02?\x89\xe4\xa9*\xfd`\x9b\xe9*Oa\x83`*\xb5n\x06\x94F\x97h\x9c\f,\xf4\xad\xa32\x9d\xa7\x03\x8c\xdf\x11Q\xbe\x17\xa4\xa4+h\xc1e\x18\x18\x83i\xcd\a\xd0[p\x99\xb4-\xfe,\xfe\x1e`\xa0NB\x81\xde\xfc\x1d\x13\xb7\x86;4\x04&`\x9dh\xb5CC\x1cH\xf4\xa3\x92\xffl`[p\x9a'ͅ\xc3Z\xaem\x93ʡQ\"\x87\x9d\xc8+\xbc\x00\xa1R(\xc4\x1e\f\xd2,P\xa9\x0e<\xeeb\xd7\xf0gm\x10\xa4\xda\xea+Ȝ+\xed\xd5\xe5\xe5\xa3ta\xa9$\xba(*%\xdd\xfe\x92\xb5^n*\xa7\x8d\xbdLq\x87\xf9\xa5\x95\x8f+a\x92L:L\\e\xf0R\x94rŨ+^.\xeb\"\xfd\xbf Q\xfb\xae\x87\xabۓ~Yg\xa4z\xec\xbc`\x85>\"\x01\xd2l\xaf0~\xa8\xa7\xa2e4=\"\xee|\xbd\xb9\xbb\xef*\x93\xb4C\xee3\xdf;\x1a֊\x80\x18&\xd5\x16\x8d\x17\xe2\xd6\xe8\x82a\xa2JK-\x95\xe3\x1fI.Q\r\xd9o\xabM!\x1d\xc9\xfd\x1f\x15ZG\xb2Z\xc35\xdb\x0f\xd2êL\x85\xc3t\r\xb7\n\xaeE\x81\xf9\xb5\xb0\xf8\xdd\x05@\x9c\xb6+bl\x9c\b\xba\xa6o\xd8\xd9s\xad\xf3\"\x98\xa9\ty\x855~Wb\xd2[24Nne\xc2\v\x03\xb6ڴ&\xc0v\xadYh\xe3\xab\x16j\xd3C݇ϏZ\x9bk\xa3\x15\xe0\vɾ]ͤ;\xcf\x19*Za\xa6R\x84\xe7\x01L\xa8M\xcc\xfa\xe0\xcd\x047\xf9\x15\x16%-\xd7\x19\x14\xef\xebn\x84\"\xf1(mܑ7(ؘ7][580*<]\x86į\x9dLk\xabq\xc0\xcd\xe3\x1c\xf5\x88mE\x95\xbb\a\x9dW\x05\xda{\xfd\x15\xad\x93\xc9X\xcf\x01\x11\x1fG\a\x06y\xa3%\x0e\xbb\f\r-N~\xc1\xf6n\x14.\U0001ac58\xb2\xc9\x13O\b\x026\x9e\x03d;\xf3\x1cJ\x9d\xc2\xce\xcf\x04\x9b}@\xfaP6\xad|6Z\xe7(Ƹ\x86/I^\xa5\x986.o\x94/\x03jo\x0e\x06qp \xa4\"-#WL\xa8\xaa\xe6\xed\x04\x9d쯄A C!\x95\x87\t\x92U\xb0&y\x9c(鰘\xc0\xf3\xa8F\xfaFA\x88\xd8\xe4x\x05\xceTc\xba\x1e`\bc\xc4\xfe\b\xcfB\x00\xb5\x84e͘ڜ\xe72AbVc\xb4\x99k̚\t\xfa\xfe\a\x19\x96i\xfd\x14ä?Q\xbf\xd69A\xc2q*l0\x13;\xa9\x8d\x1dF8\xf8\x82I\xe5pj\x1d\t\a\xa9\xdcn\xd1\x10\xac2\x13\x16m0)ǘu\xdcDP3\xc7\x05\u007f@W+t\x12\x1esc\x8a\x146œP\x81\x11'\x8b]\x95 U*w2\xadD\x0eRY'T\xe2\xe9\x13\r~\xe3\xf4\xc1\x9cB\x1c\xe0\xef\rp\xa0\x82\xa4\xd4\xf3lZ!\x85\xa3\x856\xe3\xca\x11\xda!\x98i6l\x04Y@=\xe5\x8e\xdaf(\xa2\xafQI٥\xb6v碕\x94\x0f\ns\xb1\xc1\x1c,\xe6\x988m\xa6\xd9\x13\xa3\x04\xbe\xc5\xda\xcf\tΎX\xd2\xd6g\x90\xa2\xce\x1aѶ9\rϙL2\x1f\xbf\x91\x961,H5Z\xb6\x18\xa2,\xf3\xfd1\xa2!F3\xea\xc9\xe6\x8cF\xdb\"\xcc\xc7\x10\xee\x94!i[\xa4\rnی5\xees\xbdQ\x9b7\xa6\xf7\xd0Tߤ\xec\xb7\a\xc3__ىݒ\xf6w\xb7[\xc0\xa2t\xfb\v\x90.<\x8d\x81J\x01V\x8b\xc7\xefLp\xa7\xad\x96\xdb\xe1\xe8W_-\xaf\"\xb5\x06\x8d߉\xd0\xd8Y\xddվj\x91\xc0>uG^\x80\xdc6\x02K/`+s\x87\x1cK\xcd!\xda\ttf%\xf7\x9a\f\x8a\xf5\xbd\xd4\n\xe1\x92\xec\xa6\xd9\xd2F\x8c\x18\xf0j\b\xc0\xc7\xe5a\x0f\xc32\x88\x00\tMP\xc1Y\x10i\xb0\xf0ٕ{^\x1f\xed\x13\x8e\x00?|\xfe\x88\xe9\x1c\xcb ^S\x0f\x88\xfa0\x88t\xba(0\x81Q ;Dq\x98\xd6\xec\xf1|\x0e\xed\x02\x04<\xe1\xdeGV\xa3\x9b˱F\xa2\x15\rH\x83\x9c\xd0c3\xf2\x84{\x06Ug\xe8\xa2\xe0-Q\x15ߞp\x1f\xdbu\xc0T¯\xceQx\xee\xd2\x03\xa6\"f)\xb5\xadaj\xbdv\xc0\xe98ba\x99Q\n-p\xfcD\xb2\x1b\x81\xf5\xd2\xd2O\xb8\u007fg\xbd\xf8h\xd5d\xb2\\\xc0\x012\xd8`\x91WX\xc8\xc7>\x88\\\xa6\xcdd\xbcN\x16@\xbcU\x17\xf0Y;\xfas\xf3\"-\xa1\xa8R\xf8\xa8\xd1~֎\x9f|W\x16{\"Nd\xb0\x1f\xcc\xcbRy\xb7@|Y4\u007f\x8b\x03\xbbPR\xd1Fl\xd2\u00ad\xa2\xfd\x99\xe7\xcf\x121e\x18\x90\xf3h\x15\x95匮\xd2j\xc5n:̶\x00h\x17\xafZT\xda\xf4$u\xb1\x10\xe2(\x8a5z\xf7\xe4\xad\xfc\x9b\x83\\\xf8\xb1f\xb0\xccE\x82)\xa4\x15\xe7\xdb9\xf1.\x1c>\xca\x04\n4\x8f\b%\xf9\x8dx\xa5Z`\xc9};A\v\xe3C\x8b\xd0j\xb7\x90\xc6!\xb6\xa2U\x1f\xd93\x889\xaa\xfbD\x96\xfdx\xf78*ٽs<\x14\xc5}\x91\xa6\x9cz\x16\xf9\x97\x85\x9ee\xa1\xbc\x0ec\x10\x8f\xa4\x0f?\n\xc1\xc9\xde\u007f\x91{e\xf5\xfew\x9c7\x14\xd2\xd85|\xe0\xc3\xcd\x1c\xbb\xe3C\x96\xb03U\x14H\xc2DZ =ى\x9c\xc2\a2\xde\n0\xf7\xc1\x84\xde\x1eDPq&\xe69\xd3\xd6\xfb\xfc\xadĜO\xb7Οp\u007f~q`\xbd\xceo\xd5y\x1cL\xb2\xf9\aF\xab\x89Z\xb4\xca\xf7p\xce\xef\xce90[\xb2DN\b\xde\x16hutW>~^\xb2\x15\xa0\xbdv\x88ZhpsHK!\xfc\x1c\x15\xd1:]j\xeb\x16\xa1\xf5E[\xe7\x13\x80\xbdp{$C\x18\xb3\xfb\xab\xb3\x86 \xb6\x0e\rX\xa7M8\x10%\xb3;H\x90\x93\xe4\xed\xbc\xecI\xd4M6\xd2\x03\xa6M\xe6yk!\xbcM?\xf7'\xa5\xf4\xff<̄\x83%\x86]\x1a\x9d\xa0\xb5\xf3\xaa\x14\xe99f\x12\xb6M\xb2V\xf8\xcd\xdb6\xca4Ǥ\x92C[\x16\x8a\x13kO\xd8\xd8ܼt\xf2\xced\x86\xe8w\x8c*\x9f\x82#p\xd1KQ\x88\xe1\xe1|4\xba\xd7~tX\x8050\xbfa2\x8f\x15\x1b\x95eqs\xad\x92\xbf\xb5\xc0\xa3\x90\xea\x96'\x82\xf7\xdf-X\x81`\xca\xf1ԭ\xccu\x18\xdf\n\xa4y\x10\xbb\u007f\x85pԬ\xf9\xac\xc6`O\xb2\x87'\x19\xf1\x92\x02\n\xa6\x95v\xdddM=\xd3;\v[i\xack\x11^\x00UZ>N\xfe\xbe{Luc\xcc\xc9[\xcc_\xfd\xe8NZ1\xd3\xcfuaĒ\x8du`~&v\br\v\xd2\x01\xaaDW\x8a\x13^d.h\x9a\x05\x10\xbd\x10\xbd3\x89\xf4\x99\x9d\xc1\xaa*\xe2\x19\xb2b\xed\x94j6;\xd6\x1d\xf2\xb3\x90q\xd9)8M\xacN\x16\xa8\xabY\xd7\u07b6~e\x87\x1fݫ\x80)ċ,\xaa\x02DAbY\xb2o\xdc26M\xb9\x8c\x97\xf5\xb3\x90\x8e\x0f\xfd\b6\x89g\x995MtQ\xe6\xe8\x106\xb8Ն큕)6\xe1C-\xff\xd1z\x93\xa9&`+d^\x99\x056z\xb1d\x96\xee\xdbj\xf3\xf4\xfa\x9b\xb1xDV\xcc\xccȤ\xfb\x82\xa0y\xde\u007f\x94fY\xc8\xfc\xc5\xe0뇦\xa5\x91\xa4\xa5z.:\x9d\x85\xc9\xd1k?:\xad\x95W\xa8\xfdTx:\v\x951y\vO\x9b\xf6\x16\x9e\xbe\x85\xa7o\xe1頽\x85\xa7o\xe1\xe9[x:\xde\xde\xc2\xd3N{\vO\xa3\xfdG\f\x86\xfe\xab\xa3#\x1d\xa2\xb0\x8a,\xc1\x98C{f\xae\xba\xd2\xe8:\xaf\xacC\xb3\xa4B\xfav|\xe4H\r}⻬\xf8k\xad)\xadiKWZ\xa7הLӒ\f\x8b\xc9\u007f\x8b\x11\x11\x85G\x97AOW\xdb\xc7\x16\xd0͕\xcd\xf5kǛr5\xff\xdf\x04C\x9c\x0e\xd3\xd7\xd2\xf3\xdf\xf8tk\xae\xfa\xb5o\xbc\x0f\b\x18\xff&\xeb\xca#\xcb\xdaf\x8aَ\x17\xe2Oy\xf8\xc0\xcb\xc1\xe1B\x9f\x99\xa6W\xf8\xfd\x9b\xe6eD\xb5\xd9t\x8dY}j\x89N\xecޯ\xfbo\x9c\xae+\xce&({\x96.\xf3\xdf\x1f\xd1\xd6U=v\xcbڃ\x9e\xd6\xdf\xfe\ry<\x01Q\x1bP2\xf7\x02\b\x10z\xec\x87_K\xbfE>y\x9d\xcfo\xd4\xe2\xebҖV\xa35\xf5C\xf3^\xe5\x1bjЖ} 0[o\x16\x834\xc4T\x99\x8d\u05cf\xcd@]R[\x16\xbb\a\x8f\xa8#\x8b\xaf\x1e\x8bc\x0f\xf0\x17\xbb\xb15c\xd1Q[l}\xd8\xf7\xa9\n\x8b\xac\x05\xebTx͂<\xb1\x02,\x9aaq\xd5^\xd15^\x9dʭyn\x1d\xa9\xec\x1a\xafך\x059V\xcf\x15S\xa5\x15\x85ktmVSq5\x9fI\xfc\xa6\x8a\xacׯ\xfd~\xcd8\xffx}UTUU\xd4^`\x1e稺\xa9\xa5\xd5RQ\\]Z\x19\xd5T=\x1d\x998\xaa\x1e\xea\xb0\xd6\xe9\x18)\xb3UP\xd3\x15N\xc7\xc0\x8e\xd5>E\xd45\x1d\x01٭xZ\x1c\x06\xccj\xd3L\x87\xf1\xaf\xeaC\x9b\xf7\xb5\xf9\u007fC\x03\xbf\x95hmR4\xb3\xbb\x92%\xa8Ϣ\xdd\xcfW\x0e\xe6\x1f|\xae\x13>&\xa7^\xdd\x1d\xcfT\x14\xa5\x9b\xcfG\x12\xf8E\xaa\xd4k\x12-\x96NL\xc37Tp\x81Y\x13fMWܶ\x11\xed`\xb7e\xb1\x14d\xd4S\xd8\xec}VȮ\xe1F$Y\xd3q\x02\"Ϝ\tK;\xfbB88o\xb6\xb1\x97a$=9_\x03\xfc\xac\x9b\fB\xe7\xf3\xd0\t\xb8V\x16e\xbe\x87\xca\"\x9c\xf7\x01}\xdb.lRw\xac\x12\xa5\xcdt\xb8I b#v\xd7\x1f1\x92/\t\xf7\b$\xb9\xae\xd2f\x86#\xe2\x16j\x0f_\x1e8\x92㯧\x93\xf6+\xf3:R\v\xfb\xaa\xc1G\xe8\x13 \xa7.\x8fXĲ鬊uڈG\xfc\xa4\xfd\xbd\x1a1<\xeb\x8f\xe8]\xadR\xdbՐc\xad\x8b&'5\xd9\xd36\x04\xd8\x1e\xbdԫ\xadMB\x11\xb6S&wf\x9d;\x97G\x10w\u007f\xff\xc9\x13\xe4d\x81돕\xcfp\xadJa,\x12\xa7\x03\xa1~\xd0f\xda\x12f\xfa\x19r]\xf3\xe1\xa7!\x1d\x06\xf9\xa8\x87\x93i'Q㯰\b\xea\x1bX\x17\xa3\xf2\x0f\xe3#;\x9b\xe5\x8e\x10\x8f\xe5\xc4\xf4v\x12\x96\xb0V'\x92m\x11\xa7(\xf8\x84\xe5\xfbݱp,\";b2*\x8b\xbf>+4_\xc3B\xb5\xb7\xcaKj\xe6֕\xbf\x1c\f\f\x02\x1e3\x1fd\xff\x06\xdd\xc7\xfc\x9e\xaa\x19d\xfd\xdd_ތ3\xe3\xc2=4\x87\xac\x9bY\xff\xd3k\u007f<\xa2^\x8d_\xfd\xb2jn\xa39\x8b\xe0\xacu\xc2U6\xe6\x82\x1f\xee\b\x89(]ej\xf7\x9aT\x86/\x9c  \xe8\xefc8튟\\X\x17%\xcbOM\xc76\xc3`\x9d?\x8f\n\x06\n\x9e\x85\xe5\xbbs\xfciԨkn.\v\x9a\xbc=\xc7{\xd7+H\x85\xc3\x15\xc1?M\x9c\xa3\xeb\x80/蘡\xf4\v\xf5iN\xc7kF\xf3\xc0p\xb1\xc7\xdd\x14\xea\xe3ǝ+\xf8\x8c\xcf#Oo\x14\x11q\xb8\x19\xf5g\x9a\x98r\x86b\xecz\xb3\xa3$\xee\x9aQ|\xa0<b-\xfafn\xd0}\x90\xa9\x16yށ\xe8\x0f\x8f\xc7\xc4\xfa\xffr\xeb\xd3F\t\xd1\xf4\x87\x83\x1e\x93\x86\xeb\xa8њ2X\xa3K\xea\xe0\xa1E\xb3ô\xa3$\xb5\x0f\xaf\x9f\xb4\vP$\t\x96\xae>\xfc\xe8\xde\xfew~\xce?\xc2\xe5~\xfc3\xd1\xca\xc7\xd4\xf6\n\xfe\xfa\xb73\xa8}\xedC\xb8\xc1\x8f\x1e\xfe'\x00\x00\xff\xffa\r\b,+Q\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V͎\xe44\x10\xbe\xe7)J\xcba/$\xbd\xab\xbd\xa0\xdc\xd0\xc0a\x05\x8cFӫ\xb9 \x0en\xa7\xd2mƱCU\xb9\x87\x06\xf1\xee\xc8vҝN\xd2̀\x84o\xb1\xeb端~REY\x96\x85\xea\xcd\x13\x12\x1b\xefjP\xbd\xc1\xdf\x05]\xfc\xe2\xea\xf9\x1b\xae\x8c\xdf\x1c?\x16\xcf\xc655\xdc\x05\x16\xdf=\"\xfb@\x1a\xbf\xc3\xd68#ƻ\xa2CQ\x8d\x12U\x17\x00\xca9/*^s\xfc\x04\xd0\xde\tyk\x91\xca=\xba\xea9\xecp\x17\x8cm\x90\x92\xf1\xd1\xf5\xf1C\xf5\xa9\xfaP\x00h¤\xfe\xc5tȢ\xba\xbe\x06\x17\xac-\x00\x9c\xea\xb0\x06F\x8aJ\xa2$0\xe1o\x01Y\xb8:\xa2E\xf2\x95\xf1\x05\xf7\xa8\xa3\xe3=\xf9\xd0\xd7py\xc8\xfa\x03\xa8\x1c\xd06\x99\xda&S\x8f\xd9Tz\xb5\x86\xe5\x87[\x12?\x9aA\xaa\xb7\x81\x94]\a\x94\x04\xf8\xe0I\xee/NK`\xa6\xfcb\xdc>XE\xab\xca\x05\x00k\xdfc\rI\xb7W\x1a\x9b\x02``*\xd9*\a.\x8e\x1f\xb39}\xc0Ne'\x00\xbeG\xf7\xed\xc3\xe7\xa7O۫k\x80\x06Y\x93\xe9%\xf1\xbd\x12\x19\x18\x06\x05\x03\n\x10\x0fJkd\x06\x1d\x88\xd0\td\x94`\\\xeb\xa9K9:\x9b\x06P;\x1f\x04\xe4\x80\xf0\x94(\x1f\"\xab\xce\"=\xf9\x1eI\xcc\xc8Ơv\xa9\xbe\xc9\xed\f\xeb\xfb\x18N\x96\x82&\x96\x1dr\xf24P\x82\xcd\xc0\x00\xf8\x16\xe4`\x18\b{BF's\x94\x89\x9f\x16\x94\x03\xbf\xfb\x15\xb5T\x03\x0f\x1c\x93\x15l\x13\xab\xf5\x88$@\xa8\xfdޙ?ζ9\x12\x12\x9dZ%c\x9d\\\x8eq\x82䔅\xa3\xb2\x01\xbf\x06\xe5\x1a\xe8\xd4\t\b\xa3\x17\bnb/\x89p\x05?y\xc2Df\r\a\x91\x9e\xeb\xcdfod\xec:\xed\xbb.8#\xa7Mj \xb3\v\xe2\x897\r\x1e\xd1n\xd8\xecKE\xfa`\x04\xb5\x04\u008d\xeaM\x99\xa0\xbb\xd4yU\xd7|EC\x9f\xf2\xfb+\xacr\x8a\x95\xc5B\xc6\xed'\x0f\xa9!\xfe!\x03\xb1\x1dr}d\xd5\x1cŅ\xe8x\x15\xd9y\xfc~\xfb\x05F\xd7)\x19s\xf6\x13\xef\x17E\xbe\xa4 \x12f\\\x8b\x94\x93ؒ\xef\x92MtM\xef\x8d\xcbե\xadA7\xa7\x9fî3\xc2c\xed\xc6\\Up\x97F\x11\xec\x10B\xdf(\xc1\xa6\x82\xcf\x0e\xeeT\x87\xf6N1\xfe\xef\t\x88Ls\x19\x89}[\n\xa6St.\x9cY\x9b<\x8cc\xeeF\xbeV\xba{ۣ\x8e\x19\x8c$Fm\xd3\x1a\x9d\xda\x03ZO\xa0\xd6T\xaa7!I\x1a\xff\x12\xcb0I2\x9a\xd9|\x89\xfd\xf9:\x9a\xf5q\x92^\x0e\x8aq~9\xc3\xf4\x10e\xe6\xfe\xadiQ\x9f\xb4\xc5l\"O\x13|\x1dJ<\xe8B\xb7\xf4Y\xc2=\xbe\xac\xdc>\x90\x8f\x935\xcd\xf5\xebs\xa36 \xffo\xf6\xc6-\u009dG\x96\xa5\xd2?l:\xaa'\x03z0\x04\x14\x9c\x8b}\xbb\x98\x90\t\xc8|\x92/d\x8c`\xb7\x82f\x15\xcfg\xd7\xfa\xb4\t\xa8\xe8XI\xee'\x1c\x92=\xf8ɸV\f\xde\xceu>\xcb\xe1\xf5&B\xf3I\u007f\xd2\xff\xa6\x1cǍ!\\\xf5]&T\xab\x0f\xd1\xe3\x1a\xe3\xeb\xfd5\xa0\f֪\x9d\xc5\x1a\x84\xc2R;\xeb*\"u\x9aW\xcdXj\x97}\xea\x95\x02Z(\xc4>y9\xa0\xbb\xd5\r\xf0\xa2\xe6S\xfe\xca3\xecN\xb7T\xef\xce\xcbᲥr\xe9\xd6\x10gw)f\x85\xb37\x91\xb2\x9a\xbd\\ҫ\x9bǂ\x90\xedTv\x9c\x19W\xad1.\"\xcb\x18nBXM\xf6\xe22\x99o&\xe1\xb1xR\xfbi\xc0\x1cv\xe7?\xfd\x18\xc80\x92\xe1Ͽ\x8a\xcbt\x8e\xcb\\/\xd8\xdcϷ\xe0w\xef\xae\xd6\xd9\xf4\xa9\xbdkL^\xe2\xe1\xe7_\x8a\xec\x18\x9b\xa7q\a\x8d\x97\u007f\a\x00\x00\xff\xff!\xec@\xb2>\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VM\x8f\xdb6\x10\xbd\xebW\f\xd2C.\x95\x9c \x97B\xb7\xc0\xed!h\x1a,\xe2ԗ\xa2\a\x9a\x1c\xd9ӥH\x963t\xbb\xfd\xf5\x05)j\xfd\xb1\xf26\v4\xbaq8|\xf3\xe6͇ݴmۨ@[\x8cL\xde\xf5\xa0\x02\xe1߂.\x9f\xb8\xbb\xff\x81;\xf2\xab\xe3\xdb果\xe9a\x9dX\xfc\xf8\x19٧\xa8\xf1G\x1cȑ\x90w͈\xa2\x8c\x12\xd57\x00\xca9/*\x9b9\x1f\x01\xb4w\x12\xbd\xb5\x18\xdb=\xba\xee>\xedp\x97\xc8\x1a\x8c\x05|\x0e}|ӽ\xeb\xde4\x00:by\xfe\x85FdQc\xe8\xc1%k\x1b\x00\xa7F\xec\xe1\xe8m\x1a\x91\x9d\n|\xf0b\xbd\x9e\x82uG\xb4\x18}G\xbe\xe1\x80:\xc7\xdeG\x9fB\x0f\xa7\x8b\t\xa2\xf2\x9ar\xda\x16\xb4ME\xfbXъ\x83%\x96\x9f\x9fq\xfaH,\xc51\xd8\x14\x95\xbdɬ\xf80\xb9}\xb2*\xde\xf2j\x00X\xfb\x80=|\xca\x14\x83\xd2h\x1a\x80*O\xa1\xdc\xce\x02\xbc\x9d\x10\xf5\x01G5\xe5\x02\xe0\x03\xba\xf7w\x1f\xb6\xef6\x17f\x00\x83\xac#\x05)\"/'\x02Ġ`f\x02\u007f\x1d0\"l\x8bj\xc0\xe2#r%\xfd\b\n0\xf3\xe7\xee\xd1\x18\xa2\x0f\x18\x85f\x81\xa7\ufb3dάW\xbc^g\xea\x93\x17\x98\xdcW\xc8 \a\x9c\xd3GS\xb3\x05?\x80\x1c\x88!b\x88\xc8\xe8\xe4T\xae\xd3\xe7\aP\x0e\xfc\xee\x0f\xd4\xd2\xc1\x06c\x86\x01>\xf8dMn\xc7#F\x81\x88\xda\xef\x1d\xfd\xf3\x88\xcd \xbe\x04\xb5J\xb0V\xf6\xf4\x91\x13\x8cNY8*\x9b\xf0{P\xce\xc0\xa8\x1e b\x8e\x02ɝ\xe1\x15\x17\xee\xe0\x17\x1f\x11\xc8\r\xbe\x87\x83H\xe0~\xb5ړ\xccc\xa5\xfd8&G\xf2\xb0*\x13B\xbb$>\xf2\xca\xe0\x11\xed\x8aiߪ\xa8\x0f$\xa8%E\\\xa9@m\xa1\xee\xa6n\x1f\xcdw\xb1\x0e\"\xbf\xbe\xe0*\x0f\xb9\x8bX\"\xb9\xfd\xd9Ei\xf7g*\x90;}j\x84\xe9\xe9\x94\xc5I\xe8l\xca\xea|\xfei\xf3\x05\xe6Х\x18\xd7\xea\x17\xddO\x0f\xf9T\x82,\x18\xb9\x01\xe3T\xc4!\xfa\xb1`\xa23\xc1\x93\x93rЖ\xd0]\xcb\xcfi7\x92\xe4\xba\xff\x99\x90%ת\x83u\xd95\xb0CH\xc1(A\xd3\xc1\a\ak5\xa2]+\xc6o^\x80\xac4\xb7Yد+\xc1\xf9\x9a\xbcv\x9eT;\x1f\xb0\xba\xc4n\xd4ky\x927\x01\xf5\xc5\x00e\x14\x1a\xa8N\xf6\xe0㕮j\x9e\xf3e\xbc\xee\xc2}y\xc0a\xda\xf1\x03\xed\xaf\xad\x00ʘ\xf2\v\xa1\xec\xddͷ\xcf\b\xb6\x90\xf7\xbaDʍ:\xf8\x98\x19\x1d\xc9`l\xe7<+\x93\x14k\u0084\xd6p\xf7\x04\xf2\x86\xe65\xc9\x02\xf9\x94\xe6\x05\x8f\xbbꖙd\xa1\xe7gӆº0\xcb\xfaT{\xbc\xc5`!\xe3\xdc\xe1\x14\xf1jV\xdb\xc7\x00_\xd5;\xa2$\xf1˻\xa7<\xab\x9e\xbb\xdaA:ňN*\xe6¦\xfd\u007f:(\x1c\x14\xe3\u007fh\xbe\x1c\xe1.\xbf\x9c\xcb`i@\xfd\xa0-N\x80\xe0\x87\x85n{\x11\xe5\xfc\xa1K\xe3Sn-\xbc?*\xb2jgq\xe1\xeeW\xa7n\xde\xde,\xfeb=\x9f\x189\xafSӃ\xc44a\xd7.\xab\x96S\xf5\x95\xd6\x18\x04ͧ\xeb\u007f=\xaf^]\xfcq)G\xed\xdd4\xac\xdc\xc3o\xbf7\x13*\x9a\xed\xfc\x0f$\x1b\xff\r\x00\x00\xff\xff2\x1e\xaa\xc01\n\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=]o\xdc8\x92\xef\xfd+\n\xbe\x87\xcc\x02\xdd\xed\t\xf6p\xb8\xed\xb7\xc4\xc9\xdc\x1a\x93\xcd\x18\xf9\xba\x87\xc5>\xb0\xa5\xeann$RKRv\xfa\x0e\xf7\xdf\x0f\xc5\x0f}R\x12\xdbq\x16\x99E\xac\x003\x96\xc4R\xb1\xaaXߤW\x9b\xcdf\xc5*\xfe\t\x95\xe6R\xec\x80U\x1c\xbf\x18\x14\xf4\x9b\xde~\xfeO\xbd\xe5\xf2\xfa\xfe\xf9\xea3\x17\xf9\x0enjmd\xf9\x0e\xb5\xacU\x86\xaf\xf0\xc0\x057\\\x8aU\x89\x86\xe5̰\xdd\n\x80\t!\r\xa3ۚ~\x05Ȥ0J\x16\x05\xaa\xcd\x11\xc5\xf6s\xbd\xc7}͋\x1c\x95\x05\x1e>}\xff\xf3\xf6\x8f۟W\x00\x99B;\xfc\x03/Q\x1bVV;\x10uQ\xac\x00\x04+q\a{\x96}\xae+\xbd\xbd\xc7\x02\x95\xdcr\xb9\xd2\x15f\xf4\xad\xa3\x92u\xb5\x83\xf6\x81\x1b\xe2\xf1psxiG\xdb\x1b\x05\xd7\xe6\xd7\xce\xcd7\\\x1b\xfb\xa0*jŊ\xe6K\xf6\x9e\xe6\xe2X\x17L\x85\xbb+\x00\x9d\xc9\nw\U00016568+\x96a\xbe\x02\xf0ӱ\x9f\xdcx\x84\xef\x9f;\b\xd9\tKK\"\xfaMV(^\xdc\xdd~\xfa\xe3\xfb\xdem\x80\x1cu\xa6xE\x14\b\x88\x01\xd7\xc0\xe0\x93\x9d\x16(O~0'f@a\xa5P\xa30\x1a\xcc\t!c\x95\xa9\x15\x82<\xc0\xaf\xf5\x1e\x95@\x83\xba\x01\r\x90\x15\xb56\xa8@\x1bf\x10\x98\x01\x06\x95\xe4\xc2\x00\x17`x\x89\xf0Ӌ\xbb[\x90\xfb\xbfcf40\x91\x03\xd3Zf\x9c\x19\xcc\xe1^\x16u\x89n\xec\x1f\xb6\r\xd4J\xc9\n\x95\xe1\x81\xce\xee\xeaHU\xe7\xee`zψ\x02\xee-\xc8I\x9c\xd0M\xc3S\x11sO4\x9a\x8f9q\xddN\xd7JH\x0f0\xd0KLx\xe4\xb7\xf0\x1e\x15\x81\x01}\x92u\x91\x93\x14ޣ\"\x82e\xf2(\xf8\xff4\xb05\x18i?Z0\x83^\x00ڋ\v\x83J\xb0\x02\xeeYQ\xe3ڒ\xa4dgPH$\x82Zt\xe0\xd9W\xf4\x16\xfe\"\x15\x02\x17\a\xb9\x83\x931\x95\xde]_\x1f\xb9\t\xab)\x93eY\vn\xce\xd7va\xf0}m\xa4\xd2\xd79\xdecq\xad\xf9q\xc3Tv\xe2\x063S+\xbcf\x15\xdfX\xd4\x05MXo\xcb\xfc߂\x00\xe8g=\\͙\x84Q\x1b\xc5ű\xf3\xc0J\xfd\f\ah\x018\xf9rC\xddD[Bsq\xb4\xd4y\xf7\xfa\xfd\x87\xae\xec\xf1\xaeX\xd1\xe5\xe8\xde\x0e\xd4-\v\x88`\\\x1cP\xd9qpP\xb2\xb40Q\xe4N\xfa藬\xe0(\x86\xe4\xd7\xf5\xbe\xe4\x86\xf8\xfe\x8f\x1a5\t\xb9\xdc\u008dU1\xb0G\xa8\xab\x9c$s\v\xb7\x02nX\x89\xc5\r\xd3\xf8\xcd\x19@\x94\xd6\x1b\"l\x1a\v\xbaڱ\xfd!(;O\xb5\u0383\xa0\xcb&\xf8\xe5\x14\xc2\xfb\n\xb3ނ\xa1Q\xfc\xc03\xbb,\xe0 U\xab/\x9c\xbaj\x97\xeb\xf4\x92\xa5+\xc7\x03\xab\v\xf3\xc9.u\xfdA\xbeCm\xf8\x00\xa1\x11R\xaf\xa2\x83\x02R\xa8\xe1\xe1\x84愊\xe4\xc7>\xb0Kr\x04\x13,K5\xe6vE\xb2\xcf\b\xccco\x97vQ@%\x83\x16Ұ?\ad\xfbski\xbb\x97\xb2@&\x06O\xf1KV\xd49\xe6\x8d\xda\xd6\v\xb3{=\x1a@\xca\xc40.hՐ\x11!\xf4D\xfb\x94\x14\xf3\b$\x00S\b$\xb7\\8xV\xe7\x9e0\xca \xfa\xc7\r\x96\x11\xdc&\xc5\xcc\xfd#S\xc9\xf6\x05\xee\xc0\xa8\x1aG\x8f\xddX\xa6\x14;O\xd0%\x98\xf7T\xb24\xef{-R\xf0\xccڟFWX\xca8k\xc5\xd4\x18#\xf8\x9e\x89b=\n'\xd7\xef\x05\xab\xf4I\x1a\xfd\xf2|'\xf3\x05\xe2\xfc\xd7ԸȢ \xe5W\x91\xc1\xd3f\xac\x00\xe9\n\x12/\x0f\x80,;\xd9U@¤=d\xb2\xcdF\x1e\xed\x12[\x03#.d\x8a\xe9\x13\x19\x8ei\xa8vjkZF\xc1\xae7\xe0\x14Yaf@\xd7U%\x95q\xaf6\xcf\xf5\xf6q\xf4\x8d/Ǔ\x94\x9f\x97D\xed\xcf\xf4NkY \xb3~(\xec\xf1\xc4\xee\xb9l\xb0\xb5Z\x85t\b~\xc1\xac6\xd6\x1f\x1b^\xcc@\xce\x0f\aT(\fT'\xa6\x1d]\xe7DnZY\xd2\x15\xc4<\xfap0\x8fv\xa9\x10\xfb\xeçP'\xe9\x18\x92*\xfc\x10\xa2d\x96\xc91\x149\xbf\xe7y\xcd\n\xe0B\x1b&\b8)\xcb\x06\xaf\xf1|f\x97\xd1\bggp\x02\xe6ĉ\x9e\xf1\x91\x02A*(\xc9\xe5\x19\xbf\xaaW\xd1\x0f\x00LN{\xcfH\xffK\xa7\x19U]\xa0\xf6\x9fʭUk\xb5\xecz\x12t\xc3\x11\xe7\xad\x15l\x8f\x05h,03R\xc5ɱ\xc4\xe4t\xcb1Aň\ri\x15\x01M\xb5\x9d\xd8\fH \xc3\xf8p\xe2\xd9\xc99R$AV\xa1@.Q[=ʪ\xaa8OMr\x91\xf3\t\xaa4yѧ\xa8\xd71m\x83\xf4\\N\xdafdG\xc5\x12e\x1bq\x00#g`¿(a\xb9\x18J^2eoGC\x9fVhIV9\xea-\xdc\x1e\x00\xcbʜ\xd7\xc0M\xb8\xbb\x04\x91\x15E\xe7\xfb\xbfc\xc6\\.\xf1\xb7ÑO*\xf1\xb3\\Y\x82H\\i>\xff;d\x8a5\x16ｭHfț\xee\xa85\xf0CÐ|\r\a^x\x8f\xaaÙ\xafZ/OA\x8c\x14{GW\xc9Lvz\xfd\x85\x92LM^\v \x91.\xc3\xc1\xc0\xbb\x11S\xdf0/\xc0%G\xeb\x1f5WXR\xaek\v\x1fNػc\xa3\xab\x17o_a>'u\x89\x927\x9aȋ\x01\xb2\xddO\xfb\xb0'u\x1a\xde\xf5i\"H\x9bn\xd1k`\xf0\x19\xcf\xcec\xa1$V\x85\x8aч&b\xc9\xe1\xa5\xd0f\xaf\xac\x90}Ƴ\x05\xe3\xd3Q\x8b\xa3SE\xc1\xe7\x93\xf0\x9c\xf2ڀ\x80\x84\x13\xd7>\xcdFl\xa7\x1b47{+Y\x06\xbc\x92it\xd1\x12\xaf/R$\xe1\n\xb4\x7f\xc44\x1b\xb6\xb5Y0\xc7\xd8g\x94\xc2*lvF\x9fx\x95\x04\xd9\x1aN\x92,\xbbZBr\xf1\x13+x\xde\xe0\xe8\"\x89[\xb1^%\x01\x84\xb7\xd2܊5\xbc\xfeµ\xcfﾒ\xa8\xdfJc\xef|\x13r:\xc4\x1fAL7\xd0./\xe1\xd46ѡ\x9b\xa5L\x10n\xf7\xef\xf6`\xe5\xaca\x0fה1\x94*Ѓ\x1e\xfa\xcf\xcdۇ\xfeOYkCы\x90bcM\xe56\xf6%KZ\xbdJ\x80GYT\xd5\xe3\xc8\x18\xb5\xe6\xa3\ue0c9`?\x90\xe7e\xa7F\xf4TX\x15T\xaf\x80\xbc\xb6Ĵ\xb9_f\xf0\xc83(Q\x1dq\xb5\b\xd0\xfe\xabH\xbf\xa7\xa1\x90\xa8u\x1f%ai\xa6=\xfcx\xd5\x1d\xc9\xe0\f\xaf\r\xad܄\xb7\x02\xb3\x17_\x9dH\xf9~͌\xac\x89\xb5\xfe\xc7\"uY\x9e\xdbj\x1d+\xee.\xd0\xf8\x17\xf0\xa2\xb7z;\x88\x91\xc81(YE\xeb\xf7\x7f\xc9\xccY\x81\xfe?\xa8\x18W\tk\xf8\x85-\xbe\x15\xd8\x1b\xeb\xf3\x84\xdd\xcf\xd0\x17\xb8\x06\xe2\xef=+\xe2Y\xaf\xfe\x0f)X\x01XX\xaf\x82\xb0\x1bz,kx8I\x8d$\bp\xe0\x18MZ\xf7/\xae\xe1\xea3\x9e\xaf\xd6#=pu+\xae\x9c\x81\xbfX\xdd4ނ\x14\xc5\x19\xae\xecث\xafq\x82\x12%1\xe95\x8a\xc2v\xabD\xb1\xa004x\x024\xb0\xa9\xecQX\xb8]}\xa5\x1cVR\x9bdT\xee\xa466I\xd5wK/\xc9by\x19\xf2\xd9+`\aW[\x95*T\xcdH\xed\rR\xda\xc45=\xafa\x99\xead\xc4\x1cP\n\xac\xae\xda\x15\xec\xf2\xe0W\xae\x94F\xff\x0f,\xa3'\xf3\xa8\x12\xdcJ\xc9\f\xb5\x9e\x17\x91\x04m\xdd#\xe5\x98fM\x82\x90\xb9\x00\x86\x92wKI\xc9\xcb\x1dR\"\xd2\xd2;\x03T_\x7f\xe9d/\x99\xb0 \x16\x85\xefR\xbc\xe8\xa22#\x1b\xd6^\x93P\xbcq#\xc32\xf1\x80\xac\xe6`\xeaX\x93\xaeҫ\x04\xa0=\xe1\xfc\x1e\xcct\xc9ŭ\x95,x\xfe\xe4f\x1dBQ\x0e\x1f\xe3\xb8߄\xb1-ћ\x1bv\xf5&\x81\x04[\x9ay8\xa1\xc2\x1e\xe7\xc6ynr\x14\x13ARV\xb7\x93N \xb8\x95̟i8p\xa5\x9b@\xd2b\x9e\b\xb1^X\xfd\x8f\xe6\xb0\x14\xaf\x95zT\xe0\xf4\x9b\x1b\xd9L\x94҄\x0f\xa1\x82=Y.\x8e]\xb6(\x84\x94\x83\xe1\x06Pd\xb2\xa6\x0e\x0e\x1bC\xa0\xfd\x84c\x81S\xd0\xc9$KS\x10t\xa1\xa8\xcb4\x02l\xac\xd4q1\x9b\xa7i\xaf\r\xfc\xc2x\xf1-\xd8F\x8d?\xb26\xbb\x84W\al\xa3\x16-Y\x9bF\x9f\x92p\x96\xec\v/\xeb\x12XI\xa4O\x82\tdw\t\x8b>\xc7\xe1\x81qc\xcb>\x04\x97X@\xfa,\x93eU\xa0I#\x1a\xc9ÁjS\xb6\x14\x9acc\x98\xbd\x14H\x01\f\x0e\x8c\x17\xb5Z0J\x8f\xa2\xed%\xb1\x86W\x16\x8bo&\xban\xa9\x1f\xdfX\v\xb8z\x82/\xa6h\xebJ\xa5\xbb\x8aw\n\xd3ܳ\xa5\xa4\xb4W\xbaP)N\xb2$\x9f\xdaC\xf3\"\xc6\xc4\xf9\x87\x8b\xf6\xc3E\xfb\xe1\xa2\xfdp\xd1~\xb8h?\\\xb4\x1f.\xda\x0f\x17\xed\xf7\xe7\xa2-a\xe4\xf64\xac\x1e\x89EByz\x0e\xc5\x19\xf8\xbe\x9b\xe2\xc6\xedo\bnN\xc4N\xc6:)\x86\xa3\"M\x9a~\xe3\xc4\xc6\xee\xf9\x88I@\xf0\x9b\x9a\r\a{l\x9bZ)\x86\t\xe2m\x8b\x80\x03\x8fsu!\xa1\xe6\x1a*\xf9\xa8kg\xb7\xba\xb4ͧ\xdf\xc9۴لV^\x19>2\x02\x1c\xb6\x01h\x9b\x99\xec\xf6\x90\xf4\xfbu\xac\x03\x1d0ݮ\x92}\x9c٥\x9dD\xb4\x98d\x05D.\x14\x9b\xe4\xd6\xe79z\rB\x8f>\xc1Z\xa1\xfa\xae\xe8\xb5\xd0%3\xdd\x1b\xe3\xe8D\xfb!\xee\x9fo\xfbO\x8c\xf4\x9d2\xf0\xc0\xcdi\x04\x93\x9a\x95P\x00\x85W\xe2\xd8m{\r\xf2fd\x94\x8eTP\x15\xbc\xb0䜑\xd6\x1ey\xe17\x8b;+\xb6\x97\x92l>\xfc\x18\x16\x97b\xef\f\xa87\x1c2\xd7A\x13t\xb7\r>\xb6\xab\xa9B\xf0e%\xa3I\xc9\xfa\x8a\x1e\x99\xf9\xa6\x96K:c\x86}/\x93@\x97\xfbaR\"ǅޗGt\xbc\x84^\x96\x19\xa8\xb0\xd0\xe72\xbb\xc4\xc3\x15\xa8\x96\x8c~j'\xcbbC`b\xffJ\xbf3e\x1e\xe4\x05]+I\xc4Y\xeeP\xe9\x91&\xa5/\xc5\xf7\x81\xacR\xfa\x8c\x16\xbbQ\"}&\xab\v\xbb]|\xc3\xcfLw\xc9,\xc4X\xe7IzO\xc9,h\xdbo\xb2\xdcI2\xab\x87.\xe0\xf5\x9cY\v?\xcb>\xf0\xb4\xaaY\xec\x06Y\xf4\x91\xe7\xf1\xeb\xf4;\xc4ѻ\xa4\xcbc\x91b=\xb9O\xef\xe8h:6&\xbe{i\x1fG\xbfOc\x02hJ\xf7\xc6Dw\xc6\x04\xc4ٞ\x8dԞ\x8c\t\xd8\vfwVJf\x1eƷ\x9a.۷\xe2\x9f%Q\x8f\x9e\x18\xa7\xcc\xd0{#\x15;\xe2\x1b\x99u\x0f\x18\x98\x94ؿD\au|(\xcfC\xd2W\xceU\x97\x87\xcetG\xc0\xc1g\xd1G\x10\x1b\xbb\uedebr\r\x99\xac\xb8ݗ\aRdH-\xf4!+\x12qA&\xb5۷\xf1ߥ\xcaQ͆;\xa9<\x9fůǋ\xdf\x06\xdf\xec\xc4\xd8\x1d\xc2Y̺!Tl\xfdȦ\xbf>\x03ھ\xee\x16\x1du\x7fu\x9c.z`\xe3ն\x17\xbae|\x1c\xe8 l\xd3X1\xb2`9푴yb\xbd\x85״\x01\xb3\xf7\"\x9c\x98\xa6\fX\x19\xf5i\xaf\x9a\x98\xf7:\x8c\xa2;W[\x80_d\x93Vh \xea5h^Vř2\xc0p\xd5\x1f\xf28\x01\x88.\xa7J\xe6nw\xea\x1d3\xa7_lܥw\xf3\f\xbc\x8b\f\xf1~\xac%x\xc5\xccI\xdb\xe0\x8d\vꃘ\xd9\xc8\x1a\xf6\x02\xdbh\rs\xa8+\xa8\xc9`\xf8\xfd\xe1\x17\xac\x8f%\f=\x82:\x15ï\xc0q9\x84\b{\xdc\b\xbd\x89W&\xf6\xb6\xd9\x11\x16\x1b\xbf\x83ރ\"\xbf\x89Χ \xc55\x01\x0f:S\xa7V\x12\xe3N\x04\x00\xeaD\xbb\v\xa3\xb5a\xca\xe6ۉ8pu}5\xb1S;\xf8G\xe4\xff\xdfc\x88\xb9\x1dɨ\xc5]J\xb3\x06I\t3\xea\xc64\xd9\xc9\x1e1\"b\x8eL\x98me&\xfd\xbeI\xae'螤\x05\xb2\xa4'=\x1a\xe2R\xb6݊!ێ\x85\xdcw\x99\xd52e\xfdX2w\x19\xd9\xcb\x1aM\x02\xac\x1at\x1a\x99\xfe\xceI\xef\x17c\x12\xd1\xfd\xe9\x13$\xb9\xed\xe6Ćԝ\x83#\xe6\xe22\x9f\x82\xf2Q9E\xdeS\xdb\xe0\xc2y\x14\x93\xa0\xfc\xe7\xbek\n\xcfX\x88E\xf8Ӑ\x1b\xe3\xf2A1\xa1\x0f\xb1jo\\o\x87\xf7\xe1$\x8b\xdc\x1fl\x82\x86\x14\x93\x0e炌 \x91~SҘ\xa2\xcbd \x1f\x18\x8c\a\xa7}\x81\x8a\xeb\xe0fċ8\xa4[\xa5B\x7f@\r7[x!\xce-\x06\xc1a\xc9\xc15\xf8\xd1\x01%\x95\xc2\fs\x14Y\x8c\xfe\xf2ޞ\xee@\xfd\xe3\xf2\xd0\xf5r\xe83\xec\x88Px\ar{)\xed\xe7-M.\x1fD!Y\xfe\x86\x97\xdc\xfc\xca_V\x13r\xd6c«\xd1 \xe0\xfdja\x00\x1b\x85E\xa7\x12\x88\xfc\x81\xe7\xe6D\xb5\x8d_\xf9K\xa8\xac\xf9\xcd$9h/\xbc\a&\x0f\xf03\x94\xc8\x04\x19#(\xe8[\xf1\x05RrA%\xca\x1d\xfc\x1c}섏\x0eg:b,\xd2\xe6\xf2\x8e\x9a}\xb89\xdf\x14L\xa7\xcc\xff\xf6\xb7ވ0\xf9\xdb\xeb\xdf\xec9Qy]\x90}\xcc\bڴ\xb15\xa7\x81\xe8uՇ}\xe8N\xac\xf1p\xb8\x9e)\xf4OW\xa97\xf0\x12\xb5y}8H5^\xb5tm\xe06\x9f\b\xbe\x17\xf4\x8a\xe0\x19&\x10\xeb-\x15:<\x85n\xee>v)Ty*\x06\x91'rD\x01BC\xa4\xb5[p?\xc3O\x82\xdc\xe8\xe2\x0fd\xf1\x9e\xff\t~*\xe4\x03j\xd39j\xac{y\xb1\xdc\xc1\xf3?}\v\t\xaa\xab\x8b\x97\xd0\xc7\xc1\x90\xe1\x02r \xbf\xff\xe53c\x15\xc2\x190\xde\xd8\xeeV\xb3\x04y\xdf\x7f;RV\r\a=e\x85\xac\xf3\xf6\x84\x99\x11X q\xa2\x06\xbb\xbbO\xe4\x00\xa1=\xc0%k\x8f\v\xf2I\xe6P\xce\t\xa5\x9c\xf0\xf8\xe5ӗYu?\a\xb0D\x89\xfe۾\x1eb\xad{H$\x85\xb6\x87\xb0+\x86\xa5\xa6\x1f:\xddL\xc1\xbe4\x15h\xc22\xa6`fԀ1\xc5\xc2d>|x\xe3&@-\xbb\xdbW\xb5\xb2hl*\xa64\x125\xc3\xc4ܠ=\xfd\xefI>\x8c`\x02\x14\xd2\xcf\xf9\xe5\x10o\x85D\x12W9\xbf\b{\xb7\xc6P}\xa0\t\xceO\xe3c\xe7հT\tr\xd0\\\x01Ts$\x19Qw\x04\x924\xc5\xd0\xf3؆\x13Ѭ\xa3HQ\xbc\xe1Y$~\x8f\xab\xf8\x8d\x0f1/\x99\xb6s{\xeed\xc1\xb3\xf3´}\x88l_\x1d\xef\xc4\xc2\xde\xf35p\xb1\x8a\x9b9\xdf\x06Ԥ7\xd6\xfeH\x1f\x1foSoX+\x91ϴ\xc70\xb6\xb2\xfb\x01\x89\xff\xbe\x06\xde\x02\xa3:K֞l\xa7P<\xa3é\xe2n+\x84x\xa9ÔΙ\xa4Tg\x89\x9fWw\x91\x9c\xdd\xf7\xce\xf6\nKQ'\xd1~4j>#9\x02\t\x93p:\x87u\xdaH\xbe\xe3\xf3nW\xc9\x11\xc8̴\xa7\xdd\xfe\t\xa3A\x87\x85փ\xaf\xf4H\x12T\x1a\xbd\x16\x8e/\xf5\xfd\x9d\xb5\xb2\xa7t9\x10$\x9e\x8f<\xd3\xd0'^{G\xca\xce\xf3\xe9f<\xc2\x1e\x1c\xaa|TB\x8a\xaf=\x9c\xf0\x81\xe9&\xb9\x1bu\xe6Zp\xae\x85\xcen\xc1\xce(י\x03ޣ\x00)l\x87\x9b=\xff\x8aȡ\xb7\x1d\x14\xec\x98\b\xd4.\x14\xdfB\xe7TV\xb0$\x1e\xbdp *%I\xb5=\x91\U000d9781i\xb5\x1d\xad\xb3\b\x11\xc6\xcb\xd7%>w\x14p\xe1&\n4\xc9\xc6F\x85\r){\xaf\x17Xe\x9bR}A\xcan\xf6\tgE\xda\xd1P\xa2\xd6\xec\x18\xf2y\x0f\x14\xb8\x1dQP\x85.z<\x9c/^\xb6\xad\x87^)z\x81s\xfd\x13,3\xd4yb?\x10ZG:o=\x8b)\xb9B\x1e\xa9\xbfž\xeaOJ\xf5\x1e\xc4vu\x89w\x86_*\xaeR<\x8e\xd7͋D\x1b\xdb<c\xb5\x817\xb5\xb4O\xba\xe0GN暘}djώ\xb8\xc9\xe8\xa0\xe6,\x1e\x94~K^;\xd8\xd1\x13\x83GS\xfb\xa5\xfbn0a^\xd8\x1d\x9cp\x80\xf0\xda{\x82\xe3\xef\xd1U\xb2\xbf\xd3q=%\x17\xf4\x1f*\x13\xd82t\x18\xbc\xbd\x04\xff\x02\x8f\xac\xf8\xb3,\xf2\x05\xdc߄\xf7,ު\xb6;\u0558\x1bn\xd3\x1et\xdfW\xd2\xe5\xa2T\xb5\xfe.\xd0)\xb9ü\xc2\x1at\xb7+\n2F\x86sOa\xa8\xd5U1\x88\x8d(\x80\x17\x052\xcb\xc2\xf0\xc2w\xf8:\xcc\x15\x96\xf2~ڱ\x8c\xbb\xcc\xf6\xb8\xc5\x05\xfa\xdc\xd1;\x81\xa7]\v\xd0\xf8'S\xbe\xfc\x943\xf5\x16Ǯ\xa7\xdbK\x87\xb9mF\x89\x1d%M\xaf܊;%\x8f\xd4y\x15y\xf81hڹgw\x94Ug\x05}\xab\x8e\xe4\xd37A\xc5F8\xb1\x01?\xb88\xd3\xf0\xe8\x1b\x93\x0f^!\xe9mq\xbcD\x80+?\xd7%\xfe\xf8ׂ\xa3B\xb2\xe7\xd6\x1ci\x1a\xb6\xa7݀]\xa1m\xbb\xb8Gp\xdbon\xa9\xfb\aCw\x14\xef\xc3$\x0f\x06\xb5٠\xcdw\xb8j\xfbfC\xcb\xc69\x03\x11\xb8dK\xad\xeb\xed·&W\xb2\xe9Ji\xf5\x84\x8d'\x152M\v\x85\x1b(ٙ\xba[\xb8`YF1\r^k\xc3\n\xdc^\xaa\xe5\xe6\x93s\xd6\xeb\"\x19\xc6\xfcc\xc4\r\x19\x11\xfc\xb6\xfb~X\x18\xa2.\xf7\xa8hEXp\x8ervS\x85\xb3M\xc5y\x15\x81KY\x06D\x01\x0f\x8a\x1b\x83\xa2\xdf2\t\x86,@Q\x90\xca8\xb0Hеd\x99\xe82Ұ\xe2v:\xb3ݛه\xe6\xe50-;|<9Il\xd9[\x92E\xa1R\b\xe6ۑ\xfcXbevb\xe2HB\xa5d}<\x05\xb9\x9c\xb0\xec\x13p\U000da402\xaa\xa8\x8f<\x94\xf9\x15\x9aZ\x89N\x9b\x80oB\xcc;\xe8\xc6\x03E\xba\xea\xca7]\x85\xbfQp\xedKy\x1bʁm</l;\xc6\xdaW\xae\x15\x97\xe4\xfeR\xbed\x02h{Ĝ\x15\x83\xaa\xa2NY\xed\xf1I\xd8Q\xb8\xc4\xd6~\xb0\xf3\xf2l(h2\xacH`\U000a7261S,ߟ\xfb\x7f\xb0\xa0{\xc9\xc3\xf0\xa8b\x9fl\x95>V\xb7\x87\x1f+\xa43\x8bɕ>w\xcaf\x13 \x03\xa0\x96|FQQ\x8d\x06\x06\xed\xe4%\x85\xab6?\x15'c\xf0\x8f\xb80\xff\xf1\xefOEh\x9f\xa5\xc8\x1fG\xeb0:\x90\xfb\xeb\b\xed3\"\xb9\xd7\x11\xffb\xe4v\xb4zO\xa5\xf0&๘\xea1 >L\xf3\x02\x1f\xf3\x9b=\xba\xad2\xb6\x05y\x8a\xe2\x19'C\xde찲\xf2n5cȨ\xb4T\x9a\x82j\xd5g`\xdd<)\xa7=\xfb\x04\xbb\xb7\xe0`\x8c\t\xeee\x9b\x8b\xe3\xc5dn\x87\x8e%;\x91.\xee`'KPN\xae3/\n\xd8#\x11\xbb\x11s'\xd2\\\rA\x1aTۧͫS\xf7,\xcfX\xc4l\xf6\x88\xf0ο֓\xa86?Bt\xb0}g\xcdo^\x9c\xa2Y<\x8a\x18J\xdb!7\n\x1c\xf4\x05٢\xe8.s\x8b\xa6O\xea\xf0\t4τdp\xc8\"\x80\xa9\xf3\u009d/~\xb8\x10\xd7%\x1f\x8c\x8e\x817Tԟx:\x98\xd4\v\xff\xf2X\xd4(\xe1A\xb9\x9f\xea\xdcϹL@\x05\xdb+f}0\x8f\x00\xed6qn|`\x1c\x99k\x85F\xf1\x90\xc3s\x14\x92\x87\xa9\x9a#t³Pij1\f\x13%\xdc\x15\xfd\xf5\x80)\x15\xb0$\xbcɉ\xb4\b\x01#\x99\xa4\x91N\xec\x8a\xeb\x03\x9bZ\xb4\xe0\xfb*\xd7\xe0\x15\"q \xc8T\xc1\xb4\ty4?o\xd2~\xc4\x1e\x98*\x94\xa5\xea\xbe$\xed\xb7\xa8\xff ld}gÎ$\xe2\xf9\xe8э\b\"\xe8\u0096.\xcd2*\xf9\xf8\xf0\xde\xd3h\x02:\xf8\x9dׄʒ4\xcc\xccd\"\x9a\x7fTLO,\x9aB$\x1e\xd7\xcfE\xf7\t\x01\xfcr\x00\xbe\x10d'\x12\xc9+\xab\x90\xa1O\"W\xa4V8\xac\xd1D\xeb\x80\x13\xb0a\xaa1\xf9ќ\x7f`JL\x1a\xed\xc1d\xfe۽\xebo\xeeQ\xf7ܘ\x0ef6v\xe1Z<3\xab(T\x80QU\x97\xa4f\r\xba\xa66C\x1d\xab6\xb5>\xc0D\xc7O\xdb\xf7\xc33?\xd8n\x1a\xe0\x04\x92\xfe\x8c\x1a%\x1b\x88\x87\xad\x7f\xdb|B>\x88y\x1b\x94@˹\x9d&\x1bЋ\xfc\x9d\xf1(\x16\x15\xd6t\xff\x96+\xbe~$\xab\xb2[\xcdr\xf7]\xfbf\x10S\xab\x90\x9dE\n\x87\x98w\x89f\x9d\x9f\xd8z\x9cHaZ6\x14\x92b\xff\xa7\xcaf\xfaZ\x899\xe1?9ǭ\x17B\x8b\x1ei\x17B\x886\x93E\xd5'\x1f.Ĥ\xf0\xbd\xefbw=67ÿ\xadH\xfd\xe6\"\xfc1A\xd7d\xe2\x12'\xb4\xaf\xa5鈋\x01\x1e\x95\x93zţ>\xfa\xfa\x9fJ\xe7\xfb&\xab\xfb:\xa5\x82\xf4i\xf0\xfa`37ՒZ\x88\xbe\xea3\x82\b\xf0\x13%\xf2\xa9o4#\xac\xffp\x81\a=3\x95\xafXž\x8a\xb10\xf9g\xb3e\x14[!i\xea!\xf0\x8a\xf6\x82f\x94\xeb\x8aM\xe3\xae@\xca\xddk\xc4~\x85\xe6\xd9\x04\xd2qOs\x10\"\xbe\b~\xf2nuIx\xd8\f\x9b\xca3y\xff0\xaa5F\xc6c\xd0>\xba\xfd\x9a\t5\x1e\xc7e\x13j\x86MMH\xd7\x19\x9d\xebz\xa8\xe3\xc9ߦ>\xfdĳ\xf3\xbe\xc0\xd2\x1a\xf3n@\xacN\xeb!D*\xb5#\x90\xd0\xd6nCB\x7f\"\x9f\xbb\xed\x16j\x03\x8e\x13\x9b\x1a\x06\xc5\xdb'*\xd5FM\xf3\xe8\xa6U\xa0ygm\xfb/\xf9;m\xf7\x04\xcb2\xac\x8c?\xa4\xa3\xfb\xf7l\xaf\xaez\x7f\xb2\xd6\xfe\x9aI\xe1\x92\xd3z\a\x7f\xfd\x1b\xfd\xa5Z\xd2\xe2\xb9_\x8fz\a\x7f\xfd\xdb\xea\xff\a\x00\xcfCu\xfe\xfbw\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xccZ[\x93㶱~\xe7\xaf\xe8Z?\x8c]5\xa2v\x8f_\x8e\xf9rjv\xd6'\xd9\xf2\xacgjg=yp\\e\bh\x8a\xf0\x80\x00\r\x80\xd2*\xa9\xfc\xf7T\xe3\"Q\"u\x19\xc7N2T\xd5.\t\xa0\xd1\xfd\xf5\x05\xdd\x00\x8a\xd9lV\xb0N>\xa1u\xd2\xe8\nX'\xf1\xb3GMo\xae|\xfe_WJ3_\xbd)\x9e\xa5\x16\x15\xdc\xf6Λ\xf6#:\xd3[\x8eﰖZzitѢg\x82yV\x15\x00Lk\xe3\x19}v\xf4\n\xc0\x8d\xf6\xd6(\x85v\xb6D]>\xf7\v\\\xf4R\t\xb4\x81x\x9ez\xf5\xba\xfc\xba|]\x00p\x8ba\xf8'٢\xf3\xac\xed*нR\x05\x80f-V\xb0`\xfc\xb9\xef\x9c7\x96-Q\x19\x1e:\xbbr\x85\n\xad)\xa5)\\\x87\x9c\xa6^Z\xd3w\x15\xec\x1a\"\x85\xc4V\x14\xe9m \xf6\x18\x89\xdd%b\xa1]I\xe7\xbf;\xde\xe7N:\x1f\xfau\xaa\xb7L\x1dc+tq\x8d\xb1\xfe\xfb\xdd\xd43X8\x92\a\xc0I\xbd\xec\x15\xb3G\x86\x17\x00\x8e\x9b\x0e+\b\xa3;\xc6Q\x14\x00\t\xb3 \xc8\f\x98\x10A\vL=X\xa9=\xda[\xa3\xfa6\xa3?\x03\x81\x8e[\xd9Q\x97,\v$a K\x03\xce3\xdf;p=o\x809\xb8Y1\xa9\xd8B\xe1\xfc\a\xcd\xf2\xff\x03\xc7\x00\xbf8\xa3\x1f\x98o*(㨲k\x98˭\x84p\x05\x0f\x83/~C\x028o\xa5^N\xb1tǜ\x7fbJ\x8a\xad\xd6A:\xf0\r\x82b\u0383\xa7\x0f\xf4\x16\x11\x02\x82\b!#\x04k\xe6\xd2<\x00\xabH\x05\xc5QN\xd5h\xae\xd45\xb2M\xac\xc0\xd3\x01\x95\xc8?}I\xdc\x0f\xc8f\xc3/GF\xbbG\xf7f\x89ǈ\xedA\xf1\x0ek\xd6+?\x14\x95-w\xc2N\x88\xd5!/E\x1c\x95Z\xa3$\xef\xf6\xbe\xc5Y\x17\xc6(d\xba\xd8\xf5Z\xbd\t/\x8e7\xd8\x06\xe7\xa57ӡ\xbeyx\xff\xf4\xf5\xe3\xdeg\x982\xa4\x03\xa7 ű\x81n\x1a\xb4\bO\xc1\xff\xa2\xde\\\x12mK\x13\xc0,~A\xeewJ\xec\xac\xe9\xd0z\x99\x9d%>\x83 5\xf8z\xc0\xd3\x15\xb1\x1d{\x81\xa0\xe8\x84ю\x92\xbf\xa0H\x92\x82\xa9\xc17ҁ\xc5\u03a2C\xed\x87\xf0\xe6\xc7\xd4\xc0tb\xaf\x84G\xb4D\x06\\cz%(\xa8\xad\xd0z\xb0\xc8\xcdR˿mi;\xf0&\x19\xaf\xc7\x14\"vO\xf0O\xcd\x14\x99j\x8f\xd7\xc0\xb4\x80\x96m\xc0\"\x81\x00\xbd\x1e\xd0\v]\\\t\x1f\xc8ޥ\xaeM\x05\x8d\xf7\x9d\xab\xe6\xf3\xa5\xf498sӶ\xbd\x96~3\x0fqV.zo\xac\x9b\v\\\xa1\x9a;\xb9\x9c1\xcb\x1b\xe9\x91\xfb\xde\xe2\x9cur\x16X\xd7$\xb0+[\xf1\x85M\xe1\xdc]\xed\xf1:\xf2\xda\xf8\vQ\xf3\x84\x06(bF+\x88C\xa3\xa0;\xa0\xa5^\x06t>~\xfb\xf8\t\xf2\xd4A\x19{D\xb3Y\xec\x06\xba\x9d\n\b0\xa9k\xb4a\x1c\xd4ִ\x81&j\xd1\x19\xa9}x\xe1J\xa2>\x84\xdf\xf5\x8bVz\xd2\xfb\xaf=:O\xba*\xe16\xacX\xb0@\xe8;rLQ\xc2{\r\xb7\xacEu\xcb\x1c\xfe\xe1\n \xa4\u074c\x80\xbdL\x05\xc3\xc5v\xf7GT\xaa\x84ڠ!\xaf\x85G\xf45\xe9ŏ\x1d\xf2=\xff\x11\xe8\xa4%\v\xf7\xcc#9\x0fۣ\b\xd9\xc5'\xa9\xedu\x9dvnz\x18\xe7\xe8\xdc\a#\xf0\xb0\xe5\x80\xe5\x9bm\xc7=\x1e;\xb4\xadt\xe4\xfa\x0ejc\x0fW\f\xb6\x8d\xc0\xc3'G\xaarԆ\xbaoǌ\xcc\xe0#2q\xaf\xd5\xe6H\xd3_\xacL\x91\xfd\x02E\xd2/\xb2\xf8\xb8\xd1\xfc\x01\xad4\xe2\x8c\xf0o\x0f\xbao!h\xcc\x1a\xea`\xd6ګ\r\xc5 \xb7\xd1<\x91\x1f\xd1\x04\xb8yx\x9f\x8c%9P\xf2\xb7\x84U\t7\xc9sM\r\xafAHG\t\x80\vD\xc7`QzF\xed\x15xۿH|nt-\x97c\xa1\x879\xcd1\x8b9C\xfa\x00\xb9\xdb0\x13\x85&\xb2\x8eΚ\x95\x14hg\xe4\x1f\xb2\x96\x9c\x02z-\x97\xbd\r6\v\xb5D%\xdcX\xd2#^F?nQ\xa0\xf6\x92\xa9\xea\f'ێ4\xa9gR\xc7UjG \x04\x1bۦ%U{\xd4b\x9b\x8d\f\x1foB\xd4r(`-}\x13\xc3a\xb6\xe9Q\xff\xe3\xbeG\xcf3n\xa6>\x1f\xf0\xfe\xa9Ax\xc6\r\xc5\x00b\xd9!\xb7胵\xa1\xa2\x05\x8cL\xa9\x04\xf8\xd0;O\xac\x1dƉ\xfc\x17\x12\xb5<\xfa\x197c\xa0\xcf*7\xa50\xe7Y\xbe\xa2\xd493l\xb1F\x8b\xdaO\x06u\xaaL\xacF\x8f\xa1\xea\x11\x86;ZS9v\xde\xcd\xcd\n\xedJ\xe2z\xbe6\xf6Y\xea\xe5\x8c\x00\x9f%\x0f\x9a\x13+n\xfeE\xf8g\x92#\x80O\xf7\xef\xee+\xb8\x11\x02\x8co\xd0B\xef\xb0\xeeU6\xb4A~s\r\xb4\x14\\C/\xc5\xff]\x15\x13\x94\xce\xe1b\x82\xae\x98\xba\x00\x1b\x8a\xf4\xb2\xde\xc0\xba\xc1\xc0\x14A\xf4\x18\xb5b,\xd0JI\xcan\x936c\xac\x11't5\xcc0\x87\x7f\x14\x98h\x05\x19\xb34#sz\x89\x9b\xa5d\xb7*N\n\x96\x13i\xa9\x85\xe4̣\xdb\xf7\x8d\\`$b\xc7\xc3d\n\x87ہe\xf1\x12\xc1[\xf6\xf9\xd6h\xde[2\xb9\a#\x9e\xa80\xc3\x18\xc3\xdd\x19\t>\x9c\x1a\x9b\xf9o\xd9g\xd9\xf6-\xe8\xbe]\xa0\x05S\x8fh\x12\xf6\xceK\x0e\x9d\x11\xb0\n4\x92\xb4)Q\x1d\xa2\xe2\x1b\xe6c:\xdak`1\x8frl[#\r\x9fP\x951n\x8ds\xc0\x94\x02m\x04\xba\x12\x1eƳ,pc\xb4H3\xc9Vz`\x16\xe1\xd7\x1e\xfbIS굗*\xfa\x88\x03n\xdaN\xa1?\\\x8eZdځ6\x91\xdeX'\xad\xd4\x04K\x05\xafGMQ]\x94\x86/\xd1\x1e\xb4Fo\xbe3\xfc\xf9\x8cn\xee\xb7\x1d\xa11J\xb8\x14\v\xbd\x97z\xe9ȳ\x05a\xab\xa8\x9dZR\x94\x18ф\x1c\x952Tdm(@\xea}\xbd\\\x83\xa3\x92\"*d\x03\x9c\xe9\xab䏄\xcd\x14\x88\xc6\x02\x05\xac\xb5\x95ޣN\x98\xfa\x06\xa5\x05\x8b\x9e\x16\x19\xa3\x01\xb5pe\x88\xe8y\xa2+7\xeds\xd9\x13\x10:\xd5/\xa5\x8e\x11\xc1\xf5]g\xac'6)\"f1cQC\xb1c\xd1\xf3g\xf4\xb1s\xc3VSf\x94\xb2\x8eL\x025\xa5\x11\xe2\xc5\x19\xc6\xe9\xa5Mᒩ?\x1b5\x11~F\xaa\xbd\xcb}\xa1S\x8c#\x152axP4\x18=T\xe85\xac\x1bɛI\xa2\xb4\xa0b\x17,\xa3\x8d\t\xd6\x02I¤\xb3\xa4\x12鯨\x18i\xcd\n\xc55X\\2+\x14:WLRL\xe62T\xe2\x18\xa9s1\x89\x9ev2\xcb\x1eaA\xc9x\x8e4;\xb3\xa1\xc1\x89\x93\fD\t\x7f\"s\xd3L\xf3)%ӳ\x1bϙ&\xe3\r{b\xa8Q\x80\xb1\x19\x02Xl\xa0w\xe4\xf8\xb4\xbaB\xc8͘:Bq\x90\xf0G\x93\xbb\xa5`!\x89\x87\xfdٮ\xfc\xb1\xf9\x8eP^l\x80\xe9\x8d\xd1X\xe6m\x94\x10+w2N\xa3>]2\xd03;\x87\xcfl\xc0\xfcoY\xf2\xb7\xf2\x1e+\"F\xba\xfd\xb8?\x82\xd4L%\x842z9\xd4l\x88\xd5\x16)I=\n\x17\xe5Ӭ\xf61}\xd8\\Y\xaa\xa3\x95abʏ/\xf0\xe5\xb3\xe2\x9eH\x0e\xe2\xc7T\x80V\xc5I\x04\xee\x87}s\xb1\n\xa9\x1e\xc0\x83\x98\xae\x91\x8aNfǩJ\xc8¹њ\xd2_o\x80mk\x8bm4\xcdYD\xf9¸\x15\xe3\xe7\x05\xca|\x1b:fWMa\xd7\x1b\xf2\xa5P\v\x9fc\xe3,\xe4\x00\x9cݢ\xbd\x84\x97\xdb\x1b긭K\x19\xdc\xde\xc0\xa2\xd7Ba\xe6hݠ\xa6-lYo\xa6\xe7\xa2\xe7\xd3\xddcF5\x94\xf4iS-c;-C,\x9a*Xl<\xfe\x16!;\x8b\xb5\xfc|\x81\x90\x0f\xa1c\x06\xbcc\xbe\x01\xa9\x9d\x14\bl\x02\xfe\xb8;2I\x15\xb6J\x81\xfb\x94\xb6\xff\x06\xf5\x9cJ\xaf#;/q\xa2.'\x9b\x9f,ӮF[\x15\xa7\xc18\xec\x7f\")\x1aQ\x02\xf0\x8d5\xde+\x1cf\xa8\xb4\xbd\x05>\x91\v\xc1\x97\x02|\xda\xe8\x1bdFSX=\xe6)\xb3+\x87e\x85\xa5$\v<{F\xe8,r*\xb69\xfeΩ\x860kM\xb1\xef\x8e\x12\xd3\xef\xe4\xdb\xce]`M\xefF\x83\x0e\xf3\xfbLv\x92\x16m\"i\xb1\x96\"X!|'\xdfB\x87\x96\xaak\xa3\xc5\v3\xe73\xd9\xf3\xb9\f\x9a\x1ei\x1e\xac4V\xfaͭb\xee\x12\xf9\xdf\xdf\xef\x8d\xc8¿\x9f߇=u\xd1+ʜ8Q\x9b.q\xe8\xf1́ٔ\xf0\xbe\x06l;\xbf\xb9ޫ\xf5x\x9e\x83L\xf2\xe5+\xf9[t\xfeۺ6v\xecS\xf4\xcc\xe0\xbd؞\x94\xbd\xc0\x85\x01\xb4\xe4\x97$e\xdfK\xbeM\xcan\x1f~\x18\"\xd4%\x14svFpL\x12\x84-H\xd717}\r_j\x8a\x9d\xea+\xf2\xb57\xdf\xc0\x97ʬ\xd1\xf9\xaf\x8eXH,;+x\xf3\xcd\x1faA}\xb7\xef\r\x17\xa0\xf2\xc3\xc1\x90C\a\x8a$\xff\xfb\xdd\xe7TXNK_U\x9cD\xe2!u\xcb\b\xe4a\xd9(\xf6\xf7\xc4\xcb\xe2\x05V\x9a\x8eW\xa5\xd1\xffO+\x0ej\xbe9\xc3\xcc\xd3xĉ\x1d\xeb||;\xa2\x19\xbd\x9b\x1bk\xd1uF\x8b]\xb5\xb9[>\xa7\xf7\xabw,\xbf8\xd0\x1f\x05bz\xb5\x9d\x81\x19&\x94\amY\v\xc5\x05ʎG\xd5Uq\x14\xd5\xc9c\x96\xc70j\x8b.\x01f\x16\x0e\xedjpn\xb3G\x12\xfe=\xc75\xaf\x06\xe75t.H\xbb\x11\x14y\xe3\xceg\t\x7f\xd5\xf0\x8e\xce\xf8h\x97NT\xa4h;\xd6\x05\x905k\xb3\xa6\xe1\x03z\x81D.\xcai/3ԁa\xef$6\xad\xa5RT\xfc\x1d\xaf\xf7\xa8\b\xb2\xa86t\xe9\xc1\u0530\xfa\x9f\xf2u\xf9\xaa\xb8lA\xf8\xfdO\x83\xe8z\x02\x1d\xee\xa0\xf8\x88+9>\xed\x1e\xa3{7\x1a\x91\x1d\x7f\xeb\x0e\xf4\xf2s>4\x9c\xdb\xd4\xed\xe7\x11a\x80Z*:i\x9e\x88\x13یk\xe2^\xc6\xdbǻ+ڪ\xa3s\x8a\xc9]\xae5\xdd\x02\xa0\x93\xa3\xb0\xb7\x952y\xaez\xe7\xd1N\x18\xc0V{A\xe7\xa1$\x9d\x88\x94\x90Oki\xff \x1a\x94\xb1 \x90\x0eZ)>\xf0\x86\xe9%n\v\x87\xcc\xffiN\x99\x1e\xd9\xcc\xceB\xa4>f\x1e\x17i\x94n\x86\x9c\xd1\xe6N\x99\xc7o\xc1d\xee\xb3f\xb3`/Ž8V<\x11\xa83\xbf\xbb\x19\xf3\xaf\a\xcch\u05fb\xb5\xe0B$\xf6\aL\xa31\xb0\xd2S\xe7\xbbtKhw;\xe8?\x87C\xb8(uF\xf4pu*K\x9b\xf6\xffw'\xef\xf4q2n\x97\x17\a\xad\xedݮ\x89\xb6\xf1m\xaf\v\xe4\x9a\\\xc7F\x1f\xe3Z4\xc0,\x85\x96\xe1\x97~\xb1\xbd\x8dR\x15{\xab!\xfc\xfd\x1f\xc5na\xa4\xcb\x02\x9dG1\xb8SG\x87f\x15\xbcz\xb5w'/\xbcR\x1e\x17.ȹ\n~\xfc\xa9H[\xd9\"\x1d\xb7\xb9\n~\xfc\xa9\xf8\xe7\x00F\te8\t)\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VMo\xdc6\x13\xbe\xef\xaf\x18\xe4=\xf8\xf2J\x9b \x97B\xb7\xd6\tРi`\xd8n.A\x0e\\rv55E\xb2\xc3\xe1\xba\xdb__\f%e?\x1d\xbb\x05\xbaڋș\x87\xcf<\xf3!.\x9a\xa6Y\x98D\x9f\x913\xc5ЁI\x84\x7f\n\x06}\xcb\xed\xc3\x0f\xb9\xa5\xb8ܾY<Pp\x1d\\\x97,q\xb8\xc5\x1c\v[|\x87k\n$\x14\xc3b@1Έ\xe9\x16\x00&\x84(F\x97\xb3\xbe\x02\xd8\x18\x84\xa3\xf7\xc8\xcd\x06C\xfbPV\xb8*\xe4\x1dr\x05\x9f\x8f\u07ben߶\xaf\x17\x00\x96\xb1\xba\xdfӀY̐:\b\xc5\xfb\x05@0\x03v\xe0У\xe0\xca؇\x92\x18\xff(\x98%\xb7[\xf4ȱ\xa5\xb8\xc8\t\xad\x1e\xbc\xe1XR\a\xfb\x8d\xd1\x7f\"5\x06\xf4\xaeB\xfdT\xa1nG\xa8\xba\xeb)\xcb/OY|\xa4\xc9*\xf9\xc2\xc6_&T\r2\x85M\xf1\x86/\x9a,\x00\xb2\x8d\t;\xf8d\x06\xcc\xc9Xt\v\x80I\x8fJ\xb3\x99\"\u07be\x19\xe1l\x8fC\xd5X\xdfb\xc2\xf0\xe3͇\xcfo\uf396\x01\x1cf˔T\u008b\xfc\x812\x18\x98X\x80ĉ\x1cĀ\x10\x19\x86\xc8\b#\xd3\xdc~\x03M\x1c\x13\xb2Ь\xdf\xf8\x1c\x94\xce\xc1\xea\t\x85+e9Z\x81Ӛ\xc1\f\xd2\xe3\x1c)\xba)0\x88k\x90\x9e20&ƌa\xac\xa2#`P#\x13 \xae~G+-\xdc!+\f\xe4>\x16\xef\xb4Զ\xc8\x02\x8c6n\x02\xfd\xf5\r;k\x9cz\xa872'y\xff\xa3 \xc8\xc1x\xd8\x1a_\xf0\xff`\x82\x83\xc1\xec\x80QO\x81\x12\x0e\xf0\xaaIn\xe1W\x95\x89\xc2:vЋ\xa4\xdc-\x97\x1b\x92\xb9el\x1c\x86\x12Hv\xcbZ\xfd\xb4*\x129/\x1dn\xd1/3m\x1aö'A+\x85qi\x125\x95zЀs;\xb8\xff\xf1\xd4d\xf9ꈫ\xec\xb4`\xb20\x85\xcd\xc1F\xad\xe6\xefd@kyL\xfb\xe8:\x06\xba\x17\x9a¦\xa6\xe4\xf6\xfd\xdd=\xccG\xd7d\x1c\x81¤\xfb\xde1\xefS\xa0\x82QX#W?Xs\x1c*&\x06\x97\"\x05\xa9/\xd6\x13\x86S\xf9sY\r$y.I\xcdU\v\xd7u\x8e\xc0\n\xa1$g\x04]\v\x1f\x02\\\x9b\x01\xfd\xb5\xc9\xf8\x9f'@\x95\u038d\n\xfb\xb2\x14\x1c\x8e\xc0\xfdOQ\xbaI\xb5\x83\x8dyF=\x91\xaf\vM{\x97\xd0j\x06UD\xf5\xa65\xd9\xda\x1e\xb0\x8e\f\x8f=\xd9~n\xda#\\\xd87\xf8\xbe\x99\x9fnh}F\x18\x1dJ\xa7;O\x06\x0f5w\xc4xR\x85\xcd\x01؋t\x11#%\xffCe\xaaϬ\x8d-\xcc\x18dB\xaa\xd3\xe2\x92\xd3K\xb5@\xe6\xc8g\xab'\xa4\xdeW#\x1d>b(d0a79\x82\xf4F\xe0\x11\x19\x01\x83\x8dE\xe7\f:p\xe5L\xbfI\x96\x1e\xc7i\xac\x89M\x1c-\xe6\x83\x19<?$8\\\xe0\xf4\x9d\xec\xe8_\xbf\xa1f\xe5\xb1\x03\xe1\x82gۣ\xafa6\xbb\x93=\x8f\x1b\xe3\x7f\x8e\xde=#\xc3\xc7ٮ\xe6\x82\v\x02\xe9@\x9f?&Wy\xcaw\x06\x9a\a8d\x89l6\xe7l\x00\f#\xf8h\x1f\xd0\xc1j\afd\x01}\xf4\xae\x85\xfbC\x99\xea\aC\x98\xd0AB\xa6\xe8\xc8\x1a\xefO\x83Ч\x04!\x0f$W\xea1\xc4-\xbasmG\x19V1z4\xa7\x9f\x9e\x91\xceo\x8a\xf2\x9c\x14{˹0\x85\x06\x9c\x18\x8c\xddzI\x993T8\xd7\xea@\x99\xa7\x95\x90\x1e\xc3yp\xebȃ\x91\x0et\x9e6J\xe8\xdf\x15\xc9\xc5\x02K\xbd\xc9\xf8\x8c,7js\xa9SQ\x1bU\x17\x9fmU\xfdc(\xc3\xf9I\r|\xc2\xc7\v\xab\x1f\xc2\r\xc7\rc>W\xb7\x81\x9b\xb1\xc7\xea\xcd녡^\x1c]g\x8bY/&\xee@\xc6){\xd3\xca~\xd0\x19k1\t\xbaO\xa7w\xd3W\xaf\x8e.\x99\xf5\xd5\xc6\xe0\xea\x8d;w\xf0\xe5\xab\xde %2\xba\xe9v\x95;\xf8\xf2u\xf1\xf7\x00\r\xf1}1\xd4\v\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WMs\xdc6\x0f\xbe\xebW`\xf2\x1e\xf2v&\xd2&\x93KG\xb7\xd6\xc9!S7\x93Y'\xbedr\xe0\x92X\x895E\xb2\x04\xb8\x8e\xdb\xe9\x7f\uf012\xf6{m\xe7\xd0e\x0e\x11\x01\xe2\xe3\xc1\x03\x90\xae꺮T\xb4\xb7\x98\xc8\x06߂\x8a\x16\xbf3z\xf9\xa2\xe6\xeegjlXl\xdeTw֛\x16\xae2q\x18\x96H!'\x8d\xefpm\xbde\x1b|5 +\xa3X\xb5\x15\x80\xf2>\xb0\x92m\x92O\x00\x1d<\xa7\xe0\x1c\xa6\xbaC\xdf\xdc\xe5\x15\xae\xb2u\x06S1>\xbb\u07bcn\xde6\xaf+\x00\x9d\xb0\x1c\xffl\a$VCl\xc1g\xe7*\x00\xaf\x06l\xc1\x84{\xef\x822\t\xff\xccHL\xcd\x06\x1d\xa6\xd0\xd8PQD-N\xbb\x14rla'\x18\xcfN\x01\x8dɼ\x9b\xcc,G3E\xe2,\xf1o\xe7\xa4\xd7v҈.'\xe5N\x83(B\xb2\xbe\xcbN\xa5\x13q\x05@:Dl\xe1\xa3\x1a\x90\xa2\xd2h*\x80)\xf7\x12V=e\xb7y3\x9a\xd2=\x0e\x05O\xf9\n\x11\xfd/\x9f>ܾ\xbd9\xd8\x060H:\xd9(p\x9d\xc4\f\x96@\xc1\x14\x01p\xd8\x06\x05ʃJl\xd7J3\xacS\x18`\xa5\xf4]\x8e[\xab\x00a\xf5\aj\x06\xe2\x90T\x87\xaf\x80\xb2\xeeA\x89\xbdQ\x15\\\xe8`m\x1d6\xdbC1\x85\x88\x89\xed\x8c\xf2\xb8\xf6ȵ\xb7{\x14\xf8K\xc9m\xd4\x02#\xacB\x02\xeeq\xc6\a\xcd\x04\a\x845po\t\x12Ƅ\x84~\xe4فa\x10%\xe5\xa7\f\x1a\xb8\xc1$f\x80\xfa\x90\x9d\x112n01$ԡ\xf3\xf6\xaf\xadm\x12\x84ĩS<\xd3a\xf7\xb3\x9e1y\xe5`\xa3\\\xc6W\xa0\xbc\x81A=@\u0082S\xf6{\xf6\x8a\n5\xf0{H\b֯C\v=s\xa4v\xb1\xe8,\xcfM\xa5\xc30do\xf9aQ\xfaî2\x87D\v\x83\x1bt\v\xb2]\xad\x92\xee-\xa3\xe6\x9cp\xa1\xa2\xadK\xe8^\x12\xa6f0\xffKS\x1b\xd2˃X\xf9AhF\x9c\xac\xef\xf6\x04\x85\xf3\x8fT@X?\x12f<:&\xba\x03\xda\xfa\xae\x94d\xf9\xfe\xe63̮K1\x0e\x8cn\x99\xb3=H\xbb\x12\b`֯1\x95s#\xf3\xc4&z\x13\x83\xf5\\\x1chg\xd1\x1f\xc3Oy5X\xa6\x99\xccR\xab\x06\xaeʤ\x81\x15B\x8eF1\x9a\x06>x\xb8R\x03\xba+E\xf8\x9f\x17@\x90\xa6Z\x80}^\t\xf6\x87\xe4\xee'V\xda\t\xb5=\xc1<\xc9.\xd4\xeb\xa8\xd5o\"j\xa9\x9e\x00('\xed\xda\xea\xd2\x1a\xb0\x0e\tԮ\xf3'\x00w]{\xb9se\xb1J\x1d\xf2\xf1\xeeQ,\x9f\x8b\x92\xb8\xbf\xef\xd5\xe1\xa0\xf9?6]#\xb3\x82\xa6@\xc6\xe9\xf1ӡ\xff\xc7c8\xcf\u07b3\x91\xcc$\x16\x18\x04W\x19\x052\xa4\xf6c:u-\v}\x1e\xce;\xa8\xe1\xd7\x12\xf3u\xe8\xaa\x13\xe1\x9e\xfc*x\x16\xba?\xaat\x1b\\\x1e\xf0ƫH}xBw\xbef\xb7W\xcf\xf1\xaaa\x892\xa0\xf1rh\x93\xc2\x12)\xbb\v\xee.\x90u^\xe5Rz\x1ay\xb9\xd6f\xe4\xe5\x88 /\xff\x97\xcb>yd\xa4\xddи\xb7ܟ\xb5\bp\xdf[ݗ1P\xca&\xf3\x88(h[\xba\xfb\xc7\xc3\x17\xb6ۄg\xa8S\x17J\x9dٖ\xe0O\xb6/\xf4\xe8%\a\xf5\xd47\xd53l\x10+\xceG\x9c\x7f\xb4Ӌ\xfe\f\xb5\xce)\xa1\xe7Ɋ\x80\xae\x8e\x0f4\xd5\xf3\xdal\xee\x8f/\xcb\xeb\xb6z\xb4ֳ\x83/\xcbk\xb9NYY?F\x13\x13\xd6d;\x8f\x06D&\x1d/\xdbg\xc0\x18\xff\x1d\xbe\x1f\x9eQQ\xfc\x1em*s\xed\x89\x10\xdfo\x15\x05\xa9\xfb\x1e\xfdx\xe5\x1ca3\x1aD*\u05f9V\xc7\x0f\tY+\x04\x83\x0e\x19\r\xac\x1eJ\x96\xf4@\x8c\xc3i\xdc\xeb\x90\x06\xc5-\xc8UT\xb3=C#yŪ\x95\xc3\x168e\xfc\x91\xc4c\xaf\b\x9f\xc8\xf9\x93\xe8\x9c#ƶ\x19\x8f\xb2o\xaa\xe7M\xc1\x1a>\xe2\xfd\x99\xddO)h$B\xf3\xfcL\xce6\xc1\xc9&ɓ\xcd\xec\xa14=C\xf7w\xf2j\x9e'[&O\xad\x04\x7f\xffS\xed\xbaJi\x8d\x91\xd1|<~\xfe\xbfxq\xf0\x9e/\x9f:xS\xfe\xa0\xa1\x16\xbe~\x93G\xbb\x8cW3=M\xa9\x85\xafߪ\x7f\a\x00j\x11\xef\x043\r\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4XAo\x1b7\x13\xbd\xebW\f\xfc\x1d|\xb1\xd6\tr\xf9\xa0[\xe06\x80\xd1$0\xec \x97 \aj9Ҳ\xe2\x92,g(E-\xfaߋ\xe1R\xd2Jڵ\xe5\x06\xcd\xe6\xe0%\x87\x8f3\x8f3\x8f\xa3\x9dN\xa7\x13\x15\xccW\x8cd\xbc\x9b\x81\n\x06\x7f0:y\xa3j\xf5\x7f\xaa\x8c\xbf]\xbf\x9d\xac\x8c\xd33\xb8Kľ}D\xf2)\xd6\xf8\v.\x8c3l\xbc\x9b\xb4\xc8J+V\xb3\t\x80rγ\x92a\x92W\x80\xda;\x8e\xdeZ\x8c\xd3%\xbaj\x95\xe68O\xc6j\x8c\x19|\xb7\xf5\xfaM\xf5\xaez3\x01\xa8#\xe6\xe5_L\x8bĪ\r3p\xc9\xda\t\x80S-\xce ش4.\xe2\xd2\x10\xc7lI\xd5\x1a-F_\x19?\xa1\x80\xb5컌>\x85\x19\x1c&\xba\xe5ŧ.\x9e\x87\x8c\xf4\xd8Cʓ\xd6\x10\xff6b\xf0\xd1\x10g\xa3`STvЛ<O\x8d\x8f\xfc\xf9\xb0\xe3\x14B\xec&\x8c[&\xab\xe2\xd0\xd2\t\x00\xd5>\xe0\f\xf2ʠj\xd4\x13\x80\xc2Q\xf6}ZXX\xbf\xed\xd0\xea\x06\xdb̻\xbc\xf9\x80\xee\xfd\xc3\xfd\xd7wOG\xc3\x00\x1a\xa9\x8e&\xc8\x1eCQA\xe7\x04F\x02n\xb08F\xe0\x17\xa0\xca\v\x10\xc65\xc6= \x007\x8a!&G@\x18TT\x8cv\v\x8b\xe8\xdb\f\xf15\xf3^V\xdd\x00V\xcb\n\x14\x81a\x02\xbfq\xa01X\xbfm\xd1\xf1M\x0fR9\xdd\xc1\x9e!H\x129\xac\x99\x80=x\x19\x10\x13\x87\xbc\xf1qU\xed!B\xf4\x01#\x9b\x1d\xe9\x05\xf6\x90\xe0\xbd\xd1\x13R\xae\x85\xb7\x8egВ\xd9\xd8qQ\xb8G]\xa8\x16R\xb81\x04\x11CDBǇ\xc49<\u009c\x03?\xff\x1dk\xae\xe0)\xc7@@\x8dOVK,k\x8c\f\x11k\xbft\xe6\xcf=v\x8eN6\xb5\x8a\xb1\xe4\xd9\xe11\x8e1:ea\xadl\u009b\xccV\xab\xb6\x10Qv\x81\xe4zxل*\xf8\xe4#\x82q\v?\x83\x869\xd0\xec\xf6vixWصo\xdb\xe4\foos\x8d\x9ayb\x1f\xe9V\xe3\x1a\xed-\x99\xe5Tź1\x8c5\xa7\x88\xb7*\x98iv\xddI\xc0T\xb5\xfa\x7f\xb1H\x01]\x1f\xf9\xca[Ia\xe2hܲ7\x91\x8b\xee\x99\x13\x90\x9a\x03C\xa0\xca\xd2.\xd0\x03\xd12$\xec<\xfe\xfa\xf4\x05v[\xe7\xc38\x02\x85\xc2\xfba!\x1d\x8e@\b3n\x91\x13\xc8\xd0!_\xd1\xe9\xe0\x8d\xebR\xaf\xb6\x06\xdd)\xfd\x94此o\xc4?\x12R\xce\xc4\n\xee\xb2\xda\xc1\x1c!\x05\xad\x18u\x05\xf7\x0e\xeeT\x8b\xf6N\x11\xfe\xe7\a L\xd3T\x88\xbd\xec\b\xfaB}\xf8'(\xb3\xc2Zob'\xa5#\xe7u.#O\x01k9@\xe1P\x16\x9b\x85\xa9su\xc0\xc2GP\x03\xc2s(\xdd\xf1\xf2\x95Gi\x1d\x91ΆO<z\xdfY\xed\\h<q\xae\x92\xe0#wu\xbbӶ\"+\xc7\xdb?Û\xfc\uf5880\xbf\xe0\xc6\xd3\xdep\xe7\x89H\xf6Aَ\\\xb8&\xa8%\xe6L\x15\x9e\x01\x83@\xac1\x9a\x85A\rj\xa9\x8c#\xae\xe0\x9eE\xa4T\xb2\xbc\u05cc\x1cm\t\xb2\xd0\xf5\xaa\xf0\xd8\xd2\x13\xd6\x11\xf9\x82\b\xbf||:\xd8\x1e\x05Y\x1c\xa0<{\x03\xc6\xf5\xb4\xfc\f\xb3\\\xc9r\xcd\xdd\xc0\xc6p\x93\x8d{t\xe4\xd3[\xe1v\xe4V\x18\x00T\x89\x1b\xa9\x0f!sO\xcd\x11\xe1y\xa3\x1bHN\x97[\xe4\x8a-Uu\xe4+\xd9m\x002ϯp{\x05+\xdcR\xa7\xbbg~&n|4\xdc\xf3\xb4\xdbt\x00o\xe8܇N\xf9\xc8\xc7Zu.\xaep\xfb\x8aS\x15\xad2\x11OTw\xba+\xa7\x93ѣ\f\xb8H Xq:)ʗ$\"/٥L\x9dbD\xc7\x05HJ\xf4gD\xa2E\"\xb5|)w?uV\x80?\x82\x95j\x82M\xb3\xed\x1d\x18A-\x97\xb4\xbbΪ\xbe\xeb\x8bP\xbf\x82u\x80\xd0(zɏ\a\xb19%\u009a\x05\xd6\xdb\xdab\a\xb1\x13\xad\x978\x91\a]jϷ\x9c\xc2g\xdc\f\x8c>\xee\x03\x1b\x98\xfc\xa0\x8cE\xfd\xaa\x80\xb3\x83\xf4Rȅai\xb0\xf3\xa5\x18ۜ\x14\xa0\xe6>\xf5\xab\x86zğA\xc2\xe1\xd6>*\xecsJ\fc;\xe0ӠW\xf7n\xe1\xa53\xe3\x9c\x13\x8a\xbb\xdb\x18K\x13\\dg\xb4\xa6Ǔ\xf2\xf9\x16tĥ^/j\x8e\xdaГ[\xec\xfd\xc3}\x16\x9c\x11L\xe8ۚ6X\x94\x8e\x9b\xe4\xfa\xb8&\xc06\xf0\x16\xcc\x11\xa0\xf6H\x92\xfa\x11\xe5\xce\x1c\x85\x95>\xa8VA͍5\x12t5\x19\xd9~<c\xcaI\xa2\x92ޒ.b\xe5C1\x06\x151;\xed3]\xca\xeea\x8e\xe9\x19\xc1\x84=m\xfd\xc8)\x05\ty4\x94\xd1\\\xba0R\xc8?b\xd5\xdc\xe2\f8&\x9c<\x87\xa3bT\xdbA\x8b\xf3\x1e\xfa\x15>\x8c\n\xe4\xebe\x12L\xc9\x13\xa5\xb7տ\xf5\xc7\rv\x1a\x17.\x8e\xa8\xf4\xf6\xa2P\x1e\xc5R\x94v\xd3 7\x18\xfbal\x94h\x8d\xccs\xe9*\xf6\r\xfe\b4\xc0\xa6\xc1~[\xb3\xeb+\xb4\xa1Z~\x9a\xa2\x06\xc3\xd5\xcf'\xc1\xdc{\x8bj(\x8b\x87\xaf\xf5\x9dzK\x8a\fN\xb8\xd3k\xfd\xd9\xcb\xfd\"\x7f\xc7\xd2u\x10\xf3l0\x13\xa7{\xd0\xc4>\xaae\x7f3J\xf3\xfd\x8f\xcc]\xb8]\xb70\x83\xbf\xfe\x9e\x94?\xe5\xb3S]c`ԽO.B\xc4\f\xae\xae\x8e\xbe\xd7\xe4\xd7\xda;\x9d\xbf\\\xd1\f\xbe}\x97\xaf.\xec#ꢹ4\x83o\xdf'\xff\f\x00\x83\x10\xd2\xf1\x1b\x13\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xecZ_s\x1b\xb7\x11\x7f\xe7\xa7\xd8Q\x1e\x14ψG;\xe9t\x1a\xbeERҲNd5\x92\xfd\xe2\xf1\x03x\xd8\xe3\xa1\xc2\x01(\x80#\xcdv\xfa\xdd;\v\xe0\xc8#\t\x1e)u\xf2\xe7\xc1\xa6fL\x1e\x80\x1fv\x17\xfb\x1f7\x1a\x8f\xc7#f\xc4\a\xb4Nh5\x05f\x04~\xf6\xa8\xe8\x97+\x9e\xfe\xe2\n\xa1'\xcb7\xa3'\xa1\xf8\x14nZ\xe7u\xf3\v:\xdd\xda\x12o\xb1\x12Jx\xa1ըA\xcf8\xf3l:\x02`Ji\xcf豣\x9f\x00\xa5V\xdej)ю\x17\xa8\x8a\xa7v\x8e\xf3VH\x8e6\x80w[/_\x17\xdf\x16\xafG\x00\xa5Ű\xfcQ4\xe8<k\xcc\x14T+\xe5\b@\xb1\x06\xa7`4_j\xd968g\xe5Sk\\\xb1D\x89V\x17B\x8f\x9c\xc1\x926]Xݚ)l\a\xe2\xdaDPd\xe6^\xf3\x0f\x01\xe6:\xc0\x84\x11)\x9c\x7f\x9b\x1b\xfdI8\x1ff\x18\xd9Z&\x0f\x89\b\x83N\xa8E+\x99=\x18\x1e\x01\xb8R\x1b\x9c\xc2\x1dk\xd0\x19V\"\x1f\x01$\xde\x03Y\xe3\xc4\xdd\xf2M\x84*kl\x82<\xe9\x976\xa8\xbe\xbf\x9f}\xf8\xf6a\xe71\x80\xb1ڠ\xf5\xa2c-~z'\xda{\n\xc0ѕV\x18\x12\xee\x14.\t0\xce\x02NG\x89\x0e|\x8d\x1dQ\xc8\x13\r\xa0+\xf0\xb5p`\xd1Xt\xa8\xe2\xe1\xee\x00\x03Mb\n\xf4\xfc\x9fX\xfa\x02\x1e\xd0\x12\f\xb8Z\xb7\x92\x93\x06,\xd1z\xb0X\xea\x85\x12\xff\xde`;\xf0:l*\x99\xc7$\xe1\xedG(\x8fV1\tK&[\xbc\x02\xa684l\r\x16i\x17hU\x0f/Lq\x05\xfc\xac-\x82P\x95\x9eB\xed\xbdq\xd3\xc9d!|\xa7ɥn\x9aV\t\xbf\x9e\x04\xa5\x14\xf3\xd6k\xeb&\x1c\x97('N,\xc6̖\xb5\xf0X\xfa\xd6\xe2\x84\x191\x0e\xa4+b\xd8\x15\r\xff\xca&\xddw\x97;\xb4\xfa5\x9d\xad\xf3V\xa8Eo (\xda\xc0\t\x90\xaa\x81p\xc0\xd2\xd2\xc8\xe8V\xd0\xf4\x88\xa4\xf3\xcb\x0f\x0f\x8f\xd0m\x1d\x0ec\a\x14\x92ܷ\v\xdd\xf6\bH`BUh\xc3:\xa8\xacn\x82\xc4Qq\xa3\x85\xf2\xe1G)\x05\xaa}\xf1\xbbv\xde\bO\xe7\xfe\xaf\x16\x9d\xa7\xb3*\xe0&\x987\xcc\x11ZÙG^\xc0L\xc1\rkP\xde0\x87\xbf\xfa\x01\x90\xa4ݘ\x04{\xde\x11\xf4=\xd3\xf6\x1f\xa1L\x93\xd4z\x03\x9d\xfb8r^{>\xe1\xc1`I\xa7G\x02\xa4\x95\xa2\x12e0\r\xa8\xb4\x05\xb6\xefB\x8a\x1d\xe0\xbc\xe1\xd2'z\xb5\a\xaf-[\xe0O:B\xeeOڣ\xec:\xb7\xa6\xa3\x8d\xfc\n\xd9'}\x8f\xe0\xe0\"\xfa\x01(\x80\xec\x16\xafj\xb4\x18\x94â\xf3\xa2$\xe5\xd2Nxm\xd7\x04L\b\xc8wy\x1a8\x06\xfa\xc3ϥl9\xf2{\xe6kw\x82\xa1\x1f\xfas\x81\xd9\r\x15\t\x04\f\xf3\xe4\x1c\\b\xec\x00\rhF퀔\xd5\xeb\xc07\xb4\xa6\x80\xfbn\x9d\xf3\xcc\x06\x03[\t_\xc3\xc5\xe4\"\xed\"\x99\x17˜d\x92\xa7\x8a\xa1\xe7ҁ\xd5\xda\x1f\xf2/<6\x19\xe6\x06\x05\x03!\xbe\xb1\xb9\xc4)x\xdb\x1en\x1e\xd72k\xd9zoL\xa8\xf3e:\xeb\xcf\r\xdc.\xa4\x9e\xefK\x92~\xd7\xee\xea\x00\n6\xb2\xc9J\xe2jGʳ\n\xb01~}\x05Lʈ\x98\x01$\x12h\trh\xcd\xef.J\xa59\x9e\x90\xe0\x9d昳*Z\n\xbefљ\xdekN\x93l\xabT\x8e@\x00\xad\x8a\xd13x2\x9a\x9f\xa0+\xed\xc8\xc0b\x85\x16\x15\x05\x89xFF\x87\xe8\xeb\x99P]0\x89\xc7\x06^\x1f`\x02\xcc\a\x0f\xe4\xb8\xcf\x1aJ:\xb2\x14\x7f\x7f?\xeb\x12\x8dN\x88\x89\xf6\x8cM\x9d\x90\x0f\xfdU\x02eP\xec3\xf6\xbe\x9cUQP\x84E\x82b`\x04\x96\xb8\x93ÀP\xce#㠫,\"\xe5\xb9@q\xc9bZq\x15\x03l\x8a\xe4\xdḃd\x0f\x8cB\xbb\xe0\xf0\xf7\x87ww\x93\xbf\xe6D\xbf\xe1\x02XY\xa2# \xe6\xb1A\xe5\xaf\xc0\xb5e\r\xccѡ\v\x8b\xfc\xc13\x8fEÔ\xa8\xd0\xf9\"\xed\x81\xd6}\xfc\xe6S^z\x00?j\v\xf8\x995F\xe2\x15\x88(\xf1M\xd6\xd0)\r\xa96\x89c\x83\x18\x9c\xa3P\xa3,$0Js\x13۫\xc0\xaegO\b:\xb1\xdb\"H\xf1\x84S\xb8\xa0\xe8\xd8#\xf3?d;\xff\xbd8\x82\xfau\x8c<\x174\xe9\"\x12\xb7I\x13\xfbF\xb7%2Z\x9e\x15\x8b\x05ڐW\xe7>\xb4\x04\x97\xa8\xfc+Ж$\xa0t\x0f\"\x00SX\x8bq\x1c\xf9\x01\xd1\x1f\xbf\xf9t\x94\xe2-\x0e\xc9\v\x84\xe2\xf8\x19\xbe\x01\xa1\xa2l\x8c\xe6\xaf\nx\xa4\xafn\xad<\xfbL\ue86c\xb5\xc3c\x92\xd5J\xae\x89\xe7\x9a-\x11\x9cn\x10V(\xe58\xa6\xe9\x1cVlMR\xe8\x0e\x8eԘ\x81a\xd6\x0fjk\x97\x9c?\xbe\xbb}7\x8d\x94\x91B-\x14\x91Cq\xb2\x12\x94lS\x96\x1d\x06\xa36\nw\x04ѵ\x01\x8f\xc8,k\xa6\x16\x94v\x87C\xaaZʞ\x8b\xcbQf\xd1);>̘\xf3&\x1c2\xe7}\xc7\xf1\xbb\xe5\x9eg2GJv\x0esw=-\x1fd\x8eJi\xab\xd0c\xe0\x8f\xeb\xd2\x11k%\x1a\xef&z\x89v)p5Yi\xfb$\xd4bL\xaa9\x8e:\xe0&D\x8a\x9b|\x15\xfe{1/\xa1\x8e=\x97\xa10\xf9\xb7\xe0\x8a\xf6q\x93\x171ՕX\xe7Ǳˇ\x94\xf8\xef\xaf%\xb3Xբ\xac\xbb\xda9\xf9\xd8,$\x90\x056\x8cG\xd7\xcc\xd4\xfaWWe\x12hk\x89\xa2\xf58\xf5g\xc6Lq\xfa\xee\x84\xf3\xf4\xfcE\x12l\xc5Y\xe6\xfb~v\xfb\xdb(x+^d\xabG\xeaÔ\x8dŪ\xee\xd12\xe5*\xb4\xd3\xd1 \xaf\xf7\xfb\xf3\xa1֒\xa7\xaa\x11=\x95 \x0eZ\x87<\x9f\x91\xf9\xdaj\xefe\xac\xc4|\x82\xb8\x02\xb2n+8y~O\x81\xe4\xd9\xf5]\xf1\xdc\xc4y8\xf9\xe3z\xa5\xa4f\xfc'\xd1\b\xffV\\\x1bw\x86\x1a\xdc\x1e,\xea\x92\xeb\x86}\x16M\xdbl`\xb3XT*+\xbe\x12<\x84\\x+\xae\xc1\xa0\x05\x87\xa5V\xbc\x80\xefS\x0e\xa2+x\r\r2EA\x0e$\xed\x95O\x92\x1a\xa1h\xd3)\xbc\xce\x0eG\x9d\xa0\x9e\xd4\x02mf\x86\xd0\xf7Vh+\xfc\xfaF2w\x0e\xff\xb3w;+:\xe6g\x93w\xa1=\xc6[I\xe7[\x12\xda\xf1\xc0N+\xa8\xc1\xb1Q\x8e~\xf5\x15\x06\xb1b\xad\xf4\tGDe\xcbK\x00U\xdb\xe4\xe9\x1e\xc35:\xffCUi\xeb\x8fL\x98q\x89\x03\x82;\xea2\x948+\x88܉\x94\xa0\xd6\b7\xf7\xef\xfb\x122I\x8a\x9d\x11\x908\xb2\x80г\xa0\xd0\b{\r_+m\x1b&_\x91\xbf~\xf3\x1d|-\xf5\n\x9d\x7fuDC\xa2ZN\xe1\xcdw\xbf\x86\x06\xb5\xe6\xd9&\xf4~oɾ\x01E\xc8?\xbe\xf9\f8\\\xea;\xcd8ŮJ\x9c\xf4\xb6\xbf\xecL\ue911\xe9`m\xe6\x14\xa3g\xe8\xeb\x16\xe1-\xae\x1f\xb0\xb4\xe8\xcf hoE\xae\x83\xe0҈:\xd2\xcc\xfa\x10n2\xb6YW\xec6l#\xc9\x01\x87\x97\x0e\fsn\xa5-uhsΣ\xe7\"\x9ep\r\xaef\x169\xccסe\xb3\x01\x128\xe03\x06$\xe5\xd9\"\xa3\xbf\x8c\xf3pa\xc4\xe4\xfd`8\x19\x00ޓ\xef#[\xc4^\x16\x83\x86\x19\x12\xe7\x13\xae\xc7Qu\r\x13\x96\xc4\xc3|w\x111G`\xc6H\x91\xad\x12\xbd\xee\xf7GR$e.\xb0R<Gc\xa3ѡ}$.\x86\xc9\x7fߛ\xda\xe9\x05!wz\xd1Au\x19Bhd\x1e@\x92\xeb\xe8\xd1^\xc0m\xf4\xf9\xa1n\xbe\x88\xbaq\xf1\xac\xe3\x8bM\xa2\x13\xc4\xc7<(\xa7\xceI\x86\x94\x91\xa5\xa2\x90ZTD~N\xb9\aZNGI\xa4K\t\xea\x85\xec\x928\x86y\xae\x13\xbe7\x87\xdau{\x8f\x8c\xdeՈ\xf1\x9e\xdb\xd9\x1b\x8c\xfc\x8d\xce\xd0\a\xea\xe2\xb4{:\x9e\xcf\x0f\xbb\x1e>\xcd\xefd\x1ast\x9fPH\xba/\xbeV(5\xf5~v\xefU\x87\x8f\xf7\xe6pE\xb8\xc1\xb3\xc9\xe7x\xd1 \xb0\xcePV\xccu{\xe4Ӌ-\\\\I-Ҁ\x86<4f\xa8oT1!\xc9\t\x05HW\xec\xafɠ\xf6Q\xe6XQ\x03 \xdaL\xd7\xeeL\xe4m\x9a\x1ftY\x13\xae\xc6.\xdd\x00f07\xba\xc6\xc9\b\xe1\xb0!RQ\x0e\xe1\xa7@\x17b\xe3,\xe8Y]\xe9\xac%6\xe8\x1c[\x9c2ş\xe3,\xd2\x1b\xd6-\x016\u05edߴ\x81w\xfcڥK:U<\x87\x16\x93m\xb0\xee\x10B=\xd8N{\xab65\xffS\x1bqӶ\x8bo\x03P\xf7\x10\xe6x\xb8\xcdK}\x02\x80\xa9\x99;%\xaa{\x9a\x933\xb0\x8d\xf7\x1a\xb4\xb0\xe3y\xf2\x18\xeep\x95y\xfa\x8f\x16\xdbL\xc0\x19\xc3L\xdd[\xbd\xb0\xe8\x0e5j\xdc)^v\xe1\x8f\xc1L\x9e%\x98\xfd*\xf4\x94\x90\x06\xaa\xd6T\x92\x92\x81u\x05\xec\x01\x18\xd5%\xcc\xc3\n\xed&\xe2v=\xdc\xf9\x11\xa1~\xa9?\xbfԟ_\xea\xcf/\xf5\xe7\x1f\xad\xfe4\xc9GOG\x83\x92\xe8\\y\xdfQj\xcf$\xa8\xb6\x99\xa3%>\xe6k\x8f\x9bk\xf6L\n\xd9\xdd\xd4\xf0\x9d\xd0\xd4[\xdf\xc5Ĉ\x94\xae\x94J\xa6\xe8\u07b6+\x10\xb8pF\x1e\\n\xf7\x19\t=VJX(\xad\xda\xe6\b]\xa2d\xd0\x1e\xe9\x10\x0e\xbb\xe0@ӭVG\x8c\xa9ˑ\x84\xf2\x7f\xfe\xd3\vN\x88^\xc0\xf0L^\xaf}~\xfb\xff\x7f\x87\x01\x1dp\x8a\x19Wk?\xbb=\xa1\x05\x0f\x9b\x89\x9d%\x88M\rA\x04\x86\x93\xedВ*\x1c B/_+F\xcfpg\u1756M\x9ez\x8aԝ\xc9)\x8b>\x96\xd9\a\xe4\xbc\xdb~@\xc3,eO\xa1\xa3v\xb3\xff\xf2\xe6\x158\xa1\xba\x86E\x8c\x88\xf1\xba\xd0Q\xc2O\xb5\xa9\xb6\x98IC\xe10U\xdfI\xccw\xc9\xffms\xf2x4g\x94\xf7)\x87:R\xdcor\xdc\x04x\xe9B$-F\xe7\xc5\xc51\xfc($\xba\xb5\xf3\xd8d\x06\xff\xa6\x9d\xa7l<3t-u\xf9t>\xc3Y\xc38x\x18\x8e\x8a\xf7\x84\x99.!ғm!L\xef7\x18\x8f\xfcn\xff\x8d܋\x8b\x9dWl\xc3\xcfR\xab\xd82rS\xf8\xf8\x89ޣ\r/\x9e\xa5[67\x85\x8f\x9fF\xff\x1b\x00V\xc8L\xa5\xc6,\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xccZ]s۸վׯ8\xe3\xbdp2cQI\xf6\x9dw\xba\xba\x8b\xedM\xab&\xebx\"'7\x99\\@ġ\x88\x1a\x04X\xe0P\x8a\xda\xe9\x7f\xef\x1c\x00\x94(\x89\xfa\xb0;I\xbb\xca\xccZ\x04\xf0\xe0|>88\xd4`8\x1c\x0eD\xad\xbe\xa0\xf3ʚ1\x88Z\xe1wB\xc3\xdf|\xf6\xf8'\x9f);Z\xbc\x1e<*#\xc7p\xd3x\xb2\xd5'\xf4\xb6q9\xdeb\xa1\x8c\"e͠B\x12R\x90\x18\x0f\x00\x841\x96\x04?\xf6\xfc\x15 \xb7\x86\x9c\xd5\x1a\xddp\x8e&{lf8k\x94\x96\xe8\x02x\xbb\xf5\xe2U\xf6k\xf6j\x00\x90;\f\xcb\x1fT\x85\x9eDU\x8f\xc14Z\x0f\x00\x8c\xa8p\f\xb5\x95\v\xab\x9b\n\x1dz\xb2\x0e}\xb6@\x8d\xcef\xca\x0e|\x8d9\xef:w\xb6\xa9ǰ\x19\x88\x8b\x93DQ\x9b{+\xbf\x04\x9cO\x11'\fi\xe5\xe9}\xef\xf0\a\xe5)L\xa9u\xe3\x84\xee\x91#\x8cze\xe6\x8d\x16n\x7f|\x00\xe0s[\xe3\x18\xeeD\x85\xbe\x169\xca\x01@2@\x10m\x98T\\\xbc\x8eXy\x89U0*\x7f\xb35\x9a\xb7\xf7\x93/\xbfN\xb7\x1e\x03\xd4\xce\xd6\xe8H\xb5\xea\xc5Oǭ\x9d\xa7\x00\x12}\xeeT\xcd\x16\x1e\xc3%\x03\xc6Y ٟ\xe8\x81Jl\x85B\x99d\x00[\x00\x95ʃ\xc3ڡG\x13=\xbc\x05\f<I\x18\xb0\xb3\xbfaN\x19L\xd11\f\xf8\xd26Zr\x18,\xd0\x118\xcc\xedܨ\x7f\xac\xb1=\x90\r\x9bjA\x98l\xbc\xf9(C\xe8\x8cа\x10\xba\xc1+\x10FB%V\xe0\x90w\x81\xc6t\xf0\xc2\x14\x9f\xc1\x1f\xd6!(S\xd81\x94D\xb5\x1f\x8fFsEm8綪\x1a\xa3h5\n\x91\xa9f\rY\xe7G\x12\x17\xa8G^͇\xc2\xe5\xa5\"̩q8\x12\xb5\x1a\x06\xd1\r+\xec\xb3J\xfe\xe2R\x02\xf8\xcb-Yiž\xf5䔙w\x06B\xb0\x1d\xf1\x00G\x1b(\x0f\"-\x8d\x8an\f͏\xd8:\x9f~\x9f>@\xbbup\xc6\x16($\xbbo\x16\xfa\x8d\v\xd8`\xca\x14\xe8\xc2:(\x9c\xad\x82\xc5\xd1\xc8\xda*C\xe1K\xae\x15\x9a]\xf3\xfbfV)b\xbf\xff\xbdAO\xec\xab\fnB\x8e\xc3\f\xa1\xa9\xa5 \x94\x19L\f܈\n\xf5\x8d\xf0\xf8\xc3\x1d\xc0\x96\xf6C6\xecy.\xe8\xd2\xd3\xe6?F\x19'\xabu\x06Z\n9\xe0\xaf]Z\x98֘\xb3\xfb\u0602\xbcT\x15*\x0f\xb9\x01\x85u \xf6h$ۂ\xeeO]\xfe\xccD\xfe\xd8\xd4S\xb2N\xcc\U00043358\xbb\x93vd\xbb\xee[\xd3\n\xc7\xcc\xc2\x19\xca\x7fGp`\x81\xc4\x1c\xf7@\x01t\xbbxY\xa2\xc3\x10\x1e̶*\xe7\xf0\xb2^\x91u+\x06f\x04\x94\xdb:\x1dq\x04\xffS&\u05cdDy/\xa8\xf4'\x14\x9at\xe7\xf2~\"\x104\xabQ\vbb\xf0\xadJu\x98\xb2TT\xaa]Z\xe2\x0fO\xf1FԾ\xb4\xc4|\x9389\x83I\x01Xմ\xba\nJ.K\xab;\x13\x03\xe1\x1d\xd2Q\x11V=\n\x1cU\x1e\xc2Q&f\x1a\xc7@\xae\xd97}\\+\x9c\x13\xab\x9d\xb1\xda\xca\x13ֺ\xb7\x89H\x1c\x16\xe8\xd00MDf\xadm\xe0_\x12ʴt\x12\x8fP \xbb\x87\t\x9c؇\xd5>\x1c\xb2\xc7N\x9d^\x81\xdf\xdeOړ\xa6uc\x12\x9d\xf6\xf7=iY\x80B\xa1\x0e\xb1r\xc6ޗ\x93\"n\xc6Xl'\x01\xb5\xc2\x1c\xb7\x0e1P\xc6\x13\n\t\xb6\xe8E\xe4j\a\x98\x98\x1c\xa6\x15\x1cF!/\x02\xec\xe6\xe8cӃ`nW\x12\xfe:\xfdx7\xfas\x9f\xe5\xd7Z\x80\xc8s\xf4\f$\b+4t\x05\xbe\xc9K\x10\x9e}\xae\x1c\xca)\t¬\x12F\x15\xe8)K{\xa0\xf3_\xdf|\xeb\xb7\x1e\xc0;\xeb\x00\xbf\x8b\xaa\xd6x\x05*Z|}l\xb41\xc3|\xc1\xe6X#\x1e\xce*\xfe'\xb8\xd0Ij/\x83\xba$\x1e\x11lR\xb7A\xd0\xea\x11\xc7p\xc1\xec\xd8\x11\xf3\x9fLH\xff\xba8\x80\xfa\"\x12\xcf\x05O\xba\x88\u00ad\xeb\x84.\x93m\x84\xa4R\x10\x90S\xf39\xbaPX\xf5}x\t.\xd0\xd0K\xb0\x8e-`l\a\"\x00\xb3\xf7\"\x8f\xa3\xdc\x13\xfa\xeb\x9bo\a%\xdeఽ@\x19\x89\xdf\xe1\r(\x13mS[\xf92\x83\a\xfeӯ\f\x89\uf72byi=\x1e\xb2\xac5z\xc5:\x97b\x81\xe0m\x85\xb0D\xad\x87\xb1N\x93\xb0\x14+\xb6B\xeb8\x8e7\x01\xb5pt4Z\xdb\xea\xec\xe1\xe3\xed\xc7q\x94\x8c\x03jnX\x1c>\xd5\v\xc5\xd5\x16\x97Ya0F\xa3\xf2\a\x10}\x13\xf0X̼\x14f\xceuWpR\xd1p\xf9\x94]\x0ez\x16\x9d\xca\xe3\xfd\x92\xa9?\x85C\xe9\xb4K\x1c\xff\xb5\xe2\xe3L\xe58\xc8\xceQ\xee\xae\x13\xe5G\x95\xe3\v\x953H\x18\xf4\x936\xf7\xacZ\x8e5\xf9\x91]\xa0[(\\\x8e\x96\xd6=*3\x1frh\x0ec\f\xf8\x11\x8b\xe2G\xbf\x84\xff=[\x97p\x919W\xa10\xf9gh\xc5\xfb\xf8ѳ\x94jk\xec\xf3ϱ\xcbi*\xfcv\xd7rZ,K\x95\x97\xed\xe5)ql/$p\x06VBFj\x16f\xf5\xc3C\x99\r\xda8\x96h5L\xb7\xf4\xa10\x92\xff\xf6\xca\x13?\x7f\x96\x05\x1buV\xfa~\x9e\xdc\xfe\x9c\x00oԳr\xf5\xc0\x05!\x15c\xb1\xaa\x7fp\xc2\xf8\x02\xddxpT\xd7\xfb\xdd\xf9PZ-ӭ\x01\x89\x94\x99{h<\xca\xfe\x82\x8cJg\x89t,\xc4)A\\\x01g\xb7S\x92\x99\x9f\xf8 yry\x9f=\xb5<=^\xfcI\xbb4\xda\n\xf9AU\x8aޫ\xebڟ\x11\x06\xb7{\x8b\xda\x1bK%\xbe\xab\xaa\xa9ְ\xbdX|S2r\xa9d8rὺ\x86\x1a\x1dx̭\x91\x19\xbcM5\x88-\xe0\x15T(\f\x1fr\xa0y\xaf\xfe\"\xa9R\x867\x1dë\xde\xe1\x18\x13ܔ\x98\xa3뙡\xec\xbdS\xd6)Z\xddh\xe1\xcf\xd1\x7f\xf2qkE\xab\xfcd\xf41\xf4Gd\xa3ٿ9\xa3\x1d>\xd8y\x05\xdfp\xd7\xc1\xb1{\xb1\x91X\x88FS\xc2Q1\xd8\xfa-\x80\xa6\xa9\xfa\xe5\x1e\xc25z\xfa\xbd(\xac\xa3\x03\x13&R\xe3\x11\xc3\x1d\xa4\f\xa3\xce:D\xeeT*PK\x84\x9b\xfb\xcf]\v\xd5Ɋm\x12\xb09z\x01\xa1\x93A\xa1\x13\xf2\n^\x18\xeb*\xa1_2_\xbf\xfe\r^h\xbbDO/\x0fDH\f\xcb1\xbc\xfe\xedGDPS?9\x85>\xef,\xd9M\xa0\b\xf9\xbf\x9f>G\b\x97\xdb\x0e\x13\xc9gW\xa1N\xb2\xed\xa7\xadɭ5z\x1a\x18\xeb9\xd9\xe0\t\xf1\xbaAx\x8f\xab)\xe6\x0e\xe9\f\x81vV\xf4\xb5e|\x1a\xe1\x1bC_\x16}\t\xed\xecM\xd5\x15\xef<\x9b\x93dO\xc3K\x0f\xb5\xf0~i\x1d\xb7\xe8\xfaȣC\x11\x8f\xb8\x02_\n\x87\x12f+\x10Zo\x80\x14\x1e\xe1\x8c#\x96j\x1b)\x93\xdb\x13\x06\x9a\xae'\xb6v\xd9\xd4\x06\xa9S\xb1nʐ=ޠ8\"OL\x04t\x0f<\xe5\xb8D\x9f;S[\x99\x18\xb9\x95\xaa\x85jO\xedV\xa0=T\xe8(\x91\xc1\x84\xa0j<A%(/\xfb\x81\xf8\xf4\x86\xa6\xee.\xeb\x01\xbd\x8d\x84\x1e.\xc5\x17\xbc\xb7\xca/\x9ed\x8b(\xd1\t+\xc4\"\xa7/V\x93W\xb8\xdcJ7>n?\x05\xdf\xecA\xc2\xf3\xbc\x15\xb78\xc3W\xa9\xb4:\xe0)>\b\x0e\xc4\xd2\xd5\x1e.p\x87\x85_)8\x19\xf3\x80\x9b\xdf\xdc>K+\xd99M\x9dm\x99\xff\x9d\xd2\xe8W\x9e\xb0\xca\x06\xe7\x1d\xa6\xc3Κ\x9e\xc1\xbfXO\xdc\xc6\xea\x19\xba\xd66\x7f<߈ܶ\xe7fѶ\bC\x98\xf5u\x8aw\xe6\xd4v\xfb\xc8\x18\xee\x90\xf0\xce`k\xd3\xc9\xed\xce@\xb4\xdc\xd6\xc3\x03D\xcfͮf\xe7\xc0\xeb/\xa3\xdb\xe6{X\xd0:=\xdee(\xf4̚\xd0\x18~~\xfb=\xb7\xdc$\xdb~\ry<\bo\xf6W\xa4@J!\xa9*\f\xbd\xd9 9,\x85o7\xe9K\v\xe8\xe0ť\xaa\x13\x97\xdc\xc2\xe2\x0e[!\x94F\xd9bzn/\xf1)\xc2/}.\xfb:6-P\xa0\x1a~?\xd1#\xf4\xfe\xba\x82\x8b#\x1a\x03\xbf\xea\x192\xc4So\rGr\xbcB\xef\xc5\xfcT\x82\xff\x11g\xb1\xe8\xa2]\x02bf\x1bZ\xb7\xb7S~&S\\\xfa\x14\x05\xd9S\x84\xa9K\xe1O\x89r\xcfs\xfa\"n\xcd7\xc7C\xee\x18)\xdc\xe1\xb2\xe7\xe9\xc4\xdc;;w\xe8\xf7=3l\x1d\xd8\xd3\xf0\x1c»\x10\x1dO2@\xda\xe8\x94\rҴ\xce%\x96,\t\r\xa6\xa9f\xe8\xd8\x10\xb3\x15\xe1\xfa\xddLK\r{\xa8\x90\xfa\x8c\x1bKn\x10\x92'\x99\x84\xf9\xe6\x1f;\xa7\xb90|\xf8\xb7G\xa5T\xbe\xd6{oJ\xba\x9a\x84V\x02\x87/\xe7\xd1&b\x128p\xfa\x1f\xb8\t\x1f\xbf\xea\x06\xa1n\xad\xe9\t\x97n\xca(C\xff\xff\x7fϨ\x84!\x1a\xf4zE\xfd\xdb\xff\xe7;\x1c\xa0\xe0DÎ\xd6|p\"\x16\xa6[\x93O1^\x80\xee\xe7\xbb.u\xed\x13\xd5\xf66?\x93\xa3z\r\xb5\xf70H.;ة\xf9\x92\x9elN6~\xafS\x13ʻݟ\xa3\\\\l\xfd\xba$|\xe5KX\xf8\x85\x8d\x1f\xc3\xd7o\xfc\x03\x12&\x14\x99\xba\x8b~\f_\xbf\r\xfe=\x00`uqi\xc4#\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4Y\xcdn#\xb9\x11\xbe\xeb)\n\x0e\x02_F\xed\x19l\x12\x04\xbaM\xec]\xc0\xf0\x8c1\xb0\x06sY\xec\x81j\x96$\xc6\xddd\x87EJ\xe9}\xfa\xa0ؤ\xd4?\x94%9\x98m\x1fVd\xb1\xfa\xfb\xea\x8fUӳ\xf9|>\x13\x8d\xfa\x81\x96\x94\xd1\v\x10\x8d\xc2\xff:\xd4\xfc\x8b\x8a\xd7\x7fR\xa1\xcc\xdd\xee\xd3\xecUi\xb9\x80{O\xce\xd4/H\xc6\xdb\x12\x1fp\xad\xb4r\xca\xe8Y\x8dNH\xe1\xc4b\x06 \xb46N\xf02\xf1O\x80\xd2hgMU\xa1\x9doP\x17\xaf~\x85+\xaf*\x896(O\xaf\xde},~)>\xce\x00J\x8b\xe1\xf8wU#9Q7\vо\xaaf\x00ZԸ\x00\x8b\xe4Ti\xb11\xa4\x9c\xb1\n\xa9\xd8a\x85\xd6\x14\xcą\xc1\x92_\xbb\xb1\xc67\v8nt\xa7#\xa4\x8e\xceKP\xf4\x92\x14\xb5a\xabR䞲\xdb_\x14\xb9 \xd2Tފ*\a$l\x93\xd2\x1b_\t;\x11\xe0\x17Pi\x1a\\\xc0\xb3\xa8\x91\x1aQ\xa2\x9c\x01D\x13\x04l\xf3Hr\xf7\xa9\xd3Un\xb1\x0ef\xe5_\xa6A\xfd\xf9\xdb\xe3\x8f_\x96\x83e\x80ƚ\x06\xadS\x89_\xf7\xf4\x1c\xdb[\x05\x90H\xa5U\r\xdbx\x01\xb7\xac\xb0\x93\x02\xc9\x1eE\x02\xb7\xc5\x04\ne\xc4\x00f\rn\xab\b,6\x16\tu\xe7\xe3\x81b`!\xa1\xc1\xac\xfe\x8d\xa5+`\x89\x96\xd5\x00m\x8d\xaf$\a\xc2\x0e\xad\x03\x8b\xa5\xd9h\xf5\xe7A7\x813ᥕp\x18\x8d||\x94vh\xb5\xa8`'*\x8f\x1f@h\t\xb5h\xc1\"\xbf\x05\xbc\xee\xe9\v\"T\xc0Wc\x11\x94^\x9b\x05l\x9dkhqw\xb7Q.\x05ti\xea\xdak\xe5ڻ\x10\x9bj坱t'q\x87\xd5\x1d\xa9\xcd\\\xd8r\xab\x1c\x96\xce[\xbc\x13\x8d\x9a\a\xe8\x9a\tSQ˿ؘ\x02t;\xc0\xeaZ\xf6-9\xab\xf4\xa6\xb7\x11\xa2\xed\r\x0fp\xb8\x81\"\x10\xf1hG\xf4hh^b\xeb\xbc\xfc\xba\xfc\x0e\xe9\xd5\xc1\x19\x03\xa5\x10\xed~<HG\x17\xb0\xc1\x94^\xa3\r\xe7`mM\x1d,\x8eZ6Fi\x17~\x94\x95B=6?\xf9U\xad\x1c\xfb\xfd?\x1eɱ\xaf\n\xb8\x0fY\x0e+\x04\xdfH\xe1P\x16\xf0\xa8\xe1^\xd4X\xdd\v\u009f\xee\x00\xb64\xcdٰ\x97\xb9\xa0_\xa0\x8e\xff\xb1\x96E\xb4Zo#Ր\x13\xfe\x1aׅe\x83%\xbb\x8f-\xc8G\xd5Z\x95!7`m,\x88I\x1d)\x06\xaa\xf3\xa9\xcb\xcfJ\x94\xaf\xbeY:c\xc5\x06\xbf\x98N\xe7Xh\x84\xed_\xb93\t\x1cW\x16\xceP\xfe\xff\xac\xe0D7\x80\xdb\n\xd7\xcb_'\x94>\x94\x81,\x9f7\x9c\xc0\x7f\xe5\x16\xcb\xd7\xdfB,\xe9\xb2=\xc3\xe6~ \xcc4\xb6f\x0ff\xed\x90Ap\x82;\xdcX\xe5\xda\xc4jPj\xc7Od\xb1\xc2\x0e\x04\xc7\xec\xe7\x98jf\r\x1fA*\x12\xab\n\t\x1a\xb4\xcaHUvr4\xe5\xc7\xd7\x11\x8b.\xc0Y\x8fW\xd3\x7fA!\x1f\x84\x13K\xbf\"t\x97\xd8`x\xe2\x10l\xe1\xfc\x94\xfb-MT\x02p\xf4w\xde\f5\\\xc8PGwh\xd5Z\xa1\x04\xe9\xd9Y tϨ\x01\xec\a\xc0bS\xc0\xcd\xdf\xffz\x93\xd1j,\xdc|\xba\xfb\xf4\xf1\xa6\x80\xc75`ݸ\xf6\x03\x18]\xb5\x13Hl\x0f\x1f\xf2\x99\xe1'\x0f\\c\xbbZ04-t\x89\x97\x06\xd0\xd7̑a\x18\xf5\x94\xc6\x00\x99h\x04.s\xd6\xeb\xab\xc0\x1e\xa9?a\xbb\xc4Ҟu\xf4\xcb\xf4D.q)\xee\x84\f\x98h\x04\xf8\x11:\x9e\xd0E\x84\x06\xa3\xf3\xf9\xd6T\x92\xa6>i\x04\xd1\xdeX\xd9\xf3^F%\x1f{\xc5\x16h+,JX\xb5 \xaa\xea\xa8H!1POW\xfa\xb3\xeb\x8e\x1e%\x97\xf5\xb5B{\xd6>C\xf1d\x9c\xb5\x0f`xs^\x9a\xba\x11N\xad*̿\x92\x1f.ʪ{i\xcb!\xff\xffT\xb3\x9d\xa9|\x8d\x87f\xee\f\x83\x1fC\xe9\xbew\x93\xab\x02\x14\xa6\xd2C4Q\n\xa9\x12\x134FF\x10\xf1\xba \xbet\xae\xe0\xc0\x89\xa4,\x8e\xfa\x93y\xfe\xf2\x19\xc9\xe4\x12r$2\xf6\xf1h{d\xbf\x8b.g'\x9c\x1fݕ\x9989f\xd32\x1cH\xc6.\xbd\xb5\xa8]T\xc3I\xf5\xfe\v\xfa\x15ۗ8\xea\x9c\xf1\xfc\xd3Q2\x01a\x00\x87\xa4\xee\xfa^\xb0I\xa6[\x9e\xe8엕[\x82Wl\xaf\xbe\x9bN\xb3\xe1\x87\xf3\xa7\xc2\xe1\xf0\x95\x11\x1b\xb1\xbb\x9f\x9e\n=\xbe\x8d%ǩ\x9ac\x1b\x0f\xfc\xb2*\x01\xf6\x82\x12\x82\\)\x89\xc9[\v\xb7\xe0\xdb\f\xe7\xac7+u\xc6\x06o&D\x9c9F\t\x91\xe5\xfd\xf8\x90\n\x89B\x1a\x10,\xe0\xd1\xdd\xc69*5\x18y\x87\xc68\x8ae\xfd\x96\x0e*zst\xf1\x1e\x065\x12\x89\r^@\xe3k'Ɂ)\xd21\x10+\xe3݀\xd3-Ťy\x17\x1c\x8d\xfb'l\x1f\x1f.\xc0\xf3\x1cES\xa6<>D\xf3\x81\xc6=\a=\b)Q\xa6\x99\xf1\x98\x12\xb9\xbb\x8b\x9f\xfdV\x95[\xd6f\xb16;\x94 6\xdcƪ\xf5\x80\x1f\v\x88\x95\xb1'c\xef\f\xc3f+\xe8\x12s\x7fc\xb9\\9:T\x83\x84(\x0f\x03\xb5\xaf\xf3\xaf\x99\xc3\x13\xb6\x9f\xd96'\xb6c\xa2\x9e\xdc\xffM\xa8\n\xe5\xbb\xc8[\xdc)\xe3\xe9R\x1f\x7f\xeb\xcbO\x1d=(sM\x94\xcdj\r\xd9\xf3a\xeab\xc3\r]?f\x14\x81\xd2\xe0\t\xdf\xe1\xdc\x13W\x11\xffU\x82\\\x18S~\xb5\xd6\xd8LQ\x1d\xd0\xfe2\x94\x06a;\x90\x18\x0e\xc3\xdax\x1d\xfa+^c\xcd\xc7f|\xa27\xce\x12\xb1҄\xde-Et\xd8\b\xbd].\x94\x95\xc3:[\xfc\xdf4\xc1\x99\x92ڝ\x15֊\xf6\x94\x81\xf8F\xb9\xd4<,\x9b\xa2\x82\x15\xf4\xae\x90~d\xbce\x9d\xbdxc\xca8\x7f\x8d\\\xc47k+\xc6ۛ;.\xa4=:1%\xdf\x1fT\xf6\"7\xe1eG\x94\x9f\xc9\xf4\xe4\x15s\xcd\xf5ү\x80\x9eb\x01\x98h\x843M\xda\x19\xa4'j\xf3\x95u\xf9<\x84|m\x9e\xc33\xee3\xab<\xd5OCw\x0e\xcf\xc6\xe5\xb7\xde`\xc8@\xcfU\x1f\xee\x84)\x8e\x82,\xaf\x98\x0e\xf5\xaf\xfa\x03\xb5[\xee\xc4xtv\xb9H\x13\xc9O\xa3\"\x15Kҵ!\xf6vOJZ4\xb45\xee\xdex\x9d\x99\xa1\xa7,\xfb\xf2ɟ\xda\xd7+\xb4\x8c:\xa9\xa3\xd3#\xf4\xb0ўz\xf9\xe8\x8a\xc0|4\xd5\xc4}\xe3D\xb5T\x7f\xe2\x05\x88\xbf'ل\xd6\xf1\x02PXѰj\x1d\x1e\f\xce\xff\x8c\x93\xd5ȓ\x91\xe1\xf1\\\xe9\x91/\xf3\x04RaP\xda\xfd\xe3o\xef\xa0x\xf2N\xccnL\x16\x89?\r\xc8^@0z.&\xdd\xcaq\xca\x13e\x89\x8dC\xf9<\xfezss3\xf8\x18\x13~\x96F\xcb\xf0E\x8a\x16\xf0\xfb\x1f\xb3d\x93\xf8}\x83\x16\xf0\xfb\x1f\xb3\xff\r\x00\x91\x9dA\xf6\xf4\x1a\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}}o#7\x92\xf7\xff\xfa\x14\x05'\x80f\x9e\x95\xe4̓g\x83\xe7\x8c\xe0\x02\xef\xd8I\x84d<\xc2\xd87\x8bE6\x97\xa5\xbaK\x12\xcf\xddd\x87d\xcb\xd6^\xee\xbb\x1f\x8a\xcd~ћ\xddd\xcb\xf6\xccB\x92\x91\x8ceu5Y\xac7V\xfdX\xcd2\xfe\x11\x95\xe6R\x9c\x01\xcb8\xde\x1b\x14\xf4\x9b\x1e\xdd\xfe\x7f=\xe2\xf2t\xf9\xa6w\xcbE|\x06osmd\xfa\x01\xb5\xccU\x84\x178\xe3\x82\x1b.E/E\xc3bf\xd8Y\x0f\x80\t!\r\xa3\x8f5\xfd\n\x10Ia\x94L\x12T\xc39\x8a\xd1m>\xc5iΓ\x18\x95%^\xdez\xf9\xd5\xe8\xeb\xd1W=\x80H\xa1\xbd\xfc\x86\xa7\xa8\rK\xb33\x10y\x92\xf4\x00\x04K\xf1\f\x14j#\x15\xea\xd1\x12\x13Tr\xc4eOg\x18\xd1\xcd\xe6J\xe6\xd9\x19\xd4\x7f(\xaeq\x03)&\xf1\xa1\xb8\xdc~\x92pm~j~\xfa3\xd7\xc6\xfe%KrŒ\xfaf\xf6C\xcd\xc5<O\x98\xaa>\xee\x01\xe8Hfx\x06W,E\x9d\xb1\b\xe3\x1e\x80\x9b\x93\xbd\xedЍz\xf9\xa6 \x11-0\xb5|\xa2\xdfd\x86\xe2|2\xfe\xf8\xf5\xf5\xda\xc7\x001\xeaH\xf1\x8c\xd8P\x8d\r\xb8\x06\x06\x1f\xed\xdch\x00v\x11\xc0,\x98\x01\x85\x99B\x8d\xc2h0\v\x04\x96e\t\x8f,\x13+\x8a\x00rV]\xa5a\xa6dZS\x9b\xb2\xe86\xcf\xc0H``\x98\x9a\xa3\x81\x9f\xf2)*\x81\x065DI\xae\r\xaaQE+S2Cex\xc9\xd8\xe2ݐ\xa3Ƨ\x1bs\xe9\xd3t\x8boAL\x02\x84Ő\x1d\xcb0v\x1c\xa2њ\x05\xd7\xf5\xd46\xa7\xe3\xa6\xc4\x04\xc8\xe9\x7fadFp\x8d\x8aȀ^\xc8<\x89I\ue5a8\x889\x91\x9c\v\xfeϊ\xb6\xa6\x89\xd2M\x13fЭw\xfd\xe6\u00a0\x12,\x81%Kr\x1c\x00\x131\xa4l\x05\n\xe9.\x90\x8b\x06=\xfb\x15=\x82wvy\xc4L\x9e\xc1\u0098L\x9f\x9d\x9eι)\xf5'\x92i\x9a\vnV\xa7V\x15\xf847R\xe9\xd3\x18\x97\x98\x9cj>\x1f2\x15-\xb8\xc1\xc8\xe4\nOYƇv\xe8\x82&\xacGi\xfcE\xb5l\xfd\xb5\xb1\x9a\x15I\x9e6\x8a\x8by\xe3\x0fV\xcc\x1fX\x01\x12\xf8B\x96\x8aK\x8b\x89\u058c\xe6bn\x97\xe4\xc3\xe5\xf5MSθ^#\n\x8e\xef\xf5\x85\xba^\x02b\x18\x173T\xf6\xbaBڈ&\x8a8\x93\\\x18{\x83(\xe1(6ٯ\xf3i\xca\r\xad\xfb\xef9j\x12h9\x82\xb7֨\xc0\x14!\xcfbf0\x1e\xc1X\xc0[\x96b\xf2\x96i|\xf2\x05 N\xeb!1\xb6\xdd\x124\xeda\xfd*\xbe\\p\xad\xf1\x87\xd2x\xedY/\xa7\xfd\xd7\x19Fk\x1aC\x97\xf1\x99Ss\x98I\xb5f\x1cȘ\xd5\n\xbb_i\xe9]h?Y\xb0Ϳl\f\xe5/\xd5\x17I~h\ts\xc1\x7f\xcfњ\xb8Bcqˤl\x91\x84r|V,\xd6\a\xf9\x00O\xe9\a\xef\xa3$\x8f1\xae\xac\xad~dė[\x17\x90Y0\x8c\v\x92\x7f2\xff4lQ\xff\x95\xcc\xe9\x16I\x00\xa6\x10H\x02\xb9(\xe8\x01\x17v\x11vr\x9a~\xb8\xc1t\xc7\xe0\x1e\x9c\x1dX?Ǧ\t\x9e\x81Q9n\xfd\xb9\xb8\x96)\xc5V{\x18S\xfa\xe6\xb6|\xa9\xbe\xef\fB\xc2#l:\n\xbb\xb2\xb4\xd4\xcc\x10\x0f\xb6\x88\xc2'͕\x85\x94\xb7\x8fq\xe2G\xfaNm\xc3 \xb21\x0eLq\xc1\x96\\*7w\xe7R\xa6\bx\x8fQn\xac\x9b\xdf|\xc79-*H\x05\x99\xd4f?\x17\xf6k\xa2S\x8e}K\xf8 \v\xf7\x19\x8er\x89i\xa2kFD\n\xa4\xb1\xa6\xe4\xbb\xea\xef*\x99\x17\xdfս\x9d\xb7\x00\xd8\xc7\x11\x982\x8d1H'\x03y\x82\xda\xdd+\xb6\xe6\xa9ֲ\xc1^\xd2\xd5\xe4\v\xbf\x9b\xb0)&\xa01\xc1\xc8\xc8F\x00\xe2\xc3\xcf\xf6\x96c\x0f\x1fw\xd8\x10g{\x9d%\xae'\xf6\x00I\xa0\xa0\xe3n\xc1\xa3E\xe1\x12I6-\x1d\x88%j\xabF\x14\xb6\xad\xf6M\xf2ѵo\xa1H\xadU\xaa\x8drm\xf3\xb62&ެ\xad\xae\xdc\xe0l%\x0e\xbb\xfdH\xfd\xfa\xd7d,\x17\x9b\x92ך\xb3\xe3\xadK\x0f+\xb4\xc4R\x8ez\x04\xe3\x19`\x9a\x99\xd5\x00\xb8)?}\x8c\"K\x92\xc6\xfd?\xe3\x85\xf1\x97\xf8\xf1\xe6\x95\a\x95\xf8\aW\xe51\x8a\xb4*\xd5\xed?\xc3E\xb1\xce\xe2\xda\xf9\x8a\xd6\v\xf2s\xf3\xaa\x01\xf0Y\xb5 \xf1\x00f<1\xa86V\xa6\x93\xbe\x1c\x82\x19m\xfc\x1d\xbdSf\xa2\xc5\xe5=\xa5\x06\xaat\x04@K\xbel^\f\xbc\x191\xaf;\xe6G\xe8RL\xf3{\xce\x15\xa6\x94\xa1\x18\xc1\xcd\x02\xd7>\xa1\xc8\x12ί.0~H\xeaZJ\xde\xd6D\xce7\x06ۼ\xb5\x8bz\xdbNÅ>\xd5\x0e\xc2n\x9c\xf5\x00\x18\xdc⪈X(\x1d\x91\xa1bt\xa3={\x89ͷB\x9b\x87\xb0\xea\x7f\x8b+K\xc6%\x16\x1e\xbd\xba\xad(\xb8\xcc\x00\xae\xda|m\x83\x814&\xb7\xdd+8I\x1f\xd0\xdc\xecG\xade\xc0\x19\x99\xca\x16=\xb6\xd6^\x86\xa4|\x97\xbc\x0f\x98f\xb5lu>\xa3X\xd8>%#\x12\xbb\xcd\xd6\v\x9e\xb5\xa2l\x1d'I\x96Ֆ2M\xf4\x91%<\xae\xc6X\xc8\xfdX\fz\xad\b\u00954c1\x80\xcb{Ni\x11\x92\x92\v\x89\xfaJ\x1a\xfbɓ\xb0\xb3\x18x\x003\x8b\v\xadz\x89\xc2l\x13\x1f\x9a\xf9\xa6\x16\xc2]\xfc\x8cgVΪ\xe5\xe1\x9ar?R\x95\xfc\xa0?\xba\xdb=\xec\x1f\xd6_i\xae\r\xed^\x84\x14C\xeb*G\xbb\xeedY\xab{-\xe8Q6R\xad\xad\xc8\xf6Ъ\x9b\x167lI\xf6\x86\"/;5\xe2\xa7\xc2,\xa14s\xb9۴Y<fp\xce#HQͱ\xf7(A\xfb\x93\x91}o7\x84\x96V7H\xc2ڹ\xf6\xf2\xe5L\xf7Fzs\xd7{H\x9a\xdb\xe2[\xe5b?\xfa\xd5=ɻ.3\xb2.\xd6\xc6\x1f\x8fr\x97ű\xad\xb4\xb0d\xe2a\xf1=\xd6bM{\x1b\x03#\x91c\x90\xb2\x8c\xf4\xf7\xbf\xc9\xcdY\x81\xfe\x1f\xc8\x18W-t\xf8\xdc\x16M\x12\\\xbb֥\x89\x9a\xb7\xa1;p\r\xb4\xbeK\x96l\xa7\x85\xb7_d`\x05`b\xa3\n\x1a\xddf\xc42\x80\xbb\x85\xd4H\x82\x003\x8eI\xdc{\x84\"\xcd\xf5\xe4\x16W'\x83-;p2\x16'\x85\x83\xf767U\xb4 E\xb2\x82\x13{\xedI\x97 \xa8\xa5$\xb6\xfa\x9aؙ\xf4\xdd#\x16\xcd\xc4o\x9d\xf1ua\xee\xa8\xd7Q\x0e)g\xf6\xe3\xee\x84ݞ\xf1L\xca+\xd6c\xd3\x1dy\xafG\xf7\xb8.\x87U\x19U\x11\x03\x9b\x19T.\x89g?\xabv\x00\xa3^'[\xb96\x87\x1d\x83\xad\x12t\xacL!Z\x06?H\x13\\\x01\xa0\xcd\x10}\xa2F\xe2\xcbc\xdf٘\xd1\xe5}#\xc7ȄM\x98\xaeM\xe4\xd0Q-Uw\xd8fɫ\xd5P\xdf\x16W\x962\xed\bY5gj\x9e\x93ai\xeb\xfb\x1b2DU\r\xb8\xe3f\xc1\x05\xb0\xb2܀\xca\t\x14\x83L>n\x89\\\xfe\x9ai\x98\"\x8a\x92}\x8f\x9a\x86\xd62詛\xcdw\xca\xc5\xd8\x06\x04\xf0\xe6\xe0\xfe\xbd\xb2\x96\x18\x12\xc1\xbf\xadX]-h\xf5\x81\xf58\xadH\x02-\x10\xdc-P\xe1\x9aTl'\xbc)blI\x92\xb2\x90\x8d\xbc\x02\xd1\xcdd\xdc\xd70\xe3JW;J;\xf2\x96\x14s\xddV\x1c<W\x98fG\xd0\v\x99\x9b\x805\xb8\xac\xaf\xae\x8c\x00\xcd6e\xf7<\xcdS`\xa9̅i\x1bP\xcf\xc0\xf0\xb4*)\xba\x15\xb8c\xdcXsGt\xc92\xd2^+\x92i\x96\xa0i\x1b\xfdNqFe\x8fH\n\xcdcTeɛ枓0\x01\x83\x19\xe3I\xbe\xab|s\x00\x1eKq\xa9T\xd0.\xf5}qe%L\xe4|\xef\xd6\x19Ԋ(\xb1`\xc1\x96H\t/n\x00ED\xebB\xb9.2\xd9\xf6\x16\x8e\x19b\xbe\xab\xf6\xbf\xef\xd5\xce\xc0\xd3\x1bE\x9e\xb6c\xc0\xd0j6\x17\x0f&\xc5\xea\xf7\x10\xbeg<y\x8ae#\xc9s\xc2\x1d\xb0t\x7f\xad\xaf~\x16ը\x8cJK\x92F\x92q\xfb\x80,^\x95\xfa\xc1\x8c\xa1\xad\xaaU\x0f\t*\x17M\x8b\xf8\x04\x9a\u1cffs\xa3x\xf4\x9b-\xc3e\xfa!8\xdbY\xcfkQǂ\u05ebɄ%\xf1\xa4\xd1\x0eݠrt:@\f\xc7k\x04(\xf6)\x03g\"]\xbb\"\x8f\xc8g\x8a\xc0b\xaa\xffӞ̺O\x17G\x17@\x9e=e\xf0Ρ\xcbڴ\xaa\x8df\x03\xfcVO\xa6%E\x97\xe0]\xc9\x1c\xee\x18\xa1\x94\n\xa1\xaf\x82\xb9L\xb6\xf4\xb9\xbe\xab\xeav\xf9j\xee\xf1\xed\r\x06\xf4\xcfː\xb5\x84\xb7\xa10je\xe1Vm\a]&\x9c\x10b\x19\xddR8\x92\xb29\xf6\xfb\x1a\u07be\xbb Q\xa1\xa8\x83\\\x86\x87Gp\v[Tb3%\x97<\xa6\xd0\xe9#S\x9cJ?\xa0p\x86\n\x05\x95¾|\xf5\xf1\xfc\xc3oW\xe7\xef._{\x11\xa7<*\xdegL\x90\f\xe6\xba\xf4\xe6\xd5\xea\xd3\x04P,\xb9\x92\"E_n\x8cg\xc0`Y\x8e6\xaa\x90h\xb4\xd5J\x96.\x9a\xf3\xa2X\u0378\xc4\xcbp\x91\xe5\xc6\xd9H\xb8\xe3I\x02Ӷ\x81\x8c\v\x06E\xb4`bN|\xbd\x909\x8d\xf3\xcb/mBAa\x9cGN1\xbd(:e\xfar\xe0\xcaY,I䝶\xbe\x05u\xc42\xc7c/\x9a\x8d\xe5\x05\xbd\x12\x86ݟ\x01\x1f\xe1\bN\xbel\xfc\xe9ċ\xa6\xe5V\xa6$M\xd3.\xba\xe3b\xc2\r*\x96\xc0I\x93\xb2\xdf\xc2_\xd2<1n\n\xa8\xbd\x9b\xc0%*\x98\xd6\"7\xf0\\\xfd9Sq\x82Z\x93ͽ[\xa0YX\x98$\xd6B\x86>Yg\x17\x0f(ү\x9dH\xc9\x1a\x1b\xe9E\xb1\x04\xb2\xdeV@`\x82R\xc62ҧ\x86\xe9[}\xca\x05\xb9\xd4!\xe1\x1c\x87\r\xa3{Zxá\xf3\xcf\xc3r'=\xac\xd4\xf1\xf4\v\x95\v\xc1\xc5|Ȫoq1dC\xbd\xc0$\xe9\xf7\xf6\x0e\xa9\x9b\xbb\b\x88GBw\xb1\x01\x89\x89]\x16\xfd\xb22\xe0E\xaeqD5\x8fj\xfb\xe9A\x16j\x17fy<\xdai\xe3/\xafn>\xfcm\xf2~|u\xe3Ez\xc3-\xec7\xf5aFr\xcd-\xec0\xf5^T\x1ft\v\xeb\xa6ދ\xee\x1e\xb7\xb0e꽈\xeer\vۦދ\xe4\x0e\xb7\xb0\xc7\xd4{\x91\xddt\v{M\xbd\x17\xd5u\xb7\xb0\xcf\xd4{\x91\xdc\xed\x16v\x98z/\xaa{\xdcº\xa9\xf7\xa3\xb8\xdf-l\x98z/\xb2\xbb\xdd\xc2\xd1\xd4w6\xf5(\x96\xc1f\xfeg\xb7\xfdj\x98\xa2j\xcd\xfd\x82\x00#-\u200bu;\xb7+*xZί\xcd\xefR,?\xb2uX\x85hN\u058b2\xd4\xea\xe0ȑeeu\xee\xd7/\xc6\v٥\xb5\xab\x9c\xb5`\xccU\xe3\xd4D8?\x9a<\x19\xc1;\x870`\xf0\xf6\xb7\xf1\xc5\xe5\xd5\xcd\xf8\xfb\xf1\xe5\a?\xa6tН\n4ґ5\xfd\x1d\xdbCo\x8a\xf0H\xe4\xe0\xed\x90K\x99\xc1%\x97\xb9NV.\xf1\x137W/Pu\x9d\xaamh\xae\x83\x94\xad@\xa3Z\xf2(d\xb4;\x87\xd6%\xd4i\x19\xf0\x04\xd0|`7\xdc\b{\x02\b\xef\xdf\x13\xbb\xe0'\x80\xe6Aw\xc6O\xb7?n\xb5K\x0e\xa0x\xd8\x00\xaam\x18\x15@\xf4\xe1=6\xb4\x06.6\xdf6\xfc\xba\xc0\x19˓\"\xdbvr2\xea?\xbb\x89\xfd^ɖ\x05\x94\xbdf\xf6ڂ\x0e\xaa\x8aA\xc3VtpB}\a\x8c]\v;4\xc6!\x16\xc1a'\xcb=\xa5\x17n\xee\x10^ޕ\xa4g|\xfe\x8ee?\xe1\xea\x03\xceBHl\xb2\xddbf\x1d\xbc\xd4wkP\xbfl\xd4S\f͟'\xdd\xf9\xe2\x85(~\x94'7\x0e\xfdlcXbOؔ:*V\xb7\xe8n\xe7\xc4\xfa\x8d0/\x98b\x95\x0f1m7n\x91\x14\x11fF\x9f\xca%\xc5\x0exwz'\xd5-%\xdd(\x154,\xeaa\xfa\x94&\xaaO\xbf\xb0\xff\xeb0\xba\x9b\xf7\x17\xef\xcf\xe0<\x8eAZS\x9bk\x9c\xe5I\x01\xbbk\x8d\xf4\xdd\xf5\xae\x9b\n\f\x80\xce_\x0f \xe7\xf1w\xfd^ \xb9CȆ\xb4\v˒\x03\xc9\a\x9d\xc9\xe4\xb3U饂\x89R\xed\nk\x8b@i\x02*\xbf\xb5\x81\xc1>\x8e\x92v\x81n0\xa5\x82\xedS)\x13d\xa2\xf7\xc0\x17\x0fP\x1a\x0e\x87\x03w,\x1f\xefz[\r8\x8c\xd7\xe8\xd7n\xa3\x1d\x9cu\xf7\xcbm83\x19\x9f\x81γL*\xa3\xab\x86\x05#2\x04\x83^\x00\xd9F׃Qu\xb6o\x00\xff\xa8>\xb4gG\xf4/\xfd\xfe\xb7?]\xfe\xed\xdf\xfb\xfd_\xff\x11z\x9f\x9af\xa3\xd7\xcc!\b\x13\xa8f$d\x8cd\xb2\a\x16c3r;\xaf\xf3\xc8\x02d\xae:\xb0G\x1bfr=ZHmƓA\xf9k&\xe3\xf1\xa4#IKC\x8f\xfa/\x14\x04\xeck\xfc\x12,鎚\x13\xd5`\x9ae\xb7\x1d+\xefߓ\xcaL\x98Y\xb4\x87\xd8\xedz\xdd)n\f\x12\xce\x03\f\xaa\x94\x12\xbb\x03J\x03ح@\a\xbaF\xc2\xc9\xf2\x8dg\x85\xf2\xc0\x8emV\xb2\xe8@\xcbh\xb9\xed\xccM\x17\x8bU\xa56\xc9\xfc\x959\x92\nMف\xe8\xf9d\\6\x1ezA\xc6w\xf5lղ\xbd\x84\x7f+\x01\xe7\xdf?\x89\x9f+\xa9wsuU:\xed\xac8\x83QR\r\xb5\x03\tO\xb9;\x81Wu)zU|8\x8a\xb2<Ԙ;\n)\xa6R\xad\x06寘-0%(Ð`Tl\x1e\xec~ʡ\xda!V\x03w\xb7\v\xa4\xd9d\xc1\xf6H_\xf7\x02H:8O\x94+\xda\xed$\xab2F\xc1\xf8\xc5\xfc[%?\xbb[$\x85\tyU\xb0\xe8\xb8\u05ec\xed\x87M\xe3,e\x92\xa7\xa8\a\xd5.\xa5\x03a\xa2\x87bI\x89\x9d\x8d\xb6W\xcfj\x1f\x01b\xbe\xe4\xba-\\z\u05cb\x89\xd5\xfb@\xd3D?C7\tj\r7GՙN'fl\bҵ\xf3\x83\xbac\xa8$sCh\x83\x99T)3\xa5\xe5\xc4\xfbL\x86e\xee\xcaWek\xeb(\xc9&L߄\xa4\xb1\x9dB\x13*Y\x893\xf8\xcfW\x7f\xff\xd3\x1f\xc3\xd7߽z\xf5\xcbW\xc3\x7f\xfb\xf5O\xaf\xfe>\xb2\xff\xf8?\xaf\xbf{\xfdG\xf9˟^\xbf~\xf5ꗟ\xde\xfdp3\xb9\xfc\x95\xbf\xfe\xe3\x17\x91\xa7\xb7\xc5o\x7f\xbc\xfa\x05/\x7fmI\xe4\xf5\xeb\xef\xbe\f\x1e\xf2\xfd\xb0\xce\xd0\f\xb90C\xa9\x86\x85\x10<\xda\xec\xa1\rs\xcf\x0e#J\xfd\x0fe$RQ>D\xc4\xd6\xff|C\xabNl\xe8\x18Yi\x8c\x14\x9aO/\xe7\\\x8c\xab\fËSLՆ\xff\x85<\xf4\xe1\xd3\xd0ݷ\x9e\x05\x9b\xea}\v\x1d\v\x1c\x81-\xd0w kK\xfbK\xdbG\xc2\xdd\xe1\x16\x03*\"\aӰc\xaa\xfc\x98*\xffLS\xe5ׅ\xfe\xd4yr۞\xa3\x03\xd1c\x9e<4O\x1e|q\xd8l\x8b\x9eܽg\x18a \x96з\xb4\xbf\x13O\xe8\x02o\n\xc42\x99\xe5\xd4d\xaa\xd7\x199T\xfa\xfdjO\xecg\xb1\x9c{\xad\x1b\x83ָt;Z\x7f\x15\xdcƺ\xc1y\x92\x00\x17\x85\x93\xb47#`\x89/Q\x85E\xd6\x01\x18ez\x00\x97\x04\xa0\xba[\xe0\xc6\xf4\xbd\xc8rMY\x7fe\xb8\x98\x8f\xe0\xafD\xab@\x008,\n\x17\x90\xe6\x89\xe1\x99' \xa9\xdaaU\xbdI\x80i-#N@_\x8b\xfc\xf7v\xa8\tӦ\\\x12\xe2\x1e\x18vk\x11\x97\x11\xc6\x04\xef!P?\xf5@\xf1\"Z\xae\xf9tE\x1c\xbd\x14\xcbbl\f⼀\x14\xa3\xb7\xf5\xd9=\xb6\x97\x86\xbb\x92\xfa:hM\x8dz\xf5\xa2X\x14s\xdd\x02\xc8Y\xddJ\xac\xaa\xef\xea\xde\xf3\x84\xd8\x15\xfa%h\x1b\xb2ƙ\x9b\xb5\xfat\x15\x19{\x13\x05\xdb8\xbc\xf7\xbcی\xf00wo\x88[\a\xaaAt\xe1\x93\vo\x9f$\xb4=dX\xdb1\xa4\xed\x16\xce>\x14\xcav\xd8\xf1\xd4\x1au\b\xb0F\xb7\x0048\x8e#\v\x853~\x7f\xd6\xeb\xc4\xd5sQm9\x80\xc7\xf4\x00\x87\x19\x0f\xda'P̤0Caa\xc2Ȣ\x05\xb9\xa62\xf8\xa9X\x1e\"ӟ\x00B\xbf\xc8\x1c\x1cƠ_o\xe49\x8e\xd6\xfch͏\xd6<ؚ;u\xfa\x8cM\xf93\xee\x94\xed\xc9\xe5\xb3^\xe0\xa2\xf5/\x1a\xe7\x9fmF\xa0\x990<\xd4Y\xf9J_\xab-\xa3>\xb5w\xf4SK\xdb\x04֪\x1ea\xe1+'GgX\xe8\xfc\t,\xf8\xdc7#\x96\xd0\xe3\x8f\\|\x0f)\x13ln;Q\x92)w\xa5:\xdf\xd3\x11\x14`*\x1e7\xb6\xc7\xc5\xe1rM\x8e\x93\xccT\"\x99\x9f,\xd7ώ\xa365\xb7\b\x17\x98%r\xe5:f\x8a\x18\xae\r3d\x96\xae\xd1\xf8\x01\xe0\x82\x8c\x87\x9d\xcd$O\x92\x89Lx\xb4\n\x17\xbd1\x11\x82,\xa7c9\x96\xd4\b\xde\v\xf4-˜'wl\xa5\apEgf\x060\x9e]I3)NE\xd6\xe7S\xbc(\x1a\xe9\x88\xd2ы3J\x19i\x03\x86\xcdI\xe8*ĕ\x1f\x02E\xaa\xb5\x81\x15\x00\xf1;\xae\xbb\xeeӽ\x1d\xe6\x96\x02~a\xefJ\xaeӮ\xab~r\xf1I\xf8\f\xa3U\x94\x84۬\xf3\x88\xfe\xef\x1eJDAG\xad\xb7\x1e$\x01\xf4J\x1bL˶a6\xb9\xc3m\x9b\xc9L\n\x8dd\x02*nyѭfX$\xcct\xc75\x0e\r\xf2\xa8\x97\xec5e\xda\xfc.\xdb\xd4\xd2II\x86\xc4?bIB͏\xd2\x14cʬ%~\x99*z\x97\x1d@+\xdeZ\xba\xf4\xb8K:\x90?\x0e\xab{-\x98\x88\x13T\xb6_\xa1\xcb\x01\xae\xd1'\x98*\x17̷aH\r\xef\xb2)KJ\x84F\x91T\xb1\xeb\x05Wv\xf6b\xcaO\xf0\xe8]Y<\xb2\x04M\xcf#g\xeb\xc3\xf7\xa6<Mdt\xab!\x17\x86'u{Ȳ7\xa4{P\xa37\xd5 \x13S\xfdsX\xe9\xc4pA\xad\x88O\xbf\xa8\xffd?\xf01;]\x94\xa2}?\xdfG\xf4\x82<\x15\x89\x86\x05SJ\x7f\xb7U\xbei\x81f\x92\xc2\x17\x12*g\x8b\xa6\rh\xef\xa8\x17@ն \xadh\xb8\a\xa2Z\xb3If\x8dL]\b\xd9.L\x0f\xec\x05\xb4\x97\xff\xebm\x8b\x03)VC\x82\x84\vl\xf6/\xe6\xb6'j0\xd95\r.\xec\x91ۡ\x06\x93\x8c\xb9\xb2\x0fhY5z[\x16c\xef\x02\xe6WR\x1ax\xd5?\xed\xbf\xde*j\xf5é\xcex\x82\x85w-\x9a,\x95#\xed0P\xcd\xd3,\xa1*\x11F\xfd\xd8>g\xcb\x1d\x87U\xb9\xe8\x05\xd2t\xab\\6\x84\x1a\x80\x96`\x14+\x9f2\x10>Vj/Eč\xca]\xac\xf2\xaa\xffG\x7f\x00h\xa2P<0\xc0\x9d\x14}c\xc5h\x047\x92\xdaMU\x03\x0f\xa6IM\x1e\x05\x16M\x90\xf0\x9e\nP\xdc$+\xeb\xe6\x83iR\xd7c22\xf4p\x1c\xd7h\xeb\xf2\x9e\x1bwN'\x9c\xec\f\xbe\xa2P\xc1\x14\xa1\x02\x95$\x13\xbe\xc4\xd3\x05\xb2\xc4,V\xbd@\xb2\xb6\xbb\x04=\xff\xe4\x9f\xd4<\x98\xdax\tG1\xcc\xf0\x06\xd5\xce:\a\xd5\xdd\xd3\b\x9ds\x17u\x12\xe0\a4\x9d\xdd\xeb\x8f77\x93\x1f\xb0\xee\x17\x1en\xe5iD%>\x9f\xc4<CE\xf8ޗ\xf0\x7ft\xea\xed \xce\xefGz\xb4*%k\xdc&E\x84,U\xf92r\x1d\x96\xec\x10\x8d0\x9e\x84j\x00\xc0\xdfdN\xa5\xc6)\x9b&\xab\xaa\x8b,\xb5e:\xa1\xa1\x87Þ\xb9\xb0\xbb\xdc\x1f\x91Ŕ\r!\x13\x8b\xccs\xc7|@Uk\x8c\xe5 \xeb\xfa\xb6x\xee\ue898^\xaf\x13\xea\xb8B\xa7:\xd9\x1fY\x9d\n\xa6\xe9:\xbcP=Ț_7\xc6\x172\x92\xeb\xdaps3)V\xc1qs\x1a\x9c\xee\xa7\x1fV>\xfe\xb8\x98\xa2\xeb\xed\x9cw;\x02\xc0\x85\x1d\xa6U\x8a\x0e\xa3\xebj\x81\xba\x16~v\xf2\x9f\"\xbc\x82W\x9dh\xba\xb3\x97\xfe\xb0\xb4\x83\xabu\xa3\xbf̧\xcb&;\xbc\x97\xe7S7\xa8e \x10\xb1\xf9\x1ev\xe4D\xa7p\xe7\x10\xf1\x96=̳8\xeb\x1d@\xc4\xecac*\x87D\x11\xea\x0e\xa1v\xb1\x13\xb4\x06\x8b\x8e\xfe\xfb\x02\x1c\x0f(b\x84?\feM\xa7\x03o\x879\xeev\x90\xc3nkK\\\x14\xdb\x15\x88<\x9dv\xb0$.\xcbH\xec\xad\x05\xc6-|0\xd1*u0\x82+;\xbc\x12\x8d\x13L\xb1\fa\xa8\xaf;\xbc\xa1\x91~\xf3\xe7?\x7f\xfd\xe7\x11\\u1\x19ea\x99\t\x18\x9f_\x9d\xffv\xfd\xf1\xadm\xe26\xea}B'\xdbl\xdb\x06<;\x84\xcc\\[R\xc4=J\x1a̤\xea\xb2´\xd7p\xf9o2\x12\xb4\xa7\t\xac\xb35\xdfF\xda\xf8\xe8\x85\xecL\x17'6\xb4J\xd4{f\xc7c\xa2\xec\x9a*\xf7A\xc6qM8\xfa7o'\x05\xa9z\xb3\x1d@\x93\xcc-0\x9b\xed\"ܹL\x96$$\fn\xdeN,\x83\xc2V\x96\xae\xb6\xf5\x01\x9b\xea[\xa1\xa9O\xc2\x17М \xaa\x94J,\x8a-\xd4]\x81ѣ_xdGZ\x95)\x82\xe8\xd2H\xfb\xbd\xe7\x8f\xea\x0f\x96W\xe8\xbf/\xe1@@\xfb\xf4@\x92\xb0\x99\x9aXK1\x04\x13]OM\xf4_\xc6R\x1c#\x92툤p\xf5Ru\x8b\xe3\x8f\x11ɧ\x1d\x91|n>2\xf8\xd2Lᵑ\xd9Y\xaf\x83N\xf4'\x05\x91\x03a&\xca'\xd1\xed\x035@\x1c\xb0\xa4\xa4d¶\x7f*\xb3\xe3r\r\x88`\xc1+\xdeTuN\xed\xa0\x8bڌ@\xadO-<\"ϊ\xccW\xf9@I\xff\xfe=\x99Bj|kO@\x94\x1d\t,;\b\xe0N\x1f\xa2\x89\xfc\xb5Ŧ\xae\x1cv\xc4\xd5\x13\xcb\xe5\xea\nÈ\x14\xd3\vԴW\xc3{jb\xe4\x9evʹ\x14E\t\xd7-\x1f\x97\xfe\x05L\xae!c\x9a\x1e8S\x86\xe1\xc5$\x8ar\xebD\xc6\xfd\x80\xeamc@0W,B\xc8Pq\x19\x83\xed\xfa\x17\xcb;\xffqNq΅.\x9f\xa4H\f-\x15\x83b%\f\xaa\b\x97\x8f\xfe\x19\xc1\x87\xaa'v\xe9=dn\"\x19`\x87\xe5\xac\xc9\xc5M\x00\x91\xf7\xd1I\xfa\xb1ꓳ$YՊZ\x9e\xf44\x87_\xa4m$Q(\x13\xeayo\"\x89\xbc)\xae#\x8fH\x15jTRc\"\xdetפ\x93\x13\b\x8bE\x8b\x0e\x8f\xf9*k9Gh\xd3\x11\xdat\x846\x1d\xa1MGh\xd3\x11\xdat\x846\x1d\xa1MGh\xd3\x11\xdat\x846\x1d\xa1MGh\xd3\x11\xdat\x846\x1d\xa1MGh\xd3\x11\xdat\x846\x1d\xa1MGh\xd3\x11\xdat\x846\x1d\xa1MGh\xd3\x11\xdat\x846\x1d\xa1MGh\xd3\x11\xda\xf4\xe9C\x9b\x82.+q<\x13\xca\xee\x9c\xf5\x02\x15\xa9?\xb1 \x05\x1e9\x18\x90\x9c\xd5\xf2\xebA\xb3\x1e\xce\b\xeagG\x95\x8fǯ\xba\xb4xQt@\x9f\x1a\x9e\xa4\x9f\xbb'S\xd9\x14L\x9ff\xb2\xf8O\x8d)h\x80\t\xec\b\xbd\xd0\x04\xa1\xce7\x04E\xf0\x18\x82 \xc8\xd6=\x8c\x1e\xb0H\x00o\x9a\x87D\x0et\x89n\\\xe1\xd8\xff\xc2\a\xd1\x02%\xd9\x00\xaa\xb0\a)\xb0^:\x0f+\xc86P\x02\xdb\xd5\xfe \x8an\x9e\x84\x10خ\xf4\aRtS\xec\xeb}U\xfe \xba\\\x1f\xbe\xc2\xff\x04\xd5\xfd\xc3W\xf6\x1f\xa8\xea\xc3J\xe6A4\xf7T\xf4]e>\x88\xe4\x9ej~Y\x95\x0f\xa3\xb9\xbb\x92\xbfV\x91\x0f\"ܵ\x8aߡ8\xd51\xb8\x0e\xcf$\a\x86;P\x82\x8do\x16\n\xf5B&q'\x9f\xf6\x8e\v\x9e\xe6)\x99\tM\xe6\x91/+4\xb3\xbf\x8c\x948'\xeb\xd3]\x19\x8e\b\xf3\x18\xedC,\x19O\x02jrEk\xbd\x05\xb3G\xaft\x1eE\x881\xc6u\n+DC\xbe\x1eU3\xb7U#\xb2\\o|%\x8fP\t\xcc\xd8\xfd\xdd\xd7\xff\xd7\xf3\xda\xf0\x9da `\xe3q\xb0\x86\x8d\xeaz\x81Ϟ\xed\x00\xd4\xe8\x12n\x84&R\x9e\x06\x9c\xf1\x000\x83z\xc7\x04\xd1|\x00\x94\x01\\t\x05At\x01dt\xb2\x9c\x1d\x81\x18\x0f\x800\x1c\x8fz]r\x05M\x00\xc6&\x90\"\x88p\a\xf0E\a\xdf\xf6T\xa0\x8b\xfd\x80\x8bP\x91\x84\xce`\x8b.V\xa4\u0381\x86^\xbb\x179\xd0\xf9\xe9\xf8\x9dRt\x1d\x83\x9b\x03\x80*\x9e\x8a-\x87\x80\x10t\xe0K\x97\xdcZ'\x00E\x17\xf0Dp\xc4\xd95\xd4\r\aL<\x00\x96\xe8\x92i\xee\b\x94\xe8$>\xa1\xe5\x88\xe0S\xd6\xdd\xcb\x10\x9dK\x10\x0f\x00\"B\x93h%+\xb7\x04\xa2\xcex\x84,-l\x94\x1d\xaa\x90\xa0(\x1f\x04Q\\/9\x1c\xb4tp\xf0\xb2A8\x88\xe1a\x00C\x19W\x87\xc9\x0f\xec\x06/t\x01!t\x90\xe8P\xe3\x1fTT\t6\xda\\p\xc3Yr\x81\t[]c$E\xec\x1d\x19\xad-i\xdf)\x06=~\xb4 W\xec\xcc{\x9d\x8eZ\xc1\x82\xb9'gb\\\x1e\xa8-\xab!ޔ\x8b\xf0\x11\x98\xadS\xd0\xec\xcd\xfa\xe9ɗ\xad[\xbc\\ʠ8Rz\b!\xf8Qށ\x9c\x19\x14\xf0\x8a\x8bR\x0e\xfc\xf3\xa8u\xb2\xa0\xce\x17UjMZ\xfd\xe6+o\x9an0\x9fobǦ\xb6\xb4~\xba\xbc\x9e\xbb\xc1\xe1\x13{\x8e\xf0,O\xba%\xf7(\xf1\xb8\x91\xd9\xf3_\xbc\xfa1|o\xec\xb8Kkb\xb3ԮmC\x00\xcd\xcfT\xa8\x82ag\x8fB\xce \xe0\xc9c\x0f\xc1\xcdj\xe8\x987\xd9=P\xb3\x1a6\xe6?\xd0}0\xb3 \xc8؋g87`b\xe1\xdb\xcf=\x101\x17\x9e\x05\x91\xec\x00\x0f;\xee\xc3:\xed\xc3\\<W\xc0\xc0\x8e\xfb\xb0Oh\x1f\xf6y\xec0\x1a\xbdN~\xa0\xd6%\x93\x83\x85\x99\xa5\xb9\x828W̹\x8c2\xda\xf4\xa4\vU\x15\x86\x8a욄\xa0\x1c7\x16\xadffy\x12м*Ϥp\U00050ad7\x16]\x8a\x9aM\\\xbc\x89:\xb4ˎY\xbb@)DC3%I-QS\xe7\x05AET\xa7K\xc4\x14\xda+\xe90\x0f\xd9X~\xd0|.XbC,b\xb7\xe1\x01\xfe\xe5n\x81n\\Հit3\xa9\"N\x0f\\X\xb0$\xa4\xfcB͉\x80\xc1-\xc1\xe9\x8aa\x8e\xe0\x9a\x1ekL\x8f\xdd\fK\xa6&R\xcc\xedb\xb0b\xc0x\x9faDaG\x94 \x13y\x166\x7f\nVW2W\xe5\xfc\xddc\xe3\xcaQ\x86\x806\x04O\x06\xe5R\xf7\xf5\xc3\n\xebM\xbc\x04(R\xdd\xc7\xf5i\xa2g?\x0e\xbap\xb6|\xcch\xa1\avu\x88\x1dK\x1eSz`\x15\xe4\xa1H\xcc)j\x1d\xc1GK\xaf\xb4\xfb\xf4x\x1c\x81sf\xf8ҟ\xa8s\xe2\x85\xce\x17\xe3,\x1e\xb5#b\x1eѳ5\xbd)j\xea\x1f\xd6h\xa7\aK\xceh\xbeM\xc9\xf5&\xfaJH\x906(\xce\x057+\xb2~z\x91\x1b\xa0\xb6g\xafi\xf0\x01B\xc550\x98\xa2a\xee\\+)\xbdsX\x1aP\xb0i\x12\x12\x9cLȔ\xde\xec\x14P\x98!3y\xc0\xd3\xfd\xe6\xcc\xe0\xce|\x80\x05>\x8c\x0e\xab\x0e\x84a\xa2\xd6u|\x06\xb9\xd0h:\xec\x0f\xbf\xf9\x7fϷ?\xe4)\xca\xdc\x1c\xc2i\x1f,Ax\xb7\xe0Ѣ\x99o\xe0)\xb5Y˻\x1c[\xa3\x9c\x92\x1b\xd6n\x89x\xe2\xc7G\xfe\xcbe\x15\x83\xa2F\xdf\x12\xfb\x9a|5\x1f\xc8_q\xac\xcaG\xf8\x05\x06\x8cl\xd8\xc5\xd5\xf5o?\x9f\xff\xe5\xf2\xe7\x11\\\xb2h\xd1 \xca\x050:\xb7\xe4E\xd3\xfa\x95\x05[R{\xaa\\\xf0\xdfs,6V\xaf\xaa\xfb\xbc.1\xf8^t\xc3\xf0\xfaA;Er\x14:x\x81~\xe6\xda>\xe8\xd5R!W\x83\xf7\x99\xa4\xf2\x8f\x92i/\xb8B@\xf0\xd5Lj\x8a[iM\x94\x81\x05*\x849_z:Y\x92\x1b\xf7pd\x16\x97\xa0b\xab\u0094\xed\xa5(\x96Me\xee\xb76DS\xa0!\xed\xae*\\\xf4\x10\xe7fO\xdb\\\xa3\xf6×Os\xdb,-S<e\x8a'\xab\xe6 )|\xbd\x92e\x1en峺\xf4n\xb2\xf0\xe2\xfd\xe55\\\xbd\xbf\x81Lٶ\x9e\x14\xd0\x1a\xff\x1d\xe4L\xc9\x14\xa6H\vT,x<\x82s\xb1\xb2\x84\x9c-\xf7\x8c2(\xf1\x86v\xa7\xe2R\t.\xcf\x04'_\x8d\xec\xfb\x04X\x1c+\xdf\x12Q\x05/\x8f\xb6\x0e\xd9\x14\x99\v>\xf5<Gj\xa7ސ\x81\x8egl\x02\xa0^k\nX\x1d\x1e\x9a\x10\xeb\x15f\xc5\x03\xe3\xfd\xb8D2R\x8a\xb4]Bk\fI\xff\x92\xa6V\xf6\x9e'\x01Z\xddp\x12\x94\xae[cO\x1d\x9f\x94\t\xabB^{\xc1\r7\x8am\xd5xR\x8ac\x11Q\xdb\n\x7f\x00Q\xc2\x04о\x89ǅ\xee\x14\x1d#\x06\xf0\x15|\v\xf7\xf0m\x00EJw}\xe3\xb7T]\xe3\x89\xf0\x88\xa2\xccv\x8f'\x1d\xd7\xf9\xafdƈ\x12\x8c'\xb4\xcaS\x1etƅ\x16\x18\xef\r*\xcal8\x89\xf1\xe7e\x87\x8c-M\xe1\x93\x14{\x1a\x98\xcdNT\xc1W\xb1\xe9\x0f\xa0X%a\xf7\b~\x00\xc9{\xf8\xd6\xe2m\xbe\xb1C$\xa4\xf4\x953g\\\xd7\xe1bȉ/S*7\xa4\xccD\x8b\xfa\xb0&\xad\x12m!\x82Ծ2q\x1abi;\xa4R\xa6\xd22\xf4sR\xdd0\xf8욤nKT\x17S\xba\x91ַ\xc9I\x17\x97SN0\b\xa9쌾\xdb0Д\x9d\xc8\x06\xed\x18\x1e\xdc7\xb8*EX\xf3\x97\xfa`>\xd9\u0088\t\xd21\x853TT\xaf\x0f:R6]Y\xc4$\x8fP?\xab\x15̔42\x92IGٚ82\xb4Yv\x05\xe7w\xc1\xb2\xf5\x1f\x17\x93\x01Յ\a\xd4D\xe1\xfa\xed\xcdd\r\xb3\x10@\xf3\xe4\xe6\xed\xe4\xe4\x19\xd9\x1aV`\x1a\xd6\xf1\xdf\xc4w\x970\xac\x16\xb2\xf7\fũ0\xac\xf2Z\x15\x8f6!Ôe\xc3[\\y\x85\xad\xe1\\\n\xe2\xd1\xf6\xa0\x8bɧ,kME!\x8b\xf9'\xd4\x0f\xc1\x19\x9az\\\xbb\x1b#\xa4r\xe9Y\x10\xb2\x1b\xb6\x92:\x8a8\x93\\\x18\xbd\xab[\x82\x17\xd9\xed]߱[±[±[±[\u008bvK\xf8_\xf6\xaew\xb9q\xdc\xc8\x7f\xd7S\xa0\xa6Rg\xfbbifS\xa9\xab\xc4_R\x93\xf9\xb3qe\xc7벽\xb3\x97\xdb\xecmA$$\xe1L\x01<\x82\xb4G\xb9\xbdw\xbf\xeaF\x03$%\x92\x12 \x8fw.\xcb\xccVe\xc6&\x9b@\xa3\xbb\xd1ht\xff\xda?:\xa2%\x8ch\t#Z\u0088\x960\xa2%\x8ch\t#Z\u0088\x960\xa2%\x8ch\t#Z\u0088\x960\xa2%\x8ch\t#Z\u0088\x960\xa2%\x8ch\t#Z\u0088\x960\xa2%\x8ch\t#Z\u0088\x960\xa2%\x8ch\t#Z\u0088\x960\xa2%\x8ch\t#Z\u0088\x960\xa2%\x8ch\t#Z\u0088\x960\xa2%\x8ch\t#Z\u0088\x960\xa2%<\aZB!\x8c\xae\x8a$\xec\x1c\xdc\x16\xb27z\x9dCϳ\x1bG\xca;\xcb\x01$\x99Eޑ\xa6qHy\xe6f\x82\x89V\v\xb9$G\xef\xe5\x9a+\xbe\x14Sϟ\xa9\x1f\x97yy2\xf9\xfc\x91\x86L\xaee\x18N\x02\xfc\xa9A\a\xae\x8f\x88pD\x1e\xa8\x8f=N\x1fy\x98\xcey\t\x85\xb4\x17\xec?O\xff\xfe۟\xa7g\x7f:=\xfd\xe1\xd5\xf4\x8f?\xfe\xf6\xf4\xef3\xfc˿\x9e\xfd\xe9\xecg\xf7\x8fߞ\x9d\x9d\x9e\xfe\xf0\xd7\x0f_\xdf]\xbf\xfbQ\x9e\xfd\xfc\x83\xaa\xd6\xf7\xf6_?\x9f\xfe \xde\xfdx \x91\xb3\xb3?\xfdf\xf2\v\x1fN\xdb\xfa\xf8\rJ\x0e\xfdpN\x8eۚ\x7f\x02\x03\x1b<R\xbe֕Bč\x84\xd4\xdck\x84M\xc3\nU\xca/F1\xa3M\xa6\v\a\b3\xea稟\xe1\xfayC\xb2\xd3\xd6\xd0\xe01\xae\xc9e\x1a\xd0\xd0`\x9an\xe3ƪv?Ni\x98^\xcb\x12\x8e\xd31\x95\xc2\r,\x14l\xe0\xd9\fQ[[\x15L\x12k\xe98V\xb74\n4\xdcEHzδ;\xfb\x06\x93\x86\xa0\xa9\xaa\xef)\xd0\x19\x98\xa6b!\x95H\xad{\xfa\xeb\xb3wQ\xafA\xab\xc7B\x96\x1b(\xaa\x14\x9f\x82\x02\xfbm}\xb9m\x13\x82|n\xa9\"\x94\xc6\r\x88i\xa4\xec\x9a\xfe\x123\xa9Or\x10E\xa8w\xaf\x14ƳPc\x8c(!\xd6\"\xec1܀Nn\r~\x12\x13zA\x92\xa0\x99\x0f<\x03\b\xa5\x9a\xfa\xb5N\xb7>0\x9b<\xbd`\x96\xdc\xdc\xd7R)\xa6Ю\xc2\xf3\xed\xa5c+:\xc8\xe2S\xf9,\xde1\xba\x1eׅ|\x90\x99X\x8aw&\xe1\x19j\xea\xc5Q\x96\xf9u\x0f\xd5@\xa2Ps\xa9\xcaBg\x06\"\xa8`\x89\x00\xb7\xc1\xc6|\x11'a\xc9#\x92\xb2א4\x93\xbb\xc1\x81\xf4r\xc5\xc0\xd1\xcby\x01R\xe1b\x94\xc1\x84!\xe4\xc4\xe6ZgT1\x99m\xea\xf1˸+(\xa5\x7fR\xe2\xf1'\x18\xada\x8b\x8c/}h\x12j%\"\xd3DkUuSeO\xb6`\x10\xe6/*\xc1x\xf6\xc87\xa6\x0e|\xfboFP\xbc`_\x9d\xa1}\xe0\x86\xf91\xa6\xecwg\x98a\xf5\xe6\xf5\xf5O\xb7\x7f\xbb\xfd\xe9\xf5\xdb\x0f\x97Wqv\x1c\xd6L\x04\xde\xf9'<\xe7s\x99\xc9\x18ǳ\xa5,\x90P\xdf$\x06\xbb9Oӗi\xa1\xc3K\x96\x90\xdf\xee.\xc4\xf3\xdc\x1c\x17]j\x82\xba\xa1\xd8-Z\x03\x0e&\xb9,\xb8*}л\x1e&\xac1\x04\xc4B5/\xd6\xf6\xd19\"\xfc\xa5\xad\x15|\x9dB\b\xff(\x96<]-\xcc\x1b7\x8cM\x8d)\x17E\x95\xb1\xeboo/\xff\xbd5/\xf4{\xa2\xa8\x1du\xe09.A\x1f\x14\xe9\xe85\xbe\xb1\xf8\x15\xe3*\x7f\x99\xab\x1c鏳\xda\x0f8.'\xf1\xa6R\r;&U\x83n Y\xc6\xd6:\x153\xb84\x027G\x986\xb5\xfa+\xe1\xe2\aW\xce@RA\xab\xb9l\xd3\xf4\x84K\x8d\x98\f\xc1$\xb5\xea\xc9]_\xf0̈ٳ\xed\xc6\xe0\xc8|\x80\xe3\xfbQ\xab詰T(]R\xc4/J\x1b\x00\xc0\xaf\xd0\t\xb31\x85F\xb1@kǋr2\xeb\xcdX\x1a\xc7\xf3k?r\xbca\n\xa6\n\xb0\xb7ݛ\xb1\xfbX\xb8\xb8A\x86*`\x02!\xa6\f\xf4\x945x\x9f\xba\xe6\xe6^\xa4X6\x15\xebcSt\xc5.\x8f\x9f\xfa\xdd&\x17\xd1\xf7\xa9\xe8[\xdb\xec_\xbc\xe7\r\x8f\xc6F\xdb>\xe0ѷ*\xdb\xdch]\xbe\xf70&G\t\xf2\xf7tZj\xdf\x03\x05Rd\xe8^c\xbah:\xc5E\x04\x13\xd1BZ!\xe9\v&,\xcds\x1b\x88\xa2R\xaf\xcdׅ\xae\xf2\xa3\x18\v\xce\xfaחo\xc1+\x86\x03\tȟPe\xb1Ah\xaa@\xc2l\x17\x1fݟǾ\xa3\x9c\xa6\xa8l\x1bo\x1e\xdcu=\xfb\xc07\x8cgF\xd3\xc11\x98\xa2T]\x11\x12F\xa1\x9a\x98\xca\xe8\xb9.W\xdb1\x1d4\x0f\xbb\xdf\t\a\xff\xac\x13l|$\x13v\xd1-\xba\xe1d\xf9\xbd0\x80\xbf\x9d\x88T\xa8D\xcc\xe2ﲟ1\r\x02%\xffJ+0/G\xc9\xfe\xa5\xcb\xff\x81\x88Iٖ\xdcI\x14\x8e&\x9d\xe99\xe6+\xa1q\xa9\f\\W_.\xb0\x0fW\xdc\xc2\xff\xb5\x9a\x8bL\x946P\x828\xb5\x90\x0e\t\xbf\x91k\xbe\f\xd7&^\xfa\xad\x10\x90\xb6\x94\xa9\nAAsh\xcd\x12q\fP\xdaO\xfd\xbb˷\xec\x15;\x85\xb9\x9f\xa1\xf8C\xc2e\f\xea\v\xf6\xcaܲ&r\xe1\x86\b,\r&\x89\xb6\x0303\xd1T\x9f3\xa5\xa1\x1af\xe5x\x1a\x13\x1dr\xc1+\xaa\x90\x12\xe9h\x9a\xbe\f\xd3t\xe4\xc6\xfa\x9d\x11\xc5\xd1\xfb\xeawϰ\xaf\xbe\x8duf\xad\a_\xb4W\r\r\n[\x8b\x92\xa7\xbc\xe4\xc14m:\x9d#\xb8\xa3\n1\xb2;\xac\n(\xda\xc14\x7fe\xaa\xf0\xcb\xec\xd2F|#U\xf5\xc9V\a\x98\xa3u\xe9\xf6\x1d\x92ct\x95\x14\xb3\xa3@\xf9H\x9eg\xb0*\xa5n\xeb\x13l'Mэ[\xfbZ=\xdd\xfe\x8a\xdb\x03\xdcHA\x9aq0M\x0e\xfdFS\xbdޙ<\x1cD\x05\x8f8\x157&ܡ\x9c}\xca\x16\xfc\x99\x86r\xfeڔ\xed\x98\xd0}&\x1eD\x04\xd0\xf8\x96\xb6|\x03T \xff\xc1I\r\x92\x8d\xa0\xcaX\xc6\xe7\"\xb3\xae\xa1\xd5\x1c\x8f\x94V\v\xd2䙃\xaa\x85Ύ\x87\xbc\xb8\xd1\x19\x16\x06s\xcf$ \xfbO\xc3#|\xf9X\x1e\xddm\xf2-\x1eEGѿD\x1eU\x11\x1e\xde\x0e\x8f\xc0Ml\xf3\b\xc8\xfe\x93\xf0(\xfa\n\u0088\x04\x12ή\v\xbd\x90\xe1\xca\xda\x16B\xe8\x9af\xc9\xd5\xc99\xe1[\x7feDW\x169\x1e\xa9\x90x0E7\x18^4\x8a\x9exi\xf7<\xaa\xe2\n&\xfa/\xf5\xe0\xac\xd5>o\v\x80cAt\xa9\x96\x1b\x99#\xf4\xac\xbb\x9bNx\x06\xbd{\"\xe5bG6\xb6\t\x1eQ\xcfE\xbd鈎\xcb\xe9î*\xf8\x93\x88Ȁ\xf3Q\x94N\x05e\x90\xd5\x05x\xe0\xd1\xd2ע\b\xbb\xb28\xf0S\\\xf2U\xeaj\xb9\xe1\x8bq\xc3\xd5\x04\x95\xed@98\xee\bB\xa51\x06\x96\x12{W\xe7\xac\x10\x90{\xf3 \x9cA\x83ڛL\x94'q\xebԘ\xb0\xb3\f\xc4J\x94\bP\xcb\x18CIP$x-\xe0<\xe2\x05n1`\xe0_|\xe3\x84\xed\xc53[az\xf9Xey\x01Tj\r\x89\xbcU\x83\xff\xee\xa5J\xa9n\xac\xc5|\n\x85EѤs\x19V}Jo\x9d\x18/\xc4\x05\xfb{\x9c\xee\xf9\x05c\xd3]Վ\xa2\xd84\a\x1d\xaa\x1dEӚ\x83\x1b{\\\xa4X\x0e\x9b\xb6\xad~\x14\xe1\xad\xcbNπ\x88\\V\xf7\xc7[\xaf\xef\x14\xea \x98\xc8)\x04Q\x89v\x14\xd1\xda2:\x19x\xf1\xbc\xfa\xe5\x12\xdbC\xb7\xa3iLRI\xb4K\xf5(U\xaa\x1f\xcdSES\xbe\xb7\xe4\xdc\xd19\x01sWJ\xb54\x93H\xcd\x05\xd3\x0eM\x10\xbcК\xa7\t\xa98K\xe0[\x9d\xee\x86\x0e\x82钡\"a\xbe\\\f\x85+\x82\x89\xf7\x847\xeapE0š\xf0\x86\x8d\r\x06\x93\xfce\xc2\x1b˵\xe1o\n\xf8n)yv\x9b\x8b\xe4\xe8]\xed\xeb\x0f\xb7\xaf\xdb$#(2\xd8\xe0\x1f\xb1\xad3\xac\x12\xd0d<]Kc\x00\xd6\xe3Q\xccWZ\xdfG\xd1=u\xd5\xc6KY\xae\xaa\xf9,\xd1\xebF\x16\xfd\xd4ȥyI\x9a=\x05\xee\xc459\x91*sU\x0f\xb8i\b\xe8)E7\x060\x99(\xa2\x89\xe7*\x1a\t\x84\x1d\xf2\t\xae\xbbl\xbf\x8a\x05\xa9\u008a\x85gw\xa9vE\xf1*\x12P|\x8f8F\xf3\x85\xd0e\x1ahOH\xbd\xb1.Qdq-\xed\xd5ϳ3\x9d\x8ejpou4\xa7\xffR\xd3b\xa9\xb0\xe0\x10\x91\xe7>\xb9h\xf5\xe4\xae\x1d\x12{\xa3\x1dE\x93\xb3\x13\x18\xa1\xcby<\xa9\xe9G\xe2xxU\x01[ų|ŧ\x18 \xc0p:lhQ\x14\xddag\xa5\x95\x86\x03\xe4\x1c\xea;ֹV\x11m\xbbI@ ~e\xf3\xcdXY;\x1a\x8d\xe5\xf2\x9d\xf4\"\x99`\xd3\xe1\xb0t\x04\xb1\x81\xc0m\xc1n\xb5G\xc0\xd4C\x99\x16\xb6oZ\xf9|\xbb\xba6%\x8ab!\fx\xddR1Q\x14\xba\xa0\xba\x11\x97h\xa0\x96\xd1\xe1\x84k\r\xfd\xed\xb3\f\x8c\x02\x87\x8b\x94\x93FD+\x8e\xa5u\aXX1\x03\x16G,\x16\"\xc1#{c墈\xdb\xfb\xd0Ӻ\xdf\x18܆=\xda+\xb8\x15\x8f\x00\xf3\x81\xff8[\xcbO\xc0\x81\xc6\xe8\x8e\xe5\x82\xeb\x8b\xd5M\xf2\fn\x9d\xe3\x0e\xa2\xae\xb0\xfb\x9c\xc9\xf6\x80\xa9\xb2(\x8ah\te1\xcd\xe6Ҹ\x88t\x9d\x17E\x11\xee\xec >STG\xec\f1\xf9\x16\xad\x9c\x8b'ن\xe1\x84㈁cOF(\x82,\xeb\xce\xdfp;\xb2\x97\x8f(\xd2;9\x1c.>\x16}\x870\x90\xcb\xc1d\xf85.\xe5L=i>G_N\xc7\xe5\xe2\x18\x8a\x9f\xf5\xa6\xf93\xde6?ō\xf3/s\xcb\x13\xf5\x1a!:\x1f\xd9\xe6\xf7\xb6A\xa5\x11ф\xeb\xc5I\xc4v\x8aI\xe15*v\xb6qh\xfc\xf2\x1f\xa19\xf3\xed\x0e\xf2\x00\xe7\x86I\xeb\r\xa8{\xeak\x1a\xe6\xa6@(/s\x97W\x00?P\x8a\xf6\x88\x83\xb3!\x91V\xa3\xdf\xf0\xb9g\x86\v\x8e\x14\x82\x80\xfe\xc3\xf4\xe5\xbfp\x1b\xf2-\x8d\x1d\x9e\xf7\xb5\xff\x94H#<`\xea \x0f\x01\x1b\xb0\x91t\xdf\xc6R\xb9X\bW\xe1\x1c\xb8\xed\xe5\xbc\xe0k88\x18F\xa9\xbfs\xb1\x94\xb6\xccԻV\x817\x14\x1e$\xecܺ{\xb2dk\xb9\\\xd9(\r\xe3\bE\x19\x0e7Yj\x06`d\f2\xf2 y\xf5\x91\x17k8\xb1\xf0d%`ݸ\x02\f\xd2P\xc5\xc7Nr\x9b)4\x1a\x85(\x9b\xb0\x90\x12vm\xa0\x12\x1dRz\x03Y:6\x9f\x1e\x9bO\x8fͧ\xc7\xe6\xd3c\xf3\xe9\xb1\xf9\xf4\xd8|zl>=6\x9f\x1e\x9bO\x8fͧ\xc7\xe6\xd3c\xf3\xe9\xb1\xf9\xf4\xd8|zl>=6\x9f\x1e\x9bO\x8fͧ\xc7\xe6\xd3c\xf3\xe9\xb1\xf9\xf4\xd8|zl>=6\x9f\x1e\x9bO\x8fͧ\xc7\xe6\xd3c\xf3\xe9\xb1\xf9\xf4\xd8|zl>=6\x9f\x1e\x9bO\x8fͧ\xc7\xe6\xd3c\xf3\xe9\xb1\xf9\xf4\xd8|zl>=6\x9f\x1e\x9bO\x8fͧ\xc7\xe6\xd3c\xf3\xe9__\xf3iS\xa6R]L\"\x05\xac\xbb[\x00%Q\a\x10e\x1e\xbb\x13\fY\x05\xd5\x06\xa0}vt\xce9\xf2\xf4'\x11\xf8,\xf5\xd6M\x19\xb1\xd8(\x10\x1a\x14X̋ \x9a\xdd\xc3r \xa4ؾ\xcc֥\x06Q\x95\x8a\xbd\xfb\xf6\xbdר\xa8V\aqՁ8\x9foU\"\x9e@\x10\x9a\f!\xdeO\"pj\x92L\x1b\xaa\x93\x85\xc1\xb1dŕ\x12\x199\xdd2\x8c\xb3p\xa31\x17BA\xfd\x05\x80\xe9\xcc7\x8c3#\xd52\x13\x8c\x97%OV3\xf6\xfdJ\xa8\x18!\xa0\xaeu\xf5H\r\xe4䮭0\x14b\x1d\xdag\x10\x86\xc8xRhcغ\xcaJ\x99\xfbA2#\x8c\tG\x93\xbb\\\xd4\v\fB\xd5(@=\xf7\xb3\b\x1e\xa3\x85A\xab\xd7\x1a\xe3\xb8\xe7@_\xac\xf3r\xc3`\xe9ü#`\xe1B\x16\xa6dI&\xa1\xd8\xc8.\r\xa4Bj;\xces\x16\x9a\x1b\x8f\xe5\xbbv\x15\f\xb1V\xa5\x98\xae\x90\x97\xc6V\xfa\xc4\r\x94\x86\x98JC\xd17s\x0e\xf5M\xb4Q\x06\v\xbd\x93%\x14{\xe7\xc0\xd9Qӏ\"\x87\xe9\xd7G\x9a\xbaԬ6\x86P|?\x89\xe9\xbfr\xde\xc2r\xa8χ\x98\xe4\x8ef5\x88,\x98`\xe2\x02*\x8e\x12\x0f\xd0HH$\x02j㹵\x8cA\x14\xb7\xad\xe8g7\xa2\r\xdf\xf5\x830\x86/\xc5u`\x8aM_\x80\x18\xe84\x84+\xf0\xc0\x85@j\xa5\xae߮\xd7\xed\xa4}\x02\r\"\xbb\xb6s\xf4g\xce\xc7\x02\xdaS\xa3A\xc4\xceU\xe0w\xabR\xc7K\xec\xc9Vy\f1\xd5}(\x88\xb0\x84^h\xa5P\xd0mѦF\xce\v)\x16l!!\xa4\x05\xb5y\x95\t+8\xc2~\x16Ё\x04\xa0K\f\\%h\xe5\xc2N\x8e7a\x02\xfb=1\xb2,*\x05(\xe6\x1e\x04\b`&\xe1\f\xb3,\x04\x0fuޱj\xf1\xf7\xaf\xfe\xf8ol\xbe\x01/\x18\xf3 K]\xf2\xcc\r\x92eB-\x03\xb1\xfdi{j\xe3\x90yIȠ\xa1x`X\xa8\xd4\xec\xab\xdf\xdd\xcf\xeb\xe3\x04\xd8\xfc\x97\xa9xxِ\xcfi\xa6\x97a<}\xe3\xea+}\xcd\xe4\xc9\xe43_ft\x98\x01\x9d\xc9d\x13m\b\\\xf3\x1c\xb6ҏ(\x0f\x8d/Di,yXs\x88A\xe5U\x06\xa26c\xef\x1d\xb2d\x10\xc9ʈ]4\xac]\x06\xf0@\xf9*\xb5\x1fZ\xdb&\xb8\x92)\x9aJ\x10QM\xc0st5\x8e{\xac\x8f\x13\xbf\xe7Y6\xe7\xc9\xfd\x9d\xfeF/ͷ\xea\x1d\x80\xc9\x04\x91G\xe9w\xfc\xc88x1\xabJ\xdd\x03G\xea\xe1g:l\xb7\xd5U\x99W\xa5+\xf2n,\xbc_\xcc`<H\uf839\xc8p=:\xf1\t\xf4\x16óA$9\x81\xef\xd8\xd0[\xa6\x97~\xdc\xc6\x19\x83Њ\xa0߽\xfa\xfd\x1f\xacɂ۰?\xbc\u0092Q\x03\xe5\xde2Y\xa1o\x00\x8e\xec\x9ag\x99(\xa2\xfc\x02t*A\xe8g\x1dF\xe2\xb3ۈr\xf3\x04'\xad'<r\xdf\xdd\xfd\r\xcf۲4\"[\x9c\xdbv\x15.\x82\x18D\xf4\x04\x9d\xb8\x13\xdae\xe1h\xf4K\x1ch\x1ftV\x01\xcc\xeb\x83L\x84\x89fu\x8b\x8a\xbb\t\xca$\x80\x17\x87\xa1@\xcc3\x9dܳ\x94\b5j3h\x87\xf7\xcb8\x9b|\xd6*\x94\xde\xd9Ѽ\xe7p\xc1\x13D\x91\xb15\xcfs\x8f\xe5P\xf0\xc7\xd6dі\x04\x17\xa0\xf08\x86\x1c\x93\xd5a\xd7&\xd4a\xef\xe0jM\xc8\tL\x1e\xba\xfb\xd1\xf2b\x91&\xe5\x004\x14\xdduЋ \xe9\xd7\xc4:\x9a\xb0r\xe8\x0f\x8719\xda\xea\x1dS\xd3\xd3\xe2\xb1\xf2\xb9\x02k^ҙ&2\x7f\x06\xa56\x17\x85\x91\xa6\x14\xaa\xfc\x88:\xf1&\xe3rM\xe1\xbd\b\x9a1\r\t\xa2\x19\x1a\x97\x970m\b|\xe0\x8b\xc1\x8c\x8eLf\x88\xa9m\xb1\x06\x1b[\xfa\x06Y\x80\x96t\x018\x8f%\x84>\x02\x1ef\xe1\xf4\x18\x9eO\xe5\x95v\xeb${\x94\xc3q\xac\xd9\xffX\xf3\x88~\x81V߶\x9b\x0eWgT K\x93\x8c}30\xf4\\\xe6\x1b\a\xff\x04\xd6\x1bH\xb8i\xb4\xccn0Y\xd6\nؐ@\xb9\xe0\xf6\\\xb8\x18\xc9\xccvC\x88 \x0f.+\r\x8f\x9d\\\x9c\x84q\xfa(\x93\xe3\xd8]\xe8\x9c\xc3]\xbdVGr}\x9b\xdcq@\xb3pLF\x8a\xbeg\f\xd2\x15\xa9\xc76\x8f\"jJJ\xb5\xa4}\xd8\x1d\x9f\x10y,\x82\xe2#t\x85+t\x05\xb7\x9fp\xf7P_J}\xd8bǕV\"Ɓ0\x94\ar\xe71[\xc1%\xc14\x01\xa9\xd8W\xb3\xaf^\xfd\x7f\xdb\xf8q&[\x1b\x7f$\xf0s\xc3n=+\x17\\\xcb\xf6#9\xf1\x81B\xacu\x87\xf5(\xd8I8\x9fA\xdb\x18\x9eN!\xacJ\xd2\xfc(\x8d`\xa7\xa1Qs\xf7?]4\xb1,\xcf\xda!\xbd\xe0\xf3\xdf1\xa7@\x17\xa9\x9d\x7f\x86\x9d\xc1\x1a\xf4`\x9at\xd3\xd1\x15\x8b7\xf14;\xb6\x95&\xd3_\xc4t\xfa8\xb5\xa39\xb1\xa8WgϪ$\xb4d\xef>\xe5ő\xcb\xf6\xeeS\xce1\xea\x9f\xd7\xeb7\x89D%E~\f\xac_\x04\xdd~\xb7\xe0\xcf\x02@\x9bc\xf6?#\xd72\xe3E\x86\xa9e\xb7\x96\x93l^\x01Z\xf8\x83,\xb4\x8a\xaa\xbe\x00ԁB\"\xdax!\x10\v\x12B\"\xbf9\xfd\xf8\xfa\x063\xb4c\x80\xbb`w\x16n}*\xb8\x8e\x7f\x02\x8e6&\xb9\xad\x04\xb5HGеJ\xe0\xf8\t\x92\x89\x01d\xc7_\x1e\x91\xaa\x04\x80\xe0e\xc53\x04lK\xb2\xca\xc8\a\xf1\x8cj\x16{r\xf4\xbe\xf6?\xd1\xc1\x91 \x03\xdf\xca {Ӳ4\x1en\xff\xc4\xec\"\x10\x86-\xeb\xe5\xc2:\x83n\x0f=\xefN\xab\t\x94c\xaa\f\xf2\xe1\x1fp\x0e)\xa0N\xe8\xa9s\xd1\xe8\xf9\x16D{\xfb\xb8d1\xb1\x9f?\xb4\x1e*\xd3AR\x19,\x8fa\x92Hy\x9f\x17\x93`ѻ\xb3oR\xcf5\x1bu\\\xf3OX\x1d\xc9Q]\x0f\xa2\xc90\xd8\b\xbd\xcc>\x8aL\x14\xdamK\x8f\\\x96\xbe\xde\x14 \x9b\x83;K\xe0\xc1\xc9\xe2)\xcf&O\xbe\xf4\a\xafˁ\x0f\xee_\xb6}b6(V{G1\xf4\xfd\x81\x97\xa5J\xb2*\x15o\xb2ʔ\xa2\xb8\x11FWE\xe7\xedGKv.\xbb\xdf\xf2\xc6\a\x1bj\xc0\x11\x97\xc1\x0eU\x8abj\x12\x9dw\x9a\x87\xa2~\xd9\xfb34\xa8\xd4\x01N@L\xbb\xae\xa4\x01A\x85\xa4$]\x88\x1edmUe\xd9VQcg\xdf\x04x\x0e\xbc\x93\x9eڮ\xa1\xf3\x83\x1b\"\x1c$M\xce\x0ffY\xe3\x058Wsf2\xb8\xf1\xd0\v\\|\xa4d\xff\x06\xa3\xa6\x8f\xec\x10f\xb4\x966\t\x15\x98`og\xe1\n.\xab\t9\x04\x05$\xd2aD{\x83\x82\x83\x8at\x10Ӻ\xe4\xd0\r$P\xc8\xea\xe7\xb7\x18\xe6$\xe7\x10~\xed\x8aM\x93c\xb5\f\xd2sp\xa9_\xe5_\x16\xfb\xb0K\xf7\xad\xc8\xd07\xd8úo\x9a\xcfZ\xb6\xadE\xc9\x1f\xbe\x9a\xb5\x7fSj\b1CAZ\xcf\xf5=\xd6rYe\x03O\x1b\xe0\xfc\x1fdZ\xf1\xac%\x81\r\x9eլ\x85+x%\xb3\xae\x04)\x9e\xd5\xef\xb7x\xec\v\x06g\xa1|\x1b\x8e\x02\xe3\x8d\x0f\xb8ߔ\n\xdb\xf5\xcc\x16\v\xb7_\xb1\\\xa4{\\j\an\x1c\x1fɴ\xc3!\xa97\xcd\xf6n%Zϡt\xbd\xbez\xdb\xe7\xde\xf4\x8a\xd7\xceP_\x0f\f\x87t\xc6\xfdf\xb0\v\x039bT\xf3\x05\xa9\xa9\xec^l0}\x162ր\xc1\xdc\x11\xb1]\x83\xa9\xbe\xeb^l&\x9d\x14\xa9q\x8f\xa57\x9b\xc4\a\xf0\xef\xc5`\xec\xabŎ{\xb1\xf1\xd7\xee\xc8\x17\xf8\x81\xbb\x00\xadYa[c\x0e;#÷\x9c\x83z\xee\xfe8\xae\x1d<|\xcf\xe6B\x80\xbcZQ\x81\x85\x80\xa0\n0\x1d\xa4q%\xf3}\xc91\xb0\xea\x90s@\xabY7\xef\xb5\xe4\xad\xe6]\xaasv\xa5K\xf8\xbfw\x9f\xa4\xd9S\x90\x03\x82\xf0V\vs\xa5K|\xfah\xe6ء\x1d\xcc\x1a\xfb8,.W\xf6\xac\x06\xf3\xb3\xdf\xf0Ӽ\xdc_\xff\xeeY,\r\xbbT`\xa8\x88\a\xbeX\xd1\x10\xf9f\x8d!n\x18CS\xc63\x18\x90h\xd2GF\x19\xf8F\x93s\xcdO\rRl\x0f\xc3\x0e\x01\xcb\xfdh\x80\x98\xa0\x9dg<\x11)\xf5\x99`\x1cN?\xbc\x14K9\xdc~`-\x8a%&\x1a$\xab\xa1Y\rڡ\x80\xb5\x1e\xda\xdb\xdc\xff\xf6\xbb\xc8\xfd\xa6f\xea\xd9\xfe9\\h\xdaCp\xfb\xec\xe1\x86\xeb$Ƴ\xeb\xbd\x16m/\xc7Zr\xdf\xf84m\xe6<\a\xc9\xff\x1f0\xcf(D\xff\xcbr.\v3c\xaf\xa9B\xa5\xe7\xbb\xcd7\xc8\xd7i\x12_\xf3\x1c>\x00\xab\xf0\xc03\xd8>\x00\xa6Q11\b\xbf\xa2\x17;\x1b,\x84\b\xa0\x14\aL\xaf\xbfDzq/6/Ωq\xf0\xe0R\xc1×\xeaŹ/Do)\xa5ߧ\xb0A\xe2\v\xfc\u074b\xd9\xce\x06\xdbC{϶;(%\x03\xbf\xf4^\xf7\a\x9b\xdat1\x89\x95\x8fA\xd9h\xc9\xc5\xd5\xd67[\xc2\xd1t\x8e[Ǌ\xaeO\xf2b)ʎg\x9dǌ\xa9\f3\xf6Zmv\xe8ba\\\aM\xe7\xd4\xd5r\x96\xfb(\x12Q\xb5\xc9\xfeMR\x94\xb8d\xba\x0f\xc2\xf0\xe0,dQ\xb6stzצ\xc5\xd6\xeb\xee\xb7\xce!\xa3\xb6\x11^\x83ⲥp\xbd\x89\xbbk\x8c\xe0Z\xb9\x1e\x04\xdd\x06\xc0\xe6\"\xa0Ul\xeb~Y\x16\xcc(\x9e\x9b\x95.\u0379\xc3\xf3\xee\xce\x19\xc4W)\xf2\xbd\xb6M\x9d2yO\xe5dD\x03H\xd3\xe7\x9eؙ\xe6\x0f\\f|.3Yn\xfeC+\xf1\xec\xe6\xf0\xf5\xf6\x00Zr\x0fLh\x0e\x91\xfdC\xf7\xa7\x04\xa0[\xe8V\xe5Q\x14m\xfe\x81\xe4\xea\x1e\x82\xfd\xee\x17\xae\x0e.\x06\xa4\x83\xcd\xe8\xbe\xd8\xf8\x05\x97\x8a\xf1Fs,\x18\x9e\xef\xef)\xfb,\">\x85n\xbe\xa16\x1d\xd0`e\xb1\x80\xc8چ\x15\xc2\x15\xd2@\xee\x11\xd8\xe2\xfa\xb3R\rr\x00b\xc6\r\rmJ&\xfd\x18ކ\xbfl\xba\x184\x9b\xf4\xafg\xa7J\xba\xdb\xcc[\"\xf3F罂\xd1Z\xf5\xf7;/u\x84\x9b:\x14l2p\xa3\nK\x9bXZz\xb1\xad\x844\x7f\xe9\xa8\xfa\xdf0̀\x96Z\xf5\xc6\xd2m\x81:/`e\x96\x90x\xaf\v\xffRS\xb7\xebQ\xd4_\x05\xb91\"{\xe8;0\xed\xd1\xdd}\x81+\xf8#u\xfe\x04J{\b\xd0\xc1\xfe\xaa\xf4\xd6\x1a_~{}\xbb\xa3\xcc@\xc3\xef\fn\x81!8\x8c\xfa\xd4\xf7a\xea\xda\x00\xbd\xe8\xc0#\x11\xa9%\xee߃_C\xbdm\xb9:wh%\xee.\x11\x1e\xec!\xab\x17\xad\xd5\x1a4\xb2\a(\x82}\xf7n\x93\xf7q\xf9\xf3\x19я\xf5\xa7\x879ޘkχ\x1bk\xb2\x7f\xbdj\xc3\x04O\xc0\x87\xc2\r\x13\xac\x9a\x1fh\xb7i\xea!\noF\xac\xd4\xc0/s\x9d\xda\tAYȍ\xf5k.&\x83\xac\xbf\xeexe۹X\xf3{\n\xae\x90\xaf\xb4C\x92y\xdf\f\xaay\x1a\x818\xf8\xb1L(\x1e\a\xab\xe8\xab\xd6s\x9f\xa4\xdaWe\xd9Lkv\x8f&\x90\xd8ܲZu@\x90\xab\r\xfb\xab\x87\xcb \x0e=\xb5\xc3\xe1\xfdË\xfdb\xed]b\x17\x02\xf2/;\x89\x04\xbe@et\x0e\xb8Y\xb3I\x84&AV\x889`,\x90C\xb1\x157\xccyY\x8aBy\xf5@R\r?\xbb\xaf\x9c\xd4\x06~\x1e\x84ӱV\x9a\xcdv8[/Z\xcf\xf4\x90\xb4R\x03jEߎ\x89C\xee\xb59\a\xefV}\xc7\xee\\\xa7\x87\xb0Z\xfb\x16\x83\r\x10\xc4'Xk{2\xba\xfe\xf8\xe6\x80Aܹg\xbb\x86ңWC~)\xbc\xb6\xbbJt\x14\xbb,m1\x83\x80p\x923\x94\b\x0f\xd6C\xd2+\xc29\xd3Ŗj\xc8\xf2\xc4ԕ\x1dPB\xbc}\xb4\x8cb\x9e\x95\xd2\x038G\xa9\xe0\x83+H\\\x1b\xcc\xc6\"\x1eD\f\xb6?\xf24\xad\xf9\xd4\U0007bbb2\x8d)\x9d\xa7\xaf?\xbe\xe9\xf8]O\xbe\xde![\f\xed\x15\x1ftz\xf0\x16\xd3x\xa5\xe1.ñ\x94\xb6\tD\xb3 \x98\aݝ\xc1\xe1\xca\x18HpM\xbd\x05@\x8a\x9cN\xb7z\xa4_6/\xfbw\x97B\xa8j\xdd\xc5\xe5\xd6k\x1d\xbf\xff\x8b\xc8rQ\\w\xf0{`a! %\x8a\aq\xa5Sq\xad\x8b\xd2\xec\xe3\xdb\xf6\xf3\x1dg\x8cFTDg\xf6$\x86\xa4'=\x87\f0BU\x1e\xbc'\x0e9\xf1\xf4\xfd\xeb\x8f\xfb\xe6C\xcb\x7f\xfdq\xcfD`\xdbp\x01\x9b\x1d\x8a\x8c\xc1\xfb\xe8_8\x0f\x8b\x9d:T\xbb$\xd3UJ\xd0~\xc5ٓ\xce\xd2$+\x91V\x99\xb8\xea\xcc\x01o\xcd\xf3\xb6\U00068cfe\x95\x92\xff]\xb5\xad\x89KQ\xa1\xa7wh\xb2&O\xfcݺ\xe3\\j\xe3\x91\x7f\xc6\xf5t_\xa2}\x97(\xf7\xd4\xc27I\xa2&\xad\xa1U]!\x12\b\xb1֨\xeb\xcegs~.=މ\xb3\xe3\xe60\vQ\aH\xcay\xaf\x8b\x1b\xc1S\xc8\xf9\xd9'=\xdfo=\xde\xed\xa4\xba\xa9S\xc2O\xff\xf4S\xebK\x00\x8f\xe7\"\xd1k\xd8\xd3x\xbaq\xed\"(\xd9ǝ\xc2\xe8\xa5\x19\xbb\x84\x97:\xa8b\xec\x06vF\ba\x10%\xb8\xe8\x13L\xa8\xd4\x1fe(\xcb\t\x9e+D\xa2\vH\x1b\xe5\xde\xdcu\x90}\xe4\x05\xb4\x19\x7fj'v0Q\xab\xc5\xf5\xfd\x89Y[)W\x93\xc1\xfcn\x9f\x88E\x99\x0e\x03+1\xdbM\"\xec\xa1\xcd]v\r\f\xe5\xabWl-U\x05\a\x00\x02O\xdc\xe5\xdd\x1e\xc1\x1c\xd8\xff\xbaw\xe6)\xe9\xcaN!G\x0f\x1d\v\x01q1\xe9\xe5:Y\xca[|\x8e%</\xab\x82\x98\x9fT\x05F\xea\xeaλ\xdc\t}\x97\x18\xf5ˁ\x93p\xad`\x8dM\xc9\xd7\xf9\xc5dP\x16\xde\xec\xbeAbl\xbcx7\xb5\x85.V\xbaA>\x1e\xb9qC\x80\\\x8b\x9a6\"3\x821\xf3\x1a\"\x1e\x00\xf7LQ'\aG\xbdkY\xe1\xd6\x05\xb7L\xa8Ept K\x14e\xee\x16\xf0\xbd\xfc\xd0ͤ/\x86\x04i\x9e\xd3NԷ\x83\xf6\x8fN\x99Bt\t\xb3\x87\xc1\b\xd9A\x87\xb4\xc4\xe9\x16h\n\xbe\xed\x003\b\xa1\n\x83\fK\xa1\xe0\xee\xaas\x9f\xa4\x1bX\xe8\xa4]\x01}g\x85\x1c\xff\xf0\x8e\x8a'\x90\xbfm\xc1/\xack\xef\xaccעYI\x06T٢\x13\x1ch(\xc2F@%7\x82\x1b\xad\xf60\xe2}\xf3Y\xbab\xc7!ک'\x1c\xd7\x14&#T)\xeb\xf3\xc8\x0eU\xdcC\xe1˳\x90\xc5\xcaW\xdc\xecuj\xe1\x19&w\x95\xd2\xef\xef\xa4\xc4\a\xfb\x9cW\xe2\xb1\xe3\xa7\xc0\n\x91b\xbaD\xb7*\x81\xb3z]\xe8e\xd1\xd5\xdch\xea\x14\xabCB\xa6\xec\x9a\x17\xd0\xcd)ۼ\xefn\xa2<e=\xbf\x18\xe2\x1d\re\x1f\xfb\xe81\x97p\rW\x03V\xff@R\xf9\x1c\xf6\x9c\x86\xb0\x9e\x18\xea\xb4\xdfmL\xdcGg\x90?\"܁U\xb6\x89b\xe5\xa0)\xa7b\xb1\xd0Ei\xefk\xa7S\xf0\"zw.\x90\x1cܘl\xee7\x93e\x9d\xd7@#C\xcb\x02\x91\xa7\x02\x05\x1b;֯9\xf8\x11L*\x9e$\x15\xa8\xe7KS\xf2L<\xf1&\x8e\xbb&\tY\xc7Qq\x87\xe5\x97\xcd\xe7\x9d\xe4\xd6\xfd\xf2h\x13\x06\xfd\xc2\v k\x19zA\xec\x11\x8c\x92x\x902\x03uq\xc5$&\xea\x8eP^\x97\xfdq\x9d\xd6\x1c\xee\xfc\xc3n\x02\xf8\xfa\xee4t\xf3f\xb7?\v\x0e\x90T\xa9%\x04\xdc\xe5\xe3%*+W\x85\xae\x96+'\x82}\x06\xb4\x87h\nP\x9a\x9a\xe5Y\xb5\x94ʣ\t\x96U\xa1\x1a\x01t\xcaXk\xb8>CD\x87Y\xd8\xeb\xac\x00(csǻ\x98\f\xf2\xb6\xbd=\x1e\xb7\xb3{\x94\xc6/wG~\xf0&\xf5\xdd!{sm\x81\x9b\xbb\xb4\xcf\xff\x85]\xba\xa6H\xfb\xe9\x0eE\xc6N\xe5\xc2&\xfb%0\xea\xb3\xc9\xc1\x01\u0381\x99\x1cȅ\xae\xa0\xa6;_\xec\x99\xfc\xf7\xf4X\x87kB\x14:\x9c\x93\x1d\x92\xacvW\x9c\x19=\xc89q\x83\xec)Qs\x06M\x1d\xe1\x9et\xea\xd0\xce\x0fQ\x90\xd3\x06\x93\xe9K\xf4\x93ڭ\xb7謔\x90\x0f?`\xec^\xaa\xf4\xc2ձ\xe6YU\x00,&\xfe3\xd1\xcaޫ\x99\v\xf6Ï\x137\xa1\x8f\x10\xa3\xd5\xca\\\xb0\x1f~\x9c\xfc\xdf\x00c\x8db\"\x1f\xff\x01\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=Ms۸\x92w\xfd\x8a.\xef!3U\x92\x9cԻ\xec\xd3-q<\xbb\xae\xc9K\\cO.\xaf\xde\x01\"[\x12\xd6$\xc0\x05@9ڭ\xfd\xef[\x8d\x0f~\x89\x1f\xa0\xe2쾙\xb2\x99\xaa\x19\x93@\xb3\xd1\xdf\xddh\u008b\xd5j\xb5`\x05\xff\x8aJs)6\xc0\n\x8e\xdf\f\n\xfaM\xaf\x9f\xfeU\xaf\xb9\xbc>\xbe[<q\x91n\xe0\xa6\xd4F濡\x96\xa5J\xf0#\xee\xb8\xe0\x86K\xb1\xc8Ѱ\x94\x19\xb6Y\x000!\xa4at[ӯ\x00\x89\x14F\xc9,C\xb5ڣX?\x95[ܖ<KQY\xe0\xe1\xd5Ƿ뿬\xdf.\x00\x12\x85v\xfa#\xcfQ\x1b\x96\x17\x1b\x10e\x96-\x00\x04\xcbq\x03:9`Zf\xa8\xd7G\xccP\xc95\x97\v]`Bo\xdb+Y\x16\x1b\xa8\x1f\xb8I\x1e\x13\xb7\x8a\a?\xdf\xdeʸ6\xbf\xb6n\x7f\xe2\xda\xd8GEV*\x965\xdeg\xefj.\xf6e\xc6T}\x7f\x01\xa0\x13Y\xe0\x06>\xb3\x1cu\xc1\x12L\x17\x00~a\xf6\xd5+\x8f\xfa\U0005d0d1\x1c0\xb7Ģ\xdfd\x81\xe2\xfd\xfd\xdd\u05ff<\xb4n\x03\xa4\xa8\x13\xc5\v\xa2E\x8d\x1ep\r\f\xbe\xda\x05\x82\xf2\xac\x00s`\x06\x14\x16\n5\nC#\n\x85\xab\x80aZ\x81\x04\x90\n\nT\\\xa6<\x81\x0f,y*\v7Y\x1fd\x99\xa5\xb0EP\xa5XW\x13\n%\vT\x86\a\x12\xba\xab!2\x8d\xbb\x1d\x8c\xdfТ\xdc(HIVP\x839` \f\xa6\x9e\x0e w`\x0e\\\xd7\xf8[\xf6\xb7\x00\x03\rb\x02\xe4\xf6?01kx@E`\x02։\x14GTD\x81D\xee\x05\xff\xaf\n\xb6\x06#\xedK3f\xd0\U000f5fb80\xa8\x04\xcb\xe0Ȳ\x12\x97\xc0D\n9;\x81Bz\v\x94\xa2\x01\xcf\x0e\xd1k\xf8\x9bT\b\\\xec\xe4\x06\x0e\xc6\x14zs}\xbd\xe7&\xa8J\"\xf3\xbc\x14ܜ\xae\xad\xd4\xf3mi\xa4\xd2\xd7)\x1e1\xbb\xd6|\xbfb*9p\x83\x89)\x15^\xb3\x82\xaf,\xea\x82\x16\xac\xd7y\xfa/\x81\xa3\xfaM\vWs\"\xf9\xd2Fq\xb1o<\xb0\x02=\xc2\x01\x92l'0n\xaa[hMh.\xf6\x96:\xbf\xdd><6\x85\x89\xeb\x16P\xf0t\xaf'\xea\x9a\x05D0.v\xa8\x1c\x13wJ\xe6\x16&\x8a\xb4\x90\\\x18\xfbK\x92q\x14]\xf2\xebr\x9bsC|\xff\xcf\x12\xb5!^\xad\xe1\xc6\xda\x0f\x92òH\x99\xc1t\rw\x02nX\x8e\xd9\r\xd3\xf8\xc3\x19@\x94\xd6+\"l\x1c\v\x9a\xa6\xaf\xfe!(\x1bO\xb5ƃ`\xa6\x06\xf8\x15t\xfc\xa1\xc0\xa4\xa524\x8f\xefxb\x15\x03vR\xd5&\xa0a\x85\x00Ƶ6\x98\x1e\x1a\u07bd?\x80\x89\x13\x9e\x1b%\x05\xe07\xb2.\xb56\x93\xec<\x1fP\x90\x86\xa9R\x10\x9eg0\xc1\x9b\x98\xf5\xa2s{\x88\x9at\x19\xcc\vR\xd7\t\x14\x1f\xfd0B\x91D,\xad\xdc\x11\xd9\n\xba\x13̛\xf4V\rΌ\n\xfd\xa3\x91\x85\x92G\x9eb\xdaO\xcdq\x8aҕ⎕\x99\xf9*\xb32G\xfd(\x7fCmx\x87ӽ\x8b\xf8\xd8;1\xf0\x1b5<\x1f\xd0\x1cP\x91r\xda\a\xd6\xde\xf5\xc2\x05Ze\xa91\xa5\x05\x1b\xf6\x84\xc0`\xeb(@\xb63ˠ\x90)\x1c\x1d\x8a\xb0=\x05\xa4\xcfyS\xf3g+e\x86\xac\x8fj\xf8-\xc9\xca\x14\xd3\xca\xe5\xe9\x88\xd5ޞM\xb2\xc1\x01イ\x8c\\1\xb1NTO{!\x12ǘ\x01\xa6\x10\xc8Pp\xe1`\x02\xb7\"\b\xdb\x01\x81\xa3\x7f\xdc`>\x80\xe7\xa8D\xba\x7f\x14\x84\xb0m\x86\x1b0\xaa\xc4\xc50\f\xa6\x14;\x8d\xd0,\x04PsHV\xcd\xf1\xe6<\xe3\t\x12\xb1*\xa3m\xa9fI\xd3\v\x14\xfe\x88\x04\xb3ќӍ\a\xc1\n}\x90F\x7f8\xddˎ\xcf\xeb%ܿ\r\xcd\xedQ.\x92\x9b\x82\xa2\x12mνT\xf8\t\x9a#w\x80,9Xm\"\x9aj\x0f\xddX\xcd\xdb[u]\x02#.%\x8a\xe9\x03y\xf8q\xc8v\x99KRI\xf7\x8e\x06H\xa5}tV\x16\x85T\xc6\r\xad\x9e\xeb\xf5\xf7\xd1}X\xbd\x0fR>ň\xe7\xbfӸ:,\x80\xc4f\b\xb0\xc5\x03;r\xa9t7\xb6\xc4o\x98\x94\xa6\x15\x906/f \xe5\xbb\x1d*\x14\x06\x8a\x03Ө\x831\x1f\x13\xd3q\xe3LWP\x93\xc1\x01\x9du\xd5\xeaF,\xb6\xd4\x18Z\nIQ\x1f\t\xc3\x0f!N\xbe\xb2,\x80\x8b\x94\x1fyZ\xb2\f\xb8І\tz\x01\x19\xe7\n\xbf\xfe\xf5M\xaa\xe2\x19\xfe\xce\xf5\x85U\x10\x97Z1\x85\x14H\x89@.U\xbfx\x84\x9fs0\x83\x1c\x85-#\xdf#\x87\x02\x81\xfaGQ\xee\xe6QIm0S[\xfce\xcd)\x17\x8egl\x8b\x19h\xcc01R\r\x93'F\b\xe6y\xae\x01\xca\xf6\xf8\xb0ڠ\x90!\x99t_\xf5e$<\x1fxrp\x913I\x995N\x90J\xd4\xd6V\xb3\xa2\xc8Nc\x8b\x8e\x92\x8cHs=ˀĚ\xf0s\xba\ai\xba\x8c\xec\xd5\xec\x86\x19'\xaaWb\xf3J\xf4&ѹ\xe8J\xeb,\xaaߝM\x7fya'rs\xd4k\xb8\xdb\x01\xe6\x859-\x81\x9bp7\x06*˲\x06\x1e\x7f2\xc6]\xa6-w\xdd\xd9/\xae-/µ\n\x8d?\tӬ\xb3z\xf0\xbej\x16\xc3>5g.\x81\xef*\x86\xa5K\xd8\xf1\xccG\x82S\x886\x02\x9dIν$\x81b}/]93\xc9\xe1\xb6*&D\xcc\xe8Ъ\v\x00x3{\xb4<\x88\x00\tUPa\xebO\\aN\x95\xd35<\x1e\xb0u\xc7&N\xef?\x7f\xc4tJJgH\xea٢\xdew\"\x9d&\nv\x81Q \x1b\x8b\xb2aZ\x95]ۺ\x9f^\x02\x83'<\xb9Ȫ7\xeeﻈ\xb5\xac\x02\xa9\x90j3.\xd4y\u0093\x05\xe5k\xa3Q\xf0戊/r\xe2)vh\x87\xa8\x84\x9f\xaf\x0e9\xea\xd2\r\xbb\x8a\x18U\xea!\xaa\xd7\x1d*TFO\x9fa\x94\xba\x14\xbfp\xd9\x15\xc3\xear\xadc\xfc\x1b\xaa\xb5f\xb6\x88\xa8\x0f\xbc\x88\x86\xee\f6h\xb4\x1a\x16*\xe1_Y\xc6\xd3\nW\x9b)̀x'\x96\xf0Y\x1a\xfa\xcf\xed7N\xd5_\x92\xa4\x8f\x12\xf5gi\xec\x9d\x1fJb\xb7\x88\v\t\xec&[\xb5\x14\xce-\x10]f\xbd\xbf\xc6\xc1\x06>\xa4M\x15۸\xa6\x92\xb7T\x9e>3 \x12\x18\x8f\x9cC+/\xb5\xa1dUH\xb1\xb2n:\xbcm\x06\xd0&^\x9eUR\xb58\xb5\x9c\t\xb1\x17E\x8f\xde#E\x87\x0e\xf9\xb3]\x88\xb1Ka\x91\xd1\xce\x1b\xa4%\xb1\x81\xc4\xd5(fp\xcf\x13\xc8Q\xed\x11\n\xf2\x1b\xf1B5Ò_,\x85\xf1\xa1E\xf8\xf1na\xa0\x02ֽV\xa4\xf5\x91#\x03\x9b\xa3\x86\x0f\xeco\xbc\xc4*\xad{\xb7\xf1P\x14\xf5Y\x9a\xda=h\x96\xdd\xcf\xf4,3\xf9ղ\x00\r$I-\x18\xe4̖\xd9\xff\x9bܫ\x15\xef\xff\x89¡`\\\xe95\xbc\xb7\xdb\xca\x196\xe7\x87\xfal\xe3UQ \t\x13\xae\x81\xe4\xe4\xc82*\xa4\x91\xf1\x16\x80\x99\x8dp\b\xcbn\x04\xb5\x8c\x02\xfc|\x90\xda\xf9\xfc\x1d\xc7,\xa5u_=\xe1\xe9jyf\xbd\xae\xee\xc4U\x1cL\xb2\xf9gF\xab\x8aZ\xa4\xc8Npe\x9f]\xd9\xc0l\x8e\x8a\\\x10\xbc͐\xea衔\x99n\x163D\x8bR\xf5\x10\xb5\xd0\xe4j{\x9cR\xe6\xf5\xe2\x85d\xba\x90\xda\xccB\xeb^j\xe3\n\x80\xadp\xbb\xa7B8\x01\xd5\x06\x13\xbej\blgP\x816R\x85\xadh2\xbb\x9d\xad\t⼞\xf6/L5\xaa\x91\x0e0\x95\x06\xaej\v\xe1\xaa6Wn\x8f\x9a\xfe\x7f\x1afB3\x9d\x18\x15J&\xa8\xf5\xb4(Ez\x8e\x16y\xcf\xe9X\x15k\x99K\xdevQ\xa69\xa6\x94|Y(N\xa4\x8d\x19\xd7Y\xd8\xed\xb7Fݙ\xd162&Q\xa2|\t\x8etQ\a\x00\xeb\xb6ED\xa3{\xe3f\a\x05\xf4\xc0l\x96\xc3Ծ\xb4F%\x1arS\xd4\xff\xd9\x02\x8f\x9c\x8b;+\xa7\xf0\xee\x87\x05+\x10\xb6w\xf1\xd2T\xe6&̯\x19R\xdd\x103\x03cڰ{>\xa0\xc2\x16g\xcfw2\xe29\x05\x14LSɸQ\xac\xf1oz\xa3aǕ\xaeRp\x8c\x8b\xab\xbc\x04h(#\xec\xccwI\x80\x14\xb7J]\x9cb~q\xb3\xab\x85SA\xf7ٷ\xa4DC\x84\x9a\xf8\avD\xaazq\x03(\x12YRc\x96ͮ\x90^3\x03\xa2c\xa2s&\x91>\xb3\xbeP\x94y<AVV:\xb9\x98\xac\x8e\xd5\xd7\n~a<\xfb\x91l5<GY\x9aM\xe4\xf0\x0e[\xa9\xe5R\x96\xa6\xb2\xd7$\xcc9\xfb\xc6\xf32\a\x96\x13[\xa2ႍ[x\x8eU\xa3\x92\xe3\xf53\xe3\xc6n\xfa\x11l\xf2\x033 \x1a\t\x89̋\f\r\xc2\x16wԉg7\xd7S\xac\xc2\a\xcf\xff\xdeN\x9f\xa1\x8b\xc1\x8e\xf1\xacT\xb8\xfeq\x9c\x99\x9b\xb7y\xf3\x145zF\xd8:\a\x91\x95u]\x8b\x17|{\xac\xff(Լ\x90\xf9^\xe1ˇ\xa6\x85\xe2$\xa5r*:\x9d\x84i\xa3\xd7vtꅗ\x89\xd3Px:\t\x95\xa2\x84\xd7\xf0\xf45<}\rO_\xc3\xd3\xd7\xf0\xf45<}\rO_\xc3\xd3\xd7\xf0\xf4\xff <\x8d\xc1\xd0}\xef\xb5\xf8N\xac\"[0\xa6Оx\x97\xef4\xba\xc9JmP\x85\x10o\xc0\xc3\xf7u\x19ug\xf64X'n\xc8\xca~'7$5!2\xac\xbe\xea\xdab\xd5\x06e3ƠLv\x03;&\n\x7f\x81Fh~\xd6\x01\xb7Y\\\xd26\xd7\xeeگ\xdaլ\x9c\fElF\x86\xf5{\uee6f\xab\x9a=W\xed\xde7\x9b\a\x04\x8c\u05cb\xd9\xd1ۤو&\xe8\x904\x06\xe4.\x10\xb3\xe8O \x86<\xbc\x7fwGp:Ĭ\x85🞖\x11\xddf\xc3=f\x8e\x86\xf4\xf1\xda\xf1ݺ\xfd\xc4H\xdfq\xd6\v\x12\xe0\x99\x9b\x03i\xb6\x00J]ž\xd9\xd6\x1e\xe4\xd4\xc8^\x1a\x0f@\xa4\x16p\x9e9i\x0e\x10Z\xe4\x87/v\r,[_J\xca\xe9D\xad\xbb):4\xaeC\xd5\xee\xb4v\r\xa2\xdd\xd45\xedU\xbe\xa3\amT\x1a\xe7\xf7\x9b\xc5 \xed?\xc5\x1a\xef2\xeb\xef\x1f\x9b\x80:\xa7\xb7,6\a\x8f\xe8#\x8b\xef\x1e\x8b#\x0f]\xf1=c\x93&#\\\x81\xa2\xb3\x96\xf3b]a\x91\xbd`\x8d\x0e\xafI\x90\x17v\x80E\x13,\xae۫E\xae\xb1\x1e\xafj\xd9w\xbb\t\x900\xda\xd9u\xde\xfa@\xfdZ\x93 \xfb\xfa\xb9b\xba\xb4\xa2p\x8d\xeeͪ:\xae&\xc1~_G֤]\x9b)\vSn5\xfc\xc4\xc5\xf9\xe3\xfdUQ]UQ\xb9\xc04\u038d>\xa1a\x94\xe7vKEQ\xb5\xa57\r4\x86:\xa3\xaa\xae\xa7\x91\x17G\xf5C\x9d\xf7:\x8d@\x9c\xee\x82\x1a\xeepZ\xc4\xeb\xb7\xed}\x8a\xe8k\x1a\x01\xd9\xecx\x9a\x1d\x06LJ\xd3Ā\xfe\xf3\f\xe2}m\xf6\xff!\x81߽hN\xe5\xc2\a#\x15\xdb\xe3'\x994\x8f\xaf\x19\x95\xf6\xbf\xf5NlĀ\x9e\xe7T\xffq\xe9\x89\xdc5H\xb0\x18\xfb\xec\xf2\fj\x15{\xf8\xcf\xfa\xb9\x86D\x16\xdc~w\fR$H\xf5\xc9P\x81\x1a\b\x99F\xad\xe9\x8f\xcfY\xa4JQM\xa6\x7fsdd\x12\xe7\x16\xbf\xbet\xdeߨU4\bk\xb1l\xa6\x96C᪬\xbe\xd3I\x80\xceZ!\x17I\x16\xaah\x06\x8f\xf4\xc0\xe6\xf9u<;\xdc\xda\\\xa7\x0e\x9d\xb4Vc\xc1\xa8\x9f9\xa5\xef\xc4m\xf9M\xaf\xe1\x96>D\x0f\x03\a \xda7\x1f\x98\xa6\x12J\xce\f\\U\xf5\x82\xeb0\x93\xee\\\xad\x01~\x91U\xa9\xa6\x82:\xd8\x1c\xaay^d'jT\x81\xab6\xa0\xef\x13\x9dA%-d\xea\xbe\xe7\xbfg\xe6\xf0\x8b\xcdP\xf5f\x9a\xe5\xf7=\xd3|\xf4n\x95\xa9`\xe6\xa0mR;\xb8e\xd3<8\xa3:|\x82d\x05S(\v(\xc9Y\xf9\x13:.к)l=\xb2:\x16\xdb\x17\xc07.\xa9\n_\xf4\x12\xaa#\xc3\x06\xbe䵳,f\xfel\x13\x0f\x8e\xa2B:\x96iHC*ͫ\xc9A{\\F\xda\x15\x02\x95t\xee=\x04І)C\xcc!\x82\x8d»\xba\xbe\xf2\xb8P&t\xc4P\xc1pd\xa4\x0fg\xa44K\x90TΤ3\x8fL2\x0e\x8f\x88.N\x90baF#\xdcQɈ\xb4p\xd1\n\x16c\xa1=Z\xe2\x12\xd6މ.k\xf7\x99\xdcV\f\xf5L\x1b\x81\x064\xf6\xa0\x97Slh2{\"\ak\xd4\xf6\x8a\n\xadJ\x17\xfe\x80\xac\xf1\x8a\x1d\xcd\x14\x7f\xb6\x90\x95\xee\xea\x93\xedJ\x7fjK1\x02\xcfe\xb1\xae(جg\xf4~\xfc\xeb?\xee\x1d\x05\xd70P\x7f8\x0eLx\xa9\xa8w\x8d\xbf\xa5rt\x8f\x8a\t\xbd\x1b\xeaN\xe8\xf7\x1ba\x0e\x1cd\x96\xfa\xd3\xc3А\x11\xd4\xe1t\xa8^h\xc4c%\x8dɚB\x01\x94\t\x80\xf1 \xb5\xdf\x18\xe5:\x04J\xc3[\x80dӥB\x7f\x1e\x1c7kx/N5&!\xecJ\xc1u<\xb0\xa7!\xa6\x14\n\x13L\x91\xa2[y\xb4g\xf5P\x8e&w\xcdx\x8d^\xc5\xf6\b\x99\x0f\x95ח\xf2e\xda\xeb\xa5\xf2Yd\x92\xa5\x9fx\xceͯ\xfcC1\"\x9f-\x06}<\x9b\b\xbc\xbd\x83\x1d@\x0f£\xb3dD\xfa\xccSs\xa0d\xf8W\xfe\x81\x8er\x04\x8d\x89\xa4\xd0\xf3\xbd\x8f-\xe5\x0e\xdeB\x8eL\x90c\x1c\x01\x96\x11&\xc3\xea\x97sA}\x9f\x1bx;8ĉ1\x9d\xa2\xb8\x1f\xdc-\xe0\xf2\x9e\x1a\xe9\xb89\xdddL\xc7R\xeb\xeeKkV \xd5\xdd\xf5\x97pD\x1by\xf5\x84 .&\x83\x84\x96\x107\x8d\x96?6\x8eNA\x83Ŀg\x04\xdcx\xd3\xcbxw\xc6\n>\xa06\xb7\xbb\x9dT\xfdV\x83\xae\x15ܥ\x19\x0e>\x8e\xb0u\x82'\x18I\xe2ϴ}\xe6\xe9zs\xff{\x93\xae\x85\xa7\xbd'\xe0 <h\x93v\xe9\x94\xfd-\xfc$(\xd5\xc8~&W\xf0\xee\xaf\xf0S&\x9fQ\x9b\x9fGd\xcd\xf5\x18o\xe0\xdd_\x7f\xb4<\x96\xc5E\xea\xfb{gZWy\x1d\xd8?\x93\xeaN\xf8\xb9p\x06\x99\x0f/6\x8bI\x12>\xb4g\xf4\xb4\t\x84\x83\v\x93L\x96i}\xcaY/h\x9b\x02P\x03\xed\xfdW\n\v\xd1\x1e\x1a\x96\xd4\xc7\xda\xf9\r\x8a\xb0\x9d\x18\xb6\x12\xfd\xe3\x01\x90C\xa7U\xbeP3\x81nWtbh֞\xe1w\xe6l\xb4\x13ʉ\xa1\xb5\xc8\x7f+\xd8\v\x93\xea\n\xbdE\xa5F\xc7a\xf0\xa5U\xef\x05a;d\xf0&L\x911Y\xc4\xe2\x1e\x1f?\xb9\x05Q\x1f\xd6\xfac\xa9,J\xab\x82)\x8dD\xe9\xb0P7i\xdb\xff*\xba\xe8ۓL\x8a}\xf3\xd4\xcfz\x1d\n\x89L\xae\x87\xe4\xa2\xd58\xddF\xf5H\x8b\x9e^\xd6\xef\x8d\xe1\xc1L\xd0\x1b\x82;\n\xe0&\xc21\x9f\xe0t\xa3\xb1u83\x94v\x14m\xe5\xc5\xf0d\xa0\xe62\xec\x92V>\xfd\xbf\x84\x1c.4\xbc\x97\x19ON\x11\xe4\xf0\xe5\f;\xfc\xfc\xbbVl=\x1f\xaa5y\x9d\xf6\xadzU\x01k\xe9\x0f\xa3\xf3\xf5\x11\x12\x83Z\x92\xdf\f\xd9\r\x9fxt\x12A\x8f\x87\x06^\x03\xa4\x9d\xbf$\x1c\xb9;\x00\x8d)\x14o\f\xe8\xd2\x1a\x9a\x90\xaf6\x98\xd68\x0f\x1d\x06\xa1\xf4\x9f\x03{\x91\xb4\x1e[g^\x06E\xd7ќ:\x9b9R\xcd\xee\x85i-\xf3\x10,\xa6\xb5L\xb8\xadcRM\xa6\x99M\xac\x17\xb3s\xc0\tR\x8c'Z#\x0e\xae\xd4\xf8\xe5YP7\x9cw+\xfaN8\xfb\xb9Y\x8c\x92\xf0\xf7\xb3\x89\xc1\x1c\xf59;\xaa\x9dv\x86\x9f\x81\a\x90\xc2\x13H\xbb\xa3\xf1]\t\xd8\x12.\x1cӼ^\xcc\xf4VÞ\xaa\x7f\xdbs\x15\xc2\xc36\xa8UuX\xf3\"\x82\xb2\xda0Svx٢^X\u0383\x1d\b\t+\xe8\x98t\xdfY_*{*(\x01\xb1\x81ҥ'`gL\x9b(^~\xaa\x06\x06\xb3ES\xad\xb3\xaa\xdc)<3M\a\xe6W\x99\xf1\x19H\xa8\xcf\xd2\xeeE\x94\xfe\xb9\xca\xfc\x86\xa2i\\\x11\xfc\xcb\xd8٫\a\xf6\x14Չ\x95\xdeӘ\xb0\xc8@h;1\x18鰆E\x9c\x8bY\xc1g|\xee\xb9{+H&\xcf\xc3d\xd7x\x8e\xa9m#\xe9;\xfd\x7ft\x89\xc7j\x96\xfd(UO\xac\xb6~\x89\x1b\xdei'\xa4&\xb4\x1a\xa2\xeb\xf0\xefs%?\U0005dac5%\xb4\xa6\x9f\x17цkd%\xc3\x06\xabW\xa5\xcenjTGL\x1bB\xe2#N\x7f\xa7V@\x96$X\x18ߡ\xda\xfc\xe3\x18WW\xad\xbf}a\x7f\xa5\x1c\xc5nX\xea\r\xfc\xfd\x1f\xf4\xe7.ld\xe8\xff\xb6\x83\xde\xc0\xdf\xff\xb1\xf8\xdf\x01\x00L\x8d\xfb\xe7Jd\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WM\x8f\xdb6\x13\xbe\xebW\f\xf2\x1e\xf6\xf2ZN\x90K\xa1[\xb0m\x81E\x9b`\xb1\x1b\xe4\x12\xe4@Kc\x8b\rE\xb23C;n\xd1\xff^\f%۲-\xa5N\x8aZ\xbeH\x9c\x8fg\x9e\x19ΐ\xc5b\xb1(L\xb4\x1f\x90\xd8\x06_\x81\x89\x16\xbf\bz}\xe3\xf2\xf3\x0f\\ڰܾ*>[\xdfTp\x9fXB\xf7\x84\x1c\x12\xd5\xf8#\xae\xad\xb7b\x83/:\x14\xd3\x181U\x01`\xbc\x0fb\xf43\xeb+@\x1d\xbcPp\x0ei\xb1A_~N+\\%\xeb\x1a\xa4l\xfc\xe0z\xfb\xb2|]\xbe,\x00j¬\xfe\xdev\xc8b\xbaX\x81O\xce\x15\x00\xdetX\x01#m\x91X\x8c$&\xfc=!\v\x97[tH\xa1\xb4\xa1\xe0\x88\xb5:\xdePH\xb1\x82\xd3B\xaf?\x80\xea\x03zΦ\x9e\xb3\xa9\xa7\xdeT^u\x96\xe5\x979\x89_\xed \x15]\"\xe3\xa6\x01e\x01n\x03ɻ\x93\xd3\x050S\xbfb\xfd&9C\x93\xca\x05\x00\xd7!b\x05Y7\x9a\x1a\x9b\x02@\x83>\xb0\xba\x18\xb8ؾ\xea\xcd\xd5-v\x99}}\v\x11\xfd\x9bǇ\x0f\xaf\x9f\xcf>\x034\xc85٨\xe4NF\x06\x96\xc1\xc0\x80\x02$\x80\xa9kd\x86:\x11\xa1\x17\xe8Q\x82\xf5\xeb@]\xce\xd1\xd14\x80Y\x85$ -\u0087L\xf9\x10Yy\x14\x89\x14\"\x92\xd8\x03\x1b\x83ک\xfaF_/\xb0\xdei8}\xf8\xd0h\xd9!gO\x03%\xd8\f\f@X\x83\xb4\x96\x810\x122z\xb9D\xa9\xff\xb0\x06\xe3!\xac~\xc3Zʁ\a\x06nCr\x8dV\xeb\x16I\x80\xb0\x0e\x1bo\xff8\xdaf%D\x9d:#\x87:9\xfd\xac\x17$o\x1cl\x8dK\xf8\x7f0\xbe\x81\xce\xec\x81P\xbd@\xf2#{Y\x84Kx\x1b\b3\x99\x15\xb4\"\x91\xab\xe5rc\xe5\xb0\xeb\xea\xd0u\xc9[\xd9/\xf3\x06\xb2\xab$\x81x\xd9\xe0\x16ݒ\xedfa\xa8n\xad`-\x89pi\xa2]d\xe8^\x03\xe6\xb2k\xfeG\xc3>\xe5\xbb3\xac\xb2\xd7\xcab!\xeb7\xa3\x85\xbc!\xbe\x92\x01\xdd\x0e}}\xf4\xaa}\xa0'\xa2\xad\xdf\xe4\x94<\xfd\xf4\xfc\x1e\x0e\xaes2Ό\xc2\xc0\xfbI\x91O)P¬_#e=XS\xe8\xb2M\xf4M\f\xd6\xf7\xd5U;\x8b\xfe\x92~N\xab\xce\n\x1fjWsU\xc2}nE\xb0BH\xb11\x82M\t\x0f\x1e\xeeM\x87\xee\xde0\xfe\xe7\tP\xa6y\xa1\xc4ޖ\x82q\x17=\xfd\xd4J5\xb06Z8\xb4\xb9\x99|M\xec\xee爵fPITm\xbb\xb6u\xde\x1e\xb0\x0e\x04fJ\xa5\xbc\tI\xd6\xf8F,C'\xe9\xd1\\\xf4\x97\xb0\xbe\x05\xcdt;\xd1'\xb6\x86\xf1\xf2\xe3\x05\xa6G\x95\xb9\xf4\xef\xec\x1a\xeb}\xed\xb07\xd1w\x13\xfcg(\xfa\xa0Oݵ\xcf\x05\xbc\xc3\xdd\xc4\xd7G\n\xdaYs_\a\xb8\xa16\x86y\xb3\xb1\x87\xa9:\x1fY/\x95gظU\x8f\x1a\xf4`\b(y\xaf\xfb\xf6\xaaC\xea\xff\xaa\x93_\xc9X\xc1n\x02\xcd$\x9e\a\xbf\x0e\xda[Ũc#\xfd~\xc2!ك\x9f\x1eׄ\xc1\xf9\\\x7f}\x88\xcc@\x1aM\x13{6H\x0e\x19\xef\x81\xc0\x9b\xc7\a\x90\xd6H1i\x12`,k\xbb\xe8\xb0\xeb\xfbك\xdc1`\x17e\x0f\xf6\xcc`\x13\x90\xfd\x9d\x0e\x97\x18hެv\xb2\xdaD\xb3\xb2\xcej\xd0e1\xe3~\xbeV\xfag\x8dF\xa7\x03\xdf\xc4\xcaσ0\x18\xc2\f:d\xba\x8c;\x9a9\xa7g\xc6&\x1ci\x1bG\xce)jȳ\xa1\xcc\xd6ҍ\x91B>#\x9a\x95\xc3\n\x84\x12\xce\b\xf5v\f\x91\xd9OJ\\O\xc1o\xc0\xd0!\xb3\xd9\xe0Md\xbf\xede\x01\xbfD\x97\xb7Įݏ\xf9\xb2C\x9d\x98f\xff\xdd\xd9\xcfG\xc4\xefU&4\xcd\xfe\xa6P\x9eTR[\xe9\xaeEi\x91\xc6a\xec\x8c\xced]\x97\xa1\x8d\x1cG\xf4\x8ci\x80]\x8b\xfe\xba\xfb@c\xb9\x0e[$l\xc0J\xf9\xef\x8b`\x15\x82C3UŊ\xd0\x12N\x16\xc2\"\x97\xc8\xe4\x82\xd2=\xb1035o\xc2;_\xae\xf10@N\xb7\xa4\xe2\xabYz\xbcR\x18R\xe6\xe7f\x1c\xec\f_\xd9\x1cy\x86\xd5~N\xf5\xfex\xe5\xbbNT\x7fw\xa8@Od\v\xb1\x1d~\x1f)\x93\xa5ۗ\xca\xec(8#\xe4y,{8\t\x9c\x97\xdc0\x15\xca\xdb!L&\xfb\xeac\x86ٌ\xc2c\td6\xe3\x809\xad\x8e\xe7\xf7\xaa8;h\xc1\x9f\x7f\x15\xa73\x97^Ѣ`3\xbafj\x85V\xf0\xe2\xc5\xd9%5\xbf\xd6\xc17\xf9\xc6\xce\x15|\xfc\xa4\xf7L\t\x84\xcd@\x02W\xf0\xf1S\xf1\xf7\x00\xb1[\xfd\x1f\x14\x10\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xecY\xddo\xe3\xb8\x11\x7f\xf7_1p\v$\xe9E\xca\x1e\xee\xa5\xf5\xcb\"M\xd2\"\xb8\xdbm\xb0\x0e\xf2\x92K\x01Z\x1a۬%\x92\xe5P\u07b8\xdd\xfe\xefŐ\x94%\xf9K\xcan\x17h\x81\x9a\x0f\x89Dr>~\xf3I*I\x92\x910\xf2\t-I\xad& \x8c\xc4W\x87\x8a\x9f(]\xfd\x9eR\xa9\xaf\xd6?\x8eVR\xe5\x13\xb8\xa9\xc8\xe9\xf2\x13\x92\xael\x86\xb78\x97J:\xa9ըD'r\xe1\xc4d\x04 \x94\xd2N\xf0k\xe2G\x80L+guQ\xa0M\x16\xa8\xd2U5\xc3Y%\x8b\x1c\xad'^\xb3^\xbfK\x7fJߍ\x002\x8b~\xfb\xa3,\x91\x9c(\xcd\x04TU\x14#\x00%J\x9c\xc0Z\x17U\x89F\x172\x93H\xe9\x1a\v\xb4:\x95zD\x063f\xb9\xb0\xba2\x13h&\xc2\xce(NP\xe5\xc9\x13y`\"\x1b\xff\xba\x90\xe4~ޛ\xfaE\x92\xf3Ӧ\xa8\xac(v\x99\xfb)Zj\xeb>6\f\x12X\x9b0!բ*\x84\xed\xecbn\x94i\x83\x13\xf0{\x8c\xc80\x1f\x01D\x1c\xbc\x90I\xad鏁N\xb6\xc4\xd2c\xcbOڠ\xba~\xb8\x7f\xfai\xday\r\x90#eV\x1a\x86\xae\xab\x04\x10\x16\x989\x82\xa5\xfe\fn\x89Q\x1c\x02=\xf7\x8f3\x91\xad*C\xe0\x96\u0081\xc59ZT\x19n\xe9\x02H\a\u0086u\x98Ce.a&\bs\xd0\xca\xef7V\x1b\xb4N6\x14#\x83tK\xa3Y\xd2\xc8\v\xd0\xf2\xbc\xd6\xdb\x1dM\xceX\xd9\x00\x0e\xe4\xecrȒb\r\x18\xe6\x11\x9f\xc0[\x12X4\x16\tUp\xc2\x0ea\xe0EB\x81\x9e\xfd\r3\x97\xc2\x14-\x93\x01Z\xea\xaa\xc8\xd9S\xd7h\x19\x83L/\x94\xfcǖ6\x81Ӟi!\x1cF\x8fh\x86T\x0e\xad\x12\x05\xacEQ\xe1%\b\x95C)6`\x91\xb9@\xa5Z\xf4\xfc\x12Jჶ\bR\xcd\xf5\x04\x96\xce\x19\x9a\\]-\xa4\xab#.\xd3eY)\xe96W>x\xe4\xacr\xda\xd2U\x8ek,\xaeH.\x12a\xb3\xa5t\x98\xb9\xca\xe2\x9502\xf1\xa2+V\x98\xd22\xff\x8d\x8d1Jg\x1dY݆\xfd\x8e\x9c\x95jњ\xf0!q\xc2\x02\x1c\x17 \tD\xdc\x1a\x14m\x80\xe6W\x8cΧ\xbb\xe9#Ԭ\xbd1:D!\xe2\xdel\xa4\xc6\x04\f\x98Ts\xb4~\x1f̭.=\xe2\xa8r\xa3\xa5r\xfe!+$\xaa]\xf8\xa9\x9a\x95ұ\xdd\xff^!9\xb6U\n7>\r\xc1\f\xa12\xb9p\x98\xa7p\xaf\xe0F\x94X\xdc\b\xc2\xefn\x00F\x9a\x12\x06v\x98\t\xda\x19\xb4\xf91\x95ID\xad5Q'\xba#\xf6j\xc7\xfe\xd4`Ʀc\xf4x\x9b\x9c\xcb\xcc\xc7\x05̵\x05\xd1\xc9\x13M\xb8\x1e\x0fY\x1e\xb6*\xf6_\xee\xc8\xf0\x89\xd7\xf8\xac\xd1\xe4\x03\b\t\xf0\x8c\x02\x85\x14\x1e\x97\b\"\xf3\xd2\xe8\xf9\x1e=\xf0&\x9fKK\xceo\x80\xcfKM\xc8A\x9a\xfb\xaa\xc3\xfe\x18\t\x97\xc2eK$VT\x18SH\xcc\xc1\xe9#\x04Ö4j\x1e\xb3^\xaeՙ\vd@\xa8M\xe0\xd7\xc9y v\x9d\x99\x87lgP\xc8e\xae\xceZ)\xb4\x11\xd0\x1c\x00\x98\x87tX\x1e\x80\xf2\x84A\x19X\xafg\x90\x91\xf3\xd9\t#\xf6\x992\xe6ao\x83\xc3s;\xa2\\\xfb\xa5 \xf7\x8b\x89\a\xaf\xce\x04,\xdb\x11r\xd0\xc5\xf5\x90\xbc<PU\xe51\x89\x12\x98*ah\xa9\xdd\xe8\xe0<$\xf0'Y\xe0tC\x0e\xcb?\xfa\xeav\x9c\xd2J\x1e\x9b<\x12\xaa\xcdh\\q\x10v7-ύ\x81qЙ+rG\xc8AtQ\x8e\xdd\x1a\xe63\xaac\xc8i\xcewM\x00\x80t\xc7\xd0\xed\xf3\b\x1e\x19\xc9[+\xb9>\x1e_\xb3\xab\xe1\xf4>na\x0f\x11\xbe\xa9\xe2\x92{3\xbd\x87\xdcO\xf8Ά.O\x10\x04\xd0\xca\xfb\xf5\xe7\xa5̖PV\xe4X-V7\x92\xe84\x19\xc74<\x19]o\xb0q{\x99\xb0Vl\x8e\xae*\xc5\xeb\x8d0\"\x93ns\x8a\xa7P\x9b\xbf\xccO-H\"7n+\x16h\a\xac\xec\x15\xbfc\xa4\x0f\x8d\x9cue(ū,\xab\x12\xb2\xfa\xbd\x9e\x9f\xa0\xd6Σg\x04\x86\x1b1r\xa8\xdc\x00\x93p'/f\x05N\xc0\xd9\xeax\x8a\x000\xc2qW5\x81\xbf\x9e\xff\xfa×\xe4\xe2\xfd\xf9\xf9\xf3\xbb\xe4\x0f/?\x9c\xff\x9a\xfa\x7f~w\xf1\xfe\xe2K\xfd\xf0\xc3\xc5\xc5\xf9\xf9\xf3\xcf\x1f\xfe\xfc\xf8p\xf7\"/\xbe<\xab\xaa\\\x85\xa7/\xe7\xcfx\xf72\x90\xc8\xc5\xc5\xfbߞ\x10\xea5\xe1ӋU\xe8\x90\x12\xa9\\\xa2m\x12\xc0\xefѧ\x94\xea\x7f\xc37\xa4\xda\xf3\r\xa9\xfe\xef\x1b\xdf\xd37\xcc:\xfbḚ\x98\xfaS\x9a\xb6\x93\xa1\xc6zx\xba\xe9l\x8c9\x97_\xc5#\x9f/\x13\xc2}\x83\xbd +\x84,C\x16\xf6\xb5\xe7?c\xc0\x01\xe5g[\xed\xee^\xf9<\xb7=\x1c\x03\f\x84gws\xb7$\xed\xc0ć\ai\xb1\xf4\x87\x92\x1e.\xe0\x1b\xd7\xf6\x0e\xdf\xd2\\\x7f\xbcżo\uf012\xb4\xa7\xc8\xf5\ta\xe3\xb1l\xb0\xb5\xb7\x8d\x8b\x13RQ8\xc8\xd1%\bX\xe1&\x9c\\\xf9xlЊ\x9a\x1cX\xf4\xa7^\x1f\xd8+܌\xfa\x88s\x1a\xdb\x1et{W\x0fu\x85xR\xc5͐e;\x00\xaep\x9b\xcd\x02\x92\xfc\xc2\xeb\xc6:ma\r\x9d\x13\x8dzH\xc7\xe1t\xbf\x9f\xbc\xa1èG\x8d\xfdW\xa8\xb95[s\xbe\x0e\x86\xe5\x13\x17\x1b\x91\xa3`)\xcd\xe1\xb3ѡ\x1f{\x96\x8f\x96\xfa\xda\xe2I\x142\xdf\xca\x18\xfa\xd8{u\t\x1f\xb5\xe3?w\xaf\x92\xdcP\x00\xd9Kn5\xd2G\xed\xfc\xbe\xef\x02g\x10\xfc+\xc0\f\x1b\xd9m\x84\n\x8d\x1f\xe3о\xff\xa0\x14\xee}':\x88xcY\xa6y\xaf@\xdb\x1a5v\xc2\xc8.0\xaa{^\xa5U\x82\xa5q\a\x0fu\x87F\x90\xa7\xc3\xc9CK̭\x8du\x9b\xe9@\xda]тX\xf0\xc8w5a&ܼ\x15|\x9d\ty\xe5a\xf2\xf7E\xc2\xe1Bf\x03\x99\x94h\x17\b\xa6\xafм9\x9f~\x95\xef\fk\xfb\xeb_L\xca;\x17i\x87F2(\x8d&[3\xf6.=rM\xf4-\x1a\xf9\xe2黋^tE\x1eN\xb5\xa2xxC.\x7f\x83-:q\xd9\x12\x8c\x03I@)\fG\xe6?\xb9\x80y\x87\xfe\x17\x18!-\xa5p\xedo\xe0O\\Dԣ\xbdW\xaax\"j\xd80\aI\xc0\xf6]\x8b\x82\x8b\xae\xd3 \x14`\xe1Kp/y=\xdfkd.\xe3e\x16\x17\xa2\xb9\xc4\"g]\xc6+܌/;\x11\xdcK\x9b\xb7ݫq(\xdd{\x89d[\xe7\xb5*60\xf6s\xe3t\xaf}\xe9\xe52\xb8\xbd\x19艃\x96\x91\xd3V,\xf0\xa6\x10D\xa7=\xaa\xe3 \xd3ζn\xd3\x17IrSK\xa7\xb5\x0e\xd7\x14ǯ\":\x94@\xf7ׁ\xbe\x16;\x1d}S\x92\x1b\x18NC\xc2?H\xf5\xb81o\x00\xfd\xa9\xd9\xd3E<\xaa\x18>\t\x9c\xa0\x162\xd3)\xc4y>\x02\x1d\xa9\x9e\xbeE\xa2*[\x82 \x18g$Ǘ0Vs\xe2?KM\xeeA\xb8\xe5\x98K\xe2ؗ\xb1[iCP\xf4\xca\xc7\xfc\xc5\xd1C\x92\xa4\x8e\xa0\xb2'\xb0\xf6\xc8\xfc\xb7\xb8@Ot\x9e*tI\xbc\x8b\x1c\xbd\x91\xea1\xa1\x0e\xf3J\xfc\xdd7\x8dz\xc9\xef\xbd$\xfe\xe0\x97\xb7Χ1\x8e\xe3\x1br\xc2U\x1ef\x91eh\x1c\xe6\xad\xef\xba\xfc1g\x02\xe3q\xe7{\xb0\x7fl\xdd\x03\xc3\xf3\v\x7f\xe0u\xdab\x1e\xbfZ\xd2\x04\x9e_F\xff\x1e\x00\xb0\x86\xb8\xc9j\x1f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V=\x8f\xdc6\x10\xed\xf5+\x06Nq\xcdIk\xc3M\xa0θ\xa40\xe2\x18\a\x9fs\x8d\xe1\x82K\x8e\xa4\x89)\x92ᐛl~}@\x8a\xdaO\xe9\xce\a8+5\xe2\xc7\xe3\xcc{3oY\xd5u]\tG\x8f虬iA8\xc2\x7f\x02\x9a\xf4\xc5ͷ\x9f\xb9!\xbbٽ\xa9\xbe\x91Q-\xdcE\x0ev\xfc\x84l\xa3\x97\xf8\vvd(\x905ՈA(\x11D[\x01\bcl\x10i\x98\xd3'\x80\xb4&x\xab5\xfa\xbaG\xd3|\x8b[\xdcF\xd2\n}\x06\x9f\x8f\u07bdn\xde6\xaf+\x00\xe91o\xffL#r\x10\xa3k\xc1D\xad+\x00#Flagu\x1c\x91\x8dp<ؠ\xad̫\xb9١Fo\x1b\xb2\x15;\x94\xe9\xec\xde\xdb\xe8Z8NL\x10%\xae)\xa7ǌ\xf6P\xd0>\x14\xb4\xbc@\x13\x87ߞX\xf4\x818\xe4\x85NG/\xf4jdy\r\x93\xe9\xa3\x16~mU\x05\xc0\xd2:l\xe1\xa3\x18\x91\x9d\x90\xa8*\x80BO\x0e\xb9\x9e\tx3!\xca\x01\xc7Ly\xfa\xb2\x0eͻ\xfb\xf7\x8fo\x1fΆ\x01\x14\xb2\xf4\xe4\xd2\x19k\x89\x001\b\x98#\x81\xbf\a\xf4\b\x8f\x995\xe0`=r\t\xfa\x00\n0\xc7\xcf\xcda\xd0y\xeb\xd0\a\x9a\t\x9e\x9e\x93\xf2:\x19\xbd\x88\xeb&\x85>\xad\x02\x95\xea\n\x19\u0080s\xfa\xa8J\xb6`;\b\x031xt\x1e\x19M8\xcau|l\a\u0080\xdd\xfe\x8924\xf0\x80>\xc1\x00\x0f6j\x95\xcaq\x87>\x80Gi{C\xff\x1e\xb0\x19\x82͇j\x11\xb0({|\xc8\x04\xf4Fh\xd8\t\x1d\xf1\x16\x84Q0\x8a=xL\xa7@4'xy\t7\xf0\xbb\xf5\bd:\xdb\xc2\x10\x82\xe3v\xb3\xe9)\xccm%\xed8FCa\xbf\xc9\x1dB\xdb\x18\xac\xe7\x8d\xc2\x1d\xea\rS_\v/\a\n(C\xf4\xb8\x11\x8e\xea\x1c\xbaI\ts3\xaa\x9f|iD\xbe9\x8b5\xecS\x15q\xf0d\xfa\x93\x89\\\xeeO(\x90*}*\x84i\xeb\x94\xe8\x91h2}f\xe7ӯ\x0f\x9fa>:\x8bq\x06\n\x85\xf7\xe3F>J\x90\b#ӡ\xcf\xfb\xa0\xf3v̘h\x94\xb3dB\xfe\x90\x9a\xd0\\\xd2\xcfq;RH\xba\xff\x15\x91CҪ\x81\xbb\xec5\xb0E\x88N\x89\x80\xaa\x81\xf7\x06\xeeĈ\xfaN0\xfe\xef\x02$\xa6\xb9N\xc4~\x9f\x04\xa76y\xfc%\x94\xb6\xb0v21\x9b؊^˝\xfc\xe0P\x9e5PB\xa1\x8eJgw֟!\x02\x88\xb9ϗ\xf1\x8eͽ\xde\xe0\xc5\xe3;\xea/G\x01\x84R\xf9\x1fB\xe8\xfbսO\x10\xb6\x90\xf7\x9d5\x1d\xf5\xa9P;\xeb\xc1y\xbb#\x85\xbe\x9e\xf3,\x91D_\x12&Ԋ\x9b+\xc8\x15\xce\xd3+\xad۷\xcf\xc5\xe0\xf6\xb7@\x1d0\x86[\x18\x04\x03\n9\x80\xb4\xa3\xd3\x18\x92\xa1\x14\x1a\x81\xccT\xe9'.\x7f\xf9H\xeb\bU\xf2\x1e\x01\x8c\xd2\x1a%|\xb2\x95>\xc5o\xfda\xeb-p\xb2'\x11\x80\x02p\xf4;\xda!\x83X\x82\xb41\x88\x1e\x0f^i\r^3\x90\xfeR\xc5Vc\v\xc1G\xbc\x9a^\x97\xfa)\xb9_\"\xf9\xb3\xb2\xff\x10\xe9W\x0e\xce\xd4\xe0s|G9\x80`\x98|'\xe9\xd1\xc0\xe7\x01\xf77\xfe\x9a\xaf\x92\xbbs:\x89i\r\x04\xeb\xe6S\x96[\xeb\x86K\xc0M\xb5N\xcdb\x85BvA\xf2x\xe1\xe7\xe9\xad\vh\xf5\x02\xbc\x99ɶz\x92\xfe\xfb\xb2,\xf5^Jk\xde6\xa79]\x11\xf2\x85A\xf4\v\x15\xb7*\xf6r6\xf5\xe1\x80\xea;\xf2\xe0 B\xbc\xa8\xb3\xb3\xe8\x97Ex\xc8\xdbJ\x9e\xdb\xe2\x992z\x8f&\x14\xcc3HH\xc9\xfe \xcft\x83`|\x86\xf3\xe5\x13\xee\xd3\xceY\x06M\x1dʽ\xd48\x01\x82\xed\xae _h\xf3\xe9E\x13\xc7\xeb\xd8jx\xb7\x13\x94}ca\xee\x0f#VgW\xc5_\xd4\xf3j\x90\xd3\x1dN\x9dxU\xa9\xb22rT_H\x89.\xa0\xfaxy\xcf\x7f\xf5\xea쪞?\xa55\x93Wq\v_\xbe\xa6\x1bx\xba\xec\xaar\x11\xe5\x16\xbe|\xad\xfe\x1b\x00\x11\xc8)\xde#\r\x00\x00"),
 }
 
 var CRDs = crds()
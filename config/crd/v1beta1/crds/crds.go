@@ -29,17 +29,19 @@ import (
 )
 
 var rawCRDs = [][]byte{
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec<Mo#7\xb2w\xff\x8a\x82\xdf\xc1\t`\xc9\x19\xe4\xf2\xe0\xdb\xc4\xe3\xc1\x132obĎ\xf7\xb0\xd8\x03\xd5]-1f\x93\xbd$[\xb6v\xb1\xff}\xc1\"\xd9\xdf_r\x9c\f\x82\fOv\x8b,\x16\xab\x8a\xf5\xc5\"\xcfV\xab\xd5\x19+\xf8#jÕ\xbc\x06Vp|\xb1(\xdd\u007ff\xfd\xf4\xbff\xcd\xd5\xd5\xe1\xdd\x16-{w\xf6\xc4ez\r7\xa5\xb1*\xff\x19\x8d*u\x82\x1f0\xe3\x92[\xae\xe4Y\x8e\x96\xa5̲\xeb3\x00&\xa5\xb2\xcc}6\xee_\x80DI\xab\x95\x10\xa8W;\x94\xeb\xa7r\x8bے\x8b\x145\xcd\x10\xe7?|\xb7\xfe~\xfd\xdd\x19@\xa2\x91\x86?\xf0\x1c\x8deyq\r\xb2\x14\xe2\f@\xb2\x1c\xafa˒\xa7\xb20\xeb\x03\n\xd4j\xcdՙ)0qs\xed\xb4*\x8bk\xa8\u007f\xf0C\x02\x1e~\r?\xd0h\xfa \xb8\xb1?6>~\xe2\xc6\xd2\x0f\x85(5\x13\xd5L\xf4\xcdp\xb9+\x05\xd3\xf1\xeb\x19@\xa1Ѡ>\xe0/\xf2I\xaag\xf9\x91\xa3H\xcd5dL\x18<\x030\x89*\xf0\x1a>;\f\n\x96`z\x06p`\x82\xa7\xb4:\x8f\x93*P\xbe\xbf\xdb<~\u007f\x9f\xec1g\xfe#@\x8a&Ѽ\xa0~\x019\xe0\x06\x18<\xd2\xd2@\a\x16\x80\xdd3\v\x1a\t\x13i\r\xd8=B\xc2\n[j\x04\x95\xc1\x8f\xe5\x16\xb5D\x8b&\x00\x06HDi,j0\x96Y\x04f\x81A\xa1\xb8\xb4\xc0%X\x9e#|\xf3\xfen\x03j\xfb+&\xd6\x00\x93)0cT\u0099\xc5\x14\x0eJ\x949\xfa\xb1߮\x03\xccB\xab\x02\xb5\xe5\x91ή5\x04\xab\xfa\xd6Yօ[\xb7\xef\x03\xa9\x13%\xf4\xe8\a\x81\xc0\x14\f\xd1ĭ\xc3\uee69\x97I\xf4k\x80\x05ׅɀ\xf4\x1a\xee\x1dS\xb4\x01\xb3W\xa5H\x9d\xfc\x1dP;2%j'\xf9\xbf*\xc8\x06\xac\xa2)\x05\xb3\x18X\x1f\x1b\x97\x16\xb5d\xc2q\xac\xc4K\"DΎ\xa0\xd1\xcd\x01\xa5l@\xa3.f\r\xff\xaf4\x02\x97\x99\xba\x86\xbd\xb5\x85\xb9\xbe\xba\xdaq\x1b\xb7R\xa2\xf2\xbc\x94\xdc\x1e\xafhC\xf0mi\x956W)\x1eP\\\x19\xbe[1\x9d\xec\xb9\xc5\xc41\xef\x8a\x15|E\x88K\xdaI\xeb<\xfd\x9f\xc8ts\xd1\xc0\xd4\x1e\x9d\x8c\x19\xab\xb9\xdcU\x9fI\xd2G\xe9\xeeD\xdeK\x93\x1f\xe6\xf1\xaf\xc9\xeb>9\xaa\xfc|{\xffД4n\xda4'j7\x84\xaf&\xbc#\x14\x97\x19jϸL\xab\x9c \xa2L\xbd\xac\x91\x98\n\x8e\xb2MtSnsn\x1d\xa7\xffY\xa2q\xe2\xac\xd6pC\n\x05\xb6\be\x91:)\\\xc3F\xc2\r\xcbQ\xdc0\x83\xbf;\xd9\x1d\x85\xcdʑt\x9e\xf0M=\xd8\xee\xe8\xa9U}\x8e\x1ak\x90C~\xc3\xdf\x17\x98\xb46\x86\x1b\xc33\x9e\x90\xf8C\xa6t\xad\x0f\xbcJZ7\x00\x0emJ?Q\xc6Ja\x1fi#\x9b\a\xf53\x1a˓v\x9f\x0e:\x1f\x06\x87Dt\xd0\xc0\xf3\x1e\xed\x1e\xb5\x93\x15\xfa\x81\xb6]\a\"\x10\x03\r\xa6\xb4\xe7\xd8\x13\x02\vX\xd3\xe6\x15\x02\n\x15\xf5\x8b\x81\xed1\"\xba\xee\xc0\xf1\xd4\xdc*%\x90\xb5u\x00\xbe$\xa2L1\xad\xf4\xad\x99\\\xd5m\xaf;\x19*ƥ\xdb\x19\xce48\xc4d\xfd+\xa9Z\xa6\xb1\xb70'\x9d\\zh\xa4E\xf78\xc0\x10\u05f8ż\x87Ո(\x05إ\x10l+\xf0\x1a\xac.\xbbS\xfbqLkv\x1c\xa4D4\xd4\xcb\bQ\xf5\x0e\xbaA\xf0\x84lH\xa5\x01\x88\x16\u007f\"2\xec\x95z\x9a^\xfa\xff\xb9\x1e\xb5\x06\x83\x84\xfc\x1b\xd8\xe2\x9e\x1d\xb8\xd2a\xb1\xc1\x8cl\x11\xf0\x05\x93\xd2b_\xb6\x99\x85\x94g\x19j\a\xa5\xd83\x83ƛ\xad1\x12\x8cmO\xd7\xf4\x18\xdbz\xf8\xd7,c\x1a\xfdz\xc7Pv\x9bT\x122}\xea\xfa\xe6\\\f\x99\xf2\x03OK&\x80Kc\x99L\xfc:X\x85Sw\x1d0\xce\xce\x1e\xb6^\xadE\x9c\x1d\xed[*NI\x04\xa5!wڼ\xdf\xd5\f\u0087\xd1\xe5n\x99\xd35ʋ\xa1.\x05\x9a0QJ\x9a\xb3\xdeח#\x80+.x\xdb/\xd8\x16\x05\x18\x14\x98X\xa5\x87\xc80\xcdT\xdf\xe6u\xd4\b\xed\x06\xb4U\xad\u007f\xdd\x12\x9b\x8aJ\x8d\xc2\x04x\xde\xf3d\xefͲ\x93\x17\x82\x02\xa9BC\xfb\x97\x15\x858\x0e/\x0e\xa69\xed\xdb\xc4\x16\xae\xdb\xe4f\xee\xc2\xeao\xeb\xba\xcd깺\xcdh\xbc6-+\xd6\xffuH\x19\x15\xf7ɂ\xb9\xe9\r|K\xc1tD\xe4ε\xded\x80ya\x8f\x97\xc0m\xfc\xea<\tFq\xe1(y\xaa\xb9\xfft\x8c8U\xa67\xddqo(ӿ\x91\v\xd5\xd4\u007f\x1a&\x90\xb2\xbf\x0f\xba~!\x03>5\xc7\\\x02\xcf*\x06\xa4\x97\x90qaQw81\xb5\\5͉\xdfJ\x82yK\xe5Z\xcel\xb2\xbf}qޑ\xa9\xd39\x8b\xa8\xd1\x1d\xea}\xca\xe8U\xb7\x8d\xe9$T\xa0`\x90k\xcc}\x88\xf9@\x14\xac\xbf\x90\xe7\xf3\xfe\xf3\aLǉ\x02K$\xac\xb7\x84\xf7\x1d4\x9b\xd3\x06\x17y\xd9\x02\x82\x93RE\x17>]p\t\f\x9e\xf0\xe8\xbd\v&\xc11\x84\xb9i\\\xe7Y\x88\x1a)gA\x02\xf5\x84G\x02\x12\xd2\x103c\x97\xb1\u07b7'<\xcew\xea\x90\xcda\xc3MH\xab8\xfa\xb9\x0fD\x00\x8aa\x97\x92\f(\x89\x145\xccܢ`\xa9\x8a\x88-R\xfb\xe4\xe5Ulj$݈\x91\x17\xc63\xc5I\xfb\x9e\x17\x8b\x16\xe8T'\x18\xa4=\x11\x93H\x8fL\xf0\xb4\x9a\xc6\xcb\xf7F^\xc2ge7r\xccYm\xb7\xdb\x17nB\xee\xee\x83B\xf3YY\xfa\xf2\xe6D\xf4(\x9fLB?\x8c\xb6\x90\xf4jح\xbf\x99\x8b\x9a\x15b\xdf6>ªX\xc2\rl\xa4\x8b!<\xad|6\xd1O6\xa5\xed\xdb-/\r%\x9b\xa4\x92+2v\xeb\xa1y\x02\x89\x17\nr\x93\v}\xb4\xaa)\xfdt\x8b >8\xbb\xe0G\xfb̨`\t\xa6\x90\x96DD\xca\xec1\x8b;\x9e@\x8ez7n\b\x9a\xadp:{\xc9\xf4\x8bt\xa9o'\xc9\xd3\x12\xd3\x1c[P\xc6\xe9\x1c\x1a+\xb77g\xfbD\xd6\xcet\x1cL\xe5\x8dw\x9c[\a\x19I\xf2\x1bf\xa8\xc9Ҕ\xceY\x98\xb8[\xac\xbd\x17S\xbeo\xb7=J\xde\xc6\xe5\x8c\x12t\xffv\xa6\x8a\x84\xf6?P0\xaegw\xe8{:0\x11\xd8\x1a\x19\xb2B\xcdI\x1c|n\xc0q\xf3\xc0D7!<\xb0,\xe5\xb4\x06\no\x86U\xd6\xf34.\xe1y\xaf\x8c\xb7\x8a\x19G\x91\x02\x9f\xf2\xb4\\;\u007f\xc2\xe3\xf9eo\x8f\x9fo\xe4\xb97Ͻ\x1d\x1bm\xf9\f`%\xc5\x11\xcei\xe4\xf9\xeb]\x97ER\xb7\xa0\x13\x9d\x9e-sf]4\x17\xad\xb8\x1bV\x9d\xc18Wt\x1c\xdb\x052W(c\x17\"q\xa7\x8c\xf5\x19\xba\x96\xf38\x90\x1b\x9a\x8eiBN\bX\xe6Ͻ\x94\x8e'\x1cN\x91uR\x95\x8eK\x06\a\x13\x9c=\x88i\x00Ʉ\x80\xf3z\x8fz\xfdx\xee\x8f=h\n\x96\x90[0\x01щB\xa1U\x82\xc6L\x89ì\xe6\x9dI\xb8U\xc96\xe6\x83\n\u007f\x880\x95܋m\xa9\xdb\xe8Hs\x92\x9b}\xfb\xd2\xc8\x01\xba\xad\xed\xfe\x9f\x16\xb3\xd30\x02:\x82\xces&g\x8dE\x0f\xb9\x1b?.n\x85\x00ƻ\xeczW\xd26^\xea\xe9\x05\xa1\xf9\xb2\x066\xe7rC\xc0\xe1ݛ\x9ac\x88*\x11Ow\xa9o\xe2Ț\xcc\xd5\a\xbf7\v\xd5O\xb9\x0f\xb5\xe7=jlq\xaa\x9f\x19&wN*\xdb\bϗ\x11\xda\xe3qa \xe3\xda\xd8&\x92\x86\x0e\xb6\xde>F\x91\xb7Z\xbf\"D\xf9ɏk$\x80\xf6\xea9\x9e\x14\x8e\x1c\xce\r5:\x06A\xe0\x19p\v(\x13UJJb\xb8MJ\x13x\x92ze:kd}[\xb2\xb1]CY\xe6K\x16\xbe\"\xe9\xe1r\"\xd7\xd1\xec\xfc\x91\xf1\xa9LUl'\xb1\xc9\xf2\x1cU9a\xd4\xea\xd6bӃ\x1f\xd7:\xe2\xcd\xd9\v\xcf\xcb\x1cX\ue23d\x88\xa2\xce2\xf3\x1c\xdb\xfc\x85g\xc6-iw\a\x95T\xbdUnS\x14\x02\xed\xb2h`\x8b\x99Ҵ\x17\rO\xb12\x99\x81\xe7J\x02\x83\x8cqQ\xeaE\x1a\xed\x04\x8a.\xf7\xec\xc3&\u007f\x1b\xa7}ɴ+Z\xfel\x9ar\x91\xab6\xa5U\v\xbd\xd4Q\xbb\xd3\xf8\x96.R\xa1\xb9\x93\x19\xf5\xb6^R\x10%&\x8f_ݤ\x06m\xbe\xbaI\xbd\xf6\xd5Mj\xb5\xafn\xd2W7i\xb2}u\x93\xbe\xbaI\u007fU7i\x1a\x93\x15\xe5\xad\x06\u007f\x9a\x99}\xf6\bu\x1c\xb1Q\xc8\xe1T\xff\xc6\xd7^/\xab\xcb\xdb\f\x8f\x19\xa8\xbb\f%\xdd+*8\xef\xf3\xb9>\xfa\xaf\xd5|U\xa8\xe7\x84?\n\xaf/,\x9d,\xdd[P\x887T\x9b9_^2WTҮI\xac\n;bQ\xa2\x8aS\xf4V\x1f+ٝ\x9b٬``B4kS\x98\xae\x89\xf2\x85\xea\x15gK?f\n>\xa6\xcb6\xc7)\xd4q\xed\xdb$ҭ\x12\xc3/L\xa1ɺ\x8c\xf1j\x8cp\x92\x81\x96\x1dޭۿX\x15j3\xe0\x99\xdb}o\x01T4\xe9B\x16\xb9k\x16GF\x99\n\xd7\a\xba\x94\x03\xa5Arq9X\x17Sݬh\x92\x13~*|Pt\xd2~\x9br\xed\x97\xd4n\xbc\xbab\xa3]\x931\xa8dO;\xecXZB\xba\xbc&\xa3]s1bd\x16Tb\x9c\\i1\x1foMVU\xbc\xa2\x96\"\xd6IL\x19܉\n\x8a\x05>\xc7|\xb5īj$\xe80o\x02\xeb\x93*#\x1aU\x0f\x13 \x97\xd5C, \xc9\\\xed\xc3\xc9\x15\x0f\xdd*\x83\x89E\xcc\xd59\x8c\xd70L\x00\x1d\xacnXR\xb90\x01\xb3\xaaix\xc3z\x85\x99*\x85\xb7\xa9$\xfc\xad\xbe\xe7X\xcd\xc1L\xa5\xc1\x8cg:\x85\xd5L-\xc1\xf2\n\x82\x19\xfa\xbc\xb2Z\xa0\xaa\a\x18\x9c\xf3\xd4\x1a\x81v\x15\xc0 ȅ\x95\x01#g\xff\x83 \x17\xd4\x03̜\xf8\x0f\x82\x9d4\x8c\x13\x121\xfa\xd3\xd0%4\x98\xb1L\xe2\xf7\x97\x9c\xd7,E\xe9\x14\xf5\x84G\xbc\f\xb9\t\xc4\xda\x19\xa1\xcel\x9d\x12\xeax]\xcd\xf5jz\xd8}\xb6\xaa\xaa\xf87\x81\x1f\xb9L\xbd$81nXt\xba\x86I\xc5\x15\x95KQ\xfb\\C ;\x1e\xbd\xc1\x82i\xba\x95\xbb=\xfa\x18߬\xe1\x96%\xfbvG\xd83㢼|\xa0\xaa\xf4\xbc\n\x80\xae\xe2\x18\xf7\xe5|\r\xf0QUqe\xf3*\x8c\xe1y!\x8eP\x1a\x84\xf3\xf6\x90\xd3\xfd\xfb\x01~\x1b\xc9\n\xb3W\xf1\xaeᤋ\u007f\xdf\xee;\x10\x17Ǜ\x86\x89PeZ\xc1\x1ed\x17\x93G\xb8{$\xff\x84nq%\xf5\x1d\xb6\xe0\x85D\xbf\xbd{\xc5퇷\x8c\x93\x8dU\x9a\xed\xf0\x93J\x1aw\xc4\xc7\xd6\xdf\xeeۺ\xd1\x1b\xf4Q\xccF\xc5\x12\x1e\x16\xaf\x96\xb6\x87\x0e\x85=!A\x1cd\xbeN\x1c8\f\xfb\xaajt\x87Y+&\x17\xf1\xf0\xf0\xc9#ny\x8e\xeb\x0f\xa5\xcfA\xac\n\xa6\r:\xfa\xc5\x05\xf9A[\xf7\xe7^=\xf7\u0557\n+\xfd\xa1\x8b\xafFJ?S\xa2c1\xd6\xfe\x1aj\x14\xb0H\xa6iq|\x1c\x1e\xd3\b\xa3\x1aL\xf1;Xec\xa3z\vl\xdc\xc1w\x81\xaa/\xc6z\xabە\xc3~\xc5\xf0\xbde\xcbli\xe6n.S\xa7\xf8\x0eA8\xac(5]\x8e\xf4\x00\xbc0\x9e|y9df[oCL\xf1\xe4\xa6ߟ^\x01ЩG\x8a2\xc2\xd5=\xe4gf\xaa\xdc\xef\x801\xae\x81\xf9q\xe4\xc7:X\x98\x02\x1eP\x82\x92\x94\xea\xa5ˇ\xfe\x85\x8a\xee\x98~\xea\xa5\x01#d\x92\xcbB(\x96Ɲ\x1bmNx\xd9\xe0\x81\xf4\x91>\xa0\xbe0\xa3\x10\xe9\x96u\xa6\xf4\xd0\xf2\xbb\x92\xe5\r\xc35\xa4\xcc\xe2j\x00\xe0\x02=6 Rt\xee1s똺\xf8\xddAG&\xf1\x1a\xb8?3\xc9\xd1\x18\xb6\x8b\u05cd\x9f\x9d:ڡD\xb2w\xbd5\x87(\xa2ι\xb7\xaf\xde\xfad\x04Kl\xc9\x02\xf8\x98}i\xf4\xba\xe8\xef9\xa1v\x90qA\x1d\xc3c\aA?\x0f+\x12.-\xee\xb0\xed\xd9\xe3K\xc1\xf5\xbc.\xbf\xad\xba9\x8aP։vx\xfd\xf4\a\n\xbe\xe3N!:\xc6\xee\x98\u07b2\x1d\xae\x12%\x04ҡy\x17\xa3߇\xaf\x1e\xea\xc0\xc3\x1e\xbd\x05}l\xf6\x8c\x1eO\x10f\x0f%\xbe\xf3q\x19,\xaa\xe3`\xce~U\xba\u007fΘs\xa9\xb4\xf7\xbc)\xfa\x8bC\x17\xebs\xba\x91=\x89\xef\x9d\xebQ\x1d\xac6t\x15Fa\x1a\xb6\xf3C\ap+\xf8\x8c]\x13\xe5\xcf\xd40}\xac\xde\u007f\xe9u\xd8\xc8;\xadv.X\xe9\xfdt\x13\xb5R\xef\x97;\xa6-gB\x1c=\xf8\x91Y{\x9f?\xa0\xd3\v#\x86`\x88\x80\x01\xb3i\x1a\x86Nu4ĥ\xe75\x9d\x83mUi[\x1b\xaeް=\x8e\xc7\xf9\xd6\xf0YY\x8c)/ކ\xe8, \x1a\xbb\xc2,S\xda\xfa\xd0k\xb5\x02\x9e\x05\xc3҃\xea\xb43%m\xfdC\"\xc0m\x9d\x80\xa8e\x93|A\x8d̐lZz̄NLX\x928\xff\x04\xaf\x8ce\xa2\xa7\x03^\x9d\xa9%{\xed\xa4\v\xd3_z\xe6\xacG\xe4M\xb3wU\xa2]\xe6[\x1f\x93\x100O/:\x95\xf6ZO\fg#\xb6\x88\x12\x9e5\xb7\xd6\xe9\x9bf.\x1b\xac\xd30B\x80Q\x90\xb1\xc1\xeb\xee\xe3:\x8f~U\x96\x89\xcdX.\xa6\xed\x02V]\xe3rhp\u007fQʱaKK\x1f\\\x8e\xafK\xe2&\x8et\x8cK\xf6L\xee\x9c\x00iU\xee\xf6Q\x02G,\xc5p\xfe\xb9t\bA!ʝ\x13\xe9\x90\x13\xb6\xa5\x96\x8d\xe83d\x89\xd3\x06\xaa,y\x82\xb2\x18.\x9a\xf0\xcf\x1c\x85G\xaa\xae\xc25\xf6U\xa6U\xbe\n\xf4\xa7x\xfc2D\x86\x9a+\xe72QL\x13n\x92\x8e\x80%\xb6\x17\x05J`&\xe02[25\xc5\xc8\xf1@\xcd2m\x979a\xf7\xad\xae3\xfe\x17\xc1\xc5t\r\xf7!\xba\xedob\xadr\xb8\xe9>\x11\xe6\"S\x19\xdf\xc3\xf2\xb1\xb4g\xbdqn\x99F\n[\xfc\xf5\xd1\x1eĖC\xd5r\xa0ڨ\xff1\xbeS\xfdB\xd8\xed\xbc\x17\xf5\xd8\xe9\xdc9\x03t;\xb8\x86\x17}\x9fox֏/\x8aB\xf0\xc4a\xfb\xed\x17:\xdb;,\xf0*.&\x1d\n\xf2\x1e*\xdf\x00>`\xa11q\xbb\xb2\x8f\xfc\x9d@g\xef\rb\xdbS\xb9X\xecصCD\xf3\xdeZ̋\x81\xb9&b\xc4zИ\xe2c\xb1Co\x01\xf1!\xb6\b*\x14\xb1\x8c\x06\x85\x8b\x17R\xb9\x1a\xa7,\xa4\x1a4\xb6\x10S&N\x01e\xe5\x90)\xaab\xae7\\\xd53\xd3.О\xde=\u007f\v\x9d\x06\xa2\x900\xfem\xe3\x90F\x18\x12\xf1\xfb\x83\x02\x91\x01=\xde\xf9T?\xc3\xf8\xae\xfe\x8fȷ\n\xcf.\x1e|)$i˴\xb1\xb5\x03*\xe1K\x9d `I\x82Nv?w_`<?\xa7\u007f\xe2#\x8b\xf4o\xa2\xa4\xb7\xa5\xe6\x1a\xfe\xfe\x8f3\by\xa6ǈ\x87\xfb\xf8\xdf\x00\x00\x00\xff\xff?7>\x9a\xb2R\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcYKs\xe3\xb8\x11\xbe\xebWt\xcd\x1e|\x19Q3\xd9KJ\x97\x94F\xceVM\xe2\x19\xbbF^琤j!\xa2)!\x02\x01\x06\x0fi\x95T\xfe{\xaa\xf1\x10)\x92\x92\xec\xad\xdd\xe5\xc5&\xd9\x04\xbe\xfe\xfa\rM\xa6\xd3\xe9\x845\xe2\x05\x8d\x15Ź5\x02\u007fv\xa8\xe8\xce\x16\xbb?\xdaB\xe8\xd9\xfe\xe3\x1a\x1d\xfb8\xd9\t\xc5\xe7\xb0\xf4\xd6\xe9\xfa\x1bZ\xedM\x89\xf7X\t%\x9c\xd0jR\xa3c\x9c96\x9f\x000\xa5\xb4c\xf4\xd8\xd2-@\xa9\x953ZJ4\xd3\r\xaab\xe7\u05f8\xf6Br4a\x87\xbc\xff\xfeC\xf1}\xf1a\x02P\x1a\f\x9f?\x8b\x1a\xadcu3\a奜\x00(V\xe3\x1c֬\xdc\xf9\xc6:m\xd8\x06\xa5.\xe3^\xc5\x1e%\x1a]\b=\xb1\r\x96\x01\t\xe7\x01\x1e\x93OF(\x87f\xa9\xa5\xaf#\xac)\xfce\xf5\xf8\xf5\x89\xb9\xed\x1c\n\xeb\x98\xf3\xb6h\xb6\xccb\x80\xccіF4.\x00\xfb\x14\xf6\x83U\xdc\x10\x1eҎ\x10\xbf\x02\xeb\xcb-0\v\x8b=\x13\x92\xad%\xce~T,\xff\x1fV\x8b\xb0\x9fN\xab\xbbc\x83s\xb0\xce\b\xb5\xb9\x00E2\xeb^\x98\x14\xfc\xc4\xc4\x10\xd7\xc3@\x06\x84\x05\xb7E\xa0\xaf\xc1\xd1\x03\xba\x8b|\x01\x11\x86\x90\xf9\x82\x03\xb3aI\x80}\\\x03y\a,\xad\r/g/\"j\xba\xefc\xce\xd6/\x06\x96문\xd8\xe0\x8de\xc8l\x05Ǌy\xe9\x86\xda\xde\xc7\x17]m\xc8\x1aY\x9f\xceN\xf7\x9d%\xe2nk\xad%2\x92\xd9\x18\xed\x9b9\xb4\xbe\x12?J\x9e\x1a\xbd<\xda;\x99\xfb\xa1\xbb\xbe\x14\xd6\xfd\xf5\xb2̃\xb0q\xd7Fz\xc3\xe4%O\r\"v\xab\x8d\xfb\xdan=\x85\xb5\x95\xf1\x8dP\x1b/\x99\xb9\xf0\xf9\x04\xa01h\xd1\xec\xf1G\xb5S\xfa\xa0~\x10(\xb9\x9dC\xc5dp0[jR:,ް2\x98\xcf\xfa\xb5Ia\x9b6\x8c\x8e6\x87\xff\xfeorr\x01\":\xbc\xd4\r\xaa\xc5\xd3\xe7\x97\xefW\xe5\x16k6O\x9e2\x12\x16=\n\xc8\x03Y\xc7ɶh\x10^\x02\xdb\xd1\x01m\xd2*\xad\b\xa0\xd7\xff\xc2\xd2e_l\x8cn\xd08\x91Q\xd2\xd5IR\xa7g=,w\x046\xca\x00\xa7\xb4\x841\x10RrA\x0e6(\x02\xba\x02\xb7\x15\x16\f\x06\x12\x95k\x8d{\x02T\x01S\tV\x01+\"\xdaX\xb2\x97\x97\x9cr\xd9\x1e\x8d\x03\x83\xa5\xde(\xf1\x9f\xd3\xca\x16\x9cN\xb1\xe70\xb9A\xbeB\xeeQL\x12\xcd\x1e\xdf\x03S\x1cjv\x04\x83\xb4\ax\xd5Y-\x88\xd8\x02\xbeP\xb0\nU\xe99l\x9dk\xec|6\xdb\b\x97\xd3r\xa9\xeb\xda+Ꮃ\x90\\\xc5\xda;m\xec\x8c\xe3\x1e\xe5͔̊\x99r+\x1c\x96\xce\x1b\x9c\xb1FL\x03p\x153eͿ;9\xc3]\ai//\xc5+\xc4\xc4E\xde)\x1a\xa2\xcd\xe3g\x11\u007fK/=\"V\xbe\xfdy\xf5\fy\xd3`\x82s\xce\x03\xdb\xedg\xb6%\x9e\x88\x12\xaaB\x13\rW\x19]\x87\x15Q\xf1F\v\xe5\xc2M)\x05\xaasҭ_\xd7\u0091\xa5\xff\xed\xd1:\xb2O\x01\xcbP\x9c`\x8d\xe0\x9b\x90\xd9\n\xf8\xac`\xc9j\x94Kf\xf17\xa7\x9d\x18\xb6S\xa2\xf46\xf1ݚz.\x18\xd9:=\xce\xe5n\xd4B\xa3Q\xbaj\xb0<\x8b\x13\x8eV\x18\xf2e\xc7\x1c\x86\bHA{F\xe9\xe5\xc4x9xC\x00\x97%Z\xfbEs<\u007fރ\xba8\x89\x9dak\xd0\xd4\u0086\xae\x04*m\xfa%\x8d\xa5\xbaҽr\xfe)zoP\xf9\xba\x0fa\nߐ\xf1G%\x8f\xa3/\xfef\x84\xebo0j.\xba\"\xac\xd5Q\x95Oh\x84\xe6W\xd5\xfd\xd4\x13>)\xbd\xd5\a\xa8\x82\xdb*'\x8f\x94W\xecQ\x95\xfd\xbc\x99\xaf\xc5\xd3\xe7\x9cCcp\xa4XJ\xdc\x14\xb0H1\xa9+\xf8\x00\\XjKlX\xb2O\x0fuY\xf4v\x0e\xce\xf8W+]jU\x89M_\xd5n\xef5\xee\x15W\x17\xedq\xb5\f{P\xa2!\x0fh\x8c\xde\v\x8efJ\x9e/*Q&\f\xdeĪS\x85\x82\xd8\xd7n4v\x82\x02\x069\xc5(\x93W\xed\xb5<\x89\x85\x8e\x96\t\x15\xfd\xb3\xfd<$\x0eS\xa7B\xa8\x1c*\x9ez\xa73\x1c:\xe4\x1f\x8b\x1c\x0e\xc2mcZ\x93\xc3h\x82+\x11E\xd7\x0e\x8fÇ=\xcc\xcf[$\xb9X\xf6\x10,\x96\x06]\xf0(\x94\xe4$\xe40\x05\xc0\x17oCRd\xb1\x11\x18Y\x15\xf2\xb7;<\xf6\x89\xbda\xc8Ԗ݂zG\xfdJ\x06j\xb0B\x83ʍ&d\x1a \x8cB\x87!'s]ZJ\xc7%6\xce\xce\xf4\x1e\xcd^\xe0av\xd0f'\xd4fJ\x14OS|\xccB\xab7\xfb.\xfc\x19U\xf2\xf9\xf1\xfeq\x0e\v\xceA\xbb-\x1a\xb2R\xe5ev\xa8N'\xf2>\xd4\xc5\xf7\xe0\x05\xff\xd3\xdd[\xf9\xd0M\x8c\x8c\x9b\x9c\xac\x82\u007f\x1f\xa9\x8d\np\x88\x9aU\xb4\x836@Ս\x8c['\xeb\xc5\xfc1f\xbd~\x17ܽ(\xd1P\xee\x1f\xe6\xc5\x1d\xf6S\xe2\xc5\x10J]\xfb\xd5\xf8\xc9\r\xbcP\\\x94\xd4$\x9d{~\x9e]\xf8h\x9f\xff\xea\x14\u007fY\xd5\b;U\xaf\xabH\x1f\xbb\x92\xed\xb8\x17\x93M\xaaJ\x16\x1d57\x16\x14R\xd5b\xa6\xcfU\b\xf4R+Eq\xe64\xb0Sں\xb3\xfd\x1c\xfd\x86\xa8_\xfbr\x87\x03\xa2\a*|\nb\x99\xd3\xf8\x11\xa1\xf0\x16C\n\xbd\x0e\x00nypɖhn\xa3X.H\xecT\xd8\x18,\x17\xb0\xf6\x8aK\xccX\x0e[Tԥ\x8b\xeaH\xad\xe2\xf3\xc3j4.\x13\x8f\xa1\aH}vfs\f{\xcc\xc2sX\x1f\a\xb5\xfb\xa6j\x8d\xc1J\xfc|S\xb5\xa7 \x96\tn\x98ۂPVpJ\xa2C\xbaG\x9a\xa9|\x9d\xea\xf4c\xca\no4\xc6\xe5\xf8\x8d0^\x1b\u0099ϫ\x91\xf1\x94\x84Nz\xe7\xfb\x94\xb7σv<6G\xb4h\xc7\xcf\x1fb\xdfS\x0eJ\xdb\x19\x8c\x97\xa1\xfc\x95\xee)\x9fo\f\x03\x94\xaa\xb76\x06m\xa3\x15'\xff{]\xef\xd4\xc2\xfd5:\xa81\x03Nϳ\xd5ٛ\xcc\xf9ͱ \x0e\xf8o\x1b\f\xe2\x91V\xb7\xfd\xd6\xebp\xd6Й\r~\xe31\xe0]g\x0e\xa0\xc9R\x81W\xa1[\nU\xb8\x80\u007f(\xb8\xa79\x91j\b\x9f\x13F\xea\x10\x86\xf5\\\xe9\x03}\xdcY-,\x00Z\xc5:J3\x10M\xe2q\xac\f\xaf\x0eBJ\xaa\xa4\x06k\xbd\x1f\xa9\xa4\xd4\xe6\x19\x94G`\x96\x88\xd8\xff\xa1\xf8P\xbc\xfb\x9dg\fɬ\xa3\xa1\x01\xf97܋\xfe\xa9Ȑ͇\x81|\x0eޓk\xd3\xcdOyܜ\x99$\xf6\xd3@\xfdJH\xea\xc5F\"\xbd\xad\xe2\xc3\xe3\xc7O\xab\x87;\x1bZf\x1a\xec\a\x8b\x1e\xc8|6\x00\xa4\x9eY\xa7y\xde[\x87f\xc4\xd8'[\t\vJ\x83\xd4js\x16\n\xf1J\xd3=uI\xd1u\xb4\x01\x8e4\x98S\x94\x97[\xa66؞\xd8$\xec\x1d\x94\xe4\x18C\xa4\xe7\xde\xd1z\x83P\xe3\xae\xf0\n\x1b>\x8bak<8\xe4mE\xc7\x0fxO\xa8\x93-/\f\x137\xb8\xeeI\xe7\x1aJDN]>\x80n\xaf_6,\x0eϵoj\xff\x8b\x8f\xb8\x87\xea3\xdb\x1ev\xff\xfe\xba\x87\x9f\x17\xae\x97W\x92\xc8\x1a\x96\xde\xd0\b\xd4\xe6\xdd\x10Lc\xb9\xf7u\xc7\x1c\x8b\xb3\xdf$\xbao\xfa\xbfW\xdc\xd4e\xa4\xde\xf4\x1e\xb5\xbf\xea|l\xef\xd2\x0f/\xf1\x94>\xbc\xa0\xb1\x92\x8aK\x87ȔQғ\xb6\x88Q\xf5h\x1c\xf2\xaf\xfd\xe3\xfaw1\xec\xf2\x99{\xb8-\xa9\x9eǟ\xa0\xe0\xef\xff\x9c\xc4U\x91\xbfd\x1c\xf4\xf0\xff\x01\x00\x00\xff\xff\xed\x93\x00\x8d\x01\x1b\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4\x96\xcdn\xe46\f\x80\xef~\nb{\xd8Kǳ\xc1^\n\xdf\xda\xec\x16\b\xda\x06A\xb2ͥ\xe8A#q\xc6ldI%\xa9Iӧ/$ۙ\x9f8\xc8\xf6\xb0\xbe\x89\xa2\xf8\xf3\x91\x94լV\xab\xc6$\xbaG\x16\x8a\xa1\x03\x93\b\xffQ\fe%\xed\xc3\x0f\xd2R\\\xef/6\xa8\xe6\xa2y\xa0\xe0:\xb8̢q\xb8E\x89\x99-~\xc2-\x05R\x8a\xa1\x19P\x8d3j\xba\x06\xc0\x84\x10\xd5\x14\xb1\x94%\x80\x8dA9z\x8f\xbc\xdaah\x1f\xf2\x067\x99\xbcC\xae\x1ef\xff\xfb\x0f\xed\xc7\xf6C\x03`\x19\xeb\xf1/4\xa0\xa8\x19R\a!{\xdf\x00\x043`\a\x0e=*n\x8c}ȉ\xf1\uf322\xd2\xee\xd1#ǖb#\tmq\xbc\xe3\x98S\a\x87\x8d\xf1\xfc\x14ԘЧj\xea\xa7j\xeav4Uw=\x89\xfe\xf2\x9aƯ4i%\x9f\xd9\xf8倪\x82P\xd8eoxQ\xa5\x01H\x8c\x82\xbc\xc7\xdf\xc3C\x88\x8f\xe1gB賈\xad\xf1\x82\r\x80ؘ\xb0\x83\xeb\x12u2\x16]\x03\xb07\x9e\\\xc53\xe6\x11\x13\x86\x1fo\xae\xee?\xde\xd9\x1e\a3\n\x01\x1c\x8aeJUo)\a \x01\x03S$\xa0q\n\x10b@\x88\fCd\x841Zi'\x93\x89cBV\x9a\t\x96\xef\xa8\u007f\x9eeg\xceߗ\xe8F\x1dp\xa5cP@{\x84\xa9\xee\xe8@j\xe4\x10\xb7\xa0=\t0V,a\xec\xa1#\xb3PTL\x80\xb8\xf9\v\xad\xb6pWб\x80\xf41{W\xdal\x8f\xac\xc0h\xe3.пϖ\xa5\xe4W\\z\xa3s\x81珂\"\a\xe3\v\u05cc߃\t\x0e\x06\xf3\x04\x8c\xc5\a\xe4pd\xad\xaaH\v\xbf\x158\x14\xb6\xb1\x83^5I\xb7^\xefH牱q\x18r }Z\u05fe\xa7M\xd6Ȳv\xb8G\xbf\x16ڭ\f۞\x14\xadfƵI\xb4\xaa\x81\x87:0\xed\xe0\xbe\xe3i\xbc\xe4\xfdQ\xa4\xfaT:A\x94)\xec\x9eŵ\x87_\xe5^\xfaw,\xf3xl\x8c\xff\x80\xb7\x88\n\x95\xdb\xcfw_`vZKpʼ\xd2>\x1c\x93\x03\xf8\x02\x8a\xc2\x16y,ܖ\xe3P-bp)Rк\xb0\x9e0\x9cB\x97\xbc\x19Hen\xbfR\x9f\x16.\xeb\xbd\x01\x1b\x84\x9c\x9cQt-\\\x05\xb84\x03\xfaK#\xf8ͱ\x17²*H\xdf\x06\u007f|ݝ*\x8e\xb4\x9e\xc5\xf3]\xb4X\xa1\x85\xb1\xbcKhK\xcd\n\xb8r\x96\xb6d\xeb\x18\xc062<\xf6d\xfby,O\x88>\x0fp{$^\x1a\xd8\xf2\x8d\x06ʭr*\u007f%Y\xa8u\"Ɠ^[\x1d\x99y\x93\x82\x1a\xcd\xf2\xbf8\xd4\x133\t\x9b\x991\xe8d\xa7\xde\x02K\x87\xbe&wd\x8e,\xe7y\x9f\x84\xf3\xb9\xaaԿ\x96\xa1 `\xc2\xd3t\f\xb47\n\x8fȥ\xc5m\xcc\xe5\xee@\a.\x9f\xf1\x9aP\xf48\x16\xa5\x94/q\xb4(Ҟi\x91\xe2\xf0\"\x9aW\xebP\xbe\xf2'4\x1b\x8f\x1d(g\\\xac\x9fa6O';\xa97\xf2\xa2\xd8'I\xdf\x14\x8d%\xde8\xde\xcb\xf8\x16\xf0\n7\xe4\xe1\xdc\xcb\n\xae\xf1\xf1\x85\xec*\xdcp\xdc1\x8a\xbcغ\x19I՟\xddW0Yh\xb83\xd1\xe1\x81qqXU\xe8\xab\xe9AQ7\x00\xea\xaf\xd8\x1d\x81\x15\x8dlv3\xeaC\x17\x1bk1)\xba\xeb\xf3\xe7Ļw'\uf0ba\xb418\x1a_C\xf0ǟ\xcdh\x15\xdd\xfd\x1cG\x11\xfe\x17\x00\x00\xff\xff\"\xf7\xf4 \x8c\t\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WOoܶ\x13\xbd\xebS\f\xf2;\xe4W \xd2&ȥЭuR h\x1a\x04\xb6\xe3K\xd1\x03E\xceJ\xac)\x92\xe5\x90\xeb\xb8E\xbf{1\xa4\xb4\u007f\xb4Z;=to\x1a\x0e\x87\x8f\x8f\xef\r\xb9U]ו\xf0\xfa\x0e\x03ig[\x10^\xe3\u05c8\x96\xbf\xa8\xb9\xff\x9e\x1a\xed6\xbb7\x1dF\xf1\xa6\xba\xd7V\xb5p\x95(\xba\xf1\x1aɥ \xf1\x1dn\xb5\xd5Q;[\x8d\x18\x85\x12Q\xb4\x15\x80\xb0\xd6E\xc1a\xe2O\x00\xe9l\f\xce\x18\fu\x8f\xb6\xb9O\x1dvI\x1b\x85!\xaf0\xaf\xbf{ݼm^W\x002`\x9e~\xabG\xa4(F߂M\xc6T\x00V\x8c\u0602r\x0f\xd68\xa1\x02\xfe\x91\x90\"5;4\x18\\\xa3]E\x1e%/\xda\a\x97|\v\x87\x812w\x02T6\xf3n*s]\xca\xe4\x11\xa3)\xfe\xbc6\xfaQO\x19ޤ \xcc9\x88<H\xda\xf6Ɉp6\\\x01\xf8\x80\x84a\x87_\xec\xbdu\x0f\xf6'\x8dFQ\v[a\b+\x00\x92\xcec\v\x9f\x18\xa5\x17\x12\x15\xc7R\x17&\xae'\xe4\x14EL\xd4\xc2_\u007fW\x00;a\xb4\xcaL\x95A\xe7\xd1\xfe\xf0\xf9\xc3\xdd\xdb\x1b9\xe0(J\x10@!ɠ}\xce[n\v4\x81\x80\t$D\xb7\xc7\r\u0082\bQo\x85\x8c\xb0\rn\x84N\xc8\xfb䧚\x00\xae\xfb\x1de\x04\x8a.\x88\x1e_\x01%9\x80\xe0j%\x11\x8c\xeba\xab\r6\xd3\x14\x1f\x9c\xc7\x10\xf5\xbc\x15\xfe\x1d\xc9o\x1f[\x00~\xc9;*9\xa0XpH\x10\a\x84I6\xa8\x80\xf2n\xc1m!\x0e\x9a `f\xda\x16\t\x1e\x95\x05N\x11vB\xde\xc0\r\x9fF \xa0\xc1%\xa3X\xa5;\f\x11\x02J\xd7[\xfd\xe7\xbe21/\xbc\xa4\x11q\xd6\xc9\xfc\xd36b\xb0\xc2\xf0Y$|\x05\xc2*\x18\xc5#\x04\xcc\xec${T-\xa7P\x03\xbf\xb8\x80\xa0\xedֵ0\xc4\xe8\xa9\xddlz\x1dg\xc3I7\x8e\xc9\xea\xf8\xb8ɶ\xd1]\x8a.\xd0F\xe1\x0e͆t_\x8b \a\x1dQ\xc6\x14p#\xbc\xae3p\x9b\xfd\u058c\xea\u007f{ż<B\x1a\x1fY\\\x14\x83\xb6\xfd>\x9cmp\x91w\xb6A\x91G\x99V\xf0\x1f\xe8\xe5\x10\xb3r\xfd\xfe\xe6\x16\xe6E\xf3\x11\x9cr^t\xb2\x9fF\a\xe2\x99(m\xb7\x18\xca\xc1e\x95qE\xb4\xca;mc\xfe\x90F\xa3=%\x9dR7\xeaH\xb3l\xf9|\x1a\xb8\xcam\a:\x84䕈\xa8\x1a\xf8`\xe1J\x8ch\xae\x04\xe1\u007fN;3L5S\xfa<\xf1\xc7\xdd\xf24\xb1\xb0\xb5\x0f\xcf\xedl\xf5\x84\x16V\xbe\xf1(\xf9\xbc\x984\x9e\xa7\xb7Zf\v\xc0\xd6\x05\x10\agO\xb45Gu\u05fc\x99A\x89\xd0c<\x8d-P\xdc\xe6\x14^\xf8a\x10\xa7-\xe4\xff\xd8\xf4\r\xf7\x01\x9a \x94\xce\xf0]\xb3\xa8wi\xf55\x8d\xaeb\x98\xa5\xca[g\x1e\xd9\xe8\xdcz\x8e\xd1,\x17\xe5\x1f\xda4\xae\x15\xaf\xe1ǌ\xf4\xa3\xeb\x9f\x18\xbdr6\xb2\xa0\x9fH\xb9s&\x8dxc\x85\xa7\xc1=\x999ߩ\xfb{f\x99v\x8d\xdcj\xf1\x12\xa4i\xf8\x1a)\x99ՅV\x858\xff\xf2\xbd\xfa\x1c\xcb|5\xcd,\xf3\x84\xd2q\x11\xf8>\x0f\x16#ҡ\r<\xe88\xc0à\xe5\xb0R\x15\xf2\xb4|@\xdc_\x88\x9c\xd4ٱ\xff\x0e6\xebX\a<\x93G\x9dEs\x16d\xc8\xd5Z\xf1\x85\xe7\xd6\vד\x17\x9eul\xb9\xa0\xbfճ9{&U\xa6\x10\xd0ƩF\xbe\xad\x96\x13\xbeŴ\xb3\xe2\xbf\\\u007f|ҹ\xef\x0ey\xf9\x89&\xb4-8|\xc0\x9at\xcfw+\x8f\xb1w\xb3\xb3\x96\x04\x94\xdf\xf1\x1d\xff\xec\xa9\xe1W\xaf\xc3ѓ\xe5\x02\xb4\xf7\xfb\xb4\xd2XЖ+b\xf9z\xc9\xe5\x90\xf2\xb5+\x85=\xc3\xd6!(4\x18QA\xf7X:\xe3#E\x1c\x97x\xb7.\x8c\"\xb6\xc0\x17G\x1d\xf5\x99P\xf8\xf9):\x83-Đ\xd6U\xb4\xb2Y?\b:\xb3\xd5\xc9>?s\xc6\xda\xf1\xef\xcd\xf5\xc4\xf9Å\x0eV\xc3'|8\x8b}\x0eN\"\x11.\x8dq\x01\xfd\x8a\xb8\x17\xa1û\xfd\xcd\xe1+K\xb1\x9e\xde\xe9y\x00 \xbfz\xd5\x11uӛq\x8a\x1c\x1c#\xa4D\x1fQ}Z\xbe\xd4_\xbc8yz\xe7O\xe9\xac\xd2\xe5O\x06\xfc\xfa[U\xaa\xa2\xba\x9bqp\xf0\x9f\x00\x00\x00\xff\xff]]l+\xe3\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y\xdfs۸\xf1\u007f\xd7_\xb1\x93{\xf0\xf7fB\xea\x92\xfbN\xa7\xa3\xb7\x8b\xddt\xdc\xde9\x9eȗ\x97L\x1eV\xc4JD\r\x02(\x16\x94\xa2v\xfa\xbfw\x16 %Q\xa2e\xf9\xdaK\xf9b\x13\\,\xf6\xf7~\x16\x9a\x14E1A\xaf?Q`\xed\xec\f\xd0k\xfa\x1a\xc9\xca\x1b\x97\x8f\u007f\xe4R\xbb\xe9\xfa͂\"\xbe\x99<j\xabfp\xddrt\xcdGb׆\x8anh\xa9\xad\x8e\xda\xd9IC\x11\x15F\x9cM\x00\xd0Z\x17Q\x96Y^\x01*gcp\xc6P(Vd\xcb\xc7vA\x8bV\x1bE!\x9dП\xbf\xfe\xa1\xfc\xb1\xfca\x02P\x05J\xdb\x1ftC\x1c\xb1\xf13\xb0\xad1\x13\x00\x8b\r\xcd\xc0;\xb5v\xa6mh\x81\xd5c\xeb\xb9\\\x93\xa1\xe0J\xed&쩒CW\xc1\xb5~\x06\xfb\x0fyo'PV\xe6ީO\x89ͻ\xc4&}1\x9a\xe3_Ǿ\xfe\xac9&\noڀ\xe6T\x88\xf4\x91\xb5]\xb5\x06\xc3\xc9\xe7\t\x80\x0f\xc4\x14\xd6\xf4\xab}\xb4nc\xdfk2\x8ag\xb0D\xc34\x01\xe0\xcay\x9a\xc1\x9dH\xe9\xb1\"5\x01X\xa3\xd1*\x99\"\xcb\xed<ٟ\xeeo?\xfd8\xafjj0/\ng\xe7)Dݫ'ρcwk\x00\x8a\xb8\n\xda'\x8ep%\xac2\r(q%1Ě\xa0s\b)\xe0t\f\xb8%\xc4Z3\x04J:\xd8\xec\xdc\x03\xb6 $h\xc1-\xfeFU,a.z\x06\x06\xae]k\x94\xf8\u007fM!B\xa0ʭ\xac\xfeǎ3Ct\xe9H\x83\x91:\xfb\xf6\x8f\xb6\x91\x82E#Fh\xe95\xa0U\xd0\xe0\x16\x02\xc9\x19\xd0\xda\x03n\x89\x84K\xf8\xc5\x05\x02m\x97n\x06u\x8c\x9eg\xd3\xe9J\xc7>\x94+\xd74\xad\xd5q;M\x01\xa9\x17mt\x81\xa7\x8a\xd6d\xa6\xacW\x05\x86\xaa֑\xaa\xd8\x06\x9a\xa2\xd7E\x12ܦH.\x1b\xf5]\xe8➯\x0e$\x8d[q\x1bǠ\xedj\xb7\x9c\x02\xecI\xbbK\x80\x81f\xc0n[\x96\u007fo^Y\x12\xab|\xfc\xd3\xfc\x01\xfaC\x93\v\x866O\xd6\xdeo\xe3\xbd\xe1\xc5P\xda.)d\xc7-\x83k\x12G\xb2\xca;mcz\xa9\x8c&;4:\xb7\x8bFG\xf1\xf4\xdf[\xe2(\xfe)\xe1:%4,\bZ\xaf0\x92*\xe1\xd6\xc256d\xae\x91\xe9w7\xbbX\x98\v1\xe9\xf3\x86?\xacCC\xc2l\xad\xddr_(F=t\x94\xfbsO\x95\xf8K\x8c&\xfb\xf4RW)\x05`\xe9\x02\xe01yy\xc0v,5\xe5\xc9Ua\x1e]\xc0\x15\xfd쪃$\u007fB\xa6wc;z\xa9\xa4\xb6\xe54\xa5\x8e5p\xa6<b\t`\xfa\xad\x9b\x9a\x02\xa5\x1d\x818\xeaJ\x02ɱ\x8e.l\x85\xad\xec'U\x1e\xed\x1f5\xba<\xd6):+\xff\x9dS4&\xael\x84Xc\x8e\xc9{\x972#\xb4\xd6J\x168{\xb1\x00ީ\xb3\xe7w\x9c\x11\x02-)\x90\x95\x8c\xca\xc5ǻT\xa2\"j\xdbg^.\xdd\x10݉\xf9\x16\xd9\xc0\xa4`\xe8\xe8sΆ'\xeb\xf1\xa8\xa4?\xdd\xdf\xf65\xb87R's<>\xf1\xacE\xe4YJ\x97\xb9\xc7X?{\xea\xd5\xed2\x1f\x93*Rt\x80\xe05U4(\xed\xa0-GB\x95\x17GX\x02H\xe2\x06\xea\xe8_\xe7\xfaӕ\xb9};\x10[\x03\xe6\xfe\x06\u007f\x99\u007f\xb8\x9b\xfe\xd9eYGybU\x11\v\x1b\x8cԐ\x8d\xaf\x81۪\x06dQA\aRs\xf9R6h\xf5\x928\x96\xdd\t\x14\xf8\xf3\xdb/c6\x03x\xef\x02\xd0Wl\xbc\xa1נ\xb3\x95w\x05\xb5\x0f\x10\xcd\xd9\x10;~\xb0ѱ\xd6㊣\x04R\xa7\xf0&)\x1a\xf1\x91\xc0u\x8a\xb6\x04F?\xd2\f^I\t9\x10\xf1\x9f\x92\r\xffz5\xca\xf3\xffr\x92\xbe\x12\x92WY\xb0]\xcf<L\xa2\xbd\x809\x93\x82^\xad(и5S#\x90\x02\xfb=\xb8 \xba[w\xc0 \xb1\x15\x9f\xe5BG\xeaD\xe0\xcfo\xbf<!\xed\xd0N\xa0\xad\xa2\xaf\xf0\x16\xb4\xcdV\xf1N}_\xc2C\x8a\x88\xad\x8d\xf8UΩj\xc7d\xc1Y\xb3\x1d\x97\xd6A\x8dk\x02v\r\xc1\x86\x8c)2VQ\xb0\xc1\xad\xe8\u07fbK\"\f\xc1c\x88C42\xca\xf5\xe1\xc3͇Y\x96JBh\x95*\xa9t\xb9\xa5\x16\xcc!`#wN\x89\xc9d\x8e6\aGtP\xd5hG\n+$В\xac\xbbl\xa5\x97\x95W/\xcd\xd6c\xd8\xd0?#\xf0\xe1\xb80\xfc\x8f\x9a\xf0Ej%\xd4\xfe\xacZw\a\xf1|V-\x99\x1f\x82\xa5HI3\xe5*\x16\xa5*\xf2\x91\xa7nMa\xadi3ݸ\xf0\xa8\xed\xaa\x90@,r$\xf04\x8d\x00\xd3\xefҟߤEB早\x92H\xbf\x85>r\x0eO_\xacN\x8f+/\xedJW\xf3\x0e\xf9\x1c\uf514\xd8Ժ\xaa\xfb!a_=Gs\xa4A\x95K.\xda\xed\xef\x1e\xb6b\xc86\x88<ۢ\x1bC\v\xb4J\xfeg\xcdQ\xd6_l\xb9V_\x90\xa4\xbf\xde\xde|\x9b`n\xf5\x8b3r\x14\x10\xe7\x98\xf0\xeeV\x89\xf9\x96\x9a\xc2Y8\xf5q@\xda\x03\xbb\x11$\xb9\xa3\xb9\x18\xc9E\\\x9d\x00(T*]4\xa0\xb9?\x03\xb2\xce\xe8<\x10\xfe\x01W\f\x18\b\x10\x1a\xf4\xe2\xa7G\xda\x16\xb9I{\xd4\xd2c\xa5\x8dvxeA\x80\xde\x1b=\xd2N\xbbV\xdc\xc1\xc5\x0ey\xcbX\x8b+\x1e\xd7w\xc4\xeay\xf7Yk\xe7\xf1b\f>wGg\\\xb2\x83\xd0\xd1\xed\x81\xeai\xfc\x9e\x00\xd7'\xec&S\xa0\xa0\xabCъ\xf1\xd1e@!\x90~\xb0\xe0\x9d\x1a\xbc\x0f\xe3l\xf0)\xeb\xf3\xec\xf4\x161\xb6|\xf1\xfc\x96\xa8{\xeb\xe5z\x10;\x1e\t+\xfc\x96\t\xaer\x82\x1d\x87\xd7T\xe7\\x}J\x9f.D\x82\xcabE\xddH<v1\xb4A\xeeO8\x1d\xc2\xe0\x80Yޗ\xea\xae\xf0\"\x95\xa0\x9d\xa0\xce%jC\n\xfa;\xb2\xe3='<\x0fy,h)\xa5\xaa\xf5ơꇢN\xb4\xfe\x92\xe7A\xa6\xe1t\xdfp\xc5Orl\x99T\x9a\x92G\xd4?n\x0fK\x17\x1a\x8c3P\x18\xa9\x18ah[cpah\x061\xb4\xc7\x1f\x9fL\xfd\x86\x98qu>\xbd~\xc94y>\xec6\x00.\\\x1bw\x03\xe2 ů\xb8\x8b\x9e˧ӑ\x11l\x18\xb2(\x80\x99;\xf8hL\xdaq\x98\xd6\xfbK\xd4$ς\xc4-\xffi\x86\x03\xf8\x1a\xf9\xbcq\xee\x85b,yv5\xe8L\xf6@\x9a\b\xdb\xe6\xf8\x84\x02\xeehs\xb2vk\xef\x83[\x05\xe2\xe3\xd0(\xfa\xf89Q\xb6\x80\xf7)\xce/ַ;\xe0\xbc\xca\x1d\x11\xd4\xce\xf4\xe9\xe9\"\x1a\xb0m\xb3\xa0 z/\xb6\x91xX\x84Og\xfe4E\xec\x8dv\xb0\xbb\xbfB\xc8|\xba\xa1\xa8B\x9bn\xd9$g\xa2\x03\xa5\xd9\x1b<\x9d\x8az\x15\x12\x92\x90\x94\x91\x94\xdeGk\x9f\xa6\x9eB\xfa\xf4\x92[\x8a$͍\xb3\xa3\x18\xb7\xcfOm\xe3\x1f\xfe\xffIġm\xa4ՠ\xa8w_ŀ\xef\x84\xff\u007f\x9b\xf7\x93\x8d\x95-z\xae]\xbc\xbd9\xeb\xed\xf9\x8e\xac\x8f\xf2=hI\xb5+\xdd\xfbuD\xbdˇ--?9\f.N=\x8e\x18\xe2e\xcdc> }\xa6o$\xbe\xa4J\x98\x93ǀ\xf140\xd3}\xf0\xf5\xf1\xaf,\xaf\x81u\xba\x16\x13\xec\x93\xc1P\x1euYډ@;\x17r\xac\x9er\x1c4\x82A\xe1\x1f\x8a\xfe-j\xfeH<\x1c-\xed\u007frz\xb3\u007fKqYt?1\xa5\x0f\x9dZ\xea\xe0\xf0\xeeV\xb5[\xd9\xc3\x10\xac\x04\xb2\x93\xba;\xfe\x91\xe9U\xbe*\xe9\u007f5J\xaf\x95\xb3\x19\xcd\xf2\f>\u007f\x99@w\xd7\xfa\xa9\x97C\x16\xff\x1d\x00\x00\xff\xff\x81\x16-\x05\x9e\x1b\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y_s۸\x11\u007fק\xd8\xf1=\xb87\x13R\x97\\\xa7\xd3\xd1\u06dd\xddt\xdc\xde9\x9eȗ\x97L\x1e b)\xa2&\x01\x16\xbb\x90\xacv\xfa\xdd;\v\x90\x92(Q\xb2\x9c\xe9\xa5zI\b,\x16\xbf\xfd\xed\x1f,\xe0I\x96e\x13՚O\xe8\xc98;\x03\xd5\x1a|f\xb4\xf2E\xf9ӟ)7n\xbaz\xbb@Vo'O\xc6\xea\x19\xdc\x04b\xd7|Dr\xc1\x17x\x8b\xa5\xb1\x86\x8d\xb3\x93\x06Yi\xc5j6\x01P\xd6:V2L\xf2\tP8\xcb\xde\xd55\xfal\x896\u007f\n\v\\\x04Sk\xf4q\x87~\xff\xd5\x0f\xf9\x8f\xf9\x0f\x13\x80\xc2c\\\xfeh\x1a$VM;\x03\x1b\xeaz\x02`U\x833h\x9d^\xb9:4\xe8\x91\xd8y\xa4|\x855z\x97\x1b7\xa1\x16\v\xd9u\xe9]hg\xb0\x9bH\x8b;Dɚ\a\xa7?E=\x1f\x93\x9e8U\x1b⿏N\xffb\x88\xa3H[\a\xaf\xea\x11\x1cq\x96\x8c]\x86Z\xf9\xe3\xf9\t@\xeb\x91Я\xf07\xfbd\xddھ7Xk\x9aA\xa9j\x92i*\\\x8b3\xb8\x17\xa4\xad*PO\x00V\xaa6:\U00091c3b\x16\xedO\x0fw\x9f~\x9c\x17\x156*\r\x8afעgӛ(\xbf=\xefn\xc7\x004R\xe1M\x1b5µ\xa8J2\xa0şH\xc0\x15B\xe7\x15\xd4@q\x1bp%pe\b<F\x1bl\xf2\xf0\x9eZ\x10\x11e\xc1-\xfe\x81\x05\xe70\x17;=\x01U.\xd4Z\x82`\x85\x9e\xc1c\xe1\x96\xd6\xfck\xab\x99\x80]ܲV\x8c\x1d\xc3\xfd\xcfXFoU-$\x04|\x03\xcajh\xd4\x06<\xca\x1e\x10잶(B9\xfc\xea<\x82\xb1\xa5\x9bA\xc5\xdc\xd2l:]\x1a\xee\xe3\xb9pM\x13\xac\xe1\xcd4F\xa5Y\x04v\x9e\xa6\x1aWXO\xc9,3\xe5\x8b\xca0\x16\x1c<NUk\xb2\b\xdc\xc6p\xce\x1b\xfd\x9d\uf09f\xae\xf7\x90\xf2F\xdcF\xec\x8d]n\x87c\x90\x9d\xe4]b\f\f\x81\xea\x96%\xfc;zeHX\xf9\xf8\x97\xf9#\xf4\x9bF\x17\f9\x8fl\xef\x96юx!\xca\xd8\x12}r\\\xe9]\x135\xa2խ3\x96\xe3GQ\x1b\xb4C\xd2),\x1a\xc3\xe2\xe9\u007f\x06$\x16\xff\xe4p\x13\xb3\x1a\x16\b\xa1ՊQ\xe7pg\xe1F5X\xdf(\xc2ߝva\x982\xa1\xf4e\xe2\xf7\x8b\xd1P0\xb1\xb5\x1d\xee\x8bŨ\x87\x0e\xd3\u007f\xdeb!\x0e\x13\xd6d\xa1)M\x11s\x00J\xe7A\x1d\xc9\xe7{\x8aǒS~\vU<\x85v\xceΫ%\xfe⊽4?\x81\xea\xe7\xb1\x15=,\xa9p)Q\xb1S\r\x94$\x0fT\x02\xd4\xfd\xd2u\x85\x1e\xe3\n\xa9R\xa6\x90Prd\xd8\xf9\x8d\xa8\x8d\xa6\xe8\xfc`\xfd(\xed\xd1P\xa7\xcf\xc2\u007fp]\xd0{,ѣ\x95\x90N\xd9ߺX#X\x19ۇ~*\x9e\xc0\xee\b\xfd\"\xa1\x1d\x83v\x8aj8Y\x0fG\x81\xfe\xf4p\xd7\xd7\xc0\x9e\xd1\x0e2\x1f\xeex\x96\x10\xf9\x95R\xe5\x1f\x14W/\xeez}W\xa6mbE`\a\nZ\x83\x05\x0eJ+\x18K\x8cJ\xa7\xc1\x11\x95\x00\x928\x1e;\xf97)\xff\xbb2\xb3+\xc7B5\xa8t\xbe\xc0\xdf\xe6\x1f\xee\xa7\u007fu\t\xeb\xa8NU\x14H\xa2F16h\xf9\rP(*P$&\x18\x8fz.3y\xa3\xac)\x918\xefv@O\x9f\xdf}\x19\xe3\f\xe0\xbd\xf3\x80Ϫik|\x03&\xb1\xbc-h}|\x18JDl\xf5\xc1\xdape\xc6\rW\x12G\x9d\xc1\xebh(\xab'\x04\xd7\x19\x1a\x10j\xf3\x843\xb8\x92\fރ\xf8oI\x9d\xff\\\x8d\xea\xfcCJ\x91+\x11\xb9J\xc0\xb6g\xd6~\xc6\xed\x00r\xa5\x18؛\xe5\x12=\x8e\xb3\x19\v\xb1\x14\xb8\xef\xc1y\xb1ݺ=\x05Q\xad\xf8,\xd5\x19\xd4G\x80?\xbf\xfbr\x02\xed\x90'0V\xe33\xbc\x03c\x13+\xad\xd3\xdf\xe7\xf0\x18#bcY=\xcb>E\xe5\b-8[o\xc6\xd1:\xa8\xd4\n\x81\\\x83\xb0ƺ\xceR\xaf\xa0a\xad6b\u007f\xef.\x890\x05\xad\xf2<\xec\x06F\xb5>~\xb8\xfd0K\xa8$\x84\x96\xb1\x8e\xc9)S\x1a9\xf3\xe5\xb0O'\x97\xc4d\xa4#\xa4\xe0`\aE\xa5\xecHY\x83\xd84Dv\xcb gI~\xfd\xdal=<\xb6\xfb\xdf\xc8\xf1}X\x18\xfeO\x87\xe0Ef\xc5\xd6\xf9E\xb3\xee\xf7\xe2\xf9\xacY\xd2\xc4{\x8b\x8c\xd12\xed\n\x12\xa3\nl\x99\xa6n\x85~ep=];\xffd\xec2\x93@\xccR$\xd04\xb6\xe1\xd3\xef\xe2?_eE\xec\x8c/3%\x8a~\v{d\x1f\x9a\xbeڜ\xbe\xaf\xbb\xf4T\xba\x9ew\x8d\xc7\xe1JI\x89ue\x8a\xaao\xd2w\xd5s4G\x1a\xa5S\xc9Uv\U000fb1ed\x10\x19\xbc\xe0\xd9d\xdd]0SV\xcb\xff\xc9\x10\xcb\xf8\xab\x99\v\xe6\x82$\xfd\xed\xee\xf6\xdb\x04s0\xaf\xce\xc8ц4\xc5D\xeb\xee\xb4\xd0W\x1a\xf4g\xbb\xa9\x8f\x03Ѿ\v\x1c\xe9\xe3\xb62\x177rdUK\x95\xe3\xbb۳\b\xe6[\xb1~\xf7\x1d\xe5]\xfb\xd6k\x92\x10=ӷ\x9dD\x92ԜE\x91\xfa\xee\xb1.\xb8Ð:\x868\"\x1d\xe8W!\x91됴9\xfbH\xb2\xf1\x0e~ \xd1:=\xf8\x1e\xfaw0\xb5#}0\x9c\x8cx\xf12Ê\x03]~\x9d\x89\xe2=g)?\xb9S\x12\xcf\uebfa\xd0\x14N\x9a\xb9\xe1\xe3\xcd9\xcf\xdd\x1c\xcb\xc7\x17\x02\xaf\x13.6\r\xc6\xdbBD\x00kE\xfd\x16\xc7~\x83=mia\xac\x84\xa2\ful\xb6\xa4\x0f,\x95\xa9Q\xc3\xf6\xe9\b\x1e\xe5>\x17\xaf\xcc\xd7ǵ\xb2W\x13\bu\xbc\xe7\x8d\x00>\\U:\xdf(\x9e\x81\\\x933Qp0oC]\xabE\x8d3`\x1f\x0e'O\xa6A\x83Djy>\x0f~M2\xe9\x86\xd5-\x00\xb5p\x81\xb7W\xac.!:\xf3\xaf\xa9\xf3\xf8\xe5\x17\xbcJ\xd1y\x10\x0f\"1\x16Wۤ<\x17X\x10o/\xa19\xdc\"\x83{\\\x1f\x8d\xdd\xd9\a\xef\x96\x1e\xe9\xd0\aY﨣\xf6;\x83\xf71\x02.6\xb8\xdb\xe0\xbc͝\x10T\xae\xee#ױ\xaa\xc1\x86f\x81^\f_l\x18\xa9g\xa0O\xf4\xe3\x1bj\xecyw\xbc\xed\xd6\xf7\xd5*)\xea:\xf8B\xd9\xf8$#\xd1\xc9\x0e\xb4\xa1\xb6V\xc7-|oC<\xf6$8%Cvq\xd1g\x97\xa4t\x9c{͝:¹uv\xb4#\xebS\xc1X\xfe\xd3\x1fO\x9e\x8f\xc62.\a\xa5\xb0\x9b\x15\n\u007f\x16\xfd\xffk\xdd'\x0f_b\xe5\xf9\xb2\xd25\x1f\x88\xbeT\xb5\xa2ⱚ\xb5_~\x8e\xcb\xcdp\x93oQiF\xa89\x18\xda=ؿ\xdd}E\x17e\xdd\x03}\x9c\x80d\x96\xdeۼ{\x8c\xeaFv\a\x96*\xa4\xd7B}\u007f\xf8B\u007fu5xp\x8f\x9f\x85\xb3ڤ\xbf.\xc0\xe7/\x13螨>\xf58d\xf0\xbf\x01\x00\x00\xff\xff\x98\xaaEc\xdc\x18\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4W\xcdn\xe36\x10\xbe\xfb)\x06\xdb\xc3^*y\x83\xbd\x14\xba\xb5i\x17\b\x9a\x04\vg\x9bK\xd1\x03E\x8d\xeci(\x92\xe5\f\x9d\xbaO_\x90\x92lٖ\xbd\xc1\x02\xab\x1b\x87Ùo\xbe\xf9!\xb5(\x8ab\xa1<=c`r\xb6\x02\xe5\t\xff\x15\xb4i\xc5\xe5\xcbO\\\x92[noj\x14u\xb3x!\xdbTp\x1bY\\\xb7Bv1h\xfc\x15[\xb2$\xe4\xec\xa2CQ\x8d\x12U-\x00\x94\xb5NT\x12sZ\x02hg%8c0\x14k\xb4\xe5K\xac\xb1\x8ed\x1a\f\xd9\xc3\xe8\u007f\xfb\xa1\xfcX~X\x00\xe8\x80\xf9\xf8\x17\xea\x90Eu\xbe\x02\x1b\x8dY\x00X\xd5a\x05\x01YH\a\xf4\x8eI\\ \xe4r\x8b\x06\x83+\xc9-أNn\xd7\xc1E_\xc1a\xa3?=@\xea\xc3YeC\xab\xd1\xd0.o\x19b\xf9}v\xfb\x9eX\xb2\x8a71(3\a$o3\xd9u4*\x9c)$\a> c\xd8\xe2\x1f\xf6źW\xfb\x89\xd04\\A\xab\f\xe3\x02\x80\xb5\xf3X\xc1c\x82\xea\x95\xc6f\x01\xb0U\x86\x9a\xccH\x0f\xdey\xb4?\u007f\xbe{\xfe\xf8\xa47ة^\x98,;\x8fAh\x8c1}\x93\xfc\xeee\x00\r\xb2\x0e\xe4\xb3Ex\x9fL\xf5:Ф\x8c\"\x83l\x10\x86\xbc`\x03\x9c݀kA6\xc4\x100\xc7`\xfb\x1cO\xccBRQ\x16\\\xfd7j)\xe1)\xc5\x19\x18x\xe3\xa2iR\x19l1\b\x04\xd4nm\u9ffde\x06q٥Q\x82\x03\xc5\xe3GV0Xe\x12\t\x11\u007f\x04e\x1b\xe8\xd4\x0e\x02&\x1f\x10\xed\xc4ZV\xe1\x12\x1e\\@ ۺ\n6\"\x9e\xab\xe5rM2V\xb4v]\x17-\xc9n\x99\xeb\x92\xea(.\xf0\xb2\xc1-\x9a%ӺPAoHPK\f\xb8T\x9e\x8a\f\xdc\xe6\x82.\xbb\xe6\x870\x94?\xbf\x9f \x95]J\x1bK \xbbދs\x95]\xe4=\x15\x19\x10\x83\x1a\x8e\xf5\xf8\x0f\xf4&Qbe\xf5\xdb\xd3\x17\x18\x9d\xe6\x14\x1cs\x9e\xd9>\x1c\xe3\x03\xf1\x89(\xb2-\x86>qmp]\xb6\x88\xb6\xf1\x8e\xac\xe4\x856\x84\xf6\x98t\x8euG\x922\xfdOD\x96\x94\x9f\x12ns_C\x8d\x10}\xa3\x04\x9b\x12\xee,ܪ\x0eͭb\xfc\xee\xb4'\x86\xb9H\x94~\x9d\xf8\xe98:V\xec\xd9ڋ\xc7i1\x9b\xa1\xd3\xfe\u007f\xf2\xa8S\xc2\x12k\xe9 \xb5\xa4s\x0f@\xeb\x02\xa83\xfdrbx\xae9\xd3W+\xfd\x12\xfd\x93\xb8\xa0\xd6x\xef\xf4\xa4\xcd/\xa0\xfae\xee\xc4\b+\x8d\xb8\xbeQq^\xf1\xc42\x80l\x94L:T\x14\xd9}\x9b\xcf\xc4q\x91\xf2L\xbbJ\xedj\x95\xd5\xf8)\u05ceջ\xab\xb1<\xcc\x1cH\xa1l\xdc+\xb8V\xd0NM\x8e(k<\v\"D\xfbf\x90\xfdL\xbekRi\xb5\x84\xe1*\xc0Չ\xf2\xc8s\x1b\x8d\x19,\x15\xdau^\t\xd5\x06\xc7Fn]8\x83H\xbd\x8d]\xdf\xd5\xdf\xc6\xef֙\xd8\xe1\xfen\xb8\x8a\xfc\xf9XwZ \xbd`\x00\x91B\x80p|\x05N\xbf\xa1&\x18\xbck\x06\x00C\xd1r\x8a\xf3\x8d\xd8Sr)\xe0\xd14,\xe6\x8b\xffHc\xae\xa2\x8e\x14N\xb3y\xb4y\xc2\xd7W\x87\x81(\x89\xfc\xf6q\x90\xd5Gbu\f\x01\xad\fF\xf2M\xf8M\x03\xc1(\x96I[\xa47\xd0\xd5<ߟ돐\x92)\x90$\x98vѫ\xe2\xb9~i]\xe8\x94T\x90F{\x91\x0e\x9d\xec\xa7\x17\x98\xaa\rV !\x9en^\x9e\bȬ\xd6\xd7#x\xe8u\xfa\xabp8\x00\xaavQ.\x10\x9b/\xc5+\xd4^E\xe47\x8a\xaf\xe3\xf9\x9c4\xe6Ҋou\x8e6v\xa7.\nx\xc4\xd73\xd9\nUs\xdas\x05<:\x99۸\x10\xd3L-\x9f\x88\x0eO\xec\x9b\xc3*\xd7]1<\xa9\xf3\x06@~\x996\x93\x14sߛ\x83\xe4\xd0 Jk\xf4\x82\xcd\xe3\xe9\x93\xfaݻ\xa3\x17r^jg\x1b\xea\xff\a\xe0Ͽ\x16\xbdUl\x9eG\x1cI\xf8\u007f\x00\x00\x00\xff\xfflC\xbf\xee\x8e\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}\xfds\x1c7r\xe8\xef\xfe+P<\xd7#\x99\xe3.\xa5\xbb\xe4*Q\xa5\x9e\x8b'Q>\x96-jK\xa4\xe5w\xe5s\x1c\xecL\xef.\xc2\x19`\x0e\xc0,\xb9\x89\xf3\xbf\xbfB\x03\x98\x8f\xdd\xd9\x0f`HJ\xf2\rR\x953W3=@\xa3\xd1\xe8\xef\xa6\x05\xfb\bR1\xc1_\x11Z0x\xd0\xc0\xcd_j|\xf7\xafj\xcc\xc4\xf9\xf2\xe5\x144}\xf9\xd5\x1d\xe3\xe9+\xf2\xbaTZ\xe4\x1f@\x89R&\xf0\x06f\x8c3\xcd\x04\xff*\aMS\xaa髯\b\xa1\x9c\vM\xcd\xcf\xca\xfcIH\"\xb8\x96\"\xcb@\x8e\xe6\xc0\xc7w\xe5\x14\xa6%\xcbR\x90\xf8\x05\xff\xfd\xe5\x8b\xf1\x1f\xc7/\xbe\"$\x91\x80\xaf߲\x1c\x94\xa6y\xf1\x8a\xf02˾\"\x84\xd3\x1c^\x11\tJ\v\tj\xbc\x84\f\xa4\x183\xf1\x95* 1\x1f\x9bKQ\x16\xafH\xfd\x0f\xf6\x1d7\x11\xbb\x88\x0f\xf6u\xfc%cJ\u007f\xd7\xfc\xf5{\xa64\xfeK\x91\x95\x92f\xf5\xc7\xf0G\xc5\xf8\xbc̨\xac~\xfe\x8a\x90B\x82\x02\xb9\x84\x1f\xf8\x1d\x17\xf7\xfc-\x83,U\xafȌf\xca\xfc\xb3JD\x01\xafȵ\x99EA\x13H\xbf\"dI3\x96\xe2\x12\xed\xbcD\x01\xfcbr\xf5\xf1\x8f7\xc9\x02rj\u007f$$\x05\x95HV\xe0s~~\x84)B\xc9G\\\x9f\x99\x04n\x04\xd1\v\xaa\x89\x04\x9c\n\u05ca\xe8\x05\x10Z\x14\x19K\xf0+D\xcc\x1cHR\xbd\xa3\xc8L\x8a\xbc\x865\xa5\xc9]Y\x10-\b%\x9a\xca9h\xf2]9\x05\xc9A\x83\"IV*\rr\xec\xc0\x14R\x14 5\xf3\x885\xa3AJ\xd5okk86\x8b\xb4ϐ\xd4\x10\x0fة:\x12\x80\x94(D\x00\x113\xa2\x17L\xd5K\xc2e4\xc0\x12\xf3\b\xe5DL\xff\v\x12=&7f\a\xa4\"j!\xca,5\x14\xb7\x04iP\x92\x889g\xff]AVf\x81\xe6\x93\x19\xd5\xe0v\xda\x0f\xc65HN3\xb3=%\x9c\x11\xcaS\x92\xd3\x15\x91`\xbeAJހ\x86\x8f\xa81y\x87[\xc2g\xe2\x15Yh]\xa8W\xe7\xe7s\xa6\xfd\xe1ID\x9e\x97\x9c\xe9\xd59\x1e\x016-\xb5\x90\xea<\x85%d\xe7\x8a\xcdGT&\v\xa6!ѥ\x84sZ\xb0\x11N\x9c\xe3\xd9\x19\xe7\xe9\xef\xaa\xcd:n\xccT\xaf\fA)-\x19\x9fW?#ioŻ!qK9\xf65;\xff\x1a\xbd\xe6'\x83\x95\x0f\x977\xb7M\xaab\xaa\x8ds\xc4v\x83\xd0j\xc4\x1bD1>\x03i7\x0ei\xcb@\x04\x9e\x16\x82q\x8d\u007f$\x19\x03\xdeF\xba*\xa79\xd3f\xa7\xff^\x822\xa4+\xc6\xe45\xb2\x102\x05R\x16)Ր\x8e\xc9\x15'\xafi\x0e\xd9k\xaa\xe0\xc9\xd1n0\xacF\x06\xa5\xfb\x11\xdf\xe4|\xed\a-\xb6\xaa\x9f=\x8b\xea\xdc!w\xbao\nHZ'ü\xc4f\xfe\x18τl\x1d~\xf3ʸ\x01\xb2\xebX\x9aa϶aA\xed\xdf\xd7&\xf1\xe7\xea1C+\xe6\xf3%g\u007f/\x01Y\xa8=\x93\xb0\xc9.d\x83\x9d6\x87!\x81\xf1گ\x9d\x184\x03\x1e\x92\xacL!\xadؤ\xda9\xd3ˍ\xc7\U0005284c\x1b\x1a7L\xddL\x97\xd7\xff\x8a\f\x92v\xcc\xd2\xd0\x19\xe3\x16\x1aa\x1c\x97\u0601Y3\x98\x86|cZ;\xd6D\xf0֢\xd3\f^\x11-\xcb\xf5o\xdb\xf7\xa8\x94tՉ\n\u007f\xcb\x1e\x86\x89\xeaiw\xcc3\x96\xe0\x96U\x87\x19\x91\xf1%\xe1a!\xc4\xdd\xee\xb5\xff\xc5<Qs#\x92\xa0tB\xa6\xb0\xa0K&\xa4[\xad\xbb\x12\xa6@\xe0\x01\x92R\xe3\r\xbc\x06\xb5D\xa6($)\x84\xd2\xdbֽ\xedt\x91歺\xf9O[\x11\xb6\xb1\x1e\xc7\x04\xfcV\x9a\xe5\xb5\x18\x82\xe0`\xe6\x98\x1b\xe6W?+Ei\x9fU\x9d_ ۰@\xa6TAJ\x84\xdb\xeb2\x03徔\"\xa3\xa9O\xcf\xd9\x16\xc0բ\xed]\x99\xd1)dDA\x06\x89\x16r\x1d{\xfbqh\xc7~N\xb0\x05{\x1d<\xc1qO\xc7K\x9b\xec@l\x85I\xc8\xfd\x82%\v{\x8d\x19\x1aD($\x15\xa0\xf0\x90\x18\xb1jս8\xb2{\xaf\xed\xd8qL\xea\xb1\xf3\xc0\xac\xc3\xda<:\xf5\xd8\xcbL걇\xad\xb4qYK\x91\xff0\xa8\xf4\xdc1\x980\xaf6^|L\xc2D1߈\xa2W3\x02y\xa1Wg\x84i\xff+\x8a\xf3\xa89mEO\xf5\xed/n#Bi\xfaj\xfd\xbdG\xa4鞻P}\xfa\x8b\xd9\x04d\xf67\x8e\xd7\x1f\xb8\x01\xdf7\xdf9#lVm@zFf,\xd3 \xd7vb\xd7r\xc5\xee\x9d苂\xfd7\x95\x199\xd5\xc9\xe2\xf2\xc1H \xaa6x\x1c\x84\x8d\xf5W\xad\xe0\xe6e\xd7\xf6e\xba\x13*A\xe5\x89IȭJv\x8b\x18\xac\u007f1\xf2\x1e\xb9\xb8~\x03\xe9v\xa4\x90C(lc\t\x17k\xd3l~\xd6ɡ\x87-\xc0\t)\x95\fo\xd5\xeb3B\xc9\x1d\xac\xacta\x94\xfd\x02$5\x9f1\x0f\xef\x85(\x01u|$\xa8;X!\x10\xa7\xb6\xefy\xf7\xb0\xad\xb7\xe3\x0eV\xfb\x1fZC\x9b\x99\x8dS\xb0,\xfe\xcc\x0f\x88\x00T\xf9\x0eE\x19A\xa3\x8b\xe70\xfb\x16E\x0ee\x11~xl\a/\xafڦ\x86A\n7\xf2X\xd9M1Ծ`\xc5A\vD{\x94\x02<\x13\xde\xe8\xf2\x91f,\xad>c\xe9\xfb\x8a\x9f\x91k\xa1\xaf\xf86a\xb5=.\x1f\x982\xd3\xe2)y#@]\v\x8d\xbf<:\x12픃Qh_\xc3#\xc4-\x1b6\xebo\xdan\xf6\x12\xb1\x1dWVi\xaf\xb6\x84)rō\x12aqe\xado\xf6c\xbb\xb8}{\xe4\xa5B\xe3\f\x17|\x84\x97ݸ\xeb;\x0e\xc5\a\x12rs\x176\xa7U}\xd2~\xee \x88\xb7\xe6^\xb0o[KbF\x13H\xbd\xae\x87\x960\xaaa\xce\x12\x92\x83\x9co\xbf\b\x9a\xa30<\xfb\x90\xcf\x1f\xc4K\xed\b\xa2\xa7C\xaef?\x1c3N\xf7Mcd\xce\xe6\xdeg\xfc\xd6\xeey\xb0\xd3\xf4\xb5\xfd\xc1}\xeb\xc0K\x12\xe5\x86=ؤi\x8a\x9e\b\x9aM\x0e\xe6\xde\ac~\xf3\u07b6S\xb2w\\N\vs:\xff\xc7\\UH\xb4\xffK\n\xca\xe4\xde\x13z\x81\xee\x84\fZo:\xd3K\xf3#\x06>S\xc4\xec\xe6\x92f\xeb\x06Ԏe\t\xc35 \xb3װ\x98mH\x1ag\xe4~!\x94\xbd\x15g\f\xb2\x94\xb0]\x92\x96\x19Gw\xb0::\xdb8\xe3GW\xfc\xc8^\xcf\x1b'\xd6\xdf\xe5{\x00\v\x9e\xad\xc8\x11\xbey\x14/\xba\x1cDu\a<\xc4;L\xa4\xf5h\x91A\xd3LZ\xdbG\x9d(\xba}\xb6\a\xd0\\!\x94\xfeK\x97\xf1k\xcbL&\xfe\xf9\xb6\x04\xd9aMڣ\xd98\xcbP\xc5\"\x8d\xd45\xd3 \x9dA̲M/\x9b\xf7\xd0T\xf6\x19\xbd*\x83\x17\xf5\xa68D\xeaN\n\xb0\xa6\xf1\xfd\x93;\\\xba3\xd8\b\x92\x86/\x1f\x1a\xb6:s\x02\xcd\xdf\xcd\x05<\xa6ܙ\x88<\xa7|/oߘ\xe4k\xfb\x9e\xa7\\\a\xc6\ued5c\x97x\xea\x0e\x15\xcc<\xbd\xa0\xb3\xe7\x9e\xe9\x05\xe3\x84\xfa\x83\x0f\xd2\x11\x0f%\x85\xd84\xb9v\x8d\x05Ud\n\xc0=\xd2\xf6\x1cz;\x9e\xee\xa6\xcd\x19\xbfB\xe0\xe4\xe5\xa3\xdeˤFQ\xc4\xf6y\xe4V\x1bX\xfd`o\x8eC\x91}\xbf\x00\t-\x1a\xd84\x11\xa3\\ǅn\xe8\xe9\x87!\xda\xce\xe3X\x91\x19\x93J7'\xa9H\xa9\x0e\xdbؠ\xdd23\xbee9\x88R\a\xe3\xf4\xb2~\xb7\xe5{\xcb\xe9\x03\xcb˜\xd0\\\x94{/];\xcc\x1d\xc0\xf2\xcaI\xe60zO\x99F\x06e\xa0\xa2\xa5B\v\x83\xf5\"\x03}\x98\xdc9\x85\x99a\"\x89\xe0\x8a\xa5 \xbd\xbb\xd6\xee\x13\x13\xe6\xd8\xcd(\xcb\xcaM\xa7E\xd7\bS\x03\xf9\xa5\x94\x11Z\xe0{\xfb^\xc3ƶ\x10\xf7m\xc4\x1c\xb8\xf4\x05]\x02a3\xc24\x01\x9e\x98\xbd\x00i\x19,~\xc0!\x01Q\xb2W\x8e\xb1\xe3\x10fl\x06\xf02?d\xe1#<\x97\x8c\xef0'5\x1f~K\xd9.c\xa0\x1fA\xdbdh,\xf6\x00\xfcX\xbf\xfb\f\a\xa0f\x06;\x85\x91zL\x81|\x00\x9a\xae\xfc)\xa0Z\x1b5\x10w\\\x10Y\xf2&\x17{d\xfa?\\\x87r\xdf\u007f,\xf5\x88q\xb6w#\u05ec\xdbL7\xa5\x0f\x03\xe0ɤ\x0f\x03\xbc\xba\x8a\xc2\xcd\x1bW\xad\xd7ͥ\xe0\x85V\x9cuE!\aK\"S0\n \xa4\xd6\\T\x88Jͷ\xa1%\x9d\xee\xdc\xceu\x1d.L\xac\x19B\x9d*\xd7\f\xbaj\x10\xfa!\xf6J;V\xa2$\xf7\x94kOڕXU\x88\x83h;l\x1f\xed\xa0r~\xf0\xb3\x1b\x11]^h\xf4\x81U\xc0\xb5\\a\xc8\xcfaӵ\xc3(~\xa9H\ue308\x90\xd39\x1c\x1f+\xf2\xfa\xdd\x1b//\x18\xf6\u007f0w\xb7\x83Y\x1fc!Œ\xa5F\x94\xf9H%\xa3\xd3\xcc(\x983\x90\xc0\x13P\xe4듏\x17\x1f~\xb9\xbexwy\x1a\x00\xda(\xa5\xf0PPn(\xaeT\xfe6\xae\xf6\xdbL\x1e\xf8\x92I\xc1\rjB\xf0p5#\x94,\xfdL\x93*\x0e\xca(6\xd9\x12\xd23\xe7\x1fq+\b\xc1\x87e\x93\x8c\x17\xa5\xf6\x96\xc4{\x96e\x18eœ\x05\xe5s\x83\xa57\xa2\x9cf!p\xbf\xfe\x1a\xf1!!-\x13\u007f\x00\xdda\xf8\xfa̹hh\x96\x89\xfb\x90\xcd3w\x05\xa8\x84\x16\x1e\xb7\x8d\x8d\"j\xc55}xE\xd8\xf8\xa03\xed\xc7\xd1\xd7\r Gv\xed\x85\x14f\xda\xd6\xeenq\x921\r\x92\x1er?׀\x1bp\xc7\xe4\xd2\xcc\x1b\xd2&\x99\xe1\xb78,\xe10[\xa8\x1dӚ\xc8Έ\x849\x95i\x06J\x19.y\xbf\x00\xbd\x00\xe9\x8c.\x96X\x02 \x83\xb7̚\x13\xd2\x19mW\xc7\xd7\x05\x80\xf5\x91xwU\xd8蘉\xf3T$\xea\\Su\xa7\xce\x197\xd7\xde(\xa5\x9a\x8e\x1a\x8c\xf2\xdc\xdeZ#w\x83\x8e\xbc\x1e:\xaa\x0e\xd4\xf9\xefd\xc99\xe3\xf3\x11\xad\x9eb|DGj\x01Yv|\xf0\x1c\x03ػ\x1d\x81\x1ac\xf3\xa5\xc3\xcd\xe9Aʼ\x1dm\x1e|Y\xb1\\\xfb\xd51\xb9\x16z{\xb4\xd3\xf6Q]6\x88\xd7q'W\xbe\xbc\xbe\xfd\xf0\xd7\xc9\xfb\xab\xeb\xdb0f\xdcd\xe3ۙs\x00\xccn6\xde\xc1\x9cC\xf6{\x17\x1bo3\xe7\x00\xa8{ٸc\xceA\xdc\xfc\x106N\xbe\xfe:\xf0Z\x1b\xd8\xf8\xc0\xc6\a6\xdex)\x84\x8d\x03_F\xb2\xf0\xef\x9d\xfa\xd3`7\x15E\x84\x1c8-\xd0W\xcex\x9b\x93u\x11\xc7\xd3a\xbbm\\\xe3ˏ\xb4\x1d\n\xc0\x9b\xcb\f\x80Kj\xc1\xd8G\xfc\xe2eUi\x92!l$\\K\xb2c\xb7\x87\xa8{l\xfa\x8d\\,},\x1eH\x03\x17c\xf2\xce\xf9\xc6)y\xfd\xcb՛\xcb\xeb۫\xb7W\x97\x1fB\x90Ab\xcf\b\xf1!\x0e\xbdPr\xfcx\xaa\x99\x1d[\x14\xb4B\u0092\x89Re\x87\x1e\xe8\xe6|m`t\xe7\x01\r\xbf\xbe\xedhxJ\xd0\x05\xb3\"\n\xe4\x92%\x8fC\x18j\xbcM\x9b\f\x06\xb9[l\xb1\xc2H0\xd0\xc7\x15^\xecx|\x11ƎC\x04\x99`\xa0F\xf0\xd9'\xce\xc4bՉ?[\x84\x9a\b\x02\xe8\x10\x82Z\xa2M\x04\xc8G\x14\x85\xecX\x13\x88\xde\xc0\x8c\x96\x99\xb5L\x1d\x1d\x8d\x0f\x97/\xec\xe8\xc7\x10\xdfJq\x90\xeb\xa09ZL\xf1Ʀ\xedx\xab\xf9cp\x84c\x17X\xd9\x12\a\xacZ\x16\x013+\xc1\xebq\x01QY\xf5\x88\xbd\x81\x89\xe5\x9c36\u007fG\x8b\xef`\xf5\x01f\xe1\x00֑\x8d1\x97.L\x11SK# \x12#\x89\xd8i\x85\xe2\x82\xf4\xc4\a9<\x12\xb5k\xb4pq\xeb\xe2eQ\x964h\x89Y\f\xe9s\x80\xfc\x88\x91\xb5\xfch\v\x18M\xa1\xcb\xf1\xb2\xe8e\x91\xae\xec\xd3\x0ee)\x11<\x81B\xabs\xb14\xb7:ܟ\xdf\vyg\xb4\x9f{\xa6\x17#\xeb\x03R瘀q\xfe;\xfc\x9f\xe8\x19ݾ\u007f\xf3\xfe\x15\xb9HS\"\x90\x8d\x96\nfef\x83\xbb\x0e\x8c\x14\xed\x1auJ\xf7\x19&\x18\x9f\x91\x92\xa5߄2R?zӃ(l\x84ߣ\xd0\xc4\r\xfa\xa5W\xfe\xe6\x89Ƒ!\xa9\xeaܛ\xbb\x87i\x85\xe7'/U8\xab\xf6c\n^\xe4\xec\x85\xec\xa9\x10\x19P\x1e\x01\xe3P\xc7g\xd78$\xa0\xb4k\x1c\xec\x1c\xed\x1aH\xeb\x8fq\x17\x1cח\x81\r\x8e\x14\xe1\xb7#\xa9\x83`^\x11U\x16\x85\x90ZU\xa9\xe2cs\xd8\xc3%&\xd2\xc86\x1fWy[g\xe4?\xab\x1f1\x9b@\xfdt|\xfc\xef\xdf]\xfe\xf5\xff\x1e\x1f\xff\xfc\x9fq_\xa9!6jy\xf4\a\xab\nH\xc6\\\xa4`\xd8\xf1\x99\xfd\xd3i<\x17\t\x06v\\G#Fi\xaaK5^\b\xa5\xaf&g\xfe\xcfB\xa4\xeb\u007f\xa9`A\x90<\xc2\xe5\xdc]\x1c#d\xb4h\xd4\x17ѰWZ4\x97q\xd56\f\xa5bْ\t\xd5\v#\xd3\xddK\xa65İ\r;\x8c^\b27\x92\xfd\x99\xe1c\xb5\x18\xbe|y\xf4\xc9ĉ\x99_\xe2\xa3l\x01\xe2ʉ\x14\b9\xfe\x06\xf1\x82\x96ק\xabh\xbbh\x90\x17\x93+_T\xe5\x13\xa1\xbb\xdf\xfdQm\xd5s\xdf\">\x80\xf8\xed\x13\xdc&\x1ev\x9c\xec3k\x9bt_\xd9\xc8\xf9C2%\xb7\x8f\x8ca\xed\x15\xcaӺ\xfeʉ\xfdq\x9c\x14e\x1c'v\xef\xe7\x90\v\xb9:\xf3\u007fB\xb1\x80\x1c$\xcdFJ\vI\xe7\x91l\xdeO\x13\xa7W\xffe?\x16Ǚ\x1b\x8bߜe\xb8\xed\x9183TRJ\xa3ed+\u007f\xffC\xfaIn\x9e\x8ab\xbaʿ\x1c:\xda$]\a!\xf7\xd1\xd0j\x1e\x81F\x8e\xa5\xc8\xca\x1c\xd4Y%\xe5G\x83Ec\x1d_\x92%\x95\xea\x93\xe9*)[2uX\xd8lנ|\xf5>\x8a\xf9\x10\xe4\x9fv\xfa\x8ck\x98G\xab6\xa3\xfeH\xe8T\xb9|\xba\xbd(u\x8c\xd9؏\x99\x909Օ'\xe5\xa1\x10\n\xbd\xb5\xbe$I4\xe0\x96\xbc\xf2\xf2(\x12NA\xb5\x06\xc9_\x91\xff8\xf9\xdb\xef\u007f\x1d\x9d~sr\xf2Ӌѿ\xfd\xfc\xfb\x93\xbf\x8d\xf1?\xfe\xe9\xf4\x9b\xd3_\xfd\x1f\xbf?==9\xf9\xe9\xbbw\xdf\xdeN.\u007ff\xa7\xbf\xfe\xc4\xcb\xfc\xce\xfe\xf5\xeb\xc9Op\xf9\xf3\x81@NO\xbf\t7\x9a\xdb\xf10\xaam\x18#\xc6\xf5Hȑ\xdd\xfa=\x89\xf2\xbb\x86ߎ\xc7\xe1;\x1f\xbcL\xd1\xef*%M\x99\xeb\x131\x88~\xe2Q\x8f\xe5\xf7\x92\x8e\x14$\x12\xf4\xe7es\xb5sjd\xbf\x1c\xab\xba\xa8\xc9o\xc0\f\xdbWų\xe8\xa9u\f,\xcbF\xd0i\xdc\xc7:Em\x11K\x0f\xff\x0e\x82\xed\xff~\ff\xe2\xc1L\xdc\x1c\xbf]3\xf1\x8d=+\x83\x8d\xf8\xd3؈#_\x8dY\xe5\b\x99RH\xe0V\xccܢ\"\xd4\xc2\x1cӝQju\x99,R\x88\xa2̨\x8e\xf5O\xaf\x87\xd04C\xd6\xdc\x05\xa8\"\x88\xbc\x8ec\xb6N\xf4\xbcw\x84\xd4E\x96\x11\xc6핇\x93\xf2a+\x12\xacnO\xa8\"A\x87\b\x96\xc0\xb5a+|=\x8fW\x11\xa5\xa9Ԍ\xcf\xc7\xe4\xc7E\x90\x19\xd6\xcaR.\x0e\x82q\x92\x97\x99fE\x06\xa4*\xd4X\xd5i\b\x81\xaa\x94H\x185\xab4\x98u\x85\x8b\x94\xf6\xe8E\\hz\x17\x02\xb3\x90\x90@\n<\x01\xac\xe7S6\x8aONW\x84rrɗ\xf8\xb5\xa0է\xa5\rG\xb5\xa2S5\xaf\xd6\xd7l\xecC\x00\xd8O\x124i\x8e\xa9\v\x01i\xd7\xf5\x0ebzn\x83\x8cp\xed\x8b(U\xbe\xca\x101\"V(\xae\xe24\"\x14\x86\ri\xb8\xf6\xb2V\xd2l\xb8\x97P\x8a\xfc\x19\xe3TbEӧ\x12K?/\x91\xf4\t\xc4\xd1\xc7\x13E{\x89\xa1}D\xd0]\xe2g\xb4*X\x9f\x1d\u007f\x17Ƌ\x8e}\xc4\xc6h\xf1\xad\x900c\xc1A\x96\xed$]^\xed\va)p\xcdf,B\xa27R\x8f\x84\x028\x86\x98\x02M\x16\xb6\xa0\x1fo\x87\x82\x84\xd3\xef'\x8e㶚\xfcc0\xea\x9b.\x9b\xc3\xc0u\a\xae\xbb\u007f\xfc\xb6\xb8\xae;\b_$\xcb}&\x8d\x14\xf3Jc\x13_\xdf4rS\xf1\xd4?~\xca[u*\xeb\xa2\x14\xe7\xf8\xbd\x90Ç\xa5(}\xa5\xbd\xfa\x12\xb2e\xa1\xc5=Y\xb0\xb9!\xb3\f\x96\x10\xe2\xf6\xb4\xd25\xc9)\xa7s[\xecO\v\xef\xbe\"B\x12\xc3H$K\x83RUk5\x14\x17i\xae5Æ2A\xd3F\x1f\xa7\x90\xc5g\xec\x0e\xc8\x1b(2\xb1r5\xfdxJn4Ն\xed܀\x0e\tȊ`\x0f\xb8\x8eI\x99e\x13\x91\xb1$\xc06\xdf&\xb5+\xa4\xb1\xa2\xcc2R \xa01y\xcf\xf1~\xb8\xc8\xee\xe9*\xc8\xdfx\rK\x90g\xe4jv-\xf4Īv\xedl\x05\v2\x00\"\x9b\x91W\xb6\xa3\x11\xd1t\x8e&\x84\xba\xb2\xb6\x90\xadO\x05\x80\xc5\v\xe2\x9e)x\x96\xec\xd2\xedG\xedw\xf8Ms\x15ڿ\x9f\x94`26\x83d\x95d\xb1\\\xe9\"\xc1pɺ\xa0s\xe3|\xaa\x95\xd2\x10\"\n\xb9\x02Jh\xc4`X\x18\xaf\x10\\\x81- \xe6\x8fj5\xe3P\xf3\x93\xea\x95\x18\x1a'\xa2\x15B\xe9\x1bM\xe5Ae\xaa\xea\xd1>\x8d\x13\x0fĐzB\xb3\fR\xc2\xf2\x1cRF5\x04\xa7\xf7\xf9:\x85-\x1b\x1c6\xa1s%\xf0\xc2\xef\xff\x05\xe5i\x06\x12\xab\xb29\xab[\v\xba\x06\x993N\xc3\xca3\x90*\\\t\r\x84\x90\x12\x9a$B\xa6\xae\x12\x96\xafuDe\xa8]\xa4\xe2h(\xed4\xe8u=\xea,\x10\xee4\x13ɝ\"%\xd7,\xab\x8b\xdf\xf9\xcaw\xae}Z \xcc\xc3\xe5\xe8\x06\x1b\xa9\xfesT\x9d\x95\x11\xf6\x17:\xff]\xfdO\xf8C\x98\xd0\x1a\xaf\xa5\x1cR]ts\xacU\xd8\x03$\a\f\x04\x14\x1c\xe2]\xc53a\xc4\x10CFu\r\xc8\xea\x02\x19c\x81\xc4\b\xa8\xed\xee\x1a\x14\xd9\"V\x89\xa2wA\x15\x1f\xec\xe8\xe7\x97\x0f\xae\xb0\xd2\x1c\a\x14P\x8d\xf4\xc0e\x8cC\xb3\x92*\xc3\xfa\x8e\xed3\x17\x1b\xc9d\x808\r\x92\xa4LbO\x81\x95\xcf'\x8c\x84\xe9K\x85bEu!499>?>\x8d\xcd\u007f\xf60}\xbd\x15##g`\xefH\xf4\xff\xf4\x9c\xa5\x11\x83X^d+\xc4\xefqzFX\xac\xb7\xd5%:ʒ\xfb=rErΈ:\xac\x8a\xe1\xe6В\xfa\x9a\xe5\x16\x96\x01\xadei\xe5\x87H\xa0'ǿ\x1e\x9f\x11\xd0\xc9)\xb9\x17\xfcX#\t\x8cɭ0z~$\xccj\xa9+Q\x12\x0e6\xb7\x1a\x1e\x8a\x8c%LG$\xd3\xdba\xaem\"Jm\v\xc7a\x8b2,:t\xf9\x10\xbdK6\xcf\xc3\xf0\xc1\x17x>\xed\x15N\xa8\"\x19[\xc2\xf9\x02h\xa6\x17\xb1\xf35\x14\xc5\x05\x1f\xfd7H\x81\x05\x8d\xb8\x83\x17g3\t\xf6\x105G\xef\x18\x89pE}\xfd\xdd(\x17\xbc\xb9\xb6\xbf\x85@яl\xf4\xfd\xbb\xbd\x9d|\v\xba}\xc1D\xa0\xc1\xcc\xc6\xc7~\xa3Y\x17\xe4Lȍ\xa6\x95\xfbG\xbf\xbbi!T\x04F\xc8f7D\xa5m%z\xab\x1c\xf0\x18\xff\x98\x1dZ\xb4\xd3v\\d\x1d\xb9\x9a\xc4\x06\t\xfdU\x94\x06KS:\xcdVU}K\x05\x9a\x1c\x99i\xc7\x06\xd92\x8e{\xf8\x17\xa0)\xd6\x11\xe5J\x03\r\xd0`\x9a\xa3\xe7\x91j\xcc\xe31\x84\f\xdb\xc9r\xe1\x16v`\xa1\xdc\xcd\xd1(\x06\xe4\xe8|\x8c\xa7'\xb6\x0e\x86\x1d\x12\n\xcbX\xdd\xfc>\x01\x03\xdc\xe0\a\x16\xf7\xee\xf7i\x8f\x189\xeaۈ\xdaŹ겥ꑍŸE\xba9\x00\xd13\xeb\x1b\x97JzFJ\x92.O\x8f\xc5Q\x0f\x88.+/4\\j}<B\xa6Bd!\xa3\xe6x:\xf4\x84F쬏G\xc0O\x9f`?\x12\x13\x12\xd7~\xb9\x0f\x06zż\x93\x9e\xd2\x12\xa6\x82D\xa6\x9cn&\x9cjAh\x92`ќ\xd8\xf4\\s\x19 ;Rغ\xfd\x93ܖ\x85\b\xb5\xff\xf9\xd1#1\xea1Ң\x1e!)\xaa\xa3\x1a\x9c$\xbç cK\r\xf8b\x03R\xb7\bd-\xa22\x12\xf4\xb5\x9d\x9awbzq\x82\xf2\x03{\xa6m\x8e\x97f\x96\u007f\xfa\x97\u007f\xf9㿌-\x02\xaa\xf8\xccX\x9a\xbe\xba\xb8\xbe\xf8\xe5\xe6\xe3k,t\x15\xb7\xd0'\xc8\u007f\xc2\xf4\xfa\xc8\x1b\xa5\xed\x8fF@\x06k\xa5\u0092P\xf1\xa6\x16\xa3\x158{\xb15Ȫ\x86\xef)Z]@\x86\xf2\t8I\xfc\xa54\xc2\xe3\U0009ceafN\x8a\x1b\x91\xdc\xf5\xd6~\x8fo_O,\xa0Z\x01\x8e\xc0<\xe5\xde$\xcb\xf8RdK\xdb\xdd\xeb\xf6\xf5\x04\x11\x13\xb3\x97\xe6]\xb4\xa1\xa3\xa9le\xe6\xe73\x9fm\xd0I\x04L\x96\x17\xae\x8f\x1d%\x12hƔf\t~)\xc6\xe9凙ext\xcag\xa1\xe5\x1f\xbf\xf7A.\xb5\xc2\x1f\u007fl\x1dC\xe8R\xf8c\xd5\x14k&\x88K\xfe\x19\xa4\x8aG\x92*\x9c4!}g\xc2A\xaa\x88\x19\x9f\xa3T\xf1\xe5\xdcx\x91/\x16\x12n\xb4(zE\aX\x10\x8f\x12\x1b\xe0{Nmsߓ4x\x13m\u007f\u058b\xc9Ue{\x16-\xa7;\x86f\x04\xc2Te\xb2\xf0~\x0e\x0eJ\x9dc\x18@YX\x9b\x93o\x0e\x17\xeaJ,$`\xa7-\xc1Ϫ\x9csD\x04p\xfb#\xe8$\xf4\\\xa0]\xc4EG8\xaf\x9aߤ~\xc1\x06\x89\xa4j\x01X\xfe\x15\x1eX\xdd\b\x9f*\xc1\xad\xdb\xd3m\x1a\vV\x9d\x99\"\x05U\xca:\xbet\xbd\x00\xfb\x89\x89H\x8f\x8fCE\xb0\xc6d\xc8\\\xd2\x04H\x01\x92\x89\x94`\x1d\xb4T\xdcs2\x85\xf9\xfe\xfe\xb9\xeb\xc3ѫ\x99\xa4?\x06F\xda\x01\xf4\x86V}\x1d\x03\x81~h\xd5\xeau\xc5;\x12Q\xc7G;|\x84\xd2W;,\x06ӵ\x90\xf8K\x9ae\xab\xfa\x90\x05Bu\xd9\u007f\xbaښMd\x87\x9e\x03ܚg\x8f\x8f1\xa4\x8c\xff\x16\x81֭\xf4\x85\xbd\xd0i\xb2\b\xa7\x82\xc00\xf6!\xfc\xe6\xd01\x84\xdf\xec\x1cC\xf8\x8d\x1fC\xf8\xcd\x10~3\x84\xdf\f\xe17C\xf8Mk|\x16\x86\xb9!\xfcf\b\xbfY\x1fC\xf8M\xf0\x18\xc2o\xb6\x8f!\xfcf\xe7\x18\xc2ov\x8c!\xfc&|\f\xe17\x1b\xe3\xb7\xe6(\x1b\xc2o~\xab\x8e\xb2!\xfc気\x87\xf0\x9b\xbdc\b\xbf\x19\xc2o\x86\xf0\x9b\x03\xbe=H\x15C\xf8\xcdo[\xaa\xf8rn\xbc\x1e\xf5\x9b\x82^\xf2\x11'\x13)\xa6х\x9c&\xe8\x9bf\x89\vW\x11\xb3(\x97\xba\x9fʸn\tߨ\xd3\xebkf\x045\xbb\xb5m\xc3}\bMg\xbd\x94\xd0\"\x16\x87{\xd0}\xe1%u^\b\xfb\xffj\xffy\xc3qn\xedZ\x87\xb3\xfc\xb8\x8b4\xdcc~\x88\xb7\xbc\xf6}\x87\x06<m\xf3\x94GKe}\xbd\xe4\xf1\xf2I\xb4w\xfci<\xe3O\xe5\x15\xdf\xe9\x11o\xfa\xb6#`ox÷\xf9\xb5c\x04\xeb\xc6\xec\x1eɧ\xbdӟ\xdd\xf4LǨ\xbd\x1b\xbe\xec\r\xaft\x04Ԧ\x1f\xbb\xd3#\x1d\x01\xb3\xf6ao\xf3FG\x00\xbd|`\xfa\xe9<я腎v\xc0\xf4\x12Vcm\xa9\x91r\x88\v<\xbd]HP\v\x91\x05\xf2\xb8\x16\u007f{\xc78\xcb\xcb\xdc\x1cle\x18\x13[Vq\xad\xa1\x1c\xc3\xf3\x1c{\xb3[\x17\x93\x01\xcbR\xc0vt\x94eᅹ\xb0\x88\u0602\xa2&\xaf\xca$\x01H͝Ԩ\xeb\x17\b\xf1\x8f\xe3j\xcdU\xb7\xfd\x97atf\x9b\xa4\xa1v\xf4\xc7?D\xecw\xb8V\x15\x15b\xb0?\xbc\x00\xe1\x06\xe2\xafohA\xfc\x85\x1eglx\x8ap\x82\x1d\xa1\x04䯢\x8c\xd1\xf2\xb7\x87\x11\xac\x05\x04\xc4܋\xb1!\x04=xb\xafЁ\xdda\x03\x067QX\xd8\x1a2P9\xffcL`\xb1\xe1\x02\xd17\xd5ӄ\tl\x0f\x11 ,\xce\xd6\xd0/<\xa0oh\xc0\xa3\xf5/\xab}\xde=;R\xf7\xb1j\xf6\xb5\xa4\xf5\n\x03x\x1at\xf4w~\u007f\xa2>\x91\x91\xfb\x18\xef\xee\xef\xe5\xea\x8fw\xf3ǹ\xf8w\xbb\xf7#\x8d\xf0\xbd\\\xfb=\x88%\xce\xf8\x1eix\xefkt\xefip\xdf\xed\u008fܸ'0\xb4\xef0\xb2\xa3\xb9<\x02d\xb7\x81\xbd\xaf\xa9\xfc\x91\xcd䱎\xf7\xddN\xf7\x86\xfb<J\x10\xeep\xb8ǻΣ\xe97\x8e\xa1G8\x0f\"Y1\xe3L3\x9a\xbd\x81\x8c\xaen \x11<\r\x94j֚\xa8T\xa7RY`VO\x8e0\xcd\xd6y\x82\v\xea:\xe4A\xea\xd3\x1d\xbd\xe5?\x94i\xa2ȇ\xed\xfa\xed\xba\xd7\xea\xda\u007fJ+=\xf9$\xea\xbbM\x12\xec\xbf\xf1\u007f\x11\xf7D\xcc4pr¸\xdf\xfb\xd3p\x9e\xe7\x14\xf7\xdaZS\x1d^sv_\xbe\xf0\xa0\x83s\x19\xbf8\xc3\n\x9a\x94\x94z*K\x9a\x03\xffئ4\avV\x86Z\xb2[\xe64k\x90k\xf3\xed\xc0\r\xab\xdbk\xbd\xc49{\x8e\x81\x16]\x97,\xff\xdb'\xa2\xc8 \xa8\xbd\x01Pu8S \n;\x83\x9fڡL\x81\x10;\x02\x9f\xbaØ\x02ᶂ\x9e\"B\x98>\xa95\xf1\x91\u0096v\x87,\x91B\xc4\xe8\xd8Q\xe1J\x83\xa6t\xd0\xd8\x1d\x964hJ\x9fVS\xfa\xdcu\x81F\r\x89o%M`\xf2H¡gF$-%u1\xb0^\x90\x8b\xd0\f\n\x91\xa2\x13\xda\xf2!_4\xc4V\xbd\x98\x95Yp\x86tY\bޮ\x9bc\xeb\xbc4\xcbh\x84^s։ձ^'脟\xc4B\n+\xb0\x11YrnnPwj\f:\x8cF\x13\xd8닠e\xbdY6D\xb1\xb9\xd9$# aE\x11\x96\x03\xf6+\x8f٢j\xb2ff3!\x136\xcdVdA3\xdfT<x\xa6w,\xcb\xdc\x14\xc7\xe4\x064\xd1\v\xa6\x9c(\x96\t\x1e\xcel\xf5\x82Z\x04\xc2C\x01\x89\x99W\x92\x01\xe5eaWn\x84ƕ(C\xa1\xba\x95\xbb6R\xd5\f\x99\"\x9ceg~\xc3\xfa\x94\x0f\xda<\x9a>\xe8-\x94\xee\x15\xf8\x1a7\xf7L\xc1Ys\xbe\xbe\x95`\xcc\xf9\xb4\x10\n)\x96,\xb5\xed\xdd=\x99\x1a\xf91\x94\ac\xb3\xf8\x8a\xa3s\xc1G\x1c\xe6\x14u\x18w\xc9\xc6T\xa4\xb2s\xb4Q\x1d<\xc5\x16\xf2\x8a(,\"\x16]:l\xc9(\xae\xb4A\xa9\xe4\x84\v\"P8-9ӡ\x10\xb5 jQj\x92\x8a{~:\xb6m%\x99\"\x94LA\xd3('\x87m'\x86א\"\xc0\xe943\x9c\x04c9o;I,T=\x04\xaaK\tdN5t\x863X\x1a\xd8I\xce\xe1\xbc\xd6\xfa\x02g\xa4\xe4\n\x02s)\x1a:ٟ\xfe\xf9yt2\x96\x83(\xf5gcl\xbb_\xb0d\xd1\xd4\xe9Y\x0e\x8a\x882>Q\xc9h\xebnJ\xdd4\xf0\xa4m\xe0~C\xf6\xb9\b9.̹\xdc\xe1YY\xabpV\x87\xdb\x06\xac\x97\x1a\x1e\xf5\xe6\xfa\xe6\x97\xef/\xfe|\xf9\xfd\x98\\\xd2d\xd1,\xa8\xc8\t\rT\x1e\x91\xff/\xe8\x12\b%%g\u007f/m\xff_rR}\xe5\xd4\xc7j\a@\x8d\xe9\x82\x19\xa1\x9f\x19\xf6\x1fp\xd0[\x9b\xf2=SXv\x0ea\xb8B@BAh\x8b\xf5\xb6\xc6F.\r\x10k\x85A\xedn\x01\x86g\xb3e\xd0}o`\xda([BӪ\xb4\x929\xa8\xe6\x94\x18\x89\x92NE\x19\xa4\x80,\x80p\xd0\xe6\x04W\xde\x1f\xc1U\xab\x1ag\xa9 \xa8\xf9\xee\xb4Ħ\xa1\x85d9\x95,[5'hD\xc9k\xe1\xedZ\xab0\x8d\xbb\x89\xba7\xef/o\xc8\xf5\xfb[RH,hhcZ\xf1\xdf\x037j\nf[\xec&\xa7cr\xc1W\x16\x8c\xe5\xd2L\x91\x8c)\r<l\xaaNe\xf7\xad\xa2\x8f^\x8c\xf1\xff\x8e̾I\xa3\xd3۠\xe4\xa0\xc5'\x1b)\x17\xd6>\xc0\xa6\x99\xa5\xce@\x1d\xc7\xed{\xaf\x0e\xb5\xc1\x81Kk\x01\xf5nE\x13\x83p\t\x85ퟬ\b\rb\xf5\x9e\x80qېՙ\x93\x96EZLb͈Is1\xa1v\xacN3Ҭ2\x04Y\xea\f\xb6\xa5\b^\xab?\xe4j\xe2\x89\xcfɵ\x86\xc3\a\x834Tg$\v\x96\xda\xc9٠\x803\xf2\x82\xfc;y \xff\x8eF\xa1?\x85\xea\a\xf1\xb7|\xbcPh\xad\xbeW\x93^;\xf5\xa3a:\x06\x8e\xc1\xae\x16dʬ*\x1f\x8cVx\xd0 \r3w;\x1e\x8a\xc1h\x1b\xa6\x99\xfcgG\xb06\x86\xe0j\xd6챮?/\x92%fz\u007f\x11J_;\xe6\xd3\xee\bof\x1b\f\x11E\xae\x9c\xead\xd1\xe6\x8cF|W\xbaf0\xe1\x90S\x81\xd906\x91d\xc1\x82\x9d\xb9\x9f\xe6\x80Ƅm\xb6\xe8\xf21)hͰ\x8dz\xbd\x93\x8bm9\xe4p\x0f\x8be\xcdNX7\x8bM\x1bWX\x8c\xabg\x8b\xcc\xeel\xf41e5\xea\x04i\xc3\xe9\x12\xcam\xa6\xe7\f\xa4\xb4U2\xa7\xe19>\n\xe4\x92%\x10L\x84\xd1<\xae\x90B\x8bDd\xbdhi†\x99\xd2:Q\xdfE\xd2\xd2\x0fo&g\xe4\xf6\xf5\xe4\x8c\bIn^\xdfN\xfa\xe4\xb0\x10rt\xfbzr\xf4LȌq\xa8\x8cڢQЛ~\xebBT\x9a\xf8\xb4\xec\xb0\xc8ؖ\xa7\xca(\t\xa3\x9c\x16\xa3;X\x05\b\x8e\xb1\xb8\x89\xc0\xcc\xe6t\xed\xa2szhڏ\x04\x9a\xb2\xcf$\x13\xdd1\x91zN\xdd)\xe9\xb9X\x06\xd9QP\x8d\U000b0067\x85`F\x1fq\x8d\x13\x9ay\xea\x01@\xb7d\xb4\u007fz\vې\xa7\xde9\x86<u7\x86<\xf5\xf6\x18\xf2ԇ<u?\x86<\xf5!O\xbd{\fy\xea8\x86<\xf5!O}\xc8S\x1f\xf2\xd4q\fyꇌ!O\xbd9\x86<\xf5\xe6\x18\xf2\xd4\x0f\x19C\x9e\xfa?p\xf6Ő\xa7\xfeye_\fy\xea\xbb\xc6瞛2\xe4\xa9\u007f&Vz2\xe4\xa9\x0fy\xea\x8d1\xe4\xa9\x0fy\xea\xd5\x18\xf2Է\x8e!Oݎ!O}\xcb\xf8\xc7Ք\x86<\xf5\xcfKS\xfa\xdcu\x81!O}\xc8S\x1f\xf2ԇ<\xf5!O}\xc8S\x1f\xf2\xd4\xf7\x8d!O}\xeb\n\x87<\xf5\r\x94<W\x9e\xba\x04%J\x99\x84h\x9dm\xa2z-\xf2\xa2\xd4@>x@\x95\xd8\x1a\x96\x05\x82\x97I#5\xfa9\x1b~%\x82\xcf\xd8\xdc\t_\xe79\xe5t\x0e\xa3\n3\xa3:\xc5\xea\xfc\xa9\xa3\xa83\x96\xb3\x90\x1cu3\xea\xa4\xefI\xb4\r!J}\xed\xa7\xbc\xf6R]\v\xaa5H\xfe\x8a\xfc\xc7\xc9\xdf~\xff\xeb\xe8\xf4\x9b\x93\x93\x9f^\x8c\xfe\xed\xe7ߟ\xfcm\x8c\xff\xf1O\xa7ߜ\xfe\xea\xff\xf8\xfd\xe9\xe9\xc9\xc9O߽\xfb\xf6vr\xf93;\xfd\xf5'^\xe6w\xf6\xaf_O~\x82˟\x0f\x04rz\xfa\xcdׁ\x13}T\x85\xb0}\xf2\xbeGZ\xa9\x83e\x90'\xe7\xf4\xc1\xb0\xcf\xd0\xed\xcfEɵͺ\xb0ǹ\xa2~\x1bX\x14\x92\xfdmG\xa0\x97\xe4)\x8e \x89Wm]l\xd5p\x12\xf7\x8e\xe1$\x92\xe3\x0f\x8eZ\xd6Ϣ\x15e\x1e\xf1,\xfa\xab5\xf40^\xcdH5G\xa6\x88ș6\n\xac\xd1Yi|\xd2\x06\xd3-\x13\xaf\xe3G\x98\x15E\xb1\xd8G\x1d\xc0\x1f\x1a!P\xe7\xe7\n\xafo\xa2I\x91\u05f6z\xe4\x14\xa3\x14f\x8c\a\x87;\xa2p\x19\xec\xc9\xfd\xacxT\xc4K\n\x92R2\xbdz-\xb8\x86\x87\x00#w\x9b\xdao\xda`\x88\xdd\x02\x15h\xa3\xf2\x93!\xa2\xb0I(k\xe5\x8e|\xfeV\b\xd3.9ڊl\x91\x00\xd0\xd6|\x82j/\xe6z\xacM<\x00\xb27oX\xfb\xcf\xdfK\xb6\xa4\x19p݀=Au\xb4\t>\xe4\x8c\x1eF\x88\x9a\xaa\xbb\x9a\nadT\x95\nc\xe7\x1e\xa1\xf8\x13<\xe8'\x97UQ0\x98H\xb6d\x19\xcc\xe1R%4Ó\xd1GE\xbd\xd8\x023\xf0|\x1b\x14H\x91)r\xbf\x00\xc3?\b\xf5\x866\xccV\x9f\xd3\xe0@\xe0\xdc\xecP\xe1'\xa6\xac5а\xbb\x82JC\tގ\x17l[b\x8aL\x85\xc8\\\xc6[\xb6\xaa\xe7\xee\xd2K\xb9\xf8\x85\xc3\xfd/\xe6\xdb\xc1\xce\xf7\x8cΫ\xb4W\x05z\xc3\x17\x13;\xedm\xdbd\x93\xa9J 4\xbb\xa7\xab\xd0\xe9\xde/`}~L\xbd\"/O\xf1dSE\xaa/\x86\xf2\xfb?\x9cbT\xd0\xeb\x8b\xc9/7\u007f\xbd\xf9\xe5\xe2ͻ\xab\xeb\x18\x1emv\n\x82\x1a\xab'\xb4\xa0S\x96\xb1pQp#V\xb9\t\n/\xc34=O\xa5\bM{A,{\x9f@}g\xf5\xc9Dj\x16\xb5B2\x9b\xb5';\x97\x94\x87\xe7$8CpM\f\xb2\xe4\x9a\xe5ϖvKӾ)\xb7\x17i\ni\v\x15\xc1\xf0\x1e'\xb7ⵟª\xae\xa7\x15\x01\x93\x90\xc9\xfb\x9b\xab\xff\xb7F\x89\xab\">\x14\xfc\x99\xb3\x14\t1\a\xa6\xe7\xae~\xb0\xf5\x03\x86}\xed\x1c_R\xf6iu\x9f\xf7\x89\x96\xfbP\xf2vM\xcd\"\xf6\x96\xcaE\nc2\xa9|\xa1-X\U000457a8\x04b\x00r\xcdh\x96\xad\x9a\xf2\xab\x1663>X\xc0ꎕ\x9e\xd1L\x05\xb2\xe7\xd8{\xd5\b.\uf322\xdcc\xe7*\x18$\x05.\xb4\xd3\xda#\xe8^\xcc\x10\x16\xb1\x9a{#$\xbdu\u007fE\b\x87\xf5\xb5ʔ\xc7\xf4\xa4\x9a5zb\u009d\xb8몖\xbbV+]>T\xc8\x14\x12\xeb\xad`厂ꅍ\x99̩\xba\x83\xd4\xfe\x10%\x15WnG3\xdbjѷ\xab\x02\xbc\xd70F\x1a\xb6\x11\xa8\xe8\xc9\f5\xa3D\x17G\xa2\xe9{\x9e\xad>\b\xa1\xdfV\x85&z\x90\xed\x8fN\xa7i{L\x8c\x80\x1b\x1c$a\xe66\u008dC6Ш\x83\xe1\xa9-ܹ\xfa\x8cL@\x96\xfcB}+E\x19x\xa5o\x88\xd6\xdf^\xbdA^X\xba\b\x0f\xae\xe5\n\x8b\xfc\x843\x82n\xfd\x8a\xfc\xe0\"q\xccI\v\x15Y<\v\xf0\x0ek\xf2\x8e\xae\b͔\xf0j]\xb06\xdba\xad \xcehbn\x9d\xa9\b\x0e\xb8Y7\n\x19\x16\xb0\xf9\x95P\vc\x1d\x1eRY\x04\xcd\xfc\xe2\r9\b\x94ށ\"\x85\x84\x04R\xe0I \xad>\u007f\x00\x00R\xf9\xb5\xe0\x86\x81\xf4\xa0\xf3\xab*n\x05ͳ-:\r\x15TJ\xa5\xbdNN1\xc6\x06\xd9G\xa9@\xda@&YB\xccV\u007fWN!\x03mM\x16X\x9b\x93jksc9\x9d\x87\x9e\x1b\xaa\xab\xabM\v\x02\\\x95\x12\x9ciZ\x93T@L\xf4\xb8[\xf4\x0fWo\xc8\vrbV}\x8a\xa4>\xa3,\xc3\xf0\x1bM\x03c/׳\x97\xd8\xccO\x0fQ\x89'\x9e\x04\xd7hD&|F\xb8 \xaaL\x16\x1e\x97L\xf0\xca\x1c\xe42g\"\x1c{\x1b\xccg\x1b;\t5\xfa\xd7\xcc\xe7\x1f\x87\x9d\xf4\xba\xfa~P {\xde|?<\xf9\xcd\x17oV2\xfc\xa4\xbdS\xc8\x06H\x0e\x9a\xa6TS\xc2BI\xac\xe4\x8djp\x03!\xaf\x01\xfd\xc2\xeeE\x05\xdf3^>\xd8h\xf1\xbe\xc6՛K\x04F\x9c\xf3\xc4\xea\t\xa1\x17NQd\xcc\x16\xc0]\xeb\xf3`\x19y\xe5\xd4\xecuA\xf8;\r\x199\xcd2a.\xf5pɟ\xf2T\xe4\x1b\xcb6\xca\x1c\xb4\xba\x84\x8c\x91\xe3\x87\xc2\x1f\x8eU\r\xb4ױ\x8a7_g\xb0\x84\xe0\xe2\xc6\xeb]O\f\f\xa3\xd4y:A\xa0\x11V\xc1\x8cN!\xb3\u0097=%j\xf3\x94DZ\v\xa3L\x8dRd}\v\x10|\x10\x19\xd8\xe8j\x8f\x1c\x03\xf47\x80\x1b|\xb5\x1fn\xd0J\xd3\xc2M\xa45\xf9s\xc3M\x19,q\x91u\xdc\x18\xa1\xad\x8d\x1b\x03\xf4\x8b\xc7M\xa4\t^A\x92\x88\xbc\x98H1c\xa1Gr\xe3\x12w\xc0\xea\x90\x12\xb4\xc4Ƹ\x1d۱\xc8h\x19i\x81\x8e0\xc1W\t.T\xdb;\xccg\xb9\xfc\x9fh=\x1c\xb9\xf1ٚ|\xe0\x17\xefcV\"\xee@3+\a\xe6\xd9n+\x91\xd0\fێ\xc4P\x02Y\xa7\x86upu\xd6N\xc4A\xc3\xe0\x11\x84\xe2\xa2\xcdl/\x06\xfc%\xba\x10\x14\x17)4\xaaT\xdb\xe4\xaa[\x9bUa G\x80\xf4IOF\xd6\xf0AB\xa9\x8f\xf90ߋq\xe7\tW\xdaחG\xa0\x88h\xe0)\xe3s\xb4\xeeǔz\x96\x90ٔ,ǰ\xee\xac\xe1\xe7\x18O\x9d\x9bx\x04X\u007fH\xfdv\xa1l\xcc\x04w\xb3\x17<8\x10\x91\xd8\xde\f(zΐ\xc7\x19\xd6}\xf4\xbd'\xaf\xe0\x0e\v\x9f\xfaf>\xb2k\xa8l\x811>$3\xee\x18O]&Q\v\xe5q\xa9\x85\xa4֞\xc6\xe4#6\xe5\xf1l\x8cJxE\xfe\xc6I\x85\xf2\bУ=G8\xe6\\\xb8#\xb5q\x84?X\xf5,\xce}b'\xbb\xd3=\x17\x01\xd1/}}\xaa?p<m\xe1\xe1\xb3v\x9a\\t@\xf6\xbbx\xf4|\xe7\"6M>X$\x8d\x14q\xee\x19OŽz\x1c;ŏ\x16\x98WP\x13Ú4\xe3\xf3`\x89\xa4\xb6U\xd0,kŁ\xf57V\xf8\xb3\xeb\xdb\x0fv\xa8\xe6\x81P\xbd\xd5\xda\x12n\xdbS\xd5S?\xdfb:\xe82\x06\x84:C7L\a\x9f\xcc\x180\xcf\x15}-\xcd75\xa3\xd9M\x11ګ\x83\xac\xd3\xe2\xb7\xefn.\xda\x00\xe3\x1a3\xdcc\xdfN\x83k\x03\x91\xd04gJ\xa1\x9f\x02\xa6\v!\xee\"@\x9e\xf8\x10\xea9Ӌr:NDވ\xa6\x1e)6W\xe7\xeeL\x8e\f^N#\xbe\xc1x\xe6cޭ\xed\x8ck\xe5m\xe0f!\x11 \x93\n\x9bHp\xae\xf5\x97\v\x82\xdcD\xf7u\\\x89\x1e\x8cX\u007fV\xa1e\x93\xf4\xae\xa3\n\x1a\xef!\xbfH|\xb8\xca\x1b\x8d\x8a7\x96\x10\xeb݈\x91C\xcd\xfeY\xe5\xf3yK\x95[\x85'\x01շT\xf9_jH$\x05\x9b~\x1f\xa5;\xb1Y\xabGm-(XOj\x946v\x8c5\xaa\xdc\U0010e6ca\xfamL\x83Ew(\xd0&\x93\x15\v:B\xb5\x1a\xef\x05\xbcv\xe2Ն\x85\xe0\xc2&Bc.\x97\xe0\xc8&\xd0bc#\x90\"\x05O'\x0446\xe9u|\x17Ⱥ\b\xc3,\xa3s\xac\x95b\x04\nk\x04\x89-\x8c}\xcf\xf4\x02۵,\xa0\x05\xdebT\x82\x8a\x93d\x19' \xa5\x90\xcaF\xb1{\xb76\xce\x16\xa5\x8c\x98\xe2R\x17f\x8f\r\x99N\xb0#n-\n՝\x13#\xf7I\x19\xae\x02\xb3\x19$\xa8\xec6\x0fUl\x05\xed\x13\xa6}w \x97\x98k\x18\x8d\xeb\ue633\x87\b\x90b֚Yc\xfd\xbe\x9bM\xe3\x9fc.\xb6\n\xe0阐+^\xa5ɞ\x19\xee\xd0\x0f\xb6\xcf7ц\x1c\x9a\x8dWq\xebh\xa6bJ\x839\x85_\v\x84\x1d\xcb\xc1\xc3}\xfa\xa4\xe9\xd7\u007f\x84K\x12\xfd\xfb\x0e\x949:\x8em\xc4Y\x816#\x046|\xfeq\xaa\xe9F\x94\x00\x02m\xfb\xfe\xe3X\xc5F\xb4@\xcb\xff\x1f\x01\U000f0201\b\xc0\xbb\x15\x1a\x12ק\xedi\x94\x1a\xf2\x14\x8a\ryv\xcfCL\xfa\xac\xad\x02۫\xcd\xe5M\x03F\xc3\ngD\x82\x805\xbb\xcb\xceV\xba\xae*\xe8f+_\x9d\x9b\xfd\xb7ՒC\xfc0u\xc65\x176\xe9\xbaY\xfe\xda\xf5\xfa\v!\x96\x92k\x96yWJ^d\x80%\xb3\x1a\xb3\xadj\x9e\x05\x00m\xf4\xda<\xab\xd0P7\xf6te\xbfCl\x16\xff\x85\x17E\x95m\xe9\xeb\xfeN\xaa\x0fY\xbb|\xd0,]Gd,3\xa5\x85\xf7\xfc\x90\x94\xcdf\xe0\xb3E\xa7@\n*in\x84\xeb\x10\xd8.ts\nsfS\xf8*\xd1\xe5X\xd5\x05hC0\x80\xa2\x14\xd3$g\xf3\x85=Ȅb\x89<\xe2c'3ASbxh\x00T!\xc9=\x95\xb9\x91\xfei\xb2\xc0jq\x94\x93\xb4\x94\xd8\xffL\x03MW#\xa5\xc3BWD\x01\xd2\x19\xf41E y\xc6\x1aAC\xb3\xd5\xedch\xb6:4[m\x8c\xa1\xd9j5\x86f\xabC\xb3\xd5}/\x0f\xcdV\x87f\xab\xbb\xc7\xd0luh\xb6\xda9\x86f\xab\xcd14[\xdd5\x86f\xab\x87\x8c\xa1\xd9js\f\xcdV\x9bch\xb6z\xc8\x18\x9a\xad\xfe\x03\xb7\x10\x1a\x9a\xad~^-\x84\x86f\xab\xbb\xc6\xe7\xde`ih\xb6:4[\x1d\x9a\xad\xee\x1dC\xb3U7\x86f\xab_\x12\x11\r\xcdV\x87f\xabC\xb3\xd5\u007fTMih\xb6\xfayiJ\x9f\xbb.04[\x1d\x9a\xad\x0e\xcdV\x87f\xabC\xb3ա\xd9\xea\xd0lu\xdf\x18\x9a\xadn]\xe1\xd0lu\x03%\xcf\xd5lU\xe9\x94\x05\xb4G:\xa4\xea\xb7\r\xbd\rX\xae\xaf\x18h\x18U9\x9b\x81\xc4\xcb\x14g\xb6\x11\xad\x19\x00\xd6\xf7f\xa9\xaeV\x17U\x89m\xb8$\xd0\xd4V\x03\b\xb1\xb1uNɗ=\xbc\xa7+\x15\x9eG\xc88\xb9|\xff\xb66[\x86\x97+\x8f\xc9\xed\u0095\xbc\xe7Il\xd6H\xbd\xf5\x1duAB0jô\x93L(\x1bAlQ\x9c,(\xe7\x909\xe17(\x84vA\x15\x99\x02p\"\n\xe0V\x9c\xa0D1>πP\xadi\xb2\x18\x9bهH\xa9n\xdb\xeb\xbc>\xf7\x8b\xd2\x12h\xee\xf3G\xf3\xb0\x0e^fz\x84&R(E\xf22Ӭ\xa8&H\x14`\xc1\x01\x15\x9a0\xe37\x15Ð\xebt\xc1\xb3z\x05\x16)!\xd3lv\x12A;\xe8\x19\xb6Q\xcc\v\xbd\xaa\xf2i\x80̘\f*\x83\x93d\f\xcdm\xb8^[%\x0e\xe7x\xe6\xd5\t\xee0\x1ar\x97X\x94\xf2\x14-\x0f\x85V\x98\x1fҘ\xa4\xfbhʔ\xb3R\xa9\x900u\xaa\xfd\xd5g\xc4\xfd\n\xa3H\xba\xa9\x13\x94Bg\xec^nL\xb1\xd1f\xacN\x1e\n\x11Q<\xb3\xc3\xd4f\xcfL\xceZ\x19\uf576\x15\"\x85,\xa8n0M\xb7~$}\x0eKs\xf6!\x01\x16\x94\x96L\xb7p\xbe'e|\rI\xf2\x1d(E\xe70\t\n\x0e\xd9f6\xc5\xf8\x90\x9aD\x82\xd4u\xacW\xa4EC\xb2\xaa\x93\x13\x8eUs\xca\x01@s\xbb\xba*\x13\xed^2\xad\x01I\x16{\xc2`4\\\x90\xe5lcb\xcd\xde\x1c\xef\xfc\xe7\xecgB.@\x85r\x0eOmf\xda\x14\xc8T2\x98\x91\x193\n\x91\x8d\xd4?Ú\xf1!\xb4e]\x0eJ\x81D[\x853\xd4x\xac\x8cɏ\xc1*\xb0\x96%O0M\xc0i\xa8Xk\x8b\xcd\xc8\x1c\xb3\a\xa45\x10\xfc\xf3\x8b\u007f\xfbS\x00\xd0\xe9\xcaȤ\xa8bj\xa1iVm[\x06|n(\xca^\x104\v\xf1\x8f5\xd2\xe5\xfd\xeec\x13w\x8b\xe0\x97\u007f\xb8\x9bF\x89\xeaZ\x90\xf3\x14\x96\xe7\rz\x1ceb\xde\xd5\x17\xffp19\xc2|\xddq\x84EƒU\xe4!\xf6M(\xc8B\xdc[CV\xaf\xf3V'\x9e\x15\xa2(3\x1b2\xf0\xb6\xaaC\x17V\xfcs\xa3\x96O'\xf7\n3\x80\xfbi\xadw\xb7\xb5)1~\x19Ak\xc7\fq\xe7ʭzO\xa0]\xf4-Ͳ)M\xeen\xc5\xf7b\xae\xde\xf3K)\x83\x1aGx\x9c\xd9DX\xaa4I\x16%\xbf\xb3\xad\xa8\xfd\xd43\x11\xe2\xf9\x10\xa5.J\xed\x93k\x9b\x06M\xbfv[\x9d3\xe4hXq\xc8[B\xeb\x99\xc1\x03\xf3\x06LÏ\xb0PH\xc8en\xf8B&\xe6՜U\xf3 \xff\xe1\xc5?\xff\xabe !\xab\x97\xe4__`\n\x9f:\xb3\x17\x0e\xde\xdeF`\xcci\x96\x81\x8ce\r\x86ĻX\xc1\x93r\x02\x1d{\xe8\x9f@u\xbd\xbd\xfd+\xea\xadL+\xc8fg\xb6*\x83\xb7\x9d\x05\x80<F\xd1\xea\xd8݅X\xee㙕å\xc8\xca\x1c\xde\xc0\x92%!\xde\xe8\x16\x82[0\xbc\u007f#cJ\x13\x11\xa2\xd2L3\x91ܑԁiD\xf2\xafwb=\x1c#\xc1\xd9\n[\xd7\xd5\xe8\xadOIN\x8b\"\xd4\x05\x8by\xf2\x92\u07b7\x96\x89\xdc\x02K\x81F\xb9Lc\xe3\b\xec\xc7Äa\xfff\x03?5\x18\xbf\xe9\x05\r\xf6\xde\x10\x9f\xf5\xba\xd1¥\xea\x13e\xbf\x13\f\xd7\xcbCf\xb7\x90\x8b\x86:\x03\xa2\xdd\xed1Y\x1c-\xcc\xf2\xcaS\x9dS\xed\xf4\x84\xa88\r\xa4\xba\x02\xa4b\xca\b\x16\x1f\x91\xa2_g\x94\xe5δ\x15\f1<\xb0#\xbaqa\xb8G|Ԡɠ\xd7\x02\x91ۣ\xe6KHN\x83e@\xd8x2\x967OD\xea\xc0 K\xb5-B\x8d2\x18\xb8\xf9[R\xe8\xfb\b\x01\xfd\x98\xf3\xc7\x1a7m\xdel~\x89b\xce\x16\xe2'b\xc98\xed\xde\x1c\x19y\xb1[@\xbf\xdaXM\xf3\x86#\xa0\x86\xba\xe3\xac\nc\x1bI\x19\f\xdcP\x8c\x9b\x1a9~u\xfcl|\xd9\"Y\x8a\x82\xceQ\v\xe8\x85\xebu`}\nU\x1au\x14\xe1)߫\x01\xa1BZ\xd50\x8e\x00iӝ\xeb\xfbԫ,\xb6\xba\xd2}pf\x15!T\x8a\x92\xa7֦^\xbbWޭ!\xe2Z\xf0\xf0\xe9:O\xadsdW\x151љ\xcd8y9~\xf9\xe2˹\xbeq\rk\xd7wT\x81\xd8\x06_z\xb6\xd5\xfb\x86\xc1\xbd0\xf0Ι\x1d\xeb\x0e\xbf,\xae/\xa7\x9d\xcf\xe8^2\xed(\xf7\x9e) '\xa8\x1a\x19\r\xb7QS\xef4<\x86\xafg\xfb\xf0>\xc5\x16U9}t~o\x19u0\x16\x90\xc9tY\xa4U,Ď\xab\xa2\x89\xea\xa3\xf0\xfa\xfc'v&\xc7\n\xeb\xfb\x04ou\xf4qp\xdbt\xf9P\x04w^jm\xd5\xe5CA\xd1\xee]\xb4\xf7,\x18\x11\xee2\u07beg\xb1\x10;\xf6\xecϰ\xa0ˈ\xfbL\xb1\x9ceTf+\xb3\xd97\x16\x83dZj\x02|ɤ\xe0yx<\"!K*\x19\x9df@$`\x1d\xbb\x04\x14\xf9\xfa\xe4\xe3\xc5\a\x8c\xdf=57g0L\xf0\xbbR*_ƷI\xfd\x8d\xe9\xf6\xe3-GG\x1b\x04\xec\xf1b(+\xfc&\xe6i\x85W#1\xe4\xa5.i\x86\xa5\xa8\x92\xacTl\xf9\\\xf7E\x9c\x96VI\xbb\xbf\x01%͕1{\xc3\x02\xf8\xc3Z\xb1\xb6\x9a\xe06j\xa2\x05\xba\x83Q(\xabkiv\x86l\x04q\b\xdf\xfd\xbd\xd9\x01\xc3\x19\x93]\xc5F\x9b\xe4\x15\xde5i#\xb4\x06\xeb\xe5>\xafY9\x8cz\x03(0\x90\xf6B\xa8\xce\xc5\b\x1e2\xe5\xb6Pj\xdfs\x1d\x88\xac\xbd.\xa7\x0f\x18\x80G\xf1@\x1e\xb421\xb3A\x11\x1f!\x03)\xfc\xa5qO\x99\xae\xf2\xff\x18g:\xb0\"<**\xb6J\xeb!\xdb\x1d\xb0\xd1\a\xee\xc4A\x8f\xedۦ\xdd䴃|\xf6|}\xfbw\xb7\xbe\xc8x\x92\x95)\xbc\xceJ\xa5A~\x00%J\xd9a\xe1_\xf3\x8ev\xbe\xd3H\xed\xbbw\xae\x94\xc4>2R\x89(:\x0e\xbd\xac_\xadd\n7\xa1ԧ\xefcVh\x95\x01ak\x1c)-$t\x06B\xf12\xcb֒\xcc:j\xa3\x9b\xa7\x8c\x84Й\u007f\xb3]R\xf7S3*\x9a*\xe8\x81hj<n\x03\xafU\xc6\x124cs\xff\x0f\xf6\xbf\xccl\xdd'6\xd6ew\xce\xc6\xd9`\xf0\"z\x17ϰ)\x12\xaf\xe1۬t\xfb\xd9\xf5Eo1\xa3\xed8\"\a\xa0i\x93\xd6\xfc\xe7\x83H\xa9~z\rE\x9eB\xf6ch\x938\x9a8\xaa)\xcd=7\xa5\xc9]Y|\x0e\b\xc3\xfe\xa87\x90\xe1=\xbe\x13Y\xdf7\x9f\xb4\x88\xcaA\xd3\xe5\xcbq\xfb_\x8c\x8e\xca2\x8dQ\xa8\x1d\xa2ӽ\x8d\xa43x\xb2)@)[\xb2\xb4\xa4Y\x8b\xca\x1aX\xaa\x91\x89\x89\x80,\xdbTα\x14\xa7{\xbb\x85\xd3*q+\xe8\f\uec8e\xa2\xa9\xc4\b\xc3. \xb2\x8b\x89\xb6\rpk/X\xcc9\xbf\xa3kO\xab<\xee\x1ck6\x92\xfc\x96\x02\x01\xb7\xae̚\u007f\n\xd7{q\xfd\xa6[\x00\xd9a\xbcnM\xf2b\xc7Dܙ\xa8\xb6wA+\xb3\xe8\xb6[\x13#\xe5\xd5\x19\xa1\xe4\x0eV6\x80\x92rW\x98ڃ\xc0\xee\x96\ue0bf\x03\x1b\xaa`\xdf\xeb^\xf8~\x93\xf5\x1d\xec\xb0\x06\xb5\x96k\xbe\xe7\x1d\xc0\xb8n\xf3C\xe5ȫ\x96\xea\x1a\x06ﺏwx\xeb\x0e\xb8\xfd=F\x0e\x9cv\x85@\t\x86\x9c\xec\xf6\x9b\xb9\x1bm\r\x9b\x85\n\xae\x16\xac\xc0T\xd7\x1d\xb3V\x80\xb4\xe7\xb0]\xb5\x92\xb4\xc0-E]\xf13r-\xb4\xf9\x9f\xcb\a\xa6\xb4\xda\xd3^\xe1\x8d\x00u-4>\xdb\v%vR\a\"\xc4>\x8c\x04\xca-o\xc3T\x12\x84_-\xefj\xe6\xda\xed\xd9\xf5\xedX\x04S\xe4\x8a\x1b&\xe3V^\xa5\x82)\a\xbc\x99Å\x1c\xc9C\xdf\x01\xb4\xda4\xa6<*\x85l\xe1kˇv\xc0\x9c\x02q\x9fG\x1b\xae}\a\xc3s\x8b\x8c&\x90\xfa\n\xf2\xd4\xe0\x82j\x98\xb3\x84\xe4 \xe7\xbb\xe6Y\x18>\xb5}\xeb\xf6\xba\xc1\x0e\x12v\xfb\x8a\xa6w\xd0\xfd\xdeh\xf7\xf6F\v\xae\x8e\xdf\xe3\x05\u05f9zߥ\x87f\x93=\xfci\x0f~6\xef\f\xfbQw\xd1\xd2\xc2P\xf6\xff\x18v\x8a\x84\U000bf920L\xaa1\xb9p\x99\x04\x9d\xdfl>\xef$\x8f&h\x03\x95)bp\xbe\xa4\x19\xd8⨔\x13\xb0\xa5':A\x8a\xd9ƍf\x14m\xa1,\x17\xaf\\\"Gw\xb0::k\x9d\xbcm\x01lGW\xfc\xa8\x8a\xb2o\x9f\x03\u007f\xcf\xd8\"\xfcG\xf8oG\xe3\x8dK\xb0\x13\xec\u038bq\aEl\xfd\xa7J\xd2}g\x03k\xd6\xf7\xf90Z\xd8A\a\x1bU\xe2\x9a_k\x11BS,m\x89\U0001b7e3r\x0e\xbaK\xd8w\xb2*\xba\xd9\xc7䂯6\xa0v\x173\xa9T\xa4\x8a\xa2\x8aVs\x11l憾\xfb\x06 \x176\x83\xbd\xbe\xcc\xcf\xeb{\xb2\x15\xe9x\xd1\xc9%\\\x8b\x14&BnF\x18\xb4=\r\xebOwh\x85\x8d\xa5\x8b,Ři|tc\xa1(\x87:\x19\xf4qT8\xf7\xdd\xc9\xc7ݫ\xf8P=\xb6{\xfa\x14\xab\xe6\xbbݘ|ܲ\x02\xc5i\xa1\x16B\x93\x13\x9fޛd\xa2L]v\xb3\xdc\xf0QD\xaeM%\vH\xcb\f\xbaZrm\x94\xc9\xf3\x0fz)\xac\xe4\xec\xefe\xbb\xc1\xa4\xb7ܸ\xa77\t\xbb\xc6C\xa5\x966l\x8a\x86\x9d\xfc\x19\xf7\xce\u007f\xc7\xe9c\x0e\xae\xa1\xd8.\x8b@\x05\xd0ҶP\x1a\xf3H\xb8n\x14\x86\xf2\xea[\xe2\x8a\xf4\xbbǙ\xaaf\xdbM\xde\x1b\x87\xbe\xeb\xba\x1b9\xe8k\x9e\xd8\xce\x03b#\xa4\x9bow\xd1э\x8d\xa3Nh\xa1K\xe9۱\x96\x12{\xec\xd4m\a\xa8ǌCB\x03\xe86\xd1\xdb\xd9\u0098\xe0\xb7,\a\xa5i^\xec\xdc\xf9כ\xcf\x1b\xe4\n\x99\xaa\xba^CC\x8d\xae[\xd9lj\x91\xb4n\x98\x94\x8e\x1b\x90-\x10\x94\x85\f`H\t,\x81\x13\x97p\x81\xbe^\xab\xa2o\x80\xbcE\xd1_.\xd1\xc2\xed\xa1`d\xe7LH\x82=\xa9\xaai\xaf\x1f5\x9fV\x9bR\r\xa3\x8e\x84\xc3\x03\xceTǍ`\xbb8\xeeD\xe9\xa5m\xf4\x88wC\x82QFf+\xb3̾\xeb\xe3\xc7];\xcd{\x90@\xe6\xc0\rR;\xecaNh\xb4MT\f*\xddI\xac\x8c\x1a\xb7\xb6ߌ\xd1\xd5]\x83I\xac\xda\xe19\xfe6\x01\xcb<@\xe7[\xceDWB\xb1\x8b\xd5\xff\x00TmF\xbf\xb4\x96\xff\xb6\xf9\xa4\xd3\x03\xecʭ\x9aJmw)ۑ\x8fI\xe8 n7\x19\x81_=\xf0\xdc\x12R,\xa8\xda\xcd\xe6&扪\xb5M\xe3\xb8U\x1c\xeeC\xe7\\\x80\x97\xf9:\xe0\x11\xb9\x86\xfb\x8d\xdf\xde\"A\u007ft\xed=7\x0eɈ\\\xf1\x89\x14s\xb9Yer\xe4\x0f\xcc\x06\x15\x8cȄJ\xcdh\x96\xad\xdev\xf5\x94\xf0_=\x18On\x02{nm\xfbP-\xee1nO\x94\xa1B:\xf5=R\xdd\xe6\x1d\xab\x9aF76\xd2\u007fpl\x948\xf0J=k\x83Đ\x1d\xa5G0\x9b\t\xa9\xadp9\x1a\x116s<p\xf3\"\xa5,C\xa1\xc9:{\bӵ\x8aU]\x1aB\x12\xcaWD\"1\x9ea\xb30\xba\xb2Fe\x9a$\xa59t\xe7J\xd3\xcd\xdb!\xda\x18\x85J\x99#\xa3N\x9d\xa9m\xedl>]\xf5t\xae*0 0\x8b0\xdb)5\xb1\xce\xdaN\xe1\x1a\xf3\x99+iO\x99\xd3ӥz\xed.\x1a\x80\xf9eW\xdb\x14˶[\xa8z\xd4O\xdc&\xa7mL_4\xc5\xd0m&4\xa6\xfc\x8bf\x87\x92\x05V\xed\xd2\v)\xcayՐw\x1b\x1b\xec6\xa6\x95\x98.Yd\xe5ܐ\xaf3p\xe9R\xf2\x86V\xe0L^i=\xd5\xed w!n\xab\xac\xacZw\xd4n!\xac\xf5職\xb0\xb9u;\xb8\xbbM\b\xfe\f\xef\xcfe\xc5\x1a/\xf7ߤ\x1f\xd7\x1e^s\x05\x98;\xb5\x86\xe7\xef\xbf\x13\xb6\xe9\x04B\xababf\xbb\xde3\xff\x99L\xfa\xf7Tr\xc6绗\xfb\xa3{\xa8Ctp\xef?\x9d\xf0\xe0'\xd8\x16\x1f6@Z\n\x0f\x15\x1f:N\xc7\xdaOK\x90ʚ\x0f_\xd6\u007f!\xb6\xac\xef\xd3\xfd\x03\xb1Ԝ6p\xef\xa6\xe2~\xa9\xa5o\x9b\xdd\xef|m\x16\xedw\x8c\xa7\xaf|\x04Y\x91\x95\x92f\xee\xcfDp\xcb\x11\xd4+\xf2\xd3\xcf_\x11\x87\x81\x8f~\x1e\xe6\xc7\xff\x1f\x00\x00\xff\xff=C\x02\xc8\xfa\xd8\x01\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec<Ko$\xb7\xd1w\xfd\x8a\x82\xbe\xc3~\x014#/|\tt[ked\xe0\xcdz\xb1+\xeb\x12\xe4\xc0\xe9\xae\xd10\xea&;${\xa4I\x90\xff\x1eT\x91\xfd\x9c~\xb0e\xc9p\f\xf1$q\xc8b\xb1\xde,V\xf3l\xb5Z\x9d\x89Bޡ\xb1R\xab+\x10\x85\xc4'\x87\x8a\xfe\xb3\xeb\x87?۵ԗ\x87\xf7[t\xe2\xfdكT\xe9\x15\\\x97\xd6\xe9\xfc+Z]\x9a\x04?\xe2N*\xe9\xa4Vg9:\x91\n'\xae\xce\x00\x84R\xda\t\xea\xb6\xf4/@\xa2\x953:\xcbЬ\xeeQ\xad\x1f\xca-nK\x99\xa5hx\x85j\xfd\xc3w\xeb\xef\xd7ߝ\x01$\x06y\xfa\xad\xcc\xd1:\x91\x17W\xa0\xca,;\x03P\"\xc7+\xb0\xc9\x1e\xd32C\xbb>`\x86F\xaf\xa5>\xb3\x05&\xb4ڽ\xd1eq\x05\xcd\x0f~R\xc0\xc4\xef\xe2[\x98\xcf]\x99\xb4\xee\xa7N\xf7'i\x1d\xffTd\xa5\x11Yk=\xee\xb5Rݗ\x990M\xff\x19@aТ9\xe0/\xeaA\xe9G\xf5\xa3\xc4,\xb5W\xb0\x13\x99\xa5\x9fm\xa2\v\xbc\x82τI!\x12L\xcf\x00\x0e\"\x93)\xef\xd3\xe3\xa6\vT\x1f\xbel\xee\xbe'<r\xe1;\x01R\xb4\x89\x91\x05\x8f\xabQ\x04iA\xc0\x1do\x12L`\a\xb8\xbdp`\x90qQ\x8eF\x14\x06W\x15\x96)h\x13`\x02\x14h\xa4Ne\x02?\x88\xe4\xa1,\xfcT\xbb\xd7e\x96\xc2\x16\xc1\x94j\x1d\xc6\x16F\x17h\x9c\xacHH\xad%5u_\x0f\xd3w\xb4\x15?\x06R\x92\x13\xb4\xe0\xf6\b\x81ۘ2\xf5r\x01z\an/m\x837\x93\xa4\x05\x16h\x88P\xa0\xb7\xff\xc0ĭ\xe1\x1b\xd1\xd9\xd8\n\xdbD\xab\x03\x1a\xdaw\xa2\xef\x95\xfcW\rقӼd&\x1c\x06\x8eVM*\x87F\x89\x8c\x98P\xe2\x05\b\x95B.\x8e`\x90րR\xb5\xa0\xf1\x10\xbb\x86\xbfj\x83 \xd5N_\xc1\u07b9\xc2^]^\xdeKW\xe9I\xa2\xf3\xbcT\xd2\x1d/Y\xda\xe5\xb6t\xda\xd8\xcb\x14\x0f\x98]Zy\xbf\x12&\xd9K\x87\x89+\r^\x8aB\xae\x18q\xc5j\xb2\xce\xd3\xff\xab\xb8hߵ0uG\x12\x1b\xeb\x8cT\xf7u7\v\xf1(\xddI\x96\xbdx\xf8i\x1e\xff\x86\xbc\xd4ET\xf9z\xf3\xed\xb6-:\xd2vi\xce\xd4nISCx\"\x94T;4\x9eq;\xa3s\x86\x88*-\xb4T\x8e\xffI2\x89\xaaKt[ns\xe9\x88\xd3\xff,\xd1:\xe2\xcf\x1a\xae\xd9Z\x90̕E*\x1c\xa6k\xd8(\xb8\x169f\xd7\xc2⫓\x9d(lWD\xd2y·\x8d\\w\xa0\xa7V\xdd]\x19\xa3A\x0eU:\xfc\xad\xc0\xa4\xa3\x1a4K\xeed\xc2\n\x00;m\x1a\x15\xb7m\x8b5\xae\x97\xbcv\x18\xda흴#\xd7F+\xc0'\xe2t\xa3\xaf$'\x8f{T\xa4E\xa6T\x84a\x0f\"\x04\xe3\xb1\xee\xf5\x0fҎ\u007f\xc0\xbc e\x9cD\xed6\f\"Ԉ*i\xedd\xbc\xa9\xc0\xdad\xe9`\xa9@\x0fcW\x18}\x90i\xb0\a=\xeaMQУ\xb4\x13e\xe6\xeetV\xe6ho\xf5W\xb4N&\xa7\xe3z\xc8\u007f\x1c\x9cVq\x16-Q\xd4\xedѐ\xe2\xf1\x0fl\xc3\x06\xa0\x02k\x84Ŕ\x8d\x98x@\x10\xb0\xf5\xfb&k\x98eP\xe8\x14\x0e~\x1d\xd8\x1e+\x84\xfb\xbch\xf8\xb1\xd5:C\xa1N~ǧ$+SLk\xdf4@\x8d\xde.oN\xa6\xb0\x8b\x17R\x914\x91C%$U\xf3+y\x97\xc1M\n\x83@\xea/\x95\x87\b\x92Y\x19\xb6:\xb4\x19\xe90\x1f\xc4pB\xee|\xa3\x10Bl3\xbc\x02g\xcaSi\xa9\xe6\vc\xc4q\x94JU\xe8\x13O\xa4zF0ʙL\x90\xc8S\x9b^\xa6\xd3\x1f\x80D{\xad\x1f\xe6\xc9\xf2\x17\x1aո\x15H8\xa2\x84-\xee\xc5Ajc\xfb\x91\b>aR:\x1c\xd6\x11\xe1 \x95\xbb\x1d\x1a\x82T\xec\x85E[\x19\x89q\xf2L\xa9=53\xc5\xe2\x93\xfd4\xec%F1\rƶ\xc0\xe6t\x04&0\xc2ds\xcb\x02\xa4J\xe5A\xa6\xa5\xc8@*\xeb\x84J\xfc\xbeD\x8d\xdbо`\x9a\xf5'\x98{3Z\xe1O|\xe9x$\xad\x10\xb4\x81\x9c\xdc\xef\xe9P;\xba\x06\x8cn\u007f+Ȟyc\r\x86\xe2\xe9\xb0X\xcaή\xb1\x17\x17\x13\xc0k\xee\xf8\xa0-\x13[\xcc\xc0b\x86\x89\xd3f\x8c,\xf3L\xf7-\xce\x16\x8e\xd0s\xc0*6v\x9f\xb6\xdclp\x12(\x90\xc9\u007f\xdc\xcbd\xef\xe3+\x92)\x86\x04\xa9F˶@\x14Ev\x1c\xdf,\xccKBXh\xda\x1c4m\xd60\xf4a\x0e\x9b\x88\xa6E\xd9Ӧ\xcdX\xd6.\x9dk\x11y#s\xe54\x9e%Л\x93\xc9/-\xd0D`Ig\xab\xcd\x0e0/\xdc\xf1\x02\xa4\xabz\xe7aR\x18\xd4\xe0\xf0\x87`\xd4s\xf4aӟ\xfb\xc2\xfa\xf0\x02\\\xaaQ\xf8\x9ff\x12;\x9bo\xc1\xd7,`Ч\xf6\xbc\v\x90\xbb\x9aA\xe9\x05\xecd\xe6\x90\xe3\x9ei\x14[\xaeo\x96S/E\x968\xafI-\x17.\xd9\xdf\xd4G\xc8\xd9\xf1=\n\xf5\xa7\xfbX\xb9:It\x9d\xfc,d\xe0\f\x834\x98\xfb\xbc\xc5-\xeb@\xd3Ñڇ\xcf\x1f1\x9d&\x14\xc4J\xe4\xc9v>\xf4Pn/\x1f\x8e\x01\xf1\x9b\t\x01U}\xc2\xf2\xf9\xa8\v\x10\xf0\x80G\x1f\x05\t\x05\xc4(AK\x8d\x1e$N\x89ĉ16\x11\x0fxd@!\xd7\x151?^4|{\xc0c\xdc\xc0\x1e)\t\xb3\x90\t\xf04\xa5\x0e&\b\xa7L\x96\x90\x118s\xc9\x1a\x02N\xc7l\x12\x96\x98\x9b\xaaU\x9cx\xd6vk6vҸ\x0fx|g=\xc3H;\xf6\xb2\x88\xde0\x19`\xb0\xc8zTe2\xefD&\xd3z)\xaf\x0f\x1b5\x15uw\xdbg\xed6\xea\x02n\x9e\xa4%\xf4T\n\x1f5\xda\xcf\xdaqϫ\x11֣\xff,\xb2\xfa\xa9\xaczʛy\xa2G;A\x1a%\xf4\xbem\xfc\t\xb3f\x95\xb4\xb0QtV\nt\xe147Ì\x17KF)/-gB\x95V+v\xb4끵\xa2a\x06\xf6h\xd3\xe1N\x1b\xbdֲ\xd1P\xe9@\xe7Q\xbb%\xdf\xe3!\xf8\xf4}&\x12L!-\x99\xa8\"\x1a\xa2uF8\xbc\x97\t\xe4h\xee\x11\n\xf2\x05\xb1܈\xb6Ͼ-\x96\xb9\xd8Рj\xc1Ч1(\xadH\xaf\xa3\xc6U\xec\x8f\x18<\x98\x8f\x9e\x1e\x1c\xb37v\xd0\x1c\xc7DP[\xa4)'lE\xf6e\x91\x97Xĝ\xd3\xf8\xc1\xa3\xe7\xfdk.8Q\xfaor\x91,\xec\xff\x81BH\x13\xa5\xe5\x1f\xf8\x8a/\xc3\xce\xec\x90uk/DkH\v\xc4\xf1\x83\xc8\xfa\xb7\x1d#[\xd4d\x810\xf3\xa1\x80ޝD>\x17\xf0\xb8\xd7\xd6{\xe4\x9dđ\xe4p\xb7I\v\xe7\x0fx<\xbf8\xb1K\xe7\x1bu\xeeC\x84\xbe\xd6G\x80\xad#\x0e\xad\xb2#\x9c\xf3\xec\xf3_\x17NEKg\xe4@\xbe\x0f\x8e\x0f\xc4\xe9$[E\x134\xb5\xbe|\xa4\x10z\x1a\xfbH\xd9,\xb4u\v\x10\xfa\xa2\xad\xf3\x19\xd1N\xc0\xbb,\xdf\x06^\xaeB\x9e\r\xc4Ρ\x01봩\xae\xfa\xc8H\xf6\xd2\xc6\xc4E;w\xe0 \xc6\xd6\xd9;\x0f\x96\x0es\xe7\x8d~{\xfb{\xee\xef\x00\xe9\xef9\x88\t\a0\f\xb90:Ak\xe7\xc4&\xca\xc2\xcf$6뤦\xf0\x87%\xbe[\x9b\x15ֹdkՖ\x84\xc2D\xce\xc5ǉ\x9b\xa7V^\x96\xcc\a\xfd?/\xb2˱\x03\xd6\xfa<\x17*ʁ\x9d z\xed\xe7V*\x16@\xf9#\x8a\xb9/\xd9\\,\x89\\\x83\xf0\xfd~\x82\x81\\\xaa\r/\x02\xef_%|\xa8\x8d.>\xef\xf8p]\xcdnXPw\f_\x92\x8e\xb5B\xf3}\x85\xc1\x0e'O\xb3\xfa\x8b\xc2f\xa5];\xf5A\x90\v\x9d\xbe\xb3\xb0\x93ƺ\x06\xd9h\x98\xd2\xf2%\xe9\xeb\x9d\xe5ԍ1\xcf<\xca\xfd\xec綒q{\xfdX_\xe8\x8f_\xfc\x0e5\xbe\x1eC\x90;\x90\x0eP%\xbaT\x9c4\"c\xc0\x8bxv\xc4\v2\xc4\xfa\xbd\xa6\xa1*\xf3XB\xacX\x12\xa5\x9a\xc9/\xb5'\xfc(d\xf6Zlt2G]\xce8\xe6\xa6u\xeb\x11\xfc\xdcN\xa5F.\x9ed^\xe6 rbD4\xc9)ڐ9ve\x00\x1e\x85t\xec\x91\b2\xbb'\xa7\xa3A&:/2t\b[\xdci\xc3\xfane\x8a\xb5\xeb\x0fr\xa1\xc7\xef\x1d\xfbM\xc0NȬ4\xd1Vw!7\x96\x9d\x90\x82\xe1yكO,\n+&_T:::\xa4\x9d\xf3\x04\x85Y\x12\xd0~1\xf8\xd2\xe1ca$ɢ\x9e\x8b g r|ٍ \x83\x88\nu\x1c\v!g`2\x16o!\xe4[\b\x19\a\xf7-\x84|\v!\xe7\xdb[\b\xf9\x16B\xc6Lx\v!\xdfB\xc8\xe5(\xfc\xf6!\xe4<f+\xce}\x8e\xfe\x1c\x81MT\t\xc14\xb2\x93\xab\x84j\x98무\x0eM|\xcd\xedfx\xde@\xfdu⇬\xf8Üa\xd9h\xca-\x1a\x97U\x17ᒲU\x8a\xe2\xeb\xf5g\xa3\xe3\xc8\xe2ڱ:\xed\xb8R\xae\xb9\x02\xaen\rr]<U\x15!\x0f[\x8d\xb0t\xe0\x96\xff\xe2\xa3]\rԭ\xc3\xe2ȼ\xc2\xf6wT\x9f\x1cUb5SX5]\u00ad\xab5F\xa8\xd8I\xc0w\xc9g:eĿC\xea\xcd\xd6>\x8dW<\x85\x1b9t\xe2\xf0~\xdd\xfd\xc5\xe9P\xff\x04\x8f\xd2\xed\a7\xc5ߠ\xd0qQݷ\v\xa3+Y\f\xdfx\xf5\xa9\nڀ\x92\xd9pM\x03\x11\xbc\x9a\xdf!7\xfc\\\xf8C\xe9\xb3\xf4w\xee\x98\x14[#\xf5\xecʨn\xddӨ\x81_~\x81\xb7\xa4\xac<\xbe\xf6i\xaeTiI\xc5S\xbb\x9ai\x02dl\x9dS܉w\xb6\xa6\xe9\x19\x95L\xd1u\x95/r]\x19W\xab\xf4\x1a\x15J\v꒺\xf5F3p\x97U#E\x92)\xa6\xf2hq\xbdQ\xa8\xed\x99\xd9OD\x95\xd1h\xf5\xd0\f\xe8\x81:\xa6\xf9\x9a\xa19\xf2wPy\x91J\xa1g\xd4\a\xbdl\x1d\xf1KE\xddS\xd5>\x115>\x11q\xf9\x1c\xa6\x11U<\xcbjw\"h\xf8\xcc:\x9d\xba\ngt\xed\xa5\xd59\xddڛQ\xb0159#\x157\xa30'+qb\xeblF\xa1Ϻ\xef\x19ə\xfcy\xe8\x9b\xe7\xaa\xcdy\xc9췕\xb4_\xb3MmR43g\x83x\x84g\x90\xed\xe6\xf8z+\xf7>ި>\xfa\xa5Q\xed3ǰ8\xe8\xfaӂ\x04~\x92*\xf5RD\xaaЊ>\xf8\x85\x00.\u007f\xaaC!V\xc6a\x1e\x86H\xb3wֱX\b2\xcb)l\x8f>\xc3b\xd7p#\x92}w\xe0 Ƚ\xb0t~΅\x83\xf3\xfa\xd8xYͣ\x9e\xf35\xc0\x8f\xba>\xa5\xb7?\xfa\xb32/\xb2a\xebVZ\x84\xf3.\x98矂F\xe4\xc4*Qؽ\xae\xbe\xf0\x9e=\b}\xeb\x8e\x1f\xc8DT\xdfw'\x99.\xd3\x1a\xfe({\x85:\u0097;\x8e\xb5\xf8\xbbפ\xf9\"8DS\xd5ɦ\xff\xc1\xf0\xf0\xc7\xfa\vH2\x96\x99\xb0N\x1bq\x8f\x9ft\xd2z\xcdd\x8a&\xdd\xf1\x9d\xc7*\x82-\xacr\x8f\xa1Ho\xf8\x14\x17v\xd4\a\xd7\\9\x04\xddi\xd27\x84鰙\x9c\xd4X\xe7\xb2\xd9M\xdd\xde~\xf2\x1bq2\xc7\xf5\xc7\xd2g\x85V\x850\x16\x89\xb6\xd5\x06\xfd\xa4\xed\x98\x15\xdb\xebG\xc8t\xd8\xfd\x0f}\xfc\r\xf2\xe5\x06\xa7\x9f\x16\xef\xc2?\x16P\tdE\xaey\x11\xbe\x1b\x9e\xd7:\x88\xb6\x98\xc6\xfa<&\xbbc\x90\x84\xb5:\x91lM\x1e\xa5\v_5\xbd\xc6\xf7\xed\xe3\xf1Ѩҗ\x16\u007f~Th\xbeV\xeaf7\xca\xf3e\xf2\x15\x8b_N\xa6U\xcc\x1c2\x00d\xb9z\xc3O=\x94\n$\xb1\xfe}$oz\x99T\xd5k\x1e}bM\xea\xf5\x98N\x0fE\xb2\xab\xa1'4V\xf5{\x1eg3t\xb4N\xb8\xd2\xce?\x88\xc2\xc3 \x11\x85+Mp\u007fIi\xf8\xe3~\x02\x81\xfe\x1b\xf8\xe5O\xa2dº\b\x9e}\xaa\x875\xe7t\xeb\xfc}Kel\xe0QX~s\xc4߶\xb4\x88\u007fj\x16\x87\u007f\xf0\x9e\xef\nR\xe1pE\xb0\x973m@\xbe\xf9\xf1\x83\xc9\xdd}\xa1\x11\xf5\x9dn +O\xab\x9eL\x18\xd9\xc9Х\xdd\n>\xe3\xe3Iߍ\"\xc4\xfb\xd9t\u007f/\x87\xe9]\xfd\xdeU즚\x17\xb2\xf8\x1a\xf4D\xe7\xbbf\xaa7\xb8\x97\xab\x15Yւ\xe7\xaf<-\xfc\xbf<\xf5-\x9c\x80Ih'\u007f\xea\xfd6bx&\x8cΰ\xc1\x19P\x92^W\xf3&\xda\xfb\xe6?^z\x15\xde@;\xf8\xea\x01~t,m\xc9Jpơ\xa7\xd1<\x91$X\xb8p\x17\xd0~\f\xed\xfc\x9c\xff\xa9\xde:\xe3\u007f\x13\xad|\xa8k\xaf\xe0o\u007f?\x83\xe08\xef*<\xa8\xf3\xbf\x01\x00\x00\xff\xff*\xdd\xe8w?N\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VM\x8f\xe44\x13\xbe\xe7W\x94\xf6=\xec\xe5MzG{A\xb9\xa1\x06\xa4\x110\x1aM/sA\x1c\x1c\xa7\xd2mƱCU\xb9\x87\x06\xf1ߑ\xed\xa4?\xd2\xe9\x9d\xdd\x03\xbe\xa5\\\x1f\x8f\x9f\xfaH\x15eY\x16j0\xcfHl\xbc\xabA\r\x06\xff\x14t\U0004bad7o\xb82~\xb5\xbfkP\xd4]\xf1b\\[\xc3:\xb0\xf8\xfe\t\xd9\a\xd2\xf8\x1dv\xc6\x191\xde\x15=\x8aj\x95\xa8\xba\x00P\xceyQQ\xcc\xf1\x13@{'\xe4\xadE*\xb7誗\xd0`\x13\x8cm\x91R\x84)\xfe\xfeC\xf5\xb1\xfaP\x00h\xc2d\xfe\xc9\xf4Ȣ\xfa\xa1\x06\x17\xac-\x00\x9c\xea\xb1\x06F\x8aF\xa2$0\xe1\x1f\x01Y\xb8ڣE\xf2\x95\xf1\x05\x0f\xa8c\xe0-\xf90\xd4p\xba\xc8\xf6#\xa8\xfc\xa0Mr\xb5I\xae\x9e\xb2\xabtk\rˏ\xb74~2\xa3\xd6`\x03)\xbb\f()\xf0Γ<\x9c\x82\x96\xc0L\xf9Ƹm\xb0\x8a\x16\x8d\v\x80\x810]\xfc\xe2^\x9c\u007fu?\x18\xb4-\xd7\xd0)\xcbX\x00\xb0\xf6\x03\u0590\\\x0fJc\x1be\xa1\xa113c\xb8촆\xbf\xff)\x00\xf6ʚ6\xf1\x9a/\xfd\x80\xee\xdb\xc7\xfb\xe7\x8f\x1b\xbd\xc3^e!@\x8b\xac\xc9\fIo\xe9\xf1`\x18\x14\x8c@A<(\xad\x91\x19t B'cL0\xae\xf3ԧp\xa3c\x00\xd5\xf8  ;\x84甓\xf1\xe9ը0\x90\x1f\x90\xc4L\xe8\x93ɩ>\x8f\xb2\x19\xc6\xf7\xf1\x11Y\a\xdaX\x91\xc8)\xc6XW\xd8\x02\xa7\a\x82\xef@v\x86\x810\x91\xeb\xe4\x12]\xe2\xa4\x03\xe5\xc07\xbf\xa3\x96j|=\xc7,\x06\xdb\xc62\xde#\t\x10j\xbfu毣g\x8e4ĐV\xc9T@\xd31N\x90\x9c\xb2\x91\xfe\x80\xff\a\xe5Z\xe8\xd5\x01\bc\f\b\xee\xcc[R\xe1\n~\xf6\x84\x89\xc0\x1av\"\x03\u05eb\xd5\xd6\xc8ԑ\xda\xf7}pF\x0e\xab\xd4W\xa6\t\xe2\x89W-\xeeѮ\xd8lKEzg\x04\xb5\x04\u0095\x1aL\x99\x80\xbbԐU\xdf\xfe\xefX$\xefϐ\xca!\xd6\x13\v\x19\xb7=\x8aS\x8f\xdc\xe4=\xf6G\xae\x86l\x96\xf1\x9f荢\xc8\xca\xd3\xf7\x9bO0\x05M)\xb8\xe4<\xb1}2\xe3\x13\xf1\x91(\xe3:\xa4\x9c\xb8\x8e|\x9f<\xa2k\ao\\\xae%m\r\xbaK\xd294\xbd\x11\x9e\xaa4槂u\x9aK\xd0 \x84\xa1U\x82m\x05\xf7\x0e֪G\xbbV\x8c\xff9\xed\x91a.#\xa5o\x13\u007f>N/\x153[G\xf14\xeb\x163\xb4н\x9b\x01u\xccY$.ښ\xce\xe8\xd4\x06\xd0y\x02\xb5dR\xbd\x89!O\x99\xafA1Έ\x8cc69b\x0f\xbe\x85ciT$\xf9N1^\x8afh\x1e\xa3\xc6<\xb25\x1dꃶ\x98\x1d\xe4I\x81o\x81\x88\a]\xe8\xe7\xf1Jx\xc0\xd7+\xd9#\xf98'Ӥ>?\x8b\xf9\x87\xfcs\xd9\x1aǟ\u007fM\xd6I\xbf\xab\xf3\x91{6jG7@\xc1\xb9ؑ\xdeE\xf1\xcc)\\N\xe4٭\x11\xec\xafp,\"\xb9w\x9dO\xbf{\x15C*\xc9}\x82cR\xc7\x18\x19ѕ\xbb[9\xcdg>\x8a\xbe\x80\xc0|\xd2\xca\xf0\xf5\x86qt\x18\u0085\x98e² \x8e\x91\xaeċ\x1d3\"\v֪\xc6b\rBan\x99\xed\x14\x91:\\V\xc5TF\xa7\xe5\xe8\xb3\x05r\xa5\x1ek\xffu\x87\xeeV\x85ë\xe2\xa5\xdcd7\xd0\x1cn\x19\xae\x8f[\u07bcIrY\xd6\x10\xa7n)报/ b!K\xb9T\x17\xb6\x83+\x126\xe7\x9aS\xef_\x14\xfc\xb4,̑\xdf\b\xbe\x90ԙ\xe8\xb4\xd4ޝ\xbeRa\x97\xe3\x12\x9b.\xc6W\xb4g/g\xf1\xa4\xb6\x13\x17\xa7\xd9\x1a\u05ecA\xb0}\x98\xaf\xb0\xef\xde]\xec\xa2\xe9S{ך\xbc\x81ï\xbf\x15\xd9+\xb6\xcf\x13\x8e(\xfc7\x00\x00\xff\xff\xad\x01\x9a\xeb\x00\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V\xc1\x8e\xdc6\f\xbd\xfb+\x88\xf4\x90K\xed\xc9\"\x97·`\xdb\x02A\xd3`\x91M\xe6R\xf4\xa0\x91\xe8\x19veI\x15)\xa7ۯ/$\xcb;\xe3ٙ\xa4E\x11\xdfDS\xe4\xe3\xe3#\xa1\xa6m\xdbF\x05\xdabd\xf2\xae\a\x15\b\xff\x12t\xf9\xc4\xdd\xc3\x0fܑ\xdfL7;\x14u\xd3<\x903=\xdc&\x16?~@\xf6)j\xfc\x11\ar$\xe4]3\xa2(\xa3D\xf5\r\x80r\u038b\xcaf\xceG\x00\xed\x9dDo-\xc6v\x8f\xae{H;\xdc%\xb2\x06cɰ\xe4\x9f^u\xaf\xbbW\r\x80\x8eX\xae\u007f\xa4\x11Y\xd4\x18zp\xc9\xda\x06\xc0\xa9\x11{\x98\xbcM#\xb2S\x81\x0f^\xac\xd7s\xb2nB\x8b\xd1w\xe4\x1b\x0e\xa8s\xee}\xf4)\xf4p\xfc1\x87\xa8\xb8暶%\xda}\x8d\xf6\xaeF+\x0e\x96X~\xf9\x82\xd3;b)\x8e\xc1\xa6\xa8\xecUdŇ\xc9\xed\x93U\xf1\x9aW\x03\x10\"2\xc6\t?\xb9\a\xe7?\xbb\x9f\t\xad\xe1\x1e\x06e\x19\x1b\x00\xd6>`\x0f\xefs\x05Ai4\r\xc0\xa4,\x99r\u007f\xae\xc9\ato\xee\xden_\xdf\xeb\x03\x8ej6\x02\x18d\x1d)\x14\xbf+\xc5\x001(X\xd0\xc0\xe7\x03F\x84ma\x0eX|D\xae\xc0kH\x80\xa5\x02\xee\xaa)D\x1f0\n-\x04\xe7\xefDaO\xb63</3\xe0\xd9\aL\xd6\x142\xc8\x01\xa1*\x03\rp)\x06\xfc\x00r \x86\x88\x85)'\xc7V-\x9f\x1f@9\xf0\xbb?PK\a\xf7\x99\xcd\xc8\xc0\a\x9f\xac\xc9B\x9c0\nD\xd4~\xef\xe8\xef\xa7\xc8\f\xe2KJ\xab\x04kO\x97\x8f\x9c`t\xcaf\xaa\x13~\x0f\xca\x19\x18\xd5#D\xcc9 \xb9\x93hŅ;\xf8\xd5G\x04r\x83\xef\xe1 \x12\xb8\xdfl\xf6$\xcbLi?\x8eɑ<n\xcad\xd0.\x89\x8f\xbc18\xa1\xdd0\xed[\x15\xf5\x81\x04\xb5\xa4\x88\x1b\x15\xa8-\xc0ݬ\xf2\xd1|\x17\xeb\x00\xf2\xcb\x13\xa4\xf2\x98\xc5\xc1\x12\xc9\xed\x9f\xccE\xe2Wy\xcfڞ\xdb>_\x9b\xf1\x1f\xe9ͦ\xccʇ\x9f\xee?\u0092\xb4\xb4`\xcdya\xfbx\x8d\x8f\xc4g\xa2\xc8\r\x18\xe7\xc6\rя%\":\x13<9)\am\tݚtN\xbb\x91$w\xfaτ,\xb9?\x1dܖ\xcd\x02;\x84\x14\x8c\x124\x1d\xbcup\xabF\xb4\xb7\x8a\xf1\x9bӞ\x19\xe66S\xfau\xe2O\x17\xe2\xdaqf\xeb8DuU]\xec\xd0\xe5I\xbd\x0f\xa8W\x83\x92c\xd0@ur\a\x1fA\xadجS|9Zw\xe2zi\x80a\xde\xe0\x03\xed\xd76\x00eL\xd9\xfe\xca\xde]\xb9w\x95\x9e\v\xb5ޖ\x1cY\x8e\xb9\x80\x10\xfdD\x06c\xbb\xd4V1\xa4X\x8b,\xbb\xb1k.\xe5:c\xb8\x16V\u009d\xc3[!\xb8\xabN\x19C\xa6u\xb94\xef\x1d\xac\xeb\xaf,C\xb5\xc7˹\x9fՙ\x15L\x11WS\xd8>\x85\xfe\xaa:DI\xe2\xff\xaa\x8fr\xa9z\xee\xaaFt\x8a\x11\x9dԈ\xe0\x87\x15|\xf5\xff5\x12\x0e\x8a\xf1\x8b\xfc^\x8e}\x97\xef-\x94[\x1aP?j\x8bs\xb8\xb2Ο)\xea_C\xcd\x1f\xba4\x9e\xa3j\xe1ͤȪ\x9d\xc5g\u007f>9u\xe5ߕ\x06_\xe8ۙ\xe9\xf8¹9\x9e\n{\xed\U000a2e59\x9f\byk\x9a\x1e$\xa69y\x95Z\xb5\x1cŠ\xb4\xc6 hޟ?f^\xbcX\xbdG\xcaQ{7\xcf)\xf7\xf0\xdb\xef\xcd\x1c\x15\xcdv\xc1\x91\x8d\xff\x04\x00\x00\xff\xffJ\xbeWz\r\n\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec<]o\x1c9r\xef\xf3+\nʃ/\x80ftƽ\x04\xf3浽\x88p\x1b\xaf\xb0\xf6)\x0f\x87{\xe0t\xd7\xcc\xf0\xc4&\xfbH\xb6d%\xc8\x7f\x0f\xaaH\xf6\xf7\xd7\xc8\xdaM\x16g\xb5\x1f<\xddd\xb1X_\xac*\x16\xb9\xd9n\xb7\x1bQ\xca{\xb4N\x1a\xbd\aQJ\xfc\xeaQ\xd3/\xb7{\xf87\xb7\x93\xe6\xe6\xf1\xed\x01\xbdx\xbby\x90:\xdf\xc3\xfb\xcayS\xfc\x82\xceT6\xc3\x0fx\x94Zzi\xf4\xa6@/r\xe1\xc5~\x03 \xb46^\xd0kG?\x012\xa3\xbd5J\xa1ݞP\xef\x1e\xaa\x03\x1e*\xa9r\xb4<B\x1a\xff\xf1\x8f\xbb?\xed\xfe\xb8\x01\xc8,r\xf7/\xb2@\xe7EQ\xeeAWJm\x00\xb4(p\x0f\a\x91=T\xa5\xdb=\xa2Bkv\xd2l\\\x89\x19\x8du\xb2\xa6*\xf7\xd0|\b]\"\x1ea\x0e?po~\xa1\xa4\xf3\x7fn\xbd\xfcI:\xcf\x1fJUY\xa1\xea\x91\xf8\x9d\x93\xfaT)a\xd3\xdb\r@iѡ}Ŀ\xe8\am\x9e\xf4\x8f\x12U\xee\xf6p\x14\xca\xe1\x06\xc0e\xa6\xc4=|\x12\x05\xbaRd\x98o\x00\x1e\x85\x929\xcf.\xe0dJ\xd4\xef\xeen\xef\xff\xf49;c\xc1\xf4\xa3\xd79\xba\xccʒ\xdbE\xe4@:\x10p\xcfS\x03\x1bY\x00\xfe,<XdL\xb4w\xe0\xcf\b\x99(}e\x11\xcc\x11\xfe\\\x1d\xd0j\xf4\xe8\"`\x80LUΣ\x05\xe7\x85G\x10\x1e\x04\x94Fj\x0fR\x83\x97\x05\xc2\x1f\xde\xdd݂9\xfc\x1d3\xef@\xe8\x1c\x84s&\x93\xc2c\x0e\x8fFU\x05\x86\xbe\xff\xba\x8b0KkJ\xb4^&:\xd3\xd3\x12\xac\xfa]oZohޡ\r\xe4$J\x18\xd0\x7f\f\xef0\a\xc74\xa1y\xf8\xb3t\xcd4\x99~-\xb0@M\x84\x8eH\xef\xe031\xc5:pgS\xa9\x9c\xe4\xef\x11-\x91)3'-\xff\xab\x86\xec\xc0\x1b\x1eR\t\x8f\xcew J\xed\xd1j\xa1\x88c\x15^3!\n\xf1\f\x16\x890P\xe9\x164n\xe2v\xf0\x1f\xc6\"H}4{8{_\xba\xfd\xcd\xcdI\xfa\xa4J\x99)\x8aJK\xff|\xc3\n!\x0f\x957\xd6\xdd\xe4\xf8\x88\xea\xc6\xc9\xd3V\xd8\xec,=fļ\x1bQ\xca-#\xaei\xb2nW\xe4\xff\x92\x98\xee\u07b40\xf5\xcf$c\xce[\xa9O\xf5k\x96\xf4I\xba\x93\xc8\ai\n\xdd\xc2\x14\x1b\xf2J}b\xaa\xfc\xf2\xf1\U000d7da4\xc9F\x88\xe8\t\xd4n\xba\xb9\x86\xf0D(\xa9\x8fh\xb9\x17\x1c\xad)\x18\"\xea<\xc8\x1a\xfdȔD\xdd%\xba\xab\x0e\x85\xf4\xc4\xe9\x7fT\xe8H\x9c\xcd\x0e\u07b3A\x81\x03BU\xe6$\x85;\xb8\xd5\xf0^\x14\xa8\xde\v\x87\xbf:ى\xc2nK$]&|\xdb\x0e\xa6?꿏Ԫ_'\x8b5ʡ\xa0\xf0\x9fK\xcc:\x8aA}\xe4Qf,\xfep4\xb6\xb1\a\xc1$%\x85\x9cRJzr<\x8aJ\xf9{Vd\xf7\xc5\xfc\x82\xce\xcb\x0e*\x03t>\x8cvI蠃\xa73\xfa3Z\x92\x15\xfe\xc0j׃\b\xcc@\x879\xeb\x9cx@\x10\x11kV^\xa5\xa04ɾ88<'D\xdbsj\xa8y0F\xa1Нo\xf85SU\x8eymo\xdd\xec\xac>\x0e\x9a\x93\xa1\xf0Bj\xd2\fZ\x1a\b1\xdd|eS+,\xf6\x80\x02\x90tJ\x1d\xa0\xb1\x15=\xe3\bC\xe8\x9f\xf4X\f\xb0\x9a\x10\xa5\b\xbbRJ\x1c\x14\xee\xc1۪?t\xe8'\xac\x15ϣ\x94H\v\xf5:Bԭ\xa3mP2\xe35\xa4\xb6\x00L\x8b\xdf\x11\x19\xce\xc6<\xccO\xfdߩEc\xc1 c\xff\x06\x0ex\x16\x8f\xd2\xd8\xc8\xf3\xb8\x8c\x1c\x10\xf0+f\x95煼\xfb\b\x0f\xb9<\x1eѢ\xf6P\x9e\x85CG\xa4\x9b&\xc1\x94zғ\b>\xf2\xa9\x87\x7f\xc32a1\xccw\neRR\xcdb9\xa4nx\xaa\x12\xa4\xce\xe5\xa3\xcc+\xa1@j\xe7\x85&Ф\x9e5N\xfdy̰s\x80m0k\tg\xa2}\xc7\xc4\x19\x8d`,\x14\xb4\x88\x0e\x9b\xba\xcd\bx\x80\xc9\xe9\x1e\x04\xd9\x1a\x13\xc4\xd0V\n]\x1c(g\xcb\xd9\xe8\xf5\xf5\x04\xe0\x9a\va\xedW\xe2\x80\n\x1c*̼\xb1cd\x98g\xeaZ\x1b5A\xbb\x11k\xd5\xd8_\x9ab\xdbP\x99I\x98\x00Og\x99\x9dòL\xf2\xc2V\x1cr\x83\x8e͘(K\xf5<>\xb9\x05N/\xaa\xf0Je^V\xeb!5\x93\x9c\\J̺_k-#Z֬\xff\xe7!\xa5\xd4}\xf9ZI\xcb\xdbA\xc7\xd7\x14L\"\xa2D\xb7\x83\xdb#`Q\xfa\xe7k\x90>\xbd%OBp\\8\xf54c\xff\xee\x18q\xa9L\xdf\xf6\xfb\xbd\xa2L\x7f#\x17\xea\xa1\x7f7L`c\xff9\xda\xfa\x95\f\xf8\xa9\xdd\xe7\x1a\xe4\xb1f@~\rG\xa9<\xda\x1e'&\xe1\x02I\xf6,'\xbe\x95\x04\xcb+\x15=\x85\xf0\xd9\xf9\xe3WJ.\xb8&\x9d\xb3\x8a\x1a\xfd\xae \xdb^uw1\x9d\x85J\xee\xd0?*i\xb1\b!\xe6\x973vް\xe7\xf3\xee\xd3\a̧\xa5k\x95\x84\r\xa6\xf0\xae\x87f{\xd8\xe8\"\xaf\x9b@tR\xea\xe8\x82\xc3mw\r\x02\x1e\xf09x\x17\x94\xbc(\xd1\n\x1a\x86\x1a/B\xb4\xc89\vV\xed\a|f 1\r\xb1\xd0w\x1d\xebc\x1e\x01\x9f\x97\x1b\xf5\xc8F\xd8H\x17\xd3*\xc4fzAs\xe2W+y\x1e\xbd\xea\xda\xc2\xcc\xf3\xf6\x02\x13\x91\x9eD틧W\xb3\xa9\xc9{\x04F\xbe\xa1\xb4\x85\xe2\xd8ܝe\xb9\x02.\xab9I\x11\xebDJ\"\xddS\x86\xb0\xc6/x\xf6\xb7\xfa\x1a>\x19\x7f\xab\xaf7+\xa0\xc2ǯ\xd2\xc5\xdc\xdd\a\x83\xee\x93\xf1\xfc\xe6Չ\x18P\xbe\x98\x84\xa1\x1b\xab\x90\x0ef\x98\xe6\xdf\xceE-\nq\xf8w{d\x99\xaaY\"\x1de\x86\x8c\x8d\xb4\xe2\x8fq\xb09k\xdf\xfd+*\xe7)\x92\xd0Foy\xb1ۍ\x8d\x13I\xbcR\x90\xdb\\\x18\xa2U\x0f\x19\x86[\x05\xf1\v\xf9I\xa1wȌ*J0C^1\x119\xb3'<\x9ed\x06\x05\xda\x13n\x16\xc0\xf1\xbf\x92l\xf6\x9a\xe1W\xd9\xd2\x17\xc8Ӛ\xa59\xfdEc\xdcIs\x8e=[\xd2\xcd\xc56\x89\xb5\v\rGSy/\x9f\a/\x92\xec7,PS\xe49\xef\xb3\bu\xb7\xdaz\xaf\xa6|G7[(\xb1\x82B!J\xd2\xce\xff\xa6\xa5\x8au\xe9\x7f\xa0\x14\xd2.j\xe8;\xde0Q\xd8\xe9\x19\xb3B\xedA\b\xbet@\xdc|\x14\xaa\x9f\x10\x1e\xfe\x91\xc9Ԁ\x8a\xfd\x01¬\xefi\\\xc3\xd3\xd98$\xb6Ñvd\xa0\x97\xb7\x1e>W\x0f\xf8|u=\xd0\xf1\xab[}\x15\x96\xe7\x81Ʀ\xb5|\x01\xb0\xd1\xea\x19\xae\xb8\xe7\xd5\xcb]\x97UR\xb7\xa2\x11EC\xfb\xcd*1\xa000\xad\xe2ԭރ\xa1\xd0l\xb7\xf9\x06\x99+\x8d\xf3+\x91\xb83\xces\xea\xa7\xeb<\x8e\xe4\x86\xe6c\x9a\x98\x13\x02q\f\xfb^Ʀ\x1d\x0e2d\xbdT%q\xc9\xe1h\x82s\x001\x8f \x85Rp\xd5\xe8h\x88\xed\xaf¶\a\xfd\x1fDF_椅V\xf9Қ\f\x9d\x9b\x13\x87E\xcb\xdb!\xe0\x90Ru\xb2M\x84\xa0\x82Ra\xf3ɽK\xddF\"\xcd|\x8b\x1e\x92\x1f\xbf\xb6r\x80Bs\x8euA\xcc.È\x1e\xda\x04\x12\xdd=\xb1UȽ\x0f\xfd\x92*D0l\x13\x84=Ud\x83\x96l@\xd4\f\x93\x84\xe6\xffv\x81-\xa4\xbee\x19\x82\xb7\xaf\xba\x1cC\xda<\xc1\xcb]\xea\xf7\xa9gC\xe6\xfaE\xd0\xcd\xd2\xe4\x9bYx\xf1y:\xa3\xc5\x0e\xa7\x86\x99av\xe7(Aׄ\xe7\xab`G<\xde88J\xeb\xeap.`]\xcdj\xed\v\xb9e\xf4Gk_\x10\xa2\xfc\x1c\xfa\xd5\x13\xa4\x84\xdaS\xda)\x9c\u061c\x1b{x\x1b\x04)\x93!=\xa0\xceLE{\xe2\xec\xb5#\x0f\x10H\x1a\x8c\xe9\xe2\"\xdb\xecɬ!\x14\xea\xaaX3\xf1-K\x8f\xd43\xb9\x8e\xe6\xd9\u008fB\xaa\xcdb\xbb\xcb\xd8DE\x13\xa6\xf2\xfbņ=6Qy\x8b\xa9|m\xfbH\xc0\n\xf1U\x16U\x01\xa2 b\xaf\x80\b\xb4\"\x12\x06]\xfe\u0093\x90\x9e7:\b*\x11\x9db\xcd\xcc\x14\xa5B\xbf\x86T\xc4\xfd#\xed\xc4dF;\x99c\xbddF\x9e\x1b\r\x02\x8eB\xaa\xca\xe2\xeeu)\xba\u07b3\x8fJ\xbe\xd0n\x95\xfb\xb4n\xd8-\x1b\xf1\xcd7\x8e\xb5lUK\xbb\xd6Q\xbb\xb3\xf8\x9a.Ri%Ɍy]/)\x8a\x92\xd0\xcf\xdfݤ\xefn\xd2w7黛\xf4\xddM\xfa\xee&}w\x93\xbe\xbbI\xdf\xe2&\xcdc\xb2\xe5\u0083\xcd\vF_\xdcB\x9dFl\x12r\xdc\xd5\x7f\x1fj\xaf\x93\xab1X\xbb\xc6v\xf4\xfb}F\xea.cI\xf7\x96\v·|N~K]\x10}\xc0\xbà\x85?\t/o^\xf5<\xbd\xcd\x05ę\xae͔\x83*\x91\xfd沢\x92nMb]ؑ\x8a\x12M\x1a\xa2\a6\x95);\xceƵ+\x18(i\xd7ԇ\x90+[c\xb9۬\xf23f\x94u\x05\x99\x86\U000931bfH<V\x97mNS\xa8\xcb\xf0\x1e\x89\x1a\xe1\xf9\x7f@\xa1ٺ\x8c\xe9j\x8c@\x19\xaa\xcd~|\xbb\xeb~\xf1&\xd6f\xc0\x93\xf4\xe7\x1eD\xf6\x944PȢO\xed\xe2\xc8$SތR\x8e\xca\x18\xb5Tףu1\xa9o\x87\x9c\xf03\xe3-\xd4\xee\x122\u0379\xf6\xfdm\x91a\x8b\x1e\xc5\xfa\x1d\xe6*6\x92\xede\xc7~\xb7\x19ߠ\xbcd\xb3cB~\xbe\xa1&\xa3[s\xb1\x99\xdb\xc0\x9e\xadĸ\xb8\xd2b9ޚ\xad\xaaxA-E\xaa\x93\x98\x84\t\xb3\x15\x143J\x9a\x9eD\x91\x95h\xaf\xad\x91 \xb3-&A\xc2e\x95\x11\xad\xaa\x87ͺ\x9d\xf8o\"\xc9R\xedC\x87 k*\x1e\xfaU\x06\x93\x90a\xb1\xcea\xba\x86a\x06\xe8huÚʅ\x19\x98uM\xc3+\xd6+,T)\xccX\x92ռ\x9d^\x80\xd2ߒ\xef9Us\xb0Pi\xb0\xe0\x99\xcea\xd5\xdaS\x1fCj}\x05\xc1\x02}:r\xbd\xbeZ\xa0\xae\a\x18\x1d\xf3\xd2\x1a\x81n\x15\xc0(ȕ\x95\x01\x13{\xff\xa3 W\xd4\x03,\xec\xf8\x8f\x82\x9d]\x18g$b\xf2\xd3\xd8!\xb4\xa5\x95I\xfd\xfa\x92\xf3\x92\xa9\x18\x9b\xa3\x9d\xf1\x88\xd7!7\x83XG\x9c\x7f\xee\x8d\xd6\n\xb5\x1a7/\xe0\xd4\xf6\xb0\x87l5u\xf1o\x06t\xda2H\x02\x95\xba\xb4Vt\xfa\xc0\xe1K\xe3R4>\xd7\x18ȞG\xef\xb0\x14d4s:-ǉ<\xb7\x83\x8f\";w\x1b\xc2Y8\x8a\U0008a46aҫ:\x00\xbaI}\xe8\xcd\xd5\x0e\xe0GSǕ5<w\rN\x16\xa5z\xa6D\x1e\\u\xbb\\\xe2\xb8N\xf2\xdbiQ\xba\xb3Ig\r\xf7s\xdc\xfa\xdcm;\x12\x17\xa7\x93\x86\x992U^\xc3\x1ee\x17\xedM\xdc\xdds\r'\x9f\xe2ʚ3l\xd1\vI~{\xf2\xd9\xd3\xe7\x1f^3N\xa6m\x17q\u009fL\xd6:#>5\xffn\xdb\xe8\xfer\xac\x95\xecQ\xcaF\xa5\x12\x1e\x11\xb1\xedu\xddL'\x88\xa3\xcc7\x89\x03\xc2ph\xaa&5\xcc{5;\x89/_~\n\x88\xd3\x1e\xe6\xeeCe\x19\xa1m)\xacC\xa2_\x9aP\xe8t\xa0\xff\x9e\xcdS\x0f\"\x802q\xa6?\xf4\xf1\xb5H\x84\b\x89\x8e\xd5X\x87c\xa8I\xc0\x12\x99\xe6\xc5\xf1~\xbcO+\x8cj1\x85\x18\xc2'\xeb&z\xf5\x06\x82\xf6\x19|\nT9\x93\x9c\xe2\xce\xcd*\x0fhr\xb2S~Ũ\x92\xd2\xc9\xff\xaa\x03}\xec\xe427J\xf7\x10\xc4͊\xca\xf2\xe1\xc8\x00\x80\xa6\xfe\x82\xc3\xcb13۹\x1bb\x8e'\xef\x87\xed\xf9\x16\x00\x9b\a\xa4H\xe8\x9as\xc8O\xc2չߑŸ\x01\x162\xc9\\w\x9b\xd1j\x90\x03>\xa2\x06\xa39\xd5ˇ\x0fI\nݮ\x85\x00\xf7\x19\xc0lÈ\x99\xe4\xaaTF\xe4Is#j\xe9f\x03ZF\xf8\xca\t\xfb\xc6MB\xa4b\x14\x12\xf7\xb1\xe9\xf7\x8d_X\x18\xf6@\a\xeb\xb7#\x00Wر\x11\x91\xe2\xf2\x107\xcb\x1a\xde{\x89^#W\x96\xa4c\xe0\xdc\x17\ntN\x9cx\xe1\x15\x1e\x9eh\xbfꄚ\xfc\xb3\x91ӷ1\x8ahr\xeeݣ\xb7!\x19!2O\xa9\x1b\x06\x9f\xb2/\xadVo\x86˂2'J\x0eq\xc3x\xd9A\xb4\xcf}\xe1\b\xaaBWF\x9c\xb0\xeb\xd9\xe3\xd7R\xdae[\xfe\xb1nF\x14\xe1\xac\x13kxs\xf5\a*y\x92d\x10\x89\xb1'a\x0f\xe2\x84یnU\xe1\xda\xc2\xddo\xc2\xd7\x00u\xe4b\x8f\xc1\x84~l\xb7L\x1eO\x14\xe6\x00%\xdd\xf3q\x1dWT\x92\xf8B\xfc\xdd\xd8\xe1>c!5\x1d\xa9\"7\x89\xa3\xbf\xd4u\xb7\x16o>\x91=\x8b\xef\x1d\xb5Hx\xb6mU,}\x9dZ\xe7\xc76\xe0\xb6\xf0\t\xfbKT(=\xc2\xfc\xbe\xbe\xffe\xd0\xe0V\xdfYs\xa2\xf4\xdb\xe0ST\xe4\x81\xe8o\xe1NX/\x85R\xcf\x01\xfc\xe0\xfb\xc4\xeb\x0fH\x96L\x9fV\x130b6O\xc3ب\x89\x86\xe8.\x14\xe25ɵ8P\xb1S[\xe1\x9aM\xb2\x1e\xd4f\xbc\x1d\x1d\xe5\xc0\x94\xf2\x92]\x88\xd2\xc1\x01\x9d\xdf\xe2\xf1h\xac\x0f\xa1\xd7vK\x1b\xb1aa\x19@%\xeb\xccI\xdbp\x91\b\x1db\xac\x13\x10\x8dl\xb2/hQ8\x96M\x0f\x85x&\xc7Gj\x91e\xe4\x9f\xe0\x8d\xf3B\xe1\xee\x12\x8d\x9a\v\xbd8cA҅\xf9_\x06\xcbـȷ\xed\xd6I`uU\x1cВ\xa42\xb0@/ޕ\x0eVO=o\x06P9=\x83\x1a\x9e\xac\xf4\x1eu7\x97\r\x9e,\x8cR\xe0\f\x1c\xc5\xc0q\x9a\xb7y\xf4xㅺ\x9d\xca\xc5tf\xf4\xa5n\x9a\xa6Ý\x87\x932Ć\x03\x13j\x04&]`@\v\xa4t\xa9'1.;\v}\"\x01\xb2\xa6:\x9d\x93\x04N\xac\x14\xa3P\xf3\x8a\x10\x82RU'\x12\xe9\x98\x13\xf6\x95խ\xd08f\x89\xf3\x16\xaa\"{\x80\xaa\x1c/\x9a \x1c\xeaK\xaan\xe21\xf6-\xedPm#\xfd9\x1e\xbf\x8e\x91\xa1\x95\x86\\&\x8ei\xe2I\xd2\t\xb0\xcc\xf6\xb2D\r\xc2E\\\x16K\xa6\xe6\x189\x1d\xa8ya}\xedU\xec73\xfc\xfd\xdci\xba\xe0\x7f9jL\x1b\"\x9fctۃ\f\xbc\x8f\a\xef\xfbW\x84Qd\xaa\xd3}X\x9c~\x89\xacw\xe4\x96\xd1\xc54\xc6R\x0e\xf9\xcbH\x0e\xb4\xe3Pu\x1c\xa8.\xea\xee7Yc\x9b\x1b\xc2>.{Q\xcdr\xd2\xf6\xa7\xea=@\xf2\xa7\x1ax\xc9\xf7\xf9\x83<nF\x8fZf\x84m}\xad\xd7\xcb\xe3\x89\x15\x13\x1f&1\xe3\x9a>;\xdd7\xb3\x0e\x05{\x0f\xb5o\x00\x1fh\xf3!#\xad\x1c\"\x7f\xa7\x90\xd6{\x87\xd8\xf5Tތ\";\xa6\x1b\xdd\x10ѽ\xf3\x9e\xb6\xfe0\x9f\xc5\xff~\xa2Ӕ\xe1\x13\xa9A\x0fh\x1a\xbe\xc9i\xc4\"\x96ɠp\xf5DjW㒉ԝ\xa6&⪌\x8e\xb6\x1c\xab\xb1\xa5\xa8\x8e\xb9^qVO\xc2R\xa0=\xaf=\xff\x19\x1b\x8dD!\xb1\xff\xeb\xc6!\xad0$\xe1\xf7\x1b\x05\"#v\xbc\xf7*\xa9\x1f<\xbem~1\xf9\xb6\xf1\xdaE\xfe\x10\xade\xdeR\xed\x88J|\xd3$\bD\x96!\xc9\xee\xa7\xfe\r\x8cWW\x9dK\x16\xf9gftXK\xdd\x1e\xfe\xfa7\xba<\x91\xf3LQ-\xdd\x1e\xfe\xfa\xb7\xcd\xff\x0e\x00?7>\x9a\xb2R\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcY_\x8f\xe3\xb6\x11\x7fק\x18\\\x1e\xf6%\x96\uf697B/\xc5\xde^\x03\\\xbb\x97]\x9c/ۇ4@hrd\xb1\xa6H\x95C\xd9q\x8b~\xf7b(J\x96e\xd9\xde럜\x16HD\x91\xc3\xdf\xfc\xe6/\xe9l\xb1Xd\xa2\xd1/\xe8I;[\x80h4\xfe\x1a\xd0\xf2\x1b\xe5\xdb\xdfS\xae\xddr\xf7n\x8dA\xbc˶ڪ\x02\x1eZ\n\xae\xfe\x8c\xe4Z/\xf1\x03\x96\xdaꠝ\xcdj\fB\x89 \x8a\f@X\xeb\x82\xe0a\xe2W\x00\xe9l\xf0\xce\x18\xf4\x8b\r\xda|ۮq\xddj\xa3\xd0\xc7\x1d\xfa\xfdwo\xf3\xef\xf2\xb7\x19\x80\xf4\x18\x97\x7f\xd15R\x10uS\x80m\x8d\xc9\x00\xac\xa8\xb1\x80\xb5\x90۶\xa1\xe0\xbcؠq2N\xa6|\x87\x06\xbd˵˨A\xc9[\v\xa5\"<a\x9e\xbd\xb6\x01\xfd\x833m\xdd\xc1Z\xc0\x9fVO?<\x8bP\x15\x90S\x10\xa1\xa5\xbc\xa9\x04a\x84\xac\x90\xa4\xd7\r/.\xe0}\xdc\x0fV݆\xf0\x98v\x84n\x15P++\x10\x04\xf7;\xa1\x8dX\x1b\\\xfehE\xff\xffQZ\a\xfby\x90\x1e\x0e\r\x16@\xc1k\xbb\xb9\x00\xc5\b\n/\xc2h50q\x8e\xeb\xf1l\x0eh\x82P!\xf0j\b<\xc0o\x1d_\xc0\x84!\xf4|\xc1^P\x14\t\xb0\xebd\xa0\x1a\x81e\xd9\xf0r\xf2\xa1C\xcd\xefS̽\xf5\xf33ˍ$\xdeo\xf0\x86\x186[\xae\xb0\x14\xad\t\xe7\xda~\xe8>\x8c\xb5\x11\x9b\xa3>\xa3\x9d\xd2\xcc\xd1nk\xe7\f\n\x9b\x01l\xbck\x9b\x02\x8e\xbe\xd29U\xf2\xd4\xce\xcb;{'s\xf7֎ߍ\xa6\xf0\xe7\xcbs\x1e5u\xc0\x1b\xd3za.yj\x9cB\x95\xf3\xe1\x87\xe3\xd6\vX\x13\xbb8\x00i\xbbi\x8d\xf0\x17\x96g\x00\x8dGB\xbf\xc3\x1f\xedֺ\xbd\xfd^\xa3QT@)Lt0\x92\x8e)\x8e\xc2\x1b!\xa3]\xa9]\xfb\x14\xb6i\xc3\xce\xd1\n\xf8翲\xc1\x05\xd8\xdd\xe3Gנ\xbd\x7f\xfe\xf8\xf2\xddJVXǰ>3\xc8,\x05\xec\x81b\xe4d\x15z\x84\x97\xc8v瀔\xb4J\x12\x01\xdc\xfao(C\uf2cdw\r\xfa\xa0{Z\xf8\x19%\xa9al\x82\xe5\x8e\xc1vs@qZ\xc2.\x10v\xdd\x18*\xa0\xa8\b\xb8\x12B\xa5\t<F\x12m8\x1a\xb7\x7f\\\t\xc2&X9\xac\x98hO@\x95k\x8d\xe2\\\xb6C\x1f\xc0\xa3t\x1b\xab\xff1H&\b.\xc5^@\n'\x12c\xee\xb1\xc20\xcd-~\v\xc2*\xa8\xc5\x01<\xb2\xea\xd0ڑ\xb48\x85r\xf8\xc4\xc1\xaam\xe9\n\xa8Bh\xa8X.7:\xf4iY\xba\xban\xad\x0e\x87eL\xaez\xdd\x06\xe7i\xa9p\x87fIz\xb3\x10^V:\xa0\f\xadǥh\xf4\"\x02\xb7\xac,\xe5\xb5\xfafp\x86\xbb\x11\xd2I^\x8ac]L\\䝣\xa1\xb3y\xb7\xacS\xf1H\xaf\xb6\x9b\xc8\xca\xe7?\xae\xbe@\xbfi4\xc1Hd\xef\x04\xc7et$\x9e\x89ҶD\x1fWA\xe9]\x1d%\xa2U\x8d\xd36\xc4\x17i4\xdaSҩ]\xd7:\xb0\xa5\xff\xde\"\x05\xb6O\x0e\x0f\xb18\xc1\x1a\xa1m8\x05\xa9\x1c>Zx\x105\x9a\aA\xf8\x7f\xa7\x9d\x19\xa6\x05Sz\x9b\xf8qM\xed\xffu\x13;\xb6\x86\xe1\xbe\xdc\xcdZh6JW\rʓ8QHڳ/\a\x11\x90\x83D\xa4\xa0\x1d\x89\x85+\x89\xf1r\xf0\xf2#\xa4D\xa2ON\xe1\xe9\xf8\x04\xea\xfd0\xed\x04[\x83\xbe\xd6\xc4aLP:?-i\"Օ\xf1\xd3\xe7\x9f|\xf2\x05m[O!,\xe03\n\xf5d\xcda\xf6\xc3_\xbc\x0e\xd3\rf\xcd\xc5\x7f\x1d\xac\xd5\xc1\xcag\xf4ک\xab꾟L\x1e\x94\xae\xdc\x1e\xca\xe8\xb66\x98\x03\x04\at\xb02\t\x9fH\x04\xb8\x7f\xfe\x98\x1c\"\x05G\x8a\xa5\xc4M\x0e\xf7)&]\toAiⶄ\xa2\xc8)=\xdce\xf1\xd7\x02\x82o_\xad\xb4t\xb6ԛ\xa9\xaa\xe3\xdek\xde+\xae\n\x9dp\xf5\x10\xf7\xe0D\xc3\x1e\xd0x\xb7\xd3\n\xfd\x82=_\x97ZrZ.\xf5\xa6\xf5ѻ\xa1\x8c\x05q\xaa\xddl\xec\xf0\x9f\xf4\xa88F\x85)\xaeb\x18\xa6\xf1vAh\xdb\u0558\xe3\xf2\x988|\x9d\n\xa1\rhU\xea\x9d\xc6Op1\xff\x10*\xd8\xebPui\xad\xf7\xd8\xc9\xecK\x11\xc5\xcf\x16\x0f\xe7\x83\x13\xcc_*\x84-\x1e8\xa2\x19*\xa1\xf4\x18\xa2G\xa1\xe1\xd2\xc3\x0e\x93\x03|j)0(\xc1\xae\xa2\xcf!\xf3\x93\xd6n\xf10%\xf6\x86!S[v\v\xea\x1d\xf7+=P\x8f%z\xb4a6!\xf3\x01\xc2[\f\x18O(\xcaI\xe2*(\xb1\t\xb4t;\xf4;\x8d\xfb\xe5\xde\xf9\xad\xb6\x9b\x05S\xbcH\xf1\xb1d \xb4\xfc&\xfeg\x06\x0f\xc0\x97\xa7\x0fO\x05\xdc+\x05.T\xe8\xa1%,[\xd3;Ԩ\x13\xf96\xd6\xc5o\xa1\xd5\xea\x0fwٙ\x9c\xeb|\xb8h\x1danr\xc2yZ\x97\a\xd8W\x18\xe105\xab\xce\x0e\xce\x03W76n\x9d\xac\xd7\xe5\x8f9\xebM\xbb\xe0\xf1?N4\x9c\xfb\xa7`\x16\xec8\xaf\r\xa1Ե\x17\xd9\x15e\xfa\x06^[\xa5\xa5\bH\xa7\x9eߟ]\x92\xa8\xff4\xc5_V\xb5s\x82T\xbd\xae\"}\x1a\xcf\xec\xeb\x1c\xa4d\x93\xaa\x12a\b\xdan\b,r\xd5\x12~\xcaU\ft\xe9\xac\xe58\v\x0eĐ\xb6\xee(a\xe9\x95˿\"\xea\u05ed\xdcb8\x1f\x9f\xa8\xf0>N\xeb9\xed\x161\xa0\x960\x16\xd1\xeb\x00nz\xb0\x14\x0f\xe8o\xa3x\xb8\xe7iCa\x13\xf0p\x0f\xeb\xd6*\x83=\x96}\x85\x16v\xe8uy\xe0V\xf1\xcb\xe3jF&\xf4<\xc6\x1e \xf5\xd9=\x9bsػ,\\\xc0\xfa\x10\xf0kUk<\x96\xfaכ\xaa=\xc7i=\xc1\x8d\b\x15hKZq\x12=\xa7{\xa6\x99\xea\x9f\xde\x04\xf0\x94\xb2\xc2W\x1a\xe3r\xfcv0^\x1b\xc2=\x9fEvU\xebnҠwZ\xd4\xe7\xedӠͳWjq<~~\xcfꠕ\x87\xab0^\xce\xe7_鞒\xf4sO`\xc4\xd2y\x8f\xd48\xab\xd8\xff^\xd7;\x1d\xe1\xfe/:\xa89\x03.\xc0\x8ds\xd0ɗ\xdeP\xd9\r\xa3\xa6\x03~v\x81\xc3\xd9f~\x15\xd7\f\\2An\x1d\xef\x1aFg\x83ٕ\xd9\xed\xf4\xf5\xcac\xc0\x9b\xd19\x80O\x96\x16Z\x1b\xbb\xa5X\x85s\xf8\xab\x85\x0f|N\xe4\x1a\xa2\n\xce\x05\xdc!Pv\"\x11\x00\xac\xdb\xf3⑴(\x00\x9c\xe55\xb1\xb6Ɠx쿺O{m\f\xf7A\x1ek\xb7\x9b\xa9\xa4\xdc\xe6y4\a\xbe\xees%\xec~\x97\xbf\xcd\xdf\xfc\xc6g\f\xbe\xdb\xe3C\x03\xaaϸ\xd3\xd3[\x91s6\x1f\xcf\xe6\xf7\xc1;\xb86\xbf\xfc\xd2\x1f7\x97>M\xfbe\"\x16\xa0Ԇ\xef$f\"\xfdX\xc5ϯ\x1f߯\x1e\xef\x883x@;\xdc\xf3\x1c\x9f=\xdf\x10\xf1i\x04\x15h\x9b\x92\xbb4-\x05\xf43\xc6\x1el\xa5\t\xac\x03\xe3\xec\xe6$\x14\xba\xbft\xba\a\x17[8\x15K\x9eB>\x98s\x94\xcbJ\xd8\r\x1eol\x12\xf6\x11Jv\x8cs\xa4\xa7\xdeq\xf4\x06m\xe7]\xe1\x156\xe4\x9bҫ\xf6;\x9a\xef\xf2\x05\xef\x80:ٲ7\xc6\xd7q\x9d\xcd\xd7P&r\x11\xfa\v\xe8\xff.\xd5\x01\x9c\xdfk\xdf\xd4\xfet\xfa<\x03#o\xbc\xa6\xbe\x18r7\xaa\xdf^\xf7\xf8\xf3\xc2Uu\xe3O\x04\xbd\x86\xb2\xf5|\x04:\xe6]\x1e\x9cͽ\xf9\xabR\xd0\xf0\xfb\xc4ٗ\xe9\xef\x157u\x99\xa97\x93\xa1t\xf1Z\xc0\xee\xdd\xf1-\xfd\xf0\xc2ǯ\U001013d5\\\\FD\xa6\x8c\x92F\x8eE\x8c\xabG\x13P\x8d\xee\xcc\xf9\bV\xc0\x9b7'w\xee\xf1Ur=g\x1f\xa0\x02~\xfa\x99\xef\xbf\xd93T:\xbcQ\x01?\xfd\x9c\xfd{\x00\xed\x93\x00\x8d\x01\x1b\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VKo\xdc6\x10\xbe\xebW\f\xd2C.]m\x82\\\n\xddZ'\x05\x8c\xb6\x86a\xa7\xb9\x049p\xc9Y\x8955dg\x86뺿\xbe %y\x1f٭\xd3CE]8\x9c\xe77\x0f\xb2Y\xadV\x8dI\xfe\x13\xb2\xf8H\x1d\x98\xe4\xf1/E*;i\x1f~\x90\xd6\xc7\xf5\xee\xed\x06ռm\x1e<\xb9\x0e\xae\xb2h\x1c\xefPbf\x8b\xefq\xebɫ\x8fԌ\xa8\xc6\x195]\x03`\x88\xa2\x9aB\x96\xb2\x05\xb0\x91\x94c\bȫ\x1e\xa9}\xc8\x1b\xdcd\x1f\x1cr\xb5\xb0\xd8߽iߵo\x1a\x00\xcbX\xc5?\xfa\x11E͘:\xa0\x1cB\x03@f\xc4\x0e\x1c\x06T\xdc\x18\xfb\x90\x13\xe3\x9f\x19E\xa5\xdda@\x8e\xad\x8f\x8d$\xb4\xc5p\xcf1\xa7\x0e\xf6\a\x93\xfc\xec\xd4\x14\xd0\xfb\xaaꧪ\xeanRUO\x83\x17\xfd\xe5\x12ǯ~\xe6J!\xb3\t\xe7\x1d\xaa\f\xe2\xa9\xcf\xc1\xf0Y\x96\x06 1\n\xf2\x0e\x7f\xa7\a\x8a\x8f\xf4\xb3\xc7ः\xad\t\x82\r\x80ؘ\xb0\x83\x1b3\xa2$c\xd15\x00;\x13\xbc\xab\xf0LqĄ\xf4\xe3\xed\xf5\xa7w\xf7v\xc0\xb1&\xa0\x90\x1d\x8ae\x9f*߹\x18\xc0\v\x18\x98=\x01\x8d\xb3\x83\x10\t!2\x8c\x91\x11&o\xa5\x9dU&\x8e\tY\xfd\x82`Y\a\xf5\xf3L;1\xfe\xbax7\xf1\x80+\x15\x83\x02: \xec&\x1a:\x90\xea9\xc4-\xe8\xe0\x05\x18+,4\xd5ЁZ(,\x86 n\xfe@\xab-\xdc\x17\xe8X@\x86\x98\x83+e\xb6CV`\xb4\xb1'\xff\xf7\xb3f)\xf1\x15\x93\xc1\xe8\x92\xe0\xe5\xf3\xa4\xc8dB\xc15\xe3\xf7`\xc8\xc1h\x9e\x80\xb1\u0600L\a\xda*\x8b\xb4\xf0[\x01\xc7\xd36v0\xa8&\xe9\xd6\xeb\xde\xeb\xd216\x8ec&\xafO\xebZ\xf7~\x935\xb2\xac\x1d\xee0\xac\xc5\xf7+\xc3v\xf0\x8aV3\xe3\xda$\xbf\xaa\x8eS\tV\xda\xd1}\xc7s{\xc9\xeb\x03O\xf5\xa9T\x82({\xea\x9fɵ\x86/\xe2^\xeawJ\xf3$6\x85\xb8\x87\xd7S_\x13q\xf7\xe1\xfe#,Fk\n\x0eT\u008c\xf6^L\xf6\xc0\x17\xa0<m\x91\xab\x14l9\x8eU#\x92Kѓ֍\r\x1e\xe9\x18tɛѫ,\xe5W\xf2\xd3\xc2U\x9d\x1b\xb0A\xc8\xc9\x19E\xd7\xc25\xc1\x95\x191\\\x19\xc1\xff\x1d\xf6\x82\xb0\xac\n\xa4/\x03\x7f8\ue5af\xc8w3Z\xcf\xe4e\x16\x9d\xcdЙ\xb6\xbcOhK\xce\npE\xd6o\xbd\xadm\x00\xdb\xc8\xf08x;,my\xa0\x15\xf6\r\xbc4륆-kRP\xa6\xca1\xfdB\xb0P\xf3\xe4\x19\x8fjmu\xa0\xe6E\x14\xd4h\x96\xff\x84C\x95X\x90\xb0\x99\x19Ig=u\n\x9c\x13\xfa\x96ؑ9\xf2\t\xedĝ\x0f\x95\xa5\x8c\x135\x9e\x04\f=\xcdb\xa0\x83QxDF@\xb21\x97ف\x0e\\>\xc1k\x86b\xc0i\xaa\x96\xf4%\x8e\x16\xe5y\x96.\xcb+\x8e_ys1\x0f\xe5/7\xa1\xd9\x04\xec@9\xe3\xc9\xe1$g\x98\xcd\xd3\xd1I\x1a\x8c\xe0\xbf\x06}[8\xce\xe1\x8d\x05\xeeB|\x01\xf0\xf2#\xe5\xf1\xd4\xca\nn\xf0\xf1+\xda5\xddr\xec\x19希\v\xfb\xed\x84T\xbd\xec\xbe\x01\x933\x05wB\x9a/\x9a\x0evo\xf7\xbb\n\xfaj~P\xd4\x03\x80z\x15\xbb\x03`E#\x9b~\x81z_\xc5\xc6ZL\x8a\xee\xe6\xf49\xf1\xea\xd5ѻ\xa0nm$W\x1fI\xd2\xc1\xe7/\xe5V\xd7\xc8\xe8\xe6+Q:\xf8\xfc\xa5\xf9g\x00\"\xf7\xf4 \x8c\t\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4Wώ\xdb6\x13\xbf\xeb)\x06\xf9\x0e\xf9\nDr\x82\\\n\xdd\xdaM\n\x04\xdd\x06\x817\xc9%ȁ&\xc7\x12\xbb\x14\xc9r\x86v\xb6E߽\x18J\xb2\xbd\xb2w7=\xd4\xcc!\x9a\x19\x0eg~\xf3w\xab\xba\xae+\x15\xedgLd\x83oAE\x8b\xdf\x18\xbd|Qs\xfb#56\xacv\xaf6\xc8\xeaUuk\xbdi\xe1*\x13\x87a\x8d\x14r\xd2\xf8\x06\xb7\xd6[\xb6\xc1W\x03\xb22\x8aU[\x01(\xef\x03+!\x93|\x02\xe8\xe09\x05\xe70\xd5\x1d\xfa\xe66op\x93\xad3\x98\xca\v\xf3\xfb\xbb\x97\xcd\xeb\xe6e\x05\xa0\x13\x96\xeb\x1f\xed\x80\xc4j\x88-\xf8\xec\\\x05\xe0Հ-\x98\xb0\xf7.(\x93\xf0\x8f\x8c\xc4\xd4\xec\xd0a\n\x8d\r\x15E\xd4\xf2h\x97B\x8e-\x1c\x19\xe3\xddɠљ7\x93\x9a\xf5\xa8\xa6p\x9c%\xfe\xf5\x12\xf7\xdaN\x12\xd1\xe5\xa4ܹ\x11\x85I\xd6w٩tƮ\x00bB´\xc3O\xfeև\xbd\xffŢ3\xd4\xc2V9\xc2\n\x80t\x88\xd8\xc2{5 E\xa5\xd1\b-o҄\xf5d9\xb1\xe2L-\xfc\xf5w\x05\xb0SΚ\x82\xd4\xc8\f\x11\xfdO\x1f\xde}~}\xa3{\x1cJ,\x84l\x90t\xb2\xb1\xc8-\xdd\x02K\xa0`2\x128\x1c\xec\x06\xe5A%\xb6[\xa5\x19\xb6)\f\xb0Q\xfa6\xc7I'@\xd8\xfc\x8e\x9a\x818$\xd5\xe1\v\xa0\xac{P\xa2m\x14\x04\x17:\xd8Z\x87\xcdt%\xa6\x101\xb1\x9d\x83 \xe7$\xfd\x0e\xb4\x85\xc1\xcfţQ\x06\x8c$\x1c\x12p\x8f\xb0\x1bih\x80\x8a\xb7\x10\xb6\xc0\xbd%HX\x90\xf6c\n\x9e\xa8\x05\x11Q~\xb2\xbc\x81\x1b\x89F\"\xa0>dg$Kw\x98\x18\x12\xea\xd0y\xfb\xe7A3\t.\xf2\xa4S<\xe7\xc9\xfc\xb3\x9e1y\xe5$\x16\x19_\x80\xf2\x06\x06u\a\t\v:ٟh+\"\xd4\xc0o!!X\xbf\r-\xf4̑\xdaժ\xb3<\x17\x9c\x0eÐ\xbd\xe5\xbbU)\x1b\xbb\xc9\x1c\x12\xad\f\xeeЭ\xc8v\xb5J\xba\xb7\x8c\x9as\u0095\x8a\xb6.\x86{q\x96\x9a\xc1\xfc\xef\x901\xcfO,\xe5;I.\xe2d}w \x972x\x10w)\x831=\xc6k\xa3\x8bGx\xad\xefJ \xd6oo>\xc2\xfch\t\xc1\x89\xcaC\x9e\x1c\xae\xd1\x11x\x01\xca\xfa-\xa6rk\xcc2ш\xde\xc4`=\x17\xf5\xdaY\xf4\xf7A\xa7\xbc\x19,Ӝ\xb6\x12\x9f\x06\xaeJہ\rB\x8eF1\x9a\x06\xdey\xb8R\x03\xba+E\xf8\x9f\xc3.\bS-\x90>\r\xfci\xb7\x9c\x7f\xa3\xe0\x88ց<\xb7\xb3\x8b\x11Z\x94\xf2MD-\xf1\x12\xd0\xe4\x9e\xddZ]J\x00\xb6!\x81:V\xf6\x04\xdb\\\x97\x0fզ\x1cV\xa9C\xbeO[X\xf1\xb1\x88\xc8\xc3\xfb^\xddo!\xffǦk\xa4\x0f\xd0d\xc2\xd8\x19~8}\xf9\xb1\xd7/\xe5\xe8E\x1b\xe6T\x15\xd7\x05G)ti=\xa7\xd6,\x1f\x95\x83>\x0f\x97\x94\xd7\xf0s\xb1\xf4:tՂu½\n\x9e%\xa1\x1f\x11\xf9\x1c\\\x1e\xf0ƫH}xTr\x9e\xa9\x879s\xff\u0530Fi\xb5\xf8\x90I\x13{\x8d\x94\xddŇ.&\xe2|d6>\x89\xb2\x8c\xa6\x19e\xb9 (\xcb\xffe\x9e'\x8f\x8ctl\x03{\xcb=\xec{\xab\xfb\vZ\xa1\x14v\t\x90\xf4\x17\xa2\xa0m\xa9\xd8\x7fg\xb6\xe4\xb1Mx\x96\x1euI\x9a3\xa2\x98\xbc ^\xac\xb9ˊ\xeb\xa9\x16\xaa'nO\x03\xbaz\x00\xc3e\xcd\x16\xe9\x19T\x9dSBϓ\x0e\x81W-/4\xd5\xd3e3g\xfc\xa7\xf5u[=\x12\xcfY\xf5\xa7\xf5\xb5\f?V֏vĄ5\xd9Σ\x01\xe1I\xed\n\xf9\f\x80\xf1\xdf\xe9\x8c\x7f2j\xf8-\xdat\xb2\xb2<`\xdaۃ\x98`\xb3\xefя#b\x81ƨ\x0e\xa9\x8c]\xad\xee\x0f{9\x1b\x04\x83\x0e\x19\rl\xee\x8aotG\x8c\xc3\xd2\xdemH\x83\xe2\x16dp\xd4l\xcf\x12E\xd6O\xb5q\xd8\x02\xa7\x8c\xdf\xebl\xec\x15\xe1\xa3~~\x10\x89K\xe1?\x14\xd7\xc2\xe3\xa6z\xba\x83\xd5\xf0\x1e\xf7g\xb4\x0f)h$B\xf3}\xd6_H\xee\x05iZ\xc0Zؽ:~\x95ݮ\x9e\xf6\xf4\xc2\x00([\xaf9\x81n\xda\x19'ʱb\x94\xd6\x18\x19\xcd\xfb\xe5\xa6\xfe\xecٽջ|\xea\xe0M\xf9ۃZ\xf8\xf2U\x96ei\x8ffZ\x15\xa9\x85/_\xab\x7f\x06\x00]]l+\xe3\f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4XOo\xdb:\x12\xbf\xfbS\f\xb2\x87\\b\xa5E/\v݊\xec\x16\b\xb6-\x82\xa4\xdbK\xd1\x03M\x8e-\xae)\x92\xcb\x19\xda\xf5.\xdew\x7f\x18J\xb2e[N\xfd\xfa\xf0\xa2\\4\x1cΟ\xdf\xfc\xb5\xe6\xf3\xf9LE\xfb\x15\x13\xd9\xe0kP\xd1\xe2\x0fF/oT\xad\xffN\x95\r\xf7\x9b\xb7\vd\xf5v\xb6\xb6\xde\xd4\xf0\x90\x89C\xfb\x8c\x14r\xd2\xf8\x0f\\Zo\xd9\x06?k\x91\x95Q\xac\xea\x19\x80\xf2>\xb0\x122\xc9+\x80\x0e\x9eSp\x0e\xd3|\x85\xbeZ\xe7\x05.\xb2u\x06S\xd10\xe8\u07fc\xa9\xdeUof\x00:a\xb9\xfeŶH\xac\xdaX\x83\xcf\xce\xcd\x00\xbcj\xb1\x86\xe8\xf2\xca\xfa\x84+K\x9c\n'U\x1bt\x98BeÌ\"jѻJ!\xc7\x1a\x0e\a\xdd\xf5ަΟ\xa7\"\xe9y$\xa9\x1c:K\xfc\xaf\v\f\x1f-qa\x8a.'\xe5&\xad)\xe7Ԅğ\x0f\x1a\xe7\x10Sw`\xfd*;\x95\xa6\xae\xce\x00bB´\xc1\x7f\xfb\xb5\x0f[\xff\xc1\xa23T\xc3R9\xc2\x19\x00\xe9\x10\xb1\x86\"8*\x8dFhy\x91\xfa\x98\xf4ʈ\x15g\xaa\xe1\xff\xbf\xcd\x006\xcaYS\x8c\xef\x0eCD\xff\xfe\xe9\xf1\xeb\xbb\x17\xdd`[b&d\x83\xa4\x93\x8d\x85o\xc2q\xe8\xec\xc4D\xc0\r\xf6\xb6\x13\x84%\xa8\xfe\x05\x8aݩ\x17\a\xc0\x8dbH\xd9\x13\x10F\x95\x14\xa3\xdb\xc12\x85\xb6\b\xf8Z\x02\xd3߹\x03\xacV\x15(\x02\xcb\x04a\xeb\xc1`taע绽@\xe5M'\xf4\xec\xbe\xe4\x98G\xcd\x04\x1c \bAX<\xf26\xa4u\xd5\v\x88)DLl\x87\x88\xc83*\x80=\xed\x04\x8a[\xc1\xaa\xe3\x01#)\x8f\x1d\x02\x9b\x8e\x86\x06\xa8\xe0(Ppc\t\x12\x96\x18z>d\xd4\xf0\bZ\x1e\xc2\xe2?\xa8\xb9\x82\x97b;\x015!;#>l01$\xd4a\xe5\xed\xff\xf6\x92\x8bW\xa2\xd2)F\xe2#\x89\xd63&\xaf\x9cD9\xe3]\xc1\xa8U;H(: \xfb\x91\xb4\xc2B\x15|\n\t\xc1\xfae\xa8\xa1a\x8eT\xdf߯,\x0f%\xafC\xdbfoyw_\n\xd7.2\x87D\xf7\x067\xe8\xeeɮ\xe6*\xe9\xc62j\xce\t\xefU\xb4\xf3b\xb8\x17g\xa9j\xcd\xdf\xf6\xb9x;\xb2\x94w\x92\xb6\xc4\xc9\xfa՞\\\xaa\xf0\"\xeeR\x82`\tT\x7f\xads\xf1\x00\xaf\x90\x04\x95\xe7\x7f\xbe|\x81Ai\t\xc1H$\xf4h\x1f\xae\xd1\x01x\x01\xca\xfaeI\x17K\x87\xdcDob\xb0\xbeK4\xed,\xfac\xd0)/ZIԄ\xff\xcdH%\xeb*x(\x8d\x0f\x16\b9\x1a\xc5h*x\xf4\xf0\xa0Zt\x0f\x8a\xf0/\x87]\x10\xa6\xb9@\xfas\xe0\xc7\xfdz\xf8\xeb\x18;\xb4\xf6䡛NF\xe8\xbcI\xbcD\xd4\x122\xc1M\xaeڥե\n`\x19\x12\xa8\x89\xb62\x14\xe7\xa5\x02\x95G\x19\x93\x90N\x88'\xb6\xbc\xefx\x06\xe5M .\xb5\x10C\xe2\xae2\x87\x9eշ\x8c\xb1\xe2\x8b8\xc9\x7f\xc7.\r\xf7U\x03^\xf6l\x83\r2n\x0e\xfd\xeaH\xf9-\x81\x16O\v<x\"\x16D\xc0\x06\x93]Z4\xa0V\xcaz\xe2\n\x1eYڏʎ\xf7\xfd\xa0x\xd9;׃t\xb5[\xec\xe8\x05uB\xfe\xa9g_>\xbe\x1c8\x8f\x9c\xebUS9\xbd\x03\xebG\x9d\xf9Db?}ed\xdd\xc1\xd6rSXG \x94h\xadqw\xa1ß\x89S\x99\x1b\xc9~\x01p\x0f\xc8\x11\xc8E\xcd\x1ddo\xfaypÎ*\x9d\xf8Ft\x9d\t,\xa7k\xdc\xdd\xc0\x1aw\xd4u\xd23\x1b37!Y\x1eY٩<\x936\x15穸\x1e٧Ug\xde\x1awW\xc6Q:\x90Mx\xd4E\xe7C\xc1\x1cю\xe2\xfdӲ\xef\xf6\x87م\x8c\x98(\xfcraH\x0e\x9dSBϽ\x18)\xbf_-\xfd\x16\x89\xd4\xea\xf5\xfc\xfc\xd4\xf1\x00\xfe\x88Nj\x05\xb6\xcdn\x14\x1a\x02-\xe3\xd5ߖ\xde<l1h\xaeD\x18 6\x8a^\xb7\xe0I8N\x9dwv\x89z\xa7\x1dv\x02\x86&\xf4:\x0e\xf2\xa0\xcf\xed\xa9\xba9|\xc6\xed\x19\xedy\xef\xcc\xd9\xd1\ae\x1d\x9a\xab],F\xd1\xebN\xf6h\xcav\\\xc6XjK\a\a\xb5\by\\\v4\x02\xf9D \x1cf\xecQ\xa9\x9eB`\x19\xdb3k&\xedy\xf4\xcb \x9b\x13\x97\xc8+\xee&'\xf6\x8bi\xdfB.\xd4襤{m5\xbc`\xcchG\xb4G\xeb\xe1\xc9\xecy\xff\xf4XZǤD\x18s\xda6:\x94\xfd\x97\xa4\xf5\xdf\x12`\x1by\a\xf6H\x9c\tH\x92\xd8\te\xce]\x10*\x9b\x8aVQ-\xac\xb3\xe2l5\x9bT})7\xfa\xb8\xa1\x92}\x8f\xae\xc0\xe2C\xcf\n*a16\x14\x90\x94\xdb\v9\x06eR\"\xec\xa1\x1a\xfbK9\x8a\xa3\x17\\\xb8\x905W\xf9\a\xe57\xa6Z8\xac\x81S\xc6\xd9e\x19*%\xb5\x9b8?\xddf\xaf\xd6}\xa1\xc9\xfd\xf1V\a\xb6\xcf\x06evկX\xe2'v\x81\xab.&Tfw\x85\x03\xcf\xc2'\x9dr\xdb 7\x98\xc6\xc6o\x95t\x0e9\xe7~\xea\xef\x17\xecI\xc1\x00\xdb\x06\xc7+\xc70\xf7\x8d%-?\x02р\xe5\xea\xcf\x05{\x11\x82Cu\x9e\xa3S\x83w轒\b\x13d\x7f<x_\x19\xbfW\xd88\x9d\x8a\x13\xd2NH}[\xaaa\xf3\xf6\xf0V\x8af\xde\x7f^)\a\xfd\x02cF\xba\x89C\x92,\xed(\xfd\x17\x06\xf9ޣ5FF3\xfa\xd6!\x00\xd4pss\xf4\xa1\xa4\xbc\xea\xe0M\xf9dD5|\xfb.\xdf.8$4}\xef\xa4\x1a\xbe}\x9f\xfd>\x00v\xb7\x8b\xe3\x99\x12\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Yߏ۸\xf1\x7f\xf7_1\xd8{\xd8\xef\x01\x91|\xc9}Q\x14z\xbb\xec6Ŷw\x9bE\xbc\x97\x97 \x0fcql\xb1+\x91,\x87\xb2\xe3\x16\xfdߋ!%[\xb2\xb5^\xe7\x0e\x97f\x05\xc4\xe2\x8f\x0fg>\x9c\x19\xceP\xb3,\xcbf\xe8\xf4G\xf2\xac\xad)\x00\x9d\xa6/\x81\x8c\xbcq\xfe\xf4gε\x9do^/)\xe0\xebٓ6\xaa\x80\x9b\x96\x83m>\x10\xdb֗tK+mt\xd0\xd6\xcc\x1a\n\xa80`1\x03@cl@ify\x05(\xad\t\xde\xd65\xf9lM&\x7fj\x97\xb4lu\xad\xc8\xc7\x15\xfa\xf57?\xe4?\xe6?\xcc\x00JOq\xfa\xa3n\x88\x036\xae\x00\xd3\xd6\xf5\f\xc0`C\x058\xab6\xb6n\x1bZb\xf9\xd4:\xce7T\x93\xb7\xb9\xb63vTʢko[W\xc0\xa1#\xcd\xed\x04J\xca<X\xf51¼\x8d0\xb1\xa7\xd6\x1c\xfe>\xd5\xfb\xb3\xe6\x10G\xb8\xba\xf5X\x9f\n\x11;Y\x9bu[\xa3?\xe9\x9e\x018OL~C\xbf\x9a'c\xb7杦Zq\x01+\xac\x99f\x00\\ZG\x05\xdccC\xec\xb0$5\x03\xd8`\xadU\xa4\"\xc9m\x1d\x99\x9f\x1e\xee>\xfe\xb8(+j\"\xd9\xd2\xec\xbcu\xe4\x83\xeeՓ\xbf\xc1\xc6\xee\xdb\x00\x14q鵋\x88p-Pi\f(\xd9Jb\b\x15\xc1&\xb5\x91\x02\x8eˀ]A\xa84\x83\xa7\xa8\x83I\x9b;\x80\x05\x19\x82\x06\xec\xf2\x1fT\x86\x1c\x16\xa2\xa7g\xe0ʶ\xb5\x92\xfdߐ\x0fੴk\xa3\xff\xb5Gf\b6.Yc \x0e#Dm\x02y\x83\xb5\x90\xd0\xd2+@\xa3\xa0\xc1\x1dx\x925\xa05\x03\xb48\x84s\xf8\xc5z\x02mV\xb6\x80*\x04\xc7\xc5|\xbe֡7\xe5\xd26Mkt\xd8ͣA\xeae\x1b\xac繢\r\xd5s\xd6\xeb\f}Y\xe9@eh=\xcd\xd1\xe9,\nnDY\xce\x1b\xf5\x9d\xef잯\a\x92\x86\x9dl\x1b\a\xaf\xcdz\xdf\x1c\r\xecY\xde\xc5\xc0@3`7-\xa9x\xa0W\x9a\x84\x95\x0f\x7fY<B\xbfh܂\x01$tl\x1f\xa6\xf1\x81x!J\x9b\x15\xf98\vV\xde6\x91g2\xcaYmB|)kMfL:\xb7\xcbF\a\xd9\xe9\x7f\xb6\xc4A\xf6'\x87\x9b\xe8а$h\x9d\xc2@*\x87;\x037\xd8P}\x83L\x7f8\xed\xc20gB\xe9\xcb\xc4\x0f\xe3P\xffO\xe6\x17\x1d[\xfb\xe6>PL\xeeБ\xef/\x1c\x95\xb2_B\x9a\xcc\xd3+]F\x17\x80\x95\xf5\x80ǡ\"\x1f\xc0N\xb9\xa6\xfc\xa5ȵ\b\xd6\xe3\x9a~\xb6\xe5\xc0ɟ\x91\xe9\xedԌ^*\x89m\xe2\x83\xf2;A\x03'\xec#H\x80\xba\x9f\xba\xad\xc8S4\x04O\x1ct)\x86dY\a\xebw\x02+\xf3I\ruy\x96ty\x8cUtV\xfe{\xabhJ\\\x99\b\xa1\xc2d\x93\x0fV\xc9 \xdf\x1a#^`\xcd\xc5\x028\xabή\xdf!#xZ\x91'#\x1e\x95\x82\x8f\xb31D\x05Ԧ\xf7\xbct\xbc@\xb0G\x88 ^ \x04\x93\x82\xf1F\x9f\xdb\xec\xe7\xe3\xf1\xa4\xa4?=\xdc\xf51\xb8'\xa9\x939\x1c\xafx\x96\x11yVr\xca<`\xa8^\\\xf5\xfan\x95\xa8\x11\x1c\xa1\x06\xc1i*i\x14\xdaA\x1b\x0e\x84*5N@\x02\x88\xe3z\xeaƿJ\xf1\xa7\vs\x87\xe3@\xb8\x06\x94\xb8\xa7\x15\xfcm\xf1\xfe~\xfeW\x9bd\x9d\xc4Ĳ$\x16\x18\fԐ\t\xaf\x80۲\x02d\xd9b\xedI-\x02\x06\xca\x1b4zE\x1c\xf2n\x05\xf2\xfc\xe9\xcd\xe7)\xce\x00\xdeY\x0f\xf4\x05\x1bW\xd3+Љ\xe5}@\xed\rD\xccU\x88\xd8\xe3\xc1V\x87JO+\x8er\xe6w\no\xa3\xa2\x01\x9f\bl\xa7hKP\xeb'*\xe0JB\xc8@\xc4\x7f\x8b7\xfc\xe7j\x12\xf3\xff\x92\x93^ɐ\xab$\xd8\xfe\xcc\x1c:\xd1A\xc0\xe4I^\xaf\xd7\xe4c\x0eq\xfa'\x13hC&|\x0f\u058b\xee\xc6\x0e\x00\"\xac\xf8\x7f\nt\xa4N\x04\xfe\xf4\xe6\xf33\xd2\x1eP\x84'\xd0F\xd1\x17x\x03\xda$V\x9cU\xdf\xe7\xf0(?yg\x02~\x11W/+\xcbd\xc0\x9az7-\xad\x85\n7\x04l\x1b\x82-\xd5u\x96r\x15\x05[܉\xfe\xfdv\x89\xd9\"8\xf4a\x9c\x8dL\xa2>\xbe\xbf}_$\xa9Ą\xd6FD\x91Sn\xa5%\xe7\x90d#vF\x9b\x94>n#\x9a\x88SVh&\x02\xab<QS\x82U+)D~=;\x19p\xde[\x8fӆiG\x8d\xe9\xc3q`\xf8\x1f\x1d\xc2\x17\xa9%&\xf5\xb2Z\xf7\x03{>\xab\x96\xd4\x0f\xdeP\xa0\xa8\x99\xb2%\x8bR%\xb9\xc0s\xbb!\xbfѴ\x9do\xad\x7f\xd2f\x9d\x89!fɱy.\x82\xf0\xfc\xbb\xf8\xdfo\xd2\"f早\x12\x87~\v}d\x1d\x9e\x7f\xb5:}^y\xe9\xa9t\xbd\xe82\x9f\xe3\x99\xe2\x12\xdbJ\x97U_$\x1c\xa2\xe7\x04&@\x83*\x85\\4\xbb?\xdcl\x85\xc8\u058b<\xbb\xac+C34J~\xb3\xe6 \xed_\xcd\\\xab/p\xd2_\xefn\xbf\x8d1\xb7\xfa\xab=r2!\x96G2\xc0;%\xf4\xad4\xf9bvF\xc1\x0f\xa3\xa1}b7\x91I\xee\xc7\xe4\xb3\v\x05\f\xb8>I\xa0P\xa9xр\xf5Ù$\xeb\x8c\xce#\xe1\x1fq̀\x9e\x00\xa1A'\xfb\xf4D\xbb,\x1d\xd2\x0e\xb5\x17e0\xf4\xe5\xeb\x92\x00\x9d\xab\xf5\xc4q\x1a\xec0]\xec2o\xe4\xa8B~)\xeb)\xd9,\xce\t\x9cʋ\xa9\xf4\xb9[Z,\xa3;|$\xd1\r\xf6\x90\xa8\x1e\xe1\xc2D\xe2\xfa\foR\x05Jv5\x14-\x83\xe5T!2\x1a!)\xfd\xa8\xc1١\x14ّ\x9d\x8d\xba\x92>\xb3\x17h\x93L\xb0\x1d\x19\xc0\xd9\xfa-\x8e\xee\xd9K\xf1 t\x18\xc2\xe3o\xaa\xe0J+\xb9\xe3\xf8\x9a\xea\xdc\x16ޜ\x8e\x8f\x17\"^%\xb1\x82n\xc4\x1e;\x1b\xda\"\xf7+\x9c\x16a0\x00K\xf3\xa4d\x8aX\xa4bj'Y\xe7\nuM\xaa\x03\xe4\xfcx\xce\t\xe6\x10cI+I'ZW[T}Qԉ\xd6_\xf2<J5\x1c\xef\x1b\xae\xf9YĖI\xc5*yB\xfd\xe3\xe3ae}\x83\xa1\x00\xb9c\xc8&\x00\xe5\x0e\x10\x975\x15\x10|K\x97\x99\xb0\xdc\b0\xe3\xfa\xbc{\xfd\x92ƈ\x85`?\x01pi۰/\x10G.~͝\xf5\xe4\x97J\xe1&J\xb0\x91\bR\xa3\xf5\x16\xbaj\xeb:\xce\xe8ʍ}\x8a\x9f.Q\xa5\u0380%ɶ\xfc^\x0f\ap\x15\xf2yr\x1edĔ\xf3\xecc\xd0\x19\uf447L\xdb\x1c\xaf\x90\xc1=mO\xda\xeẽ\xb7kO|l\x1aYo\xbd'\xcaf\xf0.\xda\xf9\xc5\xfav\v\x9cW\xb9\x1b\x04\x95\xad{\xf7\xb4\x01k0m\xb3$/z/w\x81x\x1c\x84\x8f\x10\xa1\xab\"\x0e\xa4\rf\xf7W\b\t\xa7+\x8aJ4\x12\xb6\xa3\xcf\x04\vJ\xb3\xab\xf1\xb4*r\xbdt\x92\xed\x8bˈK\x1f\xac\xb5wSG>v}\xcd-E\x94\xe6֚\x13\x8b\x18\xfa\xa76\xe1O\xff?џ\x8c_\xeemף\xa0\xde\xf5\n\x81owaj\xd9߇\xfd\xec\xc1\xca\x06\x1dW6\xdcݞ\xdd\xed\xc5~Xo\xe5z\x7f6\x89`q\xff{\xac~\xcb\xc7G\xda\xf0 \xcf/5E\x0e\xe8\xc3>\x1a\x9e\x17q4\xf4\x85s#\xe2\xca-\xed\x82\x1cz\f\xa7\x86\x19\xef\x83o\x8e\xbf\xb2\xbc\x02֒\xb7\xc7\xdc'%C\xa9\xd4e9N$\xb5\xb3>\xd9\xea)\xe2\xe8 \x18\x05\xfe\xb1\xe8\xdf\"\xe6O\xd8\xc3QSw\xbbV\xc0\xe6\xf5\xe1-\x9e\xefY\xf7\x89)vtj\xa9\xc1\xe2ݭj\xd7rHC\xe4\x86\xca\x05R\xf7\xc7\x1f\x99\xae\xaeF_\x8d\xe2kiM\xcaf\xb9\x80O\x9f\xe5\xdbO\xbck\xed\xea).\xe0\xd3\xe7\xd9\x7f\a\x00\x81\x16-\x05\x9e\x1b\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Yߏ۸\x11~\xf7_1\xd8{\xd8\x1e\x10ɗ\\Q\x14z\xbb\xcb6Ŷw\x9bE\x9c\xcbK\x90\x87\xb18\xb6ؕH\x953\xb2\xe3\x16\xfdߋ!%[\xb6\xb5\xdeM\x8aKc\x03\xb1\xf8\xe3\xe37\x1fg\x86#\xee,˲\x19\xb6\xf6\x03\x05\xb6\xde\x15\x80\xad\xa5\xcfBN\x9f8\x7f\xf83\xe7\xd6\xcf7/\x97$\xf8r\xf6`\x9d)\xe0u\xc7\xe2\x9bwľ\v%\xdd\xd0\xca:+ֻYC\x82\x06\x05\x8b\x19\x00:\xe7\x05\xb5\x99\xf5\x11\xa0\xf4N\x82\xafk\nٚ\\\xfe\xd0-i\xd9\xd9\xdaP\x88+\f\xebo~\xc8\x7f\xcc\x7f\x98\x01\x94\x81\xe2\xf4\xf7\xb6!\x16l\xda\x02\\W\xd73\x00\x87\r\x15\xd0z\xb3\xf1u\xd7P \x16\x1f\x88\xf3\r\xd5\x14|n\xfd\x8c[*u\xd5u\xf0][\xc0\xa1#M\xee\x19%k\xee\xbd\xf9\x10q\xde%\x9c\xd8U[\x96\xbfOv\xffbY\u2436\xee\x02\xd6\x13<b/[\xb7\xeej\f\xe7\xfd3\x806\x10S\xd8\xd0o\xee\xc1\xf9\xad{c\xa96\\\xc0\nk\xa6\x19\x00\x97\xbe\xa5\x02\xee\xb0!n\xb1$3\x03\xd8`mM\xd4#q\xf7-\xb9\x9f\xeeo?\xfc\xb8(+j\xa2\xe2\xda\xdc\x06\xdfR\x10;\x98\xa8\x9f\xd1\xee\xee\xdb\x00\fq\x19l\x1b\x11\xe1Z\xa1\xd2\x180\xba\x9f\xc4 \x15\xc1&\xb5\x91\x01\x8eˀ_\x81T\x96!P\xb4\xc1\xa5\x1d\x1e\xc1\x82\x0eA\a~\xf9\x0f*%\x87\x85\xda\x19\x18\xb8\xf2]m\xd4\t6\x14\x04\x02\x95~\xed\xec\xbf\xf6\xc8\f\xe2\xe3\x925\n\xb1\x1c!Z'\x14\x1c\xd6*BG/\x00\x9d\x81\x06w\x10H׀\u038d\xd0\xe2\x10\xce\xe1W\x1f\b\xac[\xf9\x02*\x91\x96\x8b\xf9|me\xf0\xe7\xd27M\xe7\xac\xec\xe6\xd1+\xed\xb2\x13\x1fxnhC\xf5\x9c\xed:\xc3PVV\xa8\x94.\xd0\x1c[\x9bE\xe2N\x8d\xe5\xbc1߅\xde\xf9\xf9z\xc4Tv\xbam,\xc1\xba\xf5\xbe9:٣\xba\xab\x8f\x81e\xc0~Z2\xf1 \xaf6\xa9*\xef\xfe\xb2x\x0fâq\vF\x90Ы}\x98\xc6\a\xe1U(\xebV\x14\xe2,X\x05\xdfD\x9də\xd6['\xf1\xa1\xac-\xb9cѹ[6Vt\xa7\xff\xd9\x11\x8b\xeeO\x0e\xafcTÒ\xa0k\r\n\x99\x1cn\x1d\xbcƆ\xea\xd7\xc8\xf4\xbbˮ\ns\xa6\x92>-\xfc8\x19\r\xfft~ѫ\xb5o\x1e\x92\xc5\xe4\x0e\x9d\x86\xff\xa2\xa5R7LUӉve\xcb\x18\x03\xb0\xf2\x01\xf0,]\xe4#\xe0\xa9\xe0\xd4\xcf\x12ˇ\xae]\x88\x0f\xb8\xa6_|9\n\xf3GX\xfd<5c\xa0\xa5\x19N\xa3P\x7f'hP*\xb8\xa6\x13H\x80z\x98\xba\xad(Pt\x05ͦ\xb6TW\xf2lŇ\x9d\xc2\xea|2c[\x1e\x95]\xbf\xad7\x17\xe9\xdf\xfb\xde\xe9\x03\xad(\x90S\x97N\xd1\xdf\xfa\x98#\x04\xad\x1b\\?%y\x10\x7f\x82\bꆁ\xa6\xa9=&\xf5\xe3\xf9p\x92\xe8O\xf7\xb7C\x0e\x1c\x14\xed)\xcb\xe9\x8a\x17\x05\xd1\xefJ\xb3\xfc=J\xf5\xe4\xaa\u05f7\xab\xb4\x8c\xe2\xa82\b\xad\xa5\x92\x8eR+X\xc7BhR\xe3\x04$\x80\x06N\xa0~\xfc\x8b\x14\xff}\x9a9\xa4c\x95\x1aP\xf3\x8e5\xf0\xb7\xc5ۻ\xf9_}\xe2:\x89\x89eI\xac0(Ԑ\x93\x17\xc0]Y\x01\xb2\xee\xb0\rd\x16\x82By\x83ή\x88%\xefW\xa0\xc0\x1f_}\x9a\xd2\f\xe0\x8d\x0f@\x9f\xb1ikz\x016\xa9\xbcOh\x83\x7f\xa8o\xab\x10{<\xd8Z\xa9\xec\xb4ᨇno\xf06\x1a*\xf8@\xe0{C;\x82\xda>P\x01W\x1a\xc1#\x8a\xff\xd6\xd0\xf9\xcf\xd5$\xe6\x1fR\x88\\鐫Dl\x7ff\x8d#\xee@P*\x14\x90`\xd7k\n\xf1\f?\xff\xe8\x04ڐ\x93\xef\xc1\a\xb5\xdd\xf9\x11@\x84\xd5\xe8Ky\x86\xcc\x19Ꮿ>=\xc2\xf6\x80\xa2:\x81u\x86>\xc3+\xb0.\xa9\xd2z\xf3}\x0e\xef\xf5'\xef\x9c\xe0g\x8dǲ\xf2L\x0e\xbc\xabw\xd3l=T\xb8!`\xdf\x10l\xa9\xae\xb3T+\x18\xd8\xe2N\xed\x1f\xb6K\xdd\x16\xa1\xc5 \xc7\xd5\xc0$\xea\xfb\xb77o\x8b\xc4J]h픊\x9e2+\xabg\xbe\x1e\xf6\xb13\xfa\xa4\xf6q\x17єNY\xa1\x9bHk\xfa\x8d\x96\x12\xac:=\xc2\xf3\xeb\xd9ـ\xcb\xd1zzlO\aj<\xbeO\x13\xc3\xff\xe9\x10|\x96Y\xeaRO\x9bu7\xf2\xe7\x8bfi\x11\x1f\x1c\tEˌ/Y\x8d*\xa9\x15\x9e\xfb\r\x85\x8d\xa5\xed|\xebÃu\xebL\x1d1K\x81\xcds%\xc2\xf3\xef\xe2\x7f_eE\xac\x8c\x9fgJ\x1c\xfa-\xec\xd1ux\xfe\xc5\xe6\fu\xddsO\xa5\xebE_x\x9c\xceԐ\xd8V\xb6\xac\x86\"\xfd\x90='0\x01\x1a4)\xe5\xa2\xdb\xfd\xeen\xabBvA\xf9\xec\xb2\xfe]0Cg\xf47[\x16m\xffb\xe5:\xfb\x8c \xfd\xed\xf6\xe6\xdb8sg\xbf8\"'\vR\xfdj\xfdukT\xbe\x95\xa5P\xcc.\x18\xf8\xeeh\xe8P\x05N\xd4q\xfb1\xf9\xec\x99\x04\xd9a˕\x97ۛ\x8b\f\x16\xfba\xc3\xea\a\xc9\xfb\xf2m@R\x17\xbdP\xb7=\xca$\xc1\\d\x91\xea\xee\xa9*\xb8\xe7\xa0{\xd6\x1f\vZ\x81~\x15\x13}\x1d\xd22g\xcc$\x9b\xae\xe0\x8fF\xb4~\\\x01d'\xfb{\xd4u\x10\xfd\xa89\x191{\xc2w\xb40뎊\xde˯3q\xf8\xa0Y\x8aO\xe9AT\xbd\xaf{\xa1)\xbd\x16sǗ7\x97v\xee\xf5\xf9\xf8xC\x10L\xe2%\xb6\xa1\xf8\xb6\x109\xc3\x16yX\xe2|\xdf`\x84\x96&\xc6\xeb\x8a\xd2\aC&\x16[Z\a\xae\xd0\xd6d\x06D\xd6R\x88 \xdeɄ\xeb\xf3\\9\xc0tL&\xbe\xe7M\x10>\x9d\xb5\xf2\xa1A)@_\x933\x058\xe9\u05fb,\\\xd6T\x80\x84\x8e\x9e\xe7|\xfaRˌ\xeb\xcbq\xf0k\x1a\xa3\x84q\x98\x00\xb8\xf4\x9d\xec_\xb1\xfa\x80\xe8Ϳ\xe6~\xc7\xf3\xe7\xd2h+\xe4\xcb$\xeeuĔ_\xed\x83\xf2\x92c\xe9\x87\\ל.\x91\xc1\x1dm\xcf\xdan\xdd}\xf0\xeb@|\xba\a\xd9\xe0\vg\xe5w\x06o\xa2\a<\xdb\xe0~\x81\xcb6\xf7\x83\xa0\xf2\xf5\xe0\xb9^\xb0\x06\xd75K\nj\xf8r'ă\x02C\xa0\x9f`B_\xf3\x1et;\xcc\xefw\xcc$\xa0\xbe\x82/\xd1i&\x8b\xde)\x1e\x8c\xe5\xb6\xc6\xf3\x12\xbe\x1d\xe8ii\xaaΩ\x11r\xf0\x8b\x1e\x1a4\xa4cߗ\xbcSG:7ޝ9\xc58\x14\xac\x93?\xfdq\xa2?\xb9\x99\xde\xf2\xad\x8fRa߫\x12\xfe\xbc\x93\xa9e\xff7\xecG\x0f_\x16\f\xb2\x8f\xec\x8b{\xbe8\x1a\xfaT֊\xc0S9k\x9c~\xce\xd3\xcd\xf1\"\xdf\"\xd3LHs\xd2\xd4_\x8b\x14\xb0yyx\x8a\aO\xd6_\xd0\xc7\x0eHYՌ\x16\xef/\xa3\xfa\x96Á\xa5W\v\xad\x90\xb9;\xbd\xa1\xbf\xba:\xbap\x8f\x8f\xa5w&\xfeс\v\xf8\xf8I/\xcd5\x87\x98\xbe\x10\xe6\x02>~\x9a\xfdw\x00\x98\xaaEc\xdc\x18\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WMo\x1b7\x13\xbe\xebW\f\xf2\x1eryw\x95 \x97bo\xad\xdb\x00A\x13#\x90S_\x82\x1c\xb8\xe4\xacĚK\xb2\x9c\xa1\\\xf5\xd7\x17C\xedJ\xab\xf5Z1\x02\xd4\xf2\xc1\x1c\xce\xc73\xcf|\x98ZUU\xb5R\xd1\xdec\"\x1b|\x03*Z\xfc\x9b\xd1ˉꇟ\xa8\xb6a\xbd\x7f\xdb\"\xab\xb7\xab\a\xebM\x037\x998\xf4\x1b\xa4\x90\x93\xc6_\xb1\xb3\u07b2\r~\xd5#+\xa3X5+\x00\xe5}`%b\x92#\x80\x0e\x9eSp\x0eS\xb5E_?\xe4\x16\xdbl\x9d\xc1T\"\x8c\xf1\xf7o\xeaw\xf5\x9b\x15\x80NX̿\xd8\x1e\x89U\x1f\x1b\xf0ٹ\x15\x80W=6\x90\x90\xd8\xea\x841\x90\xe5\x90,R\xbdG\x87)\xd46\xac(\xa2\x96\xb0\xdb\x14rl\xe0|q\xb4\x1e \x1d\xd3\xd9\x14G\x9b\xd1ѡ\\9K\xfc\xfb\xe2\xf5GK\\T\xa2\xcbI\xb9% 嚬\xdff\xa7\xd2\x13\x05\t\x10\x13\x12\xa6=\xfe\xe1\x1f|x\xf4\xef-:C\rt\xca\x11\xae\x00H\x87\x88\rܪ\x1e)*\x8df\x05\xb0WΚ\xc2\xc8\x11|\x88\xe8\x7f\xfe\xfc\xe1\xfeݝ\xdea_8\x17qL!bb;\xe6(\x9fI}O2\x00\x83\xa4\x93\x8d\xc5#\xbc\x16WG\x1d0RQ$\xe0\x1d\xc2\xfe(C\x03T\xc2@\xe8\x80w\x96 a\xc9\xc1\x1fk<q\v\xa2\xa2<\x84\xf6O\xd4\\Ý\xe4\x99\bh\x17\xb23\xd2\x06{L\f\tu\xd8z\xfb\xcf\xc93\x01\x87\x12\xd2)F\xe2\v\x8f\xd63&\xaf\x9c\x90\x90\xf1\xff\xa0\xbc\x81^\x1d \xa1Ā\xec'ފ\n\xd5\xf0)$\x04\xeb\xbb\xd0\xc0\x8e9R\xb3^o-\x8f\x1d\xadC\xdfgo\xf9\xb0.}i\xdb\xcc!\xd1\xda\xe0\x1eݚ\xec\xb6RI\xef,\xa3\xe6\x9cp\xad\xa2\xad\np/\xc9Rݛ\xff\xa5\xa1\xfd\xe9\xf5\x04)\x1f\xa4l\xc4\xc9\xfa\xedI\\\xba\xecYޥ\xc9\xc0\x12\xa8\xc1\xec\x98\xe2\x99^\x11\t+\x9b\xdf\xee\xbe\xc0\x18\xb4\x94`\xe2\x12\x06\xb6\xcfft&^\x88\xb2\xbe\xc3T\xac\xa0K\xa1/<\xa371X\xcf堝E\x7fI:嶷,\x95\xfe+#\xb1ԧ\x86\x9b2\xd7\xd0\"\xe4h\x14\xa3\xa9Ⴧ\x1bգ\xbbQ\x84\xff9\xed\xc20UB\xe9\xf7\x89\x9f\xae\xa3\xf1G웁\xad\x93x\xdc\x16\x8b\x15\x9a\xcf\xff]D-\x05\x13\xd6\xc4\xd0vV\x97\x19\x80.$PO\xf6E=q\xbc4\x9c\xf2i\x95~\xc8\xf1\x8eCR[\xfc\x18\xf4d̟A\xf5˒\xc5\bKV\x9cL\xa1\xfc\xbd\xa88\xf3\f\xc0;œ\tee\xfdi\xcc\x17\xf2x\x96r\xf9핌\xabW^\xe3\xfb\xd2;^\x1f\xae\xe6\xf2i\xc1@RمG\b\x1d\xa3\x9f\xba\x1cQ\xb68s\t\x90\xb2\x7f1\xc8\xe3N\xfe`\xa4\xb5:\x8b\xe9*\xc0\xcdLy\xe4\xb9\xcb\xce\r۽ҡ\x8f\x8am\xebp\b'\xed0s\n`\x8f\x01\x0fr\xff\xa3\xfc\xee\x83\xcb=\x9e\xfe7\\E~\x7f\xa9;m\x90b<\x82\x90\xfc&Xf.a\xec\t\x82\x18\xcc\x00`hZ\x92<_\x88]\xba\xc1&\xbc؆\xd5r\xf3_h,uԅ¼\x9a\x17\x973\xbe\xbe\xbb\fXq\xbe\x98\xcf\xeb련\x8f\xc4\xea\x9c\x12z\x1e\x9c\xc8\f\xfe\xd8Bp\x8ax2\x16\xf2\x06\xbaZ\xe7\x8fO\xf5GH\xe2\n\xd8\xf6x1E\x8f\x8a\x96\xe6\xa5\v\xa9W܀\xac\xf6J\x8cf\xf7\xf2\x02S\xad\xc3\x068e|Y\xd5e\x11\x13\xa9\xed\xf5\f>\x1du\x04\xb5\x1a\r@\xb5!\xf33Ċ\xf4\x1a\xb5W\x11ŝ\xa2\xebx>\x8b\xc6RY\xf1\xa5\xc1\xd1\xe7~\x1e\xa2\x82[||\"۠2\x87\xa7\x9a\x81\x97.\x9e\xc9i\xa1\x97g\xa2\xe1)\xd7\xc0\xfe\xed\xf9T\x1a\xbd\x1a\x9e\xd4\xe5\x02\xa0\xbcLͤ\xc4t\x9c\xcdAr\x1e\x10\xa55FFs;\x7fR\xbfzu\xf1B.G\x1d\xbc)_\x13\xa8\x81\xaf\xdf\xe4\x91\xcb!\xa1\x19\x1e\x9d\xd4\xc0\xd7o\xab\x7f\a\x00lC\xbf\xee\x8e\f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}{s#\xb7\x91\xf8\xff\xfc\x14(\xd9U\xdc\xfd\x85\xa4\xbc?_Rw\xaaԹ\x94\x95l\xab\xecղVʦR\x8e\xcf\x01g\x9a$NC`\f`(1\xe7\xfb\xeeW\x8d\xc7<(>\x06\x18J\xdaM\xc8Q٫\x11\xa7\a\xe8\x17\xfa\x85\x06\xcd\xd9G\x90\x8a\t~Fh\xce\xe0A\x03\xc7\xdf\xd4\xe8\xee\xdfՈ\x89\xd3\xe5\x9b\th\xfa\xa6w\xc7xzF\xde\x16J\x8b\xc5\aP\xa2\x90\t\\\xc0\x94q\xa6\x99\xe0\xbd\x05h\x9aRM\xcfz\x84P΅\xa6x[ᯄ$\x82k)\xb2\f\xe4p\x06|tWL`R\xb0,\x05i\xde\xe0߿\xfcj\xf4\xf5\xe8\xab\x1e!\x89\x04\xf3\xf8-[\x80\xd2t\x91\x9f\x11^dY\x8f\x10N\x17pF$(-$\xa8\xd1\x122\x90b\xc4DO\xe5\x90\xe0\xcbfR\x14\xf9\x19\xa9\xfe`\x9fq\x03\xb1\x93\xf8`\x1f7w2\xa6\xf4\x0f\xf5\xbb?2\xa5\xcd_\xf2\xac\x904\xab^fn*\xc6gEFey\xbbGH.A\x81\\\u009f\xf9\x1d\x17\xf7\xfc[\x06Y\xaa\xceȔf\nz\x84\xa8D\xe4pF\xae\xe9\x02TN\x13H{\x84,i\xc6R3E;.\x91\x03?\x1f_}\xfc\xfa&\x99\xc3\xc2 \x11o\xa7\xa0\x12\xc9r\xf3=?>\xc2\x14\xa1䣙\x1f\x0e\xc2\x10\x82\xe89\xd5D\x82\x19\n\u05ca\xe89\x10\x9a\xe7\x19K\xcc[\x88\x98:\x90\xa4|F\x91\xa9\x14\x8b\nք&wEN\xb4 \x94h*g\xa0\xc9\x0f\xc5\x04$\a\r\x8a$Y\xa14ȑ\x03\x93K\x91\x83\xd4\xcc#\x16\xaf\x1a+\x95\xf7\xd6\xe6\xd0\xc7I\xda\xef\x90\x14\x99\a\xecP\x97\xf6\x1e\xa4D\x19\x04\x101%z\xceT5%3\x8d\x1aX\x82_\xa1\x9c\x88\xc9\x7fC\xa2G\xe4\x06) \x15QsQd)r\xdc\x12$\xa2$\x113\xce\xfeQBV8A|eF5(݀ȸ\x06\xc9i\x86\xe4)`@(Oɂ\xae\x88\x04|\a)x\r\x9a\xf9\x8a\x1a\x91w\x86$|*\xce\xc8\\\xeb\\\x9d\x9d\x9eΘ\xf6\u0093\x88Ţ\xe0L\xafN\x8d\b\xb0I\xa1\x85T\xa7),!;Ul6\xa42\x993\r\x89.$\x9cҜ\r\xcd\xc09NV\x8d\x16\xe9\x17%\xb1\xfa\xb5\x91\xea\x152\x94Ғ\xf1Yy۰\xf6V\xbc#\x8b[α\x8f\xd9)V\xe8e|f\b\xf1\xe1\xf2\xe6\xb6\xceUL\xd5@\x12\x87\xed\xea1U!\x1e\x11\xc5\xf8\x14\xa4%\x9c\xe1-\x84\b<\xcd\x05\xe3ڀO2\x06\xbc\x89tUL\x16L#\xa5\x7f-@!\xeb\x8a\x11ykT\b\x99\x00)\xf2\x94jHG䊓\xb7t\x01\xd9[\xaa\xe0\xc9ю\x18VCD\xe9~\xc4\xd75\x9f\xff\xd8/Zl\x95\xb7\xbd\x8a\xdaH!'\xdd79$\r\xc9\xc0\x87\xd8ԋ\xf1TȆ\xf0\xa3B\xf0\"\xb9M,\U00072c8d*\xa8y\x7fm\x10\x7f*\xbf\x86\xbc\x82\x04+8\xfb\xb5\x00\xa3BQ\xe0\xf0\xd6#uQi\xc2\xe6\aY\xa0>\xb8\xad\x18\xc4\x1fxH\xb2\"\x85\xb4T\x93j\xe7H/\x1f}\x1dE^SƑ\xc7Q\xa9\xe3py\xf5W\xa3 \xe9\x86Q\"\x9f1n\xa1\x11\xc6\r\xd27`\x16\x7f\x98\x86ţa\xed\x98\x131\xab\x16\x9ddpF\xb4,\xd6\xdfm\x9f\xa3R\xd2\xd5FT\xf8U\xb6\x1d&\xcao;1\xcfX\x02\x88\x83R\x98\r2>'<̅\xb8\xdb=\xf7\xef\xf1\x1b\x956\"\x89\xb1N\xc8\x04\xe6tɄtTwK\xc2\x04\b<@Rh\xb3\x027\xaf\xb4\xc0A\x13!I.\x94\xde6\xefm\xd2\xd5XU\x1f\xffi+¶)\x01OJ\x9c^C!\b\x0e8\xc6\x05\xae9\xd5w\xa5(\xecwUo\xc3\v\bن\x052\xa1\nR\"\x1c\xad\x8b\f\x94{Sj\x14M%=\x83-\x80\xcbI۵2\xa3\x13Ȉ\x82\f\x12-\xe4:\xf6\xf6㰭&\u0602\xbd\r:\xc1iO\xa7K\xeb\xea@l\x85I\xc8\xfd\x9c%s\xbb\x8c!\x0f\x1a($\x15\xa0\x8c\x90\xa0Y\xb5\xda<\xb9=\xb4\xde+&-\x05f\xbf\xe8<\xc6f\xa9\x1e\x02\x91Y>\xb7\x86˒\xf4\xff:\xa8d|\x9d\xbfZ\xe2\xf2\xeaу\x87dLD\"\x035\"WS\x02\x8b\\\xaf\x06\x84i\x7f\x17\xad]j<\xa7mW\xf5\xeeώ\x10\xa1<}\xb5\xfe\xdc\x01y\xba#\x15\xcaW\x7f6D0\xca\xfe\xc6\xe9\xfa\x96\x04\xf8\xb1\xfè\xb0iI\x80t@\xa6,\xd3 \xd7(\xb1\x15.A\xce\xdeI\x89\xae(ؿRᵠ:\x99_>\xa0㭪\x80G+l\xac?JX\xddvm.\xa6;\xa1\xa2\xf5\xf1k\xc1$,\xacKv;\x87\xc6\x1dB%\x90\xf3\xeb\vH\xb7sW+\x0e{4\x85\xf3\xb5a\xd6_\xeb\xec\xd0v\x13pFJi\xc3\x1b\xf7T\r\b%w\xb0\xb2\xd6\x05:\xfb9H\x8a\xaf\xc1/\xef\x85(\xc1\xf8\xf8F\xb4\xef`e\x808\xb7}ϳ\xedH\xef\xfcnX\xed\xff\xd2\x1a\xdap4\xce\xc1\xb2\xf8\xc3\x1b8's\xab%\xcd}\xd0\xc5k\x98ݴ\rP\x11\xfe\xf2\xd8\x0e\x9e^I\xa6*N`\t\xd9G7?3\xae\xac\x9a\xb3\xbc\x05\\#\xe6\xc8EF&|\xd0\xe5#\x86\xcf\xca\xf1Y\xfe\xbe\xe2\x03r-\xf4\x15\x1f\xf4Z@%\x97\x0f\f\x83\r\xc8\x13\x17\x02Ե\xd0\xe6\xce\xc1\x91h\x87\x1c\x8cB\xfb\x98\x11!n\xd50ο\x1e\xbb\xd9\xcb\xc4\xf6\xe7jjx\xaa$\tS\x18I\x11\xd2\xe1\xca\xfcѽl\x97\xb6o~\x16\x85\xd2\xe8Ip\xc1\x87f\xb1\x1bmz\x8fCqKF\xaeS\xe1\xf1\xb0\xcaW\xda\u05f5\x82x\x8bv\x92}\xdaF\x123\x8c\xbez_\xcfD¨\x86\x19K\xc8\x02\xe4\fz{\xc0\x99\x9f\x1cuv\x9b\u05f7ҥ\x11\xfc\xd4fi\xf6\x1f\xa7\x8c\x1ba\xc1M\xd7\x10es\xefw<i\xf7|qc\xe8+~\x1ef\x914v\xc3\x1el\xd245\x99\b\x9a\x8d[k\xef֘o\xc8fmHF@ɂ\xe6(\x9d\xff\x83K\x95\x91\xa5\xff%9er\xaf\x84\x9e\x9btB\x06\x8d']\xe8\xa5\xfe\x12\x84\xcf\x14Aj.i\xb6\x1e@}\xfcA\x95\xc9\td\xc6\x1e\xc0\x91\xad[\x1a\x03r?\x17\n\x90\xecd\x8a\xe9\n\xb2\x16\xe7}|\x9d\xdc\xc1\xead\xf0H\xc6O\xae\xf8\x89]\x9e\x1fI\xac_\xcb\xf7\x00\x16<[\x91\x13\xf3\xe4I\xbc\xe9Ҋ\xebZ|\x89o\b\x91na\x83z\x98\xb4\x8a\x8f:St\xd4\xeb\xc0s\x18\x83\xfa~S\xf0k\xcbH\xc6\xfe\xfbM\vrC4i\x8fg\xe3\"C\xa5\x8a\xe4)\xa1S\r\xd2\x05\xc4̽\xd26\x1f\xf5\xa2u_c\xf4\x1b\x86Y\x06\xbc\xa8\x0f\xc5\x19\xa4\xee\x80H\\h|\xff\xe0\xda[w\x88\x8d\xdd\xdfX\x9b\xc9\xe5C-VG\xb9\t76&pH\xbb\x13s\x1c\xb4\x99\xf2i5ȷ\xf69Ϲ\x0e\x8c\x11a*g\x05\xaa\x8c}\"\xeb\x18Y\xf8H\xa2M$\xde3=g\x9cP\x1f\x88\a阇\x92\\\xa4\xbd\x9d\xb0\xdc5\xa7\x8aL\x00\xb8GZ\xfa\xb2+\xed\x82\xf1+\x03\x9c\xbc9\xe8\xbaL*\x14E\x90\xcf#\xb7$`yî\x1cm\x91}?\a\t\r\x1ex\x1c\"6v\x1dF\xea*?\xbd\x15l7\x8e\xbe\"S&U\xe9\xd7\xd9Q\x17\xaa\x1da\x83\xa8\x85#\xc6r\x01Q\xe8`\x9c^Vϖ\xe2\x8b3X\xd0\a\xb6(\x16\x84.D\xb1w\xd1u\xabٔh\xb6(\x93d\x0e\xa3\xf7\x94i\xa3\xa0\x10*j2\xf4j\x12\xb1\xc83\xd0\xed\xec\xce\tL1\xe8\x9f\b\xaeX\nҧkq\xd6\x05Z=\x84\x92)eY\xf18i\xd1\x19\xb3\x82_J\x19\xe1\x05\xbe\xb7ϕ\xac\x83\v\xe3}\x131-@\xe2\xd4\xe7t\t\x18,b\x9a\x00O\x90\x16\x18'B\x05k^\xe0\x90\xc0g\x8f\xf3\xd5\xdb>m\x941^\xc0\x8bE\x9b\x89\x0f\x8d\\2\xbe#\x9cT]C\xf2-eYo\xef\xf7\xc2Ȅ<\xe6\x988\x98T\x7f\xa9\x9e}\x06\x01\xa8\x94\xc1Nc\xa4\xba&\x98\xed\xa2\xe9\xcaK\x01\xd5\x1a\xdd@#\x04\x82Ȃ\u05f5\u0601\xf9\xbf\xbd\x0f\xe5\u07bf\xe7{\xad\fU\xfc\xc1ª\xb3^\x00\x11\xaf8\xab\xa8G\xb9\x01\xf0d\xd6\a\x02/\x97\"\x15\xccpW\x8d\xc7qQ\xf0F+\x02\xae\x96\x8b֖\xc8\x04\bMSHQ\xb1\x1a{\xc3۰\xb6\xb4dc:\xb7\xa31јP\xe9\xcaՋ\xaej\x8c\xde&^i\xaf\x95(\xc8=\xc5z\x19\xcbڥY\x95\x8bV\xabf\x18\x1d\x9d\xef,g\xad\xbf\xbb6\xf1\xfe\xb97\x1a}a\x15p-W\xa6\xe4\xa7\xddp}\xb0\x06H*\x92;4\x11\x16t\x06\xfd\xbe\"o\xdf]x{\x01\xd5\x7fk\xed\xeeHis\x8c\xb9\x14K\x96\xa2)\xf3\x91J\x86\xa9\x0f\"a\n\x128&\x80\xbe|\xf5\xf1\xfc\xc3/\xd7\xe7\xef._\a\x80\xc6x#<\xe4\x94#\xc7\x15ʯ\xc6%\xbdq\xf0\xc0\x97L\n\xbe\x800<\\M\t%K?Ҥ\xac\x83B\xc7&[B:p\xf9\x117\x83\x00\xc8.\xb0\xc0x^h\xa7\xfb\xc8=\xcb2\xb4\xf7\n\x9e\xcc)\x9f!\x96.D1\xc9\xda\x19%\xf6\xfa\xf2K\xe3\xa4KH\x8b\xc4\v\xa0\x13\x86/\a.EC\xb3L܇\x10\x0f\x8d%P\t\xcd=nk\x84\"j\xc55}8#l\x04\xa3\x00\x98'_ր\x9cع\xe7R\xe0\xb0\rf\x1cN2\xa6AҬ\xd7\x1a.9\xa9\xc3\x1d\x91K\x1c7\xa4u63\xefⰄv\xb1\xd0r\xd9\xf3L6 \x12fT\xa6\x19(\x85Z\xf2~\x0eznJ\xed\xa0d\x96\x00\xc8\xe0#\xb3(!\x1b\xab\xed\xaa\xfa\xba\x00\xb0\xbe\x00\xf2\xae,\x1b\xc5b\xbcT$\xeaTSu\xa7N\x19\xc7eo\x88\xd5rÚ\xa2<\xb5\xab\xd6Э\xa0C\xef\x87\x0eK\x81:\xfdB\x16\x9c3>\x1b\xd2\xf2[\x8c\x0f\xe9P\xcd!\xcb\xfa\xbd-\x03\xea\xa2ރ-\x858O0ؙߤ\x83/K\x95k\xfd\xcf\x11F\xf7K'\xae5PR-6\x06\xaf\xa3\x8dZ\xf9\xf2\xfa\xf6\xc3_\xc7ﯮo\x03\x00\xaf\xa9\xf1\xed\xca9\x00fM\xbej\x02\xb8A9\a\xc0ܩƛ\xca9\x00\xea^5\xee|\xf7\x00\x90\xed\xd48\xf9\xf2\xcb\x00\x98G5~T\xe3G5\xdeA\x8d\x03_F\xaa\xf0\x1f\x9d\xfbSS7%G\x84\b\x9c\x16&W\xcexS\x93mb\x8e\xa7\xc3vcf\x97|\xf9\x916K\x01x}\x9a\x01pI\x89\x12‡ޤUL4D\x8d\x84{Im2D-\x10r]\xab\xa5\x8f\xc5C\x1d\x17#\xf2\xce\xe5\xc6)y\xfb\xcb\xd5\xc5\xe5\xf5\xedշW\x97\x1fB\x90\x11-#e\x89C'\x94\xf4\x0f\xe7\x9a\xedt\xd0r\tK&\n\x95\xadzA\xe0\x8c\xb7\x8f\xba-\xadS\xac\xa4\x80\n_\xbe\xbd\xd9\xe7\x18\xd7\x158\xad\bn$c\xc9a\x18C\xad\x19,\x95\x19\x12\fr\xb7\xd9b\x8d\x91`\xa0\x875^\x9eʄio\xc8\x04\x03E\xffu\x9f9\x13\x8bUg\xfel1j\x82\xa1n4\x82\x1a\xa6M\x04\xc8\x03\x9aB\x1b\r\xa2\v\x98\xd2\"\xb3\x91\xa9\x93\x93Q\xffY\x15\xe2\xb7R\xb4J\x1dlU\x8a7&\x1d^F\xcd\x0f\xa1\x11\xfa\xae\xb0\xb2a\x0eX\xb7,\x02fV\x80\xf7\xe3\x02\xaa\xb2\xba\xaf\xc0NsN\xd9\xec\x1d\xcd\x7f\x80\xd5\a\x98\x86\x03XG\xb6\xa9\xb9te\x8aȏ4\x02\"AK\xc4\x0e+\x14\x17]\xf1\x11P\x89\xba\x17\x17\xb7\xae^\xd6ؒ\x88\x96\x98\xc9t\x12\xa0.\xb6\xd6\xc6)\xf5\xebF\x97\xd3e\xd1\xd3\"\x9bv\x9fnp\x96\x12\xc1\x13ȵ:\x15K\\\xd5\xe1\xfe\xf4^\xc8;\fba\x98ehs@\xea\x14'\xa9N\xbf0\xff\x8b\x1e\xd1\xed\xfb\x8b\xf7g\xe4<M\x890\x1ee\xa1`Zd\xb6\xb8\xabe\xa5覫\xda\xd2= \xb8\x1bv@\n\x96~\xd3\xefE\x01\xeb\xce\x0f\u0090\x93f\a\xe1\t\xdcYǦ+\xbf\xf2D\x82\xc4\xc2u\xa8\xe4\x1e\xd7\x1eL9\xa1\xfc`\xc9j4\xd4\tx\x933\x12\x84E\xf6D\x88\f(\x8f\x80\xd16\xf1\x19[P\xda)9\xba\xe92\xbc~\x88\xb5\xa0_-\x06\x06f\xbdyB\xc8\xc7\x15\xc1\x9c\x11U乐Z\x95[\xc5G(\xec\x83^0\xc4\xdan\xf3Q\xb9ok@\xfe^\xde4\xbb\t\xd4O\xfd\xfe\x1f\x7f\xb8\xfc\xeb\x7f\xf6\xfb?\xff=\xee-\x15\xc4Z/\x8f\xee`\xb1\x14d\xc4E\n\xa8\x8e\a\xa62d\xe4<\x9e\xf3\xc4\x14v\\G#Fi\xaa\v5\x9a\v\xa5\xaf\xc6\x03\xffk.\xd2\xf5\xdfԨ\xff\x02\x8b\xf3\xe6\xe6\x18\xd1<\xea`\xb9%-\x12\"\xf1\xdd6\x90SMے1\xd5s\xb4\xe9\xee%\xd3\x1abԆ\xf3l8\xd1 \x17\x18\xe4\x1c\x90\xb4n\x86/ߜ\x8c^j\xf9\x98\xfa)\x1e\x84\x04\x06WΤ0\x90#\x81\xba\xa0\x1d\xaa\x1c\xefO\x97\xd5v\xd1 \xcf\xc7W\xbe\xa9\xca\v\xa1\xbb\xdb\xfaQ\x92\xea\xb9W\x11_@\xfc\xed\x13\xac&\x1ev\x04H\xe2$\xbd\n\x19\x9d\xd9\xcay\x0f3<\xa4\x81W\xc6\x16\xcc\xed\x82*\xfb\xaf\xbc\xb27GI^\xc4ib\xf7\xfc\x02\x16B\xae\x06\xfeW\xc8\xe7\xb0\xc0\x8c\xcc\x10\x8bq\xe8,R\xcd\xfba\x9aᕃv/\x8b\x82X\x9f\xfc\xe3Q\x86\xc7\x1e}\x18*)$z\x19\xd9ʯ\xff\x90\xbe\xc8\xcaSr̦\xf6/q,]\x06\xdc;yh\x95\x8e0A\x8e\xa5Ȋ\x05\xa8Ai\xe5G\x83Eh\xc0\x97\x18\x9fm\xb4\xefyF\xedGHʖL\xb5+\x9b\xdd\xf4\xa1|\xf5>J\xf9\xe0\xcf\xd0\r\x1f\x1bZ\xcd@v\x84\xd2\x01\tk\x8cs\xe3\xd65[\xb9.\n\x1d\x136\xf6\x9f\xa9\x90\v\xaa\xbd^\x84\x87\\`$\xabԇq\xea\x05\xaf\x86\xbd\xf2\xe6$\x12N\x8eU\xaa\x92\x9f\x91\xffz\xf5\xb7\xdf\xfd6|\xfdͫW?}5\xfc\x8f\x9f\x7f\xf7\xeao#\xf3\x8f\xff\xf7\xfa\x9b\u05ff\xf9_~\xf7\xfa\xf5\xabW?\xfd\xf0\xee\xbb\xdb\xf1\xe5\xcf\xec\xf5o?\xf1bqg\x7f\xfb\xed\xd5Op\xf9sK \xaf_\x7f\x13\x1e4\xb7\xd7ð\x8aa\f\x19\xd7C!\x87\x96\xf4{6\xca\xef\xba<9\xce\x0e\xc1>\xfd\x0fަ(\xe1v\xb7\xb9\xfa\x9f\xa3y\xd4a\xfa\x9d\xac#\x05\x89\x04\xfdi\xc5\\혼\xe9lw\x9d\x94\xce\xf1\v\xac\xb7\x87\x0e\xc3vu\xf1,z*\x1f\x037k\x8d\x88I\x1aG\x035\xc9f\xd3\xc4\xd2ÿ\x83\xe0\xf8\xff\x81$\xe9\x18&>\x86\x89?\x930\U0004d555c\x8c\xf8ebđ\x8f\xc6\xccrh\x94R\xef\x89\xc7\x16U\xa1\x16\x96\x98\xdeX\xa5\xe6Ll4\xa2r\x91\x17\xd8f'\xb2be\xbd\x84\xa6\x8ao\x8c\xfc\x02X6%\x8c\xaac6#%\x8b\xce\x15R\xe7YF\x18\xb7K\x9e\x19\x94/[\x91`}{B1\x8e\x12\x00\x11\x96X\xe4w?\x87\xb5\x89c\xfcUi*5\xe3\xb3\x11\xf9\xcb<(\fk\xf3\u05ee\x0e\x82q\xb2(2\xcd\xf2\f\x1c\"T\xad\xb3J\bT\xa5D°\xa4\xd4`\xd65.Rڣ\xd7\xe0Bӻ\x10+%\x97\x90@\x8a\xa5^X\xfcm\xfaF8:\x93ɊPN.\xf9Ҽ-d\x9c$-l9\xaa\xe1\x9cj\\\x8d\xb7\xd9ڇ\x00\xb0/R4\x89b\xeaJ@j\xb5\x93\xa1\x96\xa0#\x90\x98VM\x94\xca\\\xa5\xea=\xbdQ\\\xd6iD8\f\r\x8c\xdc6\xb2\xac\xa55\x1b\b\xd26%\xee=\x9fC\x10k\x9a>\x95Y\xfai\x99\xa4O`\x8e\x1e\xce\x14\xedd\x86v1Aw\x99\x9fѮ`%;~-\f_U\x0fa6F\xda`\xa8\x81`ʂ\x8b,\x1b\xb8<\xe7\xa5k@X\n\\c,2ܢG\xabGB\x0eܔ\x98\x02M\xe6f\xb1q\x06L\x89\xe8p\xfe}\xe1:n\xeb\xc9\x1fBQ\xdfl\x8a9\x1c\xb5\xeeQ\xeb\xfe\xabi]'\b\x9f\xa5\xca}&\x8f\xd4\xec+=\xebE\x91\xa9\x7fQۛj\xa4\xfe\xf0[\xdeJ\xa9,\x1d4uj\xde\x17\"|\xa6\x15\xa5\xef\xb4W-B\xb8C\x01\xf7#\x909\x9b!\x9bex@I\x00Xk]\x93\x05\xe5tf\xfa\xe5\xa1\xcau\xe9+\xacDDE\"Y\x1a»57\xd4L\x12\xe3\xeah\xfce\x82\xa6\xb5s\x9cB&\x9f\xb1; \x17\x90gb\xe5z\xfa\xf1\x94\xdch\xaa\xd1ػ\x01\x1dR\x90\x15\xa1\x1e\f\xb1\xc6E\x96\x8dEƒU,\xab]!\x18\x92\x17\xb8=\xc3\x00\x1a\x91\xf7x\x1cÔ\x9cg\xf7t\xb5\xf5\x8c\x84M\xd75v\x02\x18\x90\xab\xe9\xb5\xd0c\xbb\x93\xad\xb9[\xc1\x82\f\x80Ȧ\xe4\f\xc30J\x13Mg&\x84\xe0k\x88\x06\xc8\t\xf5W\x05\x805f\xf9=S\xb0i\x03\xe13\x8a\xda\x17\xe6\x9d\xe8\x80\x18j\xaa'e\x98\x8cM!Y%Y\xacV:O\xf0\xff\xee\xf0\x11t\xd9j\xf2\xa9VJC\x88\x03\xea\x1a(\x99 \x063\x8d\xf1r\xc1\x15 \x93T\xa2Z\x8e8\x00\xb0\t?\xa9Mt\xed=\xad\x89\x86\xdd-o0\xbe\x15\xf2к4\x8e=\x10d\xf5\x84f\x19nbY, \xc5(U\xf0\xf6>ߧ\xb0\xc2(B\xc5C\xe8\\\v\xbc\xf0\xf5\x7fNy\x9a\x814]\xd9\\ԭ\x01\x1d\xcb#\x19\xa7a\xed\x19\xaar%\x13 Ġc\x92\b\x99\xbaNX\xbe\xd7\x11\x95!2\x8eW\xa9\xd1P\xde\xeb\xfc*\xa6͡\a\u009dd\"\xb9S\xa4\xe0\x9aeU\xf3;\xdf\xf9\xce\x1d\x9f\x16\b\xb3\xbd\x1d]\x8e\xba\xf6\xcfa)+\xc396D=\xfd\xa2\xfa\x93\xb9\xd1^\xb5ċ@\xdb\xee\xa2{\xa4\x00\xd7\x1fd\aS\bh\xce\x06\x8aM\x15O\x05\x9a!\xc8FN\xdfLjE\xa8#\xd3 1\x02\xaa\x87\xe0\x8e#4j\x11\x15\x17*\xb3p?#\x1e\xd5Q\x1dV\xb6b}s\x03\xd5(\xb8\xb8\xd6p\xa8wRe\xa6\xbfcS\xe6b+\x99\x10\x88\xf3 Iʤ9\x86a\xe5\xf7\x13F\xc2t\xb35ݵ\xa4\x10\x9a\xbc\xea\x9f\xf6_\xbb\xe4M4L7Q\xd3.4\x03\xbbF\x9a\xfcO\xc7Q\xa2\x19\xc4\x16y\x86\x19\x11H\xfa)\x9e\x8c\x13\t\xd2mtĎl\x8eF\xaeI\u0380(\xd1\v\x06g~\xb4\xa4\xbeg\xb9\x85E\x18WZ\x16FPT/\x18\x9e\xf9y\xd5\xff\xad? \xa0\x93\xd7\xe4^\xf0\xbe6,0\"\xb7\x02\xfd\xfcH\x98\xe5T\xb19\x1d\a\xdb\xe5\v\x1e0\xd5\xc2t\xb6\x8a\xa5\x12\xcd2\x82=WQ%\xe0\xe1\x17\xae\xe9\xd0\xe5C4\x95\xec>\x0f4ʿB\x0e\xd5v\t\xc7\xd4\\Ɩp:\a\x9a\xe9y\xecx\x91\xef\xf1ă\x7f`\x03Slh\xc4\x1d\xbcp]\x16\x95!\xeah\xd6vu\xd4;F\x06*\xeb\xff;\xd0\x1d\x17\xbe\xefoo\xc7\xdfAՕ8</V\x8d\xc6\xd7~#K\xe7 \xb1\xaa\xf4\xb9\xd7&ܳt\x80\x85\xe9{<\xba\x10\x83 \xce9\xe0\xe1\xe4\xf1\x1f-\x9a\xdbv\\e\x1d\xb9\x1a\xc7\xf1:!\x7f\x15\x05\xfa\v\x13:\xc9Ve\x7fKlUs\x82Î-\xb2e܄n\xbe\a\x9abK`T\x9f@\x03<\x98\x03\x8aTm\x1c\a\xa0\xa5=g\x9b\xcc\xdd\xc4Z6\xca}|՚\x019>\x1f\x19\xe9\x89\xed\x83\xe1+br\xabX\xdd\xf8^@\x0169\xff\xf6vlq\xef\xb08\x89\f\x8d\xe3\x0f\xf5ǈ\xdaɹ\xee\xb2\u06044\x1a$\xe3f\x88F\x00\xa2G\xd6M\xc7tK\x8cl\xc4:fz,\x8e:@t\xbb\xf2B˥\x0e,\xbc\xb5\x96\x16\x9f&zB+v\x9e\x00?]\x8a\xfd\xa2J\xe2\xeaװ\x13\x06:\x18,ݭ%B\xf2\xe8-\xa7\r\x862\x1bN1e\x90$\xa6\x7f`h\x1e\xc8\x7fp17\xea\b\xb7^\x875M;\x18Ca\xcd\\\x1cJ:l\x8c:Ķ\xa8\x03l\x8aj\x10Ֆ\xf6H\u008b\xc5\x04dl\xab\x01\xdfl@\xea\x06\x834\xe3\bq\x84&\xe4\xda\x0e\xcd'1\xbd9\x81\xfd\xa2#!\xbe\xc1Q\xfe\xe1\xf7\xbf\xff\xfa\xf7#\x8b\x00\x0f\x9b\xf2H\x88W\xe7\xd7\xe7\xbf\xdc||k\xdah\x8dz\x9f\xc8\xfe'\xb3\xbd\x1eκsɍ\x01\x84X+\x14`\b'\n$\xf1^\x81\x8b\x17#w\xa0\xefQ\xe5\x9e\"\xc1ja\xec\x9b\x17\xd0$\xf1\x8b\xd2ЈK\xef\x19\x97\x12\x9d\xe47\x98\xaf\x8eP|\rf\xe8߾\x1d[@\x95\x03\x1c\f\x11\x15)\xa1&҄u\xcd\"[\"SPr\xfbvl\x10\x13CK|\xd6\xc4\xd0M\xa8l\x05\xba\xda\xf9l\x8bN\"`b\xf8Φ\"p\xff<\xc5c\"XbF\x19\x93\xf4\xf2\x1f\x1ce\xbf\xf7\xbc\x16\xf8\x81\xbc\xfc\xfe{_\xe4R9\xfcQPI-L\xb0\xc9\xe1\x8f\x04\xea\xc2\x04\xfd\xe7\xd7\x05G\xab\xa2\xb2*\x9c5!\xfdɄG\xab\xe2\x9fŪ\xf8|V\xbc\xc8\as\t7Z\xe4g\xbdh\xee\xef\x8f-\x88\x83\xd4\x06\xf83\xa7\xb6\xa5\xefI\x1aLD\x14&nZ\xf4\xf8سh$\xddMiF LU`s[\x9b\xe7\xe0\xa0ԩ)\x03(r\x1bs\xf2\x87Å\xa6\x12s\t\xd8\xda\xd3\xd4u\xfa=\xe7\x06\x11X<\x8d7A'\xa1ra\xc2F\xae:\xc2e\xd5<\x91\xba\x15\x1b$\x92\xaa9\x98cM\xe0\x81U\a\xe1S%8\xda\xcc%ј\bU\bL\x91\x9c*e\x13_\xba\x9a\x80IR\x92\xb1H\xfb\xfdP\x13\xac6\x182\x934\x01\x92\x83d\x02\x8b\xec\n\xaeSq\xcf\xc9\x04f\xfb\xcf\xcf\xdd¯8H/\x06h\xed zUy$H(\xcd>4\x8e-p\xcd;\x12Q\xd5G;|\x84\xf2W\x83\xdcv\xbb\x96a\xfe\x82f٪DQ\xa8|\xb9\xdd\x7f\xba$\xcdcd\aB\xb4\xa4y\xf6\xfa\x18deS;\x13\b\x16\x87\xb4\x95\xbf0s\x8f\x9b\x16¹\xa0\xaa\xf7;\x96\xdf\x1c\xcbo\x8e\xe57\xc7\xf2\x9bc\xf9ͱ\xfc\xe6X~s,\xbf9\x96\xdf\x1c\xcbo\x8e\xe57\xc7\xf2\x9bc\xf9ͱ\xfc\xe6X~s,\xbf9\x96\xdf\x1c\xcbo\x8e\xe57\xc7\xf2\x9bc\xf9ͱ\xfc\xe6X~s,\xbf9\x96\xdf\x1c\xcbo\x8e\xe57\xc7\xf2\x9bc\xf9\xcd'^~\x13\xf1\x90\xaf8\x19c\xa1\xc9Y/J`\xfac\x93`g\x89+W\x11ӊ\xc3[C\xac\x862\xaa\x8e\x84/\x8f\x97\xaezf\x04\x1dv\x8bRQ\x95\xd0l\xec\x97\x12\xdaĢ}\x06\xdd7^R\xa7\xb9\xb0\xff\xa9\xf2\xe7\xb5Ĺ\x19_@\xe6<n!\rϘ\xb7ɖW\xb9\xef \xd0d{\xa6<\xda*\xeb\x9a%\x8f\xb7O\\\xc24\xf4\xb1\xa7ʌ?UV|gF\u070f\x17\x8b\xad\"`?ʆWCm\xb6\x95\x88\x80};\x87C\xe7\xb4w\xe6\xb3\xeb\x99\xe9\b؏sُ\xb2\xd2\x11P\xeby\xec\x8d\x19\xe9\b\x98U\x0e{[3\x88\b\xa0\x98\xbf~\xbaL\xf4\x01\xb3\xd0\xd1\t\x98N\xc6jl,5ʜ \xbe\xf0\xf4v.A\xcdE\x96vXA\xde1\xce\x16\xc5\x02\x05[\xa1bb˲\xae5Tcx\x9dcVN\x97bB\xb0,\x05s\x1c\x1deYp\xbe\xc96\x11\x9bS\xe3ɫ\"I\x00RH\xab\xe0N\xb8\x88|=*\xe7\\\x9e\xb6\xff&\x8cϰ\x9d\x05\xd5f\xcb\xe3\xd7\xff?\xe8\xc9X\xaf*\xaa\xc4`\x7fy\x81\xa98\xecE\x9d\x15\x19]Z\x10\xbf\xa0\xc7\x05\x1b\x9e\xa2\x9c`G)\x01\x16\x05D@\xdcQF\xb0V\x10\x10\x01<\xba\x84\xa0\x83N\xecT:\xb0\xbbl\x00q\x13\f\x92\xec*\x19(\x93\xff\x11`\xa3\xcb\x05\xa2W\xaa\xa7)\x13\xd8^\"@X\\\xac\xa1[y@\xbc\x9e\xe8^\x16\xb0%\xe7\xdd\xf1D\xea.Q\xcd.\xc6I\xe72\x80\xa7AG\xf7\xe4w4>\xe2\xe3M\x1dR\xfe\xf1\xe9\xfeH+\xb1\x9bi\x1a\x9b\xe2ߝޏ\f\xc2wJ\xedw`\x96\xb8\xe0{d\xe0\xbdkнc\xc0}w\n?\x92pO\x10h\xdf\x11d'o\xe2\\\xe6\xcd\x01\xf6\xae\xa1\xf2\x03\x87\xc9c\x13ﻓ\xee\xde\n\x8e\xe1\x18\xb29\xe1\x1e\x9f:\x8f\xe6\xdf8\x85\x1e\x91<\x88TŌ3\xcdhv\x01\x19]\xdd@\"x\x1ah\xd54\x88\xd8w\"\x80\x87\x06Z`\xd6O\xee\xb4OpN\xdd\ty\x90\xfa\xed\x8e>\xf2\x1f\b\x17}\x19P\xe6\xb8~;ﵾ\xf6/\x19\xa5\x7f\x19\xf7\xddn\x12\xecN\xf8\xef\xc5=\x11S\r\x9c\xbcb\xdc\xd3\xfeu\xb8\xces\x8e{\x15\xad)\x85\x17e\xf7\xcdW\x1et\xa8\x04\x7f~\x81\x15\x13RR\xea\xa9\"i\x0e\xfc\xa1Ci\x0e\xec\xb4Ⱥ\x84\xd30̷\x16K\v%Xu\xbc\xd6\x1b3f\xaf1LR\xcam\x96\xff\xe7g\xa2\xc8\"\xa8\xbd\x05PU9S\x10\\\xb2\xb9\xf8\xa9Y\xca\x14\bqC\xe1\xd3\xe62\xa6@\xb8\x8d\xa2\xa7\x88\x12\xa6\x17\x8d&\x1e\xa8liw\xc9\x12\xeeQ\x8a\x00\x1aU\xaet\xf4\x94\"<\xa5\xf5\xb2\xa4\xa3\xa7\xf4\xb2\x9eҧ\xee\v\xd4zH|\x87]3\xc6\a2\x0e\xbd2\"i!\xa9\xab\x81\xf5\x86\\/\xa6D.5ۢ\xad\x1erc\x06\xdb\xe8cZd\xc1;\xa4\x8b\\pgϸ\xfc\xa2\xd9\r\xdfh\xa3\x11\b\xd2\xd5dl\x98\xaf3t\xc2%1\x97\x02\x03\x15\xa0\xb0\x8a\x80c\xd2\xd1I\r\xa6wУ\t<\xeb\v\x7fh\x9d\xe4D\xb1\x19\x9eR\x87\x06\x12J\xa3f\v\xc0shC\x97xg\x1d\xba\xc1\xe2ȦB&\fw\xa2\xcei\xe6\x0f\x15\x0f\x1e\xe9\x1d\x16v\xd9!\x8e\xc8\r\x1e7\x8a\x87\xecYS,\x13<\\\xd9\xea9\xb5\b\x84\x87\x1c\x12\x1cW\x92\x01\xe5Eng\x8eF\xe3J\x142\x8eL\xee\x18\xa9r\x84X\x00²\x81'X\x97\xf6A\x8fE\xd3\x17\xbd\x05\xc2\xc4\f\x8a\xebq\x83g\xbe\r\xea\xe3\xf5G\t\xc6ȧ\xa5I.Œ\xa5貯J6E\xfb1T\a\x7f4мF\xc7Z\f\x0e3j|\x18\xb7ȆB\xbc-\xc7h\xab:x\xca\x12<K\x8f(\xec\x9e\x14\xdf:lɨ\x99i\x8dS\xc9+.\x880\xc6i\xc1\x99\x0e\x85\x88\x19\xc5y\xa1\t\xb6\x87z\x8dJ\x89),B\xa1d\x02\x9aF%9P\xb8\xdd2\xa4\bp:\xc9P\x93\x8cQ\xf9\xddnd\xb1@\xf8S\xa0\xba\x90@fT\xc3\xc6r\x06\x93\xe6\x1f\xedd\xe7\xc0W\xe2\xd1,ت\x8bMI\xc1\x15\xe8h\x9f\xec\x0f\xff\xf6<>\x19[\x80(t\xf7e\xf5@\xc1\xb6\xfb9K\xe6u\x9f\x9e-\xb0\xabZ\x11\xbfQ\t#5nH\x9by\xe0I\x8f\x81\xfb'\x8a\xcfE\xd8qa\xc9\xe5\x06?\xd5\x0f\xc0.\xf1T\xfa\xfc!\x9a\x86\xa2\x8e\xba\xb8\xbe\xf9\xe5\xc7\xf3?]\xfe8\"\x97xhz\x05\x92qB\x03\x9dG\xa3\xff\xe7t\x89\x85\x93\x05g\xbf\x16`\x9d\x9aW\xe5[^\xfbZ\xed\x00\xa81\xa7`F\xf8g\xa8\xfeU$Q~d\xca\x1c\xcbh`\xa0\xc1\v\x0f\xb9\xc0\x04I\xd8\x11\xebM\x8f\x8d\\\"\x10\xd4\xfcH\a\xa9\xc9\x1cPg\xb3e\xd0z\x8f0m\xf7(BӲ\xb5\x12\x06\xd21̅f>\x9d\x88\"\x84\x1e\b\x91\x83F\t.\xb3?x\xb4j\xbd\x1bg\xa1 \xe8\xf0\xddI\xa1q\xcd\xcc%[PɲU}\x80hJ^\v\x1f\xd7Z\xb5\xa7(^u\xd4]\xbc\xbf\xbc!\xd7\xefoI.MCC[\xd3j\xfe\x1eH\xa8\t Y,\x91\xd3\x119\xe7+\xfb\x1a\xab\xa5\x19v\xfcT\x1ax\xd8P\x9d\xcb\xee\xe27\xe4䫑\xb9N\x90n\x12}z[\xf2\x1d\x00\xb1N\x11\xbf\xe5\xc2fR\xd9$\xb3\xdc\x19\x18mptߴ\xe3\xa2\xf7d\x85K\rQ+7\x91\x8c\x11\xe1\x12r{~\xb2\"4\x00b9\x11K6\xa3\xea\x14㳬.\x7f\xbd\xa7\x0f#\x96/\x1bG\x84\xbf\x1ah\xa9\xac\f\x1f\b\xb2\xdc\x19\b\xb3\xe4B\xe3\xfe\x90\xab\xb1g>gע\x86\x0f\x06\x891\x1e\xf4XXj\xd1m\x8f\xd5\x18\x90\xaf\xc8\x1f\xc9\x03\xf9\xa3\t\n\xfd!\x04\xdd\xddV\xf9\xd8u\xdeG}\xafƝ(\xf5\x17T:\b\a\xb1\x8bUr̺\xf2\x810q\xef\x8f\x06\x89\ue2e3x(\x06\xa3c\x988\xf8O\x8eaqPƟ/M!\xe7*\x7f:,KpxX\x93{\xed\x94O\xf3Dx\x1cm0D\x9c\x1dYP\x9d̫\xeduH\x1b4ߕ\xae\xb4Y8\xe4T`\x9e\xc7m$\x993\xf5y\bhL\xd9f\x83/\x0f\xc9Ak\x81m\x93\xd5tv1F\xd7\"\xeab\x9djv\xc6:N\xd61h\x84\xb5\xbe\xd3fw1\xfa\x98\xb6\x1a\xd5\x06i\xd4t\tŞ\xd9D\xc2\x14$\xe6\x9eQ\xe3\x85FX\xb0g\x9b\\\xb2\x04Գ\xe9\xb8\\\n-\x12\x91u⥱\x03\x82\xb2\xe0\x92\xa8\xef\"y\xe9\xcf\x17\xe3\x01f`\a\xd8~\xfa\xe6\xed\xed\xb8\x91w\x0f\x86xr\xfbv|\xf2LȌI\xa8\f+\xcd5\x0e˫\fK\xd2\xf5\x9e8\x15\x13S\x19\xdb\xc8T\xa1\x930\\\xd0|x\a\xab\x00\xc31\x167\x11\x98y<\\;\xe9\x05\xcd[\u0090@S\xf6\x89\xecDwJ\xa4\x1a\xd3\xe6-\xe9\v\xb1\f\xda\xc9a\xdc(\x0f\x1bx\x9a\v\x86\xfe\b\x9b>ڧ\x1e\x00tˎ\xf6\x97\x8f\xb0\x1d\xf7\xa9\x1f\xf7\xa9\x1f\xf7\xa9\x1f\xf7\xa9\x1f\xf7\xa9\x1f\xf7\xa9\x1f\xf7\xa9\x1f\xf7\xa9\x1f\xf7\xa9\x1f\xf7\xa9\x1f\xf7\xa9\x1f\xf7\xa9\x1f\xf7\xa9\x1fl\x9f\xfa\xff\xb1w\xad\xcfm\xe3H\xfe\xbb\xfe\n\x94k\xebl\xdfXJ2\xb5u\xb5\xeb/S\xd9<\xe6\\\x9bd\\q&s[\x99\xb9)H\x84d\x9c)\x80G\x90vt7\xf7\xbf_\xfd\x1a\x0f\x92\x12\xa5\b\x90\xedy,\xd7\x1fvb\x93?\x02\x8dF\xa3\xbb\xd1\x0f\xc6\"\xfe#\xc5\xe1\xd9K\xe1!O}\xc8S\x1f\xf2ԇ<\xf5!O}\xc8S\x1f\xf2ԇ<\xf5!O}\xc8S\x1f\xf2ԇ<\xf5!O}\xc8S\x1f\xf2ԇ<\xf5!O}\xc8S\x1f\xf2ԇ<\xf5!O}\xc8S\x1f\xf2ԇ<\xf5!O}\xc8S\x1f\xf2ԇ<\xf5!O\xfd\x8f\x9b\xa7^\n\xa3\xebr\x16cuv\x99\xea\x85^\x16\x88\x02}\uf042\xda\x1a\x97\x05B\x87Ic$l\v\x8f\x1e=\x04\v̴\x9a˅S\xbe\x9e,\xb9\xe2\v1\x0e\x94\x19\x87Q\x99'ǣ\x87\xb5\xe7s\xb9\x9419\xea\xf8i\x92\xbe/\x93}\bI\xe6\xeba\xc6\xebA\xa6k\xc1+\xa4F\x9e\xb3\xff<\xf9\xf1\xab_Ƨߜ\x9c|z:\xfe\xebO_\x9d\xfc8\xa1\xff\xf8\xd7\xd3oN\x7f\xf1\xff\xf8\xea\xf4\xf4\xe4\xe4\xd3\xdf\xdf~\xfb\xe1\xf2\xd5O\xf2\xf4\x97O\xaa^\xde\xd8\x7f\xfdr\xf2I\xbc\xfaiO\x90\xd3\xd3o\xfe4\xfa\x15\r\xc2\xee\xce{C\xbc\xe2~9uqpK\xfe\x19\xe23r\x94|\xa9kE\xf5\rfn;\a\xee\xb7\r0D\x16\xed\xfc\x8c\xbb%y\x88-\x98(\x12\xbd\xe9-̰\x13\x87\x9d\xb8\xcfN|\xef\xb8e}/ZU\xe6\x1e\xf7\xa2?Zc7\xe3Ŝ\x851J\xc3\xf4RV0`q\xd1\xc1ӓ6d\xd5q\xf1:yDYQ\x9c\x8a}4\x8d\xe6\"\x91[\xf9\xb9\xdaۛ\xb0n\xb8j|\xf5$)ƙ\x98K\x15ݖ\x87nd&\xbfk\x19\x95\xf0\x12\xc2\xe2KY\xad\x90\x12'>G8\xb9\xbb\xdc~Յ\x01\x0f \xff/\xd2G\xe5\a\xc34\xe1\xfa\xa4$G\xc2&\x7f+\x02\x12\xe92\xf0\x15\xd9\"\x01\xa2\x82\x87BX\xb3\x97r=\xd6\x06\x1e\x81\xec\xdd\x1b\x04\x88=x\xcbs\x94\x97i\xb0/u\xb6\x06\x1f\xb3G\xf7cĊ\x9b\x9b\x86\v\xc5\x18\t\x7f\x81bO<A\xe9\xac\x14\x9f\xab\a\xd7UI1\xb8,\xe5\xad\xcc\xc5B\xbc23\x9eӞ<?@\x92>߂\x19\x05\x89ή\xaa*un\xe0\x8f\x84\xfc@\xe6\xbc\xf5\x87R\xb6\xfa\x82G'\xe6/\xb1B\x85\x1f\x18\xb8\x15\xb2\xa82\xac\xe0%8\xc1\xfb\xf1\"a\xc9E3\xd5:w\x9dY\xf3U3v\x97^\xaa\xf4\xcfJ\xdc\xfd\x8co\xc7\x1a\xd6\xf3\x9c/Bګ\x11\xd5\xc6]L갷-\x13\x84>\x1a\x970\x9e\xdf\xf1U\xecpQ\xe4tm|Ҝ\xb3g\xa7pa3nX\xf8b\xac\xbc\xff\xfa\x94\xa2\x82^<\xbf\xfc\xf9\xea\x1fW??\x7f\xf9\xf6\xe2]\x8a\x8c\xc6J\x89\xa8\xc6\xea3^\xf0\xa9\xcce\xbc*\xd8\xd9\x18\b\xddnC\xd1a\x98eO\xb2RǦ\xbd\x10\x95\xfd\x9d@\xa0\xb4\xe9DODB\xb6\x8bZ\x11\x9bͻ\x83]\x94\\\xc5\xe7$LWk\xccP\xd6\nΦ8fM\x93mN\x9b\x8f}em՞gph\xb7I\xf1+\xf5\x00|ᇰj\xeai%`2v\xf9\xdd\xd5\xc5\x7ft\x17\x17;#\x01\xeb\x00\x93\xe3\x90Ppl\x98\x03W\xf5\xbd\xad\x1f0\xac\xebog]\x934h֜\xe7\x87D˽\xafUKFI\xd5B\x8d\x02el\xa931\xc1\xe5\n\x8eda\xbaX\xcd7b\x99\rW\xaa\x00Th0\x95\xaf\xda\xfak\xa5mf|\xb4\x82\xd5\x1f+=\xe7\xb9\x11\x93G9W\xa1\xb8\xbc\x85\xd3ꀕ\v\x18,\x13JW\xcejO\xe0{\x948+\xf5\x8cY˽\x15\x92\xde9\xbf\x12\x94\xc3\xe6X\x95\xc6S\xfa2\x8c\x9anb\"1Q\xb6\xb3\xffX\xf5\x9f\x8ae/8\x11Po\x85*w\xa0#\xa4\xa1[\xc3%77\"\xa3ԛ\x84\x89\xcb\xe0밋\x12&\xfdaU\b\x7fk\x18;Ph\xc36\x02\x95n2c\xdd(\x89\x92\r\xb4\xf9N\xe5\xab\xf7ZW\xafC\xa1\x89\x03\xd8\xf6\ag\xd3toL\xa0\xe0Fa\"Q\x12c\x1b\xd3\u0091\x18h\xd5\xc1\xf0\xdc\x16\t)\xcdc\n\x81\xb2V\xcfͷ\xa5\xae\x8b\x03\xc8\t\xd5\xfaۋ\x97\x90_03\xc0mBU劊\xfcD\xc12\xa6\xe7[\xec+\xf6\xbd\x8b\xc4\xc1N\x8b\x04\r\"\xc0_X\xb3\xb7|\xc5xn\xb47뢭\xd9\x1eo\x05sN\x13\x9c:S\x1d\x1dp\xb3\x06G\"`\xf3+g\xc9\xe1!\xc1#\x88\xf1\xad\xa1Ƃ\xf2\x1b\x81B\xc4b&2\xa1fb\x92z\xa7\xfbH\x01\x00\xc4\xe5ﴂ\x009\x80\xcf/B\xdc\n\x15P\xe9\xf0\xe9(\xa1\xa2\xa0\xb3\xc99\xc5ؐ\xf8\xa8\x8d(\xc9\xf7\x06\x17@\xcaR\xff\xbd\x9e\x8a\\T\xd6eA\xb59\x11\xa8\x87\xbf\xc8%_\xc4\xee\x1b^\x85\xa3\r\xb5G\x95AC\x04\x9a;\x1c\xd7Z\xa4D\x8f\xbbI\x7f\x7f\xf1\x92=e'\x98\xf5)\xb1:\x02\x01!A\xa8\xa3]$fWbȹ\x1f\x1e\x91\x92v<\x8b\xae\xd1HB\xf8\x8c)\x8d\f\x8bkOK\x84\x16zw\x90˜\x89\xbfK\xd8\x14>}\xceO\x88\x93H\xe0\x96\xf0\xf9\xe7\x11'\a\x1d}\xdf\x1bQ\x1ex\xf2}\xff\xe0'_\xba[\t\xf2\xa4\xbbR$\x06\xd8RT<\xe3\x15g2\x96\xc5j\x15\xe0&\x03#\xdf+#?\xfe\xb9h\xc4\x1b\xa9\xea\xcf6Z\xdc\x1c\xb8\x0f\xae^\x11\x18s\x97'\x90\xe5\xd1\x01\xb2\xbc(rh(\x95\xee\xee\x05/\xc8\xfdR\xa5\xacv\xb3\xb1\xfc\x99F\x82\x1cw0\bF\x8d\x1d)+\xb9\xca\xf4rc\xda0\xe6D\xa7KȄ$~,\xfe\xb0\xad\xeei[\xa5\xbb\xafsq+\xa2\x8b\x1b\xaf\xed\x8c7\xc0\xc0\xa5\x8e\xe7\x13\x02\x8d\xc6d,\xe7S\x91\xe3Ҭr\xbb$$\x855\x8c6zDWc\xa9\xf3C\v\x10\xbc\xd79%u\xf2@\x1c\x80\xfe\x01hC\xaf\x1eF\x9b\x0f\xabb\x8d6\x89\xde\xe4\xdf\x1am\xeah\x8dk\x836Pں\xb4\x01\xe8\xef\x9e6\x89.x#f\b9\xbc,\xf5\\\xc6n\xc9.ˡ\v\x92\x05kBJ\xc8\x13\x9br\xed؍E\xbe\x98\xafCGb\xf2\xb2\x95\xe0\xc2+{\x86\xf9,\x97\x7fi>\x15\tK\xd2\xf8\xac\xbb\xe4a\xf2>f%\x12ӏ\xca\xc1<\xdai\xa5g<ǍB\x12'lp\xc3:\\\x93\xb5\x13\x8d\v?i\xe1P\\\xb4\x19t\x1a\xf4}̅\x8b\xa8H\x00U:\x13\xad*\xd5(O\x87\x8c\x1d\u1fd5\x00铞\xa0\xc2\xfb \xa1\xcc\xc7|\xe0{\t\x98\x95v\xa5}}y\x04N\x92^\xa8\f\xe1\x03\xf0\xee\xc7*Y\xf8)\x05\xe2En\x85\x17X\x88\f\xceEulX3\xf0\x04X\xbfI\xfdr\x81\v\xc0\xc5n\xf4pt'\xa0z=vN\a\aD\xf7\xd1\x1b\xcf^G\x8f(aݫ\x87m\x8c#`\xb4r\xd8R\xee\x90\xf0s\x83\x9eFz\xbeAr\xe7^J@t\xd6\xd3\x04\x19|2\xc8\x1f\xc6Kq\xce~T,\x90<\x01z\xfc\x85-\x9c\x00\xe9\xb7\xd4\xc6\x16~oͳ\xb4\xeb\x13\x17\x8d\xddk\xefeɈ~\xea\xebC\xfd^\xd1n\x8b\x0f\x9fu\xd5\x03u\x0f\xb2_ţ\xc7\xdb\x17>(:\xee\xc8\x18\xc7_\xf4&\xaa8wRe\xfa\xce\u070f\x9f\xe2\a\v\xe6\r\xd4\x19DS%\xd5¤\xfb*x\x9e7\xecf\xee\xc3Y\xe1\xf7\xaeo?\xd8c\x9aG\xa2:\xb1\xe2\x18\xf7b\xbe\xcb\x19\x10\t\xbd\xc5u\xd0\xe7\f\x88D\xdet\x1d\xfcj\u0380\xc5\xd2\xf0\x17%\xfcz\x95\xe4\xf9U!f\a\x9e#߾\xbdz\xde\x05Lk\xccpG-OAk 2\x9e-\xa51tO!\xa6\xd7Z\xdf$@\x9e\xf8|\xa3\x85\xac\xae\xeb\xe9d\xa6\x97\xadh걑\v\xf3\xc4\xed\xc91\xe8r\x9a\xf0\r\xa9r\x1f\xf3N{G\xa0\x1f\x8c\xf3\x81c\"\t\x90\xb3@Mb8*\xc2\x12\xb2\xe17\xc9\xfd.\xadD\x0fE\xac?\xaaҲ\xc9z\xef\x92\n\x1a\x7f\x81\xfd\x12\xe9\xe1*o\xb4*\xde\x10vk5\x12@i\xfdl\x18У\x92\xda\x19<\xb8w9\x90\xbe\xff\xde \xb1L\xd8\xf4\xfb$\xdbI\xce;]\x83\x1bE\xc1ޤ& rv\x8c\xd1\xf98\xb9\xe3\x06}B\x81C\t\x90\xb4)\xa0\xd8\xf3\xbc\xb8\xe6c2\xab馆\x8e\x9dt\xb3\xe1Z+\r\x13l\x8a\xa8\xfee\xa1\x15\x89\t\xf2f\xd9\b\xa4\x04تQ\x02Z\x8b\x14\xba\\\xa5P\xd4\x17a@\xba\x00\xd5J\x81Ba[k\xa6\x16\xc6F\x1a\x0e\xb5k\xb9\x0e\xd1Z\xadl\x84R\x984MV*&\xcaR\x97\x94ءµ6\x8d\x96\xb4\x8c\xf8\xedF\xc9\f\xd8\xfa\x1c\xef\x1f\xb7\xfc>\xa6霘\x80\x8au2\x90*b>\x1732vۛ*\xb5\x82\xf6\x89\xac|w \xdc\xfd\xdc\xd9\xeb&\xd7\xddq)?'@\xeaygd\xad\xf9\xfbn6\xad?'\xe07\x80\xa7\xb8\xf6Q!M\xf6\x8c\xc9Χ\x13\xb0}\xbeI\x85\xa4\x86v\xe3UZ:\xdc\xee\xa6\x1b\xfc\xf0M\x94u\xb2\x04\x8f\xbf\xd3\xef\xdc\xeb\xdf\xc3!\t{\xc1CAYvb#\x1a\x94\xf5G\b\xf8\xf32\xaco\x02p_\x94\x00}\xa6{\xf7\x9f\x80\xdc\x17-жkR$\xc5~\x11\x03\t\xc0\xbb\r\x1a\x96֧\xeda\x8c\x1aG\xdb\xfb5l\x1e\xff\xe6!\xe1%W\x05\xf6\xa06\x97W-\x8c\x96\x17\x0e*\xc1ވ\xcc\x1fv\b\xf9mU\xd0\xcdW\xbe:\xb7\xfc\x1f\xb8w\xa2.\xd8\x03;P\xd0\x18%]\xb7\xcb_\xbb^\x7f1\xcc\x02\xb7U\xee\xafR\x90\xb4]\x89\xeehCͳ\b\xd0V\xafͳ@\x06\xef\x1c(\x85+\xfb\x1d\xe3\xb3\xf8/:(B\xb6\xa5\xaf\xfb{\x19>$\xb2h\xbd\xd2uD\x86\xb3\x02\xa2\xd3\xdd\xfc\xb0L\xce\xe7\xc2g\x8bN\x05RG\xf9RTqJ\x9b\vݜ\x8a\x85\xb4)|Au96M\x01\xda\x18\n\x90*%+\xb6\x94\x8bk\xbb\x91\x19\xa7\x12y\xcc\xc7N\xa2<\x12C\xc4U\x04\xaa.\xd9\x1d/\x97\xd0\xfe\xf9\xecZ`\xb5\xb8B\x85C\x8c\x99\xba8\xadƦ\x8a\v]\xc1\xe5\x92s\xe8cE\xd8\xec\x11k\x04=P\x99\xa8\xa1\xd9\xea\xd0luh\xb6:4[\x1d\x9a\xad\x0e\xcdV\x87f\xabC\xb3ա\xd9\xea\xd0luh\xb6:4[\x1d\x9a\xad\x0e\xcdV\x87f\xabC\xb3ա\xd9\xea\xd0luh\xb6:4[\x1d\x9a\xad\x0e\xcdV\x87f\xabC\xb3ա\xd9\xea\xd0luh\xb6:4[\x1d\x9a\xad\x0e\xcdV\x87f\xabC\xb3ա\xd9\xea\xd0luh\xb6:4[\x1d\x9a\xad\x0e\xcdV\x87f\xabC\xb3ա\xd9\xea\xd0luh\xb6\xfa\x1bm\xb6j\xaaL\xaa\xf3Q\x12Cm\xa9\xfam\x13*\xf7\x86d\xa1b \x04U\x8dxt\xec2;2\xaf\xb4\x04\xf4\bXW\xa5\xa29Z]T%\xb5\xe1B\x99q[\r \x02\xb1\x7fH\xbe\xec!\xda\v\xc5\xe7\x11J\xc5^}\xf7:을r\xe5)\xb9]4\x93\xef\xd4L\x1c\xbc\xf4=uAF\xd1aڳ\\\xa3\x9b\x1e\xf2\x13106\xbb\xe6J\x89\xdc)\xbfQ!\xb4\xf0\xfeO\x85PL\x17\x02u\x91\xa6+ƙ\x91j\x91\vƫ\x8aϮ'\xec\x878-\xd5-{\x93\xd7\xe7~c\x107\xba\xf4\xf9\xa3˸\x0e^\x18\x1e\xe3\xb3R\x1b$u\xe6\x95,\xc2\x00\x99\x11Tp\xc0\xc4&\xcc\xf8E\x05\x13\xb5\xd2\x05Ϛ\x19\xe0\xabQ\xc1A\xba\xddI\x84\xfc\xa0g\xc0\x11ˢZ\x85|\x1at\xb4+\xa3\xca\xe0\xccrI\xee6\x9a/B\xf8P\xa7:\x93\xea̛\x13\xcaQ4\xe6,\xc1\xe4\xe8}x\x1e\x8a\xcaP~Hk\x90\ue8d94\xceKeb\xc2Թ\xebnA\a^CQb\xdd\xcc)J\xb1#v/\xb7\x86\x18h-M\x93<\x14\xa3\xa2xaG\xa9\xcd^\x98\x9cu2ރ\xb5\x15\x01KA\u05cd\xd0t\xf3'\xd6W\xe2\x165\x81\xc4LȨ\xb4d\xbeE\xf2=\xa8\xe0ki\x92o\x851|!.\xa3\x82C\xb6\xb9M\x81\xd2b\x91(s\x9d*!U\xbay\xb7Y\xab\xe3cӶ\xe5\"@\x97vv!\x13\xed\xaeD\x83U\x12c\xd4\x13\x06\x1a\xb0\x8a\xf2\x9cm\f\xacݛ\xc3\x11\xd3\x7f&\x02V\xa2\xabP%\x14\xfa\x92\xd9P\xbdi)Ŝ\xcd%\f\"\xe4c\xd5\xe6\f\xf7O1>\x00t\x01@1\a\x03\x97\xbaV\xdeQ\xe3\xa92a?D\x9b\xc0UY+\xd45\x0e\x16*\xd5ڒs\xb6(\x11q\x89\xb3\x90+\xf6\xe7\xa7\x7f\xfd\xb7\b\xd0\xe9\n:)E\xe6U\xba\xe2\xb9\x1f ˅Z\x80\xa3\xec\x01\xc1\xf3\x98\xfb\xb1\xb0H\xc1ҷM\xdc-\x81\x9f}}3\r\x9b.FXU\x9a=\xc9\xc4\xed\x93\x16?\x8es\xbd\xe8\xeb\x8b\x7f<z@G}\xcf\x16ֹ\x9c\xad\x127\xb1oB\xc1\xae\xf5\x1d\xadk\v?a\xbf9\x8d\x06\xb9\x94\xba\xa8s0̄\xbd\x0eu\xe8\xe2\x8a\x7fn\xd4\xf2ٜ:\xe4N\xd46\xf6\xc3\xea\n\x1a\x9f\x12\xe3\xa7\x115w\xca\x10wW\xb9t\x12\x06\xbf\xe8k\x9e\xe7S>\xbb\xf9\xa0\xdf\xe8\x85\xf9N\xbdB\xa9\x8c\bdO3\x1al\xceM\xc5f\u05f5\xba\x01-\x9a\xa1\xe7:\xe6\xe6C\xd7UQW>\xb9\xb6\xb5\xd8a\xee\x90kqifV\x1d\xf2\x9e\xd0fd\xe2\xb3\xf4\x0eL\xc8#*\x14\x12s\x98C.\xe4z\x11\xc6l\xda\x1b\xf9\xeb\xa7\x7f\xfe\x8b\x15 \x11\x88\xbad\x7fyJ)|\xe6\xcc\x1e8tzCa\\\xf2<\x17e\xaah\x00\x8b\xf7\x89\x82\a\x95\x04\xd5\xea`\xfb\xe5\xdeL\xd7\x0f\x1f\xfeAv\xab\xac\x8c\xc8\xe7g\xb6\xe0\xbc\xf7\x9dE@\x1e\x93ju\xec\xceB\x98\x1c\x9b*\xd2\xe4Au\xa4[\x9d\xd7(\x17y+g\xc2$\x12\xb8\x83\xe1\xef7r\x89\x92\xa71&\xcd4׳\x1b\x969\x98V$\xbf;\x83\xc3\xd2MF\x0f\x96\xad\xb0u^n\xc6T\x90\x80-yQ\xecϹn3\"O\xbe\xe4w\x9di\xd2u\aU\xf3M\x98\\z\x1c\x81\xa5q\x9c2\xdcC\x9f\x06\xc6/:\x82\xaf#\x11\x99\xcfz\xd5\xf3\xee*7}\xa2\xecw\xa2q\xbd>\x84\xd5\"u(\x86\xb4\x89R*=\x8b\xa3CY\x15n\xaa\x97\xbcrvBR\x9c\x06Ug(Di\xa4\xa9\x84\xaa>\x12G\xbfȹ\\:\xd7V4b|`G\"\x19Sn\xc4\xc7-֎z-\x92\xb8I\x97\xe8\xf19\rV\xb0R\xe3Ɉ\x1d\xde\xe1$\x14(\xb10\xe4x!s\x106\x98\x8e\\\xfc\xb0-\xd7l\xc1\x03\x94\x80Ä\xf3ǆ6]ٌ\x19\xc6nX\xda&\x16\xf1W\x12ɴ0\aKd\x00\xf8\tt\x84i$h\xdb\x03\x86:\xb4\x962\x8d\xb9\xe3\xbc\nh\xceS'\x94\xc4F\xbd\x0374v|~\x1cC\xdf\x03\x04\x8a'r\xa9\v\x8e[b\xad\x0e\xa2\xf5:\xd8!\x85*a\x8e\x12\x9e-wT8T\x91\x85\x1a\xc6\t\x90\xa6rAz\xee<\xf5&\x8b\xad\xaet\x17\x9dY\x85Vκƽ\x1d|\xea\xcd\xf5\xca\xdb5B\xbc\xd3J\xc4+\x01\xc6\xc5\x1c|\bu\x1f\xa1T\xd0e\xb6T\xec\xd9\xe4\xd9\xd3\xdf\xcf\xf1MsX;\xbe\x93\nĶ\xe4ң\xcd\xde7\f>\x88\x02o\x9d۱\xe9\xf0+\xd3\xfar\"\xed\x91gc\xb8\x1a\x1d\xe7\xdeI#\xd8\ty\x8f\x11\xbfت\xa9w\x1aK#vh\xfb\xf04\x9b\xcb\xdd\xe0\xd4\xd3{\x97\xf7\xf6\xa4\x8fDdV\xc8\xf4y\xa4M*b\xcfQ\xd1&\xf5Q|}\xfe\x13;\x92cC-\xe3O\x1fm;\xb8ez\xf5\xb9(\x0fZ\xaaW\x9f\vN~\uf8bbf\x91\x98^)ܱf\xa9\x88=k\xf67\x81Ү\xf1癑K\x99\xf32_a\xb1\xaf,\x05ٴ\xae\x98P\xb7\xb2\xd4j\x19\x1f\x8f\x88l\xf0R\xa2j1+\x05ձ\x83\xb3\xe1O'\x1f\x9f\xbf\xa7\xf8\xddS\x9c\x9cј¯J\x8dk\xe3\r\xeeo\r\xf70\xd9rt\xb4\xc1\xc0\x9e.\xe0\xachl\x9c垮\xd0\x18\x96uU\xf3\x9cJQ\xcd\xf2\xda\xc8[\xf1H\x1b$\xcdJ\v\xda\xee\x1f\xc0Hse\xcc^\xca\b\xf9Б\f\xa1x\xf6\xb1٬\x89\x16\xb3\x8c\x17s\xab\x94\xf9\xf3\xf0\xac?d#JB\xb8\xbc\x8ep\xb9\x04%\xcd9\x93]\xc5ƩH뚴n\xa2\xd8z\xb9\x8f\xebV\x8e\xe3\xde\b\x0e\x8c\xe4\xbd\x18\xaes1\x82\xe7\xa3H6\xfb`\xdfs\x1d\x88\xac\xbfn\xc9?S\xd6\x1a\xa7\r\xb9\a\"\xc3m\fF\xc0>\x8a\\\x94\xda\x1f\x1aw\\V!\xff\x0fe`#+\u0093\xa1b\xab\xb4NF\xf7\xba\xd0{\xae\xc4^\x8f}i\x99v\xb3\xd3\x0e\xf6\xf9\xc2\u05f7\x7fw\xeb\x8bR\xcd\xf2:\x13/\xf2\xdaT\xa2|/\x8c\xae\xcb\x1e\x0f\x7f\x87C.\xfa\xdf\t\x02\x85\xcaޓ\xf1\x883\xa6\x12\xe5\xd8\xcctѳ\xe9\xcb\xe6ՠS\xb8\x01e>}\x1f>\xdf&\x03\x02̈\xfa\xb9\xba\x14\xbd\x81P\xaa\xce\xf3\xb5$\xb3\x9e\xda\xe8x\n\x1aBo\xfe\xcdvM\xdd\x0f\r&\x9a)\xf8\x9edj=\x0eK\x953\x93ã\xaf\xe7\xb4̄c\xff\v\xa3u\x9fX\x83en\xe5l\x9c\r&no\x17q\xa1\x9470>+\x9d 6\xc4\xe1\x167ڎ-\xb2\a\x996y\xcd\x7f>\x8a\x95\x9a\xa7\xd7H\xe49\xe4\xcb\x14\xdad\x8e6\x8d\x1aNs\xcf\xe1\x02\xba.~\v\x04\xa3ޱW\"\xa7s|'\xb1\u07b4\x9f\xb4\x84B\x8f\xf9\xdbg\x93\xee_`\xa3\xca\x1c\xe1'\xbdY2\xc8\xc7qt\x82.\x8b\xf2\u07b72\xaby\xde\xe1\xb2\x16\x95\x1ab\u0090V2\xdf4\xcey\u07bcݡiHܚ\xc4\xd0j\x97w\x94n:\xa0\f\xbb\x80\xc8\xcd'\xd6ȶ\xfe\x82\xa5\x9c\xbbwt\xedi\x8d\xa7\x9d\x13\xcd0<\xb6\x14\b\xf8p-:O\x11\x0f=\x7f\xf7\xb2_\x01\xd9\xc2D\x1b\x83|\xbec nO\xf8\xbf\xd0}\x97S\x87\xb6\x9d\x9a\x14)o\x10\xe2w#V6\x80\x92+W\x98\xdaCPwKW*\xf1F\xd8P\x05\xfb\xded\x94沾\x11;\xbcA\x9d\xe9\xe2{\xfe\x02\x98\xe6\x8d_\x84\x8b\xbc@\x04\xdb\xfem\x97j\xb0\xeb\xb6n\xc7N\xf5?\x9e\"{\x0e;\x10\xb0\x14\xe0?\xbb\xfc\xecF\xac`\xad\x81\x9c\xe0\xafkY@P\xed\xaaB\x8e@\\=\xf7\xd4\x0e\xad$-\xb8\xddA\x17ꌽ\xd3\x15\xfe\xef\xd5gi*\xf3\x85\xf6\n/\xb50\xeftE\xcf\x1eD\x12;\xa8=\tb\x1f&\x06U\xd6\x1a\u009e\xb2\xf8az\x14~*\xc2\xfc\xb6\"\x93w\xf7BAȸ\x99\x87T0\xe3\xc0\xdb9\\$\xde=\xfa\x0eP\xff]\xa0;R\xea\xb2C\xaf-\x1fځ9\x15\xcc}\x9e|\xb8vp\x14\x9e[\xe4|&2_A\x9e\xc3\xca\xe0\x95X\xc8\x19[\x8ar\xb1k\x9c\x05\xe4\xd4\xf6\xa5\xdb!I\xf6^\xdb\xed\xa7\x90\xffߗT\xd3\x1b\xd1\xff\xdex\xf7\xf2&+\xaeN\xde\xd3\x01\xd7;{ߥ\x87\xe7\x97_\x90O_\xa0O\x87\xaf[\x1fu\a-/\xc0\xd9\xff\vqJ\x8c\xf2\x7f\xac\xe0\xb24\x13\xf6\xdce\x12\xf4~\xb3\xfd\xbc\xd3<\xda\xd0K^\x00\x1e4\xbf\xe59D=\x04\x87b\"\x17[]_z\xbeq\x04\xc2\xd0F\xb2\x04\x84h\xb8\x129\xba\x11\xab\xa3\xb3\xce\xce\xdb\x16\xc0vt\xa1\x8eB\x94}w\x1f\xf8s\xc6\x16\xe1?\xa2\xbf\x1dM6\x0e\xc1^؝\a\xe3\x0e\x8e\xd8\xfa\xa7\xa0龵\x815\xe7\xa3\x14^\xd8\xc1\a\x1d\x1ex\xb7\xf6\xb5\x0e#\xb4\xd5Ҏ\n\xbf\xf99^.D\xd5\xf3\xa4\xd7U\xe9\x9a}\u009e\xab\xd5\x06j\x7f1\x13\xaf\\5\x1cU\x04\xbf\x8bô\x81\xdcm \x176C\xbd\xbe\xf0\xebɾD\a\x97\x89\xf2V\xbcә\xb8\xd4ee\xcew\x11\xedr\xfd\xe9\x1e\xab\xb05u\x9d\xa36\xba{t\xd4{\xdf\xe0t\xd0\x18\xf5q\xbb\t\xe7\xbe{\xf9q\xf7,އ\xc7v\x0f\x1fjoX\x8dˏ\x9b\xdb\x00\xf6\x1a3\x8a\x17\xe6\x1a\xcd\x06|z\xef,\xd7u沛\xcb\xd3{\x9a\x9b\x99]\x8b\xac\xceE_K\xae\xce\xec\xaeZ\x0fz-\xacV\xf2\xbf\xebn\x83I\xef\xb9qO\xaf!\xb26\x1d\x82Y꩕Yq\xf27Z;\xff\x1dg\x8f9\\p\xec\x06f\x1b\x90\xf8u\x89\x9a\xd6\xe8C\xab\xaaVa(\xc7\x14h\x7fپ\xfd\x96&\x8cv2\xdak\xd3\xf7\x1dwc\x87\xbev\x13ۻAl\x84\xf4\xf9h\v\xa5\x1d\x1f]\xd1Sl\xc6\vt\x18t\xbd6\xea\x92z\xec4m\a\xb8\xa7\xb8#\xc2\xe8˪\xb7\xf3\x85I\xad\xe0\xb53\x15_\x16;W\xfe\xc5\xe6\xf3H\xd2\xd1ef\x9az\r-3ڝ\x1c}Q\xefw\xbci\x98\x94MZ\xc86\x17\x8at\xa1\x99.qg\"n\x91z\xa7\\I.\x8f\xbd\xbeB6\xb2\x99\x84\a.\x14=\n\x1c\xc9\xe4\v\xa2\x9eTa\xd8fԟV\vO\xf0\xb8'\xe1p\x8f=\xd5s\"Pp\xb6\xd9IR\x8a^w6\xe5\f\xdeQZ\xca<\xb7\x1d }\xfc\xb8k\xa7y'J\xc1\x16B\xe18\xee\xf1\x879\xa5\x11mBj\xa0\xfb\x9d\xe8)F\x14\xe23\\\xe1\xf8\x06\x938c\x83\xc4\xef\x13\xdf\xf8\xc1\x03\xc8p\x19\xed\x9bP\xecb\xf5\xdf\vn\xb4\xda9\xfd\xd7\xed'\x9d\x1d@Csf*\xa7\xf5s\x1d\xf9d\x19沆I\xd2\x04_\x9d\xec\xbb4\xc557\xbb\xc5\xdc%\x9e\xf0\xf2\xad\xbd݂\x84s\xdbs\rD\xa8z\xb9\x0e<f\xef\xc4\xdd\xc6\xef0y\x91}t\xed=76ɘ]\xa8\xcbR/\xca\xcd*\x93c\xbfa6\xb8`\xcc.y\x89r\x9a\xf9\xeau_O\x891\xeb\xfd\xf5v:\xb9\x01\xec&\x95{\xa8Q\xf7\xa4\xb2;\n\\ȧ\xbeG\xaa[\xbcc\xd3\xf0\xe8\x1al\xf3\xc1\t\xccW\xe1\x8dzم\xa4\x90\x1dS\x8d\xc5|\xae\xcb\xca*\x97\xe31\x120\xac\f\xdc@\x05o\x90\xd2d/{\x98\xac\x1a\x13ˍ\x8a\xa4\x04W+D\x82\x18\xad\xd0f\x87-\xf9\n\xe6\xa2T|6\xab\xb1鞘\x8a\xe7\"\xea\xc4\xdd\xe5\xf7 \xa3̱Q\xaf\xcd\xd4!\xf3E\xfbiϙM\x11b\x02\xb3\x04\xc3u:\xaa\xe3\xd1em\x0f,\xb3\xf9\xccn\xe6\x193\x9a\xcdy9\x8a-\x1a@\xf9e\x17\xdb\f\xcb\xce\xd8?\x84G\xfd\xc0\xe9\xe5\xcd\xe1\xeb\xb6\x1a\xbaͅ\x86\xb4{W\xbf\x8b\xaf(\xb5{\x01V)u\xbd\b\ry\xb7\x89\xc1^\xc8\fYؚ\x15y\xbd\x00\xfb:\aWU\x97M\x1fW\x9e;\x97W\xd6\fu;\xe4.\xc2mՕM\xe7\x8c:\x1f\xed\xa0g\xf78\xdb\xf3\x14fw=\xbd\xa8}B\xf0o\xf0\xfc\xf4\x9d\x8f\xb5M\xf22;)\xd2\xc8\xd1\xf6\x99\x1a\xae\x02\xa0k7x\xfe\xfc;\x91\x9b\x97@\xe45\x9ca?\x9f\x8e\xf6\xf2\xa1l\x1d\xff^\xf3\xdet[\xdc\xf1\x12=\x0fwO\xf7\a\xf7P\x8f\xea\xe0\xde\x7f8\xe5\xc1\x0f\xb0\xab>l@Z\x0e\x8fU\x1fzv\xc7گn\x91h\x00\x1a\xdc>k\xfeEԲw\x9f\xee\x0f𓖷\"k\xd1\xde\r\xc5\xfd\xa6Ѿmv\xbf\xbb\x9a\xc3/\x18\xbb\x91*;\xf7\x11dE^\x97Hɦ\x7fδ\xb2\x12\xc1\x9c\xb3O?\x8d\x98\xa3\xc0G?\x0e\xf6\xe9\xa7\xd1\xff\x0f\x00=C\x02\xc8\xfa\xd8\x01\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec<Mo\xdc8\xb2w\xfd\x8a\x82\xdf!\xef\x01ny\x82\xb9<\xf4-\xcf\xf1\xe0\x19\x9bM\x82\x89Ǘ\xc1\x1c\xd8Ru7\xd7\x14\xa9!\xa9v\xbc\x8b\xfd\xef\x8b\"E}\xb5>(\xc7Y\xcc\x0e\xdc\xca!\xa6\xc8b\xb1\xbeXU,1\xd9l6\t+\xf9=jÕ\xdc\x02+9~\xb5(\xe9/\x93>\xfc\xafI\xb9\xba:\xbdݡeo\x93\a.\xf3-\\Wƪ\xe2g4\xaa\xd2\x19\xbe\xc7=\x97\xdcr%\x93\x02-˙e\xdb\x04\x80I\xa9,\xa3fC\x7f\x02dJZ\xad\x84@\xbd9\xa0L\x1f\xaa\x1d\xee*.r\xd4n\x860\xff\xe9\x87\xf4\xc7\xf4\x87\x04 \xd3\xe8\x86\xdf\xf1\x02\x8deE\xb9\x05Y\t\x91\x00HV\xe0\x16Lvļ\x12h\xd2\x13\n\xd4*\xe5*1%f4\xdbA\xab\xaa\xdcB\xfb\xc2\x0f\xaa1\xf1\xab\xf8R\x8fwM\x82\x1b\xfb\x97^\xf3\an\xac{U\x8aJ3љϵ\x1a.\x0f\x95`\xbamO\x00J\x8d\x06\xf5\t\x7f\x91\x0fR=ʟ8\x8a\xdclaτ\xc1\x04\xc0d\xaa\xc4-|d\x05\x9a\x92e\x98'\x00'&x\xee\xd6\xe9qS%\xcaw\x9fo\xef\x7f$\xf4\nGIj\xce\xd1d\x9a\x97\xae_\x83\"p\x03\f\xee\xdd\"A\xd7\xec\x00{d\x164:\\\xa4\xa5\x1e\xa5\xc6M\xc02\a\xa5k\x98\x00%j\xaer\x9e\xc1\xff\xb1\xec\xa1*\xfdPsT\x95\xc8a\x87\xa0+\x99\xd6}K\xadJԖ\a\x12\xd2ӑ\x9a\xa6m\x80\xe9\x1bZ\x8a\xef\x039\xc9\t\x1a\xb0G\x84\x93o\xc3\xdcQ\xaf`\xa0\xf6`\x8fܴx;\x92t\xc0\x02ua\x12\xd4\xeeo\x98\xd9\x14\xbe\x10\x9d\xb5\t\xd8fJ\x9ePӺ3u\x90\xfc\xef\rd\x03V\xb9)\x05\xb3hl\x0f\"\x97\x16\xb5d\x82\x98P\xe1%0\x99C\xc1\x9e@#\xcd\x01\x95\xec@s]L\n\x7fU\x1a\x81˽\xda\xc2\xd1\xda\xd2l\xaf\xae\x0e\xdc\x06=\xc9TQT\x92ۧ+'\xed|WY\xa5\xcdU\x8e'\x14W\x86\x1f6LgGn1\xb3\x95\xc6+V\xf2\x8dC\\\xd2bMZ\xe4\xff\x15\xb8h\xdet0\xb5O$6\xc6j.\x0fM\xb3\x13\xe2I\xba\x93,{\xf1\xf0\xc3\xfc\x12[\xf2rypT\xf9\xf9\xe6\xcb]Wt\xb8逄\x9a\xda\xed0\xd3\x12\x9e\b\xc5\xe5\x1e\xb5g\xdc^\xab\xc2AD\x99\x97\x8aK\xeb\xfe\xc8\x04G\xd9'\xba\xa9v\x05\xb7\xc4\xe9\xdf+4\x96\xf8\x93µ\xb3\x16$sU\x993\x8by\n\xb7\x12\xaeY\x81\xe2\x9a\x19\xfc\xeed'\n\x9b\r\x91t\x99\xf0]#\x17~4~[S\xabi\x0e\xc6h\x94CA\x87\xbf\x94\x98\xf5T\x83F\xf1=Ϝ\x02\xc0^\xe9V\xc5;\x96\x06`Z/\xe9\t]\xfb\xad\x138xA\xb9\xd6J\x02~%\xbb\xd1\xea+\xc9\xc9\xe3\x11%i\x91\xae$a8\x80\b\xb5\xf1H\x93^\xe38\xed\xe8\xb1X\x94\xa4\x8c\xb3\xa8\xdd՝\b5\x12\xa4\xbc\xd9d\xc8\x0ePK0Y\xaa\xb6T\xa0Ʊ+\xb5:\xf1\x1c\xf31\xea\xcdQ\x90\x9e\x1c\xf7\xac\x12\xf6^\x89\xaa@s\xa7~Fcy\x8f\xa7\xa3ȿ\x1f\x1d\x168\x8b\x06\x1e\x8fh\x8f\xa8I\xf1\xdc\vg\xc3F\xa0\x02\xad\xad2\x98\xd32-{@`\xb0\xf3\xeb&k(\x04\x94*\x87\x93G\x0fvO\x01\xe1!/Z~\xec\x94\x12\xc8\xe4\xd9{\xfc\x9a\x89*Ǽٛ\xcc\xe2*oΆ\xb8-\x9eqI\xd2D\x1b*\xb1J\xb6oiw\x19\x01\n\xc04\x02\xa9?\x97\x1e\"p\xc7J؍\n\x16\xfd\xe3\x16\x8bQ\fg\xe4\xce\xff#\x17\x82\xed\x04n\xc1\xea\n\x93\xa9\xf1Lk\xf64I\xa5\xe0\xfa\xc4\x13\xa9\x19Q\x1be\xc13$\xf24\xa6\xd7\xd1\xe9O@\xa2\xa3R\x0f\xcbd\xf9\x7f\xea\xd5n+\x909\x8f\x12vxd'\xae\xb4\x19z\"\xf8\x15\xb3\xca:\x87\xe9\xfca\x16r\xbeߣFi\xa1<2\x83&\x18\x89i\xf2̩==\x811\x13\xaf\a\xebi\xd9K\x8cr4\x98Z\x02)\xff\xb9\xfe\x85\x1f!L6\xb7*\x81˜\x9fx^1\x01\\\x1a\xcb$\x81'\xb5op\x1b[\xd7\x02\xeb\xcf0\xf7f4\xe0O|\xe9\xedHJ\"(\r\x05y=\xe7]M2\x02\xbe~\xa6\x96\xbfcdϼ\xb1\x06M\xfe{=Y\xee6\xbb\xd6^\\\xce\x00o\xb8\xe3\x9d6\xc1v(\xc0\xa0\xc0\xcc*=E\x96e\xa6\xaf\xb1\x85\x13\xf4\x1c\xb1\x8a\xad\xdd'\x91l\x178\v\x14\xc8\xe4?\x1eyv\xf4\xfe\x15ɔ\xdbA Wh\x9c\xb9de)\x9e\xa6\x17\x1b!\tQ\xe6`\x85a\x883\x11\xe7\x94\x0e2\xf5\x1cB7c;\xfb+ѹ\x11\x91W2s9\x94\xc9\x15t\xbe=\x1b\xfc\xd2\x02M\x04\xe6hR\xb8\xdd\x03\x16\xa5}\xba\x04nC\xeb2L&D\a\x87?\x05\xa3\x9e\xa3\x0f\xb7ñ/\xac\x0f/\xc0\xa5\x06\x85\xffh&\xb9\xcd\xe6K\xbd\u05ec`Ї\xee\xb8K\xe0\xfb\x86A\xf9%칰\x14U\x8fE0\xfd_C\xc4EN\xbd\x14Y\xe2vMz\nf\xb3\xe3M\x13B.\xf6\x1fPh8\x1cx7\x92\xe8o\U0008b409R\xbfW\\c\xe1\xf3\x16wG쵸\xa8\xe3\xdd\xc7\xf7\x98\xcfKc\xb4D\x9e-\xe7\xdd\x00\xe5\xee\xf4u\x18\x10\xbf\x98ڡj\",\x97\xcf1\x97\xc0\xe0\x01\x9f\xbc\x17Dٱ\x125\xa3\xa9&\x03\x89ᣑbq'x\x04\xc9\x01\xaas]\x11\xe3\xe3E\xa3NZ\xe1S\\\xc7\x01)\t\xb3:\x13\xe0iJ\r\xb4F״B&\xea\x88\xc1k\b\xa5\x9e\"\xc7D\x9b\x9b\xf0\x04N<k\xb9\r\x1b\xdběg\xf4\x1bʛ\t\x97\x1a2G^F\xc2\xf6\x06\x18\f:=\n\x99\xcc{\xca<7x\xfa\xc8\xe5V^&\x91 ᣲ\xb7\xf2\x12n\xber\xca\xe2\x91ܼWh>*\xebZ\xbe\x1ba=\xfa\xcf\"\xab\x1f\xeaTOz3O\xf4\xe8&H\xa3\x84\xde\xff\xbb\xdd;\xd9kX\xc5\r\xa5,\x95\x0et\xa1\x97~\xc2h\x90\x1e\xa5\xa22\x96\x02F\xa9\xe4\xc6m\xb4\xe9\xc8\\\xd10k\xf6(\xdd\xe3N\x17\xbd\x9a\x124m4T\n\xe8<jw\xe4\xcby\b>}/\xe8`\x03\xf2\xca\x11\x95EC4V3\x8b\a\x9eA\x81\xfa\x80P\xd2^\x10ˍh\xfb\xfcL\x99\x8bu\r¯6\xf4\xbd\xfc\xfcԳ!\xbd\x8e\xea\x17\xd8\x1f\xd1y4\x1f\xfd\xedks\x1b\xb4\xf3c\"\xa8\xcd\xf2ܝ\n2\xf1y\xd5.\xb1\x8a;=\xfd\xee\xa0\xe7\x94\x1c\n\xe6\x12\xa5\xff\xa0-\xd2\t\xfb?\xa1d\\Gi\xf9;w\xc4'\xb07\xbaκu'\xa29\xb8\x01\xe2\xf8\x89\x89\xe1i\xc7\xf8\x8f̱\x04\x14\xce7!\f\x87\x9e\xcf%<\x1e\x95A\x12\r\xd8\xd3)b\x04Pn\xe0\xe2\x01\x9f..\xcf\xec\xd2ŭ\xbc\xf0.\xc2P\xeb#\xc06\x1e\x87\x92\xe2\t.\xdc\xe8\x8bos\xa7\xa2\xa53\xb2#E\x7f\xdb$ZL(\f\x0e\xde\x04\rm\x0e\x1f)$M\x93\x17\x90\xcdR\x19\xbb\x02\xa1\xcf\xcaX\x97N\xeb;\xbc\xeb\xf2m\xb5\\\xd5y6`{\x8b\x1a\x8cU:\x1c\xf5\x91\x91\x1c\xa4\x8d\x89\x8bf)\xe0`\xba\x93\xbd\xf3`)\xe4\xbeh\xf5\xdb\xe7?.\xfc\x19 \xfd\x7f\tbF\xe3h\xdb@J\xc9eh̒\xd8DY\xf8\x1eQϩ\xd7$5\x99\x0f\x96(ݸ\xbcA\x85x+M^\xce\x15&r.\xf7\x1a,\xe8\xe6k'/\xcb\xe8\xa8\x0e\xb3\b\x91]\x8f\x1d=t\xa2\xca\xfa\a\xccш^\xfb\xb1A\xc5jP\xce\xfe0}\xa8\xc8\xe6\xc5\xfb/\xadH\xffq\x9c\x81\x82\xcb['\x8f\xf0\xf6\xbb\xb8\x0f\x10\x0e\xd2\xf0y\xe1\xc3u\x18ݲ\xa0i\x18?$\x9d\xfa\xd1\xf1\xe2\xe3\x115\xf68y\x9eՏ\xe5\x8ds\x9b)\xa9\xdaI}\x10\xe4R\xe5o\f\xec\xb96M\x88\x8b\xf1\xe1\x1c7P-Z\x90oฒ7Z?3\x94\xfb\xe4\xc76\v\xa6\xc4\xe7cs\xa0?}\xf0;\xf6s\xc7cH\x99#n\x01e\xa6**`q\xd1\f\xbaI<;\xe2\x05\x19b\xf7\xbd\xf6AY\x15\xb1\x84\xd88I\xe4r!\xbf\xd4>\x1b\xf8\x89q\xf1\xbd\xd8hy\x81\xaa\xb2ۨ\xce\x036R\x11\x9a\xaalc\x7fIh\v\xf6\x95\x17U\x01\xac FDB\x05\xda\xd9\t\x93\xbe\f\xc0#\xe3\xd6\x1d\x80\x11d\xb2\xea`U4\xc8L\x15\xa5@\x8b\xb0\xc3=\x9d\xd4eJ\x1a\x9ec\xb3\xf5\xd7r1(\xa8\x9a{\x18\xec\x19\x17\x95\xc6\xf4\xfbpc]\x84T\x1b\x9e\x88\xbeѮe<\n\x1b\xb7\x01%/4o\xdcNP\xea5\x0e\xedg\x8d/\xed>\x96\x9a\x93,\xaa%\x0fr\x01\xa2\xf3/\xfb\x1ed-\xa2L>M\xb9\x90\v0i\x7f\x7fu!_]\xc8W\x17\xf2Յ|u!_]\xc8W\x17\xf2Յ|u!\a.\xe42f\x1bW4\x93|\x036Q%\x04\xf3\xc8\xce\xceRW\xc3\\\x8b\xcaX\xd4\xc1\r\x1bݗ\xc7*a\x86\xe3F\xea\xaf3\xdfe\xe3>\xccɓ9߭\xf9\xd2d\x87M\x99\x8e\x8bׂ\xa2\xb8C\xd9e\xefx\x91h\xf3u\xda\xfc\xac\x1ak\x9b\xac/\xe0\xea\xd7 7\xc5S\xa1\by\xdcj\xd4S\xd7\xdc\xf2_|t\xab\x81\xfauX\xce3\x0fئ\xc9*\x1fk\xc1\x10D\x92p\\\xe6\x02J\xab\xc5)\xba\x84[\x859F\x00\xc3@@\x06\xe4k\x85\xed\x0fJ\xbd\xc5ڧ\xe9\x8a'O5\xfax\xe6\xf46\xed\xbf\xb1\xaa\xae\x7f\x82Gn\x8f#P\x814V\x02\x85\x8b\xf2\xd0-\x8c\x0e\xb2h\xd5(U\xa9tYr1^\xd3\xc0D;\xbeGn\xf8\xe4\xf0g\"}\x0e\xf9\x96¤\xe1Q\xdfx\xaf\x01%\x87\x83\xe6*\xa3®\xe4\xf2\xeci2\x13\x9a\xaf<\xc0\x9b\x91\xb9o\xa8}Z*UZS\xf1ԭf\x9a\x01\x19[\xe7\x14\x17\xf1.\xd64=\xa3\x92)T(\xcd\u0085\xc5\xfa\xa5\x05S\x10\x9e@\xc3\x15\xcbx\xa1\n\xa5\x15uI\xfdz\xa3\x05\xb8몑\"\xc9\x14Sy\xd4#RL\xbdQ]ۓ\xc4U\x93\xcdT\x19MV\x0f%\xab똖k\x86\x16`\xf6Qy\x91J\xa1g\xd4\a-ثU\xbc\x9f\xdf\x16\xc3/\xc6랫\xf6\x89\xa8\xf1\x89\xf0˗0\xedT\xafL!\xba\xaev'\x82\x86=\xbd\x88\xaf\xd3i\xaap&\xe7^[\x9dӯ\xbd\x99\x04\x1bS\x933Qq3\ts\xb6\x12'\xb6\xcef\x12\xfa\xe2\xf6\xbd 9\xb3\xafǾy\x8e\xdd%ſWҾe\x99J\xe7\xa8\x17b\x83x\x84\x17\x90\xed\xa9ħ\xc1̝`\xb5ul=~ݘc\\\x1cT\xf3iA\x06t\x11\x80\x97\"*T\xebx\x1f\xf4\xc2\x05|\xad+D\x02=n\x87\x83\xa79\x88u\f\x96\x8c\xccrN\xdf\x1e\xbb\f\x8bI\xe1\x86e\xc7~\xc7Q\x90Gf(~.\x98\x85\x8b&l\xbc\n\xe3\xa8\xe5\"\x05\xf8I5Qz\x03\xd3\\\x82\xe1E)ƭ[e\x10.\xfa`\x9e\xe3\xc6\xcfʉ\x91\xac4G\x15\xbe\xf0\xde.q\xf7K\xbf\xffH&\"|ߝ\tU\xe5\r\xfcI\xf6\xd2\xe9\xd9\xe7{W\r\xee\xbe{\xcd\xda/\x82ko*D6!\xaa\t\xaf\xc7?\xd6\x7f\x81\xcc\x04\x1d\x14\xb2\x03~PY\xe76\x939\x9a\xf4\xfb\xd7A\x81\x8bZ\x83-\f\xb9ǺHo\x04\"e\x19\xfd\x8a\x86\xe0ڪ\x95Zw\xda\xf4\ra:n&g5\xd6Z\xb1\xb8\xa8\xbb\xbb\x0f~!\x94\x9eM\xdfW\xda!\xb3)\x996H\xb4\r\v\xf4\x83vc\xd3\xd0C%\"B\xc9C\xf7\xa2\x83\x16\x7f\x8dD\x1c\x9f~Z\xbd\n\x7fY@\x10\xc8@\xaee\x11\xbe\x1f\x1f\xd7\tD;L#\x86M\xca\xee\x14$f\x8cʸ\xb3&\x94\x06\xf0\xa5)uD\x9f\xac\xf2\xeef\t0\xe7\x1fM*}e\xf0ӣ\xa4$d\xadn\xe6Vz\xb9\xdb&3D\xfb\xe5lX`\xe6\x98\x01 \xcb5\xe8>\x00\x0etɅ'\x89\xf1\xf7#y\xd3\xebH\x15n\xf3H\x93\x15z=\xa5\xd3c\x9e\xecf\xec\n\x8dMs\x9fG\xb2@Gc\x99\xadz\x1c\x1b\xbd\x8c\xe4\x8b\xeb\x06\x19+鎜\xfa\xf0\xb1\xd2\xee\xe3~\x02\xe1rnϹ\x12E0c#x\xf6\xa1\xe9\xd6\xc6\xe9\xc6:\x85n\x8c\r<2C\xb7#է-\x1d\xe2\x0f \xb7\x17\xb1\f^\xf8\x9do\vt\xd9͆`\xafgڈ|\xbb\xcb\x0ffW\xf7\x99z\x84\x85\x05\xb2\xbaa\xe1ʄ\x89\x95\x8c\x1d\xdam\xe0#>\x9e\xb5\xddH\x92\xb6a6ݟ\xcba~\xdf\xdcw\x15\xbb\xa8\xf6\x86,WIgf\xd7ׂ\xf7\x9d\a\xb9Z\xca\xf9\xb5\xf0\xfc\x91\xa7\x81\xff\xe6\xfbd\xf4\x13\xb1\x8cV\xf2?I\x94\xe1\x99\xc4\x7f\xca\xe0\x8c(ɠ\xa9\xbe%k\v\xa7\xb7\xed_n\xfd\x9b\xfa\x0e4\xf7\x02\xc0]:\x96wd\xa5ތ\xeb\x96V\xf3X\x96ai변\xeeeh\x17\x17\xbd\xbb\xceܟ\x99\x92\xde\xd55[\xf8\xf57\xba\xbf\xccm\x9c\xf5}^f\v\xbf\xfe\x96\xfck\x00*\xdd\xe8w?N\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V͎\xe36\f\xbe\xfb)\x88\xeda/\xb5\xb3\x8b\xbd\x14\xbe\x15\xd3\x16X\xb4],&۹,\xf6 \xcbt\xa2\x8e,\xb9$\x95iZ\xf4\xdd\vIv\xe28NgZ\xa0q.\xa6H~\xd4\xc7\x1f\xb3(˲P\x83y@b\xe3]\rj0\xf8\xbb\xa0\x8bo\\=~Õ\xf1\x9b\xc3\xdb\x06E\xbd-\x1e\x8dkk\xb8\v,\xbe\xbfG\xf6\x814~\x87\x9dqF\x8cwE\x8f\xa2Z%\xaa.\x00\x94s^T\x14s|\x05\xd0\xde\tyk\x91\xca\x1d\xba\xea14\xd8\x04c[\xa4\x840\xe1\x1f\xdeT\xef\xaa7\x05\x80&L\xe6\x9fL\x8f,\xaa\x1fjp\xc1\xda\x02\xc0\xa9\x1ek`\xa4\x03\x12\x8b\x92\xc0\x84\xbf\x05d\xe1\xea\x80\x16\xc9W\xc6\x17<\xa0\x8e\xc0;\xf2a\xa8\xe1|\x90\xedǠ\xf2\x85\xb6\xc9\xd56\xb9\xbaϮҩ5,?\xde\xd2\xf8ɌZ\x83\r\xa4\xecz@I\x81\xf7\x9e\xe4\xc3\x19\xb4\x04f\xca'\xc6\xed\x82U\xb4j\\\x00\f\x84\xe9\xe0\x17\xf7\xe8\xfc\x93\xfb\xc1\xa0m\xb9\x86NY\xc6\x02\x80\xb5\x1f\xb0\x86\xe4zP\x1a\xdb(\v\r\x8d\x99\x19\xe1\xb2\xd3\x1a\xfe\xfc\xab\x008(k\xda\xc4k>\xf4\x03\xbao?\xbe\x7fx\xb7\xd5{\xecS梸E\xd6d\x86\xa4\xb7vy0\f\n\xc6@A<(\xad\x91\x19t B'#&\x18\xd7y\xea\x13\xdc\xe8\x18@5>\b\xc8\x1e\xe1!\xe5d\xbcz5*\f\xe4\a$1\x13Y\xf1\x99\xd5\xe7I\xb6\x88\xf1u\xbcDց6V$r\u0088%b\xbc\xc3\x168]\x10|\a\xb27\f\x84\x89\\'\x97\xd1ſ\xef@9\xf0ͯ\xa8\xa5\x1ao\xcf\xc0{\x1fl\x1b\xcb\xf8\x80$@\xa8\xfdΙ?N\x9e9\xd2\x10!\xad\x92\xa9\x80\xa6\x9fq\x82䔍\xf4\a\xfc\x1a\x94k\xa1WG \x8c\x18\x10\xdc\xcc[R\xe1\n~\xf6\x84\x89\xc0\x1a\xf6\"\x03כ\xcd\xce\xc8ԑ\xda\xf7}pF\x8e\x9b\xd4W\xa6\t\xe2\x897-\x1e\xd0n\xd8\xecJEzo\x04\xb5\x04\u008d\x1aL\x99\x02w\xf1\xb2\\\xf5\xedW\xa7\"y=\x8bT\x8e\xb1\x9eXȸ\xddI\x9cz\xe4&\xef\xb1?r5d\xb3|\xc53\xbd\xc6\xedR\"\xee\xbf\xdf~\x82\t4\xa5`\xe6\x12F\xb6\xcff|&>\x12e\\\x87\x94\xac\xa0#\xdf'\x8f\xe8\xda\xc1\x1b\x97kI[\x83\xee\x92t\x0eMo\x84\xa7*\x8d\xf9\xa9\xe0.\xcd%h\x10\xc2\xd0*\xc1\xb6\x82\xf7\x0e\xeeT\x8f\xf6N1\xfe\xef\xb4G\x86\xb9\x8c\x94>O\xfc|\x9cN\xbf\xac\x98\xd9:\x89\xa7Y\xb7\x9a\xa1\x95\xee\xdd\x0e\xa8c\xce\"q\xd1\xd6tF\xa76\x80\xce\x13\xa85\x93\xea\xd9\x18\x92\xf6\xbf\x8ab\x9c\x119\x8e\xc5\xe4\xf0\xdd\xf3q\xac\x8d\x8a\xf8\f{\xc5x)ZD\xf31j,\x91\xad\xe9P\x1f\xb5\xc5\xec O\n|.\x88\xf8\xa0\v\xfd\x12\xaf\x84\x0f\xf8t%\xfbH>\xce\xc94\xa9\x01\x9e\xc9\xff\xf8qٙ\xe9\x13z\xeb6Y'}\xae\xe6#w6jG7@\xc1\xb9ؑ\xdeE\xf1\xc2)\\N\xe4ũ\x11\xec\xaf\xe2X\x8d\xe4\xbd\xeb|\x9c\x93\xa2\"\xa4\x92\xdc'8&u\xc4\xc8\x11]\xb9\xbb\x95\xd3\xf5Q\xf4\x02\x02\xf3?~\xf2\xff\x83a\x1c\x1d\x86p\x05\xb3L\xb1\xac\x88#ҕx\xb5c\xc6Ȃ\xb5\xaa\xb1X\x83PXZf;E\xa4\x8e\x17'\xc3TF\xe7\xe5\xa8\xf8\xa7\xb4\\\xa9\xc7\xda\x7fڣ\xbbU\xe1\xf0\xa4x\xe1q\x86\n\xcd\xf1\x96\xe1\xddi\xcb[6I\xde\x04j\x88S\xb7\x14s\xc5\xd2\v\x88X\xc9R.Օ\xed\xe0\x8a\x84\xed\\s\xea\xfd\x8b\x82\x9f\x96\x85\xeae\xe0+I]\x88F\x7f5\x1cޞ\xdfR\x0f\x95\xe3\x12\x9b\x0e\xc6[\xb4\xb3\x9b\xb3xR\xbb\x89\x8b\xf3l\x8dk\xd6 \xd8ζ\xc9X\x875\xbczu\xb1\x8b\xa6W\xed]\x9b\x16s\xae\xe1\xf3\x97\xb8\x1b\x8a'lG\n\xb8\x86\xcf_\x8a\xbf\a\x00\xad\x01\x9a\xeb\x00\f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xecY\xddoۺ\x15\x7f\xf7_q\xe0\rH\xb2FJ\x8b\xfb\xb2\xf9\xa5Ȓ\xdc!\xb8\xb7]Pgy\xc9\xcd\x00Z:\xb69K$\xc7C\xb9\xf1\xd6\xfd\xef\xc3!)˲eK\xed\xed\xb0=\xcczHď\xf3\xf1;\x9f\xa4\x92$\x19\t#\x9fВ\xd4j\x02\xc2H|u\xa8\xf8\x8d\xd2\xd5\xef)\x95\xfaj\xfdn\x86N\xbc\x1b\xad\xa4\xca'pS\x91\xd3\xe5'$]\xd9\foq.\x95tR\xabQ\x89N\xe4\u0089\xc9\b@(\xa5\x9d\xe0a\xe2W\x80L+guQ\xa0M\x16\xa8\xd2U5\xc3Y%\x8b\x1c\xad\xe7P\xf3_\xbfM\x7fHߎ\x002\x8b~\xfb\xa3,\x91\x9c(\xcd\x04TU\x14#\x00%J\x9c\xc0Z\x17U\x89F\x172\x93H\xe9\x1a\v\xb4:\x95zD\x063f\xb9\xb0\xba2\x13h&\xc2\xce(NP\xe5\xc9\x13y`\"\x1b?\\Hr?\x1dL\xfd,\xc9\xf9iSTV\x14\xfb\xcc\xfd\x14-\xb5u\x1f\x1b\x06\t\xacM\x98\x90jQ\x15¶v17c\x91Ю\xf1/j\xa5\xf4g\xf5\xa3\xc4\"\xa7\t\xccEA8\x02\xa0L\x1b\x9c\x80'iD\x86\xf9\b`-\n\x99{X\x02\x13mP]?\xdc?\xfd0͖Xz\xe0y8Gʬ4~]K\x11 ,0s\x04K\xfd\x19\xdc\x12\xa3H\x04z\xee_g\"[U\x86\xc0-\x85\x03\x8bs\xb4\xa82\x8cT\x01\xa4\x03a\xc3*̡2\x970\x13\x849h\xe5w\x1b\xab\rZ'\x1bz\x91|\x1a)4\vjI\x01v\x9co;\xb6\xa7\xc1\x19\xab\x18\x1c\x04rv7d\t\x11\xd6a\fs \xaf~\xe0*\t,zlUp\xc0\x1d\xb2\xc0K\x84\x02=\xfb\x1bf.\x85)\xe3o\th\xa9\xab\"g\x1f]\xa3e\xcd3\xbdP\xf2\x1f[\xca\x04N{\x96\x85pH\xaeEQ*\x87V\x89\x82\x8dS\xe1%\b\x95C)6`\x91y@\xa5v\xa8\xf9%\x94\xc2\am\x11\xa4\x9a\xeb\t,\x9d34\xb9\xbaZHW\x87[\xa6˲R\xd2m\xae|\xd0\xc8Y崥\xab\x1c\xd7X\\\x91\\$\xc2fK\xe90s\x95\xc5+ad\xe2\x05W\xac,\xa5e\xfe\x1b\x1bc\x93\xcev$u\x1bv'rV\xaa\xc5v؇\xc1Q\xdc9\x12@\x12\x88\xb8-\xa8\xd8\xc0\xcbC\x8cʧ\xbb\xe9#\xd4L\xbd\tvHBD\xbb\xd9F\r\xf0\f\x94Ts\xb4~\x17̭.=Ψr\xa3\xa5r\xfe%+$\xaa6\xe8T\xcdJ\xe9\xd8\xd2\x7f\xaf\x90\x1c\xdb'\x85\x1b\x9ft`\x86P\x99\\8\xccS\xb8Wp#J,n\x04\xe1\x7f\x1cvF\x98\x12\x86\xb4\x1f\xf8\xdd\\Y\xffx\xff$\xa2\xb5\x1d\xae\xd3Y\xa7\x85vc{j0cc1b\xbcI\xcee\xe6\xfd\x1f\xe6ڂh\xe5\x81: \x8f\x05%?\xb6*\xf6\x87\xf6\xb8\x7f\xe2\x15>#4\xb1\x0e!\xbd\x9dQ؟\xc2\xe3\x12Ad^\x0e=ߣ\x06\u07bcsi\xc9\xf9\xe5\xf0y\xa9\t9\fs_Q\xd8\xf3\"\xd9R\xb8l\x89\xc4\n\nc\n\x8998\xddI.lH\xa3\xbe1\x97\xe5Z\x9d\xb9@\x04\x84\xda\x04n\xad\\\x06\xa2\xed\xb4\xfc\xc8ݬ\b\xb9\xcc\xd5\xd9NZl\x843\a\xa0\xf2#\x1d\x96\a\x00\x9e0 \xc3\xe9\xf5\v\xd2q\xa6:j\xb4Ӧ\x8b\x995k\n\xc5I!\xae\xfdB\x90\x87e\xc1\x03VGy\x8dY'Ah\x90<\x94\x93\x1fTU\xd9-K\x02S%\f-\xb5\x1bu\xccB\x02?\xca\x02\xa7\x1brX\xfe\xd1קcTV\xb2{\xaa3\x00\x9b\xa7q\xb7\x01X\xdd\xec\xf8ft\xfcNw\xad\xc8u\x12\x83\xe8\x84\x1c\x935\xa8gTG\x88Ӝ\xbd\x1a\a\a\xe9\xba\xd1<my~2\x92\xb7Vru;\xb6b_\xb3\xe9}\xdc\xc0\x9e |+\xc4\xe5\xf2fz\x0f\xb9\x9f\b\xfd\xd3\xe5Qr\x00Zy\xbf\xfd\xbc\x94\xd9\x12ʊ\x1c\xab\xc3jF\x02\xad\xb6\xa0[\xb3\x13\x913آ\xbb\x8b\x84\xb5bsdM)^o\x84\x11\x99t\x9b\xe3܄\xda\xfcy~|:\x89|\xb8\rX\xa0\xed]\xd7#t\xcb$\x1f\x1a\xf9\xea\xcc^\x8aWYV%d\xf5x\x80\xf4(=\x88`\x9f\x11\x18n\x97ȡr\xbd\x06\xe0N[\xcc\n\x9c\x80\xb3\xd5q\xe2F8\xee}&\xf0\xd7\xf3_\xde|I.ޟ\x9f?\xbfM\xfe\xf0\xf2\xe6\xfc\x97\xd4\xff\xf3\xbb\x8b\xf7\x17_\xea\x977\x17\x17\xe7\xe7\xcf?}\xf8\xd3\xe3\xc3\u074b\xbc\xf8\xf2\xac\xaar\x15\u07be\x9c?\xe3\xdd\xcb@\"\x17\x17\xef\x7f{T\xa4ׄO\x16V\xa1CJ\xa4r\x89\xb6I\x80\xfc\xa4.\xa5T\xff۞ Ձ'H\xf5\x7fO\xf8\xfe\x9e`\xd6\xd9\xcfb\x86\xc5ԟ\x94\xb4\x9d\f3\xd0\xc3\xd3Mk[̡<\x14\x0f]>\xe1\x8bcU\xa1ݽt\xd9\b\xb2B\xc82dU_C~\xbd\xd1z\x8bȶ^ݽ\U0008928e\x17\xc9NP\xf6\xb7\xb6\v\xcb\x1e8\xdc\xcaK\x8b\xa5? \x9c\xe4\x01\xbe\xa9\xdc]\xcfm\t\\\x7f\xbc\xc5\xfc\xf4\xce\xde\xc2r\xa0\xc2\xf5\t1\xe3\xd1h\xa0u\xb7͆\x13RQ8J\xd1%\bX\xe1&\x9c\x1a\xf9`jЊ\x9a\x18X\xf4\xe7M\x0ek^\xd5K\\\xa8\xed\x11\xb3g\xed0\xd3\xc73\"n\xfa\x17\xed\xc1\xb6\xc2m\x9e\n\xf8\xf1\x80\u05c9uق\x19:\x1d\x1a\x9d$\x1c\x1f\xa7\xfb\xbcbpgP?5\xda_\xad\xde\xd6L͙6\x18\x92\xcf=l4\xf6\xf6\xa54]g\x94\xae\x1f{\x91\x8f\x89\xfa\x82\xe0\x89/z\xb6\xf2\x85^\xf3^]\xc2G\xed\xf8\xcfݫ$7\f6\xf6\x89[\x8d\xf4Q;\xbf뻃\x18D\xfej\b\xc36v\x12\xa1B\x93\xc6\xfa\xef\xde3P\n\xf7}%\xad\xf9mM\"\x89O\xfd\xda\xd6X\xb1\xc3Ef\x81Mݗ*\xad\x12,\x8d\xeb8Xu=A\x96\x16\x1f\x0f(\x81\xb6-\x84wY\x0e\xa2\xdc\x16+\x88\x04\x8f|\x1f\x12f\u008dV\xc1w\x80\x90W\x1e \x7f##\x1c.d6\x88E\x89v\x81`N\x97\x8d\xaf̓\xdf\xe0+C\x9a\xf2\xfa\x17\x13m\xebz\xaa\xebI\x06\xa4\xc6dk\xb6\x9e\x85\x9dW0߮\x87/\x80\xbe/\xe8AS\xe4\xe1T)\x8a\x87\xc1\x99y0\xf2\xad\xb8\xdb\x11\xc9\a\x1f\x94\xc2p\xe4\xfd\x93ːw\xda\x7f\x81\x11\xd2R\n\xd7\xfe\xee\xba苿ݝR\xc5SJÄ\xe9K\x02\xb6\xe6Z\x14\\6\x9d\x06\xa1\x00\v_D{\x88\xeb\xf9A\x03r\x19\xaf\x89\xb8\xa4\xcc\xf9Ҝ\xf5\x18\xafp3\xbelEh\x0fe\xdet\xafơ\xf4\x1e$\x89m\x9d֪\xd8\xc0\xd8ύӃƣ\x87\xc7\xc0\xb6d\x90\xd7\rXDN[\xb1\xc0\x9bB\x10\x9d\xf2\x9f\x96CL[\x9b\xda-Z$ȭ'\x9d\xd25\\\f\x1c?\xfe\xb7\xe8\xfc\x9a\x83\nK\"\xcbt\xf4͉kP\xd0\xf4\aw\x90\xe6qc\x06\xc3\xfc\xd4\xechc\x1c\x15\v\x97\xe7Gi\x85\x8cs\nc\x9e\x8f\xd0F\x9a\xa7nj\xa8ʖ \b\xc6\x19\xc9\xf1%\x8c՜\xf8\xcfR\x93{\x10n9\xe6\xa26\xf6\xa5\xe8V\xda\xe0\xf8=\xb21oq\xf4\xd8\"\xa9%\xa4<\x19:\aD\xfe\xdb\x06?\x19{\xc7\vU\x12\xef\xf5F_A\xaf[\x94.\x1e\x89\xbf'\xa6\xd1I\xb2{C\xf1\x93\xd9\x04\xd6\xef\x9a7\x8f_R\x7fW\xe5\t\x00\xffe2\xdf9@\xc6\x10\x8e#䄫\xfc>\x91eh\x1c\xe6;\x9f>\xf9\v\xc8\x04\xc6\xe3\xd6'S\xff\xbas\xd5\n\xcf/\xfc\x91\xd3i\x8by\xfc\xb8G\x13x~\x19\xfd{\x00\x8ey*Ւ\x1e\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VO\x8fܶ\x0f\xbd\xfbS\x10\xf9\x1dr\xf9ٓE.\x85o\xc1\xb6\x05\x82\xa6\xc1\"\x9b\xec%\xc8A#\xd1\x1eveI\x15)\xa7\xdbO_H\x96\xe7_g6)\x8a\xee\xecE4\xf9D>>\xd2nڶmT\xa0\a\x8cL\xde\xf5\xa0\x02\xe1\x1f\x82.\x9f\xb8{\xfc\x81;\xf2\x9b\xf9f\x8b\xa2n\x9aGr\xa6\x87\xdb\xc4\xe2\xa7\x0f\xc8>E\x8d?\xe2@\x8e\x84\xbck&\x14e\x94\xa8\xbe\x01P\xceyQ\xd9\xcc\xf9\b\xa0\xbd\x93\xe8\xad\xc5؎\xe8\xbaǴ\xc5m\"k0\x96\x1b\xd6\xfb\xe7W\xdd\xeb\xeeU\x03\xa0#\x96\xf0\x8f4!\x8b\x9aB\x0f.Y\xdb\x0085a\x0f\xb3\xb7iBv*\xf0\u038b\xf5\xbaxs7\xa3\xc5\xe8;\xf2\r\a\xd4\xf9\xee1\xfa\x14z8<X j^KM\x0f\x05\xed\xbe\xa2\xbd\xabh\xc5\xc1\x12\xcb/\xcf8\xbd#\x96\xe2\x18l\x8a\xca^ͬ\xf80\xb91Y\x15\xafy5\x00!\"c\x9c\xf1\x93{t\xfe\xab\xfb\x99\xd0\x1a\xeeaP\x96\xb1\x01`\xed\x03\xf6\xf0^M\xc8Ai4\r\xc0\xac,\x99\x92\xccR\x93\x0f\xe8\xdeܽ}x}\xafw8\x95~d\xb3A֑B\xf1\xbbR\f\x10\x83\x825\x1b\xf8\xbaÈ\xf0P\x98\x03\x16\x1f\x91k\xe2\x15\x12`\xad\x80\xbbj\n\xd1\a\x8cB+\xc1\xf9w\xa4\xb0\xbd\xed,\x9f\x979\xe1\xc5\aL\xd6\x142\xc8\x0ea^lh\x80K1\xe0\a\x90\x1d1D,L99\xb4j\xfd\xf9\x01\x94\x03\xbf\xfd\r\xb5tp\x9fٌ\f\xbc\xf3ɚ,\xc4\x19\xa3@D\xedGG\x7f\xee\x91\x19ė+\xad\x12d9A$'\x18\x9d\xb2\x99\xea\x84\xff\a\xe5\fL\xea\t\"\xe6; \xb9#\xb4\xe2\xc2\x1d\xfc\xea#\x02\xb9\xc1\xf7\xb0\x13\t\xdco6#\xc9:S\xdaOSr$O\x9b2\x19\xb4M\xe2#o\f\xceh7Lc\xab\xa2ޑ\xa0\x96\x14q\xa3\x02\xb5%q\x97\x8b\xe5n2\xff\x8bu\x00\xf9\xe5Q\xa6\xf2\x94\xc5\xc1\x12ɍ{s\x91\xf8U\u07b3\xb6\x97\xb6/aK\x89\azɍ\x85\x95\x0f?\xdd\x7f\x84\xf5\xd2҂#H\xa8l\x1f\xc2\xf8@|&\x8a܀\xb1D\xc1\x10\xfdT\x10љ\xe0\xc9I9hK\xe8NI紝Hr\xa7\x7fOȒ\xfb\xd3\xc1m\xd9,\xb0EH\xc1(A\xd3\xc1[\a\xb7jB{\xab\x18\xffs\xda3\xc3\xdcfJ\xbfM\xfc\xf1B\\\xffr|_\xd9ڛ\xd7Uu\xb1C\x97'\xf5>\xa0>\x19\x94\x8cA\x03\xd5\xc9\x1d|\x04u\x84\b\xeb\x14_F[\x87\xf7\xda\x00\xd7\r>\xd0xj\x03PƔ\xed\xaf\xecݕ\xb8\xab\xf4\\\xa8\xf5ֻ\x81\xc6,\xc7\\@\x88~&\x83\xb1]k\xab9\xa4X\x8b,\xbb\xb1k.\xddu\xc6p-\xac\xc0\xf5\xcfepW\x9dr\x0eY\x97kвw\xb0\xae\xbf\xb2\fՈ]\xf3]uf\x05Sē)l\xf7\xd0\xcd7rgQ\x92NH\xfd\x1e}\x94\xa0Z۶jD\xa7\x18\xd1IE\x04?\x1ca\x02\xa8\x7f\xaf\x91\xb0S\x8c\xcf\xf2{\x19\xfb.ǭ\x94[\x1aP?i\x8b\v\\f\xfeT\xca\xffH\xce\xf9\x1f]\x9aγj\xe1ͬȪ\xadſ=\xf9\xe4ԕgW\x1a|\xa1og\xa6\xfa\x1e\xeba\xbe9\x9cJS\xdb\xf5\x8b&?\x00(/\x7fӃĴ$V\xa5V-\a1(\xad1\b\x9a\xf7\xe7\x1f3/^\x9c|\x8f\x94\xa3\xf6n\x99S\xee\xe1\xf3\x97\xfc\x1d\x91\xdf榾q\xb9\x87\xcf_\x9a\xbf\x06\x00J\xbeWz\r\n\x00\x00"),
 }
 
 var CRDs = crds()
@@ -18,12 +18,16 @@ package velero
 
 import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 )
 
 type PluginLister interface {
 	// List returns all PluginIdentifiers for kind.
 	List(kind framework.PluginKind) []framework.PluginIdentifier
+	// Status returns the capabilities and health that the plugin for kind and name
+	// reported when it was discovered.
+	Status(kind framework.PluginKind, name string) (clientmgmt.PluginStatus, error)
 }
 
 // GetInstalledPluginInfo returns a list of installed plugins
@@ -32,12 +36,28 @@ func GetInstalledPluginInfo(pluginLister PluginLister) []velerov1api.PluginInfo
 	for _, v := range framework.AllPluginKinds() {
 		list := pluginLister.List(v)
 		for _, plugin := range list {
-			pluginInfo := velerov1api.PluginInfo{
-				Name: plugin.Name,
-				Kind: plugin.Kind.String(),
-			}
-			plugins = append(plugins, pluginInfo)
+			plugins = append(plugins, GetPluginInfo(pluginLister, plugin))
 		}
 	}
 	return plugins
 }
+
+// GetPluginInfo returns information about an installed plugin
+func GetPluginInfo(pluginLister PluginLister, plugin framework.PluginIdentifier) velerov1api.PluginInfo {
+	pluginInfo := velerov1api.PluginInfo{
+		Name: plugin.Name,
+		Kind: plugin.Kind.String(),
+	}
+	if status, err := pluginLister.Status(plugin.Kind, plugin.Name); err == nil {
+		if status.Capabilities != nil {
+			pluginInfo.APIVersion = status.Capabilities.APIVersion
+			for _, feature := range status.Capabilities.Features {
+				pluginInfo.Features = append(pluginInfo.Features, string(feature))
+			}
+		}
+		ready := status.Health.Ready
+		pluginInfo.Ready = &ready
+		pluginInfo.Message = status.Health.Message
+	}
+	return pluginInfo
+}
@@ -0,0 +1,87 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package velero
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+type fakePluginLister struct {
+	plugins  []framework.PluginIdentifier
+	statuses map[string]clientmgmt.PluginStatus
+}
+
+func (l *fakePluginLister) List(kind framework.PluginKind) []framework.PluginIdentifier {
+	var plugins []framework.PluginIdentifier
+	for _, plugin := range l.plugins {
+		if plugin.Kind == kind {
+			plugins = append(plugins, plugin)
+		}
+	}
+	return plugins
+}
+
+func (l *fakePluginLister) Status(kind framework.PluginKind, name string) (clientmgmt.PluginStatus, error) {
+	return l.statuses[name], nil
+}
+
+func TestGetInstalledPluginInfo(t *testing.T) {
+	lister := &fakePluginLister{
+		plugins: []framework.PluginIdentifier{
+			{Kind: framework.PluginKindObjectStore, Name: "velero.io/s3"},
+			{Kind: framework.PluginKindVolumeSnapshotter, Name: "example.io/legacy"},
+		},
+		statuses: map[string]clientmgmt.PluginStatus{
+			"velero.io/s3": {
+				Capabilities: &framework.PluginCapabilities{
+					APIVersion: framework.PluginAPIVersion,
+					Features:   []velero.PluginFeature{velero.PluginFeatureStreamingUpload, velero.PluginFeatureObjectRetention},
+				},
+				Health: framework.PluginHealth{Message: "credentials are missing"},
+			},
+			"example.io/legacy": {
+				Health: framework.PluginHealth{Ready: true},
+			},
+		},
+	}
+
+	ready, notReady := true, false
+	expected := []velerov1api.PluginInfo{
+		{
+			Name:       "velero.io/s3",
+			Kind:       "ObjectStore",
+			APIVersion: framework.PluginAPIVersion,
+			Features:   []string{"StreamingUpload", "ObjectRetention"},
+			Ready:      &notReady,
+			Message:    "credentials are missing",
+		},
+		{
+			Name:  "example.io/legacy",
+			Kind:  "VolumeSnapshotter",
+			Ready: &ready,
+		},
+	}
+
+	assert.ElementsMatch(t, expected, GetInstalledPluginInfo(lister))
+}
@@ -0,0 +1,227 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumepolicy
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// Volume is a volume that a policy is evaluated against: a pod volume, the
+// persistent volume claim it uses, if any, and its persistent volume, if any.
+// Persistent volumes that are backed up on their own have no pod volume.
+type Volume struct {
+	PodVolume *corev1api.Volume
+	PVC       *corev1api.PersistentVolumeClaim
+	PV        *corev1api.PersistentVolume
+}
+
+// Policy is a validated VolumePolicy.
+type Policy struct {
+	rules []rule
+}
+
+type rule struct {
+	conditions velerov1api.VolumePolicyConditions
+	selector   labels.Selector
+	action     velerov1api.VolumePolicyAction
+}
+
+// New validates the given VolumePolicy and returns a Policy for it.
+func New(policy *velerov1api.VolumePolicy) (*Policy, error) {
+	p := new(Policy)
+
+	for i, r := range policy.Spec.Rules {
+		switch r.Action {
+		case velerov1api.VolumePolicyActionSnapshot, velerov1api.VolumePolicyActionFileSystemBackup, velerov1api.VolumePolicyActionSkip:
+		default:
+			return nil, errors.Errorf("rule %d has invalid action %q", i, r.Action)
+		}
+
+		conditions := r.Conditions
+		if conditions.MinCapacity != nil && conditions.MaxCapacity != nil && conditions.MinCapacity.Cmp(*conditions.MaxCapacity) > 0 {
+			return nil, errors.Errorf("rule %d has a minimum capacity greater than its maximum capacity", i)
+		}
+
+		for _, volumeType := range conditions.VolumeTypes {
+			if !volumeTypes.Has(volumeType) {
+				return nil, errors.Errorf("rule %d has invalid volume type %q", i, volumeType)
+			}
+		}
+
+		var selector labels.Selector
+		if conditions.PVCLabelSelector != nil {
+			var err error
+			if selector, err = metav1.LabelSelectorAsSelector(conditions.PVCLabelSelector); err != nil {
+				return nil, errors.Wrapf(err, "rule %d has invalid PVC label selector", i)
+			}
+		}
+
+		p.rules = append(p.rules, rule{
+			conditions: conditions,
+			selector:   selector,
+			action:     r.Action,
+		})
+	}
+
+	return p, nil
+}
+
+// GetAction returns the action of the first of the policy's rules that the
+// given volume matches, and whether it matched any. A nil Policy matches no
+// volumes.
+func (p *Policy) GetAction(volume Volume) (velerov1api.VolumePolicyAction, bool) {
+	if p == nil {
+		return "", false
+	}
+
+	for _, r := range p.rules {
+		if r.matches(volume) {
+			return r.action, true
+		}
+	}
+
+	return "", false
+}
+
+func (r *rule) matches(volume Volume) bool {
+	conditions := r.conditions
+
+	if len(conditions.StorageClasses) > 0 && !contains(conditions.StorageClasses, storageClass(volume)) {
+		return false
+	}
+
+	if r.selector != nil && (volume.PVC == nil || !r.selector.Matches(labels.Set(volume.PVC.Labels))) {
+		return false
+	}
+
+	if conditions.MinCapacity != nil || conditions.MaxCapacity != nil {
+		capacity, ok := capacity(volume)
+		if !ok {
+			return false
+		}
+		if conditions.MinCapacity != nil && capacity.Cmp(*conditions.MinCapacity) < 0 {
+			return false
+		}
+		if conditions.MaxCapacity != nil && capacity.Cmp(*conditions.MaxCapacity) > 0 {
+			return false
+		}
+	}
+
+	if len(conditions.CSIDrivers) > 0 && !contains(conditions.CSIDrivers, csiDriver(volume)) {
+		return false
+	}
+
+	if len(conditions.VolumeTypes) > 0 && !contains(conditions.VolumeTypes, volumeType(volume)) {
+		return false
+	}
+
+	return true
+}
+
+func contains(values []string, value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func storageClass(volume Volume) string {
+	if volume.PVC != nil && volume.PVC.Spec.StorageClassName != nil {
+		return *volume.PVC.Spec.StorageClassName
+	}
+	if volume.PV != nil {
+		return volume.PV.Spec.StorageClassName
+	}
+	return ""
+}
+
+func capacity(volume Volume) (quantity resource.Quantity, ok bool) {
+	if volume.PV != nil {
+		quantity, ok = volume.PV.Spec.Capacity[corev1api.ResourceStorage]
+		return
+	}
+	if volume.PVC != nil {
+		quantity, ok = volume.PVC.Status.Capacity[corev1api.ResourceStorage]
+		return
+	}
+	return
+}
+
+func csiDriver(volume Volume) string {
+	if volume.PV != nil && volume.PV.Spec.CSI != nil {
+		return volume.PV.Spec.CSI.Driver
+	}
+	if volume.PodVolume != nil && volume.PodVolume.CSI != nil {
+		return volume.PodVolume.CSI.Driver
+	}
+	return ""
+}
+
+// volumeType returns the JSON name of the source field that's set on the
+// volume, using the persistent volume's source for volumes that use a
+// persistent volume claim.
+func volumeType(volume Volume) string {
+	if volume.PV != nil {
+		return sourceType(volume.PV.Spec.PersistentVolumeSource)
+	}
+	if volume.PodVolume != nil && volume.PodVolume.PersistentVolumeClaim == nil {
+		return sourceType(volume.PodVolume.VolumeSource)
+	}
+	return ""
+}
+
+func sourceType(source interface{}) string {
+	v := reflect.ValueOf(source)
+	for i := 0; i < v.NumField(); i++ {
+		if !v.Field(i).IsNil() {
+			return jsonName(v.Type().Field(i))
+		}
+	}
+	return ""
+}
+
+func jsonName(field reflect.StructField) string {
+	return strings.Split(field.Tag.Get("json"), ",")[0]
+}
+
+// volumeTypes is the set of valid volume types, the JSON names of the fields
+// of pod and persistent volume sources.
+var volumeTypes = func() sets.String {
+	types := sets.NewString()
+	for _, t := range []reflect.Type{reflect.TypeOf(corev1api.VolumeSource{}), reflect.TypeOf(corev1api.PersistentVolumeSource{})} {
+		for i := 0; i < t.NumField(); i++ {
+			types.Insert(jsonName(t.Field(i)))
+		}
+	}
+	types.Delete("persistentVolumeClaim")
+	return types
+}()
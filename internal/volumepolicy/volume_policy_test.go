@@ -0,0 +1,203 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumepolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func quantity(s string) *resource.Quantity {
+	q := resource.MustParse(s)
+	return &q
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   []velerov1api.VolumePolicyRule
+		wantErr string
+	}{
+		{
+			name: "valid rules",
+			rules: []velerov1api.VolumePolicyRule{
+				{
+					Conditions: velerov1api.VolumePolicyConditions{
+						PVCLabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+						MinCapacity:      quantity("1Gi"),
+						MaxCapacity:      quantity("10Gi"),
+						VolumeTypes:      []string{"csi", "nfs"},
+					},
+					Action: velerov1api.VolumePolicyActionSnapshot,
+				},
+				{Action: velerov1api.VolumePolicyActionSkip},
+			},
+		},
+		{
+			name:    "invalid action",
+			rules:   []velerov1api.VolumePolicyRule{{Action: "Copy"}},
+			wantErr: `rule 0 has invalid action "Copy"`,
+		},
+		{
+			name: "minimum capacity greater than maximum capacity",
+			rules: []velerov1api.VolumePolicyRule{
+				{
+					Conditions: velerov1api.VolumePolicyConditions{MinCapacity: quantity("10Gi"), MaxCapacity: quantity("1Gi")},
+					Action:     velerov1api.VolumePolicyActionSkip,
+				},
+			},
+			wantErr: "rule 0 has a minimum capacity greater than its maximum capacity",
+		},
+		{
+			name: "invalid volume type",
+			rules: []velerov1api.VolumePolicyRule{
+				{Action: velerov1api.VolumePolicyActionSkip},
+				{
+					Conditions: velerov1api.VolumePolicyConditions{VolumeTypes: []string{"persistentVolumeClaim"}},
+					Action:     velerov1api.VolumePolicyActionSkip,
+				},
+			},
+			wantErr: `rule 1 has invalid volume type "persistentVolumeClaim"`,
+		},
+		{
+			name: "invalid PVC label selector",
+			rules: []velerov1api.VolumePolicyRule{
+				{
+					Conditions: velerov1api.VolumePolicyConditions{
+						PVCLabelSelector: &metav1.LabelSelector{
+							MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Matches"}},
+						},
+					},
+					Action: velerov1api.VolumePolicyActionSkip,
+				},
+			},
+			wantErr: "rule 0 has invalid PVC label selector",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			policy, err := New(builder.ForVolumePolicy(velerov1api.DefaultNamespace, "policy-1").Rules(test.rules...).Result())
+			if test.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, policy.rules, len(test.rules))
+		})
+	}
+}
+
+func TestGetAction(t *testing.T) {
+	pvc := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").
+		ObjectMeta(builder.WithLabels("app", "db")).
+		StorageClass("gp2").
+		VolumeName("pv-1").
+		Result()
+	csiPV := builder.ForPersistentVolume("pv-1").CSI("ebs.csi.aws.com", "vol-1").Capacity("5Gi").Result()
+	nfsPV := builder.ForPersistentVolume("pv-1").StorageClass("nfs").Capacity("50Gi").Result()
+	nfsPV.Spec.NFS = &corev1api.NFSVolumeSource{Server: "nfs.example.com", Path: "/exports"}
+
+	rules := []velerov1api.VolumePolicyRule{
+		{
+			Conditions: velerov1api.VolumePolicyConditions{
+				StorageClasses:   []string{"gp2"},
+				PVCLabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+				MaxCapacity:      quantity("10Gi"),
+			},
+			Action: velerov1api.VolumePolicyActionSnapshot,
+		},
+		{
+			Conditions: velerov1api.VolumePolicyConditions{CSIDrivers: []string{"ebs.csi.aws.com"}},
+			Action:     velerov1api.VolumePolicyActionFileSystemBackup,
+		},
+		{
+			Conditions: velerov1api.VolumePolicyConditions{VolumeTypes: []string{"nfs", "emptyDir"}},
+			Action:     velerov1api.VolumePolicyActionSkip,
+		},
+	}
+
+	tests := []struct {
+		name        string
+		volume      Volume
+		wantAction  velerov1api.VolumePolicyAction
+		wantMatched bool
+	}{
+		{
+			name:        "volume matching all of the first rule's conditions gets its action",
+			volume:      Volume{PodVolume: builder.ForVolume("data").PersistentVolumeClaimSource("pvc-1").Result(), PVC: pvc, PV: csiPV},
+			wantAction:  velerov1api.VolumePolicyActionSnapshot,
+			wantMatched: true,
+		},
+		{
+			name:        "volume too large for the first rule gets the action of the next rule it matches",
+			volume:      Volume{PVC: pvc, PV: builder.ForPersistentVolume("pv-1").CSI("ebs.csi.aws.com", "vol-1").Capacity("20Gi").Result()},
+			wantAction:  velerov1api.VolumePolicyActionFileSystemBackup,
+			wantMatched: true,
+		},
+		{
+			name:        "inline CSI pod volume matches on its driver",
+			volume:      Volume{PodVolume: builder.ForVolume("data").CSISource("ebs.csi.aws.com").Result()},
+			wantAction:  velerov1api.VolumePolicyActionFileSystemBackup,
+			wantMatched: true,
+		},
+		{
+			name:        "persistent volume without a claim matches on its source type",
+			volume:      Volume{PV: nfsPV},
+			wantAction:  velerov1api.VolumePolicyActionSkip,
+			wantMatched: true,
+		},
+		{
+			name:        "pod volume matches on its source type",
+			volume:      Volume{PodVolume: builder.ForVolume("cache").EmptyDirSource().Result()},
+			wantAction:  velerov1api.VolumePolicyActionSkip,
+			wantMatched: true,
+		},
+		{
+			name:        "volume matching no rule isn't matched",
+			volume:      Volume{PodVolume: builder.ForVolume("data").PersistentVolumeClaimSource("pvc-2").Result()},
+			wantMatched: false,
+		},
+	}
+
+	policy, err := New(builder.ForVolumePolicy(velerov1api.DefaultNamespace, "policy-1").Rules(rules...).Result())
+	require.NoError(t, err)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			action, matched := policy.GetAction(test.volume)
+			assert.Equal(t, test.wantAction, action)
+			assert.Equal(t, test.wantMatched, matched)
+		})
+	}
+}
+
+func TestGetActionNilPolicy(t *testing.T) {
+	var policy *Policy
+	action, matched := policy.GetAction(Volume{PodVolume: builder.ForVolume("cache").EmptyDirSource().Result()})
+	assert.Equal(t, velerov1api.VolumePolicyAction(""), action)
+	assert.False(t, matched)
+}
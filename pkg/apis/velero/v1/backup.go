@@ -66,6 +66,13 @@ type BackupSpec struct {
 	// +nullable
 	SnapshotVolumes *bool `json:"snapshotVolumes,omitempty"`
 
+	// GroupVolumeSnapshotsByPod specifies whether the persistent volumes
+	// of each pod are snapshotted together, as a crash-consistent group,
+	// by volume snapshotters that support group snapshots.
+	// +optional
+	// +nullable
+	GroupVolumeSnapshotsByPod *bool `json:"groupVolumeSnapshotsByPod,omitempty"`
+
 	// TTL is a time.Duration-parseable string describing how long
 	// the Backup should be retained for.
 	// +optional
@@ -85,6 +92,12 @@ type BackupSpec struct {
 	// +optional
 	StorageLocation string `json:"storageLocation,omitempty"`
 
+	// MirrorStorageLocations is a list containing names of additional
+	// BackupStorageLocations that the backup is copied to once it completes.
+	// +optional
+	// +nullable
+	MirrorStorageLocations []string `json:"mirrorStorageLocations,omitempty"`
+
 	// VolumeSnapshotLocations is a list containing names of VolumeSnapshotLocations associated with this backup.
 	// +optional
 	VolumeSnapshotLocations []string `json:"volumeSnapshotLocations,omitempty"`
@@ -95,6 +108,32 @@ type BackupSpec struct {
 	// + nullable
 	DefaultVolumesToRestic *bool `json:"defaultVolumesToRestic,omitempty"`
 
+	// UploaderType is the type of the uploader used to back up pod volume
+	// data. Defaults to "restic".
+	// +optional
+	// +kubebuilder:validation:Enum=restic
+	UploaderType string `json:"uploaderType,omitempty"`
+
+	// VolumePolicy is the name of the VolumePolicy, in the Velero namespace,
+	// that selects how the backup's volumes are backed up. Volumes it selects
+	// an action for aren't subject to the pod volume annotations or
+	// DefaultVolumesToRestic.
+	// +optional
+	VolumePolicy string `json:"volumePolicy,omitempty"`
+
+	// PodVolumeTransfer holds the settings used to throttle pod volume data
+	// transfers for this backup and restores from it. Any settings specified
+	// here take precedence over those of the backup storage location.
+	// +optional
+	// +nullable
+	PodVolumeTransfer *PodVolumeTransferSettings `json:"podVolumeTransfer,omitempty"`
+
+	// PodVolumePathFilters select the paths within pod volumes that are
+	// backed up using restic.
+	// +optional
+	// +nullable
+	PodVolumePathFilters []PodVolumePathFilter `json:"podVolumePathFilters,omitempty"`
+
 	// OrderedResources specifies the backup order of resources of specific Kind.
 	// The map key is the Kind name and value is a list of resource names separated by commas.
 	// Each resource name has format "namespace/resourcename".  For cluster resources, simply use "resourcename".
@@ -103,6 +142,29 @@ type BackupSpec struct {
 	OrderedResources map[string]string `json:"orderedResources,omitempty"`
 }
 
+// PodVolumePathFilter selects the paths within pod volumes that are backed up
+// using restic.
+type PodVolumePathFilter struct {
+	// Volumes are the names of the pod volumes the filter applies to. If
+	// empty, it applies to all pod volumes.
+	// +optional
+	// +nullable
+	Volumes []string `json:"volumes,omitempty"`
+
+	// IncludedPaths are glob patterns of the paths, relative to the volume's
+	// root, to back up. If empty, all paths are backed up.
+	// +optional
+	// +nullable
+	IncludedPaths []string `json:"includedPaths,omitempty"`
+
+	// ExcludedPaths are restic exclude patterns of the paths not to back up.
+	// Patterns starting with "/" are relative to the volume's root, others
+	// match at any depth.
+	// +optional
+	// +nullable
+	ExcludedPaths []string `json:"excludedPaths,omitempty"`
+}
+
 // BackupHooks contains custom behaviors that should be executed at different phases of the backup.
 type BackupHooks struct {
 	// Resources are hooks that should be executed when backing up individual instances of a resource.
@@ -198,7 +260,7 @@ const (
 
 // BackupPhase is a string representation of the lifecycle phase
 // of a Velero backup.
-// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;Completed;PartiallyFailed;Failed;Deleting
+// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;Uploading;UploadingPartialFailure;Completed;PartiallyFailed;Failed;Deleting
 type BackupPhase string
 
 const (
@@ -310,6 +372,82 @@ type BackupStatus struct {
 	// +optional
 	// +nullable
 	Progress *BackupProgress `json:"progress,omitempty"`
+
+	// Replicas records the status of the copies of the backup in its mirror
+	// storage locations.
+	// +optional
+	// +nullable
+	Replicas []BackupReplicaStatus `json:"replicas,omitempty"`
+
+	// RetainUntil is the time until which the backup's objects in its storage
+	// location are locked, so the backup can't be deleted or garbage
+	// collected before then.
+	// +optional
+	// +nullable
+	RetainUntil *metav1.Time `json:"retainUntil,omitempty"`
+
+	// LegalHold is true if a legal hold is placed on the backup's objects in
+	// its storage location, so the backup can't be deleted or garbage
+	// collected until the hold is removed.
+	// +optional
+	LegalHold bool `json:"legalHold,omitempty"`
+}
+
+// BackupReplicaPhase is a string representation of the lifecycle phase
+// of a copy of a Velero backup.
+// +kubebuilder:validation:Enum=New;InProgress;Completed;Failed
+type BackupReplicaPhase string
+
+const (
+	// BackupReplicaPhaseNew means the backup hasn't been copied to the
+	// storage location yet.
+	BackupReplicaPhaseNew BackupReplicaPhase = "New"
+
+	// BackupReplicaPhaseInProgress means the backup is being copied to the
+	// storage location.
+	BackupReplicaPhaseInProgress BackupReplicaPhase = "InProgress"
+
+	// BackupReplicaPhaseCompleted means the backup has been copied to the
+	// storage location.
+	BackupReplicaPhaseCompleted BackupReplicaPhase = "Completed"
+
+	// BackupReplicaPhaseFailed means the backup couldn't be copied to the
+	// storage location.
+	BackupReplicaPhaseFailed BackupReplicaPhase = "Failed"
+)
+
+// BackupReplicaStatus is the status of the copy of a backup in one of its
+// mirror storage locations.
+type BackupReplicaStatus struct {
+	// StorageLocation is the name of the BackupStorageLocation the backup is
+	// copied to.
+	StorageLocation string `json:"storageLocation"`
+
+	// Phase is the current state of the copy.
+	// +optional
+	Phase BackupReplicaPhase `json:"phase,omitempty"`
+
+	// FailureReason is the reason the backup couldn't be copied, if it failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+
+	// CompletionTimestamp records the time the backup was copied, or the
+	// time of the last failed attempt to copy it.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// Attempts is the number of times copying the backup has been attempted.
+	// Failed copies are retried with a backoff until the maximum number of
+	// attempts is reached.
+	// +optional
+	Attempts int `json:"attempts,omitempty"`
+
+	// Warning describes the data of the backup that isn't included in the
+	// copy, such as the backup's volume snapshots and restic backups, which
+	// are only stored by the backup's own locations.
+	// +optional
+	Warning string `json:"warning,omitempty"`
 }
 
 // BackupProgress stores information about the progress of a Backup's execution.
@@ -325,6 +463,29 @@ type BackupProgress struct {
 	// backup tarball so far.
 	// +optional
 	ItemsBackedUp int `json:"itemsBackedUp,omitempty"`
+
+	// VolumeSnapshotsUploading is the number of volume snapshots whose data is
+	// still being uploaded by their volume snapshotter.
+	// +optional
+	VolumeSnapshotsUploading int `json:"volumeSnapshotsUploading,omitempty"`
+
+	// VolumeSnapshotBytesUploaded is the number of bytes of volume snapshot data
+	// uploaded so far, as reported by the volume snapshotters that track the
+	// progress of their snapshots.
+	// +optional
+	VolumeSnapshotBytesUploaded int64 `json:"volumeSnapshotBytesUploaded,omitempty"`
+
+	// VolumeSnapshotBytesTotal is the total number of bytes of volume snapshot
+	// data to upload, as reported by the volume snapshotters that track the
+	// progress of their snapshots.
+	// +optional
+	VolumeSnapshotBytesTotal int64 `json:"volumeSnapshotBytesTotal,omitempty"`
+
+	// VolumeSnapshotUploadStartTimestamp records the time the backup started
+	// waiting for the data of its volume snapshots to be uploaded.
+	// +optional
+	// +nullable
+	VolumeSnapshotUploadStartTimestamp *metav1.Time `json:"volumeSnapshotUploadStartTimestamp,omitempty"`
 }
 
 // +genclient
@@ -54,8 +54,65 @@ type BackupStorageLocationSpec struct {
 	// +optional
 	// +nullable
 	ValidationFrequency *metav1.Duration `json:"validationFrequency,omitempty"`
+
+	// MaxConcurrentPodVolumeBackups is the maximum number of restic pod volume backups
+	// to this location that may run at the same time across all nodes. Pod volume backups
+	// beyond this limit are queued until others complete. A value of 0 means no limit.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxConcurrentPodVolumeBackups int `json:"maxConcurrentPodVolumeBackups,omitempty"`
+
+	// PodVolumeTransfer holds the settings used to throttle pod volume data
+	// transfers to and from this location. Settings specified by a backup
+	// take precedence.
+	// +optional
+	// +nullable
+	PodVolumeTransfer *PodVolumeTransferSettings `json:"podVolumeTransfer,omitempty"`
+
+	// ObjectLock holds the settings used to lock the objects of the backups
+	// stored in this location, so that they can't be deleted or overwritten
+	// until their retention ends. The location's object store plugin must
+	// support locking objects, and its bucket must have object locking enabled.
+	// +optional
+	// +nullable
+	ObjectLock *ObjectLockSettings `json:"objectLock,omitempty"`
 }
 
+// ObjectLockSettings are the settings used to lock the objects of backups in
+// object storage.
+type ObjectLockSettings struct {
+	// Mode is the retention mode of the objects. Governance retention can be
+	// shortened or removed by users with special permissions, and Compliance
+	// retention can't be shortened or removed by anyone. Defaults to Governance.
+	// +optional
+	Mode ObjectLockMode `json:"mode,omitempty"`
+
+	// RetentionPeriod is how long the objects are retained for after they're
+	// uploaded.
+	// +optional
+	// +nullable
+	RetentionPeriod *metav1.Duration `json:"retentionPeriod,omitempty"`
+
+	// LegalHold places a legal hold on the objects, which keeps them from being
+	// deleted until it's removed, regardless of their retention.
+	// +optional
+	LegalHold bool `json:"legalHold,omitempty"`
+}
+
+// ObjectLockMode is the retention mode of locked objects.
+// +kubebuilder:validation:Enum=Governance;Compliance
+type ObjectLockMode string
+
+const (
+	// ObjectLockModeGovernance means that users with special permissions can
+	// shorten or remove the retention of the objects.
+	ObjectLockModeGovernance ObjectLockMode = "Governance"
+
+	// ObjectLockModeCompliance means that nobody can shorten or remove the
+	// retention of the objects.
+	ObjectLockModeCompliance ObjectLockMode = "Compliance"
+)
+
 // BackupStorageLocationStatus defines the observed state of BackupStorageLocation
 type BackupStorageLocationStatus struct {
 	// Phase is the current state of the BackupStorageLocation.
@@ -46,4 +46,12 @@ const (
 
 	// APIGroupVersionsFeatureFlag is the feature flag string that defines whether or not to handle multiple API Group Versions
 	APIGroupVersionsFeatureFlag = "EnableAPIGroupVersions"
+
+	// ResticHostPathVolumesFeatureFlag is the feature flag string that defines whether or not hostPath
+	// volumes are backed up using restic.
+	ResticHostPathVolumesFeatureFlag = "EnableResticHostPathVolumes"
+
+	// ResticBlockVolumesFeatureFlag is the feature flag string that defines whether or not raw block
+	// persistent volumes are backed up using restic.
+	ResticBlockVolumesFeatureFlag = "EnableResticBlockVolumes"
 )
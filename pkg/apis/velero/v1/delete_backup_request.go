@@ -48,6 +48,17 @@ type DeleteBackupRequestStatus struct {
 	// +optional
 	// +nullable
 	Errors []string `json:"errors,omitempty"`
+
+	// LockedUntil is the time until which the backup's objects in object
+	// storage are locked. The deletion is retried then.
+	// +optional
+	// +nullable
+	LockedUntil *metav1.Time `json:"lockedUntil,omitempty"`
+
+	// LegalHold is true if the backup's objects in object storage are locked
+	// by a legal hold. The deletion is retried periodically until it's removed.
+	// +optional
+	LegalHold bool `json:"legalHold,omitempty"`
 }
 
 // +genclient
@@ -38,6 +38,15 @@ const (
 	// PVCUIDLabel is the label key used to identify a PVC by uid.
 	PVCUIDLabel = "velero.io/pvc-uid"
 
+	// RestoreHelperPodLabel is the label key used to identify the temporary
+	// pods used to restore pod volumes before the restored pods are created.
+	RestoreHelperPodLabel = "velero.io/restore-helper-pod"
+
+	// VolumeSnapshotGroupLabel is the label key used on persistent volume
+	// claims to group the volumes that are snapshotted together, as a
+	// crash-consistent group.
+	VolumeSnapshotGroupLabel = "velero.io/volume-snapshot-group"
+
 	// PodVolumeOperationTimeoutAnnotation is the annotation key used to apply
 	// a backup/restore-specific timeout value for pod volume operations (i.e.
 	// restic backups/restores).
@@ -62,4 +71,32 @@ const (
 	// SourceClusterK8sMajorVersionAnnotation is the label key used to identify the k8s
 	// minor version of the backup , i.e. 16
 	SourceClusterK8sMinorVersionAnnotation = "velero.io/source-cluster-k8s-minor-version"
+
+	// ResticRepositoryCheckRequestAnnotation is the annotation key used to
+	// request an integrity check of a restic repository.
+	ResticRepositoryCheckRequestAnnotation = "velero.io/check-requested"
+
+	// ResticRepositoryUnlockRequestAnnotation is the annotation key used to
+	// request that locks be removed from a restic repository. Its value is
+	// either ResticRepositoryUnlockStale or ResticRepositoryUnlockAll.
+	ResticRepositoryUnlockRequestAnnotation = "velero.io/unlock-requested"
+
+	// ResticRepositoryUnlockStale requests that only stale locks are removed.
+	ResticRepositoryUnlockStale = "stale"
+
+	// ResticRepositoryUnlockAll requests that all locks are removed.
+	ResticRepositoryUnlockAll = "all"
+
+	// ResticRepositoryKeyRotationAnnotation is the annotation key used on a
+	// restic repository key secret to identify a rotation of the key.
+	ResticRepositoryKeyRotationAnnotation = "velero.io/key-rotation-id"
+
+	// ResticRepositoryKeyRotationAbortedAnnotation is the annotation key used
+	// on a restic repository key secret to record that the rotation with the
+	// ID in its value was aborted.
+	ResticRepositoryKeyRotationAbortedAnnotation = "velero.io/key-rotation-aborted"
+
+	// ResticRepositoryKeyLabel is the label key used to identify the secrets
+	// holding the keys of restic repositories.
+	ResticRepositoryKeyLabel = "velero.io/restic-repository-key"
 )
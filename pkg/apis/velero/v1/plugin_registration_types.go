@@ -0,0 +1,112 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=pr
+// +kubebuilder:object:generate=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+
+// PluginRegistration registers the plugins of a plugin server that runs
+// separately from the Velero server, e.g. as its own deployment, and that
+// the Velero server connects to over the network.
+type PluginRegistration struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec PluginRegistrationSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status PluginRegistrationStatus `json:"status,omitempty"`
+}
+
+// PluginRegistrationSpec is the specification for a PluginRegistration.
+type PluginRegistrationSpec struct {
+	// Address is the host and port of the plugin server.
+	Address string `json:"address"`
+
+	// TLSSecretName is the name of the secret, in the Velero namespace,
+	// with the certificate and key that the Velero server authenticates to
+	// the plugin server with, under the "tls.crt" and "tls.key" keys, and
+	// the certificate authority that the plugin server's certificate is
+	// verified against, under the "ca.crt" key.
+	TLSSecretName string `json:"tlsSecretName"`
+
+	// ServerName is the name that the plugin server's certificate is
+	// verified against. It defaults to the host of the address.
+	// +optional
+	ServerName string `json:"serverName,omitempty"`
+}
+
+// PluginRegistrationPhase represents the lifecycle phase of a PluginRegistration.
+// +kubebuilder:validation:Enum=New;Registered;Failed
+type PluginRegistrationPhase string
+
+const (
+	// PluginRegistrationPhaseNew means the plugins of the PluginRegistration
+	// haven't been registered yet.
+	PluginRegistrationPhaseNew PluginRegistrationPhase = "New"
+
+	// PluginRegistrationPhaseRegistered means the plugins of the
+	// PluginRegistration have been registered with the Velero server.
+	PluginRegistrationPhaseRegistered PluginRegistrationPhase = "Registered"
+
+	// PluginRegistrationPhaseFailed means the plugins of the
+	// PluginRegistration couldn't be registered with the Velero server.
+	PluginRegistrationPhaseFailed PluginRegistrationPhase = "Failed"
+)
+
+// PluginRegistrationStatus is the current status of a PluginRegistration.
+type PluginRegistrationStatus struct {
+	// Phase is the current lifecycle phase of the PluginRegistration.
+	// +optional
+	Phase PluginRegistrationPhase `json:"phase,omitempty"`
+
+	// Message explains why the plugins couldn't be registered.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Plugins list information about the plugins registered from the plugin
+	// server.
+	// +optional
+	// +nullable
+	Plugins []PluginInfo `json:"plugins,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:rbac:groups=velero.io,resources=pluginregistrations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=pluginregistrations/status,verbs=get;update;patch
+
+// PluginRegistrationList is a list of PluginRegistrations.
+type PluginRegistrationList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []PluginRegistration `json:"items"`
+}
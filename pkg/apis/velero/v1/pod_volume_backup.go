@@ -40,23 +40,72 @@ type PodVolumeBackupSpec struct {
 	// RepoIdentifier is the restic repository identifier.
 	RepoIdentifier string `json:"repoIdentifier"`
 
+	// RepositoryKeySecret is the name of the secret in the Velero namespace
+	// that holds the restic repository's password. If empty, the key shared
+	// by all repositories is used.
+	// +optional
+	RepositoryKeySecret string `json:"repositoryKeySecret,omitempty"`
+
 	// Tags are a map of key-value pairs that should be applied to the
 	// volume backup as tags.
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
+
+	// IncludedPaths are glob patterns of the paths, relative to the volume's
+	// root, to back up. If empty, all paths are backed up.
+	// +optional
+	// +nullable
+	IncludedPaths []string `json:"includedPaths,omitempty"`
+
+	// ExcludedPaths are restic exclude patterns of the paths not to back up.
+	// Patterns starting with "/" are relative to the volume's root.
+	// +optional
+	// +nullable
+	ExcludedPaths []string `json:"excludedPaths,omitempty"`
+
+	// UploaderType is the type of the uploader used to back up the volume.
+	// Defaults to "restic".
+	// +optional
+	UploaderType string `json:"uploaderType,omitempty"`
+
+	// PodVolumeTransfer holds the settings used to throttle the transfer,
+	// overriding those of the backup storage location.
+	// +optional
+	// +nullable
+	PodVolumeTransfer *PodVolumeTransferSettings `json:"podVolumeTransfer,omitempty"`
 }
 
 // PodVolumeBackupPhase represents the lifecycle phase of a PodVolumeBackup.
-// +kubebuilder:validation:Enum=New;InProgress;Completed;Failed
+// +kubebuilder:validation:Enum=New;Queued;InProgress;Completed;Failed
 type PodVolumeBackupPhase string
 
 const (
 	PodVolumeBackupPhaseNew        PodVolumeBackupPhase = "New"
+	PodVolumeBackupPhaseQueued     PodVolumeBackupPhase = "Queued"
 	PodVolumeBackupPhaseInProgress PodVolumeBackupPhase = "InProgress"
 	PodVolumeBackupPhaseCompleted  PodVolumeBackupPhase = "Completed"
 	PodVolumeBackupPhaseFailed     PodVolumeBackupPhase = "Failed"
 )
 
+// PodVolumeType is the type of the data of a backed up pod volume.
+// +kubebuilder:validation:Enum=Filesystem;HostPath;Block
+type PodVolumeType string
+
+const (
+	// PodVolumeTypeFilesystem is a volume mounted into the pod, whose files
+	// are found under the kubelet's pods directory.
+	PodVolumeTypeFilesystem PodVolumeType = "Filesystem"
+
+	// PodVolumeTypeHostPath is a hostPath volume, or a volume claiming a
+	// hostPath persistent volume, whose files are found on the node's
+	// filesystem.
+	PodVolumeTypeHostPath PodVolumeType = "HostPath"
+
+	// PodVolumeTypeBlock is a raw block persistent volume, whose device
+	// contents are backed up as a single file.
+	PodVolumeTypeBlock PodVolumeType = "Block"
+)
+
 // PodVolumeBackupStatus is the current status of a PodVolumeBackup.
 type PodVolumeBackupStatus struct {
 	// Phase is the current state of the PodVolumeBackup.
@@ -67,6 +116,10 @@ type PodVolumeBackupStatus struct {
 	// +optional
 	Path string `json:"path,omitempty"`
 
+	// VolumeType is the type of the backed up volume's data.
+	// +optional
+	VolumeType PodVolumeType `json:"volumeType,omitempty"`
+
 	// SnapshotID is the identifier for the snapshot of the pod volume.
 	// +optional
 	SnapshotID string `json:"snapshotID,omitempty"`
@@ -96,6 +149,12 @@ type PodVolumeBackupStatus struct {
 	// about the backup operation.
 	// +optional
 	Progress PodVolumeOperationProgress `json:"progress,omitempty"`
+
+	// PodVolumeTransfer holds the throttling settings that were applied
+	// to the backup.
+	// +optional
+	// +nullable
+	PodVolumeTransfer *PodVolumeTransferSettings `json:"podVolumeTransfer,omitempty"`
 }
 
 // +genclient
@@ -36,8 +36,37 @@ type PodVolumeRestoreSpec struct {
 	// RepoIdentifier is the restic repository identifier.
 	RepoIdentifier string `json:"repoIdentifier"`
 
+	// RepositoryKeySecret is the name of the secret in the Velero namespace
+	// that holds the restic repository's password. If empty, the key shared
+	// by all repositories is used.
+	// +optional
+	RepositoryKeySecret string `json:"repositoryKeySecret,omitempty"`
+
 	// SnapshotID is the ID of the volume snapshot to be restored.
 	SnapshotID string `json:"snapshotID"`
+
+	// VolumeType is the type of the data of the volume snapshot, as
+	// recorded by its pod volume backup. Defaults to Filesystem.
+	// +optional
+	VolumeType PodVolumeType `json:"volumeType,omitempty"`
+
+	// UploaderType is the type of the uploader used to restore the volume.
+	// It must match the uploader used to back up the volume. Defaults to
+	// "restic".
+	// +optional
+	UploaderType string `json:"uploaderType,omitempty"`
+
+	// PodVolumeTransfer holds the settings used to throttle the transfer,
+	// overriding those of the backup storage location.
+	// +optional
+	// +nullable
+	PodVolumeTransfer *PodVolumeTransferSettings `json:"podVolumeTransfer,omitempty"`
+
+	// IncludedPaths is a list of patterns of the paths within the snapshot
+	// to restore. If empty, the whole snapshot is restored.
+	// +optional
+	// +nullable
+	IncludedPaths []string `json:"includedPaths,omitempty"`
 }
 
 // PodVolumeRestorePhase represents the lifecycle phase of a PodVolumeRestore.
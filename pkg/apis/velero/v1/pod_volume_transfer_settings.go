@@ -0,0 +1,58 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// PodVolumeTransferSettings holds the settings used to throttle the transfer
+// of pod volume data to and from a backup storage location.
+type PodVolumeTransferSettings struct {
+	// UploadLimitKiBps is the maximum upload bandwidth in KiB per second.
+	// A value of 0 means no limit.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	UploadLimitKiBps int `json:"uploadLimitKiBps,omitempty"`
+
+	// DownloadLimitKiBps is the maximum download bandwidth in KiB per second.
+	// A value of 0 means no limit.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	DownloadLimitKiBps int `json:"downloadLimitKiBps,omitempty"`
+
+	// Nice is the CPU scheduling priority of the data transfer, from 0
+	// (normal) to 19 (lowest).
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=19
+	Nice int `json:"nice,omitempty"`
+
+	// IOPriorityClass is the I/O scheduling class of the data transfer.
+	// If empty, the default class is used.
+	// +optional
+	IOPriorityClass IOPriorityClass `json:"ioPriorityClass,omitempty"`
+}
+
+// IOPriorityClass is an I/O scheduling class.
+// +kubebuilder:validation:Enum=BestEffort;Idle
+type IOPriorityClass string
+
+const (
+	// IOPriorityClassBestEffort schedules I/O with the default priority.
+	IOPriorityClassBestEffort IOPriorityClass = "BestEffort"
+
+	// IOPriorityClassIdle schedules I/O only when no other process needs
+	// the disk.
+	IOPriorityClassIdle IOPriorityClass = "Idle"
+)
@@ -56,6 +56,8 @@ func CustomResources() map[string]typeInfo {
 		"BackupStorageLocation":  newTypeInfo("backupstoragelocations", &BackupStorageLocation{}, &BackupStorageLocationList{}),
 		"VolumeSnapshotLocation": newTypeInfo("volumesnapshotlocations", &VolumeSnapshotLocation{}, &VolumeSnapshotLocationList{}),
 		"ServerStatusRequest":    newTypeInfo("serverstatusrequests", &ServerStatusRequest{}, &ServerStatusRequestList{}),
+		"VolumePolicy":           newTypeInfo("volumepolicies", &VolumePolicy{}, &VolumePolicyList{}),
+		"PluginRegistration":     newTypeInfo("pluginregistrations", &PluginRegistration{}, &PluginRegistrationList{}),
 	}
 }
 
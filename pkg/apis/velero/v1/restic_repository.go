@@ -36,6 +36,24 @@ type ResticRepositorySpec struct {
 
 	// MaintenanceFrequency is how often maintenance should be run.
 	MaintenanceFrequency metav1.Duration `json:"maintenanceFrequency"`
+
+	// CheckFrequency is how often the integrity of the repository should be
+	// checked. A value of 0 disables periodic checks.
+	// +optional
+	// +nullable
+	CheckFrequency *metav1.Duration `json:"checkFrequency,omitempty"`
+
+	// CheckReadDataSubset is the subset of the repository's data that is read
+	// and verified during an integrity check, e.g. "5%" or "1/10". If empty,
+	// only the repository's structure is checked.
+	// +optional
+	CheckReadDataSubset string `json:"checkReadDataSubset,omitempty"`
+
+	// RepositoryKeySecret is the name of the secret in the Velero namespace
+	// that holds the repository's password. If empty, the key shared by all
+	// repositories is used.
+	// +optional
+	RepositoryKeySecret string `json:"repositoryKeySecret,omitempty"`
 }
 
 // ResticRepositoryPhase represents the lifecycle phase of a ResticRepository.
@@ -62,6 +80,81 @@ type ResticRepositoryStatus struct {
 	// +optional
 	// +nullable
 	LastMaintenanceTime *metav1.Time `json:"lastMaintenanceTime,omitempty"`
+
+	// LastCheckTime is the last time the repository's integrity was checked.
+	// +optional
+	// +nullable
+	LastCheckTime *metav1.Time `json:"lastCheckTime,omitempty"`
+
+	// LastCheckErrors are the errors found by the last integrity check. It's
+	// empty if the check passed.
+	// +optional
+	// +nullable
+	LastCheckErrors []string `json:"lastCheckErrors,omitempty"`
+
+	// Stats holds statistics about the repository's contents as of the last
+	// integrity check.
+	// +optional
+	// +nullable
+	Stats *ResticRepositoryStats `json:"stats,omitempty"`
+
+	// KeyRotation is the state of the latest rotation of the repository's key.
+	// +optional
+	// +nullable
+	KeyRotation *ResticRepositoryKeyRotation `json:"keyRotation,omitempty"`
+}
+
+// ResticRepositoryKeyRotationPhase represents the lifecycle phase of the
+// rotation of a ResticRepository's key.
+// +kubebuilder:validation:Enum=KeyAdded;Completed;Failed
+type ResticRepositoryKeyRotationPhase string
+
+const (
+	ResticRepositoryKeyRotationPhaseKeyAdded  ResticRepositoryKeyRotationPhase = "KeyAdded"
+	ResticRepositoryKeyRotationPhaseCompleted ResticRepositoryKeyRotationPhase = "Completed"
+	ResticRepositoryKeyRotationPhaseFailed    ResticRepositoryKeyRotationPhase = "Failed"
+)
+
+// ResticRepositoryKeyRotation is the state of the rotation of a ResticRepository's key.
+type ResticRepositoryKeyRotation struct {
+	// ID identifies the rotation. It's the value of the key secret's
+	// rotation annotation.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// Phase is the current state of the rotation.
+	// +optional
+	Phase ResticRepositoryKeyRotationPhase `json:"phase,omitempty"`
+
+	// PreviousKeyID is the ID of the repository's previous key, which is
+	// removed once the new key is in use.
+	// +optional
+	PreviousKeyID string `json:"previousKeyID,omitempty"`
+
+	// NewKeyID is the ID of the new key added to the repository, which is
+	// removed again if the rotation is aborted.
+	// +optional
+	NewKeyID string `json:"newKeyID,omitempty"`
+
+	// Message is a message about the rotation's status.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// CompletionTimestamp records the time the rotation was completed.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+}
+
+// ResticRepositoryStats holds statistics about a restic repository's contents.
+type ResticRepositoryStats struct {
+	// TotalSize is the total size in bytes of the data stored in the repository.
+	// +optional
+	TotalSize int64 `json:"totalSize,omitempty"`
+
+	// SnapshotCount is the number of snapshots in the repository.
+	// +optional
+	SnapshotCount int `json:"snapshotCount,omitempty"`
 }
 
 // +genclient
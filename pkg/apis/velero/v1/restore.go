@@ -92,6 +92,111 @@ type RestoreSpec struct {
 	// Hooks represent custom behaviors that should be executed during or post restore.
 	// +optional
 	Hooks RestoreHooks `json:"hooks,omitempty"`
+
+	// WaitForReadiness, if specified, makes Velero wait for restored items
+	// to become ready before completing the restore. Items that are not
+	// ready by the end of the timeout are recorded as restore warnings.
+	// +optional
+	// +nullable
+	WaitForReadiness *RestoreReadinessSpec `json:"waitForReadiness,omitempty"`
+
+	// PodVolumeRestoreMode specifies how restic data is restored into the
+	// volumes of restored pods. Defaults to InitContainer.
+	// +optional
+	PodVolumeRestoreMode PodVolumeRestoreMode `json:"podVolumeRestoreMode,omitempty"`
+
+	// PodVolumeFileRestore, if specified, makes the restore restore files
+	// from the restic backup of a single pod volume into a persistent volume
+	// claim, instead of restoring any Kubernetes objects.
+	// +optional
+	// +nullable
+	PodVolumeFileRestore *PodVolumeFileRestoreSpec `json:"podVolumeFileRestore,omitempty"`
+
+	// PersistentVolumeMapping, if specified, changes where and how persistent
+	// volumes are created from their snapshots, instead of creating them just
+	// like the snapshotted volumes.
+	// +optional
+	// +nullable
+	PersistentVolumeMapping *PersistentVolumeMappingSpec `json:"persistentVolumeMapping,omitempty"`
+}
+
+// PersistentVolumeMappingSpec defines how the persistent volumes created from
+// snapshots differ from the snapshotted volumes.
+type PersistentVolumeMappingSpec struct {
+	// AvailabilityZones is a map of the availability zones that volumes were
+	// snapshotted in to the availability zones to create them in. Volumes
+	// created in a different zone have their zone labels and node affinity
+	// rewritten to match. Volumes in zones not in the map are created in the
+	// zone they were snapshotted in.
+	// +optional
+	AvailabilityZones map[string]string `json:"availabilityZones,omitempty"`
+
+	// VolumeTypes is a map of the types of the snapshotted volumes to the
+	// types of the volumes to create. Volumes of types not in the map are
+	// created with the type they were snapshotted with.
+	// +optional
+	VolumeTypes map[string]string `json:"volumeTypes,omitempty"`
+
+	// IOPS is a map of the types of the volumes to create to the provisioned
+	// IOPS to create them with, overriding the IOPS of the snapshotted volumes.
+	// +optional
+	IOPS map[string]int64 `json:"iops,omitempty"`
+
+	// FromSnapshotCopies specifies whether volumes are created from the copies
+	// of their snapshots in their volume snapshot location's secondary region
+	// or location, instead of from the snapshots themselves.
+	// +optional
+	// +nullable
+	FromSnapshotCopies *bool `json:"fromSnapshotCopies,omitempty"`
+}
+
+// PodVolumeFileRestoreSpec defines which files of a pod volume backup are
+// restored, and where to.
+type PodVolumeFileRestoreSpec struct {
+	// Namespace is the namespace of the backed up pod.
+	Namespace string `json:"namespace"`
+
+	// Pod is the name of the backed up pod.
+	Pod string `json:"pod"`
+
+	// Volume is the name of the backed up volume within the pod.
+	Volume string `json:"volume"`
+
+	// Paths is a list of patterns of the paths to restore, relative to the
+	// volume's root. If empty, all of the volume's files are restored.
+	// +optional
+	// +nullable
+	Paths []string `json:"paths,omitempty"`
+
+	// TargetPVC is the name of the persistent volume claim that the files
+	// are restored into. It must exist in the pod's namespace, or the
+	// namespace it's mapped to by NamespaceMapping.
+	TargetPVC string `json:"targetPVC"`
+}
+
+// PodVolumeRestoreMode specifies how restic data is restored into pod volumes.
+// +kubebuilder:validation:Enum=InitContainer;HelperPod
+type PodVolumeRestoreMode string
+
+const (
+	// PodVolumeRestoreModeInitContainer injects an init container into
+	// restored pods that waits for their volumes to be restored.
+	PodVolumeRestoreModeInitContainer PodVolumeRestoreMode = "InitContainer"
+
+	// PodVolumeRestoreModeHelperPod restores the data of volumes backed by
+	// persistent volume claims using a temporary helper pod, before the
+	// restored pod is created. Other volumes are restored using an init
+	// container.
+	PodVolumeRestoreModeHelperPod PodVolumeRestoreMode = "HelperPod"
+)
+
+// RestoreReadinessSpec defines how Velero waits for restored items to become ready.
+type RestoreReadinessSpec struct {
+	// Timeout defines the maximum amount of time Velero should wait for all
+	// restored items to become ready. If not specified, a default of 10 minutes
+	// is used.
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
 }
 
 // RestoreHooks contains custom behaviors that should be executed during or post restore.
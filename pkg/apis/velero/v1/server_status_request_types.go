@@ -64,6 +64,27 @@ const (
 type PluginInfo struct {
 	Name string `json:"name"`
 	Kind string `json:"kind"`
+
+	// APIVersion is the version of the plugin API that the plugin implements.
+	// It's empty if the plugin doesn't report its capabilities.
+	// +optional
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// Features are the optional features of the plugin API that the plugin
+	// supports.
+	// +optional
+	// +nullable
+	Features []string `json:"features,omitempty"`
+
+	// Ready is whether the plugin was ready to serve requests when the Velero
+	// server discovered it.
+	// +optional
+	// +nullable
+	Ready *bool `json:"ready,omitempty"`
+
+	// Message explains why the plugin isn't ready.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // ServerStatusRequestStatus is the current status of a ServerStatusRequest.
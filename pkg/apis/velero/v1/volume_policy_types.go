@@ -0,0 +1,127 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=vp
+// +kubebuilder:object:generate=true
+// +kubebuilder:storageversion
+
+// VolumePolicy selects how the volumes of the backups that reference it are
+// backed up, based on the properties of the volumes.
+type VolumePolicy struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec VolumePolicySpec `json:"spec,omitempty"`
+}
+
+// VolumePolicySpec is the specification for a VolumePolicy.
+type VolumePolicySpec struct {
+	// Rules are the volume policy's rules. The action of the first rule
+	// whose conditions a volume matches is applied to the volume. Volumes
+	// that don't match any rule are backed up as if the backup didn't
+	// reference a volume policy.
+	Rules []VolumePolicyRule `json:"rules"`
+}
+
+// VolumePolicyRule is a rule of a VolumePolicy.
+type VolumePolicyRule struct {
+	// Conditions are the conditions a volume must match for the rule's
+	// action to be applied to it.
+	// +optional
+	Conditions VolumePolicyConditions `json:"conditions,omitempty"`
+
+	// Action is how the volumes matching the rule are backed up.
+	Action VolumePolicyAction `json:"action"`
+}
+
+// VolumePolicyConditions are the conditions of a VolumePolicyRule. A volume
+// matches the conditions if it matches all of the specified ones.
+type VolumePolicyConditions struct {
+	// StorageClasses is a list of storage class names, one of which must be
+	// the storage class of the volume's persistent volume claim.
+	// +optional
+	StorageClasses []string `json:"storageClasses,omitempty"`
+
+	// PVCLabelSelector is a label selector that the volume's persistent
+	// volume claim must match.
+	// +optional
+	// +nullable
+	PVCLabelSelector *metav1.LabelSelector `json:"pvcLabelSelector,omitempty"`
+
+	// MinCapacity is the minimum capacity of the volume's persistent volume.
+	// +optional
+	// +nullable
+	MinCapacity *resource.Quantity `json:"minCapacity,omitempty"`
+
+	// MaxCapacity is the maximum capacity of the volume's persistent volume.
+	// +optional
+	// +nullable
+	MaxCapacity *resource.Quantity `json:"maxCapacity,omitempty"`
+
+	// CSIDrivers is a list of CSI driver names, one of which must be the
+	// driver of the volume.
+	// +optional
+	CSIDrivers []string `json:"csiDrivers,omitempty"`
+
+	// VolumeTypes is a list of volume source types, one of which must be the
+	// type of the volume, such as "csi", "nfs", "hostPath" or "emptyDir". The
+	// type of a persistent volume claim is the type of its persistent volume.
+	// +optional
+	VolumeTypes []string `json:"volumeTypes,omitempty"`
+}
+
+// VolumePolicyAction is how the volumes matching a VolumePolicyRule are
+// backed up.
+// +kubebuilder:validation:Enum=Snapshot;FileSystemBackup;Skip
+type VolumePolicyAction string
+
+const (
+	// VolumePolicyActionSnapshot means the volume is backed up by taking a
+	// snapshot, if it's supported for the volume.
+	VolumePolicyActionSnapshot VolumePolicyAction = "Snapshot"
+
+	// VolumePolicyActionFileSystemBackup means the files of the volume are
+	// backed up using restic.
+	VolumePolicyActionFileSystemBackup VolumePolicyAction = "FileSystemBackup"
+
+	// VolumePolicyActionSkip means the volume's data isn't backed up.
+	VolumePolicyActionSkip VolumePolicyAction = "Skip"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// VolumePolicyList is a list of VolumePolicies.
+type VolumePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []VolumePolicy `json:"items"`
+}
@@ -55,6 +55,21 @@ type VolumeSnapshotLocationSpec struct {
 	// Config is for provider-specific configuration fields.
 	// +optional
 	Config map[string]string `json:"config,omitempty"`
+
+	// Copy, if set, has each completed snapshot in this location copied to a
+	// secondary region or location, so that it survives an outage of this one.
+	// +optional
+	// +nullable
+	Copy *VolumeSnapshotCopySpec `json:"copy,omitempty"`
+}
+
+// VolumeSnapshotCopySpec defines the secondary region or location that the
+// snapshots of a VolumeSnapshotLocation are copied to.
+type VolumeSnapshotCopySpec struct {
+	// Config is for provider-specific configuration fields of the secondary
+	// region or location, such as its region. They're applied on top of the
+	// VolumeSnapshotLocation's config.
+	Config map[string]string `json:"config"`
 }
 
 // VolumeSnapshotLocationPhase is the lifecycle phase of a Velero VolumeSnapshotLocation.
@@ -113,6 +113,10 @@ func (in *BackupList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupProgress) DeepCopyInto(out *BackupProgress) {
 	*out = *in
+	if in.VolumeSnapshotUploadStartTimestamp != nil {
+		in, out := &in.VolumeSnapshotUploadStartTimestamp, &out.VolumeSnapshotUploadStartTimestamp
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -126,6 +130,26 @@ func (in *BackupProgress) DeepCopy() *BackupProgress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupReplicaStatus) DeepCopyInto(out *BackupReplicaStatus) {
+	*out = *in
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupReplicaStatus.
+func (in *BackupReplicaStatus) DeepCopy() *BackupReplicaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupReplicaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupResourceHook) DeepCopyInto(out *BackupResourceHook) {
 	*out = *in
@@ -236,6 +260,11 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.GroupVolumeSnapshotsByPod != nil {
+		in, out := &in.GroupVolumeSnapshotsByPod, &out.GroupVolumeSnapshotsByPod
+		*out = new(bool)
+		**out = **in
+	}
 	out.TTL = in.TTL
 	if in.IncludeClusterResources != nil {
 		in, out := &in.IncludeClusterResources, &out.IncludeClusterResources
@@ -243,6 +272,11 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		**out = **in
 	}
 	in.Hooks.DeepCopyInto(&out.Hooks)
+	if in.MirrorStorageLocations != nil {
+		in, out := &in.MirrorStorageLocations, &out.MirrorStorageLocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.VolumeSnapshotLocations != nil {
 		in, out := &in.VolumeSnapshotLocations, &out.VolumeSnapshotLocations
 		*out = make([]string, len(*in))
@@ -260,6 +294,18 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.PodVolumeTransfer != nil {
+		in, out := &in.PodVolumeTransfer, &out.PodVolumeTransfer
+		*out = new(PodVolumeTransferSettings)
+		**out = **in
+	}
+	if in.PodVolumePathFilters != nil {
+		in, out := &in.PodVolumePathFilters, &out.PodVolumePathFilters
+		*out = make([]PodVolumePathFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -296,7 +342,18 @@ func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
 	if in.Progress != nil {
 		in, out := &in.Progress, &out.Progress
 		*out = new(BackupProgress)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = make([]BackupReplicaStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RetainUntil != nil {
+		in, out := &in.RetainUntil, &out.RetainUntil
+		*out = (*in).DeepCopy()
 	}
 	return
 }
@@ -398,6 +455,16 @@ func (in *BackupStorageLocationSpec) DeepCopyInto(out *BackupStorageLocationSpec
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.PodVolumeTransfer != nil {
+		in, out := &in.PodVolumeTransfer, &out.PodVolumeTransfer
+		*out = new(PodVolumeTransferSettings)
+		**out = **in
+	}
+	if in.ObjectLock != nil {
+		in, out := &in.ObjectLock, &out.ObjectLock
+		*out = new(ObjectLockSettings)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -520,6 +587,10 @@ func (in *DeleteBackupRequestStatus) DeepCopyInto(out *DeleteBackupRequestStatus
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.LockedUntil != nil {
+		in, out := &in.LockedUntil, &out.LockedUntil
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -739,6 +810,27 @@ func (in *Metadata) DeepCopy() *Metadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLockSettings) DeepCopyInto(out *ObjectLockSettings) {
+	*out = *in
+	if in.RetentionPeriod != nil {
+		in, out := &in.RetentionPeriod, &out.RetentionPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLockSettings.
+func (in *ObjectLockSettings) DeepCopy() *ObjectLockSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLockSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectStorageLocation) DeepCopyInto(out *ObjectStorageLocation) {
 	*out = *in
@@ -760,9 +852,61 @@ func (in *ObjectStorageLocation) DeepCopy() *ObjectStorageLocation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeMappingSpec) DeepCopyInto(out *PersistentVolumeMappingSpec) {
+	*out = *in
+	if in.AvailabilityZones != nil {
+		in, out := &in.AvailabilityZones, &out.AvailabilityZones
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.VolumeTypes != nil {
+		in, out := &in.VolumeTypes, &out.VolumeTypes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.FromSnapshotCopies != nil {
+		in, out := &in.FromSnapshotCopies, &out.FromSnapshotCopies
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeMappingSpec.
+func (in *PersistentVolumeMappingSpec) DeepCopy() *PersistentVolumeMappingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeMappingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PluginInfo) DeepCopyInto(out *PluginInfo) {
 	*out = *in
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ready != nil {
+		in, out := &in.Ready, &out.Ready
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -776,6 +920,106 @@ func (in *PluginInfo) DeepCopy() *PluginInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginRegistration) DeepCopyInto(out *PluginRegistration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginRegistration.
+func (in *PluginRegistration) DeepCopy() *PluginRegistration {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginRegistration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PluginRegistration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginRegistrationList) DeepCopyInto(out *PluginRegistrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PluginRegistration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginRegistrationList.
+func (in *PluginRegistrationList) DeepCopy() *PluginRegistrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginRegistrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PluginRegistrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginRegistrationSpec) DeepCopyInto(out *PluginRegistrationSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginRegistrationSpec.
+func (in *PluginRegistrationSpec) DeepCopy() *PluginRegistrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginRegistrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginRegistrationStatus) DeepCopyInto(out *PluginRegistrationStatus) {
+	*out = *in
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]PluginInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginRegistrationStatus.
+func (in *PluginRegistrationStatus) DeepCopy() *PluginRegistrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginRegistrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodVolumeBackup) DeepCopyInto(out *PodVolumeBackup) {
 	*out = *in
@@ -848,6 +1092,21 @@ func (in *PodVolumeBackupSpec) DeepCopyInto(out *PodVolumeBackupSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.IncludedPaths != nil {
+		in, out := &in.IncludedPaths, &out.IncludedPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedPaths != nil {
+		in, out := &in.ExcludedPaths, &out.ExcludedPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PodVolumeTransfer != nil {
+		in, out := &in.PodVolumeTransfer, &out.PodVolumeTransfer
+		*out = new(PodVolumeTransferSettings)
+		**out = **in
+	}
 	return
 }
 
@@ -873,6 +1132,11 @@ func (in *PodVolumeBackupStatus) DeepCopyInto(out *PodVolumeBackupStatus) {
 		*out = (*in).DeepCopy()
 	}
 	out.Progress = in.Progress
+	if in.PodVolumeTransfer != nil {
+		in, out := &in.PodVolumeTransfer, &out.PodVolumeTransfer
+		*out = new(PodVolumeTransferSettings)
+		**out = **in
+	}
 	return
 }
 
@@ -886,6 +1150,27 @@ func (in *PodVolumeBackupStatus) DeepCopy() *PodVolumeBackupStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodVolumeFileRestoreSpec) DeepCopyInto(out *PodVolumeFileRestoreSpec) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodVolumeFileRestoreSpec.
+func (in *PodVolumeFileRestoreSpec) DeepCopy() *PodVolumeFileRestoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodVolumeFileRestoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodVolumeOperationProgress) DeepCopyInto(out *PodVolumeOperationProgress) {
 	*out = *in
@@ -902,12 +1187,43 @@ func (in *PodVolumeOperationProgress) DeepCopy() *PodVolumeOperationProgress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodVolumePathFilter) DeepCopyInto(out *PodVolumePathFilter) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IncludedPaths != nil {
+		in, out := &in.IncludedPaths, &out.IncludedPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedPaths != nil {
+		in, out := &in.ExcludedPaths, &out.ExcludedPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodVolumePathFilter.
+func (in *PodVolumePathFilter) DeepCopy() *PodVolumePathFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(PodVolumePathFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodVolumeRestore) DeepCopyInto(out *PodVolumeRestore) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -967,6 +1283,16 @@ func (in *PodVolumeRestoreList) DeepCopyObject() runtime.Object {
 func (in *PodVolumeRestoreSpec) DeepCopyInto(out *PodVolumeRestoreSpec) {
 	*out = *in
 	out.Pod = in.Pod
+	if in.PodVolumeTransfer != nil {
+		in, out := &in.PodVolumeTransfer, &out.PodVolumeTransfer
+		*out = new(PodVolumeTransferSettings)
+		**out = **in
+	}
+	if in.IncludedPaths != nil {
+		in, out := &in.IncludedPaths, &out.IncludedPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1005,12 +1331,28 @@ func (in *PodVolumeRestoreStatus) DeepCopy() *PodVolumeRestoreStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodVolumeTransferSettings) DeepCopyInto(out *PodVolumeTransferSettings) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodVolumeTransferSettings.
+func (in *PodVolumeTransferSettings) DeepCopy() *PodVolumeTransferSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(PodVolumeTransferSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResticRepository) DeepCopyInto(out *ResticRepository) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -1033,6 +1375,26 @@ func (in *ResticRepository) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResticRepositoryKeyRotation) DeepCopyInto(out *ResticRepositoryKeyRotation) {
+	*out = *in
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResticRepositoryKeyRotation.
+func (in *ResticRepositoryKeyRotation) DeepCopy() *ResticRepositoryKeyRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(ResticRepositoryKeyRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResticRepositoryList) DeepCopyInto(out *ResticRepositoryList) {
 	*out = *in
@@ -1070,6 +1432,11 @@ func (in *ResticRepositoryList) DeepCopyObject() runtime.Object {
 func (in *ResticRepositorySpec) DeepCopyInto(out *ResticRepositorySpec) {
 	*out = *in
 	out.MaintenanceFrequency = in.MaintenanceFrequency
+	if in.CheckFrequency != nil {
+		in, out := &in.CheckFrequency, &out.CheckFrequency
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -1083,6 +1450,22 @@ func (in *ResticRepositorySpec) DeepCopy() *ResticRepositorySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResticRepositoryStats) DeepCopyInto(out *ResticRepositoryStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResticRepositoryStats.
+func (in *ResticRepositoryStats) DeepCopy() *ResticRepositoryStats {
+	if in == nil {
+		return nil
+	}
+	out := new(ResticRepositoryStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResticRepositoryStatus) DeepCopyInto(out *ResticRepositoryStatus) {
 	*out = *in
@@ -1090,6 +1473,25 @@ func (in *ResticRepositoryStatus) DeepCopyInto(out *ResticRepositoryStatus) {
 		in, out := &in.LastMaintenanceTime, &out.LastMaintenanceTime
 		*out = (*in).DeepCopy()
 	}
+	if in.LastCheckTime != nil {
+		in, out := &in.LastCheckTime, &out.LastCheckTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastCheckErrors != nil {
+		in, out := &in.LastCheckErrors, &out.LastCheckErrors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Stats != nil {
+		in, out := &in.Stats, &out.Stats
+		*out = new(ResticRepositoryStats)
+		**out = **in
+	}
+	if in.KeyRotation != nil {
+		in, out := &in.KeyRotation, &out.KeyRotation
+		*out = new(ResticRepositoryKeyRotation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1203,6 +1605,23 @@ func (in *RestoreProgress) DeepCopy() *RestoreProgress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreReadinessSpec) DeepCopyInto(out *RestoreReadinessSpec) {
+	*out = *in
+	out.Timeout = in.Timeout
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreReadinessSpec.
+func (in *RestoreReadinessSpec) DeepCopy() *RestoreReadinessSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreReadinessSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RestoreResourceHook) DeepCopyInto(out *RestoreResourceHook) {
 	*out = *in
@@ -1328,6 +1747,21 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 		**out = **in
 	}
 	in.Hooks.DeepCopyInto(&out.Hooks)
+	if in.WaitForReadiness != nil {
+		in, out := &in.WaitForReadiness, &out.WaitForReadiness
+		*out = new(RestoreReadinessSpec)
+		**out = **in
+	}
+	if in.PodVolumeFileRestore != nil {
+		in, out := &in.PodVolumeFileRestore, &out.PodVolumeFileRestore
+		*out = new(PodVolumeFileRestoreSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PersistentVolumeMapping != nil {
+		in, out := &in.PersistentVolumeMapping, &out.PersistentVolumeMapping
+		*out = new(PersistentVolumeMappingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1570,7 +2004,9 @@ func (in *ServerStatusRequestStatus) DeepCopyInto(out *ServerStatusRequestStatus
 	if in.Plugins != nil {
 		in, out := &in.Plugins, &out.Plugins
 		*out = make([]PluginInfo, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -1606,6 +2042,170 @@ func (in *StorageType) DeepCopy() *StorageType {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumePolicy) DeepCopyInto(out *VolumePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumePolicy.
+func (in *VolumePolicy) DeepCopy() *VolumePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumePolicyConditions) DeepCopyInto(out *VolumePolicyConditions) {
+	*out = *in
+	if in.StorageClasses != nil {
+		in, out := &in.StorageClasses, &out.StorageClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PVCLabelSelector != nil {
+		in, out := &in.PVCLabelSelector, &out.PVCLabelSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MinCapacity != nil {
+		in, out := &in.MinCapacity, &out.MinCapacity
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxCapacity != nil {
+		in, out := &in.MaxCapacity, &out.MaxCapacity
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.CSIDrivers != nil {
+		in, out := &in.CSIDrivers, &out.CSIDrivers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VolumeTypes != nil {
+		in, out := &in.VolumeTypes, &out.VolumeTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumePolicyConditions.
+func (in *VolumePolicyConditions) DeepCopy() *VolumePolicyConditions {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumePolicyConditions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumePolicyList) DeepCopyInto(out *VolumePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VolumePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumePolicyList.
+func (in *VolumePolicyList) DeepCopy() *VolumePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumePolicyRule) DeepCopyInto(out *VolumePolicyRule) {
+	*out = *in
+	in.Conditions.DeepCopyInto(&out.Conditions)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumePolicyRule.
+func (in *VolumePolicyRule) DeepCopy() *VolumePolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumePolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumePolicySpec) DeepCopyInto(out *VolumePolicySpec) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]VolumePolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumePolicySpec.
+func (in *VolumePolicySpec) DeepCopy() *VolumePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotCopySpec) DeepCopyInto(out *VolumeSnapshotCopySpec) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotCopySpec.
+func (in *VolumeSnapshotCopySpec) DeepCopy() *VolumeSnapshotCopySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotCopySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeSnapshotLocation) DeepCopyInto(out *VolumeSnapshotLocation) {
 	*out = *in
@@ -1677,6 +2277,11 @@ func (in *VolumeSnapshotLocationSpec) DeepCopyInto(out *VolumeSnapshotLocationSp
 			(*out)[key] = val
 		}
 	}
+	if in.Copy != nil {
+		in, out := &in.Copy, &out.Copy
+		*out = new(VolumeSnapshotCopySpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
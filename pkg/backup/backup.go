@@ -30,6 +30,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -37,6 +38,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 
 	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -47,6 +49,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 )
 
@@ -60,8 +63,8 @@ const BackupFormatVersion = "1.1.0"
 // Backupper performs backups.
 type Backupper interface {
 	// Backup takes a backup using the specification in the velerov1api.Backup and writes backup and log data
-	// to the given writers.
-	Backup(logger logrus.FieldLogger, backup *Request, backupFile io.Writer, actions []velero.BackupItemAction, volumeSnapshotterGetter VolumeSnapshotterGetter) error
+	// to the given writers. Calls to the backup's actions are canceled when ctx is done.
+	Backup(ctx context.Context, logger logrus.FieldLogger, backup *Request, backupFile io.Writer, actions []velero.BackupItemActionV2, volumeSnapshotterGetter VolumeSnapshotterGetter) error
 }
 
 // kubernetesBackupper implements Backupper.
@@ -69,6 +72,8 @@ type kubernetesBackupper struct {
 	backupClient           velerov1client.BackupsGetter
 	dynamicFactory         client.DynamicFactory
 	discoveryHelper        discovery.Helper
+	pvcClient              corev1client.PersistentVolumeClaimsGetter
+	pvClient               corev1client.PersistentVolumesGetter
 	podCommandExecutor     podexec.PodCommandExecutor
 	resticBackupperFactory restic.BackupperFactory
 	resticTimeout          time.Duration
@@ -77,7 +82,7 @@ type kubernetesBackupper struct {
 }
 
 type resolvedAction struct {
-	velero.BackupItemAction
+	velero.BackupItemActionV2
 
 	resourceIncludesExcludes  *collections.IncludesExcludes
 	namespaceIncludesExcludes *collections.IncludesExcludes
@@ -103,6 +108,8 @@ func NewKubernetesBackupper(
 	backupClient velerov1client.BackupsGetter,
 	discoveryHelper discovery.Helper,
 	dynamicFactory client.DynamicFactory,
+	pvcClient corev1client.PersistentVolumeClaimsGetter,
+	pvClient corev1client.PersistentVolumesGetter,
 	podCommandExecutor podexec.PodCommandExecutor,
 	resticBackupperFactory restic.BackupperFactory,
 	resticTimeout time.Duration,
@@ -113,6 +120,8 @@ func NewKubernetesBackupper(
 		backupClient:           backupClient,
 		discoveryHelper:        discoveryHelper,
 		dynamicFactory:         dynamicFactory,
+		pvcClient:              pvcClient,
+		pvClient:               pvClient,
 		podCommandExecutor:     podCommandExecutor,
 		resticBackupperFactory: resticBackupperFactory,
 		resticTimeout:          resticTimeout,
@@ -121,7 +130,7 @@ func NewKubernetesBackupper(
 	}, nil
 }
 
-func resolveActions(actions []velero.BackupItemAction, helper discovery.Helper) ([]resolvedAction, error) {
+func resolveActions(actions []velero.BackupItemActionV2, helper discovery.Helper) ([]resolvedAction, error) {
 	var resolved []resolvedAction
 
 	for _, action := range actions {
@@ -141,7 +150,7 @@ func resolveActions(actions []velero.BackupItemAction, helper discovery.Helper)
 		}
 
 		res := resolvedAction{
-			BackupItemAction:          action,
+			BackupItemActionV2:        action,
 			resourceIncludesExcludes:  resources,
 			namespaceIncludesExcludes: namespaces,
 			selector:                  selector,
@@ -205,7 +214,7 @@ type VolumeSnapshotterGetter interface {
 // a complete backup failure is returned. Errors that constitute partial failures (i.e. failures to
 // back up individual resources that don't prevent the backup from continuing to be processed) are logged
 // to the backup log.
-func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Request, backupFile io.Writer, actions []velero.BackupItemAction, volumeSnapshotterGetter VolumeSnapshotterGetter) error {
+func (kb *kubernetesBackupper) Backup(ctx context.Context, log logrus.FieldLogger, backupRequest *Request, backupFile io.Writer, actions []velero.BackupItemActionV2, volumeSnapshotterGetter VolumeSnapshotterGetter) error {
 	gzippedData := gzip.NewWriter(backupFile)
 	defer gzippedData.Close()
 
@@ -249,12 +258,12 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 		}
 	}
 
-	ctx, cancelFunc := context.WithTimeout(context.Background(), podVolumeTimeout)
+	podVolumeCtx, cancelFunc := context.WithTimeout(ctx, podVolumeTimeout)
 	defer cancelFunc()
 
 	var resticBackupper restic.Backupper
 	if kb.resticBackupperFactory != nil {
-		resticBackupper, err = kb.resticBackupperFactory.NewBackupper(ctx, backupRequest.Backup)
+		resticBackupper, err = kb.resticBackupperFactory.NewBackupper(podVolumeCtx, backupRequest.Backup)
 		if err != nil {
 			return errors.WithStack(err)
 		}
@@ -278,7 +287,10 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 		pageSize:              kb.clientPageSize,
 	}
 
+	_, collectSpan := tracing.Tracer().Start(ctx, "CollectItems")
 	items := collector.getAllItems()
+	collectSpan.SetAttributes(attribute.Int("items", len(items)))
+	collectSpan.End()
 	log.WithField("progress", "").Infof("Collected %d items matching the backup spec from the Kubernetes API (actual number of items backed up may be more or less depending on velero.io/exclude-from-backup annotation, plugins returning additional related items to back up, etc.)", len(items))
 
 	backupRequest.Status.Progress = &velerov1api.BackupProgress{TotalItems: len(items)}
@@ -287,11 +299,16 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 		log.WithError(errors.WithStack((err))).Warn("Got error trying to update backup's status.progress.totalItems")
 	}
 
+	// the items' actions are called within the span of the items' backup
+	itemsCtx, itemsSpan := tracing.Tracer().Start(ctx, "BackUpItems")
 	itemBackupper := &itemBackupper{
+		ctx:                     itemsCtx,
 		backupRequest:           backupRequest,
 		tarWriter:               tw,
 		dynamicFactory:          kb.dynamicFactory,
 		discoveryHelper:         kb.discoveryHelper,
+		pvcClient:               kb.pvcClient,
+		pvClient:                kb.pvClient,
 		resticBackupper:         resticBackupper,
 		resticSnapshotTracker:   newPVCSnapshotTracker(),
 		volumeSnapshotterGetter: volumeSnapshotterGetter,
@@ -410,6 +427,9 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 		}
 	}
 
+	itemsSpan.SetAttributes(attribute.Int("items", len(backupRequest.BackedUpItems)))
+	itemsSpan.End()
+
 	// do a final update on progress since we may have just added some CRDs and may not have updated
 	// for the last few processed items.
 	backupRequest.Status.Progress.TotalItems = len(backupRequest.BackedUpItems)
@@ -41,6 +41,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/velero/internal/volumepolicy"
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/client"
@@ -85,7 +86,7 @@ func TestBackedUpItemsMatchesTarballContents(t *testing.T) {
 		h.addItems(t, resource)
 	}
 
-	h.backupper.Backup(h.log, req, backupFile, nil, nil)
+	h.backupper.Backup(context.Background(), h.log, req, backupFile, nil, nil)
 
 	// go through BackedUpItems after the backup to assemble the list of files we
 	// expect to see in the tarball and compare to see if they match
@@ -141,7 +142,7 @@ func TestBackupProgressIsUpdated(t *testing.T) {
 		h.addItems(t, resource)
 	}
 
-	h.backupper.Backup(h.log, req, backupFile, nil, nil)
+	h.backupper.Backup(context.Background(), h.log, req, backupFile, nil, nil)
 
 	require.NotNil(t, req.Status.Progress)
 	assert.Equal(t, len(req.BackedUpItems), req.Status.Progress.TotalItems)
@@ -742,7 +743,7 @@ func TestBackupResourceFiltering(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			h.backupper.Backup(h.log, req, backupFile, nil, nil)
+			h.backupper.Backup(context.Background(), h.log, req, backupFile, nil, nil)
 
 			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
 		})
@@ -916,7 +917,7 @@ func TestCRDInclusion(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			h.backupper.Backup(h.log, req, backupFile, nil, nil)
+			h.backupper.Backup(context.Background(), h.log, req, backupFile, nil, nil)
 
 			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
 		})
@@ -1008,7 +1009,7 @@ func TestBackupResourceCohabitation(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			h.backupper.Backup(h.log, req, backupFile, nil, nil)
+			h.backupper.Backup(context.Background(), h.log, req, backupFile, nil, nil)
 
 			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
 		})
@@ -1031,7 +1032,7 @@ func TestBackupUsesNewCohabitatingResourcesForEachBackup(t *testing.T) {
 	h.addItems(t, test.Deployments(builder.ForDeployment("ns-1", "deploy-1").Result()))
 	h.addItems(t, test.ExtensionsDeployments(builder.ForDeployment("ns-1", "deploy-1").Result()))
 
-	h.backupper.Backup(h.log, backup1, backup1File, nil, nil)
+	h.backupper.Backup(context.Background(), h.log, backup1, backup1File, nil, nil)
 
 	assertTarballContents(t, backup1File, "metadata/version", "resources/deployments.apps/namespaces/ns-1/deploy-1.json", "resources/deployments.apps/v1-preferredversion/namespaces/ns-1/deploy-1.json")
 
@@ -1041,7 +1042,7 @@ func TestBackupUsesNewCohabitatingResourcesForEachBackup(t *testing.T) {
 	}
 	backup2File := bytes.NewBuffer([]byte{})
 
-	h.backupper.Backup(h.log, backup2, backup2File, nil, nil)
+	h.backupper.Backup(context.Background(), h.log, backup2, backup2File, nil, nil)
 
 	assertTarballContents(t, backup2File, "metadata/version", "resources/deployments.apps/namespaces/ns-1/deploy-1.json", "resources/deployments.apps/v1-preferredversion/namespaces/ns-1/deploy-1.json")
 }
@@ -1093,7 +1094,7 @@ func TestBackupResourceOrdering(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			h.backupper.Backup(h.log, req, backupFile, nil, nil)
+			h.backupper.Backup(context.Background(), h.log, req, backupFile, nil, nil)
 
 			assertTarballOrdering(t, backupFile, "pods", "persistentvolumeclaims", "persistentvolumes")
 		})
@@ -1336,7 +1337,7 @@ func TestBackupActionsRunForCorrectItems(t *testing.T) {
 				actions = append(actions, action)
 			}
 
-			err := h.backupper.Backup(h.log, req, backupFile, actions, nil)
+			err := h.backupper.Backup(context.Background(), h.log, req, backupFile, actionsV2(actions), nil)
 			assert.NoError(t, err)
 
 			for action, want := range tc.actions {
@@ -1409,7 +1410,7 @@ func TestBackupWithInvalidActions(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			assert.Error(t, h.backupper.Backup(h.log, req, backupFile, tc.actions, nil))
+			assert.Error(t, h.backupper.Backup(context.Background(), h.log, req, backupFile, actionsV2(tc.actions), nil))
 		})
 	}
 }
@@ -1540,7 +1541,7 @@ func TestBackupActionModifications(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			err := h.backupper.Backup(h.log, req, backupFile, tc.actions, nil)
+			err := h.backupper.Backup(context.Background(), h.log, req, backupFile, actionsV2(tc.actions), nil)
 			assert.NoError(t, err)
 
 			assertTarballFileContents(t, backupFile, tc.want)
@@ -1792,7 +1793,7 @@ func TestBackupActionAdditionalItems(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			err := h.backupper.Backup(h.log, req, backupFile, tc.actions, nil)
+			err := h.backupper.Backup(context.Background(), h.log, req, backupFile, actionsV2(tc.actions), nil)
 			assert.NoError(t, err)
 
 			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
@@ -2236,7 +2237,7 @@ func TestBackupWithSnapshots(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			err := h.backupper.Backup(h.log, tc.req, backupFile, nil, tc.snapshotterGetter)
+			err := h.backupper.Backup(context.Background(), h.log, tc.req, backupFile, nil, tc.snapshotterGetter)
 			assert.NoError(t, err)
 
 			assert.Equal(t, tc.want, tc.req.VolumeSnapshots)
@@ -2294,7 +2295,7 @@ func TestBackupWithInvalidHooks(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			assert.EqualError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil), tc.want.Error())
+			assert.EqualError(t, h.backupper.Backup(context.Background(), h.log, req, backupFile, nil, nil), tc.want.Error())
 		})
 	}
 }
@@ -2557,7 +2558,7 @@ func TestBackupWithHooks(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
+			require.NoError(t, h.backupper.Backup(context.Background(), h.log, req, backupFile, nil, nil))
 
 			assertTarballContents(t, backupFile, append(tc.wantBackedUp, "metadata/version")...)
 		})
@@ -2682,7 +2683,7 @@ func TestBackupWithRestic(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, tc.snapshotterGetter))
+			require.NoError(t, h.backupper.Backup(context.Background(), h.log, req, backupFile, nil, tc.snapshotterGetter))
 
 			assert.Equal(t, tc.want, req.PodVolumeBackups)
 
@@ -2692,6 +2693,376 @@ func TestBackupWithRestic(t *testing.T) {
 	}
 }
 
+// TestBackupWithVolumePolicy runs backups with a volume policy and ensures that pod volumes
+// and PVs are backed up using restic, snapshotted, or skipped as selected by the policy, and
+// that volumes the policy doesn't select an action for are backed up as they would be without it.
+func TestBackupWithVolumePolicy(t *testing.T) {
+	tests := []struct {
+		name          string
+		backup        *velerov1.Backup
+		rules         []velerov1.VolumePolicyRule
+		pvcs          []*corev1.PersistentVolumeClaim
+		pvs           []*corev1.PersistentVolume
+		pod           *corev1.Pod
+		wantPVBs      []*velerov1.PodVolumeBackup
+		wantSnapshots []string
+	}{
+		{
+			name:   "volumes are backed up using restic or snapshotted based on their storage class",
+			backup: defaultBackup().Result(),
+			rules: []velerov1.VolumePolicyRule{
+				{
+					Conditions: velerov1.VolumePolicyConditions{StorageClasses: []string{"nfs"}},
+					Action:     velerov1.VolumePolicyActionFileSystemBackup,
+				},
+				{
+					Conditions: velerov1.VolumePolicyConditions{StorageClasses: []string{"gp2"}},
+					Action:     velerov1.VolumePolicyActionSnapshot,
+				},
+			},
+			pvcs: []*corev1.PersistentVolumeClaim{
+				builder.ForPersistentVolumeClaim("ns-1", "pvc-1").StorageClass("gp2").VolumeName("pv-1").Result(),
+				builder.ForPersistentVolumeClaim("ns-1", "pvc-2").StorageClass("nfs").VolumeName("pv-2").Result(),
+			},
+			pvs: []*corev1.PersistentVolume{
+				builder.ForPersistentVolume("pv-1").ClaimRef("ns-1", "pvc-1").StorageClass("gp2").Result(),
+				builder.ForPersistentVolume("pv-2").ClaimRef("ns-1", "pvc-2").StorageClass("nfs").Result(),
+			},
+			pod: builder.ForPod("ns-1", "pod-1").
+				ObjectMeta(builder.WithAnnotations("backup.velero.io/backup-volumes", "vol-1")).
+				Volumes(
+					builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result(),
+					builder.ForVolume("vol-2").PersistentVolumeClaimSource("pvc-2").Result(),
+				).
+				Result(),
+			wantPVBs: []*velerov1.PodVolumeBackup{
+				builder.ForPodVolumeBackup("velero", "pvb-ns-1-pod-1-vol-2").Result(),
+			},
+			wantSnapshots: []string{"pv-1"},
+		},
+		{
+			name:   "skipped volumes are neither backed up using restic nor snapshotted",
+			backup: defaultBackup().DefaultVolumesToRestic(true).Result(),
+			rules: []velerov1.VolumePolicyRule{
+				{
+					Conditions: velerov1.VolumePolicyConditions{VolumeTypes: []string{"emptyDir"}},
+					Action:     velerov1.VolumePolicyActionSkip,
+				},
+				{
+					Conditions: velerov1.VolumePolicyConditions{PVCLabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "scratch"}}},
+					Action:     velerov1.VolumePolicyActionSkip,
+				},
+			},
+			pvcs: []*corev1.PersistentVolumeClaim{
+				builder.ForPersistentVolumeClaim("ns-1", "pvc-1").ObjectMeta(builder.WithLabels("tier", "scratch")).VolumeName("pv-1").Result(),
+			},
+			pvs: []*corev1.PersistentVolume{
+				builder.ForPersistentVolume("pv-1").ClaimRef("ns-1", "pvc-1").Result(),
+				builder.ForPersistentVolume("pv-2").Result(),
+			},
+			pod: builder.ForPod("ns-1", "pod-1").
+				Volumes(
+					builder.ForVolume("cache").EmptyDirSource().Result(),
+					builder.ForVolume("scratch").PersistentVolumeClaimSource("pvc-1").Result(),
+					builder.ForVolume("data").CSISource("csi.example.com").Result(),
+				).
+				Result(),
+			wantPVBs: []*velerov1.PodVolumeBackup{
+				builder.ForPodVolumeBackup("velero", "pvb-ns-1-pod-1-data").Result(),
+			},
+			wantSnapshots: []string{"pv-2"},
+		},
+		{
+			name:   "volumes whose data comes from the cluster's state aren't backed up using restic when the policy selects them",
+			backup: defaultBackup().Result(),
+			rules: []velerov1.VolumePolicyRule{
+				{
+					Conditions: velerov1.VolumePolicyConditions{VolumeTypes: []string{"emptyDir", "secret", "configMap", "projected", "downwardAPI"}},
+					Action:     velerov1.VolumePolicyActionFileSystemBackup,
+				},
+			},
+			pod: builder.ForPod("ns-1", "pod-1").
+				Volumes(
+					builder.ForVolume("cache").EmptyDirSource().Result(),
+					&corev1.Volume{Name: "creds", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "secret-1"}}},
+					&corev1.Volume{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{}}},
+					&corev1.Volume{Name: "kube-api-access", VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{}}},
+					&corev1.Volume{Name: "podinfo", VolumeSource: corev1.VolumeSource{DownwardAPI: &corev1.DownwardAPIVolumeSource{}}},
+				).
+				Result(),
+			wantPVBs: []*velerov1.PodVolumeBackup{
+				builder.ForPodVolumeBackup("velero", "pvb-ns-1-pod-1-cache").Result(),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h          = newHarness(t)
+				backupFile = bytes.NewBuffer([]byte{})
+			)
+
+			policy, err := volumepolicy.New(builder.ForVolumePolicy("velero", "policy-1").Rules(tc.rules...).Result())
+			require.NoError(t, err)
+
+			req := &Request{
+				Backup:            tc.backup,
+				SnapshotLocations: []*velerov1.VolumeSnapshotLocation{newSnapshotLocation("velero", "default", "default")},
+				VolumePolicy:      policy,
+			}
+
+			h.backupper.resticBackupperFactory = new(fakeResticBackupperFactory)
+
+			// the volume policy is evaluated against PVCs and PVs retrieved using the
+			// typed client, so add them to it as well as to the dynamic client.
+			var pvcs, pvs []metav1.Object
+			for _, pvc := range tc.pvcs {
+				_, err := h.KubeClient.CoreV1().PersistentVolumeClaims(pvc.Namespace).Create(context.TODO(), pvc, metav1.CreateOptions{})
+				require.NoError(t, err)
+				pvcs = append(pvcs, pvc)
+			}
+
+			snapshotter := new(fakeVolumeSnapshotter)
+			for _, pv := range tc.pvs {
+				_, err := h.KubeClient.CoreV1().PersistentVolumes().Create(context.TODO(), pv, metav1.CreateOptions{})
+				require.NoError(t, err)
+				pvs = append(pvs, pv)
+				snapshotter.WithVolume(pv.Name, "vol-"+pv.Name, "", "type-1", 100, false)
+			}
+
+			h.addItems(t, test.Pods(tc.pod))
+			h.addItems(t, test.PVCs(pvcs...))
+			h.addItems(t, test.PVs(pvs...))
+
+			require.NoError(t, h.backupper.Backup(context.Background(), h.log, req, backupFile, nil, volumeSnapshotterGetter{"default": snapshotter}))
+
+			assert.Equal(t, tc.wantPVBs, req.PodVolumeBackups)
+
+			var snapshots []string
+			for _, snapshot := range req.VolumeSnapshots {
+				snapshots = append(snapshots, snapshot.Spec.PersistentVolumeName)
+			}
+			assert.Equal(t, tc.wantSnapshots, snapshots)
+		})
+	}
+}
+
+// fakeGroupVolumeSnapshotter is a fakeVolumeSnapshotter that also implements the
+// velero.GroupVolumeSnapshotter interface, and records the volume IDs of each of the
+// group snapshots it takes.
+type fakeGroupVolumeSnapshotter struct {
+	*fakeVolumeSnapshotter
+
+	notSupported bool
+	groups       [][]string
+}
+
+// CreateGroupSnapshot returns a snapshotID of "<volumeID>-group-snapshot" for each of the
+// volumes, or velero.ErrGroupSnapshotNotSupported if notSupported is true.
+func (vs *fakeGroupVolumeSnapshotter) CreateGroupSnapshot(volumeAZs map[string]string, tags map[string]string) (map[string]string, error) {
+	if vs.notSupported {
+		return nil, velero.ErrGroupSnapshotNotSupported
+	}
+
+	var volumeIDs []string
+	snapshotIDs := make(map[string]string)
+	for volumeID := range volumeAZs {
+		volumeIDs = append(volumeIDs, volumeID)
+		snapshotIDs[volumeID] = volumeID + "-group-snapshot"
+	}
+	sort.Strings(volumeIDs)
+	vs.groups = append(vs.groups, volumeIDs)
+
+	return snapshotIDs, nil
+}
+
+// TestBackupWithGroupSnapshots runs backups of persistent volumes that are part of volume
+// snapshot groups, and ensures that the volumes of each group are snapshotted together if
+// the volume snapshotter supports it, and individually otherwise.
+func TestBackupWithGroupSnapshots(t *testing.T) {
+	pvcs := func(groupLabels ...string) []*corev1.PersistentVolumeClaim {
+		var pvcs []*corev1.PersistentVolumeClaim
+		for i, group := range groupLabels {
+			pvc := builder.ForPersistentVolumeClaim("ns-1", fmt.Sprintf("pvc-%d", i+1)).VolumeName(fmt.Sprintf("pv-%d", i+1))
+			if group != "" {
+				pvc.ObjectMeta(builder.WithLabels(velerov1.VolumeSnapshotGroupLabel, group))
+			}
+			pvcs = append(pvcs, pvc.Result())
+		}
+		return pvcs
+	}
+
+	pod := builder.ForPod("ns-1", "pod-1").
+		Volumes(
+			builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result(),
+			builder.ForVolume("vol-2").PersistentVolumeClaimSource("pvc-2").Result(),
+		).
+		Result()
+
+	tests := []struct {
+		name          string
+		backup        *velerov1.Backup
+		pvcs          []*corev1.PersistentVolumeClaim
+		pod           *corev1.Pod
+		notSupported  bool
+		noGroupImpl   bool
+		wantGroups    [][]string
+		wantSnapshots map[string]string
+	}{
+		{
+			name:       "volumes of labeled PVCs are snapshotted as a group",
+			backup:     defaultBackup().Result(),
+			pvcs:       pvcs("db", "db", ""),
+			wantGroups: [][]string{{"vol-pv-1", "vol-pv-2"}},
+			wantSnapshots: map[string]string{
+				"pv-1": "vol-pv-1-group-snapshot",
+				"pv-2": "vol-pv-2-group-snapshot",
+				"pv-3": "vol-pv-3-snapshot",
+			},
+		},
+		{
+			name:       "volumes of PVCs with different labels are snapshotted as separate groups",
+			backup:     defaultBackup().Result(),
+			pvcs:       pvcs("db", "db", "logs", "logs"),
+			wantGroups: [][]string{{"vol-pv-1", "vol-pv-2"}, {"vol-pv-3", "vol-pv-4"}},
+			wantSnapshots: map[string]string{
+				"pv-1": "vol-pv-1-group-snapshot",
+				"pv-2": "vol-pv-2-group-snapshot",
+				"pv-3": "vol-pv-3-group-snapshot",
+				"pv-4": "vol-pv-4-group-snapshot",
+			},
+		},
+		{
+			name:       "volumes of a pod are snapshotted as a group when the backup groups snapshots by pod",
+			backup:     defaultBackup().GroupVolumeSnapshotsByPod(true).Result(),
+			pvcs:       pvcs("", ""),
+			pod:        pod,
+			wantGroups: [][]string{{"vol-pv-1", "vol-pv-2"}},
+			wantSnapshots: map[string]string{
+				"pv-1": "vol-pv-1-group-snapshot",
+				"pv-2": "vol-pv-2-group-snapshot",
+			},
+		},
+		{
+			name:   "volumes of a pod are snapshotted individually when the backup doesn't group snapshots by pod",
+			backup: defaultBackup().Result(),
+			pvcs:   pvcs("", ""),
+			pod:    pod,
+			wantSnapshots: map[string]string{
+				"pv-1": "vol-pv-1-snapshot",
+				"pv-2": "vol-pv-2-snapshot",
+			},
+		},
+		{
+			name:   "PVCs of a group that don't match the backup's label selector aren't snapshotted with the group",
+			backup: defaultBackup().LabelSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}).Result(),
+			pvcs: []*corev1.PersistentVolumeClaim{
+				builder.ForPersistentVolumeClaim("ns-1", "pvc-1").ObjectMeta(builder.WithLabels(velerov1.VolumeSnapshotGroupLabel, "db", "app", "db")).VolumeName("pv-1").Result(),
+				builder.ForPersistentVolumeClaim("ns-1", "pvc-2").ObjectMeta(builder.WithLabels(velerov1.VolumeSnapshotGroupLabel, "db")).VolumeName("pv-2").Result(),
+			},
+			wantSnapshots: map[string]string{
+				"pv-1": "vol-pv-1-snapshot",
+			},
+		},
+		{
+			name:   "PVCs of a group that are excluded from the backup aren't snapshotted with the group",
+			backup: defaultBackup().Result(),
+			pvcs: []*corev1.PersistentVolumeClaim{
+				builder.ForPersistentVolumeClaim("ns-1", "pvc-1").ObjectMeta(builder.WithLabels(velerov1.VolumeSnapshotGroupLabel, "db")).VolumeName("pv-1").Result(),
+				builder.ForPersistentVolumeClaim("ns-1", "pvc-2").ObjectMeta(builder.WithLabels(velerov1.VolumeSnapshotGroupLabel, "db")).VolumeName("pv-2").Result(),
+				builder.ForPersistentVolumeClaim("ns-1", "pvc-3").ObjectMeta(builder.WithLabels(velerov1.VolumeSnapshotGroupLabel, "db", "velero.io/exclude-from-backup", "true")).VolumeName("pv-3").Result(),
+			},
+			wantGroups: [][]string{{"vol-pv-1", "vol-pv-2"}},
+			wantSnapshots: map[string]string{
+				"pv-1": "vol-pv-1-group-snapshot",
+				"pv-2": "vol-pv-2-group-snapshot",
+				"pv-3": "vol-pv-3-snapshot",
+			},
+		},
+		{
+			name:         "volumes of a group are snapshotted individually when the volume snapshotter doesn't support group snapshots",
+			backup:       defaultBackup().Result(),
+			pvcs:         pvcs("db", "db"),
+			notSupported: true,
+			wantSnapshots: map[string]string{
+				"pv-1": "vol-pv-1-snapshot",
+				"pv-2": "vol-pv-2-snapshot",
+			},
+		},
+		{
+			name:        "volumes of a group are snapshotted individually when the volume snapshotter doesn't implement group snapshots",
+			backup:      defaultBackup().Result(),
+			pvcs:        pvcs("db", "db"),
+			noGroupImpl: true,
+			wantSnapshots: map[string]string{
+				"pv-1": "vol-pv-1-snapshot",
+				"pv-2": "vol-pv-2-snapshot",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h          = newHarness(t)
+				backupFile = bytes.NewBuffer([]byte{})
+			)
+
+			req := &Request{
+				Backup:            tc.backup,
+				SnapshotLocations: []*velerov1.VolumeSnapshotLocation{newSnapshotLocation("velero", "default", "default")},
+			}
+
+			// volume snapshot groups are resolved using the typed client, so add the
+			// PVCs and PVs to it as well as to the dynamic client.
+			var pvcs, pvs []metav1.Object
+			snapshotter := &fakeGroupVolumeSnapshotter{
+				fakeVolumeSnapshotter: new(fakeVolumeSnapshotter),
+				notSupported:          tc.notSupported,
+			}
+			for _, pvc := range tc.pvcs {
+				_, err := h.KubeClient.CoreV1().PersistentVolumeClaims(pvc.Namespace).Create(context.TODO(), pvc, metav1.CreateOptions{})
+				require.NoError(t, err)
+				pvcs = append(pvcs, pvc)
+
+				// PVs have their PVC's app label, so that they match the same label selectors.
+				pvBuilder := builder.ForPersistentVolume(pvc.Spec.VolumeName).ClaimRef(pvc.Namespace, pvc.Name)
+				if app, ok := pvc.Labels["app"]; ok {
+					pvBuilder.ObjectMeta(builder.WithLabels("app", app))
+				}
+				pv := pvBuilder.Result()
+				_, err = h.KubeClient.CoreV1().PersistentVolumes().Create(context.TODO(), pv, metav1.CreateOptions{})
+				require.NoError(t, err)
+				pvs = append(pvs, pv)
+				snapshotter.WithVolume(pv.Name, "vol-"+pv.Name, "", "type-1", 100, false)
+			}
+
+			if tc.pod != nil {
+				h.addItems(t, test.Pods(tc.pod))
+			}
+			h.addItems(t, test.PVCs(pvcs...))
+			h.addItems(t, test.PVs(pvs...))
+
+			var snapshotterGetter volumeSnapshotterGetter = map[string]velero.VolumeSnapshotter{"default": snapshotter}
+			if tc.noGroupImpl {
+				snapshotterGetter["default"] = snapshotter.fakeVolumeSnapshotter
+			}
+
+			require.NoError(t, h.backupper.Backup(context.Background(), h.log, req, backupFile, nil, snapshotterGetter))
+
+			assert.Equal(t, tc.wantGroups, snapshotter.groups)
+
+			snapshots := make(map[string]string)
+			for _, snapshot := range req.VolumeSnapshots {
+				assert.Equal(t, volume.SnapshotPhaseCompleted, snapshot.Status.Phase)
+				snapshots[snapshot.Spec.PersistentVolumeName] = snapshot.Status.ProviderSnapshotID
+			}
+			assert.Equal(t, tc.wantSnapshots, snapshots)
+		})
+	}
+}
+
 // pluggableAction is a backup item action that can be plugged with an Execute
 // function body at runtime.
 type pluggableAction struct {
@@ -2711,6 +3082,16 @@ func (a *pluggableAction) AppliesTo() (velero.ResourceSelector, error) {
 	return a.selector, nil
 }
 
+// actionsV2 lifts actions into BackupItemActionV2s, as the plugin manager does for
+// version 1 backup item action plugins.
+func actionsV2(actions []velero.BackupItemAction) []velero.BackupItemActionV2 {
+	var res []velero.BackupItemActionV2
+	for _, action := range actions {
+		res = append(res, velero.BackupItemActionV1ToV2(action))
+	}
+	return res
+}
+
 type harness struct {
 	*test.APIServer
 	backupper *kubernetesBackupper
@@ -2753,6 +3134,8 @@ func newHarness(t *testing.T) *harness {
 			backupClient:    apiServer.VeleroClient.VeleroV1(),
 			dynamicFactory:  client.NewDynamicFactory(apiServer.DynamicClient),
 			discoveryHelper: discoveryHelper,
+			pvcClient:       apiServer.KubeClient.CoreV1(),
+			pvClient:        apiServer.KubeClient.CoreV1(),
 
 			// unsupported
 			podCommandExecutor:     nil,
@@ -18,6 +18,7 @@ package backup
 
 import (
 	"archive/tar"
+	"context"
 	"encoding/json"
 	"fmt"
 	"path/filepath"
@@ -33,8 +34,11 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 
 	"github.com/vmware-tanzu/velero/internal/hook"
+	"github.com/vmware-tanzu/velero/internal/volumepolicy"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
@@ -47,16 +51,27 @@ import (
 
 // itemBackupper can back up individual items to a tar writer.
 type itemBackupper struct {
+	// ctx is the context of the backup, which the calls to its actions use.
+	ctx                     context.Context
 	backupRequest           *Request
 	tarWriter               tarWriter
 	dynamicFactory          client.DynamicFactory
 	discoveryHelper         discovery.Helper
+	pvcClient               corev1client.PersistentVolumeClaimsGetter
+	pvClient                corev1client.PersistentVolumesGetter
 	resticBackupper         restic.Backupper
 	resticSnapshotTracker   *pvcSnapshotTracker
 	volumeSnapshotterGetter VolumeSnapshotterGetter
 
 	itemHookHandler                    hook.ItemHookHandler
 	snapshotLocationVolumeSnapshotters map[string]velero.VolumeSnapshotter
+
+	// podVolumeSnapshotGroups maps the namespace/name of the persistent volume
+	// claims used by the backed up pods to the volume snapshot group of the pod.
+	podVolumeSnapshotGroups map[string]*volumeSnapshotGroup
+	// groupSnapshottedPVs are the names of the persistent volumes that were
+	// handled as members of a volume snapshot group.
+	groupSnapshottedPVs sets.String
 }
 
 // backupItem backs up an individual item to tarWriter. The item may be excluded based on the
@@ -139,10 +154,11 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 			// nil it on error since it's not valid
 			pod = nil
 		} else {
-			// Get the list of volumes to back up using restic from the pod's annotations. Remove from this list
-			// any volumes that use a PVC that we've already backed up (this would be in a read-write-many scenario,
-			// where it's been backed up from another pod), since we don't need >1 backup per PVC.
-			for _, volume := range restic.GetPodVolumesUsingRestic(pod, boolptr.IsSetToTrue(ib.backupRequest.Spec.DefaultVolumesToRestic)) {
+			// Get the list of volumes to back up using restic from the backup's volume policy and the pod's
+			// annotations. Remove from this list any volumes that use a PVC that we've already backed up (this
+			// would be in a read-write-many scenario, where it's been backed up from another pod), since we
+			// don't need >1 backup per PVC.
+			for _, volume := range ib.getResticVolumesToBackup(pod, log) {
 				if found, pvcName := ib.resticSnapshotTracker.HasPVCForPodVolume(pod, volume); found {
 					log.WithFields(map[string]interface{}{
 						"podVolume": volume,
@@ -158,6 +174,10 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 			// via an item action in the next step, we don't snapshot PVs that will have their data backed up
 			// with restic.
 			ib.resticSnapshotTracker.Track(pod, resticVolumesToBackup)
+
+			if boolptr.IsSetToTrue(ib.backupRequest.Spec.GroupVolumeSnapshotsByPod) {
+				ib.trackPodVolumeSnapshotGroup(pod, resticVolumesToBackup)
+			}
 		}
 	}
 
@@ -326,7 +346,7 @@ func (ib *itemBackupper) executeActions(
 
 		log.Info("Executing custom action")
 
-		updatedItem, additionalItemIdentifiers, err := action.Execute(obj, ib.backupRequest.Backup)
+		updatedItem, additionalItemIdentifiers, err := action.Execute(ib.ctx, obj, ib.backupRequest.Backup)
 		if err != nil {
 			return nil, errors.Wrapf(err, "error executing custom action (groupResource=%s, namespace=%s, name=%s)", groupResource.String(), namespace, name)
 		}
@@ -416,12 +436,58 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 
 	log = log.WithField("persistentVolume", pv.Name)
 
+	if ib.groupSnapshottedPVs.Has(pv.Name) {
+		log.Info("Skipping snapshot of persistent volume because it was snapshotted with its volume snapshot group.")
+		return nil
+	}
+
+	var errs []error
+	if group := ib.getVolumeSnapshotGroup(pv, log); group != nil {
+		if err := ib.takeGroupSnapshot(group, log); err != nil {
+			errs = append(errs, err)
+		}
+
+		if ib.groupSnapshottedPVs.Has(pv.Name) {
+			return kubeerrs.NewAggregate(errs)
+		}
+	}
+
+	snapshot, volumeSnapshotter, err := ib.newVolumeSnapshot(obj, pv, log)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if snapshot != nil {
+		if err := ib.createSnapshot(volumeSnapshotter, snapshot, log.WithField("volumeID", snapshot.Spec.ProviderVolumeID)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// nil errors are automatically removed
+	return kubeerrs.NewAggregate(errs)
+}
+
+// newVolumeSnapshot returns a new snapshot of the volume underlying the given PersistentVolume,
+// along with the volume snapshotter to take it with, or nil if the volume isn't to be, or can't be,
+// snapshotted.
+func (ib *itemBackupper) newVolumeSnapshot(obj runtime.Unstructured, pv *corev1api.PersistentVolume, log logrus.FieldLogger) (*volume.Snapshot, velero.VolumeSnapshotter, error) {
 	// If this PV is claimed, see if we've already taken a (restic) snapshot of the contents
 	// of this PV. If so, don't take a snapshot.
 	if pv.Spec.ClaimRef != nil {
 		if ib.resticSnapshotTracker.Has(pv.Spec.ClaimRef.Namespace, pv.Spec.ClaimRef.Name) {
 			log.Info("Skipping snapshot of persistent volume because volume is being backed up with restic.")
-			return nil
+			return nil, nil, nil
+		}
+	}
+
+	if ib.backupRequest.VolumePolicy != nil {
+		policyVolume := volumepolicy.Volume{PV: pv}
+		if pv.Spec.ClaimRef != nil {
+			policyVolume.PVC = ib.getPVC(pv.Spec.ClaimRef.Namespace, pv.Spec.ClaimRef.Name, log)
+		}
+
+		if action, matched := ib.backupRequest.VolumePolicy.GetAction(policyVolume); matched && action != velerov1api.VolumePolicyActionSnapshot {
+			log.Infof("Skipping snapshot of persistent volume because the backup's volume policy selects the %s action for it.", action)
+			return nil, nil, nil
 		}
 	}
 
@@ -467,27 +533,27 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 
 	if volumeSnapshotter == nil {
 		log.Info("Persistent volume is not a supported volume type for snapshots, skipping.")
-		return nil
+		return nil, nil, nil
 	}
 
 	log = log.WithField("volumeID", volumeID)
 
-	// create tags from the backup's labels
-	tags := map[string]string{}
-	for k, v := range ib.backupRequest.GetLabels() {
-		tags[k] = v
-	}
-	tags["velero.io/backup"] = ib.backupRequest.Name
-	tags["velero.io/pv"] = pv.Name
-
 	log.Info("Getting volume information")
 	volumeType, iops, err := volumeSnapshotter.GetVolumeInfo(volumeID, pvFailureDomainZone)
 	if err != nil {
-		return errors.WithMessage(err, "error getting volume info")
+		return nil, nil, errors.WithMessage(err, "error getting volume info")
 	}
 
+	return volumeSnapshot(ib.backupRequest.Backup, pv.Name, volumeID, volumeType, pvFailureDomainZone, location, iops), volumeSnapshotter, nil
+}
+
+// createSnapshot takes the given snapshot of a single volume, and adds it to the backup's
+// volume snapshots.
+func (ib *itemBackupper) createSnapshot(volumeSnapshotter velero.VolumeSnapshotter, snapshot *volume.Snapshot, log logrus.FieldLogger) error {
+	tags := ib.snapshotTags()
+	tags["velero.io/pv"] = snapshot.Spec.PersistentVolumeName
+
 	log.Info("Snapshotting persistent volume")
-	snapshot := volumeSnapshot(ib.backupRequest.Backup, pv.Name, volumeID, volumeType, pvFailureDomainZone, location, iops)
 
 	var errs []error
 	snapshotID, err := volumeSnapshotter.CreateSnapshot(snapshot.Spec.ProviderVolumeID, snapshot.Spec.VolumeAZ, tags)
@@ -504,6 +570,18 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 	return kubeerrs.NewAggregate(errs)
 }
 
+// snapshotTags returns the tags applied to the backup's volume snapshots, which are
+// created from the backup's labels.
+func (ib *itemBackupper) snapshotTags() map[string]string {
+	tags := map[string]string{}
+	for k, v := range ib.backupRequest.GetLabels() {
+		tags[k] = v
+	}
+	tags["velero.io/backup"] = ib.backupRequest.Name
+
+	return tags
+}
+
 func volumeSnapshot(backup *velerov1api.Backup, volumeName, volumeID, volumeType, az, location string, iops *int64) *volume.Snapshot {
 	return &volume.Snapshot{
 		Spec: volume.SnapshotSpec{
@@ -535,3 +613,76 @@ func resourceVersion(obj runtime.Unstructured) string {
 	gvk := obj.GetObjectKind().GroupVersionKind()
 	return gvk.Version
 }
+
+// getResticVolumesToBackup returns the names of the pod's volumes to back up
+// using restic. Volumes that the backup's volume policy selects an action for
+// are backed up using restic if the action is file-system backup, and other
+// volumes if the pod's annotations or the backup's DefaultVolumesToRestic
+// select them.
+func (ib *itemBackupper) getResticVolumesToBackup(pod *corev1api.Pod, log logrus.FieldLogger) []string {
+	volumes := restic.GetPodVolumesUsingRestic(pod, boolptr.IsSetToTrue(ib.backupRequest.Spec.DefaultVolumesToRestic))
+	if ib.backupRequest.VolumePolicy == nil {
+		return volumes
+	}
+
+	defaultVolumes := sets.NewString(volumes...)
+	// volumes whose data comes from the cluster's state, such as secrets and
+	// projected service account tokens, aren't backed up with restic even if
+	// the policy selects them.
+	eligibleVolumes := sets.NewString(restic.GetPodVolumesUsingRestic(pod, true)...)
+	volumes = nil
+
+	for i := range pod.Spec.Volumes {
+		podVolume := &pod.Spec.Volumes[i]
+
+		policyVolume := volumepolicy.Volume{PodVolume: podVolume}
+		if podVolume.PersistentVolumeClaim != nil {
+			policyVolume.PVC = ib.getPVC(pod.Namespace, podVolume.PersistentVolumeClaim.ClaimName, log)
+			if policyVolume.PVC != nil && policyVolume.PVC.Spec.VolumeName != "" {
+				policyVolume.PV = ib.getPV(policyVolume.PVC.Spec.VolumeName, log)
+			}
+		}
+
+		action, matched := ib.backupRequest.VolumePolicy.GetAction(policyVolume)
+		if !matched {
+			if defaultVolumes.Has(podVolume.Name) {
+				volumes = append(volumes, podVolume.Name)
+			}
+			continue
+		}
+
+		log.WithField("podVolume", podVolume.Name).Infof("Backup's volume policy selects the %s action for pod volume", action)
+		if action != velerov1api.VolumePolicyActionFileSystemBackup {
+			continue
+		}
+		if !eligibleVolumes.Has(podVolume.Name) {
+			log.WithField("podVolume", podVolume.Name).Info("Pod volume can't be backed up with restic, skipping it")
+			continue
+		}
+		volumes = append(volumes, podVolume.Name)
+	}
+
+	return volumes
+}
+
+// getPVC returns the given persistent volume claim, or nil if it can't be
+// retrieved, in which case volume policy conditions on it aren't matched.
+func (ib *itemBackupper) getPVC(namespace, name string, log logrus.FieldLogger) *corev1api.PersistentVolumeClaim {
+	pvc, err := ib.pvcClient.PersistentVolumeClaims(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Warnf("Unable to get persistent volume claim %s/%s to evaluate the backup's volume policy", namespace, name)
+		return nil
+	}
+	return pvc
+}
+
+// getPV returns the given persistent volume, or nil if it can't be
+// retrieved, in which case volume policy conditions on it aren't matched.
+func (ib *itemBackupper) getPV(name string, log logrus.FieldLogger) *corev1api.PersistentVolume {
+	pv, err := ib.pvClient.PersistentVolumes().Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Warnf("Unable to get persistent volume %s to evaluate the backup's volume policy", name)
+		return nil
+	}
+	return pv
+}
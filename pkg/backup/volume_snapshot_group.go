@@ -0,0 +1,308 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+// volumeSnapshotGroup is a set of persistent volume claims in a namespace whose
+// volumes are snapshotted together, as a crash-consistent group.
+type volumeSnapshotGroup struct {
+	name      string
+	namespace string
+	pvcs      []string
+	// labeled is whether the group is made of the claims with the same
+	// velero.io/volume-snapshot-group label value, rather than of the
+	// claims used by a pod.
+	labeled bool
+}
+
+// trackPodVolumeSnapshotGroup records the persistent volume claims used by the pod's
+// volumes that aren't backed up using restic as a volume snapshot group, so that their
+// volumes are snapshotted together when the first of them is backed up. Claims that
+// are already part of another pod's group are left in it.
+func (ib *itemBackupper) trackPodVolumeSnapshotGroup(pod *corev1api.Pod, resticVolumes []string) {
+	group := &volumeSnapshotGroup{
+		name:      pod.Name,
+		namespace: pod.Namespace,
+	}
+
+	restic := sets.NewString(resticVolumes...)
+	for _, podVolume := range pod.Spec.Volumes {
+		if podVolume.PersistentVolumeClaim == nil || restic.Has(podVolume.Name) {
+			continue
+		}
+
+		key := pod.Namespace + "/" + podVolume.PersistentVolumeClaim.ClaimName
+		if _, ok := ib.podVolumeSnapshotGroups[key]; ok {
+			continue
+		}
+		group.pvcs = append(group.pvcs, podVolume.PersistentVolumeClaim.ClaimName)
+	}
+
+	if len(group.pvcs) < 2 {
+		return
+	}
+
+	if ib.podVolumeSnapshotGroups == nil {
+		ib.podVolumeSnapshotGroups = make(map[string]*volumeSnapshotGroup)
+	}
+	for _, pvc := range group.pvcs {
+		ib.podVolumeSnapshotGroups[pod.Namespace+"/"+pvc] = group
+	}
+}
+
+// getVolumeSnapshotGroup returns the volume snapshot group of the given persistent volume's
+// claim, or nil if it isn't part of a group of multiple claims. Claims labeled with
+// velero.io/volume-snapshot-group are grouped with the other claims in their namespace that
+// have the same label value; otherwise, the claims used by the same pod are grouped if the
+// backup groups volume snapshots by pod.
+func (ib *itemBackupper) getVolumeSnapshotGroup(pv *corev1api.PersistentVolume, log logrus.FieldLogger) *volumeSnapshotGroup {
+	if pv.Spec.ClaimRef == nil {
+		return nil
+	}
+	namespace, name := pv.Spec.ClaimRef.Namespace, pv.Spec.ClaimRef.Name
+
+	pvc, err := ib.pvcClient.PersistentVolumeClaims(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Warnf("Unable to get persistent volume claim %s/%s to find its volume snapshot group", namespace, name)
+		return nil
+	}
+
+	groupName := pvc.Labels[velerov1api.VolumeSnapshotGroupLabel]
+	if groupName == "" {
+		return ib.podVolumeSnapshotGroups[namespace+"/"+name]
+	}
+
+	selector := labels.SelectorFromSet(map[string]string{velerov1api.VolumeSnapshotGroupLabel: groupName})
+	pvcs, err := ib.pvcClient.PersistentVolumeClaims(namespace).List(context.TODO(), metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Warnf("Unable to list the persistent volume claims of volume snapshot group %s", groupName)
+		return nil
+	}
+
+	group := &volumeSnapshotGroup{
+		name:      groupName,
+		namespace: namespace,
+		labeled:   true,
+	}
+	for i := range pvcs.Items {
+		if !ib.groupMemberInBackup(group, &pvcs.Items[i]) {
+			log.Debugf("Persistent volume claim %s/%s of volume snapshot group %s isn't included in the backup", namespace, pvcs.Items[i].Name, groupName)
+			continue
+		}
+		group.pvcs = append(group.pvcs, pvcs.Items[i].Name)
+	}
+	if len(group.pvcs) < 2 {
+		return nil
+	}
+
+	return group
+}
+
+// groupMemberInBackup returns whether the persistent volume claim of the volume snapshot
+// group is included in the backup, so that its volume can be snapshotted with the group.
+// Claims that have already been backed up are included. Otherwise, claims used by a pod
+// are included like the pod's other additional items, and claims grouped by their label
+// must also match the backup's label selector.
+func (ib *itemBackupper) groupMemberInBackup(group *volumeSnapshotGroup, pvc *corev1api.PersistentVolumeClaim) bool {
+	key := itemKey{
+		resource:  kuberesource.PersistentVolumeClaims.String(),
+		namespace: pvc.Namespace,
+		name:      pvc.Name,
+	}
+	if _, backedUp := ib.backupRequest.BackedUpItems[key]; backedUp {
+		return true
+	}
+
+	if pvc.Labels["velero.io/exclude-from-backup"] == "true" {
+		return false
+	}
+	if !ib.backupRequest.NamespaceIncludesExcludes.ShouldInclude(pvc.Namespace) {
+		return false
+	}
+	if !ib.backupRequest.ResourceIncludesExcludes.ShouldInclude(kuberesource.PersistentVolumeClaims.String()) {
+		return false
+	}
+
+	if group.labeled && ib.backupRequest.Spec.LabelSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(ib.backupRequest.Spec.LabelSelector)
+		if err != nil || !selector.Matches(labels.Set(pvc.Labels)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// takeGroupSnapshot snapshots the volumes of the persistent volume claims in the group
+// together, using a single group snapshot per volume snapshot location, and adds the
+// snapshots to the backup's volume snapshots. Volumes whose volume snapshotter doesn't
+// support group snapshots are snapshotted individually.
+func (ib *itemBackupper) takeGroupSnapshot(group *volumeSnapshotGroup, log logrus.FieldLogger) error {
+	log = log.WithField("volumeSnapshotGroup", group.name)
+	log.Infof("Snapshotting persistent volumes of volume snapshot group %s/%s", group.namespace, group.name)
+
+	if ib.groupSnapshottedPVs == nil {
+		ib.groupSnapshottedPVs = sets.NewString()
+	}
+
+	var (
+		errs         []error
+		snapshots    = make(map[string][]*volume.Snapshot)
+		snapshotters = make(map[string]velero.VolumeSnapshotter)
+	)
+
+	for _, pvcName := range sets.NewString(group.pvcs...).List() {
+		log := log.WithField("persistentVolumeClaim", group.namespace+"/"+pvcName)
+
+		pvc, err := ib.pvcClient.PersistentVolumeClaims(group.namespace).Get(context.TODO(), pvcName, metav1.GetOptions{})
+		if err != nil {
+			log.WithError(errors.WithStack(err)).Warn("Unable to get persistent volume claim of volume snapshot group, skipping.")
+			continue
+		}
+		if !ib.groupMemberInBackup(group, pvc) {
+			log.Info("Persistent volume claim of volume snapshot group isn't included in the backup, skipping.")
+			continue
+		}
+		if pvc.Spec.VolumeName == "" {
+			log.Info("Persistent volume claim of volume snapshot group isn't bound, skipping.")
+			continue
+		}
+
+		pv, err := ib.pvClient.PersistentVolumes().Get(context.TODO(), pvc.Spec.VolumeName, metav1.GetOptions{})
+		if err != nil {
+			log.WithError(errors.WithStack(err)).Warn("Unable to get persistent volume of volume snapshot group, skipping.")
+			continue
+		}
+		if pv.Labels["velero.io/exclude-from-backup"] == "true" || ib.groupSnapshottedPVs.Has(pv.Name) {
+			continue
+		}
+		ib.groupSnapshottedPVs.Insert(pv.Name)
+
+		log = log.WithField("persistentVolume", pv.Name)
+
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pv)
+		if err != nil {
+			errs = append(errs, errors.WithStack(err))
+			continue
+		}
+		obj := &unstructured.Unstructured{Object: content}
+
+		snapshot, volumeSnapshotter, err := ib.newVolumeSnapshot(obj, pv, log)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if snapshot == nil {
+			continue
+		}
+
+		snapshots[snapshot.Spec.Location] = append(snapshots[snapshot.Spec.Location], snapshot)
+		snapshotters[snapshot.Spec.Location] = volumeSnapshotter
+	}
+
+	locations := make([]string, 0, len(snapshots))
+	for location := range snapshots {
+		locations = append(locations, location)
+	}
+	sort.Strings(locations)
+
+	for _, location := range locations {
+		log := log.WithField("volumeSnapshotLocation", location)
+		if err := ib.createGroupSnapshot(group, snapshotters[location], snapshots[location], log); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// nil errors are automatically removed
+	return kubeerrs.NewAggregate(errs)
+}
+
+// createGroupSnapshot takes the given snapshots of volumes in the same volume snapshot location
+// as a single group snapshot, and adds them to the backup's volume snapshots. If the volume
+// snapshotter doesn't support group snapshots, each of the snapshots is taken individually.
+func (ib *itemBackupper) createGroupSnapshot(group *volumeSnapshotGroup, volumeSnapshotter velero.VolumeSnapshotter, snapshots []*volume.Snapshot, log logrus.FieldLogger) error {
+	var snapshotIDs map[string]string
+	err := velero.ErrGroupSnapshotNotSupported
+	if groupSnapshotter, ok := volumeSnapshotter.(velero.GroupVolumeSnapshotter); ok && len(snapshots) > 1 {
+		tags := ib.snapshotTags()
+		tags[velerov1api.VolumeSnapshotGroupLabel] = group.name
+
+		volumeAZs := make(map[string]string, len(snapshots))
+		for _, snapshot := range snapshots {
+			volumeAZs[snapshot.Spec.ProviderVolumeID] = snapshot.Spec.VolumeAZ
+		}
+
+		log.Info("Taking group snapshot of persistent volumes")
+		snapshotIDs, err = groupSnapshotter.CreateGroupSnapshot(volumeAZs, tags)
+	}
+
+	if err == velero.ErrGroupSnapshotNotSupported {
+		if len(snapshots) > 1 {
+			log.Info("Volume snapshotter doesn't support group snapshots, snapshotting persistent volumes individually")
+		}
+
+		var errs []error
+		for _, snapshot := range snapshots {
+			log := log.WithField("persistentVolume", snapshot.Spec.PersistentVolumeName).WithField("volumeID", snapshot.Spec.ProviderVolumeID)
+			if err := ib.createSnapshot(volumeSnapshotter, snapshot, log); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return kubeerrs.NewAggregate(errs)
+	}
+
+	var errs []error
+	if err != nil {
+		errs = append(errs, errors.Wrapf(err, "error taking group snapshot of volume snapshot group %s/%s", group.namespace, group.name))
+	}
+
+	for _, snapshot := range snapshots {
+		snapshotID, ok := snapshotIDs[snapshot.Spec.ProviderVolumeID]
+		switch {
+		case err != nil:
+			snapshot.Status.Phase = volume.SnapshotPhaseFailed
+		case !ok || snapshotID == "":
+			errs = append(errs, errors.Errorf("no snapshot of volume %s was returned by the group snapshot of volume snapshot group %s/%s", snapshot.Spec.ProviderVolumeID, group.namespace, group.name))
+			snapshot.Status.Phase = volume.SnapshotPhaseFailed
+		default:
+			snapshot.Status.Phase = volume.SnapshotPhaseCompleted
+			snapshot.Status.ProviderSnapshotID = snapshotID
+		}
+		ib.backupRequest.VolumeSnapshots = append(ib.backupRequest.VolumeSnapshots, snapshot)
+	}
+
+	return kubeerrs.NewAggregate(errs)
+}
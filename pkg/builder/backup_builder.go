@@ -168,12 +168,42 @@ func (b *BackupBuilder) SnapshotVolumes(val bool) *BackupBuilder {
 	return b
 }
 
+// GroupVolumeSnapshotsByPod sets the Backup's "GroupVolumeSnapshotsByPod" flag.
+func (b *BackupBuilder) GroupVolumeSnapshotsByPod(val bool) *BackupBuilder {
+	b.object.Spec.GroupVolumeSnapshotsByPod = &val
+	return b
+}
+
 // DefaultVolumesToRestic sets the Backup's "DefaultVolumesToRestic" flag.
 func (b *BackupBuilder) DefaultVolumesToRestic(val bool) *BackupBuilder {
 	b.object.Spec.DefaultVolumesToRestic = &val
 	return b
 }
 
+// UploaderType sets the Backup's uploader type.
+func (b *BackupBuilder) UploaderType(uploaderType string) *BackupBuilder {
+	b.object.Spec.UploaderType = uploaderType
+	return b
+}
+
+// VolumePolicy sets the Backup's volume policy.
+func (b *BackupBuilder) VolumePolicy(name string) *BackupBuilder {
+	b.object.Spec.VolumePolicy = name
+	return b
+}
+
+// PodVolumePathFilters appends to the Backup's pod volume path filters.
+func (b *BackupBuilder) PodVolumePathFilters(filters ...velerov1api.PodVolumePathFilter) *BackupBuilder {
+	b.object.Spec.PodVolumePathFilters = append(b.object.Spec.PodVolumePathFilters, filters...)
+	return b
+}
+
+// PodVolumeTransfer sets the Backup's pod volume transfer settings.
+func (b *BackupBuilder) PodVolumeTransfer(settings *velerov1api.PodVolumeTransferSettings) *BackupBuilder {
+	b.object.Spec.PodVolumeTransfer = settings
+	return b
+}
+
 // Phase sets the Backup's phase.
 func (b *BackupBuilder) Phase(phase velerov1api.BackupPhase) *BackupBuilder {
 	b.object.Status.Phase = phase
@@ -186,6 +216,12 @@ func (b *BackupBuilder) StorageLocation(location string) *BackupBuilder {
 	return b
 }
 
+// MirrorStorageLocations sets the Backup's mirror storage locations.
+func (b *BackupBuilder) MirrorStorageLocations(locations ...string) *BackupBuilder {
+	b.object.Spec.MirrorStorageLocations = locations
+	return b
+}
+
 // VolumeSnapshotLocations sets the Backup's volume snapshot locations.
 func (b *BackupBuilder) VolumeSnapshotLocations(locations ...string) *BackupBuilder {
 	b.object.Spec.VolumeSnapshotLocations = locations
@@ -216,6 +252,24 @@ func (b *BackupBuilder) CompletionTimestamp(val time.Time) *BackupBuilder {
 	return b
 }
 
+// Replicas sets the Backup's replica statuses.
+func (b *BackupBuilder) Replicas(replicas ...velerov1api.BackupReplicaStatus) *BackupBuilder {
+	b.object.Status.Replicas = replicas
+	return b
+}
+
+// RetainUntil sets the time until which the Backup's objects are locked.
+func (b *BackupBuilder) RetainUntil(val time.Time) *BackupBuilder {
+	b.object.Status.RetainUntil = &metav1.Time{Time: val}
+	return b
+}
+
+// LegalHold sets whether a legal hold is placed on the Backup's objects.
+func (b *BackupBuilder) LegalHold(val bool) *BackupBuilder {
+	b.object.Status.LegalHold = val
+	return b
+}
+
 // Hooks sets the Backup's hooks.
 func (b *BackupBuilder) Hooks(hooks velerov1api.BackupHooks) *BackupBuilder {
 	b.object.Spec.Hooks = hooks
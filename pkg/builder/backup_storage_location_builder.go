@@ -66,6 +66,12 @@ func (b *BackupStorageLocationBuilder) Provider(name string) *BackupStorageLocat
 	return b
 }
 
+// Config sets the BackupStorageLocation's config.
+func (b *BackupStorageLocationBuilder) Config(config map[string]string) *BackupStorageLocationBuilder {
+	b.object.Spec.Config = config
+	return b
+}
+
 // Bucket sets the BackupStorageLocation's object storage bucket.
 func (b *BackupStorageLocationBuilder) Bucket(val string) *BackupStorageLocationBuilder {
 	if b.object.Spec.StorageType.ObjectStorage == nil {
@@ -111,6 +117,24 @@ func (b *BackupStorageLocationBuilder) ValidationFrequency(frequency time.Durati
 	return b
 }
 
+// MaxConcurrentPodVolumeBackups sets the BackupStorageLocation's pod volume backup concurrency limit.
+func (b *BackupStorageLocationBuilder) MaxConcurrentPodVolumeBackups(limit int) *BackupStorageLocationBuilder {
+	b.object.Spec.MaxConcurrentPodVolumeBackups = limit
+	return b
+}
+
+// PodVolumeTransfer sets the BackupStorageLocation's pod volume transfer settings.
+func (b *BackupStorageLocationBuilder) PodVolumeTransfer(settings *velerov1api.PodVolumeTransferSettings) *BackupStorageLocationBuilder {
+	b.object.Spec.PodVolumeTransfer = settings
+	return b
+}
+
+// ObjectLock sets the BackupStorageLocation's object lock settings.
+func (b *BackupStorageLocationBuilder) ObjectLock(settings *velerov1api.ObjectLockSettings) *BackupStorageLocationBuilder {
+	b.object.Spec.ObjectLock = settings
+	return b
+}
+
 // LastValidationTime sets the BackupStorageLocation's last validated time.
 func (b *BackupStorageLocationBuilder) LastValidationTime(lastValidated time.Time) *BackupStorageLocationBuilder {
 	b.object.Status.LastValidationTime = &metav1.Time{Time: lastValidated}
@@ -18,6 +18,7 @@ package builder
 
 import (
 	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -94,3 +95,19 @@ func (b *PersistentVolumeBuilder) StorageClass(name string) *PersistentVolumeBui
 	b.object.Spec.StorageClassName = name
 	return b
 }
+
+// HostPath sets the PersistentVolume's hostPath source.
+func (b *PersistentVolumeBuilder) HostPath(path string) *PersistentVolumeBuilder {
+	b.object.Spec.HostPath = &corev1api.HostPathVolumeSource{
+		Path: path,
+	}
+	return b
+}
+
+// Capacity sets the PersistentVolume's storage capacity.
+func (b *PersistentVolumeBuilder) Capacity(capacity string) *PersistentVolumeBuilder {
+	b.object.Spec.Capacity = corev1api.ResourceList{
+		corev1api.ResourceStorage: resource.MustParse(capacity),
+	}
+	return b
+}
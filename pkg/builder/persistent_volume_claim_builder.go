@@ -67,3 +67,9 @@ func (b *PersistentVolumeClaimBuilder) StorageClass(name string) *PersistentVolu
 	b.object.Spec.StorageClassName = &name
 	return b
 }
+
+// VolumeMode sets the PersistentVolumeClaim's volume mode.
+func (b *PersistentVolumeClaimBuilder) VolumeMode(mode corev1api.PersistentVolumeMode) *PersistentVolumeClaimBuilder {
+	b.object.Spec.VolumeMode = &mode
+	return b
+}
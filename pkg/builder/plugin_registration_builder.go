@@ -0,0 +1,82 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// PluginRegistrationBuilder builds PluginRegistration objects.
+type PluginRegistrationBuilder struct {
+	object *velerov1api.PluginRegistration
+}
+
+// ForPluginRegistration is the constructor for a PluginRegistrationBuilder.
+func ForPluginRegistration(ns, name string) *PluginRegistrationBuilder {
+	return &PluginRegistrationBuilder{
+		object: &velerov1api.PluginRegistration{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: velerov1api.SchemeGroupVersion.String(),
+				Kind:       "PluginRegistration",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      name,
+			},
+		},
+	}
+}
+
+// Result returns the built PluginRegistration.
+func (b *PluginRegistrationBuilder) Result() *velerov1api.PluginRegistration {
+	return b.object
+}
+
+// ObjectMeta applies functional options to the PluginRegistration's ObjectMeta.
+func (b *PluginRegistrationBuilder) ObjectMeta(opts ...ObjectMetaOpt) *PluginRegistrationBuilder {
+	for _, opt := range opts {
+		opt(b.object)
+	}
+
+	return b
+}
+
+// Address sets the PluginRegistration's plugin server address.
+func (b *PluginRegistrationBuilder) Address(address string) *PluginRegistrationBuilder {
+	b.object.Spec.Address = address
+	return b
+}
+
+// TLSSecretName sets the name of the PluginRegistration's TLS secret.
+func (b *PluginRegistrationBuilder) TLSSecretName(name string) *PluginRegistrationBuilder {
+	b.object.Spec.TLSSecretName = name
+	return b
+}
+
+// ServerName sets the name that the PluginRegistration's plugin server certificate is verified against.
+func (b *PluginRegistrationBuilder) ServerName(name string) *PluginRegistrationBuilder {
+	b.object.Spec.ServerName = name
+	return b
+}
+
+// Phase sets the PluginRegistration's phase.
+func (b *PluginRegistrationBuilder) Phase(phase velerov1api.PluginRegistrationPhase) *PluginRegistrationBuilder {
+	b.object.Status.Phase = phase
+	return b
+}
@@ -76,6 +76,12 @@ func (b *PodBuilder) NodeName(val string) *PodBuilder {
 	return b
 }
 
+// Phase sets the pod's phase
+func (b *PodBuilder) Phase(phase corev1api.PodPhase) *PodBuilder {
+	b.object.Status.Phase = phase
+	return b
+}
+
 func (b *PodBuilder) InitContainers(containers ...*corev1api.Container) *PodBuilder {
 	for _, c := range containers {
 		b.object.Spec.InitContainers = append(b.object.Spec.InitContainers, *c)
@@ -69,6 +69,12 @@ func (b *PodVolumeBackupBuilder) SnapshotID(snapshotID string) *PodVolumeBackupB
 	return b
 }
 
+// VolumeType sets the PodVolumeBackup's volume type.
+func (b *PodVolumeBackupBuilder) VolumeType(volumeType velerov1api.PodVolumeType) *PodVolumeBackupBuilder {
+	b.object.Status.VolumeType = volumeType
+	return b
+}
+
 // PodName sets the name of the pod associated with this PodVolumeBackup.
 func (b *PodVolumeBackupBuilder) PodName(name string) *PodVolumeBackupBuilder {
 	b.object.Spec.Pod.Name = name
@@ -86,3 +92,15 @@ func (b *PodVolumeBackupBuilder) Volume(volume string) *PodVolumeBackupBuilder {
 	b.object.Spec.Volume = volume
 	return b
 }
+
+// Node sets the name of the node on which this PodVolumeBackup runs.
+func (b *PodVolumeBackupBuilder) Node(node string) *PodVolumeBackupBuilder {
+	b.object.Spec.Node = node
+	return b
+}
+
+// BackupStorageLocation sets the name of the backup storage location for this PodVolumeBackup.
+func (b *PodVolumeBackupBuilder) BackupStorageLocation(location string) *PodVolumeBackupBuilder {
+	b.object.Spec.BackupStorageLocation = location
+	return b
+}
@@ -142,6 +142,32 @@ func (b *RestoreBuilder) PreserveNodePorts(val bool) *RestoreBuilder {
 	return b
 }
 
+// WaitForReadiness sets the Restore's readiness wait timeout.
+func (b *RestoreBuilder) WaitForReadiness(timeout time.Duration) *RestoreBuilder {
+	b.object.Spec.WaitForReadiness = &velerov1api.RestoreReadinessSpec{
+		Timeout: metav1.Duration{Duration: timeout},
+	}
+	return b
+}
+
+// PodVolumeRestoreMode sets the Restore's pod volume restore mode.
+func (b *RestoreBuilder) PodVolumeRestoreMode(mode velerov1api.PodVolumeRestoreMode) *RestoreBuilder {
+	b.object.Spec.PodVolumeRestoreMode = mode
+	return b
+}
+
+// PodVolumeFileRestore sets the Restore's pod volume file restore.
+func (b *RestoreBuilder) PodVolumeFileRestore(fileRestore *velerov1api.PodVolumeFileRestoreSpec) *RestoreBuilder {
+	b.object.Spec.PodVolumeFileRestore = fileRestore
+	return b
+}
+
+// PersistentVolumeMapping sets the Restore's persistent volume mapping.
+func (b *RestoreBuilder) PersistentVolumeMapping(mapping *velerov1api.PersistentVolumeMappingSpec) *RestoreBuilder {
+	b.object.Spec.PersistentVolumeMapping = mapping
+	return b
+}
+
 // StartTimestamp sets the Restore's start timestamp.
 func (b *RestoreBuilder) StartTimestamp(val time.Time) *RestoreBuilder {
 	b.object.Status.StartTimestamp = &metav1.Time{Time: val}
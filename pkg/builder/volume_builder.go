@@ -54,3 +54,9 @@ func (b *VolumeBuilder) CSISource(driver string) *VolumeBuilder {
 	}
 	return b
 }
+
+// EmptyDirSource sets the Volume's emptyDir source.
+func (b *VolumeBuilder) EmptyDirSource() *VolumeBuilder {
+	b.object.EmptyDir = &corev1api.EmptyDirVolumeSource{}
+	return b
+}
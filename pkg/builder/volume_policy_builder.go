@@ -0,0 +1,64 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// VolumePolicyBuilder builds VolumePolicy objects.
+type VolumePolicyBuilder struct {
+	object *velerov1api.VolumePolicy
+}
+
+// ForVolumePolicy is the constructor for a VolumePolicyBuilder.
+func ForVolumePolicy(ns, name string) *VolumePolicyBuilder {
+	return &VolumePolicyBuilder{
+		object: &velerov1api.VolumePolicy{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: velerov1api.SchemeGroupVersion.String(),
+				Kind:       "VolumePolicy",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      name,
+			},
+		},
+	}
+}
+
+// Result returns the built VolumePolicy.
+func (b *VolumePolicyBuilder) Result() *velerov1api.VolumePolicy {
+	return b.object
+}
+
+// ObjectMeta applies functional options to the VolumePolicy's ObjectMeta.
+func (b *VolumePolicyBuilder) ObjectMeta(opts ...ObjectMetaOpt) *VolumePolicyBuilder {
+	for _, opt := range opts {
+		opt(b.object)
+	}
+
+	return b
+}
+
+// Rules appends to the VolumePolicy's rules.
+func (b *VolumePolicyBuilder) Rules(rules ...velerov1api.VolumePolicyRule) *VolumePolicyBuilder {
+	b.object.Spec.Rules = append(b.object.Spec.Rules, rules...)
+	return b
+}
@@ -62,3 +62,16 @@ func (b *VolumeSnapshotLocationBuilder) Provider(name string) *VolumeSnapshotLoc
 	b.object.Spec.Provider = name
 	return b
 }
+
+// Config sets the VolumeSnapshotLocation's config.
+func (b *VolumeSnapshotLocationBuilder) Config(config map[string]string) *VolumeSnapshotLocationBuilder {
+	b.object.Spec.Config = config
+	return b
+}
+
+// CopyConfig sets the config of the secondary region or location that the
+// VolumeSnapshotLocation's snapshots are copied to.
+func (b *VolumeSnapshotLocationBuilder) CopyConfig(config map[string]string) *VolumeSnapshotLocationBuilder {
+	b.object.Spec.Copy = &velerov1api.VolumeSnapshotCopySpec{Config: config}
+	return b
+}
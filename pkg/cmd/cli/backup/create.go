@@ -38,6 +38,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 	veleroclient "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
 	v1 "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 )
 
@@ -87,6 +88,7 @@ type CreateOptions struct {
 	Name                    string
 	TTL                     time.Duration
 	SnapshotVolumes         flag.OptionalBool
+	GroupSnapshotsByPod     flag.OptionalBool
 	DefaultVolumesToRestic  flag.OptionalBool
 	IncludeNamespaces       flag.StringArray
 	ExcludeNamespaces       flag.StringArray
@@ -97,9 +99,13 @@ type CreateOptions struct {
 	IncludeClusterResources flag.OptionalBool
 	Wait                    bool
 	StorageLocation         string
+	MirrorLocations         []string
 	SnapshotLocations       []string
 	FromSchedule            string
 	OrderedResources        string
+	UploaderType            string
+	VolumePolicy            string
+	PodVolumeTransfer       *flag.PodVolumeTransfer
 
 	client veleroclient.Interface
 }
@@ -111,6 +117,7 @@ func NewCreateOptions() *CreateOptions {
 		Labels:                  flag.NewMap(),
 		SnapshotVolumes:         flag.NewOptionalBool(nil),
 		IncludeClusterResources: flag.NewOptionalBool(nil),
+		PodVolumeTransfer:       flag.NewPodVolumeTransfer(),
 	}
 }
 
@@ -122,6 +129,7 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.Var(&o.ExcludeResources, "exclude-resources", "Resources to exclude from the backup, formatted as resource.group, such as storageclasses.storage.k8s.io.")
 	flags.Var(&o.Labels, "labels", "Labels to apply to the backup.")
 	flags.StringVar(&o.StorageLocation, "storage-location", "", "Location in which to store the backup.")
+	flags.StringSliceVar(&o.MirrorLocations, "mirror-storage-locations", o.MirrorLocations, "List of additional backup storage locations to copy the backup to once it completes.")
 	flags.StringSliceVar(&o.SnapshotLocations, "volume-snapshot-locations", o.SnapshotLocations, "List of locations (at most one per provider) where volume snapshots should be stored.")
 	flags.VarP(&o.Selector, "selector", "l", "Only back up resources matching this label selector.")
 	flags.StringVar(&o.OrderedResources, "ordered-resources", "", "Mapping Kinds to an ordered list of specific resources of that Kind.  Resource names are separated by commas and their names are in format 'namespace/resourcename'. For cluster scope resource, simply use resource name. Key-value pairs in the mapping are separated by semi-colon.  Example: 'pods=ns1/pod1,ns1/pod2;persistentvolumeclaims=ns1/pvc4,ns1/pvc8'.  Optional.")
@@ -130,11 +138,18 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	// like a normal bool flag
 	f.NoOptDefVal = "true"
 
+	f = flags.VarPF(&o.GroupSnapshotsByPod, "group-snapshots-by-pod", "", "Snapshot the PersistentVolumes of each pod together, as a crash-consistent group, if the volume snapshotter supports it.")
+	f.NoOptDefVal = "true"
+
 	f = flags.VarPF(&o.IncludeClusterResources, "include-cluster-resources", "", "Include cluster-scoped resources in the backup")
 	f.NoOptDefVal = "true"
 
 	f = flags.VarPF(&o.DefaultVolumesToRestic, "default-volumes-to-restic", "", "Use restic by default to backup all pod volumes")
 	f.NoOptDefVal = "true"
+
+	flags.StringVar(&o.UploaderType, "uploader-type", o.UploaderType, fmt.Sprintf("The type of uploader used to back up pod volume data. Valid values are: %s, %s. Optional.", uploader.ResticType, uploader.KopiaType))
+	flags.StringVar(&o.VolumePolicy, "volume-policy", "", "Name of the volume policy that selects how the backup's volumes are backed up. Optional.")
+	o.PodVolumeTransfer.BindFlags(flags)
 }
 
 // BindWait binds the wait flag separately so it is not called by other create
@@ -169,6 +184,14 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return kubeerrs.NewAggregate(errs)
 	}
 
+	if err := uploader.ValidateUploaderType(o.UploaderType); err != nil {
+		return err
+	}
+
+	if err := o.PodVolumeTransfer.Validate(); err != nil {
+		return err
+	}
+
 	if o.StorageLocation != "" {
 		location := &velerov1api.BackupStorageLocation{}
 		if err := client.Get(context.Background(), kbclient.ObjectKey{
@@ -179,6 +202,26 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		}
 	}
 
+	for _, loc := range o.MirrorLocations {
+		location := &velerov1api.BackupStorageLocation{}
+		if err := client.Get(context.Background(), kbclient.ObjectKey{
+			Namespace: f.Namespace(),
+			Name:      loc,
+		}, location); err != nil {
+			return err
+		}
+	}
+
+	if o.VolumePolicy != "" {
+		volumePolicy := &velerov1api.VolumePolicy{}
+		if err := client.Get(context.Background(), kbclient.ObjectKey{
+			Namespace: f.Namespace(),
+			Name:      o.VolumePolicy,
+		}, volumePolicy); err != nil {
+			return err
+		}
+	}
+
 	for _, loc := range o.SnapshotLocations {
 		if _, err := o.client.VeleroV1().VolumeSnapshotLocations(f.Namespace()).Get(context.TODO(), loc, metav1.GetOptions{}); err != nil {
 			return err
@@ -276,7 +319,9 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 					return nil
 				}
 
-				if backup.Status.Phase != velerov1api.BackupPhaseNew && backup.Status.Phase != velerov1api.BackupPhaseInProgress {
+				switch backup.Status.Phase {
+				case velerov1api.BackupPhaseNew, velerov1api.BackupPhaseInProgress, velerov1api.BackupPhaseUploading, velerov1api.BackupPhaseUploadingPartialFailure:
+				default:
 					fmt.Printf("\nBackup completed with status: %s. You may check for more information using the commands `velero backup describe %s` and `velero backup logs %s`.\n", backup.Status.Phase, backup.Name, backup.Name)
 					return nil
 				}
@@ -335,6 +380,7 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 			LabelSelector(o.Selector.LabelSelector).
 			TTL(o.TTL).
 			StorageLocation(o.StorageLocation).
+			MirrorStorageLocations(o.MirrorLocations...).
 			VolumeSnapshotLocations(o.SnapshotLocations...)
 		if len(o.OrderedResources) > 0 {
 			orders, err := parseOrderedResources(o.OrderedResources)
@@ -347,12 +393,24 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 		if o.SnapshotVolumes.Value != nil {
 			backupBuilder.SnapshotVolumes(*o.SnapshotVolumes.Value)
 		}
+		if o.GroupSnapshotsByPod.Value != nil {
+			backupBuilder.GroupVolumeSnapshotsByPod(*o.GroupSnapshotsByPod.Value)
+		}
 		if o.IncludeClusterResources.Value != nil {
 			backupBuilder.IncludeClusterResources(*o.IncludeClusterResources.Value)
 		}
 		if o.DefaultVolumesToRestic.Value != nil {
 			backupBuilder.DefaultVolumesToRestic(*o.DefaultVolumesToRestic.Value)
 		}
+		if o.UploaderType != "" {
+			backupBuilder.UploaderType(o.UploaderType)
+		}
+		if o.VolumePolicy != "" {
+			backupBuilder.VolumePolicy(o.VolumePolicy)
+		}
+		if settings := o.PodVolumeTransfer.Settings(); settings != nil {
+			backupBuilder.PodVolumeTransfer(settings)
+		}
 	}
 
 	backup := backupBuilder.ObjectMeta(builder.WithLabelsMap(o.Labels.Data())).Result()
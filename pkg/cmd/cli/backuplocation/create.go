@@ -72,6 +72,8 @@ type CreateOptions struct {
 	Labels                                flag.Map
 	CACertFile                            string
 	AccessMode                            *flag.Enum
+	MaxConcurrentPodVolumeBackups         int
+	PodVolumeTransfer                     *flag.PodVolumeTransfer
 }
 
 func NewCreateOptions() *CreateOptions {
@@ -83,6 +85,7 @@ func NewCreateOptions() *CreateOptions {
 			string(velerov1api.BackupStorageLocationAccessModeReadWrite),
 			string(velerov1api.BackupStorageLocationAccessModeReadOnly),
 		),
+		PodVolumeTransfer: flag.NewPodVolumeTransfer(),
 	}
 }
 
@@ -102,6 +105,8 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 		"access-mode",
 		fmt.Sprintf("Access mode for the backup storage location. Valid values are %s", strings.Join(o.AccessMode.AllowedValues(), ",")),
 	)
+	flags.IntVar(&o.MaxConcurrentPodVolumeBackups, "max-concurrent-pod-volume-backups", o.MaxConcurrentPodVolumeBackups, "Maximum number of restic pod volume backups to this location that may run at the same time across all nodes. Optional. Set this to 0 for no limit. Default: 0.")
+	o.PodVolumeTransfer.BindFlags(flags)
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -125,6 +130,14 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--credential can only contain 1 key/value pair")
 	}
 
+	if o.MaxConcurrentPodVolumeBackups < 0 {
+		return errors.New("--max-concurrent-pod-volume-backups must be non-negative")
+	}
+
+	if err := o.PodVolumeTransfer.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -161,9 +174,11 @@ func (o *CreateOptions) BuildBackupStorageLocation(namespace string, setBackupSy
 					CACert: caCertData,
 				},
 			},
-			Config:     o.Config.Data(),
-			Default:    o.DefaultBackupStorageLocation,
-			AccessMode: velerov1api.BackupStorageLocationAccessMode(o.AccessMode.String()),
+			Config:                        o.Config.Data(),
+			Default:                       o.DefaultBackupStorageLocation,
+			AccessMode:                    velerov1api.BackupStorageLocationAccessMode(o.AccessMode.String()),
+			MaxConcurrentPodVolumeBackups: o.MaxConcurrentPodVolumeBackups,
+			PodVolumeTransfer:             o.PodVolumeTransfer.Settings(),
 		},
 	}
 
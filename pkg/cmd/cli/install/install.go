@@ -39,6 +39,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 	velerodiscovery "github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/install"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
@@ -237,6 +238,8 @@ This is useful as a starting point for more customized installations.
 
   # velero install --provider gcp --plugins velero/velero-plugin-for-gcp:v1.0.0 --bucket gcp-backups --secret-file ./gcp-creds.json --restic-pod-cpu-request=1000m --restic-pod-cpu-limit=5000m --restic-pod-mem-request=512Mi --restic-pod-mem-limit=1024Mi
 
+  # velero install --provider velero.io/s3 --bucket backups --secret-file ./minio-creds --backup-location-config s3Url=http://minio.velero.svc:9000,s3ForcePathStyle=true --use-volume-snapshots=false
+
   # velero install --provider azure --plugins velero/velero-plugin-for-microsoft-azure:v1.0.0 --bucket $BLOB_CONTAINER --secret-file ./credentials-velero --backup-location-config resourceGroup=$AZURE_BACKUP_RESOURCE_GROUP,storageAccount=$AZURE_STORAGE_ACCOUNT_ID[,subscriptionId=$AZURE_BACKUP_SUBSCRIPTION_ID] --snapshot-location-config apiTimeout=<YOUR_TIMEOUT>[,resourceGroup=$AZURE_BACKUP_RESOURCE_GROUP,subscriptionId=$AZURE_BACKUP_SUBSCRIPTION_ID]`,
 		Run: func(c *cobra.Command, args []string) {
 			cmd.CheckError(o.Validate(c, args, f))
@@ -404,7 +407,9 @@ func (o *InstallOptions) Validate(c *cobra.Command, args []string, f client.Fact
 			return errors.New("--provider must be empty when using --no-default-backup-location and --use-volume-snapshots=false")
 		}
 	} else {
-		if len(o.Plugins) == 0 {
+		// the object stores built into velero don't need a plugin, as long as
+		// there's no volume snapshotter
+		if len(o.Plugins) == 0 && (o.UseVolumeSnapshots || !isBuiltInObjectStore(o.ProviderName)) {
 			return errors.New("--plugins flag is required")
 		}
 	}
@@ -426,3 +431,13 @@ func (o *InstallOptions) Validate(c *cobra.Command, args []string, f client.Fact
 
 	return nil
 }
+
+// isBuiltInObjectStore returns whether the provider is one of the object stores
+// built into velero.
+func isBuiltInObjectStore(provider string) bool {
+	if !strings.Contains(provider, "/") {
+		provider = "velero.io/" + provider
+	}
+
+	return provider == persistence.S3ObjectStoreProvider || provider == persistence.FilesystemObjectStoreProvider
+}
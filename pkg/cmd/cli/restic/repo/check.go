@@ -0,0 +1,95 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+func NewCheckCommand(f client.Factory) *cobra.Command {
+	c := &cobra.Command{
+		Use:   "check NAME [NAME...]",
+		Short: "Request an integrity check of restic repositories",
+		Long: `Request an integrity check of restic repositories.
+
+The check is run by the Velero server. Its result is recorded in the
+repository's status and can be viewed with "velero restic repo get -o yaml".`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(requestRepoOperation(f, args, api.ResticRepositoryCheckRequestAnnotation, time.Now().UTC().Format(time.RFC3339)))
+		},
+	}
+
+	return c
+}
+
+// requestRepoOperation sets the given annotation on each of the named restic
+// repositories so that the Velero server runs the corresponding operation.
+func requestRepoOperation(f client.Factory, names []string, annotation, value string) error {
+	veleroClient, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	patch := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				annotation: value,
+			},
+		},
+	}
+	patchBytes, err := json.Marshal(patch)
+	if err != nil {
+		return errors.Wrap(err, "error marshalling patch")
+	}
+
+	var errs []error
+	for _, name := range names {
+		if _, err := veleroClient.VeleroV1().ResticRepositories(f.Namespace()).Patch(context.TODO(), name, types.MergePatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+			errs = append(errs, errors.Wrapf(err, "error updating restic repository %s", name))
+			continue
+		}
+		fmt.Printf("Request to %s restic repository %q submitted successfully.\n", annotationVerb(annotation), name)
+	}
+
+	if len(errs) > 0 {
+		for _, err := range errs[1:] {
+			fmt.Println(err)
+		}
+		return errs[0]
+	}
+	return nil
+}
+
+func annotationVerb(annotation string) string {
+	if annotation == api.ResticRepositoryUnlockRequestAnnotation {
+		return "unlock"
+	}
+	return "check"
+}
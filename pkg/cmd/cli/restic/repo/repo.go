@@ -31,6 +31,9 @@ func NewRepositoryCommand(f client.Factory) *cobra.Command {
 
 	c.AddCommand(
 		NewGetCommand(f, "get"),
+		NewCheckCommand(f),
+		NewUnlockCommand(f),
+		NewRotateKeyCommand(f),
 	)
 
 	return c
@@ -0,0 +1,74 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+)
+
+func NewRotateKeyCommand(f client.Factory) *cobra.Command {
+	var volumeNamespace string
+
+	c := &cobra.Command{
+		Use:   "rotate-key",
+		Short: "Rotate the key of restic repositories",
+		Long: `Rotate the key of restic repositories.
+
+A new random key is generated and added to every repository that uses the key
+secret by the Velero server. Once it has been added to all of them, it replaces
+the previous key, which is then removed from the repositories. The progress of
+the rotation is recorded in the status.keyRotation field of each repository.
+
+By default the key shared by all repositories is rotated. Use --volume-namespace
+to rotate the key of the repositories for a volume namespace instead, if the
+server was started with --restic-per-namespace-keys.`,
+		Args: cobra.NoArgs,
+		Run: func(c *cobra.Command, args []string) {
+			kubeClient, err := f.KubeClient()
+			cmd.CheckError(err)
+
+			secretName := restic.KeySecretName("")
+			if volumeNamespace != "" {
+				secretName = restic.RepositoryKeySecretName(volumeNamespace)
+			}
+
+			secret, err := kubeClient.CoreV1().Secrets(f.Namespace()).Get(context.TODO(), secretName, metav1.GetOptions{})
+			cmd.CheckError(errors.Wrapf(err, "error getting repository key secret %s", secretName))
+
+			cmd.CheckError(restic.SetNewRepositoryKey(secret, time.Now().UTC().Format(time.RFC3339)))
+
+			_, err = kubeClient.CoreV1().Secrets(f.Namespace()).Update(context.TODO(), secret, metav1.UpdateOptions{})
+			cmd.CheckError(errors.Wrapf(err, "error updating repository key secret %s", secretName))
+
+			fmt.Printf("Rotation of the key in secret %q started. Run `velero restic repo get -o yaml` to see its progress.\n", secretName)
+		},
+	}
+
+	c.Flags().StringVar(&volumeNamespace, "volume-namespace", volumeNamespace, "Rotate the key of the repositories for this volume namespace.")
+
+	return c
+}
@@ -0,0 +1,51 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"github.com/spf13/cobra"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+func NewUnlockCommand(f client.Factory) *cobra.Command {
+	var removeAll bool
+
+	c := &cobra.Command{
+		Use:   "unlock NAME [NAME...]",
+		Short: "Request removal of locks from restic repositories",
+		Long: `Request removal of locks from restic repositories.
+
+By default only stale locks are removed. Use --remove-all to remove all locks,
+including those held by running operations. Only do this if you are sure that
+no other process is using the repository.`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			mode := api.ResticRepositoryUnlockStale
+			if removeAll {
+				mode = api.ResticRepositoryUnlockAll
+			}
+			cmd.CheckError(requestRepoOperation(f, args, api.ResticRepositoryUnlockRequestAnnotation, mode))
+		},
+	}
+
+	c.Flags().BoolVar(&removeAll, "remove-all", removeAll, "Remove all locks, not just stale ones.")
+
+	return c
+}
@@ -21,6 +21,7 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
@@ -34,6 +35,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	kubeinformers "k8s.io/client-go/informers"
 	corev1informers "k8s.io/client-go/informers/core/v1"
@@ -68,11 +70,16 @@ const (
 	// defaultCredentialsDirectory is the path on disk where credential
 	// files will be written to
 	defaultCredentialsDirectory = "/tmp/credentials"
+
+	// defaultMaxConcurrentBackups is the default number of pod volume backups
+	// that are run at the same time on a node.
+	defaultMaxConcurrentBackups = 1
 )
 
 func NewServerCommand(f client.Factory) *cobra.Command {
 	logLevelFlag := logging.LogLevelFlag(logrus.InfoLevel)
 	formatFlag := logging.NewFormatFlag()
+	maxConcurrentBackups := defaultMaxConcurrentBackups
 
 	command := &cobra.Command{
 		Use:    "server",
@@ -87,7 +94,11 @@ func NewServerCommand(f client.Factory) *cobra.Command {
 			logger.Infof("Starting Velero restic server %s (%s)", buildinfo.Version, buildinfo.FormattedGitSHA())
 
 			f.SetBasename(fmt.Sprintf("%s-%s", c.Parent().Name(), c.Name()))
-			s, err := newResticServer(logger, f, defaultMetricsAddress)
+			if maxConcurrentBackups < 1 {
+				cmd.CheckError(errors.New("--max-concurrent-backups must be at least 1"))
+			}
+
+			s, err := newResticServer(logger, f, defaultMetricsAddress, maxConcurrentBackups)
 			cmd.CheckError(err)
 
 			s.run()
@@ -96,6 +107,7 @@ func NewServerCommand(f client.Factory) *cobra.Command {
 
 	command.Flags().Var(logLevelFlag, "log-level", fmt.Sprintf("The level at which to log. Valid values are %s.", strings.Join(logLevelFlag.AllowedValues(), ", ")))
 	command.Flags().Var(formatFlag, "log-format", fmt.Sprintf("The format for log output. Valid values are %s.", strings.Join(formatFlag.AllowedValues(), ", ")))
+	command.Flags().IntVar(&maxConcurrentBackups, "max-concurrent-backups", maxConcurrentBackups, "The maximum number of pod volume backups to run at the same time on this node.")
 
 	return command
 }
@@ -114,9 +126,10 @@ type resticServer struct {
 	metrics               *metrics.ServerMetrics
 	metricsAddress        string
 	namespace             string
+	maxConcurrentBackups  int
 }
 
-func newResticServer(logger logrus.FieldLogger, factory client.Factory, metricAddress string) (*resticServer, error) {
+func newResticServer(logger logrus.FieldLogger, factory client.Factory, metricAddress string, maxConcurrentBackups int) (*resticServer, error) {
 
 	kubeClient, err := factory.KubeClient()
 	if err != nil {
@@ -171,6 +184,7 @@ func newResticServer(logger logrus.FieldLogger, factory client.Factory, metricAd
 		mgr:                   mgr,
 		metricsAddress:        metricAddress,
 		namespace:             factory.Namespace(),
+		maxConcurrentBackups:  maxConcurrentBackups,
 	}
 
 	if err := s.validatePodVolumesHostPath(); err != nil {
@@ -216,6 +230,7 @@ func (s *resticServer) run() {
 		s.kubeInformerFactory.Core().V1().PersistentVolumes(),
 		s.metrics,
 		s.mgr.GetClient(),
+		s.mgr.GetAPIReader(),
 		os.Getenv("NODE_NAME"),
 		credentialFileStore,
 	)
@@ -243,7 +258,9 @@ func (s *resticServer) run() {
 
 	// Adding the controllers to the manager will register them as a (runtime-controller) runnable,
 	// so the manager will ensure the cache is started and ready before all controller are started
-	s.mgr.Add(managercontroller.Runnable(backupController, 1))
+	s.markInProgressBackupsFailed()
+
+	s.mgr.Add(managercontroller.Runnable(backupController, s.maxConcurrentBackups))
 	s.mgr.Add(managercontroller.Runnable(restoreController, 1))
 
 	s.logger.Info("Controllers starting...")
@@ -253,6 +270,37 @@ func (s *resticServer) run() {
 	}
 }
 
+// markInProgressBackupsFailed marks the pod volume backups on this node that are
+// still in progress as failed. They were interrupted by a restart of the restic
+// server and will never complete, and would otherwise keep counting against their
+// backup storage location's concurrency limit.
+func (s *resticServer) markInProgressBackupsFailed() {
+	backups, err := s.veleroClient.VeleroV1().PodVolumeBackups(s.namespace).List(s.ctx, metav1.ListOptions{})
+	if err != nil {
+		s.logger.WithError(errors.WithStack(err)).Error("failed to list pod volume backups")
+		return
+	}
+
+	for i := range backups.Items {
+		backup := &backups.Items[i]
+		if backup.Spec.Node != os.Getenv("NODE_NAME") || backup.Status.Phase != velerov1api.PodVolumeBackupPhaseInProgress {
+			continue
+		}
+
+		patch := fmt.Sprintf(
+			`{"status":{"phase":%q,"message":%q,"completionTimestamp":%q}}`,
+			velerov1api.PodVolumeBackupPhaseFailed,
+			"found an in progress pod volume backup on restic server startup, mark it as failed",
+			time.Now().UTC().Format(time.RFC3339),
+		)
+		if _, err := s.veleroClient.VeleroV1().PodVolumeBackups(backup.Namespace).Patch(s.ctx, backup.Name, types.MergePatchType, []byte(patch), metav1.PatchOptions{}); err != nil {
+			s.logger.WithError(errors.WithStack(err)).Errorf("failed to mark pod volume backup %s/%s as failed", backup.Namespace, backup.Name)
+			continue
+		}
+		s.logger.Infof("Marked in progress pod volume backup %s/%s as failed", backup.Namespace, backup.Name)
+	}
+}
+
 // validatePodVolumesHostPath validates that the pod volumes path contains a
 // directory for each Pod running on this node
 func (s *resticServer) validatePodVolumesHostPath() error {
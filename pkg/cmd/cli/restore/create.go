@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -57,7 +59,10 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
   velero restore create --from-schedule schedule-1 --allow-partially-failed
 
   # Create a restore for only persistentvolumeclaims and persistentvolumes within a backup.
-  velero restore create --from-backup backup-2 --include-resources persistentvolumeclaims,persistentvolumes`,
+  velero restore create --from-backup backup-2 --include-resources persistentvolumeclaims,persistentvolumes
+
+  # Restore the "config" directory of the restic backup of volume "data" of pod "ns-1/pod-1" into persistent volume claim "pvc-2", without restoring any Kubernetes objects.
+  velero restore create --from-backup backup-3 --pod-volume ns-1/pod-1/data --path /config --target-pvc pvc-2`,
 		Args: cobra.MaximumNArgs(1),
 		Run: func(c *cobra.Command, args []string) {
 			cmd.CheckError(o.Complete(args, f))
@@ -89,8 +94,19 @@ type CreateOptions struct {
 	IncludeClusterResources flag.OptionalBool
 	Wait                    bool
 	AllowPartiallyFailed    flag.OptionalBool
-
-	client veleroclient.Interface
+	WaitForReadiness        bool
+	ReadinessTimeout        time.Duration
+	PodVolumeRestoreMode    *flag.Enum
+	PodVolume               string
+	Paths                   flag.StringArray
+	TargetPVC               string
+	VolumeAZMappings        flag.Map
+	VolumeTypeMappings      flag.Map
+	VolumeIOPS              flag.Map
+	FromSnapshotCopies      bool
+
+	client        veleroclient.Interface
+	volumeMapping *api.PersistentVolumeMappingSpec
 }
 
 func NewCreateOptions() *CreateOptions {
@@ -98,9 +114,17 @@ func NewCreateOptions() *CreateOptions {
 		Labels:                  flag.NewMap(),
 		IncludeNamespaces:       flag.NewStringArray("*"),
 		NamespaceMappings:       flag.NewMap().WithEntryDelimiter(",").WithKeyValueDelimiter(":"),
+		VolumeAZMappings:        flag.NewMap().WithEntryDelimiter(",").WithKeyValueDelimiter(":"),
+		VolumeTypeMappings:      flag.NewMap().WithEntryDelimiter(",").WithKeyValueDelimiter(":"),
+		VolumeIOPS:              flag.NewMap().WithEntryDelimiter(",").WithKeyValueDelimiter(":"),
 		RestoreVolumes:          flag.NewOptionalBool(nil),
 		PreserveNodePorts:       flag.NewOptionalBool(nil),
 		IncludeClusterResources: flag.NewOptionalBool(nil),
+		PodVolumeRestoreMode: flag.NewEnum(
+			string(api.PodVolumeRestoreModeInitContainer),
+			string(api.PodVolumeRestoreModeInitContainer),
+			string(api.PodVolumeRestoreModeHelperPod),
+		),
 	}
 }
 
@@ -131,6 +155,16 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	f.NoOptDefVal = "true"
 
 	flags.BoolVarP(&o.Wait, "wait", "w", o.Wait, "Wait for the operation to complete.")
+	flags.BoolVar(&o.WaitForReadiness, "wait-for-readiness", o.WaitForReadiness, "Wait for restored items to become ready before completing the restore. Items that don't become ready are reported as warnings.")
+	flags.DurationVar(&o.ReadinessTimeout, "readiness-timeout", o.ReadinessTimeout, "How long to wait for restored items to become ready. Only used with --wait-for-readiness. Defaults to 10 minutes.")
+	flags.Var(o.PodVolumeRestoreMode, "pod-volume-restore-mode", fmt.Sprintf("How restic data is restored into the volumes of restored pods. With %s, the data of volumes backed by persistent volume claims is restored using a temporary helper pod before the pods are created, instead of an init container. Valid values are %s.", api.PodVolumeRestoreModeHelperPod, strings.Join(o.PodVolumeRestoreMode.AllowedValues(), ",")))
+	flags.StringVar(&o.PodVolume, "pod-volume", "", "Restore files from the restic backup of this pod volume, in the form namespace/pod/volume, into the persistent volume claim specified by --target-pvc, instead of restoring any Kubernetes objects.")
+	flags.Var(&o.Paths, "path", "Paths to restore from the pod volume, relative to its root. Only used with --pod-volume. If unset, all of the volume's files are restored.")
+	flags.Var(&o.VolumeAZMappings, "volume-az-mappings", "Availability zone mappings from the zone volumes were snapshotted in to the zone to restore them to, in the form src1:dst1,src2:dst2,...")
+	flags.Var(&o.VolumeTypeMappings, "volume-type-mappings", "Volume type mappings from the type of snapshotted volumes to the type to restore them as, in the form src1:dst1,src2:dst2,...")
+	flags.Var(&o.VolumeIOPS, "volume-iops", "Provisioned IOPS to restore volumes with, by the type they're restored as, in the form type1:iops1,type2:iops2,...")
+	flags.BoolVar(&o.FromSnapshotCopies, "from-snapshot-copies", o.FromSnapshotCopies, "Restore volumes from the copies of their snapshots in their volume snapshot location's secondary region or location.")
+	flags.StringVar(&o.TargetPVC, "target-pvc", "", "Persistent volume claim to restore the pod volume's files into. It must exist in the pod's namespace, or the namespace it's mapped to by --namespace-mappings. Required with --pod-volume.")
 }
 
 func (o *CreateOptions) Complete(args []string, f client.Factory) error {
@@ -163,6 +197,23 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("either a backup or schedule must be specified, but not both")
 	}
 
+	if o.PodVolume != "" {
+		if _, err := parsePodVolume(o.PodVolume); err != nil {
+			return err
+		}
+		if o.TargetPVC == "" {
+			return errors.New("--target-pvc is required with --pod-volume")
+		}
+	} else if len(o.Paths) > 0 || o.TargetPVC != "" {
+		return errors.New("--path and --target-pvc can only be used with --pod-volume")
+	}
+
+	volumeMapping, err := o.persistentVolumeMapping()
+	if err != nil {
+		return err
+	}
+	o.volumeMapping = volumeMapping
+
 	if err := output.ValidateFlags(c); err != nil {
 		return err
 	}
@@ -190,6 +241,51 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 	return nil
 }
 
+// parsePodVolume parses a pod volume in the form namespace/pod/volume into a
+// pod volume file restore spec.
+func parsePodVolume(podVolume string) (*api.PodVolumeFileRestoreSpec, error) {
+	parts := strings.Split(podVolume, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, errors.Errorf("invalid pod volume %q, must be in the form namespace/pod/volume", podVolume)
+	}
+
+	return &api.PodVolumeFileRestoreSpec{
+		Namespace: parts[0],
+		Pod:       parts[1],
+		Volume:    parts[2],
+	}, nil
+}
+
+// persistentVolumeMapping returns the persistent volume mapping specified by the volume
+// mapping flags, or nil if none of them are set.
+func (o *CreateOptions) persistentVolumeMapping() (*api.PersistentVolumeMappingSpec, error) {
+	if len(o.VolumeAZMappings.Data()) == 0 && len(o.VolumeTypeMappings.Data()) == 0 && len(o.VolumeIOPS.Data()) == 0 && !o.FromSnapshotCopies {
+		return nil, nil
+	}
+
+	mapping := &api.PersistentVolumeMappingSpec{
+		AvailabilityZones: o.VolumeAZMappings.Data(),
+		VolumeTypes:       o.VolumeTypeMappings.Data(),
+	}
+
+	for volumeType, value := range o.VolumeIOPS.Data() {
+		iops, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || iops <= 0 {
+			return nil, errors.Errorf("invalid IOPS %q for volume type %s, must be a positive integer", value, volumeType)
+		}
+		if mapping.IOPS == nil {
+			mapping.IOPS = make(map[string]int64)
+		}
+		mapping.IOPS[volumeType] = iops
+	}
+
+	if o.FromSnapshotCopies {
+		mapping.FromSnapshotCopies = boolptr.True()
+	}
+
+	return mapping, nil
+}
+
 // mostRecentBackup returns the backup with the most recent start timestamp that has a phase that's
 // in the provided list of allowed phases.
 func mostRecentBackup(backups []api.Backup, allowedPhases ...api.BackupPhase) *api.Backup {
@@ -269,9 +365,27 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 			RestorePVs:              o.RestoreVolumes.Value,
 			PreserveNodePorts:       o.PreserveNodePorts.Value,
 			IncludeClusterResources: o.IncludeClusterResources.Value,
+			PodVolumeRestoreMode:    api.PodVolumeRestoreMode(o.PodVolumeRestoreMode.String()),
+			PersistentVolumeMapping: o.volumeMapping,
 		},
 	}
 
+	if o.PodVolume != "" {
+		fileRestore, err := parsePodVolume(o.PodVolume)
+		if err != nil {
+			return err
+		}
+		fileRestore.Paths = o.Paths
+		fileRestore.TargetPVC = o.TargetPVC
+		restore.Spec.PodVolumeFileRestore = fileRestore
+	}
+
+	if o.WaitForReadiness {
+		restore.Spec.WaitForReadiness = &api.RestoreReadinessSpec{
+			Timeout: metav1.Duration{Duration: o.ReadinessTimeout},
+		}
+	}
+
 	if printed, err := output.PrintWithFormat(c, restore); printed || err != nil {
 		return err
 	}
@@ -124,17 +124,22 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		},
 		Spec: api.ScheduleSpec{
 			Template: api.BackupSpec{
-				IncludedNamespaces:      o.BackupOptions.IncludeNamespaces,
-				ExcludedNamespaces:      o.BackupOptions.ExcludeNamespaces,
-				IncludedResources:       o.BackupOptions.IncludeResources,
-				ExcludedResources:       o.BackupOptions.ExcludeResources,
-				IncludeClusterResources: o.BackupOptions.IncludeClusterResources.Value,
-				LabelSelector:           o.BackupOptions.Selector.LabelSelector,
-				SnapshotVolumes:         o.BackupOptions.SnapshotVolumes.Value,
-				TTL:                     metav1.Duration{Duration: o.BackupOptions.TTL},
-				StorageLocation:         o.BackupOptions.StorageLocation,
-				VolumeSnapshotLocations: o.BackupOptions.SnapshotLocations,
-				DefaultVolumesToRestic:  o.BackupOptions.DefaultVolumesToRestic.Value,
+				IncludedNamespaces:        o.BackupOptions.IncludeNamespaces,
+				ExcludedNamespaces:        o.BackupOptions.ExcludeNamespaces,
+				IncludedResources:         o.BackupOptions.IncludeResources,
+				ExcludedResources:         o.BackupOptions.ExcludeResources,
+				IncludeClusterResources:   o.BackupOptions.IncludeClusterResources.Value,
+				LabelSelector:             o.BackupOptions.Selector.LabelSelector,
+				SnapshotVolumes:           o.BackupOptions.SnapshotVolumes.Value,
+				GroupVolumeSnapshotsByPod: o.BackupOptions.GroupSnapshotsByPod.Value,
+				TTL:                       metav1.Duration{Duration: o.BackupOptions.TTL},
+				StorageLocation:           o.BackupOptions.StorageLocation,
+				MirrorStorageLocations:    o.BackupOptions.MirrorLocations,
+				VolumeSnapshotLocations:   o.BackupOptions.SnapshotLocations,
+				DefaultVolumesToRestic:    o.BackupOptions.DefaultVolumesToRestic.Value,
+				UploaderType:              o.BackupOptions.UploaderType,
+				VolumePolicy:              o.BackupOptions.VolumePolicy,
+				PodVolumeTransfer:         o.BackupOptions.PodVolumeTransfer.Settings(),
 			},
 			Schedule:                   o.Schedule,
 			UseOwnerReferencesInBackup: &o.UseOwnerReferencesInBackup,
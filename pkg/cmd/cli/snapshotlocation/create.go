@@ -54,21 +54,24 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 }
 
 type CreateOptions struct {
-	Name     string
-	Provider string
-	Config   flag.Map
-	Labels   flag.Map
+	Name       string
+	Provider   string
+	Config     flag.Map
+	CopyConfig flag.Map
+	Labels     flag.Map
 }
 
 func NewCreateOptions() *CreateOptions {
 	return &CreateOptions{
-		Config: flag.NewMap(),
+		Config:     flag.NewMap(),
+		CopyConfig: flag.NewMap(),
 	}
 }
 
 func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.Provider, "provider", o.Provider, "Name of the volume snapshot provider (e.g. aws, azure, gcp).")
 	flags.Var(&o.Config, "config", "Configuration key-value pairs.")
+	flags.Var(&o.CopyConfig, "copy-config", "Configuration key-value pairs of a secondary region or location to copy each completed snapshot to. Applied on top of the configuration key-value pairs.")
 	flags.Var(&o.Labels, "labels", "Labels to apply to the volume snapshot location.")
 }
 
@@ -102,6 +105,12 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		},
 	}
 
+	if len(o.CopyConfig.Data()) > 0 {
+		volumeSnapshotLocation.Spec.Copy = &api.VolumeSnapshotCopySpec{
+			Config: o.CopyConfig.Data(),
+		}
+	}
+
 	if printed, err := output.PrintWithFormat(c, volumeSnapshotLocation); printed || err != nil {
 		return err
 	}
@@ -25,6 +25,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	velerodiscovery "github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
 	veleroplugin "github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/restore"
 )
@@ -55,6 +56,8 @@ func NewCommand(f client.Factory) *cobra.Command {
 				RegisterRestoreItemAction("velero.io/crd-preserve-fields", newCRDV1PreserveUnknownFieldsItemAction).
 				RegisterRestoreItemAction("velero.io/change-pvc-node-selector", newChangePVCNodeSelectorItemAction(f)).
 				RegisterRestoreItemAction("velero.io/apiservice", newAPIServiceRestoreItemAction).
+				RegisterObjectStore(persistence.FilesystemObjectStoreProvider, newFilesystemObjectStore).
+				RegisterObjectStore(persistence.S3ObjectStoreProvider, newS3ObjectStore).
 				Serve()
 		},
 	}
@@ -202,3 +205,11 @@ func newChangePVCNodeSelectorItemAction(f client.Factory) veleroplugin.HandlerIn
 func newAPIServiceRestoreItemAction(logger logrus.FieldLogger) (interface{}, error) {
 	return restore.NewAPIServiceAction(logger), nil
 }
+
+func newFilesystemObjectStore(logger logrus.FieldLogger) (interface{}, error) {
+	return persistence.NewFilesystemObjectStore(), nil
+}
+
+func newS3ObjectStore(logger logrus.FieldLogger) (interface{}, error) {
+	return persistence.NewS3ObjectStore(), nil
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"go.opentelemetry.io/otel"
 	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -65,14 +66,17 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/vmware-tanzu/velero/internal/storage"
@@ -89,6 +93,9 @@ const (
 	defaultPodVolumeOperationTimeout  = 240 * time.Minute
 	defaultResourceTerminatingTimeout = 10 * time.Minute
 
+	// how often the health of the registered plugins is checked
+	pluginHealthCheckInterval = time.Minute
+
 	// server's client default qps and burst
 	defaultClientQPS      float32 = 20.0
 	defaultClientBurst    int     = 30
@@ -100,6 +107,12 @@ const (
 	// the default TTL for a backup
 	defaultBackupTTL = 30 * 24 * time.Hour
 
+	// the default time to wait for volume snapshotters to upload the data of a backup's snapshots
+	defaultVolumeSnapshotUploadTimeout = 4 * time.Hour
+
+	// the default time to wait for a call to a plugin to complete
+	defaultPluginCallTimeout = time.Hour
+
 	// defaultCredentialsDirectory is the path on disk where credential
 	// files will be written to
 	defaultCredentialsDirectory = "/tmp/credentials"
@@ -110,6 +123,10 @@ type serverConfig struct {
 	pluginDir, metricsAddress, defaultBackupLocation                        string
 	backupSyncPeriod, podVolumeOperationTimeout, resourceTerminatingTimeout time.Duration
 	defaultBackupTTL, storeValidationFrequency                              time.Duration
+	volumeSnapshotUploadTimeout, pluginCallTimeout                          time.Duration
+	traceSampleRatio                                                        float64
+	traceOTLPEndpoint                                                       string
+	traceOTLPInsecure                                                       bool
 	restoreResourcePriorities                                               []string
 	defaultVolumeSnapshotLocations                                          map[string]string
 	restoreOnly                                                             bool
@@ -118,9 +135,15 @@ type serverConfig struct {
 	clientBurst                                                             int
 	clientPageSize                                                          int
 	profilerAddress                                                         string
+	filesystemObjectStoreAddress                                            string
+	filesystemObjectStoreTLSCert, filesystemObjectStoreTLSKey               string
+	filesystemObjectStoreInsecure                                           bool
 	formatFlag                                                              *logging.FormatFlag
 	defaultResticMaintenanceFrequency                                       time.Duration
+	defaultResticCheckFrequency                                             time.Duration
+	resticKeyRotationTimeout                                                time.Duration
 	defaultVolumesToRestic                                                  bool
+	resticPerNamespaceKeys                                                  bool
 }
 
 type controllerRunInfo struct {
@@ -139,6 +162,8 @@ func NewCommand(f client.Factory) *cobra.Command {
 			defaultVolumeSnapshotLocations:    make(map[string]string),
 			backupSyncPeriod:                  defaultBackupSyncPeriod,
 			defaultBackupTTL:                  defaultBackupTTL,
+			volumeSnapshotUploadTimeout:       defaultVolumeSnapshotUploadTimeout,
+			pluginCallTimeout:                 defaultPluginCallTimeout,
 			storeValidationFrequency:          defaultStoreValidationFrequency,
 			podVolumeOperationTimeout:         defaultPodVolumeOperationTimeout,
 			restoreResourcePriorities:         defaultRestorePriorities,
@@ -149,6 +174,8 @@ func NewCommand(f client.Factory) *cobra.Command {
 			resourceTerminatingTimeout:        defaultResourceTerminatingTimeout,
 			formatFlag:                        logging.NewFormatFlag(),
 			defaultResticMaintenanceFrequency: restic.DefaultMaintenanceFrequency,
+			defaultResticCheckFrequency:       restic.DefaultCheckFrequency,
+			resticKeyRotationTimeout:          restic.DefaultKeyRotationTimeout,
 			defaultVolumesToRestic:            restic.DefaultVolumesToRestic,
 		}
 	)
@@ -210,9 +237,21 @@ func NewCommand(f client.Factory) *cobra.Command {
 	command.Flags().IntVar(&config.clientBurst, "client-burst", config.clientBurst, "Maximum number of requests by the server to the Kubernetes API in a short period of time.")
 	command.Flags().IntVar(&config.clientPageSize, "client-page-size", config.clientPageSize, "Page size of requests by the server to the Kubernetes API when listing objects during a backup. Set to 0 to disable paging.")
 	command.Flags().StringVar(&config.profilerAddress, "profiler-address", config.profilerAddress, "The address to expose the pprof profiler.")
+	command.Flags().StringVar(&config.filesystemObjectStoreAddress, "filesystem-object-store-address", config.filesystemObjectStoreAddress, "The address to serve the signed URLs of backup storage locations using the velero.io/filesystem provider at. Leave empty to disable.")
+	command.Flags().StringVar(&config.filesystemObjectStoreTLSCert, "filesystem-object-store-tls-cert", config.filesystemObjectStoreTLSCert, "Path to the TLS certificate that the filesystem object store server serves HTTPS with.")
+	command.Flags().StringVar(&config.filesystemObjectStoreTLSKey, "filesystem-object-store-tls-key", config.filesystemObjectStoreTLSKey, "Path to the private key of the filesystem object store server's TLS certificate.")
+	command.Flags().BoolVar(&config.filesystemObjectStoreInsecure, "filesystem-object-store-insecure", config.filesystemObjectStoreInsecure, "Serve the filesystem object store server over plain HTTP, without a TLS certificate. Only use this if TLS is terminated in front of the server, e.g. by an Ingress.")
 	command.Flags().DurationVar(&config.resourceTerminatingTimeout, "terminating-resource-timeout", config.resourceTerminatingTimeout, "How long to wait on persistent volumes and namespaces to terminate during a restore before timing out.")
 	command.Flags().DurationVar(&config.defaultBackupTTL, "default-backup-ttl", config.defaultBackupTTL, "How long to wait by default before backups can be garbage collected.")
+	command.Flags().DurationVar(&config.volumeSnapshotUploadTimeout, "volume-snapshot-upload-timeout", config.volumeSnapshotUploadTimeout, "How long to wait for volume snapshotters to upload the data of a backup's volume snapshots before marking the snapshots as failed.")
+	command.Flags().DurationVar(&config.pluginCallTimeout, "plugin-call-timeout", config.pluginCallTimeout, "How long to wait for each call to a plugin to complete before failing it. Uploads and downloads of backup storage location objects aren't limited. Set this to 0 to disable the timeout.")
+	command.Flags().Float64Var(&config.traceSampleRatio, "trace-sample-ratio", config.traceSampleRatio, "The fraction of backups and restores to trace with OpenTelemetry, from 0 to 1. The spans of traced backups and restores, and of the plugin calls they make, are exported to the trace-otlp-endpoint, or written to the server's log if it's empty, and their trace IDs to the backup or restore log. Set this to 0 to disable tracing.")
+	command.Flags().StringVar(&config.traceOTLPEndpoint, "trace-otlp-endpoint", config.traceOTLPEndpoint, "The host:port of the OpenTelemetry collector to export spans to over OTLP/gRPC. Leave empty to write them to the server's log.")
+	command.Flags().BoolVar(&config.traceOTLPInsecure, "trace-otlp-insecure", config.traceOTLPInsecure, "Export spans to the trace-otlp-endpoint without TLS.")
 	command.Flags().DurationVar(&config.defaultResticMaintenanceFrequency, "default-restic-prune-frequency", config.defaultResticMaintenanceFrequency, "How often 'restic prune' is run for restic repositories by default.")
+	command.Flags().DurationVar(&config.defaultResticCheckFrequency, "default-restic-check-frequency", config.defaultResticCheckFrequency, "How often 'restic check' is run for restic repositories by default. Set this to 0 to disable periodic checks.")
+	command.Flags().DurationVar(&config.resticKeyRotationTimeout, "restic-key-rotation-timeout", config.resticKeyRotationTimeout, "How long the new key of a restic repository key rotation has to be added to all repositories within before the rotation is aborted.")
+	command.Flags().BoolVar(&config.resticPerNamespaceKeys, "restic-per-namespace-keys", config.resticPerNamespaceKeys, "Give new restic repositories a key of their own for each volume namespace, instead of the key shared by all repositories. Existing repositories keep their key.")
 	command.Flags().BoolVar(&config.defaultVolumesToRestic, "default-volumes-to-restic", config.defaultVolumesToRestic, "Backup all volumes with restic by default.")
 
 	return command
@@ -235,6 +274,7 @@ type server struct {
 	logger                              logrus.FieldLogger
 	logLevel                            logrus.Level
 	pluginRegistry                      clientmgmt.Registry
+	pluginRegistrationReconciler        *controller.PluginRegistrationReconciler
 	resticManager                       restic.RepositoryManager
 	metrics                             *metrics.ServerMetrics
 	config                              serverConfig
@@ -243,6 +283,10 @@ type server struct {
 }
 
 func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*server, error) {
+	if config.traceSampleRatio < 0 || config.traceSampleRatio > 1 {
+		return nil, errors.New("trace-sample-ratio must be between 0 and 1")
+	}
+
 	if config.clientQPS < 0.0 {
 		return nil, errors.New("client-qps must be positive")
 	}
@@ -257,6 +301,10 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		return nil, errors.New("client-page-size must not be negative")
 	}
 
+	if err := validateFilesystemObjectStoreConfig(config); err != nil {
+		return nil, err
+	}
+
 	kubeClient, err := f.KubeClient()
 	if err != nil {
 		return nil, err
@@ -353,6 +401,25 @@ func (s *server) run() error {
 		go s.runProfiler()
 	}
 
+	if s.config.traceSampleRatio > 0 {
+		exporter := tracing.NewLogExporter(s.logger)
+		if s.config.traceOTLPEndpoint != "" {
+			var err error
+			if exporter, err = tracing.NewOTLPExporter(s.ctx, s.config.traceOTLPEndpoint, s.config.traceOTLPInsecure); err != nil {
+				return err
+			}
+		}
+		provider := tracing.NewTracerProvider(exporter, s.config.traceSampleRatio)
+		otel.SetTracerProvider(provider)
+		// export the spans that haven't been exported yet when the server shuts down,
+		// without waiting long for an unreachable collector
+		defer func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			provider.Shutdown(ctx)
+		}()
+	}
+
 	// Since s.namespace, which specifies where backups/restores/schedules/etc. should live,
 	// *could* be different from the namespace where the Velero server pod runs, check to make
 	// sure it exists, and fail fast if it doesn't.
@@ -368,6 +435,12 @@ func (s *server) run() error {
 		return err
 	}
 
+	if err := s.registerRemotePlugins(); err != nil {
+		return err
+	}
+
+	s.checkPluginHealth()
+
 	if err := s.initRestic(); err != nil {
 		return err
 	}
@@ -414,6 +487,21 @@ func (s *server) initDiscoveryHelper() error {
 	return nil
 }
 
+// checkPluginHealth spawns a goroutine to check the health of the registered plugins
+// every pluginHealthCheckInterval, so that plugins that aren't ready aren't used and
+// the reported plugin statuses stay current.
+func (s *server) checkPluginHealth() {
+	go wait.Until(
+		func() {
+			if err := s.pluginRegistry.CheckHealth(); err != nil {
+				s.logger.WithError(err).Error("Error checking plugin health")
+			}
+		},
+		pluginHealthCheckInterval,
+		s.ctx.Done(),
+	)
+}
+
 // veleroResourcesExist checks for the existence of each Velero CRD via discovery
 // and returns an error if any of them don't exist.
 func (s *server) veleroResourcesExist() error {
@@ -499,6 +587,21 @@ var defaultRestorePriorities = []string{
 	"clusterresourcesets.addons.cluster.x-k8s.io",
 }
 
+// registerRemotePlugins registers the plugins of the PluginRegistrations in the
+// server's namespace, before the controllers that use them start. The
+// PluginRegistration controller then retries the ones that failed, and
+// handles new, edited and deleted ones.
+func (s *server) registerRemotePlugins() error {
+	// the manager's client reads from its cache, which isn't started yet
+	kbClient, err := kbclient.New(s.kubeClientConfig, kbclient.Options{Scheme: s.mgr.GetScheme()})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	s.pluginRegistrationReconciler = controller.NewPluginRegistrationReconciler(s.ctx, kbClient, s.namespace, s.pluginRegistry, s.logger)
+	return s.pluginRegistrationReconciler.ReconcileAll()
+}
+
 func (s *server) initRestic() error {
 	// warn if restic daemonset does not exist
 	if _, err := s.kubeClient.AppsV1().DaemonSets(s.namespace).Get(s.ctx, restic.DaemonSet, metav1.GetOptions{}); apierrors.IsNotFound(err) {
@@ -521,7 +624,9 @@ func (s *server) initRestic() error {
 		s.mgr.GetClient(),
 		s.kubeClient.CoreV1(),
 		s.kubeClient.CoreV1(),
+		s.kubeClient.CoreV1(),
 		s.credentialFileStore,
+		s.config.resticPerNamespaceKeys,
 		s.logger,
 	)
 	if err != nil {
@@ -573,13 +678,20 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.logger.Fatalf("Failed to start metric server at [%s]: %v", s.metricsAddress, err)
 		}
 	}()
+	if s.config.filesystemObjectStoreAddress != "" {
+		go s.runFilesystemObjectStoreServer()
+	}
+
 	s.metrics = metrics.NewServerMetrics()
 	s.metrics.RegisterAllMetrics()
 	// Initialize manual backup metrics
 	s.metrics.InitSchedule("")
 
+	// plugin calls are canceled when the server shuts down
+	pluginCtx := framework.WithCallTimeout(s.ctx, s.config.pluginCallTimeout)
+	pluginCtx = framework.WithPluginMetrics(pluginCtx, s.metrics)
 	newPluginManager := func(logger logrus.FieldLogger) clientmgmt.Manager {
-		return clientmgmt.NewManager(logger, s.logLevel, s.pluginRegistry)
+		return clientmgmt.NewManager(pluginCtx, logger, s.logLevel, s.pluginRegistry)
 	}
 
 	backupStoreGetter := persistence.NewObjectBackupStoreGetter(s.credentialFileStore)
@@ -615,6 +727,8 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.veleroClient.VeleroV1(),
 			s.discoveryHelper,
 			client.NewDynamicFactory(s.dynamicClient),
+			s.kubeClient.CoreV1(),
+			s.kubeClient.CoreV1(),
 			podexec.NewPodCommandExecutor(s.kubeClientConfig, s.kubeClient.CoreV1().RESTClient()),
 			s.resticManager,
 			s.config.podVolumeOperationTimeout,
@@ -643,6 +757,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			csiVSLister,
 			csiVSCLister,
 			backupStoreGetter,
+			s.config.volumeSnapshotUploadTimeout,
 		)
 
 		return controllerRunInfo{
@@ -682,6 +797,23 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	replicationControllerRunInfo := func() controllerRunInfo {
+		replicationController := controller.NewBackupReplicationController(
+			s.logger,
+			s.sharedInformerFactory.Velero().V1().Backups(),
+			s.veleroClient.VeleroV1(),
+			s.mgr.GetClient(),
+			backupTracker,
+			newPluginManager,
+			backupStoreGetter,
+		)
+
+		return controllerRunInfo{
+			controller: replicationController,
+			numWorkers: defaultControllerWorkers,
+		}
+	}
+
 	deletionControllerRunInfo := func() controllerRunInfo {
 		deletionController := controller.NewBackupDeletionController(
 			s.logger,
@@ -692,6 +824,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.veleroClient.VeleroV1(), // restoreClient
 			backupTracker,
 			s.resticManager,
+			s.credentialFileStore,
 			s.sharedInformerFactory.Velero().V1().PodVolumeBackups().Lister(),
 			s.mgr.GetClient(),
 			s.sharedInformerFactory.Velero().V1().VolumeSnapshotLocations().Lister(),
@@ -723,6 +856,8 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.logger,
 			podexec.NewPodCommandExecutor(s.kubeClientConfig, s.kubeClient.CoreV1().RESTClient()),
 			s.kubeClient.CoreV1().RESTClient(),
+			s.kubeClient.CoreV1(),
+			s.kubeClient.CoreV1().ConfigMaps(s.namespace),
 		)
 		cmd.CheckError(err)
 
@@ -757,6 +892,8 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.mgr.GetClient(),
 			s.resticManager,
 			s.config.defaultResticMaintenanceFrequency,
+			s.config.defaultResticCheckFrequency,
+			s.config.resticKeyRotationTimeout,
 		)
 
 		return controllerRunInfo{
@@ -770,6 +907,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		controller.Backup:            backupControllerRunInfo,
 		controller.Schedule:          scheduleControllerRunInfo,
 		controller.GarbageCollection: gcControllerRunInfo,
+		controller.BackupReplication: replicationControllerRunInfo,
 		controller.BackupDeletion:    deletionControllerRunInfo,
 		controller.Restore:           restoreControllerRunInfo,
 		controller.ResticRepo:        resticRepoControllerRunInfo,
@@ -780,11 +918,12 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	enabledRuntimeControllers[controller.DownloadRequest] = struct{}{}
 
 	if s.config.restoreOnly {
-		s.logger.Info("Restore only mode - not starting the backup, schedule, delete-backup, or GC controllers")
+		s.logger.Info("Restore only mode - not starting the backup, schedule, backup-replication, delete-backup, or GC controllers")
 		s.config.disabledControllers = append(s.config.disabledControllers,
 			controller.Backup,
 			controller.Schedule,
 			controller.GarbageCollection,
+			controller.BackupReplication,
 			controller.BackupDeletion,
 		)
 	}
@@ -840,6 +979,10 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupStorageLocation)
 	}
 
+	if err := s.pluginRegistrationReconciler.SetupWithManager(s.mgr); err != nil {
+		s.logger.Fatal(err, "unable to create controller", "controller", controller.PluginRegistration)
+	}
+
 	if _, ok := enabledRuntimeControllers[controller.ServerStatusRequest]; ok {
 		r := controller.ServerStatusRequestReconciler{
 			Scheme:         s.mgr.GetScheme(),
@@ -924,6 +1067,39 @@ func (s *server) runProfiler() {
 	}
 }
 
+// validateFilesystemObjectStoreConfig checks that the filesystem object store server, if it's
+// enabled, is served over TLS, unless plain HTTP was asked for explicitly.
+func validateFilesystemObjectStoreConfig(config serverConfig) error {
+	if config.filesystemObjectStoreAddress == "" {
+		return nil
+	}
+	if (config.filesystemObjectStoreTLSCert == "") != (config.filesystemObjectStoreTLSKey == "") {
+		return errors.New("filesystem-object-store-tls-cert and filesystem-object-store-tls-key must be set together")
+	}
+	if config.filesystemObjectStoreTLSCert == "" && !config.filesystemObjectStoreInsecure {
+		return errors.New("filesystem-object-store-address requires filesystem-object-store-tls-cert and filesystem-object-store-tls-key, or filesystem-object-store-insecure to serve plain HTTP")
+	}
+	return nil
+}
+
+func (s *server) runFilesystemObjectStoreServer() {
+	handler := persistence.NewFilesystemURLHandler(s.mgr.GetClient(), s.namespace, s.credentialFileStore, s.logger)
+
+	// the signed URLs are bearer credentials, so they're only served over plain HTTP if that
+	// was asked for explicitly
+	var err error
+	if s.config.filesystemObjectStoreTLSCert != "" {
+		s.logger.Infof("Starting filesystem object store server at address [%s]", s.config.filesystemObjectStoreAddress)
+		err = http.ListenAndServeTLS(s.config.filesystemObjectStoreAddress, s.config.filesystemObjectStoreTLSCert, s.config.filesystemObjectStoreTLSKey, handler)
+	} else {
+		s.logger.Warnf("Starting filesystem object store server at address [%s] without TLS", s.config.filesystemObjectStoreAddress)
+		err = http.ListenAndServe(s.config.filesystemObjectStoreAddress, handler)
+	}
+	if err != nil {
+		s.logger.WithError(errors.WithStack(err)).Error("error running filesystem object store http server")
+	}
+}
+
 // CSIInformerFactoryWrapper is a proxy around the CSI SharedInformerFactory that checks the CSI feature flag before performing operations.
 type CSIInformerFactoryWrapper struct {
 	factory snapshotv1beta1informers.SharedInformerFactory
@@ -19,6 +19,7 @@ package server
 import (
 	"testing"
 
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -154,3 +155,71 @@ func TestRemoveControllers(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateFilesystemObjectStoreConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		address     string
+		tlsCert     string
+		tlsKey      string
+		insecure    bool
+		expectedErr string
+	}{
+		{
+			name: "disabled server needs no TLS",
+		},
+		{
+			name:    "server with a TLS certificate and key is valid",
+			address: ":8086",
+			tlsCert: "/certs/tls.crt",
+			tlsKey:  "/certs/tls.key",
+		},
+		{
+			name:     "server without TLS is valid if it's explicitly insecure",
+			address:  ":8086",
+			insecure: true,
+		},
+		{
+			name:        "server without TLS is refused",
+			address:     ":8086",
+			expectedErr: "filesystem-object-store-address requires filesystem-object-store-tls-cert and filesystem-object-store-tls-key, or filesystem-object-store-insecure to serve plain HTTP",
+		},
+		{
+			name:        "server with a TLS certificate but no key is refused",
+			address:     ":8086",
+			tlsCert:     "/certs/tls.crt",
+			expectedErr: "filesystem-object-store-tls-cert and filesystem-object-store-tls-key must be set together",
+		},
+		{
+			name:        "server with a TLS key but no certificate is refused",
+			address:     ":8086",
+			tlsKey:      "/certs/tls.key",
+			insecure:    true,
+			expectedErr: "filesystem-object-store-tls-cert and filesystem-object-store-tls-key must be set together",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateFilesystemObjectStoreConfig(serverConfig{
+				filesystemObjectStoreAddress:  test.address,
+				filesystemObjectStoreTLSCert:  test.tlsCert,
+				filesystemObjectStoreTLSKey:   test.tlsKey,
+				filesystemObjectStoreInsecure: test.insecure,
+			})
+			if test.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedErr)
+			}
+		})
+	}
+}
+
+func TestNewServerRejectsInvalidTraceSampleRatio(t *testing.T) {
+	for _, ratio := range []float64{-0.5, 1.5} {
+		// the ratio is checked before the client factory is used
+		_, err := newServer(nil, serverConfig{traceSampleRatio: ratio}, logrus.New())
+		assert.EqualError(t, err, "trace-sample-ratio must be between 0 and 1")
+	}
+}
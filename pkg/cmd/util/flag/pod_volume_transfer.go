@@ -0,0 +1,80 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flag
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// PodVolumeTransfer holds the flags used to specify pod volume transfer
+// throttling settings.
+type PodVolumeTransfer struct {
+	UploadLimitKiBps   int
+	DownloadLimitKiBps int
+	Nice               int
+	IOPriorityClass    *Enum
+}
+
+// NewPodVolumeTransfer returns a new, empty set of pod volume transfer flags.
+func NewPodVolumeTransfer() *PodVolumeTransfer {
+	return &PodVolumeTransfer{
+		IOPriorityClass: NewEnum("", string(velerov1api.IOPriorityClassBestEffort), string(velerov1api.IOPriorityClassIdle)),
+	}
+}
+
+// BindFlags binds the pod volume transfer flags to the flag set.
+func (p *PodVolumeTransfer) BindFlags(flags *pflag.FlagSet) {
+	flags.IntVar(&p.UploadLimitKiBps, "pod-volume-upload-limit", p.UploadLimitKiBps, "Maximum upload bandwidth in KiB/s for pod volume data transfers. Optional. Set this to 0 for no limit.")
+	flags.IntVar(&p.DownloadLimitKiBps, "pod-volume-download-limit", p.DownloadLimitKiBps, "Maximum download bandwidth in KiB/s for pod volume data transfers. Optional. Set this to 0 for no limit.")
+	flags.IntVar(&p.Nice, "pod-volume-nice", p.Nice, "CPU scheduling priority of pod volume data transfers, from 0 (normal) to 19 (lowest). Optional.")
+	flags.Var(p.IOPriorityClass, "pod-volume-io-priority-class", fmt.Sprintf("I/O scheduling class of pod volume data transfers. Valid values are %s. Optional.", strings.Join(p.IOPriorityClass.AllowedValues(), ", ")))
+}
+
+// Validate returns an error if any of the flag values are invalid.
+func (p *PodVolumeTransfer) Validate() error {
+	if p.UploadLimitKiBps < 0 {
+		return errors.New("--pod-volume-upload-limit must be non-negative")
+	}
+	if p.DownloadLimitKiBps < 0 {
+		return errors.New("--pod-volume-download-limit must be non-negative")
+	}
+	if p.Nice < 0 || p.Nice > 19 {
+		return errors.New("--pod-volume-nice must be between 0 and 19")
+	}
+	return nil
+}
+
+// Settings returns the pod volume transfer settings specified by the flags, or
+// nil if none were specified.
+func (p *PodVolumeTransfer) Settings() *velerov1api.PodVolumeTransferSettings {
+	settings := &velerov1api.PodVolumeTransferSettings{
+		UploadLimitKiBps:   p.UploadLimitKiBps,
+		DownloadLimitKiBps: p.DownloadLimitKiBps,
+		Nice:               p.Nice,
+		IOPriorityClass:    velerov1api.IOPriorityClass(p.IOPriorityClass.String()),
+	}
+	if *settings == (velerov1api.PodVolumeTransferSettings{}) {
+		return nil
+	}
+	return settings
+}
@@ -35,6 +35,8 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
 	"github.com/vmware-tanzu/velero/pkg/features"
 	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -67,6 +69,9 @@ func DescribeBackup(
 			phaseString = color.GreenString(phaseString)
 		case velerov1api.BackupPhaseDeleting:
 		case velerov1api.BackupPhaseInProgress:
+		case velerov1api.BackupPhaseUploading:
+		case velerov1api.BackupPhaseUploadingPartialFailure:
+			phaseString = color.YellowString(phaseString)
 		case velerov1api.BackupPhaseNew:
 		}
 
@@ -158,9 +163,50 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 
 	d.Println()
 	d.Printf("Storage Location:\t%s\n", spec.StorageLocation)
+	if len(spec.MirrorStorageLocations) > 0 {
+		d.Printf("Mirror Storage Locations:\t%s\n", strings.Join(spec.MirrorStorageLocations, ", "))
+	}
 
 	d.Println()
 	d.Printf("Velero-Native Snapshot PVs:\t%s\n", BoolPointerString(spec.SnapshotVolumes, "false", "true", "auto"))
+	if boolptr.IsSetToTrue(spec.GroupVolumeSnapshotsByPod) {
+		d.Printf("Group Snapshots By Pod:\ttrue\n")
+	}
+	if spec.VolumePolicy != "" {
+		d.Printf("Volume Policy:\t%s\n", spec.VolumePolicy)
+	}
+
+	d.Println()
+	d.Printf("Uploader Type:\t%s\n", uploader.GetUploaderType(spec.UploaderType))
+	if transfer := spec.PodVolumeTransfer; transfer != nil {
+		d.Printf("Pod Volume Transfer:\n")
+		d.Printf("\tUpload Limit (KiB/s):\t%d\n", transfer.UploadLimitKiBps)
+		d.Printf("\tDownload Limit (KiB/s):\t%d\n", transfer.DownloadLimitKiBps)
+		d.Printf("\tNice:\t%d\n", transfer.Nice)
+		if transfer.IOPriorityClass != "" {
+			d.Printf("\tI/O Priority Class:\t%s\n", transfer.IOPriorityClass)
+		}
+	}
+	if len(spec.PodVolumePathFilters) > 0 {
+		d.Printf("Pod Volume Path Filters:\n")
+		for _, filter := range spec.PodVolumePathFilters {
+			s = "*"
+			if len(filter.Volumes) > 0 {
+				s = strings.Join(filter.Volumes, ", ")
+			}
+			d.Printf("\tVolumes:\t%s\n", s)
+			s = "*"
+			if len(filter.IncludedPaths) > 0 {
+				s = strings.Join(filter.IncludedPaths, ", ")
+			}
+			d.Printf("\t\tIncluded Paths:\t%s\n", s)
+			s = "<none>"
+			if len(filter.ExcludedPaths) > 0 {
+				s = strings.Join(filter.ExcludedPaths, ", ")
+			}
+			d.Printf("\t\tExcluded Paths:\t%s\n", s)
+		}
+	}
 
 	d.Println()
 	d.Printf("TTL:\t%s\n", spec.TTL.Duration)
@@ -269,6 +315,12 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 	// if the controller hasn't processed this Backup yet, in which case this will
 	// just display `<nil>`, though this should be temporary.
 	d.Printf("Expiration:\t%s\n", status.Expiration)
+	if status.RetainUntil != nil {
+		d.Printf("Retained Until:\t%s\n", status.RetainUntil)
+	}
+	if status.LegalHold {
+		d.Printf("Legal Hold:\tyes\n")
+	}
 	d.Println()
 
 	if backup.Status.Progress != nil {
@@ -280,6 +332,32 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 			d.Printf("Items backed up:\t%d\n", backup.Status.Progress.ItemsBackedUp)
 		}
 
+		if backup.Status.Phase == velerov1api.BackupPhaseUploading || backup.Status.Phase == velerov1api.BackupPhaseUploadingPartialFailure {
+			d.Printf("Volume snapshots uploading:\t%d\n", backup.Status.Progress.VolumeSnapshotsUploading)
+			d.Printf("Volume snapshot bytes uploaded:\t%d of %d\n", backup.Status.Progress.VolumeSnapshotBytesUploaded, backup.Status.Progress.VolumeSnapshotBytesTotal)
+		}
+
+		d.Println()
+	}
+
+	if len(status.Replicas) > 0 {
+		d.Printf("Copies in Mirror Storage Locations:\n")
+		for _, replica := range status.Replicas {
+			phase := replica.Phase
+			if phase == "" {
+				phase = velerov1api.BackupReplicaPhaseNew
+			}
+			d.Printf("\t%s:\t%s\n", replica.StorageLocation, phase)
+			if replica.FailureReason != "" {
+				d.Printf("\t\tFailure Reason:\t%s\n", replica.FailureReason)
+			}
+			if replica.Attempts > 0 {
+				d.Printf("\t\tAttempts:\t%d\n", replica.Attempts)
+			}
+			if replica.Warning != "" {
+				d.Printf("\t\tWarning:\t%s\n", replica.Warning)
+			}
+		}
 		d.Println()
 	}
 
@@ -309,6 +387,9 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 		d.Printf("Velero-Native Snapshots:\n")
 		for _, snap := range snapshots {
 			describeSnapshot(d, snap.Spec.PersistentVolumeName, snap.Status.ProviderSnapshotID, snap.Spec.VolumeType, snap.Spec.VolumeAZ, snap.Spec.VolumeIOPS)
+			if snap.Status.Copy != nil {
+				d.Printf("\t\tCopy Snapshot ID:\t%s (%s)\n", snap.Status.Copy.ProviderSnapshotID, snap.Status.Copy.Phase)
+			}
 		}
 		return
 	}
@@ -381,6 +462,11 @@ func DescribeDeleteBackupRequests(d *Describer, requests []velerov1api.DeleteBac
 		}
 
 		d.Printf("\t%s: %s\n", req.CreationTimestamp.String(), req.Status.Phase)
+		if req.Status.LegalHold {
+			d.Printf("\tWaiting for the legal hold on the backup's objects to be removed\n")
+		} else if req.Status.LockedUntil != nil {
+			d.Printf("\tWaiting for the backup's objects to be unlocked at %s\n", req.Status.LockedUntil)
+		}
 		if len(req.Status.Errors) > 0 {
 			d.Printf("\tErrors:\n")
 			for _, err := range req.Status.Errors {
@@ -416,6 +502,7 @@ func DescribePodVolumeBackups(d *Describer, backups []velerov1api.PodVolumeBacku
 		string(velerov1api.PodVolumeBackupPhaseCompleted),
 		string(velerov1api.PodVolumeBackupPhaseFailed),
 		"In Progress",
+		string(velerov1api.PodVolumeBackupPhaseQueued),
 		string(velerov1api.PodVolumeBackupPhaseNew),
 	} {
 		if len(backupsByPhase[phase]) == 0 {
@@ -452,6 +539,7 @@ func groupByPhase(backups []velerov1api.PodVolumeBackup) map[string][]velerov1ap
 		velerov1api.PodVolumeBackupPhaseCompleted:  string(velerov1api.PodVolumeBackupPhaseCompleted),
 		velerov1api.PodVolumeBackupPhaseFailed:     string(velerov1api.PodVolumeBackupPhaseFailed),
 		velerov1api.PodVolumeBackupPhaseInProgress: "In Progress",
+		velerov1api.PodVolumeBackupPhaseQueued:     string(velerov1api.PodVolumeBackupPhaseQueued),
 		velerov1api.PodVolumeBackupPhaseNew:        string(velerov1api.PodVolumeBackupPhaseNew),
 		"":                                         string(velerov1api.PodVolumeBackupPhaseNew),
 	}
@@ -18,6 +18,7 @@ package output
 
 import (
 	"sort"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -30,6 +31,9 @@ var (
 		// https://github.com/kubernetes/kubernetes/blob/v1.15.3/pkg/printers/tableprinter.go#L204
 		{Name: "Name", Type: "string", Format: "name"},
 		{Name: "Kind"},
+		{Name: "API Version"},
+		{Name: "Features"},
+		{Name: "Ready"},
 	}
 )
 
@@ -57,7 +61,26 @@ func sortByKindAndName(plugins []velerov1api.PluginInfo) {
 func printPlugin(plugin velerov1api.PluginInfo) []metav1.TableRow {
 	row := metav1.TableRow{}
 
-	row.Cells = append(row.Cells, plugin.Name, plugin.Kind)
+	apiVersion := plugin.APIVersion
+	if apiVersion == "" {
+		apiVersion = "<unknown>"
+	}
+
+	features := "<none>"
+	if len(plugin.Features) > 0 {
+		features = strings.Join(plugin.Features, ",")
+	}
+
+	ready := "<unknown>"
+	if plugin.Ready != nil {
+		if *plugin.Ready {
+			ready = "true"
+		} else {
+			ready = "false: " + plugin.Message
+		}
+	}
+
+	row.Cells = append(row.Cells, plugin.Name, plugin.Kind, apiVersion, features, ready)
 
 	return []metav1.TableRow{row}
 }
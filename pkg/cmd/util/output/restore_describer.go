@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -143,6 +144,35 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 		d.Println()
 		d.Printf("Restore PVs:\t%s\n", BoolPointerString(restore.Spec.RestorePVs, "false", "true", "auto"))
 
+		if volumeMapping := restore.Spec.PersistentVolumeMapping; volumeMapping != nil {
+			d.Println()
+			d.DescribeMap("Volume availability zone mappings", volumeMapping.AvailabilityZones)
+			d.DescribeMap("Volume type mappings", volumeMapping.VolumeTypes)
+			iops := make(map[string]string, len(volumeMapping.IOPS))
+			for volumeType, value := range volumeMapping.IOPS {
+				iops[volumeType] = strconv.FormatInt(value, 10)
+			}
+			d.DescribeMap("Volume IOPS", iops)
+			d.Printf("Restore from snapshot copies:\t%s\n", BoolPointerString(volumeMapping.FromSnapshotCopies, "false", "true", "false"))
+		}
+
+		if restore.Spec.PodVolumeRestoreMode != "" {
+			d.Println()
+			d.Printf("Pod Volume Restore Mode:\t%s\n", restore.Spec.PodVolumeRestoreMode)
+		}
+
+		if fileRestore := restore.Spec.PodVolumeFileRestore; fileRestore != nil {
+			d.Println()
+			d.Printf("Pod Volume File Restore:\n")
+			d.Printf("\tPod Volume:\t%s/%s/%s\n", fileRestore.Namespace, fileRestore.Pod, fileRestore.Volume)
+			s = "*"
+			if len(fileRestore.Paths) > 0 {
+				s = strings.Join(fileRestore.Paths, ", ")
+			}
+			d.Printf("\tPaths:\t%s\n", s)
+			d.Printf("\tTarget PVC:\t%s\n", fileRestore.TargetPVC)
+		}
+
 		if len(podVolumeRestores) > 0 {
 			d.Println()
 			describePodVolumeRestores(d, podVolumeRestores, details)
@@ -151,6 +181,16 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 		d.Println()
 		d.Printf("Preserve Service NodePorts:\t%s\n", BoolPointerString(restore.Spec.PreserveNodePorts, "false", "true", "auto"))
 
+		d.Println()
+		s = "false"
+		if restore.Spec.WaitForReadiness != nil {
+			s = "true"
+			if restore.Spec.WaitForReadiness.Timeout.Duration > 0 {
+				s = fmt.Sprintf("true (timeout %s)", restore.Spec.WaitForReadiness.Timeout.Duration)
+			}
+		}
+		d.Printf("Wait for readiness:\t%s\n", s)
+
 	})
 }
 
@@ -30,18 +30,22 @@ import (
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 
 	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1beta1"
 	snapshotv1beta1listers "github.com/kubernetes-csi/external-snapshotter/client/v4/listers/volumesnapshot/v1beta1"
 
 	"github.com/vmware-tanzu/velero/internal/storage"
+	"github.com/vmware-tanzu/velero/internal/volumepolicy"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
@@ -53,6 +57,10 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
@@ -84,6 +92,8 @@ type backupController struct {
 	formatFlag                  logging.Format
 	volumeSnapshotLister        snapshotv1beta1listers.VolumeSnapshotLister
 	volumeSnapshotContentLister snapshotv1beta1listers.VolumeSnapshotContentLister
+	snapshotUploadTimeout       time.Duration
+	snapshotUploadPollInterval  time.Duration
 }
 
 func NewBackupController(
@@ -106,6 +116,7 @@ func NewBackupController(
 	volumeSnapshotLister snapshotv1beta1listers.VolumeSnapshotLister,
 	volumeSnapshotContentLister snapshotv1beta1listers.VolumeSnapshotContentLister,
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	snapshotUploadTimeout time.Duration,
 ) Interface {
 	c := &backupController{
 		genericController:           newGenericController(Backup, logger),
@@ -128,6 +139,8 @@ func NewBackupController(
 		volumeSnapshotLister:        volumeSnapshotLister,
 		volumeSnapshotContentLister: volumeSnapshotContentLister,
 		backupStoreGetter:           backupStoreGetter,
+		snapshotUploadTimeout:       snapshotUploadTimeout,
+		snapshotUploadPollInterval:  10 * time.Second,
 	}
 
 	c.syncHandler = c.processBackup
@@ -141,12 +154,14 @@ func NewBackupController(
 
 				switch backup.Status.Phase {
 				case "", velerov1api.BackupPhaseNew:
-					// only process new backups
+					// only process new backups, and backups whose volume snapshot data
+					// is still being uploaded, e.g. when the server restarts
+				case velerov1api.BackupPhaseUploading, velerov1api.BackupPhaseUploadingPartialFailure:
 				default:
 					c.logger.WithFields(logrus.Fields{
 						"backup": kubeutil.NamespaceAndName(backup),
 						"phase":  backup.Status.Phase,
-					}).Debug("Backup is not new, skipping")
+					}).Debug("Backup is not new or uploading, skipping")
 					return
 				}
 
@@ -235,6 +250,8 @@ func (c *backupController) processBackup(key string) error {
 	switch original.Status.Phase {
 	case "", velerov1api.BackupPhaseNew:
 		// only process new backups
+	case velerov1api.BackupPhaseUploading, velerov1api.BackupPhaseUploadingPartialFailure:
+		return c.processUploadingBackup(key, original)
 	default:
 		return nil
 	}
@@ -262,8 +279,14 @@ func (c *backupController) processBackup(key string) error {
 		return nil
 	}
 
+	// the backup stays tracked while its volume snapshot data is uploaded, until
+	// processUploadingBackup finishes it
 	c.backupTracker.Add(request.Namespace, request.Name)
-	defer c.backupTracker.Delete(request.Namespace, request.Name)
+	defer func() {
+		if !isUploading(request.Backup) {
+			c.backupTracker.Delete(request.Namespace, request.Name)
+		}
+	}()
 
 	log.Debug("Running backup")
 
@@ -298,9 +321,18 @@ func (c *backupController) processBackup(key string) error {
 		log.WithError(err).Error("error updating backup's final status")
 	}
 
+	if isUploading(request.Backup) {
+		c.queue.AddAfter(key, c.snapshotUploadPollInterval)
+	}
+
 	return nil
 }
 
+// isUploading returns whether the data of the backup's volume snapshots is still being uploaded.
+func isUploading(backup *velerov1api.Backup) bool {
+	return backup.Status.Phase == velerov1api.BackupPhaseUploading || backup.Status.Phase == velerov1api.BackupPhaseUploadingPartialFailure
+}
+
 func patchBackup(original, updated *velerov1api.Backup, client velerov1client.BackupsGetter) (*velerov1api.Backup, error) {
 	origBytes, err := json.Marshal(original)
 	if err != nil {
@@ -392,6 +424,40 @@ func (c *backupController) prepareBackupRequest(backup *velerov1api.Backup) *pkg
 		}
 	}
 
+	// validate the mirror storage locations, and record a replica for each
+	// of them to be copied once the backup completes
+	request.Status.Replicas = nil
+	mirrors := sets.NewString()
+	for _, name := range request.Spec.MirrorStorageLocations {
+		if name == request.Spec.StorageLocation || mirrors.Has(name) {
+			continue
+		}
+		mirrors.Insert(name)
+
+		location := &velerov1api.BackupStorageLocation{}
+		if err := c.kbClient.Get(context.Background(), kbclient.ObjectKey{
+			Namespace: request.Namespace,
+			Name:      name,
+		}, location); err != nil {
+			if apierrors.IsNotFound(err) {
+				request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("a BackupStorageLocation with the name %s specified as a mirror storage location needs to be created before this backup can be executed", name))
+			} else {
+				request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("error getting mirror storage location %s: %v", name, err))
+			}
+			continue
+		}
+		if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors,
+				fmt.Sprintf("backup can't be copied to mirror storage location %s because it is currently in read-only mode", name))
+			continue
+		}
+
+		request.Status.Replicas = append(request.Status.Replicas, velerov1api.BackupReplicaStatus{
+			StorageLocation: name,
+			Phase:           velerov1api.BackupReplicaPhaseNew,
+		})
+	}
+
 	// add the storage location as a label for easy filtering later.
 	if request.Labels == nil {
 		request.Labels = make(map[string]string)
@@ -428,6 +494,40 @@ func (c *backupController) prepareBackupRequest(backup *velerov1api.Backup) *pkg
 		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded namespace lists: %v", err))
 	}
 
+	// validate the uploader type
+	if err := uploader.ValidateUploaderType(request.Spec.UploaderType); err != nil {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, err.Error())
+	}
+
+	// validate the pod volume path filters
+	for _, filter := range request.Spec.PodVolumePathFilters {
+		if err := restic.ValidatePathPatterns(filter.IncludedPaths); err != nil {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid pod volume included paths: %v", err))
+		}
+		if err := restic.ValidatePathPatterns(filter.ExcludedPaths); err != nil {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid pod volume excluded paths: %v", err))
+		}
+	}
+
+	// get and validate the volume policy, and store it on the request
+	if request.Spec.VolumePolicy != "" {
+		volumePolicy := &velerov1api.VolumePolicy{}
+		if err := c.kbClient.Get(context.Background(), kbclient.ObjectKey{
+			Namespace: request.Namespace,
+			Name:      request.Spec.VolumePolicy,
+		}, volumePolicy); err != nil {
+			if apierrors.IsNotFound(err) {
+				request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("a VolumePolicy with the name %s specified in the backup spec needs to be created before this backup can be executed", request.Spec.VolumePolicy))
+			} else {
+				request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("error getting volume policy named %s: %v", request.Spec.VolumePolicy, err))
+			}
+		} else if policy, err := volumepolicy.New(volumePolicy); err != nil {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("invalid volume policy %s: %v", request.Spec.VolumePolicy, err))
+		} else {
+			request.VolumePolicy = policy
+		}
+	}
+
 	return request
 }
 
@@ -530,7 +630,7 @@ func (c *backupController) validateAndGetSnapshotLocations(backup *velerov1api.B
 // runBackup runs and uploads a validated backup. Any error returned from this function
 // causes the backup to be Failed; if no error is returned, the backup's status's Errors
 // field is checked to see if the backup was a partial failure.
-func (c *backupController) runBackup(backup *pkgbackup.Request) error {
+func (c *backupController) runBackup(backup *pkgbackup.Request) (err error) {
 	c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).Info("Setting up backup log")
 
 	logFile, err := ioutil.TempFile("", "")
@@ -551,7 +651,15 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	logCounter := logging.NewLogCounterHook()
 	logger.Hooks.Add(logCounter)
 
-	backupLog := logger.WithField(Backup, kubeutil.NamespaceAndName(backup))
+	// the calls to the backup's actions are canceled once the backup is done with them
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctx, span := tracing.Tracer().Start(ctx, "Backup", trace.WithAttributes(attribute.String("backup", kubeutil.NamespaceAndName(backup))))
+	defer func() { tracing.EndSpan(span, err) }()
+
+	// the ID of the backup's trace is logged with each of its log entries
+	backupLog := tracing.WithTraceID(ctx, logger.WithField(Backup, kubeutil.NamespaceAndName(backup)))
 
 	backupLog.Info("Setting up backup temp file")
 	backupFile, err := ioutil.TempFile("", "")
@@ -565,7 +673,7 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	defer pluginManager.CleanupClients()
 
 	backupLog.Info("Getting backup item actions")
-	actions, err := pluginManager.GetBackupItemActions()
+	actions, err := pluginManager.GetBackupItemActionsV2()
 	if err != nil {
 		return err
 	}
@@ -586,9 +694,36 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 		return errors.Errorf("backup already exists in object storage")
 	}
 
+	// Stream the backup tarball to object storage as it's written if the object store supports
+	// streaming uploads, so that the tarball doesn't need to fit on local disk. Otherwise, write
+	// it to the temp file, to be uploaded once the backup is complete.
+	contentsReader, contentsWriter := io.Pipe()
+	contentsStreamed := true
+	contentsUploaded := make(chan error, 1)
+	go func() {
+		err := backupStore.PutBackupContents(backup.Name, contentsReader)
+		if err == velero.ErrObjectStreamingNotSupported {
+			backupLog.Info("Object store doesn't support streaming uploads, writing backup tarball to temp file")
+			contentsStreamed = false
+			_, err = io.Copy(backupFile, contentsReader)
+		}
+		// unblock the backupper if the upload ended before the tarball was completely written
+		contentsReader.CloseWithError(errors.Wrap(err, "error uploading backup tarball"))
+		contentsUploaded <- err
+	}()
+
 	var fatalErrs []error
-	if err := c.backupper.Backup(backupLog, backup, backupFile, actions, pluginManager); err != nil {
+	backupContents := &countingWriter{writer: contentsWriter}
+	if err := c.backupper.Backup(ctx, backupLog, backup, backupContents, actions, pluginManager); err != nil {
 		fatalErrs = append(fatalErrs, err)
+		// abort the upload, so that the incomplete tarball isn't stored
+		contentsWriter.CloseWithError(err)
+		<-contentsUploaded
+	} else {
+		contentsWriter.Close()
+		if err := <-contentsUploaded; err != nil {
+			fatalErrs = append(fatalErrs, errors.Wrap(err, "error uploading backup tarball"))
+		}
 	}
 
 	// Empty slices here so that they can be passed in to the persistBackup call later, regardless of whether or not CSI's enabled.
@@ -616,18 +751,29 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 		}
 	}
 
-	// Mark completion timestamp before serializing and uploading.
-	// Otherwise, the JSON file in object storage has a CompletionTimestamp of 'null'.
-	backup.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
-
-	backup.Status.VolumeSnapshotsAttempted = len(backup.VolumeSnapshots)
-	for _, snap := range backup.VolumeSnapshots {
-		if snap.Status.Phase == volume.SnapshotPhaseCompleted {
-			backup.Status.VolumeSnapshotsCompleted++
+	// Volume snapshotters may keep uploading the data of snapshots after they've been taken. If
+	// they are, the backup is finished by processUploadingBackup once they're done, which decides
+	// whether the snapshots completed and copies them.
+	var uploading bool
+	if len(fatalErrs) == 0 {
+		uploading = c.startSnapshotUploads(backup, pluginManager, logCounter, backupLog)
+		if !uploading {
+			c.copySnapshots(backup, pluginManager, backupLog)
 		}
 	}
 
-	recordBackupMetrics(backupLog, backup.Backup, backupFile, c.metrics)
+	backup.Status.VolumeSnapshotsAttempted = len(backup.VolumeSnapshots)
+
+	if uploading {
+		c.metrics.SetBackupTarballSizeBytesGauge(backup.GetLabels()[velerov1api.ScheduleNameLabel], backupContents.written)
+	} else {
+		// Mark completion timestamp before serializing and uploading.
+		// Otherwise, the JSON file in object storage has a CompletionTimestamp of 'null'.
+		backup.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+		backup.Status.VolumeSnapshotsCompleted = countCompletedSnapshots(backup.VolumeSnapshots)
+
+		recordBackupMetrics(backupLog, backup.Backup, backupContents.written, c.metrics)
+	}
 
 	if err := gzippedLogFile.Close(); err != nil {
 		c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).WithError(err).Error("error closing gzippedLogFile")
@@ -635,6 +781,13 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 
 	backup.Status.Warnings = logCounter.GetCount(logrus.WarnLevel)
 	backup.Status.Errors = logCounter.GetCount(logrus.ErrorLevel)
+	if uploading {
+		// the warnings and errors of the uploads are counted from the volume snapshots' status
+		// once they're done, so that they're counted once however often the uploads are checked
+		warnings, errs := countSnapshotUploadIssues(backup.VolumeSnapshots)
+		backup.Status.Warnings -= warnings
+		backup.Status.Errors -= errs
+	}
 
 	// Assign finalize phase as close to end as possible so that any errors
 	// logged to backupLog are captured. This is done before uploading the
@@ -643,6 +796,8 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	switch {
 	case len(fatalErrs) > 0:
 		backup.Status.Phase = velerov1api.BackupPhaseFailed
+	case uploading:
+		// the phase was set by startSnapshotUploads
 	case logCounter.GetCount(logrus.ErrorLevel) > 0:
 		backup.Status.Phase = velerov1api.BackupPhasePartiallyFailed
 	default:
@@ -657,27 +812,516 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 		return err
 	}
 
-	if errs := persistBackup(backup, backupFile, logFile, backupStore, c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)), volumeSnapshots, volumeSnapshotContents); len(errs) > 0 {
+	// the tarball only needs to be uploaded with the rest of the backup if it wasn't streamed
+	var persistedContents io.Reader
+	if !contentsStreamed {
+		persistedContents = backupFile
+	}
+
+	// record how the backup's objects are locked before they're put, so that
+	// the backup's metadata in object storage records it too, and the objects
+	// are locked until exactly the recorded time
+	if objectLock := backup.StorageLocation.Spec.ObjectLock; objectLock != nil {
+		if objectLock.RetentionPeriod != nil && objectLock.RetentionPeriod.Duration > 0 {
+			backup.Status.RetainUntil = &metav1.Time{Time: c.clock.Now().Add(objectLock.RetentionPeriod.Duration)}
+		}
+		backup.Status.LegalHold = objectLock.LegalHold
+	}
+
+	_, persistSpan := tracing.Tracer().Start(ctx, "PersistBackup")
+	errs := persistBackup(backup, persistedContents, logFile, backupStore, c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)), volumeSnapshots, volumeSnapshotContents)
+	tracing.EndSpan(persistSpan, kerrors.NewAggregate(errs))
+	if len(errs) > 0 {
 		fatalErrs = append(fatalErrs, errs...)
+
+		// the backup's metadata wasn't stored, so the streamed tarball would be left behind
+		// without a backup in object storage
+		if contentsStreamed {
+			if err := backupStore.DeleteBackupContents(backup.Name); err != nil {
+				backupLog.WithError(err).Error("Error deleting streamed backup tarball")
+			}
+		}
 	}
 
-	c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).Info("Backup completed")
+	if uploading {
+		c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).Info("Backup items completed, waiting for volume snapshot data to be uploaded")
+	} else {
+		c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).Info("Backup completed")
+	}
 
 	// if we return a non-nil error, the calling function will update
 	// the backup's phase to Failed.
 	return kerrors.NewAggregate(fatalErrs)
 }
 
-func recordBackupMetrics(log logrus.FieldLogger, backup *velerov1api.Backup, backupFile *os.File, serverMetrics *metrics.ServerMetrics) {
-	backupScheduleName := backup.GetLabels()[velerov1api.ScheduleNameLabel]
+// uploadingSnapshot is a completed volume snapshot whose data may still be being uploaded by
+// its volume snapshotter.
+type uploadingSnapshot struct {
+	snapshot *volume.Snapshot
+	reporter velero.SnapshotProgressReporter
+	progress *velero.SnapshotProgress
 
-	var backupSizeBytes int64
-	if backupFileStat, err := backupFile.Stat(); err != nil {
-		log.WithError(errors.WithStack(err)).Error("Error getting backup file info")
+	// statusChanged is whether checking the upload changed the snapshot's status, which then
+	// needs to be persisted
+	statusChanged bool
+}
+
+// uploadingSnapshots returns the backup's completed volume snapshots whose volume snapshotter
+// reports the upload progress of their data.
+func (c *backupController) uploadingSnapshots(backup *pkgbackup.Request, pluginManager clientmgmt.Manager, log logrus.FieldLogger) []*uploadingSnapshot {
+	var snapshots []*uploadingSnapshot
+	snapshotters := make(map[string]velero.VolumeSnapshotter)
+	for _, snapshot := range backup.VolumeSnapshots {
+		if snapshot.Status.Phase != volume.SnapshotPhaseCompleted {
+			continue
+		}
+
+		volumeSnapshotter, ok := snapshotters[snapshot.Spec.Location]
+		if !ok {
+			volumeSnapshotter = c.getVolumeSnapshotter(backup, snapshot.Spec.Location, pluginManager, log)
+			snapshotters[snapshot.Spec.Location] = volumeSnapshotter
+		}
+
+		if reporter, ok := volumeSnapshotter.(velero.SnapshotProgressReporter); ok {
+			snapshots = append(snapshots, &uploadingSnapshot{snapshot: snapshot, reporter: reporter})
+		}
+	}
+
+	return snapshots
+}
+
+// startSnapshotUploads checks whether the data of the backup's completed volume snapshots is
+// still being uploaded by their volume snapshotters. If it is, the backup is put in the Uploading
+// phase, or UploadingPartialFailure if errors have already been logged, its progress records the
+// uploads, and true is returned.
+func (c *backupController) startSnapshotUploads(backup *pkgbackup.Request, pluginManager clientmgmt.Manager, logCounter *logging.LogCounterHook, log logrus.FieldLogger) bool {
+	snapshots := c.uploadingSnapshots(backup, pluginManager, log)
+	pending := c.pollSnapshotUploads(snapshots, log)
+	if len(pending) == 0 {
+		return false
+	}
+
+	if logCounter.GetCount(logrus.ErrorLevel) > 0 {
+		backup.Status.Phase = velerov1api.BackupPhaseUploadingPartialFailure
+	} else {
+		backup.Status.Phase = velerov1api.BackupPhaseUploading
+	}
+	updateSnapshotUploadProgress(backup.Backup, snapshots, pending)
+	backup.Status.Progress.VolumeSnapshotUploadStartTimestamp = &metav1.Time{Time: c.clock.Now()}
+
+	log.Infof("Waiting for the data of %d volume snapshots to be uploaded", len(pending))
+	return true
+}
+
+// processUploadingBackup checks the upload progress of the volume snapshots of a backup in the
+// Uploading or UploadingPartialFailure phase. Once the data of all of them has been uploaded, or
+// the upload timeout has passed, it copies the snapshots and completes the backup. Until then,
+// the backup is requeued to be checked again after the poll interval.
+func (c *backupController) processUploadingBackup(key string, original *velerov1api.Backup) error {
+	// the backup isn't tracked yet if the server restarted while it was uploading
+	c.backupTracker.Add(original.Namespace, original.Name)
+
+	// what's logged while checking the uploads is appended to the backup's log as well
+	logEntries := new(bytes.Buffer)
+	logger := logging.DefaultLogger(c.backupLogLevel, c.formatFlag)
+	logger.Out = io.MultiWriter(os.Stdout, logEntries)
+	log := logger.WithField(Backup, kubeutil.NamespaceAndName(original))
+
+	pluginManager := c.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	request, backupStore, err := c.prepareUploadingBackupRequest(original, pluginManager, log)
+	if err != nil {
+		return err
+	}
+
+	snapshots := c.uploadingSnapshots(request, pluginManager, log)
+	pending := c.pollSnapshotUploads(snapshots, log)
+	updateSnapshotUploadProgress(request.Backup, snapshots, pending)
+
+	progress := request.Status.Progress
+	if progress.VolumeSnapshotUploadStartTimestamp == nil {
+		progress.VolumeSnapshotUploadStartTimestamp = &metav1.Time{Time: c.clock.Now()}
+	}
+
+	done := len(pending) == 0
+	if !done && c.clock.Now().Sub(progress.VolumeSnapshotUploadStartTimestamp.Time) >= c.snapshotUploadTimeout {
+		for _, s := range pending {
+			log.Errorf("Timed out waiting for the data of volume snapshot %s of persistent volume %s to be uploaded", s.snapshot.Status.ProviderSnapshotID, s.snapshot.Spec.PersistentVolumeName)
+			s.snapshot.Status.Phase = volume.SnapshotPhaseFailed
+			s.snapshot.Status.UploadFailureReason = "timed out waiting for the data to be uploaded"
+		}
+		done = true
+	}
+
+	if done {
+		c.copySnapshots(request, pluginManager, log)
+	}
+
+	warnings, errs := countSnapshotUploadIssues(request.VolumeSnapshots)
+	if errs > 0 {
+		request.Status.Phase = velerov1api.BackupPhaseUploadingPartialFailure
+	}
+
+	if !done {
+		// record the snapshots whose upload failed, so that they aren't checked again, and the
+		// errors getting the upload progress, so that each is only counted once
+		for _, s := range snapshots {
+			if s.statusChanged {
+				if err := putBackupVolumeSnapshots(request, backupStore); err != nil {
+					return err
+				}
+				break
+			}
+		}
+		c.appendBackupLog(request, backupStore, logEntries)
+
+		if _, err := patchBackup(original, request.Backup, c.client); err != nil {
+			return errors.Wrap(err, "error updating backup's snapshot upload progress")
+		}
+		c.queue.AddAfter(key, c.snapshotUploadPollInterval)
+		return nil
+	}
+
+	request.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+	request.Status.VolumeSnapshotsCompleted = countCompletedSnapshots(request.VolumeSnapshots)
+	request.Status.Warnings += warnings
+	request.Status.Errors += errs
+	if request.Status.Phase == velerov1api.BackupPhaseUploadingPartialFailure {
+		request.Status.Phase = velerov1api.BackupPhasePartiallyFailed
 	} else {
-		backupSizeBytes = backupFileStat.Size()
+		request.Status.Phase = velerov1api.BackupPhaseCompleted
+	}
+
+	c.appendBackupLog(request, backupStore, logEntries)
+	if err := persistUploadedBackup(request, backupStore); err != nil {
+		log.WithError(err).Error("Error persisting backup")
+		request.Status.Phase = velerov1api.BackupPhaseFailed
+	}
+
+	backupScheduleName := request.GetLabels()[velerov1api.ScheduleNameLabel]
+	switch request.Status.Phase {
+	case velerov1api.BackupPhaseCompleted:
+		c.metrics.RegisterBackupSuccess(backupScheduleName)
+	case velerov1api.BackupPhasePartiallyFailed:
+		c.metrics.RegisterBackupPartialFailure(backupScheduleName)
+	case velerov1api.BackupPhaseFailed:
+		c.metrics.RegisterBackupFailed(backupScheduleName)
+	}
+	recordBackupCompletionMetrics(request.Backup, c.metrics)
+
+	log.Debug("Updating backup's final status")
+	if _, err := patchBackup(original, request.Backup, c.client); err != nil {
+		return errors.Wrap(err, "error updating backup's final status")
+	}
+	c.backupTracker.Delete(request.Namespace, request.Name)
+
+	log.Info("Backup completed")
+	return nil
+}
+
+// prepareUploadingBackupRequest rebuilds the request of a backup whose volume snapshot data is
+// being uploaded from the backup, its storage location, and the volume snapshots in its backup
+// store, and returns it along with the backup store.
+func (c *backupController) prepareUploadingBackupRequest(backup *velerov1api.Backup, pluginManager clientmgmt.Manager, log logrus.FieldLogger) (*pkgbackup.Request, persistence.BackupStore, error) {
+	request := &pkgbackup.Request{
+		Backup:          backup.DeepCopy(), // don't modify items in the cache
+		StorageLocation: &velerov1api.BackupStorageLocation{},
+	}
+
+	if err := c.kbClient.Get(context.Background(), kbclient.ObjectKey{
+		Namespace: backup.Namespace,
+		Name:      backup.Spec.StorageLocation,
+	}, request.StorageLocation); err != nil {
+		return nil, nil, errors.Wrapf(err, "error getting backup storage location %s", backup.Spec.StorageLocation)
+	}
+
+	backupStore, err := c.backupStoreGetter.Get(request.StorageLocation, pluginManager, log)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if request.VolumeSnapshots, err = backupStore.GetBackupVolumeSnapshots(backup.Name); err != nil {
+		return nil, nil, errors.Wrap(err, "error getting backup's volume snapshots")
+	}
+
+	locations := sets.NewString()
+	for _, snapshot := range request.VolumeSnapshots {
+		if locations.Has(snapshot.Spec.Location) {
+			continue
+		}
+		locations.Insert(snapshot.Spec.Location)
+
+		location, err := c.snapshotLocationLister.VolumeSnapshotLocations(backup.Namespace).Get(snapshot.Spec.Location)
+		if err != nil {
+			log.WithError(err).Warnf("Error getting volume snapshot location %s", snapshot.Spec.Location)
+			continue
+		}
+		request.SnapshotLocations = append(request.SnapshotLocations, location)
+	}
+
+	return request, backupStore, nil
+}
+
+// updateSnapshotUploadProgress records the upload progress of the given volume snapshots, of
+// which the pending ones are still being uploaded, in the backup's progress.
+func updateSnapshotUploadProgress(backup *velerov1api.Backup, snapshots, pending []*uploadingSnapshot) {
+	if backup.Status.Progress == nil {
+		backup.Status.Progress = &velerov1api.BackupProgress{}
+	}
+
+	backup.Status.Progress.VolumeSnapshotsUploading = len(pending)
+	backup.Status.Progress.VolumeSnapshotBytesUploaded = 0
+	backup.Status.Progress.VolumeSnapshotBytesTotal = 0
+	for _, s := range snapshots {
+		if s.progress != nil {
+			backup.Status.Progress.VolumeSnapshotBytesUploaded += s.progress.BytesDone
+			backup.Status.Progress.VolumeSnapshotBytesTotal += s.progress.BytesTotal
+		}
+	}
+}
+
+// countCompletedSnapshots returns the number of the given volume snapshots that completed.
+func countCompletedSnapshots(snapshots []*volume.Snapshot) int {
+	var completed int
+	for _, snapshot := range snapshots {
+		if snapshot.Status.Phase == volume.SnapshotPhaseCompleted {
+			completed++
+		}
 	}
-	serverMetrics.SetBackupTarballSizeBytesGauge(backupScheduleName, backupSizeBytes)
+	return completed
+}
+
+// countSnapshotUploadIssues returns the number of warnings and errors of uploading the data of
+// the given volume snapshots and copying them, from the snapshots' status: a warning for each
+// snapshot whose upload progress couldn't be gotten, and an error for each snapshot whose
+// upload or copy failed.
+func countSnapshotUploadIssues(snapshots []*volume.Snapshot) (warnings, errs int) {
+	for _, snapshot := range snapshots {
+		if snapshot.Status.UploadProgressError != "" {
+			warnings++
+		}
+		if snapshot.Status.UploadFailureReason != "" {
+			errs++
+		}
+		if snapshot.Status.Copy != nil && snapshot.Status.Copy.Phase == volume.SnapshotPhaseFailed {
+			errs++
+		}
+	}
+	return warnings, errs
+}
+
+// appendBackupLog appends the given log entries to the backup's log in its backup store. Failing
+// to do so is only logged, since the entries are logged to stdout as well.
+func (c *backupController) appendBackupLog(backup *pkgbackup.Request, backupStore persistence.BackupStore, entries *bytes.Buffer) {
+	if entries.Len() == 0 {
+		return
+	}
+
+	if err := appendGzippedLog(backup, backupStore, entries); err != nil {
+		c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).WithError(err).Error("Error appending to backup log")
+	}
+}
+
+// appendGzippedLog replaces the backup's gzipped log in its backup store with one that has the
+// given entries appended to it, as a second gzip member, which gzip readers read as a
+// continuation of the first.
+func appendGzippedLog(backup *pkgbackup.Request, backupStore persistence.BackupStore, entries io.Reader) error {
+	existing, err := backupStore.GetBackupLog(backup.Name)
+	if err != nil {
+		return errors.Wrap(err, "error getting backup log")
+	}
+	defer existing.Close()
+
+	log := new(bytes.Buffer)
+	if _, err := io.Copy(log, existing); err != nil {
+		return errors.Wrap(err, "error reading backup log")
+	}
+
+	gzippedEntries := gzip.NewWriter(log)
+	if _, err := io.Copy(gzippedEntries, entries); err != nil {
+		return errors.Wrap(err, "error compressing backup log entries")
+	}
+	if err := gzippedEntries.Close(); err != nil {
+		return errors.Wrap(err, "error compressing backup log entries")
+	}
+
+	return errors.Wrap(backupStore.PutBackupLog(backup.Name, bytes.NewReader(log.Bytes()), backupRetainUntil(backup.Backup)), "error uploading backup log")
+}
+
+// putBackupVolumeSnapshots replaces the list of the backup's volume snapshots in its backup store.
+func putBackupVolumeSnapshots(backup *pkgbackup.Request, backupStore persistence.BackupStore) error {
+	volumeSnapshots, errs := encodeToJSONGzip(backup.VolumeSnapshots, "native volumesnapshots list")
+	if len(errs) > 0 {
+		return kerrors.NewAggregate(errs)
+	}
+
+	return errors.Wrap(backupStore.PutBackupVolumeSnapshots(backup.Name, volumeSnapshots, backupRetainUntil(backup.Backup)), "error uploading native volumesnapshots list")
+}
+
+// persistUploadedBackup replaces the volume snapshots and metadata of a backup whose volume
+// snapshot data has been uploaded in its backup store, to record their final status.
+func persistUploadedBackup(backup *pkgbackup.Request, backupStore persistence.BackupStore) error {
+	if err := putBackupVolumeSnapshots(backup, backupStore); err != nil {
+		return err
+	}
+
+	backupJSON := new(bytes.Buffer)
+	if err := encode.EncodeTo(backup.Backup, "json", backupJSON); err != nil {
+		return errors.Wrap(err, "error encoding backup")
+	}
+
+	return errors.Wrap(backupStore.PutBackupMetadata(backup.Name, backupJSON, backupRetainUntil(backup.Backup)), "error uploading backup metadata")
+}
+
+// backupRetainUntil returns the time until which the backup's objects are
+// locked in object storage, or zero if it isn't known.
+func backupRetainUntil(backup *velerov1api.Backup) time.Time {
+	if backup.Status.RetainUntil == nil {
+		return time.Time{}
+	}
+	return backup.Status.RetainUntil.Time
+}
+
+// pollSnapshotUploads gets the upload progress of the given snapshots and returns the ones
+// that are still being uploaded. Snapshots whose volume snapshotter doesn't report progress
+// for them are considered uploaded, and snapshots whose upload failed are marked as failed.
+// The first error getting the progress of a snapshot and the reason its upload failed are
+// recorded in its status, from which they're counted.
+func (c *backupController) pollSnapshotUploads(snapshots []*uploadingSnapshot, log logrus.FieldLogger) []*uploadingSnapshot {
+	var pending []*uploadingSnapshot
+	for _, s := range snapshots {
+		log := log.WithField("persistentVolume", s.snapshot.Spec.PersistentVolumeName).WithField("snapshotID", s.snapshot.Status.ProviderSnapshotID)
+
+		progress, err := s.reporter.SnapshotProgress(s.snapshot.Status.ProviderSnapshotID)
+		switch {
+		case err == velero.ErrSnapshotProgressNotSupported:
+			continue
+		case err != nil:
+			if s.snapshot.Status.UploadProgressError == "" {
+				log.WithError(err).Warn("Error getting upload progress of volume snapshot")
+				s.snapshot.Status.UploadProgressError = err.Error()
+				s.statusChanged = true
+			} else {
+				log.WithError(err).Debug("Error getting upload progress of volume snapshot")
+			}
+			pending = append(pending, s)
+			continue
+		}
+
+		s.progress = progress
+		switch {
+		case progress.FailureReason != "":
+			log.Errorf("Error uploading the data of volume snapshot: %s", progress.FailureReason)
+			s.snapshot.Status.Phase = volume.SnapshotPhaseFailed
+			s.snapshot.Status.UploadFailureReason = progress.FailureReason
+			s.statusChanged = true
+		case progress.Completed:
+			log.Info("Volume snapshot data uploaded")
+		default:
+			pending = append(pending, s)
+		}
+	}
+
+	return pending
+}
+
+// copySnapshots copies each of the backup's completed volume snapshots whose volume snapshot
+// location has a secondary region or location to it, and records the copies in the snapshots'
+// status. Failing to copy a snapshot is logged as an error, but doesn't fail the snapshot.
+func (c *backupController) copySnapshots(backup *pkgbackup.Request, pluginManager clientmgmt.Manager, log logrus.FieldLogger) {
+	locations := make(map[string]*velerov1api.VolumeSnapshotLocation)
+	for _, location := range backup.SnapshotLocations {
+		locations[location.Name] = location
+	}
+
+	snapshotters := make(map[string]velero.VolumeSnapshotter)
+	for _, snapshot := range backup.VolumeSnapshots {
+		location := locations[snapshot.Spec.Location]
+		if snapshot.Status.Phase != volume.SnapshotPhaseCompleted || location == nil || location.Spec.Copy == nil {
+			continue
+		}
+
+		log := log.WithField("persistentVolume", snapshot.Spec.PersistentVolumeName).WithField("snapshotID", snapshot.Status.ProviderSnapshotID)
+
+		volumeSnapshotter, ok := snapshotters[location.Name]
+		if !ok {
+			volumeSnapshotter = c.getVolumeSnapshotter(backup, location.Name, pluginManager, log)
+			snapshotters[location.Name] = volumeSnapshotter
+		}
+
+		snapshot.Status.Copy = &volume.SnapshotCopyStatus{
+			Config: snapshotCopyConfig(location),
+			Phase:  volume.SnapshotPhaseFailed,
+		}
+
+		if volumeSnapshotter == nil {
+			log.Errorf("Unable to copy volume snapshot because the volume snapshotter for volume snapshot location %s isn't available", location.Name)
+			continue
+		}
+
+		err := velero.ErrCopySnapshotNotSupported
+		var copyID string
+		if copier, ok := volumeSnapshotter.(velero.SnapshotCopier); ok {
+			log.Info("Copying volume snapshot to secondary location")
+			copyID, err = copier.CopySnapshot(snapshot.Status.ProviderSnapshotID, snapshot.Spec.VolumeAZ, snapshot.Status.Copy.Config)
+		}
+		if err != nil {
+			log.WithError(err).Error("Error copying volume snapshot to secondary location")
+			continue
+		}
+
+		log.WithField("copySnapshotID", copyID).Info("Copied volume snapshot to secondary location")
+		snapshot.Status.Copy.ProviderSnapshotID = copyID
+		snapshot.Status.Copy.Phase = volume.SnapshotPhaseCompleted
+	}
+}
+
+// snapshotCopyConfig returns the provider config of the secondary region or location that
+// the volume snapshot location's snapshots are copied to, which is the location's config
+// with the copy config applied on top of it.
+func snapshotCopyConfig(location *velerov1api.VolumeSnapshotLocation) map[string]string {
+	config := make(map[string]string, len(location.Spec.Config)+len(location.Spec.Copy.Config))
+	for k, v := range location.Spec.Config {
+		config[k] = v
+	}
+	for k, v := range location.Spec.Copy.Config {
+		config[k] = v
+	}
+	return config
+}
+
+// getVolumeSnapshotter returns the initialized volume snapshotter of the backup's volume snapshot
+// location with the given name, or nil if it can't be found or initialized.
+func (c *backupController) getVolumeSnapshotter(backup *pkgbackup.Request, locationName string, pluginManager clientmgmt.Manager, log logrus.FieldLogger) velero.VolumeSnapshotter {
+	for _, location := range backup.SnapshotLocations {
+		if location.Name != locationName {
+			continue
+		}
+
+		volumeSnapshotter, err := pluginManager.GetVolumeSnapshotter(location.Spec.Provider)
+		if err != nil {
+			log.WithError(err).Warnf("Error getting volume snapshotter for volume snapshot location %s", locationName)
+			return nil
+		}
+		if err := volumeSnapshotter.Init(location.Spec.Config); err != nil {
+			log.WithError(err).Warnf("Error initializing volume snapshotter for volume snapshot location %s", locationName)
+			return nil
+		}
+		return volumeSnapshotter
+	}
+
+	return nil
+}
+
+func recordBackupMetrics(log logrus.FieldLogger, backup *velerov1api.Backup, backupSizeBytes int64, serverMetrics *metrics.ServerMetrics) {
+	serverMetrics.SetBackupTarballSizeBytesGauge(backup.GetLabels()[velerov1api.ScheduleNameLabel], backupSizeBytes)
+	recordBackupCompletionMetrics(backup, serverMetrics)
+}
+
+// recordBackupCompletionMetrics records the duration and volume snapshot metrics of a completed backup.
+func recordBackupCompletionMetrics(backup *velerov1api.Backup, serverMetrics *metrics.ServerMetrics) {
+	backupScheduleName := backup.GetLabels()[velerov1api.ScheduleNameLabel]
 
 	backupDuration := backup.Status.CompletionTimestamp.Time.Sub(backup.Status.StartTimestamp.Time)
 	backupDurationSeconds := float64(backupDuration / time.Second)
@@ -688,7 +1332,8 @@ func recordBackupMetrics(log logrus.FieldLogger, backup *velerov1api.Backup, bac
 }
 
 func persistBackup(backup *pkgbackup.Request,
-	backupContents, backupLog *os.File,
+	backupContents io.Reader,
+	backupLog *os.File,
 	backupStore persistence.BackupStore,
 	log logrus.FieldLogger,
 	csiVolumeSnapshots []*snapshotv1beta1api.VolumeSnapshot,
@@ -747,6 +1392,7 @@ func persistBackup(backup *pkgbackup.Request,
 		BackupResourceList:        backupResourceList,
 		CSIVolumeSnapshots:        csiSnapshotJSON,
 		CSIVolumeSnapshotContents: csiSnapshotContentsJSON,
+		RetainUntil:               backupRetainUntil(backup.Backup),
 	}
 	if err := backupStore.PutBackup(backupInfo); err != nil {
 		persistErrs = append(persistErrs, err)
@@ -768,6 +1414,19 @@ func closeAndRemoveFile(file *os.File, log logrus.FieldLogger) {
 	}
 }
 
+// countingWriter is an io.Writer that counts the bytes written to the
+// underlying writer.
+type countingWriter struct {
+	writer  io.Writer
+	written int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	w.written += int64(n)
+	return n, err
+}
+
 // encodeToJSONGzip takes arbitrary Go data and encodes it to GZip compressed JSON in a buffer, as well as a description of the data to put into an error should encoding fail.
 func encodeToJSONGzip(data interface{}, desc string) (*bytes.Buffer, []error) {
 	buf := new(bytes.Buffer)
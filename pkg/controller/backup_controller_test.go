@@ -18,9 +18,12 @@ package controller
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 
 	"sort"
 	"strings"
@@ -33,6 +36,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apimachinery/pkg/version"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -49,16 +53,18 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
+	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
 type fakeBackupper struct {
 	mock.Mock
 }
 
-func (b *fakeBackupper) Backup(logger logrus.FieldLogger, backup *pkgbackup.Request, backupFile io.Writer, actions []velero.BackupItemAction, volumeSnapshotterGetter pkgbackup.VolumeSnapshotterGetter) error {
+func (b *fakeBackupper) Backup(ctx context.Context, logger logrus.FieldLogger, backup *pkgbackup.Request, backupFile io.Writer, actions []velero.BackupItemActionV2, volumeSnapshotterGetter pkgbackup.VolumeSnapshotterGetter) error {
 	args := b.Called(logger, backup, backupFile, actions, volumeSnapshotterGetter)
 	return args.Error(0)
 }
@@ -138,8 +144,10 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 	tests := []struct {
 		name           string
 		backup         *velerov1api.Backup
-		backupLocation *velerov1api.BackupStorageLocation
-		expectedErrs   []string
+		backupLocation  *velerov1api.BackupStorageLocation
+		mirrorLocations []*velerov1api.BackupStorageLocation
+		volumePolicy    *velerov1api.VolumePolicy
+		expectedErrs    []string
 	}{
 		{
 			name:           "invalid included/excluded resources fails validation",
@@ -164,6 +172,47 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 			backupLocation: builder.ForBackupStorageLocation("velero", "read-only").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
 			expectedErrs:   []string{"backup can't be created because backup storage location read-only is currently in read-only mode"},
 		},
+		{
+			name:           "non-existent mirror storage location fails validation",
+			backup:         defaultBackup().MirrorStorageLocations("nonexistent").Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{"a BackupStorageLocation with the name nonexistent specified as a mirror storage location needs to be created before this backup can be executed"},
+		},
+		{
+			name:            "read-only mirror storage location fails validation",
+			backup:          defaultBackup().MirrorStorageLocations("read-only").Result(),
+			backupLocation:  defaultBackupLocation,
+			mirrorLocations: []*velerov1api.BackupStorageLocation{builder.ForBackupStorageLocation("velero", "read-only").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result()},
+			expectedErrs:    []string{"backup can't be copied to mirror storage location read-only because it is currently in read-only mode"},
+		},
+		{
+			name: "invalid pod volume path filters fail validation",
+			backup: defaultBackup().PodVolumePathFilters(velerov1api.PodVolumePathFilter{
+				IncludedPaths: []string{"[data"},
+				ExcludedPaths: []string{"../cache"},
+			}).Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs: []string{
+				`Invalid pod volume included paths: invalid path pattern "[data": syntax error in pattern`,
+				`Invalid pod volume excluded paths: path pattern "../cache" must not refer to a parent directory`,
+			},
+		},
+		{
+			name:           "non-existent volume policy fails validation",
+			backup:         defaultBackup().VolumePolicy("nonexistent").Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{"a VolumePolicy with the name nonexistent specified in the backup spec needs to be created before this backup can be executed"},
+		},
+		{
+			name:           "invalid volume policy fails validation",
+			backup:         defaultBackup().VolumePolicy("policy-1").Result(),
+			backupLocation: defaultBackupLocation,
+			volumePolicy: builder.ForVolumePolicy("velero", "policy-1").Rules(velerov1api.VolumePolicyRule{
+				Conditions: velerov1api.VolumePolicyConditions{VolumeTypes: []string{"tape"}},
+				Action:     velerov1api.VolumePolicyActionSkip,
+			}).Result(),
+			expectedErrs: []string{`invalid volume policy policy-1: rule 0 has invalid volume type "tape"`},
+		},
 	}
 
 	for _, test := range tests {
@@ -179,12 +228,17 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 			discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
 			require.NoError(t, err)
 
-			var fakeClient kbclient.Client
+			var objs []runtime.Object
 			if test.backupLocation != nil {
-				fakeClient = velerotest.NewFakeControllerRuntimeClient(t, test.backupLocation)
-			} else {
-				fakeClient = velerotest.NewFakeControllerRuntimeClient(t)
+				objs = append(objs, test.backupLocation)
+			}
+			for _, location := range test.mirrorLocations {
+				objs = append(objs, location)
+			}
+			if test.volumePolicy != nil {
+				objs = append(objs, test.volumePolicy)
 			}
+			fakeClient := velerotest.NewFakeControllerRuntimeClient(t, objs...)
 
 			c := &backupController{
 				genericController:      newGenericController("backup-test", logger),
@@ -272,6 +326,46 @@ func TestBackupLocationLabel(t *testing.T) {
 	}
 }
 
+func TestBackupMirrorStorageLocations(t *testing.T) {
+	formatFlag := logging.FormatText
+
+	var (
+		backup          = defaultBackup().StorageLocation("loc-1").MirrorStorageLocations("loc-2", "loc-1", "loc-3", "loc-2").Result()
+		clientset       = fake.NewSimpleClientset(backup)
+		sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+		logger          = logging.DefaultLogger(logrus.DebugLevel, formatFlag)
+		fakeClient      = velerotest.NewFakeControllerRuntimeClient(t,
+			builder.ForBackupStorageLocation("velero", "loc-1").Result(),
+			builder.ForBackupStorageLocation("velero", "loc-2").Result(),
+			builder.ForBackupStorageLocation("velero", "loc-3").Result(),
+		)
+	)
+
+	apiServer := velerotest.NewAPIServer(t)
+	discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
+	require.NoError(t, err)
+
+	c := &backupController{
+		genericController:      newGenericController("backup-test", logger),
+		discoveryHelper:        discoveryHelper,
+		client:                 clientset.VeleroV1(),
+		lister:                 sharedInformers.Velero().V1().Backups().Lister(),
+		kbClient:               fakeClient,
+		snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+		defaultBackupLocation:  "loc-1",
+		clock:                  &clock.RealClock{},
+		formatFlag:             formatFlag,
+	}
+
+	// the backup's own storage location and duplicates don't get replicas
+	res := c.prepareBackupRequest(backup)
+	assert.Empty(t, res.Status.ValidationErrors)
+	assert.Equal(t, []velerov1api.BackupReplicaStatus{
+		{StorageLocation: "loc-2", Phase: velerov1api.BackupReplicaPhaseNew},
+		{StorageLocation: "loc-3", Phase: velerov1api.BackupReplicaPhaseNew},
+	}, res.Status.Replicas)
+}
+
 func TestDefaultBackupTTL(t *testing.T) {
 	var (
 		defaultBackupTTL = metav1.Duration{Duration: 24 * 30 * time.Hour}
@@ -823,10 +917,11 @@ func TestProcessBackupCompletions(t *testing.T) {
 				formatFlag:             formatFlag,
 			}
 
-			pluginManager.On("GetBackupItemActions").Return(nil, nil)
+			pluginManager.On("GetBackupItemActionsV2").Return(nil, nil)
 			pluginManager.On("CleanupClients").Return(nil)
-			backupper.On("Backup", mock.Anything, mock.Anything, mock.Anything, []velero.BackupItemAction(nil), pluginManager).Return(nil)
+			backupper.On("Backup", mock.Anything, mock.Anything, mock.Anything, []velero.BackupItemActionV2(nil), pluginManager).Return(nil)
 			backupStore.On("BackupExists", test.backupLocation.Spec.StorageType.ObjectStorage.Bucket, test.backup.Name).Return(test.backupExists, test.existenceCheckError)
+			backupStore.On("PutBackupContents", test.backup.Name, mock.Anything).Return(velero.ErrObjectStreamingNotSupported)
 
 			// Ensure we have a CompletionTimestamp when uploading and that the backup name matches the backup in the object store.
 			// Failures will display the bytes in buf.
@@ -858,6 +953,229 @@ func TestProcessBackupCompletions(t *testing.T) {
 	}
 }
 
+func TestProcessBackupContentsUpload(t *testing.T) {
+	backupLocation := builder.ForBackupStorageLocation("velero", "loc-1").Default(true).Bucket("store-1").Result()
+	backupErr := errors.New("backup failed")
+
+	tests := []struct {
+		name                     string
+		streamingSupported       bool
+		backupErr                error
+		putBackupErr             error
+		expectedPhase            velerov1api.BackupPhase
+		expectedStreamed         string
+		expectedStreamErr        error
+		expectedPersisted        string
+		expectedContentsDeletion bool
+	}{
+		{
+			name:               "tarball is streamed to object stores that support streaming uploads",
+			streamingSupported: true,
+			expectedPhase:      velerov1api.BackupPhaseCompleted,
+			expectedStreamed:   "contents",
+		},
+		{
+			name:              "tarball is uploaded with the rest of the backup to object stores that don't support streaming uploads",
+			expectedPhase:     velerov1api.BackupPhaseCompleted,
+			expectedPersisted: "contents",
+		},
+		{
+			name:               "streamed upload is aborted if the backup fails",
+			streamingSupported: true,
+			backupErr:          backupErr,
+			expectedPhase:      velerov1api.BackupPhaseFailed,
+			expectedStreamed:   "contents",
+			expectedStreamErr:  backupErr,
+		},
+		{
+			name:              "tarball of a failed backup is uploaded with the rest of the backup to object stores that don't support streaming uploads",
+			backupErr:         backupErr,
+			expectedPhase:     velerov1api.BackupPhaseFailed,
+			expectedPersisted: "contents",
+		},
+		{
+			name:                     "streamed tarball is deleted if the rest of the backup can't be uploaded",
+			streamingSupported:       true,
+			putBackupErr:             errors.New("put failed"),
+			expectedPhase:            velerov1api.BackupPhaseFailed,
+			expectedStreamed:         "contents",
+			expectedContentsDeletion: true,
+		},
+		{
+			name:              "tarball that wasn't streamed isn't deleted separately if the rest of the backup can't be uploaded",
+			putBackupErr:      errors.New("put failed"),
+			expectedPhase:     velerov1api.BackupPhaseFailed,
+			expectedPersisted: "contents",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backup := defaultBackup().Result()
+
+			var (
+				clientset       = fake.NewSimpleClientset(backup)
+				sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+				logger          = logging.DefaultLogger(logrus.DebugLevel, logging.FormatText)
+				pluginManager   = new(pluginmocks.Manager)
+				backupStore     = new(persistencemocks.BackupStore)
+				backupper       = new(fakeBackupper)
+				apiServer       = velerotest.NewAPIServer(t)
+			)
+
+			apiServer.DiscoveryClient.FakedServerVersion = &version.Info{Major: "1", Minor: "16", GitVersion: "v1.16.4"}
+			discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
+			require.NoError(t, err)
+
+			c := &backupController{
+				genericController:      newGenericController("backup-test", logger),
+				discoveryHelper:        discoveryHelper,
+				client:                 clientset.VeleroV1(),
+				lister:                 sharedInformers.Velero().V1().Backups().Lister(),
+				kbClient:               velerotest.NewFakeControllerRuntimeClient(t, backupLocation),
+				snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+				defaultBackupLocation:  backupLocation.Name,
+				backupTracker:          NewBackupTracker(),
+				metrics:                metrics.NewServerMetrics(),
+				clock:                  clock.NewFakeClock(time.Now()),
+				newPluginManager:       func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				backupStoreGetter:      NewFakeSingleObjectBackupStoreGetter(backupStore),
+				backupper:              backupper,
+				formatFlag:             logging.FormatText,
+			}
+
+			pluginManager.On("GetBackupItemActionsV2").Return(nil, nil)
+			pluginManager.On("CleanupClients").Return(nil)
+			backupper.On("Backup", mock.Anything, mock.Anything, mock.Anything, []velero.BackupItemActionV2(nil), pluginManager).
+				Run(func(args mock.Arguments) {
+					args.Get(2).(io.Writer).Write([]byte("contents"))
+				}).
+				Return(test.backupErr)
+			backupStore.On("BackupExists", backupLocation.Spec.StorageType.ObjectStorage.Bucket, backup.Name).Return(false, nil)
+
+			var (
+				streamed  []byte
+				streamErr error
+			)
+			if test.streamingSupported {
+				backupStore.On("PutBackupContents", backup.Name, mock.Anything).
+					Return(func(_ string, contents io.Reader) error {
+						streamed, streamErr = ioutil.ReadAll(contents)
+						return streamErr
+					})
+			} else {
+				backupStore.On("PutBackupContents", backup.Name, mock.Anything).Return(velero.ErrObjectStreamingNotSupported)
+			}
+
+			var persisted []byte
+			backupStore.On("PutBackup", mock.Anything).
+				Return(func(info persistence.BackupInfo) error {
+					if info.Contents != nil {
+						// the backup store seeks to the beginning of the files it puts
+						_, err := info.Contents.(io.Seeker).Seek(0, io.SeekStart)
+						require.NoError(t, err)
+						persisted, err = ioutil.ReadAll(info.Contents)
+						require.NoError(t, err)
+					}
+					return test.putBackupErr
+				})
+			backupStore.On("DeleteBackupContents", backup.Name).Return(nil)
+
+			require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup))
+			require.NoError(t, c.processBackup(fmt.Sprintf("%s/%s", backup.Namespace, backup.Name)))
+
+			res, err := clientset.VeleroV1().Backups(backup.Namespace).Get(context.TODO(), backup.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedPhase, res.Status.Phase)
+
+			assert.Equal(t, test.expectedStreamed, string(streamed))
+			assert.Equal(t, test.expectedStreamErr, streamErr)
+			assert.Equal(t, test.expectedPersisted, string(persisted))
+			if test.expectedContentsDeletion {
+				backupStore.AssertCalled(t, "DeleteBackupContents", backup.Name)
+			} else {
+				backupStore.AssertNotCalled(t, "DeleteBackupContents", backup.Name)
+			}
+		})
+	}
+}
+
+func TestProcessBackupObjectLock(t *testing.T) {
+	now, err := time.Parse(time.RFC1123Z, time.RFC1123Z)
+	require.NoError(t, err)
+	now = now.Local()
+
+	backupLocation := builder.ForBackupStorageLocation("velero", "loc-1").Default(true).Bucket("store-1").ObjectLock(&velerov1api.ObjectLockSettings{
+		RetentionPeriod: &metav1.Duration{Duration: time.Hour},
+		LegalHold:       true,
+	}).Result()
+	backup := defaultBackup().Result()
+
+	var (
+		clientset       = fake.NewSimpleClientset(backup)
+		sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+		logger          = logging.DefaultLogger(logrus.DebugLevel, logging.FormatText)
+		pluginManager   = new(pluginmocks.Manager)
+		backupStore     = new(persistencemocks.BackupStore)
+		backupper       = new(fakeBackupper)
+		apiServer       = velerotest.NewAPIServer(t)
+	)
+
+	apiServer.DiscoveryClient.FakedServerVersion = &version.Info{Major: "1", Minor: "16", GitVersion: "v1.16.4"}
+	discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
+	require.NoError(t, err)
+
+	c := &backupController{
+		genericController:      newGenericController("backup-test", logger),
+		discoveryHelper:        discoveryHelper,
+		client:                 clientset.VeleroV1(),
+		lister:                 sharedInformers.Velero().V1().Backups().Lister(),
+		kbClient:               velerotest.NewFakeControllerRuntimeClient(t, backupLocation),
+		snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+		defaultBackupLocation:  backupLocation.Name,
+		backupTracker:          NewBackupTracker(),
+		metrics:                metrics.NewServerMetrics(),
+		clock:                  clock.NewFakeClock(now),
+		newPluginManager:       func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		backupStoreGetter:      NewFakeSingleObjectBackupStoreGetter(backupStore),
+		backupper:              backupper,
+		formatFlag:             logging.FormatText,
+	}
+
+	pluginManager.On("GetBackupItemActionsV2").Return(nil, nil)
+	pluginManager.On("CleanupClients").Return(nil)
+	backupper.On("Backup", mock.Anything, mock.Anything, mock.Anything, []velero.BackupItemActionV2(nil), pluginManager).Return(nil)
+	backupStore.On("BackupExists", backupLocation.Spec.StorageType.ObjectStorage.Bucket, backup.Name).Return(false, nil)
+	backupStore.On("PutBackupContents", backup.Name, mock.Anything).Return(velero.ErrObjectStreamingNotSupported)
+
+	// the lock is recorded in the backup's metadata, and the backup's objects
+	// are locked until the recorded time
+	var (
+		persisted   velerov1api.Backup
+		retainUntil time.Time
+	)
+	backupStore.On("PutBackup", mock.Anything).
+		Return(func(info persistence.BackupInfo) error {
+			retainUntil = info.RetainUntil
+			return json.NewDecoder(info.Metadata).Decode(&persisted)
+		})
+
+	require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup))
+	require.NoError(t, c.processBackup(fmt.Sprintf("%s/%s", backup.Namespace, backup.Name)))
+
+	res, err := clientset.VeleroV1().Backups(backup.Namespace).Get(context.TODO(), backup.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, velerov1api.BackupPhaseCompleted, res.Status.Phase)
+	require.NotNil(t, res.Status.RetainUntil)
+	assert.True(t, now.Add(time.Hour).Equal(res.Status.RetainUntil.Time))
+	assert.True(t, res.Status.LegalHold)
+
+	require.NotNil(t, persisted.Status.RetainUntil)
+	assert.True(t, now.Add(time.Hour).Equal(persisted.Status.RetainUntil.Time))
+	assert.True(t, persisted.Status.LegalHold)
+	assert.True(t, now.Add(time.Hour).Equal(retainUntil))
+}
+
 func TestValidateAndGetSnapshotLocations(t *testing.T) {
 	tests := []struct {
 		name                                string
@@ -1144,3 +1462,557 @@ func Test_getLastSuccessBySchedule(t *testing.T) {
 		})
 	}
 }
+
+func TestStartSnapshotUploads(t *testing.T) {
+	location := builder.ForVolumeSnapshotLocation(velerov1api.DefaultNamespace, "vsl-1").Provider("provider-1").Result()
+	now, err := time.Parse(time.RFC1123Z, time.RFC1123Z)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name                  string
+		volumeSnapshotter     func() velero.VolumeSnapshotter
+		loggedErrors          int
+		expectedUploading     bool
+		expectedSnapshotPhase volume.SnapshotPhase
+		expectedErrors        int
+		expectedPhase         velerov1api.BackupPhase
+		expectedProgress      *velerov1api.BackupProgress
+	}{
+		{
+			name: "snapshotter that doesn't report progress leaves the backup's phase unchanged",
+			volumeSnapshotter: func() velero.VolumeSnapshotter {
+				return new(velerotest.FakeVolumeSnapshotter)
+			},
+			expectedSnapshotPhase: volume.SnapshotPhaseCompleted,
+			expectedPhase:         velerov1api.BackupPhaseInProgress,
+		},
+		{
+			name: "snapshotter returning ErrSnapshotProgressNotSupported leaves the backup's phase unchanged",
+			volumeSnapshotter: func() velero.VolumeSnapshotter {
+				vs := new(mocks.VolumeSnapshotter)
+				vs.On("Init", mock.Anything).Return(nil)
+				vs.On("SnapshotProgress", "snap-1").Return(nil, velero.ErrSnapshotProgressNotSupported)
+				return vs
+			},
+			expectedSnapshotPhase: volume.SnapshotPhaseCompleted,
+			expectedPhase:         velerov1api.BackupPhaseInProgress,
+		},
+		{
+			name: "snapshot whose data is already uploaded leaves the backup's phase unchanged",
+			volumeSnapshotter: func() velero.VolumeSnapshotter {
+				vs := new(mocks.VolumeSnapshotter)
+				vs.On("Init", mock.Anything).Return(nil)
+				vs.On("SnapshotProgress", "snap-1").Return(&velero.SnapshotProgress{Completed: true, BytesDone: 100, BytesTotal: 100}, nil)
+				return vs
+			},
+			expectedSnapshotPhase: volume.SnapshotPhaseCompleted,
+			expectedPhase:         velerov1api.BackupPhaseInProgress,
+		},
+		{
+			name: "backup is uploading while the snapshot's data is being uploaded",
+			volumeSnapshotter: func() velero.VolumeSnapshotter {
+				vs := new(mocks.VolumeSnapshotter)
+				vs.On("Init", mock.Anything).Return(nil)
+				vs.On("SnapshotProgress", "snap-1").Return(&velero.SnapshotProgress{BytesDone: 10, BytesTotal: 100}, nil)
+				return vs
+			},
+			expectedUploading:     true,
+			expectedSnapshotPhase: volume.SnapshotPhaseCompleted,
+			expectedPhase:         velerov1api.BackupPhaseUploading,
+			expectedProgress: &velerov1api.BackupProgress{
+				VolumeSnapshotsUploading:           1,
+				VolumeSnapshotBytesUploaded:        10,
+				VolumeSnapshotBytesTotal:           100,
+				VolumeSnapshotUploadStartTimestamp: &metav1.Time{Time: now},
+			},
+		},
+		{
+			name: "backup with logged errors is uploading with partial failure",
+			volumeSnapshotter: func() velero.VolumeSnapshotter {
+				vs := new(mocks.VolumeSnapshotter)
+				vs.On("Init", mock.Anything).Return(nil)
+				vs.On("SnapshotProgress", "snap-1").Return(&velero.SnapshotProgress{BytesDone: 10, BytesTotal: 100}, nil)
+				return vs
+			},
+			loggedErrors:          1,
+			expectedUploading:     true,
+			expectedSnapshotPhase: volume.SnapshotPhaseCompleted,
+			expectedErrors:        1,
+			expectedPhase:         velerov1api.BackupPhaseUploadingPartialFailure,
+			expectedProgress: &velerov1api.BackupProgress{
+				VolumeSnapshotsUploading:           1,
+				VolumeSnapshotBytesUploaded:        10,
+				VolumeSnapshotBytesTotal:           100,
+				VolumeSnapshotUploadStartTimestamp: &metav1.Time{Time: now},
+			},
+		},
+		{
+			name: "snapshot whose upload failed is marked as failed",
+			volumeSnapshotter: func() velero.VolumeSnapshotter {
+				vs := new(mocks.VolumeSnapshotter)
+				vs.On("Init", mock.Anything).Return(nil)
+				vs.On("SnapshotProgress", "snap-1").Return(&velero.SnapshotProgress{FailureReason: "upload failed"}, nil)
+				return vs
+			},
+			expectedSnapshotPhase: volume.SnapshotPhaseFailed,
+			expectedErrors:        1,
+			expectedPhase:         velerov1api.BackupPhaseInProgress,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				pluginManager = new(pluginmocks.Manager)
+				logger        = logrus.New()
+				logCounter    = logging.NewLogCounterHook()
+			)
+			logger.Out = ioutil.Discard
+			logger.Hooks.Add(logCounter)
+			for i := 0; i < test.loggedErrors; i++ {
+				logger.Error("backup error")
+			}
+
+			c := &backupController{
+				clock: clock.NewFakeClock(now),
+			}
+
+			pluginManager.On("GetVolumeSnapshotter", "provider-1").Return(test.volumeSnapshotter(), nil)
+
+			snapshot := &volume.Snapshot{
+				Spec: volume.SnapshotSpec{
+					Location:             "vsl-1",
+					PersistentVolumeName: "pv-1",
+				},
+				Status: volume.SnapshotStatus{
+					ProviderSnapshotID: "snap-1",
+					Phase:              volume.SnapshotPhaseCompleted,
+				},
+			}
+			request := &pkgbackup.Request{
+				Backup:            defaultBackup().Phase(velerov1api.BackupPhaseInProgress).Result(),
+				SnapshotLocations: []*velerov1api.VolumeSnapshotLocation{location},
+				VolumeSnapshots:   []*volume.Snapshot{snapshot},
+			}
+
+			assert.Equal(t, test.expectedUploading, c.startSnapshotUploads(request, pluginManager, logCounter, logger))
+			assert.Equal(t, test.expectedSnapshotPhase, snapshot.Status.Phase)
+			assert.Equal(t, test.expectedErrors, logCounter.GetCount(logrus.ErrorLevel))
+			assert.Equal(t, test.expectedPhase, request.Status.Phase)
+			assert.Equal(t, test.expectedProgress, request.Status.Progress)
+		})
+	}
+}
+
+func TestProcessUploadingBackup(t *testing.T) {
+	now, err := time.Parse(time.RFC1123Z, time.RFC1123Z)
+	require.NoError(t, err)
+	now = now.Local()
+
+	location := builder.ForVolumeSnapshotLocation(velerov1api.DefaultNamespace, "vsl-1").Provider("provider-1").Result()
+	backupLocation := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "loc-1").Bucket("store-1").Result()
+
+	tests := []struct {
+		name                  string
+		phase                 velerov1api.BackupPhase
+		warnings              int
+		errors                int
+		uploadStart           time.Time
+		polls                 int
+		progress              []*velero.SnapshotProgress
+		progressErr           error
+		expectedPhase         velerov1api.BackupPhase
+		expectedWarnings      int
+		expectedErrors        int
+		expectedSnapshotPhase volume.SnapshotPhase
+		expectedCompleted     int
+		expectedRequeued      bool
+		expectedPersisted     bool
+		expectedLogEntry      string
+	}{
+		{
+			name:                  "backup whose snapshot data is still being uploaded is requeued",
+			phase:                 velerov1api.BackupPhaseUploading,
+			uploadStart:           now.Add(-time.Minute),
+			progress:              []*velero.SnapshotProgress{{BytesDone: 50, BytesTotal: 100}},
+			expectedPhase:         velerov1api.BackupPhaseUploading,
+			expectedSnapshotPhase: volume.SnapshotPhaseCompleted,
+			expectedRequeued:      true,
+		},
+		{
+			name:                  "backup whose snapshot data has been uploaded is completed",
+			phase:                 velerov1api.BackupPhaseUploading,
+			uploadStart:           now.Add(-time.Minute),
+			progress:              []*velero.SnapshotProgress{{Completed: true, BytesDone: 100, BytesTotal: 100}},
+			expectedPhase:         velerov1api.BackupPhaseCompleted,
+			expectedSnapshotPhase: volume.SnapshotPhaseCompleted,
+			expectedCompleted:     1,
+			expectedPersisted:     true,
+		},
+		{
+			name:                  "backup uploading with partial failure is partially failed once its snapshot data has been uploaded",
+			phase:                 velerov1api.BackupPhaseUploadingPartialFailure,
+			warnings:              2,
+			errors:                1,
+			uploadStart:           now.Add(-time.Minute),
+			progress:              []*velero.SnapshotProgress{{Completed: true, BytesDone: 100, BytesTotal: 100}},
+			expectedPhase:         velerov1api.BackupPhasePartiallyFailed,
+			expectedWarnings:      2,
+			expectedErrors:        1,
+			expectedSnapshotPhase: volume.SnapshotPhaseCompleted,
+			expectedCompleted:     1,
+			expectedPersisted:     true,
+		},
+		{
+			name:                  "backup whose snapshot upload failed is partially failed",
+			phase:                 velerov1api.BackupPhaseUploading,
+			uploadStart:           now.Add(-time.Minute),
+			progress:              []*velero.SnapshotProgress{{FailureReason: "upload failed"}},
+			expectedPhase:         velerov1api.BackupPhasePartiallyFailed,
+			expectedErrors:        1,
+			expectedSnapshotPhase: volume.SnapshotPhaseFailed,
+			expectedPersisted:     true,
+			expectedLogEntry:      "Error uploading the data of volume snapshot: upload failed",
+		},
+		{
+			name:                  "backup whose snapshot upload doesn't finish before the timeout is partially failed",
+			phase:                 velerov1api.BackupPhaseUploading,
+			uploadStart:           now.Add(-2 * time.Hour),
+			progress:              []*velero.SnapshotProgress{{BytesDone: 50, BytesTotal: 100}},
+			expectedPhase:         velerov1api.BackupPhasePartiallyFailed,
+			expectedErrors:        1,
+			expectedSnapshotPhase: volume.SnapshotPhaseFailed,
+			expectedPersisted:     true,
+			expectedLogEntry:      "Timed out waiting for the data of volume snapshot snap-1 of persistent volume pv-1 to be uploaded",
+		},
+		{
+			name:                  "errors getting the upload progress of a snapshot are counted once however often it's checked",
+			phase:                 velerov1api.BackupPhaseUploading,
+			uploadStart:           now.Add(-time.Minute),
+			polls:                 3,
+			progressErr:           errors.New("progress unavailable"),
+			expectedPhase:         velerov1api.BackupPhaseUploading,
+			expectedSnapshotPhase: volume.SnapshotPhaseCompleted,
+			expectedRequeued:      true,
+			expectedLogEntry:      "Error getting upload progress of volume snapshot",
+		},
+		{
+			name:                  "errors getting the upload progress of a snapshot are counted as a warning once the backup completes",
+			phase:                 velerov1api.BackupPhaseUploading,
+			uploadStart:           now.Add(-2 * time.Hour),
+			polls:                 2,
+			progressErr:           errors.New("progress unavailable"),
+			expectedPhase:         velerov1api.BackupPhasePartiallyFailed,
+			expectedWarnings:      1,
+			expectedErrors:        1,
+			expectedSnapshotPhase: volume.SnapshotPhaseFailed,
+			expectedPersisted:     true,
+			expectedLogEntry:      "Error getting upload progress of volume snapshot",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backup := defaultBackup().
+				StorageLocation("loc-1").
+				Phase(test.phase).
+				StartTimestamp(now.Add(-2 * time.Hour)).
+				Result()
+			backup.Status.VolumeSnapshotsAttempted = 1
+			backup.Status.Warnings = test.warnings
+			backup.Status.Errors = test.errors
+			backup.Status.Progress = &velerov1api.BackupProgress{
+				VolumeSnapshotsUploading:           1,
+				VolumeSnapshotUploadStartTimestamp: &metav1.Time{Time: test.uploadStart},
+			}
+
+			var (
+				clientset         = fake.NewSimpleClientset(backup)
+				sharedInformers   = informers.NewSharedInformerFactory(clientset, 0)
+				logger            = logging.DefaultLogger(logrus.DebugLevel, logging.FormatText)
+				pluginManager     = new(pluginmocks.Manager)
+				backupStore       = new(persistencemocks.BackupStore)
+				volumeSnapshotter = new(mocks.VolumeSnapshotter)
+				backupTracker     = NewBackupTracker()
+			)
+
+			c := &backupController{
+				genericController:          newGenericController("backup-test", logger),
+				client:                     clientset.VeleroV1(),
+				lister:                     sharedInformers.Velero().V1().Backups().Lister(),
+				kbClient:                   velerotest.NewFakeControllerRuntimeClient(t, backupLocation),
+				snapshotLocationLister:     sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+				backupTracker:              backupTracker,
+				metrics:                    metrics.NewServerMetrics(),
+				clock:                      clock.NewFakeClock(now),
+				newPluginManager:           func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				backupStoreGetter:          NewFakeSingleObjectBackupStoreGetter(backupStore),
+				backupLogLevel:             logrus.InfoLevel,
+				formatFlag:                 logging.FormatText,
+				snapshotUploadTimeout:      time.Hour,
+				snapshotUploadPollInterval: 0,
+			}
+			require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup))
+			require.NoError(t, sharedInformers.Velero().V1().VolumeSnapshotLocations().Informer().GetStore().Add(location))
+
+			snapshot := &volume.Snapshot{
+				Spec: volume.SnapshotSpec{
+					BackupName:           backup.Name,
+					Location:             "vsl-1",
+					PersistentVolumeName: "pv-1",
+				},
+				Status: volume.SnapshotStatus{
+					ProviderSnapshotID: "snap-1",
+					Phase:              volume.SnapshotPhaseCompleted,
+				},
+			}
+
+			pluginManager.On("GetVolumeSnapshotter", "provider-1").Return(volumeSnapshotter, nil)
+			pluginManager.On("CleanupClients").Return(nil)
+			volumeSnapshotter.On("Init", mock.Anything).Return(nil)
+			for _, progress := range test.progress {
+				volumeSnapshotter.On("SnapshotProgress", "snap-1").Return(progress, nil)
+			}
+			if test.progressErr != nil {
+				volumeSnapshotter.On("SnapshotProgress", "snap-1").Return(nil, test.progressErr)
+			}
+
+			// the backup's log is replaced with each put, like in object storage
+			backupLog := new(bytes.Buffer)
+			gzippedLog := gzip.NewWriter(backupLog)
+			_, err := gzippedLog.Write([]byte("backup log\n"))
+			require.NoError(t, err)
+			require.NoError(t, gzippedLog.Close())
+
+			backupStore.On("GetBackupVolumeSnapshots", backup.Name).Return([]*volume.Snapshot{snapshot}, nil)
+			backupStore.On("PutBackupVolumeSnapshots", backup.Name, mock.Anything, mock.Anything).Return(nil)
+			backupStore.On("PutBackupMetadata", backup.Name, mock.Anything, mock.Anything).Return(nil)
+			backupStore.On("GetBackupLog", backup.Name).Return(func(string) io.ReadCloser {
+				return ioutil.NopCloser(bytes.NewReader(backupLog.Bytes()))
+			}, nil)
+			backupStore.On("PutBackupLog", backup.Name, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				backupLog = new(bytes.Buffer)
+				_, err := io.Copy(backupLog, args.Get(1).(io.Reader))
+				require.NoError(t, err)
+			}).Return(nil)
+
+			polls := test.polls
+			if polls == 0 {
+				polls = 1
+			}
+
+			var res *velerov1api.Backup
+			for i := 0; i < polls; i++ {
+				require.NoError(t, c.processBackup(fmt.Sprintf("%s/%s", backup.Namespace, backup.Name)))
+
+				res, err = clientset.VeleroV1().Backups(backup.Namespace).Get(context.TODO(), backup.Name, metav1.GetOptions{})
+				require.NoError(t, err)
+				require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Update(res))
+			}
+
+			assert.Equal(t, test.expectedPhase, res.Status.Phase)
+			assert.Equal(t, test.expectedWarnings, res.Status.Warnings)
+			assert.Equal(t, test.expectedErrors, res.Status.Errors)
+			assert.Equal(t, test.expectedCompleted, res.Status.VolumeSnapshotsCompleted)
+			assert.Equal(t, test.expectedSnapshotPhase, snapshot.Status.Phase)
+			assert.Equal(t, test.expectedRequeued, c.queue.Len() == 1)
+			assert.Equal(t, test.expectedRequeued, backupTracker.Contains(backup.Namespace, backup.Name))
+
+			if test.expectedPersisted {
+				assert.Equal(t, &metav1.Time{Time: now}, res.Status.CompletionTimestamp)
+				backupStore.AssertCalled(t, "PutBackupVolumeSnapshots", backup.Name, mock.Anything, mock.Anything)
+				backupStore.AssertCalled(t, "PutBackupMetadata", backup.Name, mock.Anything, mock.Anything)
+			} else {
+				assert.Nil(t, res.Status.CompletionTimestamp)
+				backupStore.AssertNotCalled(t, "PutBackupMetadata", backup.Name, mock.Anything, mock.Anything)
+			}
+
+			gzipReader, err := gzip.NewReader(backupLog)
+			require.NoError(t, err)
+			logs, err := ioutil.ReadAll(gzipReader)
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(string(logs), "backup log\n"))
+			if test.expectedLogEntry != "" {
+				assert.Equal(t, 1, strings.Count(string(logs), test.expectedLogEntry))
+			}
+		})
+	}
+}
+
+func TestBackupControllerQueuesUploadingBackups(t *testing.T) {
+	backups := []runtime.Object{
+		builder.ForBackup(velerov1api.DefaultNamespace, "new").Phase(velerov1api.BackupPhaseNew).Result(),
+		builder.ForBackup(velerov1api.DefaultNamespace, "uploading").Phase(velerov1api.BackupPhaseUploading).Result(),
+		builder.ForBackup(velerov1api.DefaultNamespace, "uploading-partial-failure").Phase(velerov1api.BackupPhaseUploadingPartialFailure).Result(),
+		builder.ForBackup(velerov1api.DefaultNamespace, "completed").Phase(velerov1api.BackupPhaseCompleted).Result(),
+	}
+
+	var (
+		clientset       = fake.NewSimpleClientset(backups...)
+		sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+		logger          = velerotest.NewLogger()
+	)
+
+	c := NewBackupController(
+		sharedInformers.Velero().V1().Backups(),
+		clientset.VeleroV1(),
+		nil,
+		nil,
+		logger,
+		logrus.InfoLevel,
+		nil,
+		NewBackupTracker(),
+		velerotest.NewFakeControllerRuntimeClient(t),
+		"default",
+		false,
+		time.Hour,
+		sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+		nil,
+		metrics.NewServerMetrics(),
+		logging.FormatText,
+		nil,
+		nil,
+		nil,
+		time.Hour,
+	).(*backupController)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sharedInformers.Start(ctx.Done())
+	sharedInformers.WaitForCacheSync(ctx.Done())
+
+	// backups in the Uploading phases are queued when the server starts, to
+	// finish tracking the upload of their volume snapshot data
+	require.Eventually(t, func() bool { return c.queue.Len() == 3 }, 5*time.Second, 10*time.Millisecond)
+
+	var keys []string
+	for c.queue.Len() > 0 {
+		key, _ := c.queue.Get()
+		keys = append(keys, key.(string))
+		c.queue.Done(key)
+	}
+	sort.Strings(keys)
+
+	assert.Equal(t, []string{"velero/new", "velero/uploading", "velero/uploading-partial-failure"}, keys)
+}
+
+func TestCopySnapshots(t *testing.T) {
+	tests := []struct {
+		name              string
+		location          *velerov1api.VolumeSnapshotLocation
+		snapshotPhase     volume.SnapshotPhase
+		volumeSnapshotter func() velero.VolumeSnapshotter
+		expectedCopy      *volume.SnapshotCopyStatus
+		expectedErrors    int
+	}{
+		{
+			name:          "snapshot in location without copy config isn't copied",
+			location:      builder.ForVolumeSnapshotLocation(velerov1api.DefaultNamespace, "vsl-1").Provider("provider-1").Result(),
+			snapshotPhase: volume.SnapshotPhaseCompleted,
+		},
+		{
+			name: "failed snapshot isn't copied",
+			location: builder.ForVolumeSnapshotLocation(velerov1api.DefaultNamespace, "vsl-1").
+				Provider("provider-1").
+				CopyConfig(map[string]string{"region": "us-west-2"}).
+				Result(),
+			snapshotPhase: volume.SnapshotPhaseFailed,
+		},
+		{
+			name: "completed snapshot is copied using the location's config with the copy config applied",
+			location: builder.ForVolumeSnapshotLocation(velerov1api.DefaultNamespace, "vsl-1").
+				Provider("provider-1").
+				Config(map[string]string{"region": "us-east-1", "profile": "backups"}).
+				CopyConfig(map[string]string{"region": "us-west-2"}).
+				Result(),
+			snapshotPhase: volume.SnapshotPhaseCompleted,
+			volumeSnapshotter: func() velero.VolumeSnapshotter {
+				vs := new(mocks.VolumeSnapshotter)
+				vs.On("Init", map[string]string{"region": "us-east-1", "profile": "backups"}).Return(nil)
+				vs.On("CopySnapshot", "snap-1", "zone-1", map[string]string{"region": "us-west-2", "profile": "backups"}).Return("copy-1", nil)
+				return vs
+			},
+			expectedCopy: &volume.SnapshotCopyStatus{
+				Config:             map[string]string{"region": "us-west-2", "profile": "backups"},
+				ProviderSnapshotID: "copy-1",
+				Phase:              volume.SnapshotPhaseCompleted,
+			},
+		},
+		{
+			name: "snapshotter that doesn't copy snapshots fails the copy",
+			location: builder.ForVolumeSnapshotLocation(velerov1api.DefaultNamespace, "vsl-1").
+				Provider("provider-1").
+				CopyConfig(map[string]string{"region": "us-west-2"}).
+				Result(),
+			snapshotPhase: volume.SnapshotPhaseCompleted,
+			volumeSnapshotter: func() velero.VolumeSnapshotter {
+				return new(velerotest.FakeVolumeSnapshotter)
+			},
+			expectedCopy: &volume.SnapshotCopyStatus{
+				Config: map[string]string{"region": "us-west-2"},
+				Phase:  volume.SnapshotPhaseFailed,
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "error copying snapshot fails the copy",
+			location: builder.ForVolumeSnapshotLocation(velerov1api.DefaultNamespace, "vsl-1").
+				Provider("provider-1").
+				CopyConfig(map[string]string{"region": "us-west-2"}).
+				Result(),
+			snapshotPhase: volume.SnapshotPhaseCompleted,
+			volumeSnapshotter: func() velero.VolumeSnapshotter {
+				vs := new(mocks.VolumeSnapshotter)
+				vs.On("Init", mock.Anything).Return(nil)
+				vs.On("CopySnapshot", "snap-1", "zone-1", map[string]string{"region": "us-west-2"}).Return("", errors.New("copy error"))
+				return vs
+			},
+			expectedCopy: &volume.SnapshotCopyStatus{
+				Config: map[string]string{"region": "us-west-2"},
+				Phase:  volume.SnapshotPhaseFailed,
+			},
+			expectedErrors: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				pluginManager = new(pluginmocks.Manager)
+				logger        = logrus.New()
+				logCounter    = logging.NewLogCounterHook()
+			)
+			logger.Out = ioutil.Discard
+			logger.Hooks.Add(logCounter)
+
+			if test.volumeSnapshotter != nil {
+				pluginManager.On("GetVolumeSnapshotter", "provider-1").Return(test.volumeSnapshotter(), nil)
+			}
+
+			snapshot := &volume.Snapshot{
+				Spec: volume.SnapshotSpec{
+					Location:             "vsl-1",
+					PersistentVolumeName: "pv-1",
+					VolumeAZ:             "zone-1",
+				},
+				Status: volume.SnapshotStatus{
+					ProviderSnapshotID: "snap-1",
+					Phase:              test.snapshotPhase,
+				},
+			}
+			request := &pkgbackup.Request{
+				Backup:            defaultBackup().Result(),
+				SnapshotLocations: []*velerov1api.VolumeSnapshotLocation{test.location},
+				VolumeSnapshots:   []*volume.Snapshot{snapshot},
+			}
+
+			c := &backupController{}
+			c.copySnapshots(request, pluginManager, logger)
+
+			assert.Equal(t, test.expectedCopy, snapshot.Status.Copy)
+			assert.Equal(t, test.snapshotPhase, snapshot.Status.Phase)
+			assert.Equal(t, test.expectedErrors, logCounter.GetCount(logrus.ErrorLevel))
+			pluginManager.AssertExpectations(t)
+		})
+	}
+}
@@ -35,6 +35,7 @@ import (
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/internal/delete"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
@@ -48,14 +49,21 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	"github.com/vmware-tanzu/velero/pkg/uploader/provider"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
+	"github.com/vmware-tanzu/velero/pkg/volume"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const resticTimeout = time.Minute
 
+// objectLockRetryInterval is how often the deletion of a backup is retried
+// while its objects are locked without a known end, e.g. by a legal hold.
+const objectLockRetryInterval = time.Hour
+
 type backupDeletionController struct {
 	*genericController
 
@@ -66,6 +74,7 @@ type backupDeletionController struct {
 	restoreClient             velerov1client.RestoresGetter
 	backupTracker             BackupTracker
 	resticMgr                 restic.RepositoryManager
+	credentialsFileStore      credentials.FileStore
 	podvolumeBackupLister     velerov1listers.PodVolumeBackupLister
 	kbClient                  client.Client
 	snapshotLocationLister    velerov1listers.VolumeSnapshotLocationLister
@@ -78,6 +87,7 @@ type backupDeletionController struct {
 	backupStoreGetter         persistence.ObjectBackupStoreGetter
 	metrics                   *metrics.ServerMetrics
 	helper                    discovery.Helper
+	deleteKopiaSnapshot       func(context.Context, string, string, string, *velerov1api.BackupStorageLocation, credentials.FileStore, logrus.FieldLogger) error
 }
 
 // NewBackupDeletionController creates a new backup deletion controller.
@@ -90,6 +100,7 @@ func NewBackupDeletionController(
 	restoreClient velerov1client.RestoresGetter,
 	backupTracker BackupTracker,
 	resticMgr restic.RepositoryManager,
+	credentialsFileStore credentials.FileStore,
 	podvolumeBackupLister velerov1listers.PodVolumeBackupLister,
 	kbClient client.Client,
 	snapshotLocationLister velerov1listers.VolumeSnapshotLocationLister,
@@ -110,6 +121,7 @@ func NewBackupDeletionController(
 		restoreClient:             restoreClient,
 		backupTracker:             backupTracker,
 		resticMgr:                 resticMgr,
+		credentialsFileStore:      credentialsFileStore,
 		podvolumeBackupLister:     podvolumeBackupLister,
 		kbClient:                  kbClient,
 		snapshotLocationLister:    snapshotLocationLister,
@@ -120,8 +132,9 @@ func NewBackupDeletionController(
 		helper:                    helper,
 		// use variables to refer to these functions so they can be
 		// replaced with fakes for testing.
-		newPluginManager:  newPluginManager,
-		backupStoreGetter: backupStoreGetter,
+		newPluginManager:    newPluginManager,
+		backupStoreGetter:   backupStoreGetter,
+		deleteKopiaSnapshot: provider.DeleteKopiaSnapshot,
 
 		clock: &clock.RealClock{},
 	}
@@ -244,6 +257,15 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 		return err
 	}
 
+	// Don't start deleting a backup whose objects are still locked in object
+	// storage, since they can't be removed and the rest of the backup is needed
+	// to restore them
+	if locked, err := c.backupObjectLock(backup, location, log); err != nil {
+		return err
+	} else if locked != nil {
+		return c.requeueLockedRequest(req, locked, nil, log)
+	}
+
 	// if the request object has no labels defined, initialise an empty map since
 	// we will be updating labels
 	if req.Labels == nil {
@@ -333,24 +355,7 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 		if snapshots, err := backupStore.GetBackupVolumeSnapshots(backup.Name); err != nil {
 			errs = append(errs, errors.Wrap(err, "error getting backup's volume snapshots").Error())
 		} else {
-			volumeSnapshotters := make(map[string]velero.VolumeSnapshotter)
-
-			for _, snapshot := range snapshots {
-				log.WithField("providerSnapshotID", snapshot.Status.ProviderSnapshotID).Info("Removing snapshot associated with backup")
-
-				volumeSnapshotter, ok := volumeSnapshotters[snapshot.Spec.Location]
-				if !ok {
-					if volumeSnapshotter, err = volumeSnapshotterForSnapshotLocation(backup.Namespace, snapshot.Spec.Location, c.snapshotLocationLister, pluginManager); err != nil {
-						errs = append(errs, err.Error())
-						continue
-					}
-					volumeSnapshotters[snapshot.Spec.Location] = volumeSnapshotter
-				}
-
-				if err := volumeSnapshotter.DeleteSnapshot(snapshot.Status.ProviderSnapshotID); err != nil {
-					errs = append(errs, errors.Wrapf(err, "error deleting snapshot %s", snapshot.Status.ProviderSnapshotID).Error())
-				}
-			}
+			errs = append(errs, c.deleteVolumeSnapshots(backup.Namespace, snapshots, pluginManager, log)...)
 		}
 	}
 
@@ -361,13 +366,34 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 		}
 	}
 
+	var locked *velero.ObjectLockedError
+
 	if backupStore != nil {
 		log.Info("Removing backup from backup storage")
 		if err := backupStore.DeleteBackup(backup.Name); err != nil {
-			errs = append(errs, err.Error())
+			if !errors.As(err, &locked) {
+				errs = append(errs, err.Error())
+			}
 		}
 	}
 
+	for _, replica := range backup.Status.Replicas {
+		if err := c.deleteBackupReplica(backup, replica, pluginManager, log); err != nil {
+			var replicaLocked *velero.ObjectLockedError
+			if errors.As(err, &replicaLocked) {
+				locked = persistence.LongestObjectLock(locked, replicaLocked)
+			} else {
+				errs = append(errs, err.Error())
+			}
+		}
+	}
+
+	// Keep the restores and the backup API object until the backup's locked
+	// objects can be deleted too
+	if locked != nil {
+		return c.requeueLockedRequest(req, locked, errs, log)
+	}
+
 	log.Info("Removing restores")
 	if restores, err := c.restoreLister.Restores(backup.Namespace).List(labels.Everything()); err != nil {
 		log.WithError(errors.WithStack(err)).Error("Error listing restore API objects")
@@ -411,6 +437,8 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 	req, err = c.patchDeleteBackupRequest(req, func(r *velerov1api.DeleteBackupRequest) {
 		r.Status.Phase = velerov1api.DeleteBackupRequestPhaseProcessed
 		r.Status.Errors = errs
+		r.Status.LockedUntil = nil
+		r.Status.LegalHold = false
 	})
 	if err != nil {
 		return err
@@ -429,6 +457,32 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 	return nil
 }
 
+// requeueLockedRequest records on the request that its backup's objects are
+// locked in object storage, along with any other errors from deleting the
+// backup, and retries the request once the lock is expected to end. Legal
+// holds have no known end, so those are retried periodically.
+func (c *backupDeletionController) requeueLockedRequest(req *velerov1api.DeleteBackupRequest, locked *velero.ObjectLockedError, errs []string, log logrus.FieldLogger) error {
+	retryAfter := objectLockRetryInterval
+	var lockedUntil *metav1.Time
+	if !locked.LegalHold && !locked.RetainUntil.IsZero() {
+		lockedUntil = &metav1.Time{Time: locked.RetainUntil}
+		retryAfter = locked.RetainUntil.Sub(c.clock.Now())
+	}
+
+	log.WithError(locked).Infof("Backup cannot be deleted yet, retrying in %s", retryAfter)
+
+	if _, err := c.patchDeleteBackupRequest(req, func(r *velerov1api.DeleteBackupRequest) {
+		r.Status.Errors = errs
+		r.Status.LockedUntil = lockedUntil
+		r.Status.LegalHold = locked.LegalHold
+	}); err != nil {
+		return err
+	}
+
+	c.queue.AddAfter(kube.NamespaceAndName(req), retryAfter)
+	return nil
+}
+
 func volumeSnapshotterForSnapshotLocation(
 	namespace, snapshotLocationName string,
 	snapshotLocationLister velerov1listers.VolumeSnapshotLocationLister,
@@ -451,6 +505,219 @@ func volumeSnapshotterForSnapshotLocation(
 	return volumeSnapshotter, nil
 }
 
+// backupObjectLock returns the longest lock of the backup's objects in its
+// storage location and in the mirror storage locations it was copied to, or
+// nil if none of them are locked. The locks of copies aren't recorded, so
+// they're assumed to last for their location's retention period from when
+// the copy ended. Legal holds can be removed in object storage at any time,
+// so they're checked there.
+func (c *backupDeletionController) backupObjectLock(backup *velerov1api.Backup, location *velerov1api.BackupStorageLocation, log logrus.FieldLogger) (*velero.ObjectLockedError, error) {
+	now := c.clock.Now()
+
+	var pluginManager clientmgmt.Manager
+	defer func() {
+		if pluginManager != nil {
+			pluginManager.CleanupClients()
+		}
+	}()
+	checkLegalHold := func(location *velerov1api.BackupStorageLocation, key string) (*velero.ObjectLockedError, error) {
+		if pluginManager == nil {
+			pluginManager = c.newPluginManager(log)
+		}
+		return c.legalHoldObjectLock(backup, location, key, pluginManager, log)
+	}
+
+	var locked *velero.ObjectLockedError
+	if backup.Status.LegalHold {
+		var err error
+		if locked, err = checkLegalHold(location, backup.Name); err != nil {
+			return nil, err
+		}
+	} else if backup.Status.RetainUntil != nil && backup.Status.RetainUntil.After(now) {
+		locked = &velero.ObjectLockedError{Key: backup.Name, RetainUntil: backup.Status.RetainUntil.Time}
+	}
+
+	for _, replica := range backup.Status.Replicas {
+		// the backup was never copied to the location
+		if replica.Phase == "" || replica.Phase == velerov1api.BackupReplicaPhaseNew {
+			continue
+		}
+
+		location := &velerov1api.BackupStorageLocation{}
+		if err := c.kbClient.Get(context.Background(), client.ObjectKey{
+			Namespace: backup.Namespace,
+			Name:      replica.StorageLocation,
+		}, location); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, errors.Wrapf(err, "error getting mirror storage location %s", replica.StorageLocation)
+		}
+
+		objectLock := location.Spec.ObjectLock
+		if objectLock == nil {
+			continue
+		}
+
+		key := fmt.Sprintf("%s in mirror storage location %s", backup.Name, replica.StorageLocation)
+		if objectLock.LegalHold {
+			replicaLocked, err := checkLegalHold(location, key)
+			if err != nil {
+				return nil, err
+			}
+			if replicaLocked != nil {
+				locked = persistence.LongestObjectLock(locked, replicaLocked)
+			}
+			continue
+		}
+		if objectLock.RetentionPeriod == nil || objectLock.RetentionPeriod.Duration <= 0 {
+			continue
+		}
+
+		copied := now
+		if replica.Phase != velerov1api.BackupReplicaPhaseInProgress && replica.CompletionTimestamp != nil {
+			copied = replica.CompletionTimestamp.Time
+		}
+		if retainUntil := copied.Add(objectLock.RetentionPeriod.Duration); retainUntil.After(now) {
+			locked = persistence.LongestObjectLock(locked, &velero.ObjectLockedError{Key: key, RetainUntil: retainUntil})
+		}
+	}
+
+	return locked, nil
+}
+
+// legalHoldObjectLock returns the current lock of the backup's objects in a
+// storage location they were put in with a legal hold, or nil if the hold was
+// removed and they aren't locked anymore.
+func (c *backupDeletionController) legalHoldObjectLock(backup *velerov1api.Backup, location *velerov1api.BackupStorageLocation, key string, pluginManager clientmgmt.Manager, log logrus.FieldLogger) (*velero.ObjectLockedError, error) {
+	backupStore, err := c.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		return nil, err
+	}
+
+	locked, err := backupStore.GetBackupObjectLock(backup.Name)
+	if err == velero.ErrObjectRetentionNotSupported {
+		// the hold can't be checked, so assume it's still placed
+		return &velero.ObjectLockedError{Key: key, LegalHold: true}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error checking lock of backup in storage location %s", location.Name)
+	}
+
+	if locked != nil {
+		locked.Key = key
+	}
+	return locked, nil
+}
+
+// deleteBackupReplica deletes the copy of the backup in one of its mirror storage locations.
+func (c *backupDeletionController) deleteBackupReplica(backup *velerov1api.Backup, replica velerov1api.BackupReplicaStatus, pluginManager clientmgmt.Manager, log logrus.FieldLogger) error {
+	// the backup was never copied to the location
+	if replica.Phase == "" || replica.Phase == velerov1api.BackupReplicaPhaseNew {
+		return nil
+	}
+
+	log = log.WithField("mirrorStorageLocation", replica.StorageLocation)
+
+	location := &velerov1api.BackupStorageLocation{}
+	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
+		Namespace: backup.Namespace,
+		Name:      replica.StorageLocation,
+	}, location); err != nil {
+		if apierrors.IsNotFound(err) && replica.Phase == velerov1api.BackupReplicaPhaseFailed {
+			log.Info("Skipping removal of failed backup copy because its backup storage location doesn't exist")
+			return nil
+		}
+		return errors.Wrapf(err, "error getting mirror storage location %s", replica.StorageLocation)
+	}
+
+	if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		return errors.Errorf("copy of backup in mirror storage location %s can't be deleted because the location is in read-only mode", replica.StorageLocation)
+	}
+
+	backupStore, err := c.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Removing copy of backup from mirror storage location")
+	return errors.Wrapf(backupStore.DeleteBackup(backup.Name), "error deleting copy of backup from mirror storage location %s", replica.StorageLocation)
+}
+
+// deleteVolumeSnapshots deletes the given volume snapshots, and their copies, and returns
+// the errors deleting them.
+func (c *backupDeletionController) deleteVolumeSnapshots(namespace string, snapshots []*volume.Snapshot, pluginManager clientmgmt.Manager, log logrus.FieldLogger) []string {
+	var errs []string
+	volumeSnapshotters := make(map[string]velero.VolumeSnapshotter)
+
+	// Volume snapshotters of the same provider share a plugin process, so initializing one
+	// with the config of a secondary location would reconfigure the others. Copies are deleted
+	// with the volume snapshotters of a separate plugin manager, so that the ones deleting the
+	// snapshots keep the config of their volume snapshot location.
+	var copyPluginManager clientmgmt.Manager
+	defer func() {
+		if copyPluginManager != nil {
+			copyPluginManager.CleanupClients()
+		}
+	}()
+
+	for _, snapshot := range snapshots {
+		log.WithField("providerSnapshotID", snapshot.Status.ProviderSnapshotID).Info("Removing snapshot associated with backup")
+
+		if snapshot.Status.Copy != nil && snapshot.Status.Copy.ProviderSnapshotID != "" {
+			log.WithField("providerSnapshotID", snapshot.Status.Copy.ProviderSnapshotID).Info("Removing copy of snapshot associated with backup")
+			if copyPluginManager == nil {
+				copyPluginManager = c.newPluginManager(log)
+			}
+			if err := c.deleteSnapshotCopy(namespace, snapshot, copyPluginManager); err != nil {
+				errs = append(errs, err.Error())
+			}
+		}
+
+		volumeSnapshotter, ok := volumeSnapshotters[snapshot.Spec.Location]
+		if !ok {
+			var err error
+			if volumeSnapshotter, err = volumeSnapshotterForSnapshotLocation(namespace, snapshot.Spec.Location, c.snapshotLocationLister, pluginManager); err != nil {
+				errs = append(errs, err.Error())
+				continue
+			}
+			volumeSnapshotters[snapshot.Spec.Location] = volumeSnapshotter
+		}
+
+		if err := volumeSnapshotter.DeleteSnapshot(snapshot.Status.ProviderSnapshotID); err != nil {
+			errs = append(errs, errors.Wrapf(err, "error deleting snapshot %s", snapshot.Status.ProviderSnapshotID).Error())
+		}
+	}
+
+	return errs
+}
+
+// deleteSnapshotCopy deletes the copy of the snapshot in its volume snapshot location's
+// secondary region or location, using the config the snapshot was copied with. The plugin
+// manager must not be used for the snapshots of the location, since the volume snapshotter
+// is initialized with the config of the secondary location.
+func (c *backupDeletionController) deleteSnapshotCopy(namespace string, snapshot *volume.Snapshot, pluginManager clientmgmt.Manager) error {
+	snapshotLocation, err := c.snapshotLocationLister.VolumeSnapshotLocations(namespace).Get(snapshot.Spec.Location)
+	if err != nil {
+		return errors.Wrapf(err, "error getting volume snapshot location %s", snapshot.Spec.Location)
+	}
+
+	volumeSnapshotter, err := pluginManager.GetVolumeSnapshotter(snapshotLocation.Spec.Provider)
+	if err != nil {
+		return errors.Wrapf(err, "error getting volume snapshotter for provider %s", snapshotLocation.Spec.Provider)
+	}
+
+	if err := volumeSnapshotter.Init(snapshot.Status.Copy.Config); err != nil {
+		return errors.Wrapf(err, "error initializing volume snapshotter for the secondary location of volume snapshot location %s", snapshot.Spec.Location)
+	}
+
+	if err := volumeSnapshotter.DeleteSnapshot(snapshot.Status.Copy.ProviderSnapshotID); err != nil {
+		return errors.Wrapf(err, "error deleting copy %s of snapshot %s", snapshot.Status.Copy.ProviderSnapshotID, snapshot.Status.ProviderSnapshotID)
+	}
+
+	return nil
+}
+
 func (c *backupDeletionController) deleteExistingDeletionRequests(req *velerov1api.DeleteBackupRequest, log logrus.FieldLogger) []error {
 	log.Info("Removing existing deletion requests for backup")
 	selector := label.NewSelectorForBackup(req.Spec.BackupName)
@@ -488,6 +755,13 @@ func (c *backupDeletionController) deleteResticSnapshots(backup *velerov1api.Bac
 
 	var errs []error
 	for _, snapshot := range snapshots {
+		if uploader.GetUploaderType(snapshot.UploaderType) == uploader.KopiaType {
+			if err := c.forgetKopiaSnapshot(ctx, backup.Namespace, snapshot); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+
 		if err := c.resticMgr.Forget(ctx, snapshot); err != nil {
 			errs = append(errs, err)
 		}
@@ -496,6 +770,17 @@ func (c *backupDeletionController) deleteResticSnapshots(backup *velerov1api.Bac
 	return errs
 }
 
+// forgetKopiaSnapshot deletes a snapshot taken by the Kopia uploader from its
+// repository.
+func (c *backupDeletionController) forgetKopiaSnapshot(ctx context.Context, namespace string, snapshot restic.SnapshotIdentifier) error {
+	location := &velerov1api.BackupStorageLocation{}
+	if err := c.kbClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: snapshot.BackupStorageLocation}, location); err != nil {
+		return errors.Wrapf(err, "error getting backup storage location %s", snapshot.BackupStorageLocation)
+	}
+
+	return c.deleteKopiaSnapshot(ctx, snapshot.SnapshotID, snapshot.RepoIdentifier, snapshot.RepositoryKeySecret, location, c.credentialsFileStore, c.logger)
+}
+
 const deleteBackupRequestMaxAge = 24 * time.Hour
 
 func (c *backupDeletionController) deleteExpiredRequests() {
@@ -36,6 +36,7 @@ import (
 	core "k8s.io/client-go/testing"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/builder"
@@ -47,7 +48,9 @@ import (
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
+	"github.com/vmware-tanzu/velero/pkg/restic"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -64,6 +67,7 @@ func TestBackupDeletionControllerProcessQueueItem(t *testing.T) {
 		client.VeleroV1(), // restoreClient
 		NewBackupTracker(),
 		nil, // restic repository manager
+		nil, // credentials file store
 		sharedInformers.Velero().V1().PodVolumeBackups().Lister(),
 		nil,
 		sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
@@ -162,6 +166,7 @@ func setupBackupDeletionControllerTest(t *testing.T, objects ...runtime.Object)
 			client.VeleroV1(), // restoreClient
 			NewBackupTracker(),
 			nil, // restic repository manager
+			nil, // credentials file store
 			sharedInformers.Velero().V1().PodVolumeBackups().Lister(),
 			fakeClient,
 			sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
@@ -426,6 +431,289 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 		assert.Equal(t, expectedActions, td.client.Actions())
 	})
 
+	t.Run("backup whose objects are still retained isn't deleted", func(t *testing.T) {
+		now := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").RetainUntil(now.Add(time.Hour)).Result()
+		location := builder.ForBackupStorageLocation("velero", "default").Result()
+
+		td := setupBackupDeletionControllerTest(t, location, backup)
+		td.controller.clock = clock.NewFakeClock(now)
+
+		err := td.controller.processRequest(td.req)
+		require.NoError(t, err)
+
+		expectedActions := []core.Action{
+			core.NewGetAction(
+				velerov1api.SchemeGroupVersion.WithResource("backups"),
+				td.req.Namespace,
+				td.req.Spec.BackupName,
+			),
+			core.NewPatchAction(
+				velerov1api.SchemeGroupVersion.WithResource("deletebackuprequests"),
+				td.req.Namespace,
+				td.req.Name,
+				types.MergePatchType,
+				[]byte(`{"status":{"lockedUntil":"2021-10-01T13:00:00Z"}}`),
+			),
+		}
+
+		assert.Equal(t, expectedActions, td.client.Actions())
+	})
+
+	t.Run("backup with a legal hold on its objects isn't deleted", func(t *testing.T) {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").LegalHold(true).Result()
+		location := builder.ForBackupStorageLocation("velero", "default").Result()
+
+		td := setupBackupDeletionControllerTest(t, location, backup)
+		td.backupStore.On("GetBackupObjectLock", "foo").Return(&velero.ObjectLockedError{Key: "backups/foo/velero-backup.json", LegalHold: true}, nil)
+
+		err := td.controller.processRequest(td.req)
+		require.NoError(t, err)
+
+		expectedActions := []core.Action{
+			core.NewGetAction(
+				velerov1api.SchemeGroupVersion.WithResource("backups"),
+				td.req.Namespace,
+				td.req.Spec.BackupName,
+			),
+			core.NewPatchAction(
+				velerov1api.SchemeGroupVersion.WithResource("deletebackuprequests"),
+				td.req.Namespace,
+				td.req.Name,
+				types.MergePatchType,
+				[]byte(`{"status":{"legalHold":true}}`),
+			),
+		}
+
+		assert.Equal(t, expectedActions, td.client.Actions())
+		td.backupStore.AssertNotCalled(t, "DeleteBackup", "foo")
+	})
+
+	t.Run("backup whose legal hold can't be checked isn't deleted", func(t *testing.T) {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").LegalHold(true).Result()
+		location := builder.ForBackupStorageLocation("velero", "default").Result()
+
+		td := setupBackupDeletionControllerTest(t, location, backup)
+		td.backupStore.On("GetBackupObjectLock", "foo").Return(nil, velero.ErrObjectRetentionNotSupported)
+
+		err := td.controller.processRequest(td.req)
+		require.NoError(t, err)
+
+		expectedActions := []core.Action{
+			core.NewGetAction(
+				velerov1api.SchemeGroupVersion.WithResource("backups"),
+				td.req.Namespace,
+				td.req.Spec.BackupName,
+			),
+			core.NewPatchAction(
+				velerov1api.SchemeGroupVersion.WithResource("deletebackuprequests"),
+				td.req.Namespace,
+				td.req.Name,
+				types.MergePatchType,
+				[]byte(`{"status":{"legalHold":true}}`),
+			),
+		}
+
+		assert.Equal(t, expectedActions, td.client.Actions())
+	})
+
+	t.Run("backup whose legal hold was removed is deleted", func(t *testing.T) {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").LegalHold(true).Result()
+		backup.UID = "uid"
+		location := builder.ForBackupStorageLocation("velero", "default").Bucket("bucket").Result()
+
+		td := setupBackupDeletionControllerTest(t, location, backup)
+
+		td.client.PrependReactor("patch", "backups", func(action core.Action) (bool, runtime.Object, error) {
+			return true, backup, nil
+		})
+
+		pluginManager := &pluginmocks.Manager{}
+		pluginManager.On("GetDeleteItemActions").Return(nil, nil)
+		pluginManager.On("CleanupClients")
+		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
+
+		td.backupStore.On("GetBackupObjectLock", "foo").Return(nil, nil)
+		td.backupStore.On("GetBackupVolumeSnapshots", td.req.Spec.BackupName).Return(nil, nil)
+		td.backupStore.On("DeleteBackup", td.req.Spec.BackupName).Return(nil)
+
+		err := td.controller.processRequest(td.req)
+		require.NoError(t, err)
+
+		expectedActions := []core.Action{
+			core.NewGetAction(
+				velerov1api.SchemeGroupVersion.WithResource("backups"),
+				td.req.Namespace,
+				td.req.Spec.BackupName,
+			),
+			core.NewPatchAction(
+				velerov1api.SchemeGroupVersion.WithResource("deletebackuprequests"),
+				td.req.Namespace,
+				td.req.Name,
+				types.MergePatchType,
+				[]byte(`{"status":{"phase":"InProgress"}}`),
+			),
+			core.NewPatchAction(
+				velerov1api.SchemeGroupVersion.WithResource("backups"),
+				td.req.Namespace,
+				td.req.Spec.BackupName,
+				types.MergePatchType,
+				[]byte(`{"status":{"phase":"Deleting"}}`),
+			),
+			core.NewDeleteAction(
+				velerov1api.SchemeGroupVersion.WithResource("backups"),
+				td.req.Namespace,
+				td.req.Spec.BackupName,
+			),
+			core.NewPatchAction(
+				velerov1api.SchemeGroupVersion.WithResource("deletebackuprequests"),
+				td.req.Namespace,
+				td.req.Name,
+				types.MergePatchType,
+				[]byte(`{"status":{"phase":"Processed"}}`),
+			),
+			core.NewDeleteCollectionAction(
+				velerov1api.SchemeGroupVersion.WithResource("deletebackuprequests"),
+				td.req.Namespace,
+				pkgbackup.NewDeleteBackupRequestListOptions(td.req.Spec.BackupName, "uid"),
+			),
+		}
+
+		velerotest.CompareActions(t, expectedActions, td.client.Actions())
+		td.backupStore.AssertCalled(t, "DeleteBackup", "foo")
+	})
+
+	t.Run("backup whose copy has a legal hold in a mirror storage location isn't deleted", func(t *testing.T) {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").Replicas(
+			velerov1api.BackupReplicaStatus{StorageLocation: "held", Phase: velerov1api.BackupReplicaPhaseCompleted},
+		).Result()
+		location := builder.ForBackupStorageLocation("velero", "default").Result()
+		held := builder.ForBackupStorageLocation("velero", "held").ObjectLock(&velerov1api.ObjectLockSettings{
+			LegalHold: true,
+		}).Result()
+
+		td := setupBackupDeletionControllerTest(t, location, held, backup)
+		td.backupStore.On("GetBackupObjectLock", "foo").Return(&velero.ObjectLockedError{Key: "backups/foo/velero-backup.json", LegalHold: true}, nil)
+
+		err := td.controller.processRequest(td.req)
+		require.NoError(t, err)
+
+		expectedActions := []core.Action{
+			core.NewGetAction(
+				velerov1api.SchemeGroupVersion.WithResource("backups"),
+				td.req.Namespace,
+				td.req.Spec.BackupName,
+			),
+			core.NewPatchAction(
+				velerov1api.SchemeGroupVersion.WithResource("deletebackuprequests"),
+				td.req.Namespace,
+				td.req.Name,
+				types.MergePatchType,
+				[]byte(`{"status":{"legalHold":true}}`),
+			),
+		}
+
+		assert.Equal(t, expectedActions, td.client.Actions())
+	})
+
+	t.Run("backup whose copy is still retained in a mirror storage location isn't deleted", func(t *testing.T) {
+		now := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").Replicas(
+			velerov1api.BackupReplicaStatus{StorageLocation: "unlocked", Phase: velerov1api.BackupReplicaPhaseCompleted, CompletionTimestamp: &metav1.Time{Time: now.Add(-time.Hour)}},
+			velerov1api.BackupReplicaStatus{StorageLocation: "locked", Phase: velerov1api.BackupReplicaPhaseCompleted, CompletionTimestamp: &metav1.Time{Time: now.Add(-time.Hour)}},
+			velerov1api.BackupReplicaStatus{StorageLocation: "never-copied", Phase: velerov1api.BackupReplicaPhaseNew},
+		).Result()
+		location := builder.ForBackupStorageLocation("velero", "default").Result()
+		unlocked := builder.ForBackupStorageLocation("velero", "unlocked").Result()
+		locked := builder.ForBackupStorageLocation("velero", "locked").ObjectLock(&velerov1api.ObjectLockSettings{
+			RetentionPeriod: &metav1.Duration{Duration: 24 * time.Hour},
+		}).Result()
+		neverCopied := builder.ForBackupStorageLocation("velero", "never-copied").ObjectLock(&velerov1api.ObjectLockSettings{
+			LegalHold: true,
+		}).Result()
+
+		td := setupBackupDeletionControllerTest(t, location, unlocked, locked, neverCopied, backup)
+		td.controller.clock = clock.NewFakeClock(now)
+
+		err := td.controller.processRequest(td.req)
+		require.NoError(t, err)
+
+		expectedActions := []core.Action{
+			core.NewGetAction(
+				velerov1api.SchemeGroupVersion.WithResource("backups"),
+				td.req.Namespace,
+				td.req.Spec.BackupName,
+			),
+			core.NewPatchAction(
+				velerov1api.SchemeGroupVersion.WithResource("deletebackuprequests"),
+				td.req.Namespace,
+				td.req.Name,
+				types.MergePatchType,
+				[]byte(`{"status":{"lockedUntil":"2021-10-02T11:00:00Z"}}`),
+			),
+		}
+
+		assert.Equal(t, expectedActions, td.client.Actions())
+		td.backupStore.AssertNotCalled(t, "GetBackupVolumeSnapshots", "foo")
+	})
+
+	t.Run("backup whose objects are locked by a legal hold is kept", func(t *testing.T) {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").Result()
+		backup.UID = "uid"
+		location := builder.ForBackupStorageLocation("velero", "default").Bucket("bucket").Result()
+		restore := builder.ForRestore("velero", "restore-1").Phase(velerov1api.RestorePhaseCompleted).Backup("foo").Result()
+
+		td := setupBackupDeletionControllerTest(t, location, backup, restore)
+		td.sharedInformers.Velero().V1().Restores().Informer().GetStore().Add(restore)
+
+		td.client.PrependReactor("patch", "backups", func(action core.Action) (bool, runtime.Object, error) {
+			return true, backup, nil
+		})
+
+		pluginManager := &pluginmocks.Manager{}
+		pluginManager.On("GetDeleteItemActions").Return(nil, nil)
+		pluginManager.On("CleanupClients")
+		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
+
+		td.backupStore.On("GetBackupVolumeSnapshots", td.req.Spec.BackupName).Return(nil, nil)
+		td.backupStore.On("DeleteBackup", td.req.Spec.BackupName).Return(&velero.ObjectLockedError{Key: "backups/foo/foo.tar.gz", LegalHold: true})
+
+		err := td.controller.processRequest(td.req)
+		require.NoError(t, err)
+
+		expectedActions := []core.Action{
+			core.NewGetAction(
+				velerov1api.SchemeGroupVersion.WithResource("backups"),
+				td.req.Namespace,
+				td.req.Spec.BackupName,
+			),
+			core.NewPatchAction(
+				velerov1api.SchemeGroupVersion.WithResource("deletebackuprequests"),
+				td.req.Namespace,
+				td.req.Name,
+				types.MergePatchType,
+				[]byte(`{"status":{"phase":"InProgress"}}`),
+			),
+			core.NewPatchAction(
+				velerov1api.SchemeGroupVersion.WithResource("backups"),
+				td.req.Namespace,
+				td.req.Spec.BackupName,
+				types.MergePatchType,
+				[]byte(`{"status":{"phase":"Deleting"}}`),
+			),
+			core.NewPatchAction(
+				velerov1api.SchemeGroupVersion.WithResource("deletebackuprequests"),
+				td.req.Namespace,
+				td.req.Name,
+				types.MergePatchType,
+				[]byte(`{"status":{"legalHold":true}}`),
+			),
+		}
+
+		velerotest.CompareActions(t, expectedActions, td.client.Actions())
+		td.backupStore.AssertNotCalled(t, "DeleteRestore", "restore-1")
+	})
+
 	t.Run("full delete, no errors", func(t *testing.T) {
 		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").Result()
 		backup.UID = "uid"
@@ -1120,6 +1408,7 @@ func TestBackupDeletionControllerDeleteExpiredRequests(t *testing.T) {
 				client.VeleroV1(), // restoreClient
 				NewBackupTracker(),
 				nil,
+				nil,
 				sharedInformers.Velero().V1().PodVolumeBackups().Lister(),
 				fakeClient,
 				sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
@@ -1151,3 +1440,259 @@ func TestBackupDeletionControllerDeleteExpiredRequests(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteSnapshotCopy(t *testing.T) {
+	sharedInformers := informers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0)
+	location := builder.ForVolumeSnapshotLocation(velerov1api.DefaultNamespace, "vsl-1").
+		Provider("provider-1").
+		Config(map[string]string{"region": "us-east-1"}).
+		CopyConfig(map[string]string{"region": "us-west-2"}).
+		Result()
+	require.NoError(t, sharedInformers.Velero().V1().VolumeSnapshotLocations().Informer().GetStore().Add(location))
+
+	c := &backupDeletionController{
+		snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+	}
+
+	// the copy is deleted using the config it was made with, rather than the location's current copy config
+	volumeSnapshotter := new(mocks.VolumeSnapshotter)
+	volumeSnapshotter.On("Init", map[string]string{"region": "eu-west-1"}).Return(nil)
+	volumeSnapshotter.On("DeleteSnapshot", "copy-1").Return(nil)
+
+	pluginManager := new(pluginmocks.Manager)
+	pluginManager.On("GetVolumeSnapshotter", "provider-1").Return(volumeSnapshotter, nil)
+
+	snapshot := &volume.Snapshot{
+		Spec: volume.SnapshotSpec{
+			Location: "vsl-1",
+		},
+		Status: volume.SnapshotStatus{
+			ProviderSnapshotID: "snap-1",
+			Copy: &volume.SnapshotCopyStatus{
+				Config:             map[string]string{"region": "eu-west-1"},
+				ProviderSnapshotID: "copy-1",
+				Phase:              volume.SnapshotPhaseCompleted,
+			},
+		},
+	}
+
+	require.NoError(t, c.deleteSnapshotCopy(velerov1api.DefaultNamespace, snapshot, pluginManager))
+	volumeSnapshotter.AssertExpectations(t)
+}
+
+// configRecordingVolumeSnapshotter is a volume snapshotter that records the config it
+// was last initialized with when each snapshot is deleted.
+type configRecordingVolumeSnapshotter struct {
+	velerotest.FakeVolumeSnapshotter
+	config  map[string]string
+	deleted map[string]map[string]string
+}
+
+func (vs *configRecordingVolumeSnapshotter) Init(config map[string]string) error {
+	vs.config = config
+	return nil
+}
+
+func (vs *configRecordingVolumeSnapshotter) DeleteSnapshot(snapshotID string) error {
+	vs.deleted[snapshotID] = vs.config
+	return nil
+}
+
+func TestDeleteVolumeSnapshotsWithCopies(t *testing.T) {
+	sharedInformers := informers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0)
+	location := builder.ForVolumeSnapshotLocation(velerov1api.DefaultNamespace, "vsl-1").
+		Provider("provider-1").
+		Config(map[string]string{"region": "us-east-1"}).
+		CopyConfig(map[string]string{"region": "us-west-2"}).
+		Result()
+	require.NoError(t, sharedInformers.Velero().V1().VolumeSnapshotLocations().Informer().GetStore().Add(location))
+
+	// the volume snapshotters of a plugin manager share their plugin process, so they're
+	// all the same volume snapshotter
+	deleted := make(map[string]map[string]string)
+	var pluginManagers []*pluginmocks.Manager
+	newPluginManager := func(logrus.FieldLogger) clientmgmt.Manager {
+		pluginManager := new(pluginmocks.Manager)
+		pluginManager.On("GetVolumeSnapshotter", "provider-1").Return(&configRecordingVolumeSnapshotter{deleted: deleted}, nil)
+		pluginManager.On("CleanupClients").Return(nil)
+		pluginManagers = append(pluginManagers, pluginManager)
+		return pluginManager
+	}
+
+	c := &backupDeletionController{
+		snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+		newPluginManager:       newPluginManager,
+	}
+
+	copyConfig := map[string]string{"region": "us-west-2"}
+	snapshots := []*volume.Snapshot{
+		{
+			Spec: volume.SnapshotSpec{Location: "vsl-1"},
+			Status: volume.SnapshotStatus{
+				ProviderSnapshotID: "snap-1",
+				Copy:               &volume.SnapshotCopyStatus{Config: copyConfig, ProviderSnapshotID: "copy-1", Phase: volume.SnapshotPhaseCompleted},
+			},
+		},
+		{
+			Spec: volume.SnapshotSpec{Location: "vsl-1"},
+			Status: volume.SnapshotStatus{
+				ProviderSnapshotID: "snap-2",
+				Copy:               &volume.SnapshotCopyStatus{Config: copyConfig, ProviderSnapshotID: "copy-2", Phase: volume.SnapshotPhaseCompleted},
+			},
+		},
+	}
+
+	// deleting a copy doesn't reconfigure the volume snapshotter deleting the snapshots
+	pluginManager := newPluginManager(velerotest.NewLogger())
+	errs := c.deleteVolumeSnapshots(velerov1api.DefaultNamespace, snapshots, pluginManager, velerotest.NewLogger())
+	assert.Empty(t, errs)
+
+	assert.Equal(t, map[string]map[string]string{
+		"copy-1": copyConfig,
+		"snap-1": location.Spec.Config,
+		"copy-2": copyConfig,
+		"snap-2": location.Spec.Config,
+	}, deleted)
+
+	// the plugin manager for the copies is cleaned up
+	require.Len(t, pluginManagers, 2)
+	pluginManagers[1].AssertCalled(t, "CleanupClients")
+}
+
+func TestDeleteBackupReplica(t *testing.T) {
+	tests := []struct {
+		name        string
+		replica     velerov1api.BackupReplicaStatus
+		location    *velerov1api.BackupStorageLocation
+		deleteErr   error
+		expectErr   bool
+		expectStore bool
+	}{
+		{
+			name:    "replica that was never copied is skipped",
+			replica: velerov1api.BackupReplicaStatus{StorageLocation: "mirror", Phase: velerov1api.BackupReplicaPhaseNew},
+		},
+		{
+			name:    "failed replica in a nonexistent location is skipped",
+			replica: velerov1api.BackupReplicaStatus{StorageLocation: "mirror", Phase: velerov1api.BackupReplicaPhaseFailed},
+		},
+		{
+			name:      "completed replica in a nonexistent location is an error",
+			replica:   velerov1api.BackupReplicaStatus{StorageLocation: "mirror", Phase: velerov1api.BackupReplicaPhaseCompleted},
+			expectErr: true,
+		},
+		{
+			name:      "replica in a read-only location is an error",
+			replica:   velerov1api.BackupReplicaStatus{StorageLocation: "mirror", Phase: velerov1api.BackupReplicaPhaseCompleted},
+			location:  builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "mirror").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
+			expectErr: true,
+		},
+		{
+			name:        "completed replica is deleted",
+			replica:     velerov1api.BackupReplicaStatus{StorageLocation: "mirror", Phase: velerov1api.BackupReplicaPhaseCompleted},
+			location:    builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "mirror").Bucket("mirror-bucket").Result(),
+			expectStore: true,
+		},
+		{
+			name:        "failed replica is deleted, in case it was partially copied",
+			replica:     velerov1api.BackupReplicaStatus{StorageLocation: "mirror", Phase: velerov1api.BackupReplicaPhaseFailed},
+			location:    builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "mirror").Bucket("mirror-bucket").Result(),
+			expectStore: true,
+		},
+		{
+			name:        "error deleting replica is returned",
+			replica:     velerov1api.BackupReplicaStatus{StorageLocation: "mirror", Phase: velerov1api.BackupReplicaPhaseCompleted},
+			location:    builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "mirror").Bucket("mirror-bucket").Result(),
+			deleteErr:   errors.New("delete error"),
+			expectErr:   true,
+			expectStore: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var objs []runtime.Object
+			if test.location != nil {
+				objs = append(objs, test.location)
+			}
+
+			backupStore := new(persistencemocks.BackupStore)
+			if test.expectStore {
+				backupStore.On("DeleteBackup", "backup-1").Return(test.deleteErr)
+			}
+
+			c := &backupDeletionController{
+				kbClient:          velerotest.NewFakeControllerRuntimeClient(t, objs...),
+				backupStoreGetter: NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{"mirror": backupStore}),
+			}
+
+			backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Replicas(test.replica).Result()
+			err := c.deleteBackupReplica(backup, test.replica, new(pluginmocks.Manager), velerotest.NewLogger())
+			if test.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			backupStore.AssertExpectations(t)
+		})
+	}
+}
+
+// fakeForgetRepositoryManager records the restic snapshots that are forgotten.
+type fakeForgetRepositoryManager struct {
+	restic.RepositoryManager
+
+	forgotten []string
+}
+
+func (m *fakeForgetRepositoryManager) Forget(_ context.Context, snapshot restic.SnapshotIdentifier) error {
+	m.forgotten = append(m.forgotten, snapshot.SnapshotID)
+	return nil
+}
+
+func TestDeleteResticSnapshotsDeletesKopiaSnapshots(t *testing.T) {
+	var (
+		client          = fake.NewSimpleClientset()
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+		location        = builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Provider("aws").Bucket("bucket").Result()
+		backup          = builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").Result()
+		repoManager     = &fakeForgetRepositoryManager{}
+		kopiaDeleted    []string
+	)
+
+	newPVB := func(name, uploaderType, snapshotID string) *velerov1api.PodVolumeBackup {
+		pvb := builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, name).
+			ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, backup.Name)).
+			PodNamespace("ns-1").
+			SnapshotID(snapshotID).
+			Result()
+		pvb.Spec.UploaderType = uploaderType
+		pvb.Spec.RepoIdentifier = "s3:s3.amazonaws.com/bucket/restic/ns-1"
+		pvb.Spec.RepositoryKeySecret = "ns-1-repo-key"
+		return pvb
+	}
+	for _, pvb := range []*velerov1api.PodVolumeBackup{
+		newPVB("pvb-1", "", "restic-1"),
+		newPVB("pvb-2", uploader.KopiaType, "kopia-1"),
+	} {
+		require.NoError(t, sharedInformers.Velero().V1().PodVolumeBackups().Informer().GetStore().Add(pvb))
+	}
+
+	c := &backupDeletionController{
+		genericController:     newGenericController(BackupDeletion, velerotest.NewLogger()),
+		resticMgr:             repoManager,
+		podvolumeBackupLister: sharedInformers.Velero().V1().PodVolumeBackups().Lister(),
+		kbClient:              velerotest.NewFakeControllerRuntimeClient(t, location),
+		deleteKopiaSnapshot: func(_ context.Context, snapshotID, repoIdentifier, repoKeySecret string, bsl *velerov1api.BackupStorageLocation, _ credentials.FileStore, _ logrus.FieldLogger) error {
+			assert.Equal(t, "s3:s3.amazonaws.com/bucket/restic/ns-1", repoIdentifier)
+			assert.Equal(t, "ns-1-repo-key", repoKeySecret)
+			assert.Equal(t, "bucket", bsl.Spec.ObjectStorage.Bucket)
+			kopiaDeleted = append(kopiaDeleted, snapshotID)
+			return nil
+		},
+	}
+
+	assert.Empty(t, c.deleteResticSnapshots(backup))
+	assert.Equal(t, []string{"restic-1"}, repoManager.forgotten)
+	assert.Equal(t, []string{"kopia-1"}, kopiaDeleted)
+}
@@ -0,0 +1,401 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
+	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
+	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+)
+
+const (
+	backupReplicationResyncPeriod = 10 * time.Minute
+
+	// maxReplicaAttempts is the number of times copying a backup to one of its
+	// mirror storage locations is attempted before the copy is given up on.
+	maxReplicaAttempts = 5
+
+	// replicaRetryBaseDelay is the delay before the first retry of a failed
+	// copy. It's doubled for each following retry, up to replicaRetryMaxDelay.
+	replicaRetryBaseDelay = time.Minute
+	replicaRetryMaxDelay  = time.Hour
+)
+
+// backupReplicationController copies completed backups to their mirror
+// storage locations, and records the status of each copy on the backup.
+type backupReplicationController struct {
+	*genericController
+
+	backupLister      velerov1listers.BackupLister
+	backupClient      velerov1client.BackupsGetter
+	kbClient          client.Client
+	backupTracker     BackupTracker
+	newPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
+	backupStoreGetter persistence.ObjectBackupStoreGetter
+	clock             clock.Clock
+}
+
+// NewBackupReplicationController constructs a new backupReplicationController.
+func NewBackupReplicationController(
+	logger logrus.FieldLogger,
+	backupInformer velerov1informers.BackupInformer,
+	backupClient velerov1client.BackupsGetter,
+	kbClient client.Client,
+	backupTracker BackupTracker,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	backupStoreGetter persistence.ObjectBackupStoreGetter,
+) Interface {
+	c := &backupReplicationController{
+		genericController: newGenericController(BackupReplication, logger),
+		backupLister:      backupInformer.Lister(),
+		backupClient:      backupClient,
+		kbClient:          kbClient,
+		backupTracker:     backupTracker,
+		newPluginManager:  newPluginManager,
+		backupStoreGetter: backupStoreGetter,
+		clock:             &clock.RealClock{},
+	}
+
+	c.syncHandler = c.processQueueItem
+	c.resyncPeriod = backupReplicationResyncPeriod
+	c.resyncFunc = c.enqueueAllBackups
+
+	backupInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.enqueue,
+			UpdateFunc: func(_, obj interface{}) { c.enqueue(obj) },
+		},
+	)
+
+	return c
+}
+
+// enqueueAllBackups lists all backups from cache and enqueues all of them so that
+// copies that failed to be started are retried.
+func (c *backupReplicationController) enqueueAllBackups() {
+	backups, err := c.backupLister.List(labels.Everything())
+	if err != nil {
+		c.logger.WithError(errors.WithStack(err)).Error("error listing backups")
+		return
+	}
+
+	for _, backup := range backups {
+		c.enqueue(backup)
+	}
+}
+
+func (c *backupReplicationController) processQueueItem(key string) error {
+	log := c.logger.WithField("backup", key)
+
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return errors.Wrap(err, "error splitting queue key")
+	}
+
+	backup, err := c.backupLister.Backups(ns).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.Debug("Unable to find backup")
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "error getting backup")
+	}
+
+	if !needsReplication(backup, c.clock.Now()) {
+		// failed copies that will be retried later are requeued for then,
+		// since the backup may not be updated or resynced before
+		if retry, ok := nextReplicaRetry(backup); ok {
+			c.queue.AddAfter(key, retry.Sub(c.clock.Now()))
+		}
+		return nil
+	}
+
+	// track the backup as in progress while it's copied, so that it can't be
+	// deleted until all of its copies are recorded
+	c.backupTracker.Add(ns, name)
+	defer c.backupTracker.Delete(ns, name)
+
+	// get the latest version of the backup, in case it started being deleted
+	// since the informer's cache was updated
+	backup, err = c.backupClient.Backups(ns).Get(context.TODO(), name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "error getting backup")
+	}
+	if !needsReplication(backup, c.clock.Now()) {
+		return nil
+	}
+
+	return c.replicateBackup(backup, log)
+}
+
+// needsReplication returns true if the backup completed, and has copies that
+// haven't been completed or failed, or failed copies that are due a retry.
+func needsReplication(backup *velerov1api.Backup, now time.Time) bool {
+	if !replicable(backup) {
+		return false
+	}
+
+	for _, replica := range backup.Status.Replicas {
+		if replicaPending(replica, now) {
+			return true
+		}
+	}
+	return false
+}
+
+// replicable returns true if the backup is in a phase in which it can be copied.
+func replicable(backup *velerov1api.Backup) bool {
+	switch backup.Status.Phase {
+	case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// replicaPending returns true if the copy of a backup hasn't been completed or
+// failed, or if it failed and is due a retry. Copies that are in progress are
+// pending, since they're only in progress while being copied by this
+// controller, which must have been interrupted if it's not copying them.
+func replicaPending(replica velerov1api.BackupReplicaStatus, now time.Time) bool {
+	switch replica.Phase {
+	case "", velerov1api.BackupReplicaPhaseNew, velerov1api.BackupReplicaPhaseInProgress:
+		return true
+	case velerov1api.BackupReplicaPhaseFailed:
+		retry, ok := replicaRetryTime(replica)
+		return ok && !now.Before(retry)
+	default:
+		return false
+	}
+}
+
+// replicaRetryTime returns the time at which the failed copy of a backup
+// should be retried, and false if it shouldn't be retried because it isn't
+// failed or has no attempts left.
+func replicaRetryTime(replica velerov1api.BackupReplicaStatus) (time.Time, bool) {
+	if replica.Phase != velerov1api.BackupReplicaPhaseFailed || replica.Attempts >= maxReplicaAttempts {
+		return time.Time{}, false
+	}
+
+	var failed time.Time
+	if replica.CompletionTimestamp != nil {
+		failed = replica.CompletionTimestamp.Time
+	}
+	return failed.Add(replicaRetryDelay(replica.Attempts)), true
+}
+
+// replicaRetryDelay returns the delay before retrying a copy that has failed
+// the given number of attempts.
+func replicaRetryDelay(attempts int) time.Duration {
+	delay := replicaRetryBaseDelay
+	for i := 1; i < attempts && delay < replicaRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > replicaRetryMaxDelay {
+		delay = replicaRetryMaxDelay
+	}
+	return delay
+}
+
+// nextReplicaRetry returns the earliest time at which one of the backup's
+// failed copies should be retried, and false if none of them will be.
+func nextReplicaRetry(backup *velerov1api.Backup) (time.Time, bool) {
+	if !replicable(backup) {
+		return time.Time{}, false
+	}
+
+	var (
+		next  time.Time
+		found bool
+	)
+	for _, replica := range backup.Status.Replicas {
+		retry, ok := replicaRetryTime(replica)
+		if !ok {
+			continue
+		}
+		if !found || retry.Before(next) {
+			next, found = retry, true
+		}
+	}
+	return next, found
+}
+
+// replicaWarning returns a description of the backup's data that isn't copied
+// to its mirror storage locations, or an empty string if all of it is. Only
+// the backup's files in object storage are copied: its volume snapshots are
+// stored by the volume snapshot locations, and its restic backups by the
+// restic repositories of the backup's storage location.
+func replicaWarning(backup *velerov1api.Backup, source persistence.BackupStore, log logrus.FieldLogger) string {
+	var missing []string
+	if n := backup.Status.VolumeSnapshotsCompleted; n > 0 {
+		missing = append(missing, fmt.Sprintf("%d volume snapshot(s)", n))
+	}
+
+	podVolumeBackups, err := source.GetPodVolumeBackups(backup.Name)
+	if err != nil {
+		log.WithError(err).Warn("Error getting pod volume backups of backup")
+	}
+	if n := len(podVolumeBackups); n > 0 {
+		missing = append(missing, fmt.Sprintf("%d restic pod volume backup(s)", n))
+	}
+
+	if len(missing) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("the copy only includes the backup's files in object storage: the data of its %s isn't copied and can only be restored while the backup's original locations are available", strings.Join(missing, " and "))
+}
+
+// replicateBackup copies the backup to each of the storage locations of its
+// pending copies, updating the backup's status as each copy starts and ends.
+func (c *backupReplicationController) replicateBackup(backup *velerov1api.Backup, log logrus.FieldLogger) error {
+	location := &velerov1api.BackupStorageLocation{}
+	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
+		Namespace: backup.Namespace,
+		Name:      backup.Spec.StorageLocation,
+	}, location); err != nil {
+		if apierrors.IsNotFound(err) {
+			return c.failPendingReplicas(backup, fmt.Sprintf("backup storage location %s of the backup not found", backup.Spec.StorageLocation))
+		}
+		return errors.Wrap(err, "error getting backup storage location")
+	}
+
+	pluginManager := c.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	source, err := c.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		return err
+	}
+
+	warning := replicaWarning(backup, source, log)
+
+	for i := range backup.Status.Replicas {
+		if !replicaPending(backup.Status.Replicas[i], c.clock.Now()) {
+			continue
+		}
+
+		replicaLog := log.WithField("mirrorStorageLocation", backup.Status.Replicas[i].StorageLocation)
+
+		original := backup.DeepCopy()
+		backup.Status.Replicas[i].Phase = velerov1api.BackupReplicaPhaseInProgress
+		if backup, err = patchBackup(original, backup, c.backupClient); err != nil {
+			return err
+		}
+
+		replica := &backup.Status.Replicas[i]
+		original = backup.DeepCopy()
+		if err := c.replicateBackupTo(backup, replica.StorageLocation, source, pluginManager, replicaLog); err != nil {
+			replicaLog.WithError(err).Error("Error copying backup to mirror storage location")
+			replica.Phase = velerov1api.BackupReplicaPhaseFailed
+			replica.FailureReason = err.Error()
+			replica.Warning = ""
+		} else {
+			replicaLog.Info("Copied backup to mirror storage location")
+			replica.Phase = velerov1api.BackupReplicaPhaseCompleted
+			replica.FailureReason = ""
+			replica.Warning = warning
+			if warning != "" {
+				replicaLog.Warn(warning)
+			}
+		}
+		replica.Attempts++
+		replica.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+
+		if backup, err = patchBackup(original, backup, c.backupClient); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// replicateBackupTo copies the backup from the source backup store to the named
+// backup storage location, unless the backup is already there.
+func (c *backupReplicationController) replicateBackupTo(backup *velerov1api.Backup, locationName string, source persistence.BackupStore, pluginManager clientmgmt.Manager, log logrus.FieldLogger) error {
+	location := &velerov1api.BackupStorageLocation{}
+	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
+		Namespace: backup.Namespace,
+		Name:      locationName,
+	}, location); err != nil {
+		if apierrors.IsNotFound(err) {
+			return errors.Errorf("backup storage location %s not found", locationName)
+		}
+		return errors.Wrap(err, "error getting backup storage location")
+	}
+
+	if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		return errors.Errorf("backup storage location %s is in read-only mode", locationName)
+	}
+
+	destination, err := c.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		return err
+	}
+
+	// the backup may already have been copied, if the controller was
+	// interrupted before recording that it was, or if the backup was synced
+	// from the mirror storage location
+	exists, err := destination.BackupExists(location.Spec.ObjectStorage.Bucket, backup.Name)
+	if err != nil {
+		return errors.Wrap(err, "error checking if backup exists in mirror storage location")
+	}
+	if exists {
+		log.Info("Backup already exists in mirror storage location")
+		return nil
+	}
+
+	log.Info("Copying backup to mirror storage location")
+	return persistence.CopyBackup(backup.Name, source, destination)
+}
+
+// failPendingReplicas marks all of the backup's pending copies as failed, so
+// that they're retried later.
+func (c *backupReplicationController) failPendingReplicas(backup *velerov1api.Backup, reason string) error {
+	original := backup.DeepCopy()
+	for i := range backup.Status.Replicas {
+		if !replicaPending(backup.Status.Replicas[i], c.clock.Now()) {
+			continue
+		}
+		backup.Status.Replicas[i].Phase = velerov1api.BackupReplicaPhaseFailed
+		backup.Status.Replicas[i].FailureReason = reason
+		backup.Status.Replicas[i].Attempts++
+		backup.Status.Replicas[i].CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+	}
+
+	_, err := patchBackup(original, backup, c.backupClient)
+	return err
+}
@@ -0,0 +1,230 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestBackupReplicationControllerProcessQueueItem(t *testing.T) {
+	now, err := time.Parse(time.RFC1123Z, time.RFC1123Z)
+	require.NoError(t, err)
+	now = now.Local()
+
+	newBackup := func(phase velerov1api.BackupPhase, replicas ...velerov1api.BackupReplicaStatus) *velerov1api.Backup {
+		return builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").
+			StorageLocation("default").
+			Phase(phase).
+			Replicas(replicas...).
+			Result()
+	}
+	replica := func(location string, phase velerov1api.BackupReplicaPhase) velerov1api.BackupReplicaStatus {
+		return velerov1api.BackupReplicaStatus{StorageLocation: location, Phase: phase}
+	}
+	finished := func(location string, phase velerov1api.BackupReplicaPhase, reason string, attempts int) velerov1api.BackupReplicaStatus {
+		return velerov1api.BackupReplicaStatus{StorageLocation: location, Phase: phase, FailureReason: reason, CompletionTimestamp: &metav1.Time{Time: now}, Attempts: attempts}
+	}
+	failedAt := func(location string, at time.Time, attempts int) velerov1api.BackupReplicaStatus {
+		return velerov1api.BackupReplicaStatus{StorageLocation: location, Phase: velerov1api.BackupReplicaPhaseFailed, FailureReason: "earlier error", CompletionTimestamp: &metav1.Time{Time: at}, Attempts: attempts}
+	}
+	withSnapshots := func(backup *velerov1api.Backup, count int) *velerov1api.Backup {
+		backup.Status.VolumeSnapshotsCompleted = count
+		return backup
+	}
+
+	tests := []struct {
+		name             string
+		backup           *velerov1api.Backup
+		podVolumeBackups []*velerov1api.PodVolumeBackup
+		mirrorExists     bool
+		copyErr          error
+		expectCopy       bool
+		expectedReplicas []velerov1api.BackupReplicaStatus
+	}{
+		{
+			name:             "backup that hasn't completed isn't copied",
+			backup:           newBackup(velerov1api.BackupPhaseInProgress, replica("mirror", velerov1api.BackupReplicaPhaseNew)),
+			expectedReplicas: []velerov1api.BackupReplicaStatus{replica("mirror", velerov1api.BackupReplicaPhaseNew)},
+		},
+		{
+			name:             "failed backup isn't copied",
+			backup:           newBackup(velerov1api.BackupPhaseFailed, replica("mirror", velerov1api.BackupReplicaPhaseNew)),
+			expectedReplicas: []velerov1api.BackupReplicaStatus{replica("mirror", velerov1api.BackupReplicaPhaseNew)},
+		},
+		{
+			name:             "completed backup is copied",
+			backup:           newBackup(velerov1api.BackupPhaseCompleted, replica("mirror", velerov1api.BackupReplicaPhaseNew)),
+			expectCopy:       true,
+			expectedReplicas: []velerov1api.BackupReplicaStatus{finished("mirror", velerov1api.BackupReplicaPhaseCompleted, "", 1)},
+		},
+		{
+			name:             "interrupted copy of partially failed backup is copied",
+			backup:           newBackup(velerov1api.BackupPhasePartiallyFailed, replica("mirror", velerov1api.BackupReplicaPhaseInProgress)),
+			expectCopy:       true,
+			expectedReplicas: []velerov1api.BackupReplicaStatus{finished("mirror", velerov1api.BackupReplicaPhaseCompleted, "", 1)},
+		},
+		{
+			name:             "backup that's already in the mirror storage location isn't copied again",
+			backup:           newBackup(velerov1api.BackupPhaseCompleted, replica("mirror", velerov1api.BackupReplicaPhaseNew)),
+			mirrorExists:     true,
+			expectedReplicas: []velerov1api.BackupReplicaStatus{finished("mirror", velerov1api.BackupReplicaPhaseCompleted, "", 1)},
+		},
+		{
+			name:       "failed copy is recorded",
+			backup:     newBackup(velerov1api.BackupPhaseCompleted, replica("mirror", velerov1api.BackupReplicaPhaseNew)),
+			copyErr:    errors.New("put error"),
+			expectCopy: true,
+			expectedReplicas: []velerov1api.BackupReplicaStatus{
+				finished("mirror", velerov1api.BackupReplicaPhaseFailed, "error putting backup file velero-backup.json: put error", 1),
+			},
+		},
+		{
+			name: "copies to missing and read-only locations fail, and failed copies that aren't due a retry are left alone",
+			backup: newBackup(velerov1api.BackupPhaseCompleted,
+				replica("missing", velerov1api.BackupReplicaPhaseNew),
+				replica("read-only", velerov1api.BackupReplicaPhaseNew),
+				failedAt("mirror", now.Add(-30*time.Second), 1),
+			),
+			expectedReplicas: []velerov1api.BackupReplicaStatus{
+				finished("missing", velerov1api.BackupReplicaPhaseFailed, "backup storage location missing not found", 1),
+				finished("read-only", velerov1api.BackupReplicaPhaseFailed, "backup storage location read-only is in read-only mode", 1),
+				failedAt("mirror", now.Add(-30*time.Second), 1),
+			},
+		},
+		{
+			name:             "failed copy that's due a retry is copied again",
+			backup:           newBackup(velerov1api.BackupPhaseCompleted, failedAt("mirror", now.Add(-2*time.Minute), 2)),
+			expectCopy:       true,
+			expectedReplicas: []velerov1api.BackupReplicaStatus{finished("mirror", velerov1api.BackupReplicaPhaseCompleted, "", 3)},
+		},
+		{
+			name:             "failed copy with no attempts left isn't copied again",
+			backup:           newBackup(velerov1api.BackupPhaseCompleted, failedAt("mirror", now.Add(-24*time.Hour), maxReplicaAttempts)),
+			expectedReplicas: []velerov1api.BackupReplicaStatus{failedAt("mirror", now.Add(-24*time.Hour), maxReplicaAttempts)},
+		},
+		{
+			name:   "copy of backup with volume data records a warning",
+			backup: withSnapshots(newBackup(velerov1api.BackupPhaseCompleted, replica("mirror", velerov1api.BackupReplicaPhaseNew)), 2),
+			podVolumeBackups: []*velerov1api.PodVolumeBackup{
+				builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").Result(),
+			},
+			expectCopy: true,
+			expectedReplicas: []velerov1api.BackupReplicaStatus{
+				{
+					StorageLocation:     "mirror",
+					Phase:               velerov1api.BackupReplicaPhaseCompleted,
+					CompletionTimestamp: &metav1.Time{Time: now},
+					Attempts:            1,
+					Warning:             "the copy only includes the backup's files in object storage: the data of its 2 volume snapshot(s) and 1 restic pod volume backup(s) isn't copied and can only be restored while the backup's original locations are available",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				client          = fake.NewSimpleClientset(test.backup)
+				sharedInformers = informers.NewSharedInformerFactory(client, 0)
+				fakeClient      = velerotest.NewFakeControllerRuntimeClient(t,
+					builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Bucket("bucket").Result(),
+					builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "mirror").Bucket("mirror-bucket").Result(),
+					builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "read-only").Bucket("read-only-bucket").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
+				)
+				pluginManager = new(pluginmocks.Manager)
+				source        = new(persistencemocks.BackupStore)
+				mirror        = new(persistencemocks.BackupStore)
+			)
+			defer source.AssertExpectations(t)
+			defer mirror.AssertExpectations(t)
+
+			pluginManager.On("CleanupClients").Return(nil)
+			mirror.On("BackupExists", "mirror-bucket", "backup-1").Return(test.mirrorExists, nil).Maybe()
+			source.On("GetPodVolumeBackups", "backup-1").Return(test.podVolumeBackups, nil).Maybe()
+			if test.expectCopy {
+				source.On("ListBackupFiles", "backup-1").Return([]string{"backup-1.tar.gz", "velero-backup.json"}, nil)
+				source.On("GetBackupFile", "backup-1", "backup-1.tar.gz").Return(ioutil.NopCloser(strings.NewReader("contents")), nil)
+				source.On("GetBackupFile", "backup-1", "velero-backup.json").Return(ioutil.NopCloser(strings.NewReader("metadata")), nil)
+				mirror.On("PutBackupFile", "backup-1", "backup-1.tar.gz", mock.Anything).Return(nil)
+				mirror.On("PutBackupFile", "backup-1", "velero-backup.json", mock.Anything).Return(test.copyErr)
+				if test.copyErr != nil {
+					mirror.On("DeleteBackup", "backup-1").Return(nil)
+				}
+			}
+
+			c := NewBackupReplicationController(
+				velerotest.NewLogger(),
+				sharedInformers.Velero().V1().Backups(),
+				client.VeleroV1(),
+				fakeClient,
+				NewBackupTracker(),
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{
+					"default": source,
+					"mirror":  mirror,
+				}),
+			).(*backupReplicationController)
+			c.clock = clock.NewFakeClock(now)
+
+			require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(test.backup))
+			require.NoError(t, c.processQueueItem("velero/backup-1"))
+
+			res, err := client.VeleroV1().Backups(velerov1api.DefaultNamespace).Get(context.TODO(), "backup-1", metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedReplicas, res.Status.Replicas)
+			assert.False(t, c.backupTracker.Contains(velerov1api.DefaultNamespace, "backup-1"))
+		})
+	}
+}
+
+func TestReplicaRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int
+		expected time.Duration
+	}{
+		{attempts: 0, expected: time.Minute},
+		{attempts: 1, expected: time.Minute},
+		{attempts: 2, expected: 2 * time.Minute},
+		{attempts: 4, expected: 8 * time.Minute},
+		{attempts: 10, expected: time.Hour},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, replicaRetryDelay(test.attempts), "attempts: %d", test.attempts)
+	}
+}
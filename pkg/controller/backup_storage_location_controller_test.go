@@ -17,6 +17,9 @@ limitations under the License.
 package controller
 
 import (
+	"fmt"
+	"io/ioutil"
+	"os"
 	"time"
 
 	. "github.com/onsi/ginkgo"
@@ -33,9 +36,11 @@ import (
 	"github.com/vmware-tanzu/velero/internal/storage"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
 	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
@@ -244,4 +249,83 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 			Expect(instance.Status.Phase).To(BeIdenticalTo(tests[i].expectedPhase))
 		}
 	})
+
+	It("Should validate backup storage locations in S3 buckets with the built-in S3 object store", func() {
+		server := velerotest.NewFakeS3Server("bucket-1", "bucket-2")
+		defer server.Close()
+
+		// a bucket with unknown top-level directories is invalid
+		server.PutObject("bucket-2", "unknown/object", []byte("data"))
+
+		credentialsFile, err := ioutil.TempFile("", "velero-s3-credentials")
+		Expect(err).To(BeNil())
+		defer os.Remove(credentialsFile.Name())
+		_, err = credentialsFile.WriteString(server.CredentialsFile())
+		Expect(err).To(BeNil())
+		Expect(credentialsFile.Close()).To(Succeed())
+
+		tests := []struct {
+			bucket        string
+			expectedPhase velerov1api.BackupStorageLocationPhase
+		}{
+			{
+				bucket:        "bucket-1",
+				expectedPhase: velerov1api.BackupStorageLocationPhaseAvailable,
+			},
+			{
+				bucket:        "bucket-2",
+				expectedPhase: velerov1api.BackupStorageLocationPhaseUnavailable,
+			},
+			{
+				bucket:        "nonexistent-bucket",
+				expectedPhase: velerov1api.BackupStorageLocationPhaseUnavailable,
+			},
+		}
+
+		// Setup
+		pluginManager := &pluginmocks.Manager{}
+		pluginManager.On("CleanupClients").Return(nil)
+		pluginManager.On("GetObjectStore", persistence.S3ObjectStoreProvider).Return(func(string) velero.ObjectStore { return persistence.NewS3ObjectStore() }, nil)
+
+		locations := new(velerov1api.BackupStorageLocationList)
+		for i, test := range tests {
+			location := builder.ForBackupStorageLocation("ns-1", fmt.Sprintf("location-%d", i+1)).
+				Provider(persistence.S3ObjectStoreProvider).
+				Bucket(test.bucket).
+				Config(map[string]string{"s3Url": server.URL, "s3ForcePathStyle": "true", "credentialsFile": credentialsFile.Name()}).
+				ValidationFrequency(1 * time.Second).
+				Result()
+			locations.Items = append(locations.Items, *location)
+		}
+
+		// Setup reconciler
+		Expect(velerov1api.AddToScheme(scheme.Scheme)).To(Succeed())
+		r := BackupStorageLocationReconciler{
+			Ctx:    ctx,
+			Client: fake.NewFakeClientWithScheme(scheme.Scheme, locations),
+			DefaultBackupLocationInfo: storage.DefaultBackupLocationInfo{
+				StorageLocation:           "location-1",
+				ServerValidationFrequency: 0,
+			},
+			NewPluginManager:  func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+			BackupStoreGetter: persistence.NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil)),
+			Log:               velerotest.NewLogger(),
+		}
+
+		actualResult, err := r.Reconcile(ctx, ctrl.Request{
+			NamespacedName: types.NamespacedName{Namespace: "ns-1"},
+		})
+
+		Expect(actualResult).To(BeEquivalentTo(ctrl.Result{Requeue: true}))
+		Expect(err).To(BeNil())
+
+		// Assertions
+		for i, location := range locations.Items {
+			key := client.ObjectKey{Name: location.Name, Namespace: location.Namespace}
+			instance := &velerov1api.BackupStorageLocation{}
+			err := r.Client.Get(ctx, key, instance)
+			Expect(err).To(BeNil())
+			Expect(instance.Status.Phase).To(BeIdenticalTo(tests[i].expectedPhase))
+		}
+	})
 })
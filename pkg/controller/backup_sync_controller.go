@@ -217,6 +217,14 @@ func (c *backupSyncController) run() {
 				continue
 			}
 
+			// the data of the backup's volume snapshots is still being uploaded, so it's
+			// synced once the cluster that created it has finished it
+			switch backup.Status.Phase {
+			case velerov1api.BackupPhaseUploading, velerov1api.BackupPhaseUploadingPartialFailure:
+				log.Debug("Backup's volume snapshot data is still being uploaded, skipping")
+				continue
+			}
+
 			backup.Namespace = c.namespace
 			backup.ResourceVersion = ""
 
@@ -19,10 +19,12 @@ package controller
 const (
 	Backup                = "backup"
 	BackupDeletion        = "backup-deletion"
+	BackupReplication     = "backup-replication"
 	BackupStorageLocation = "backup-storage-location"
 	BackupSync            = "backup-sync"
 	DownloadRequest       = "download-request"
 	GarbageCollection     = "gc"
+	PluginRegistration    = "plugin-registration"
 	PodVolumeBackup       = "pod-volume-backup"
 	PodVolumeRestore      = "pod-volume-restore"
 	ResticRepo            = "restic-repo"
@@ -35,6 +37,7 @@ const (
 var DisableableControllers = []string{
 	Backup,
 	BackupDeletion,
+	BackupReplication,
 	BackupSync,
 	DownloadRequest,
 	GarbageCollection,
@@ -134,6 +134,12 @@ func (c *gcController) processQueueItem(key string) error {
 
 	log.Info("Backup has expired")
 
+	if backup.Status.RetainUntil != nil && backup.Status.RetainUntil.After(now) {
+		log.Infof("Backup cannot be garbage-collected until its objects' retention in object storage ends at %s", backup.Status.RetainUntil)
+		c.queue.AddAfter(key, backup.Status.RetainUntil.Sub(now))
+		return nil
+	}
+
 	loc := &velerov1api.BackupStorageLocation{}
 	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
 		Namespace: ns,
@@ -184,6 +184,25 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 			backupLocation: builder.ForBackupStorageLocation("velero", "read-write").AccessMode(velerov1api.BackupStorageLocationAccessModeReadWrite).Result(),
 			expectDeletion: true,
 		},
+		{
+			name:           "expired backup whose objects are still retained is not deleted",
+			backup:         defaultBackup().Expiration(fakeClock.Now().Add(-time.Minute)).RetainUntil(fakeClock.Now().Add(time.Hour)).StorageLocation("default").Result(),
+			backupLocation: defaultBackupLocation,
+			expectDeletion: false,
+		},
+		{
+			name:           "expired backup whose objects' retention has ended is deleted",
+			backup:         defaultBackup().Expiration(fakeClock.Now().Add(-time.Minute)).RetainUntil(fakeClock.Now().Add(-time.Second)).StorageLocation("default").Result(),
+			backupLocation: defaultBackupLocation,
+			expectDeletion: true,
+		},
+		{
+			// the deletion request waits for the hold to be removed
+			name:           "expired backup with a legal hold on its objects is deleted",
+			backup:         defaultBackup().Expiration(fakeClock.Now().Add(-time.Minute)).LegalHold(true).StorageLocation("default").Result(),
+			backupLocation: defaultBackupLocation,
+			expectDeletion: true,
+		},
 		{
 			name:           "expired backup with no pending deletion requests is deleted",
 			backup:         defaultBackup().Expiration(fakeClock.Now().Add(-time.Second)).StorageLocation("default").Result(),
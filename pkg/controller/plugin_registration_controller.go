@@ -0,0 +1,214 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/tls"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/vmware-tanzu/velero/internal/velero"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// pluginRegistrationCAKey is the key of the certificate authority in the TLS
+// secret of a PluginRegistration.
+const pluginRegistrationCAKey = "ca.crt"
+
+// RemotePluginRegistry is the part of the plugin registry that the plugins of
+// PluginRegistrations are registered with.
+type RemotePluginRegistry interface {
+	PluginLister
+	DiscoverRemotePlugins(server clientmgmt.RemotePluginServer) ([]framework.PluginIdentifier, error)
+	RemoveRemotePlugins(address string)
+}
+
+// PluginRegistrationReconciler registers the plugins of PluginRegistrations,
+// registers them again when a PluginRegistration is edited, and unregisters
+// them when it's deleted. Registrations that fail are retried with backoff.
+type PluginRegistrationReconciler struct {
+	Ctx context.Context
+	// Client reads PluginRegistrations and their TLS secrets. It shouldn't
+	// read from the manager's cache, since registrations are reconciled when
+	// the server starts, before the cache is, and since the TLS secrets are
+	// read again each time a plugin server is connected to.
+	Client         client.Client
+	Namespace      string
+	PluginRegistry RemotePluginRegistry
+	Log            logrus.FieldLogger
+
+	// registered is the spec of each PluginRegistration whose plugins are
+	// registered, by name. Registrations are reconciled one at a time, so it
+	// isn't locked.
+	registered map[string]velerov1api.PluginRegistrationSpec
+}
+
+// NewPluginRegistrationReconciler returns a PluginRegistrationReconciler for
+// the PluginRegistrations in namespace.
+func NewPluginRegistrationReconciler(ctx context.Context, client client.Client, namespace string, registry RemotePluginRegistry, logger logrus.FieldLogger) *PluginRegistrationReconciler {
+	return &PluginRegistrationReconciler{
+		Ctx:            ctx,
+		Client:         client,
+		Namespace:      namespace,
+		PluginRegistry: registry,
+		Log:            logger,
+		registered:     make(map[string]velerov1api.PluginRegistrationSpec),
+	}
+}
+
+// ReconcileAll reconciles all of the PluginRegistrations, so that their
+// plugins are registered before the controllers that use them start.
+// Registrations that fail are retried by the controller.
+func (r *PluginRegistrationReconciler) ReconcileAll() error {
+	registrations := new(velerov1api.PluginRegistrationList)
+	if err := r.Client.List(r.Ctx, registrations, client.InNamespace(r.Namespace)); err != nil {
+		return errors.Wrap(err, "error listing plugin registrations")
+	}
+
+	for _, registration := range registrations.Items {
+		// errors are logged, and retried by the controller
+		r.Reconcile(r.Ctx, ctrl.Request{NamespacedName: client.ObjectKey{Namespace: r.Namespace, Name: registration.Name}})
+	}
+
+	return nil
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=pluginregistrations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=pluginregistrations/status,verbs=get;update;patch
+func (r *PluginRegistrationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithFields(logrus.Fields{
+		"controller":         PluginRegistration,
+		"pluginRegistration": req.NamespacedName,
+	})
+
+	registration := &velerov1api.PluginRegistration{}
+	if err := r.Client.Get(ctx, req.NamespacedName, registration); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find PluginRegistration")
+			r.unregister(req.Name, log)
+			return ctrl.Result{}, nil
+		}
+
+		log.WithError(err).Error("Error getting PluginRegistration")
+		return ctrl.Result{}, errors.WithStack(err)
+	}
+
+	if spec, found := r.registered[registration.Name]; found && spec == registration.Spec {
+		log.Debug("PluginRegistration's plugins are already registered")
+		return ctrl.Result{}, nil
+	}
+
+	// the registration is new, was edited, or failed before, so the plugins
+	// registered for its previous spec, if any, are replaced
+	r.unregister(registration.Name, log)
+
+	log = log.WithField("address", registration.Spec.Address)
+	original := registration.DeepCopy()
+	plugins, err := r.register(registration)
+	if err != nil {
+		log.WithError(err).Error("Error registering remote plugins")
+		registration.Status.Phase = velerov1api.PluginRegistrationPhaseFailed
+		registration.Status.Message = err.Error()
+		registration.Status.Plugins = nil
+	} else {
+		log.Infof("Registered %d remote plugins", len(plugins))
+		r.registered[registration.Name] = registration.Spec
+		registration.Status.Phase = velerov1api.PluginRegistrationPhaseRegistered
+		registration.Status.Message = ""
+		registration.Status.Plugins = plugins
+	}
+
+	if err := r.Client.Status().Patch(ctx, registration, client.MergeFrom(original)); err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error patching PluginRegistration's status")
+	}
+
+	// failed registrations are requeued with backoff
+	return ctrl.Result{}, err
+}
+
+// unregister unregisters the plugins registered for the PluginRegistration
+// named name, if any.
+func (r *PluginRegistrationReconciler) unregister(name string, log logrus.FieldLogger) {
+	spec, found := r.registered[name]
+	if !found {
+		return
+	}
+
+	log.WithField("address", spec.Address).Info("Unregistering remote plugins")
+	r.PluginRegistry.RemoveRemotePlugins(spec.Address)
+	delete(r.registered, name)
+}
+
+func (r *PluginRegistrationReconciler) register(registration *velerov1api.PluginRegistration) ([]velerov1api.PluginInfo, error) {
+	spec := registration.Spec
+	ids, err := r.PluginRegistry.DiscoverRemotePlugins(clientmgmt.RemotePluginServer{
+		Address: spec.Address,
+		LoadTLSConfig: func() (*tls.Config, error) {
+			return r.loadTLSConfig(spec)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var plugins []velerov1api.PluginInfo
+	for _, id := range ids {
+		plugins = append(plugins, velero.GetPluginInfo(r.PluginRegistry, id))
+	}
+	return plugins, nil
+}
+
+// loadTLSConfig returns the TLS configuration of the client of the plugin
+// server of spec, from its TLS secret.
+func (r *PluginRegistrationReconciler) loadTLSConfig(spec velerov1api.PluginRegistrationSpec) (*tls.Config, error) {
+	secret, err := kube.GetSecret(r.Client, r.Namespace, spec.TLSSecretName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting TLS secret %s", spec.TLSSecretName)
+	}
+
+	for _, key := range []string{corev1api.TLSCertKey, corev1api.TLSPrivateKeyKey, pluginRegistrationCAKey} {
+		if _, found := secret.Data[key]; !found {
+			return nil, errors.Errorf("%q secret is missing data for key %q", secret.Name, key)
+		}
+	}
+
+	return framework.ClientTLSConfig(
+		secret.Data[corev1api.TLSCertKey],
+		secret.Data[corev1api.TLSPrivateKeyKey],
+		secret.Data[pluginRegistrationCAKey],
+		spec.ServerName,
+	)
+}
+
+func (r *PluginRegistrationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// status updates, including the controller's own, don't change the
+		// generation, and don't need to be reconciled
+		For(&velerov1api.PluginRegistration{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
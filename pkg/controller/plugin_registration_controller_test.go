@@ -0,0 +1,216 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/tls"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+type fakeRemotePluginRegistry struct {
+	plugins    map[string][]framework.PluginIdentifier
+	registered map[string]bool
+	servers    []clientmgmt.RemotePluginServer
+}
+
+func newFakeRemotePluginRegistry(plugins map[string][]framework.PluginIdentifier) *fakeRemotePluginRegistry {
+	return &fakeRemotePluginRegistry{plugins: plugins, registered: make(map[string]bool)}
+}
+
+func (r *fakeRemotePluginRegistry) List(kind framework.PluginKind) []framework.PluginIdentifier {
+	return nil
+}
+
+func (r *fakeRemotePluginRegistry) Status(kind framework.PluginKind, name string) (clientmgmt.PluginStatus, error) {
+	return clientmgmt.PluginStatus{Health: framework.PluginHealth{Ready: true}}, nil
+}
+
+func (r *fakeRemotePluginRegistry) DiscoverRemotePlugins(server clientmgmt.RemotePluginServer) ([]framework.PluginIdentifier, error) {
+	r.servers = append(r.servers, server)
+
+	if _, err := server.LoadTLSConfig(); err != nil {
+		return nil, err
+	}
+	plugins, ok := r.plugins[server.Address]
+	if !ok {
+		return nil, errors.Errorf("error connecting to plugin server %s", server.Address)
+	}
+	if r.registered[server.Address] {
+		return nil, errors.Errorf("plugins of %s are already registered", server.Address)
+	}
+	r.registered[server.Address] = true
+	return plugins, nil
+}
+
+func (r *fakeRemotePluginRegistry) RemoveRemotePlugins(address string) {
+	delete(r.registered, address)
+}
+
+func pluginRegistrationTLSSecret(name string, certs velerotest.TLSCertificates) *corev1api.Secret {
+	return builder.ForSecret("velero", name).Data(map[string][]byte{
+		corev1api.TLSCertKey:       certs.ClientCert,
+		corev1api.TLSPrivateKeyKey: certs.ClientKey,
+		pluginRegistrationCAKey:    certs.CA,
+	}).Result()
+}
+
+func reconcilePluginRegistration(r *PluginRegistrationReconciler, name string) error {
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: kbclient.ObjectKey{Namespace: "velero", Name: name}})
+	return err
+}
+
+func getPluginRegistration(t *testing.T, kbClient kbclient.Client, name string) *velerov1api.PluginRegistration {
+	registration := new(velerov1api.PluginRegistration)
+	require.NoError(t, kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: "velero", Name: name}, registration))
+	return registration
+}
+
+func TestPluginRegistrationReconcileAll(t *testing.T) {
+	certs := velerotest.NewTLSCertificates("plugins.velero.svc")
+	incompleteSecret := builder.ForSecret("velero", "incomplete-tls").Data(map[string][]byte{
+		corev1api.TLSCertKey:       certs.ClientCert,
+		corev1api.TLSPrivateKeyKey: certs.ClientKey,
+	}).Result()
+
+	kbClient := velerotest.NewFakeControllerRuntimeClient(t,
+		pluginRegistrationTLSSecret("plugins-tls", certs),
+		incompleteSecret,
+		builder.ForPluginRegistration("velero", "registered").Address("plugins.velero.svc:8443").TLSSecretName("plugins-tls").ServerName("plugins.velero.svc").Result(),
+		builder.ForPluginRegistration("velero", "unreachable").Address("missing.velero.svc:8443").TLSSecretName("plugins-tls").Result(),
+		builder.ForPluginRegistration("velero", "missing-secret").Address("other.velero.svc:8443").TLSSecretName("missing-tls").Result(),
+		builder.ForPluginRegistration("velero", "incomplete-secret").Address("other.velero.svc:8443").TLSSecretName("incomplete-tls").Result(),
+		builder.ForPluginRegistration("other", "other-namespace").Address("other.velero.svc:8443").TLSSecretName("plugins-tls").Result(),
+	)
+
+	registry := newFakeRemotePluginRegistry(map[string][]framework.PluginIdentifier{
+		"plugins.velero.svc:8443": {
+			{Kind: framework.PluginKindObjectStore, Name: "example.io/object-store", Command: "plugins.velero.svc:8443"},
+		},
+		"other.velero.svc:8443": {
+			{Kind: framework.PluginKindObjectStore, Name: "example.io/other", Command: "other.velero.svc:8443"},
+		},
+	})
+
+	r := NewPluginRegistrationReconciler(context.Background(), kbClient, "velero", registry, velerotest.NewLogger())
+	require.NoError(t, r.ReconcileAll())
+
+	// the TLS configuration is loaded from the registration's secret
+	require.Len(t, registry.servers, 4)
+	servers := make(map[string]clientmgmt.RemotePluginServer)
+	for _, server := range registry.servers {
+		servers[server.Address] = server
+	}
+	tlsConfig, err := servers["plugins.velero.svc:8443"].LoadTLSConfig()
+	require.NoError(t, err)
+	assert.Equal(t, "plugins.velero.svc", tlsConfig.ServerName)
+	tlsConfig, err = servers["missing.velero.svc:8443"].LoadTLSConfig()
+	require.NoError(t, err)
+	assert.Empty(t, tlsConfig.ServerName)
+
+	ready := true
+	registered := getPluginRegistration(t, kbClient, "registered")
+	assert.Equal(t, velerov1api.PluginRegistrationPhaseRegistered, registered.Status.Phase)
+	assert.Empty(t, registered.Status.Message)
+	assert.Equal(t, []velerov1api.PluginInfo{{Name: "example.io/object-store", Kind: "ObjectStore", Ready: &ready}}, registered.Status.Plugins)
+
+	for _, name := range []string{"unreachable", "missing-secret", "incomplete-secret"} {
+		failed := getPluginRegistration(t, kbClient, name)
+		assert.Equal(t, velerov1api.PluginRegistrationPhaseFailed, failed.Status.Phase, name)
+		assert.NotEmpty(t, failed.Status.Message, name)
+		assert.Empty(t, failed.Status.Plugins, name)
+	}
+	assert.Contains(t, getPluginRegistration(t, kbClient, "incomplete-secret").Status.Message, `missing data for key "ca.crt"`)
+
+	other := new(velerov1api.PluginRegistration)
+	require.NoError(t, kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: "other", Name: "other-namespace"}, other))
+	assert.Empty(t, other.Status.Phase)
+}
+
+func TestPluginRegistrationReconcile(t *testing.T) {
+	certs := velerotest.NewTLSCertificates("plugins.velero.svc")
+	kbClient := velerotest.NewFakeControllerRuntimeClient(t,
+		pluginRegistrationTLSSecret("plugins-tls", certs),
+		builder.ForPluginRegistration("velero", "plugins").Address("missing.velero.svc:8443").TLSSecretName("plugins-tls").Result(),
+	)
+
+	registry := newFakeRemotePluginRegistry(map[string][]framework.PluginIdentifier{
+		"plugins.velero.svc:8443": {{Kind: framework.PluginKindObjectStore, Name: "example.io/plugins"}},
+		"other.velero.svc:8443":   {{Kind: framework.PluginKindObjectStore, Name: "example.io/other"}},
+	})
+	r := NewPluginRegistrationReconciler(context.Background(), kbClient, "velero", registry, velerotest.NewLogger())
+
+	// failed registrations are returned as errors, so that they're retried with backoff
+	assert.Error(t, reconcilePluginRegistration(r, "plugins"))
+	assert.Equal(t, velerov1api.PluginRegistrationPhaseFailed, getPluginRegistration(t, kbClient, "plugins").Status.Phase)
+	assert.Error(t, reconcilePluginRegistration(r, "plugins"))
+	assert.Len(t, registry.servers, 2)
+
+	// edited registrations are registered again
+	registration := getPluginRegistration(t, kbClient, "plugins")
+	registration.Spec.Address = "plugins.velero.svc:8443"
+	require.NoError(t, kbClient.Update(context.Background(), registration))
+	require.NoError(t, reconcilePluginRegistration(r, "plugins"))
+	registration = getPluginRegistration(t, kbClient, "plugins")
+	assert.Equal(t, velerov1api.PluginRegistrationPhaseRegistered, registration.Status.Phase)
+	assert.Empty(t, registration.Status.Message)
+	assert.Len(t, registration.Status.Plugins, 1)
+	assert.True(t, registry.registered["plugins.velero.svc:8443"])
+
+	// registrations that are already registered aren't registered again
+	require.NoError(t, reconcilePluginRegistration(r, "plugins"))
+	assert.Len(t, registry.servers, 3)
+
+	// the plugins of the previous address are unregistered
+	registration.Spec.Address = "other.velero.svc:8443"
+	require.NoError(t, kbClient.Update(context.Background(), registration))
+	require.NoError(t, reconcilePluginRegistration(r, "plugins"))
+	assert.False(t, registry.registered["plugins.velero.svc:8443"])
+	assert.True(t, registry.registered["other.velero.svc:8443"])
+	assert.Equal(t, "example.io/other", getPluginRegistration(t, kbClient, "plugins").Status.Plugins[0].Name)
+
+	// rotated certificates are loaded from the secret when the server is connected to again
+	rotated := velerotest.NewTLSCertificates("plugins.velero.svc")
+	secret := new(corev1api.Secret)
+	require.NoError(t, kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: "velero", Name: "plugins-tls"}, secret))
+	secret.Data = pluginRegistrationTLSSecret("plugins-tls", rotated).Data
+	require.NoError(t, kbClient.Update(context.Background(), secret))
+	tlsConfig, err := registry.servers[len(registry.servers)-1].LoadTLSConfig()
+	require.NoError(t, err)
+	require.Len(t, tlsConfig.Certificates, 1)
+	rotatedCert, err := tls.X509KeyPair(rotated.ClientCert, rotated.ClientKey)
+	require.NoError(t, err)
+	assert.Equal(t, rotatedCert.Certificate, tlsConfig.Certificates[0].Certificate)
+
+	// the plugins of deleted registrations are unregistered
+	require.NoError(t, kbClient.Delete(context.Background(), registration))
+	require.NoError(t, reconcilePluginRegistration(r, "plugins"))
+	assert.Empty(t, registry.registered)
+}
@@ -20,9 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os"
 	"path/filepath"
-	"strings"
 	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
@@ -43,9 +41,9 @@ import (
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
-	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	"github.com/vmware-tanzu/velero/pkg/uploader/provider"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
-	"github.com/vmware-tanzu/velero/pkg/util/kube"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -66,8 +64,17 @@ type podVolumeBackupController struct {
 	processBackupFunc func(*velerov1api.PodVolumeBackup) error
 	fileSystem        filesystem.Interface
 	clock             clock.Clock
+
+	// apiReader reads the slots of backup storage locations' concurrency
+	// limits from the API server rather than the cache, since they're
+	// updated by the controllers of all nodes.
+	apiReader client.Reader
 }
 
+// queuedBackupRequeueInterval is how long to wait before re-checking whether a
+// queued pod volume backup can be started.
+const queuedBackupRequeueInterval = 10 * time.Second
+
 // NewPodVolumeBackupController creates a new pod volume backup controller.
 func NewPodVolumeBackupController(
 	logger logrus.FieldLogger,
@@ -78,6 +85,7 @@ func NewPodVolumeBackupController(
 	pvInformer corev1informers.PersistentVolumeInformer,
 	metrics *metrics.ServerMetrics,
 	kbClient client.Client,
+	apiReader client.Reader,
 	nodeName string,
 	credentialsFileStore credentials.FileStore,
 ) Interface {
@@ -92,6 +100,7 @@ func NewPodVolumeBackupController(
 		nodeName:              nodeName,
 		metrics:               metrics,
 		credentialsFileStore:  credentialsFileStore,
+		apiReader:             apiReader,
 
 		fileSystem: filesystem.NewFileSystem(),
 		clock:      &clock.RealClock{},
@@ -126,8 +135,10 @@ func (c *podVolumeBackupController) pvbHandler(obj interface{}) {
 
 	log := loggerForPodVolumeBackup(c.logger, req)
 
-	if req.Status.Phase != "" && req.Status.Phase != velerov1api.PodVolumeBackupPhaseNew {
-		log.Debug("Backup is not new, not enqueuing")
+	switch req.Status.Phase {
+	case "", velerov1api.PodVolumeBackupPhaseNew, velerov1api.PodVolumeBackupPhaseQueued:
+	default:
+		log.Debug("Backup is not new or queued, not enqueuing")
 		return
 	}
 
@@ -156,18 +167,82 @@ func (c *podVolumeBackupController) processQueueItem(key string) error {
 		return errors.Wrap(err, "error getting PodVolumeBackup")
 	}
 
-	// only process new items
+	// only process new and queued items
 	switch req.Status.Phase {
-	case "", velerov1api.PodVolumeBackupPhaseNew:
+	case "", velerov1api.PodVolumeBackupPhaseNew, velerov1api.PodVolumeBackupPhaseQueued:
 	default:
 		return nil
 	}
 
 	// Don't mutate the shared cache
 	reqCopy := req.DeepCopy()
+
+	started, err := c.tryStart(reqCopy)
+	if err != nil {
+		return err
+	}
+	if !started {
+		c.queue.AddAfter(key, queuedBackupRequeueInterval)
+		return nil
+	}
+	defer c.finish(reqCopy)
+
 	return c.processBackupFunc(reqCopy)
 }
 
+// tryStart checks whether the pod volume backup can be started without exceeding
+// its backup storage location's concurrency limit. If it can, it claims one of the
+// location's slots and true is returned. Otherwise, it's moved to the Queued phase
+// and false is returned.
+func (c *podVolumeBackupController) tryStart(req *velerov1api.PodVolumeBackup) (bool, error) {
+	log := loggerForPodVolumeBackup(c.logger, req)
+
+	var limit int
+	location := &velerov1api.BackupStorageLocation{}
+	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
+		Namespace: req.Namespace,
+		Name:      req.Spec.BackupStorageLocation,
+	}, location); err != nil {
+		// processBackup will fail the pod volume backup with a meaningful message.
+		log.WithError(err).Debug("Unable to get backup storage location to check concurrency limit")
+	} else {
+		limit = location.Spec.MaxConcurrentPodVolumeBackups
+	}
+
+	if limit <= 0 {
+		return true, nil
+	}
+
+	claimed, running, err := c.claimSlot(req, limit)
+	if err != nil {
+		return false, err
+	}
+	if claimed {
+		return true, nil
+	}
+
+	if req.Status.Phase != velerov1api.PodVolumeBackupPhaseQueued {
+		log.Infof("Queueing backup, %d pod volume backups to backup storage location %s are in progress", running, req.Spec.BackupStorageLocation)
+		if _, err := c.patchPodVolumeBackup(req, func(r *velerov1api.PodVolumeBackup) {
+			r.Status.Phase = velerov1api.PodVolumeBackupPhaseQueued
+			r.Status.Message = fmt.Sprintf("waiting for one of %d in-progress pod volume backups to backup storage location %s to complete", running, req.Spec.BackupStorageLocation)
+		}); err != nil {
+			return false, errors.Wrap(err, "error setting PodVolumeBackup phase to Queued")
+		}
+	}
+
+	return false, nil
+}
+
+// finish releases the pod volume backup's claim of a slot of its backup storage
+// location, if it has one. Claims that fail to be released are released by the
+// next backup that finds the pod volume backup completed or failed.
+func (c *podVolumeBackupController) finish(req *velerov1api.PodVolumeBackup) {
+	if err := c.releaseSlot(req); err != nil {
+		loggerForPodVolumeBackup(c.logger, req).WithError(err).Warn("Error releasing backup storage location concurrency slot")
+	}
+}
+
 func loggerForPodVolumeBackup(baseLogger logrus.FieldLogger, req *velerov1api.PodVolumeBackup) logrus.FieldLogger {
 	log := baseLogger.WithFields(logrus.Fields{
 		"namespace": req.Namespace,
@@ -195,6 +270,7 @@ func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBack
 	// update status to InProgress
 	req, err = c.patchPodVolumeBackup(req, func(r *velerov1api.PodVolumeBackup) {
 		r.Status.Phase = velerov1api.PodVolumeBackupPhaseInProgress
+		r.Status.Message = ""
 		r.Status.StartTimestamp = &metav1.Time{Time: c.clock.Now()}
 	})
 	if err != nil {
@@ -208,37 +284,12 @@ func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBack
 		return c.fail(req, errors.Wrap(err, "error getting pod").Error(), log)
 	}
 
-	volumeDir, err := kube.GetVolumeDirectory(pod, req.Spec.Volume, c.pvcLister, c.pvLister)
-	if err != nil {
-		log.WithError(err).Error("Error getting volume directory name")
-		return c.fail(req, errors.Wrap(err, "error getting volume directory name").Error(), log)
-	}
-
-	pathGlob := fmt.Sprintf("/host_pods/%s/volumes/*/%s", string(req.Spec.Pod.UID), volumeDir)
-	log.WithField("pathGlob", pathGlob).Debug("Looking for path matching glob")
-
-	path, err := singlePathMatch(pathGlob)
-	if err != nil {
-		log.WithError(err).Error("Error uniquely identifying volume path")
-		return c.fail(req, errors.Wrap(err, "error getting volume path on host").Error(), log)
-	}
-	log.WithField("path", path).Debugf("Found path matching glob")
-
-	// temp creds
-	credentialsFile, err := c.credentialsFileStore.Path(restic.RepoKeySelector())
+	volumeType, path, err := getPodVolumePath(pod, req.Spec.Volume, c.pvcLister, c.pvLister, c.fileSystem)
 	if err != nil {
-		log.WithError(err).Error("Error creating temp restic credentials file")
-		return c.fail(req, errors.Wrap(err, "error creating temp restic credentials file").Error(), log)
+		log.WithError(err).Error("Error getting volume path")
+		return c.fail(req, err.Error(), log)
 	}
-	// ignore error since there's nothing we can do and it's a temp file.
-	defer os.Remove(credentialsFile)
-
-	resticCmd := restic.BackupCommand(
-		req.Spec.RepoIdentifier,
-		credentialsFile,
-		path,
-		req.Spec.Tags,
-	)
+	log.WithFields(logrus.Fields{"path": path, "volumeType": volumeType}).Debug("Found volume path")
 
 	backupLocation := &velerov1api.BackupStorageLocation{}
 	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
@@ -248,68 +299,59 @@ func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBack
 		return c.fail(req, errors.Wrap(err, "error getting backup storage location").Error(), log)
 	}
 
-	// if there's a caCert on the ObjectStorage, write it to disk so that it can be passed to restic
-	var caCertFile string
-	if backupLocation.Spec.ObjectStorage != nil && backupLocation.Spec.ObjectStorage.CACert != nil {
-		caCertFile, err = restic.TempCACertFile(backupLocation.Spec.ObjectStorage.CACert, req.Spec.BackupStorageLocation, c.fileSystem)
+	transferSettings := uploader.GetTransferSettings(backupLocation, req.Spec.PodVolumeTransfer)
+	if transferSettings != nil {
+		req, err = c.patchPodVolumeBackup(req, func(r *velerov1api.PodVolumeBackup) {
+			r.Status.PodVolumeTransfer = transferSettings
+		})
 		if err != nil {
-			log.WithError(err).Error("Error creating temp cacert file")
+			log.WithError(err).Error("Error setting PodVolumeBackup transfer settings")
+			return errors.WithStack(err)
 		}
-		// ignore error since there's nothing we can do and it's a temp file.
-		defer os.Remove(caCertFile)
 	}
-	resticCmd.CACertFile = caCertFile
 
-	env, err := restic.CmdEnv(backupLocation, c.credentialsFileStore)
+	uploaderProv, err := provider.NewUploaderProvider(req.Spec.UploaderType, req.Spec.RepoIdentifier, req.Spec.RepositoryKeySecret, backupLocation, transferSettings, c.credentialsFileStore, c.fileSystem, log)
 	if err != nil {
-		return c.fail(req, errors.Wrap(err, "error setting restic cmd env").Error(), log)
+		return c.fail(req, errors.Wrap(err, "error creating uploader provider").Error(), log)
 	}
-	resticCmd.Env = env
+	defer uploaderProv.Close(context.Background())
 
 	// If this is a PVC, look for the most recent completed pod volume backup for it and get
-	// its restic snapshot ID to use as the value of the `--parent` flag. Without this,
-	// if the pod using the PVC (and therefore the directory path under /host_pods/) has
-	// changed since the PVC's last backup, restic will not be able to identify a suitable
-	// parent snapshot to use, and will have to do a full rescan of the contents of the PVC.
+	// its snapshot ID to use as the parent snapshot. Without this, if the pod using the PVC
+	// (and therefore the directory path under /host_pods/) has changed since the PVC's last
+	// backup, the uploader will not be able to identify a suitable parent snapshot to use,
+	// and will have to do a full rescan of the contents of the PVC.
+	var parentSnapshotID string
 	if pvcUID, ok := req.Labels[velerov1api.PVCUIDLabel]; ok {
-		parentSnapshotID := getParentSnapshot(log, pvcUID, req.Spec.BackupStorageLocation, c.podVolumeBackupLister.PodVolumeBackups(req.Namespace))
+		parentSnapshotID = getParentSnapshot(log, pvcUID, req.Spec.BackupStorageLocation, req.Spec.UploaderType, c.podVolumeBackupLister.PodVolumeBackups(req.Namespace))
 		if parentSnapshotID == "" {
-			log.Info("No parent snapshot found for PVC, not using --parent flag for this backup")
+			log.Info("No parent snapshot found for PVC, not using a parent snapshot for this backup")
 		} else {
-			log.WithField("parentSnapshotID", parentSnapshotID).Info("Setting --parent flag for this backup")
-			resticCmd.ExtraFlags = append(resticCmd.ExtraFlags, fmt.Sprintf("--parent=%s", parentSnapshotID))
+			log.WithField("parentSnapshotID", parentSnapshotID).Info("Using parent snapshot for this backup")
 		}
 	}
 
-	var stdout, stderr string
-
-	var emptySnapshot bool
-	if stdout, stderr, err = restic.RunBackup(resticCmd, log, c.updateBackupProgressFunc(req, log)); err != nil {
-		if strings.Contains(stderr, "snapshot is empty") {
-			emptySnapshot = true
-		} else {
-			log.WithError(errors.WithStack(err)).Errorf("Error running command=%s, stdout=%s, stderr=%s", resticCmd.String(), stdout, stderr)
-			return c.fail(req, fmt.Sprintf("error running restic backup, stderr=%s: %s", stderr, err.Error()), log)
+	var (
+		snapshotID    string
+		emptySnapshot bool
+	)
+	if volumeType == velerov1api.PodVolumeTypeBlock {
+		if len(req.Spec.IncludedPaths) > 0 || len(req.Spec.ExcludedPaths) > 0 {
+			log.Warn("Ignoring included and excluded paths for block volume")
 		}
+		snapshotID, err = uploaderProv.RunBlockBackup(context.Background(), path, req.Spec.Tags, parentSnapshotID, c.updateBackupProgressFunc(req, log))
+	} else {
+		snapshotID, emptySnapshot, err = uploaderProv.RunBackup(context.Background(), path, req.Spec.IncludedPaths, req.Spec.ExcludedPaths, req.Spec.Tags, parentSnapshotID, c.updateBackupProgressFunc(req, log))
 	}
-	log.Debugf("Ran command=%s, stdout=%s, stderr=%s", resticCmd.String(), stdout, stderr)
-
-	var snapshotID string
-	if !emptySnapshot {
-		cmd := restic.GetSnapshotCommand(req.Spec.RepoIdentifier, credentialsFile, req.Spec.Tags)
-		cmd.Env = env
-		cmd.CACertFile = caCertFile
-
-		snapshotID, err = restic.GetSnapshotID(cmd)
-		if err != nil {
-			log.WithError(err).Error("Error getting SnapshotID")
-			return c.fail(req, errors.Wrap(err, "error getting snapshot id").Error(), log)
-		}
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error running backup")
+		return c.fail(req, errors.Wrapf(err, "error running %s backup", uploader.GetUploaderType(req.Spec.UploaderType)).Error(), log)
 	}
 
 	// update status to Completed with path & snapshot id
 	req, err = c.patchPodVolumeBackup(req, func(r *velerov1api.PodVolumeBackup) {
 		r.Status.Path = path
+		r.Status.VolumeType = volumeType
 		r.Status.Phase = velerov1api.PodVolumeBackupPhaseCompleted
 		r.Status.SnapshotID = snapshotID
 		r.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
@@ -324,18 +366,18 @@ func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBack
 	latencyDuration := req.Status.CompletionTimestamp.Time.Sub(req.Status.StartTimestamp.Time)
 	latencySeconds := float64(latencyDuration / time.Second)
 	backupName := getOwningBackup(req)
-	c.metrics.ObserveResticOpLatency(c.nodeName, req.Name, resticCmd.Command, backupName, latencySeconds)
-	c.metrics.RegisterResticOpLatencyGauge(c.nodeName, req.Name, resticCmd.Command, backupName, latencySeconds)
+	c.metrics.ObserveResticOpLatency(c.nodeName, req.Name, "backup", backupName, latencySeconds)
+	c.metrics.RegisterResticOpLatencyGauge(c.nodeName, req.Name, "backup", backupName, latencySeconds)
 	c.metrics.RegisterPodVolumeBackupDequeue(c.nodeName)
 	log.Info("Backup completed")
 
 	return nil
 }
 
-// getParentSnapshot finds the most recent completed pod volume backup for the specified PVC and returns its
-// restic snapshot ID. Any errors encountered are logged but not returned since they do not prevent a backup
+// getParentSnapshot finds the most recent completed pod volume backup for the specified PVC taken by the given
+// uploader and returns its snapshot ID. Any errors encountered are logged but not returned since they do not prevent a backup
 // from proceeding.
-func getParentSnapshot(log logrus.FieldLogger, pvcUID, backupStorageLocation string, podVolumeBackupLister listers.PodVolumeBackupNamespaceLister) string {
+func getParentSnapshot(log logrus.FieldLogger, pvcUID, backupStorageLocation, uploaderType string, podVolumeBackupLister listers.PodVolumeBackupNamespaceLister) string {
 	log = log.WithField("pvcUID", pvcUID)
 	log.Infof("Looking for most recent completed pod volume backup for this PVC")
 
@@ -361,6 +403,11 @@ func getParentSnapshot(log logrus.FieldLogger, pvcUID, backupStorageLocation str
 			continue
 		}
 
+		if uploader.GetUploaderType(uploaderType) != uploader.GetUploaderType(backup.Spec.UploaderType) {
+			// snapshots of one uploader can't be the parent of another's.
+			continue
+		}
+
 		if mostRecentBackup == nil || backup.Status.StartTimestamp.After(mostRecentBackup.Status.StartTimestamp.Time) {
 			mostRecentBackup = backup
 		}
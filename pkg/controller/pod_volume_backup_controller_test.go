@@ -17,12 +17,24 @@ limitations under the License.
 package controller
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
@@ -56,6 +68,18 @@ func TestPVBHandler(t *testing.T) {
 			},
 			shouldEnqueue: true,
 		},
+		{
+			name: "Queued phase pvb on same node should be enqueued",
+			obj: &velerov1api.PodVolumeBackup{
+				Spec: velerov1api.PodVolumeBackupSpec{
+					Node: controllerNode,
+				},
+				Status: velerov1api.PodVolumeBackupStatus{
+					Phase: velerov1api.PodVolumeBackupPhaseQueued,
+				},
+			},
+			shouldEnqueue: true,
+		},
 		{
 			name: "InProgress phase pvb on same node should not be enqueued",
 			obj: &velerov1api.PodVolumeBackup{
@@ -171,3 +195,207 @@ func TestPVBHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestPodVolumeBackupTryStart(t *testing.T) {
+	controllerNode := "foo"
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	claimedAt := func(ago time.Duration) string { return now.Add(-ago).Format(time.RFC3339) }
+
+	tests := []struct {
+		name          string
+		limit         int
+		claims        map[string]string
+		existing      []*velerov1api.PodVolumeBackup
+		req           *velerov1api.PodVolumeBackup
+		expectStarted bool
+		expectPhase   velerov1api.PodVolumeBackupPhase
+		expectClaims  map[string]string
+	}{
+		{
+			name:          "no limit on the location starts the backup without claiming a slot",
+			existing:      []*velerov1api.PodVolumeBackup{builder.ForPodVolumeBackup("velero", "pvb-2").Node("bar").BackupStorageLocation("default").Phase(velerov1api.PodVolumeBackupPhaseInProgress).Result()},
+			req:           builder.ForPodVolumeBackup("velero", "pvb-1").Node(controllerNode).BackupStorageLocation("default").Result(),
+			expectStarted: true,
+		},
+		{
+			name:          "backups under the limit claims a slot and starts the backup",
+			limit:         2,
+			claims:        map[string]string{"pvb-2": claimedAt(time.Hour)},
+			existing:      []*velerov1api.PodVolumeBackup{builder.ForPodVolumeBackup("velero", "pvb-2").Node("bar").BackupStorageLocation("default").Phase(velerov1api.PodVolumeBackupPhaseInProgress).Result()},
+			req:           builder.ForPodVolumeBackup("velero", "pvb-1").Node(controllerNode).BackupStorageLocation("default").Result(),
+			expectStarted: true,
+			expectClaims:  map[string]string{"pvb-2": claimedAt(time.Hour), "pvb-1": claimedAt(0)},
+		},
+		{
+			name:          "no claims yet creates the slots and starts the backup",
+			limit:         1,
+			req:           builder.ForPodVolumeBackup("velero", "pvb-1").Node(controllerNode).BackupStorageLocation("default").Result(),
+			expectStarted: true,
+			expectClaims:  map[string]string{"pvb-1": claimedAt(0)},
+		},
+		{
+			name:         "claims of in-progress backups at the limit queues the backup",
+			limit:        1,
+			claims:       map[string]string{"pvb-2": claimedAt(time.Hour)},
+			existing:     []*velerov1api.PodVolumeBackup{builder.ForPodVolumeBackup("velero", "pvb-2").Node("bar").BackupStorageLocation("default").Phase(velerov1api.PodVolumeBackupPhaseInProgress).Result()},
+			req:          builder.ForPodVolumeBackup("velero", "pvb-1").Node(controllerNode).BackupStorageLocation("default").Result(),
+			expectPhase:  velerov1api.PodVolumeBackupPhaseQueued,
+			expectClaims: map[string]string{"pvb-2": claimedAt(time.Hour)},
+		},
+		{
+			name:         "recent claims of backups not in the cache yet count against the limit",
+			limit:        1,
+			claims:       map[string]string{"pvb-2": claimedAt(time.Second)},
+			req:          builder.ForPodVolumeBackup("velero", "pvb-1").Node(controllerNode).BackupStorageLocation("default").Result(),
+			expectPhase:  velerov1api.PodVolumeBackupPhaseQueued,
+			expectClaims: map[string]string{"pvb-2": claimedAt(time.Second)},
+		},
+		{
+			name:   "claims of completed and missing backups are released",
+			limit:  1,
+			claims: map[string]string{"pvb-2": claimedAt(time.Hour), "pvb-3": claimedAt(time.Hour)},
+			existing: []*velerov1api.PodVolumeBackup{
+				builder.ForPodVolumeBackup("velero", "pvb-2").Node("bar").BackupStorageLocation("default").Phase(velerov1api.PodVolumeBackupPhaseCompleted).Result(),
+			},
+			req:           builder.ForPodVolumeBackup("velero", "pvb-1").Node(controllerNode).BackupStorageLocation("default").Phase(velerov1api.PodVolumeBackupPhaseQueued).Result(),
+			expectStarted: true,
+			expectPhase:   velerov1api.PodVolumeBackupPhaseQueued,
+			expectClaims:  map[string]string{"pvb-1": claimedAt(0)},
+		},
+		{
+			name:          "a backup that already has a claim is started",
+			limit:         1,
+			claims:        map[string]string{"pvb-1": claimedAt(time.Hour)},
+			req:           builder.ForPodVolumeBackup("velero", "pvb-1").Node(controllerNode).BackupStorageLocation("default").Result(),
+			expectStarted: true,
+			expectClaims:  map[string]string{"pvb-1": claimedAt(time.Hour)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var objs []runtime.Object
+			for _, pvb := range append(test.existing, test.req) {
+				objs = append(objs, pvb)
+			}
+			clientset := fake.NewSimpleClientset(objs...)
+			sharedInformers := informers.NewSharedInformerFactory(clientset, 0)
+			for _, pvb := range test.existing {
+				require.NoError(t, sharedInformers.Velero().V1().PodVolumeBackups().Informer().GetStore().Add(pvb))
+			}
+
+			kbObjs := []runtime.Object{builder.ForBackupStorageLocation("velero", "default").MaxConcurrentPodVolumeBackups(test.limit).Result()}
+			if test.claims != nil {
+				kbObjs = append(kbObjs, &corev1api.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: podVolumeBackupSlotsName("default")},
+					Data:       test.claims,
+				})
+			}
+			kbClient := velerotest.NewFakeControllerRuntimeClient(t, kbObjs...)
+
+			c := &podVolumeBackupController{
+				genericController:     newGenericController(PodVolumeBackup, velerotest.NewLogger()),
+				podVolumeBackupClient: clientset.VeleroV1(),
+				podVolumeBackupLister: sharedInformers.Velero().V1().PodVolumeBackups().Lister(),
+				kbClient:              kbClient,
+				apiReader:             kbClient,
+				nodeName:              controllerNode,
+				clock:                 clock.NewFakeClock(now),
+			}
+
+			started, err := c.tryStart(test.req)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectStarted, started)
+
+			slots := &corev1api.ConfigMap{}
+			err = kbClient.Get(context.TODO(), kbclient.ObjectKey{Namespace: "velero", Name: podVolumeBackupSlotsName("default")}, slots)
+			if test.expectClaims == nil {
+				assert.True(t, apierrors.IsNotFound(err))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, test.expectClaims, slots.Data)
+			}
+
+			if test.expectStarted {
+				c.finish(test.req)
+				if test.expectClaims != nil {
+					require.NoError(t, kbClient.Get(context.TODO(), kbclient.ObjectKey{Namespace: "velero", Name: podVolumeBackupSlotsName("default")}, slots))
+					assert.NotContains(t, slots.Data, test.req.Name)
+				}
+			}
+
+			res, err := clientset.VeleroV1().PodVolumeBackups(test.req.Namespace).Get(context.TODO(), test.req.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, test.expectPhase, res.Status.Phase)
+		})
+	}
+}
+
+// conflictingClient fails the first update of each object with a conflict, as
+// if another node had updated it first.
+type conflictingClient struct {
+	kbclient.Client
+	conflicted bool
+}
+
+func (c *conflictingClient) Update(ctx context.Context, obj kbclient.Object, opts ...kbclient.UpdateOption) error {
+	if !c.conflicted {
+		c.conflicted = true
+
+		// the other node claims a slot
+		slots := obj.DeepCopyObject().(*corev1api.ConfigMap)
+		if err := c.Client.Get(ctx, kbclient.ObjectKeyFromObject(obj), slots); err != nil {
+			return err
+		}
+		slots.Data["pvb-other"] = slots.Data["pvb-2"]
+		if err := c.Client.Update(ctx, slots); err != nil {
+			return err
+		}
+		return apierrors.NewConflict(corev1api.Resource("configmaps"), obj.GetName(), errors.New("object has been modified"))
+	}
+	return c.Client.Update(ctx, obj, opts...)
+}
+
+func TestPodVolumeBackupTryStartConcurrentClaims(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	claimedAt := now.Format(time.RFC3339)
+
+	pvb2 := builder.ForPodVolumeBackup("velero", "pvb-2").Node("bar").BackupStorageLocation("default").Phase(velerov1api.PodVolumeBackupPhaseInProgress).Result()
+	pvbOther := builder.ForPodVolumeBackup("velero", "pvb-other").Node("baz").BackupStorageLocation("default").Phase(velerov1api.PodVolumeBackupPhaseInProgress).Result()
+	req := builder.ForPodVolumeBackup("velero", "pvb-1").Node("foo").BackupStorageLocation("default").Result()
+
+	clientset := fake.NewSimpleClientset(pvb2, pvbOther, req)
+	sharedInformers := informers.NewSharedInformerFactory(clientset, 0)
+	require.NoError(t, sharedInformers.Velero().V1().PodVolumeBackups().Informer().GetStore().Add(pvb2))
+	require.NoError(t, sharedInformers.Velero().V1().PodVolumeBackups().Informer().GetStore().Add(pvbOther))
+
+	kbClient := &conflictingClient{
+		Client: velerotest.NewFakeControllerRuntimeClient(t,
+			builder.ForBackupStorageLocation("velero", "default").MaxConcurrentPodVolumeBackups(2).Result(),
+			&corev1api.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: podVolumeBackupSlotsName("default")},
+				Data:       map[string]string{"pvb-2": claimedAt},
+			},
+		),
+	}
+
+	c := &podVolumeBackupController{
+		genericController:     newGenericController(PodVolumeBackup, velerotest.NewLogger()),
+		podVolumeBackupClient: clientset.VeleroV1(),
+		podVolumeBackupLister: sharedInformers.Velero().V1().PodVolumeBackups().Lister(),
+		kbClient:              kbClient,
+		apiReader:             kbClient,
+		nodeName:              "foo",
+		clock:                 clock.NewFakeClock(now),
+	}
+
+	// the slot pvb-1 was about to claim was taken by another node, so the retry
+	// finds the location at its limit
+	started, err := c.tryStart(req)
+	require.NoError(t, err)
+	assert.False(t, started)
+
+	slots := &corev1api.ConfigMap{}
+	require.NoError(t, kbClient.Get(context.TODO(), kbclient.ObjectKey{Namespace: "velero", Name: podVolumeBackupSlotsName("default")}, slots))
+	assert.Equal(t, map[string]string{"pvb-2": claimedAt, "pvb-other": claimedAt}, slots.Data)
+}
@@ -0,0 +1,156 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/label"
+)
+
+// staleSlotClaimAge is how old the claim of a pod volume backup that can't be
+// found has to be for it to be released. Pod volume backups claimed on other
+// nodes may not be in this node's cache yet.
+const staleSlotClaimAge = time.Minute
+
+// podVolumeBackupSlotsName returns the name of the config map that holds the
+// claims of the pod volume backups in progress to the given backup storage
+// location. The config map is keyed by the names of the pod volume backups,
+// and its values are the times they were claimed at.
+func podVolumeBackupSlotsName(location string) string {
+	return location + "-pod-volume-backup-slots"
+}
+
+// isConflictOrAlreadyExists returns whether err is due to a concurrent update
+// or creation of the same object.
+func isConflictOrAlreadyExists(err error) bool {
+	return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+}
+
+// claimSlot claims one of the limit slots of req's backup storage location for
+// req, unless they're all claimed. The claims of all nodes are kept in a config
+// map that's updated with optimistic concurrency, so that the node agents can't
+// exceed the limit together. It returns whether req has a claim, and the number
+// of claims of the location.
+func (c *podVolumeBackupController) claimSlot(req *velerov1api.PodVolumeBackup, limit int) (bool, int, error) {
+	var claimed bool
+	var running int
+
+	err := retry.OnError(retry.DefaultBackoff, isConflictOrAlreadyExists, func() error {
+		claimed, running = false, 0
+
+		slots := &corev1api.ConfigMap{}
+		err := c.apiReader.Get(context.Background(), client.ObjectKey{
+			Namespace: req.Namespace,
+			Name:      podVolumeBackupSlotsName(req.Spec.BackupStorageLocation),
+		}, slots)
+		exists := err == nil
+		switch {
+		case apierrors.IsNotFound(err):
+			slots = builder.ForConfigMap(req.Namespace, podVolumeBackupSlotsName(req.Spec.BackupStorageLocation)).
+				ObjectMeta(builder.WithLabels(velerov1api.StorageLocationLabel, label.GetValidName(req.Spec.BackupStorageLocation))).
+				Result()
+		case err != nil:
+			return errors.WithStack(err)
+		}
+		if slots.Data == nil {
+			slots.Data = make(map[string]string)
+		}
+
+		now := c.clock.Now()
+		for name, claimedAt := range slots.Data {
+			if name != req.Name && c.isStaleSlotClaim(req.Namespace, name, claimedAt, now) {
+				delete(slots.Data, name)
+			}
+		}
+
+		if _, found := slots.Data[req.Name]; found {
+			claimed, running = true, len(slots.Data)
+			return nil
+		}
+		if len(slots.Data) >= limit {
+			running = len(slots.Data)
+			return nil
+		}
+
+		slots.Data[req.Name] = now.UTC().Format(time.RFC3339)
+		if exists {
+			err = c.kbClient.Update(context.Background(), slots)
+		} else {
+			err = c.kbClient.Create(context.Background(), slots)
+		}
+		if err != nil {
+			return err
+		}
+
+		claimed, running = true, len(slots.Data)
+		return nil
+	})
+	if err != nil {
+		return false, 0, errors.Wrap(err, "error claiming backup storage location concurrency slot")
+	}
+
+	return claimed, running, nil
+}
+
+// isStaleSlotClaim returns whether the claim of the pod volume backup with the
+// given name can be released, because the backup is done or no longer exists.
+func (c *podVolumeBackupController) isStaleSlotClaim(namespace, name, claimedAt string, now time.Time) bool {
+	pvb, err := c.podVolumeBackupLister.PodVolumeBackups(namespace).Get(name)
+	if err == nil {
+		return pvb.Status.Phase == velerov1api.PodVolumeBackupPhaseCompleted || pvb.Status.Phase == velerov1api.PodVolumeBackupPhaseFailed
+	}
+
+	claimTime, err := time.Parse(time.RFC3339, claimedAt)
+	return err != nil || now.Sub(claimTime) > staleSlotClaimAge
+}
+
+// releaseSlot releases req's claim of one of its backup storage location's
+// slots, if it has one.
+func (c *podVolumeBackupController) releaseSlot(req *velerov1api.PodVolumeBackup) error {
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		slots := &corev1api.ConfigMap{}
+		err := c.apiReader.Get(context.Background(), client.ObjectKey{
+			Namespace: req.Namespace,
+			Name:      podVolumeBackupSlotsName(req.Spec.BackupStorageLocation),
+		}, slots)
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		if _, found := slots.Data[req.Name]; !found {
+			return nil
+		}
+		delete(slots.Data, req.Name)
+
+		return c.kbClient.Update(context.Background(), slots)
+	})
+
+	return errors.Wrap(err, "error releasing backup storage location concurrency slot")
+}
@@ -0,0 +1,101 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// hostRootPath is where the node's root filesystem is mounted in the restic
+// daemonset pod when backing up hostPath volumes is enabled.
+const hostRootPath = "/host_root"
+
+// getPodVolumePath returns the type of the data of the given pod volume and
+// its path in the restic daemonset pod: the volume's directory under the
+// kubelet's pods directory, its hostPath under the node's root filesystem, or
+// its device for block volumes.
+func getPodVolumePath(pod *corev1api.Pod, volumeName string, pvcLister corev1listers.PersistentVolumeClaimLister, pvLister corev1listers.PersistentVolumeLister, fs filesystem.Interface) (velerov1api.PodVolumeType, string, error) {
+	var volume *corev1api.Volume
+	for i := range pod.Spec.Volumes {
+		if pod.Spec.Volumes[i].Name == volumeName {
+			volume = &pod.Spec.Volumes[i]
+			break
+		}
+	}
+	if volume == nil {
+		return "", "", errors.New("volume not found in pod")
+	}
+
+	var hostPath string
+	if volume.HostPath != nil {
+		hostPath = volume.HostPath.Path
+	}
+
+	if volume.PersistentVolumeClaim != nil {
+		pvc, err := pvcLister.PersistentVolumeClaims(pod.Namespace).Get(volume.PersistentVolumeClaim.ClaimName)
+		if err != nil {
+			return "", "", errors.WithStack(err)
+		}
+
+		// the kubelet publishes the device of a block volume under the pod's
+		// directory, named after its persistent volume.
+		if pvc.Spec.VolumeMode != nil && *pvc.Spec.VolumeMode == corev1api.PersistentVolumeBlock {
+			path, err := singlePathMatch(fmt.Sprintf("/host_pods/%s/volumeDevices/*/%s", string(pod.UID), pvc.Spec.VolumeName))
+			if err != nil {
+				return "", "", errors.Wrap(err, "error getting block volume device path on host")
+			}
+			return velerov1api.PodVolumeTypeBlock, path, nil
+		}
+
+		pv, err := pvLister.Get(pvc.Spec.VolumeName)
+		if err != nil {
+			return "", "", errors.WithStack(err)
+		}
+		if pv.Spec.HostPath != nil {
+			hostPath = pv.Spec.HostPath.Path
+		}
+	}
+
+	if hostPath != "" {
+		path := filepath.Join(hostRootPath, hostPath)
+		if _, err := fs.Stat(path); err != nil {
+			return "", "", errors.Wrapf(err, "error getting hostPath volume path on host, the node's root filesystem must be mounted at %s", hostRootPath)
+		}
+		return velerov1api.PodVolumeTypeHostPath, path, nil
+	}
+
+	volumeDir, err := kube.GetVolumeDirectory(pod, volumeName, pvcLister, pvLister)
+	if err != nil {
+		return "", "", errors.Wrap(err, "error getting volume directory name")
+	}
+
+	path, err := singlePathMatch(fmt.Sprintf("/host_pods/%s/volumes/*/%s", string(pod.UID), volumeDir))
+	if err != nil {
+		return "", "", errors.Wrap(err, "error getting volume path on host")
+	}
+
+	return velerov1api.PodVolumeTypeFilesystem, path, nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestGetPodVolumePath(t *testing.T) {
+	tests := []struct {
+		name         string
+		volume       *corev1api.Volume
+		pvc          *corev1api.PersistentVolumeClaim
+		pv           *corev1api.PersistentVolume
+		hostDirs     []string
+		expectedType velerov1api.PodVolumeType
+		expectedPath string
+		expectedErr  string
+	}{
+		{
+			name:        "missing volume returns an error",
+			expectedErr: "volume not found in pod",
+		},
+		{
+			name:         "hostPath volume returns its path under the host root",
+			volume:       &corev1api.Volume{Name: "vol-1", VolumeSource: corev1api.VolumeSource{HostPath: &corev1api.HostPathVolumeSource{Path: "/data"}}},
+			hostDirs:     []string{"/host_root/data"},
+			expectedType: velerov1api.PodVolumeTypeHostPath,
+			expectedPath: "/host_root/data",
+		},
+		{
+			name:        "hostPath volume without the host root mounted returns an error",
+			volume:      &corev1api.Volume{Name: "vol-1", VolumeSource: corev1api.VolumeSource{HostPath: &corev1api.HostPathVolumeSource{Path: "/data"}}},
+			expectedErr: "error getting hostPath volume path on host",
+		},
+		{
+			name:         "PVC bound to a hostPath persistent volume returns its path under the host root",
+			volume:       builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result(),
+			pvc:          builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result(),
+			pv:           builder.ForPersistentVolume("pv-1").HostPath("/mnt/disks/pv-1").Result(),
+			hostDirs:     []string{"/host_root/mnt/disks/pv-1"},
+			expectedType: velerov1api.PodVolumeTypeHostPath,
+			expectedPath: "/host_root/mnt/disks/pv-1",
+		},
+		{
+			name:        "block volume without a published device returns an error",
+			volume:      builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result(),
+			pvc:         builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").VolumeMode(corev1api.PersistentVolumeBlock).Result(),
+			expectedErr: "error getting block volume device path on host",
+		},
+		{
+			name:        "filesystem volume without a directory under the pod returns an error",
+			volume:      builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result(),
+			pvc:         builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result(),
+			pv:          builder.ForPersistentVolume("pv-1").Result(),
+			expectedErr: "error getting volume path on host",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pod := builder.ForPod("ns-1", "pod-1").Result()
+			if tc.volume != nil {
+				pod.Spec.Volumes = append(pod.Spec.Volumes, *tc.volume)
+			}
+
+			informerFactory := kubeinformers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0)
+			pvcInformer := informerFactory.Core().V1().PersistentVolumeClaims()
+			pvInformer := informerFactory.Core().V1().PersistentVolumes()
+			if tc.pvc != nil {
+				require.NoError(t, pvcInformer.Informer().GetStore().Add(tc.pvc))
+			}
+			if tc.pv != nil {
+				require.NoError(t, pvInformer.Informer().GetStore().Add(tc.pv))
+			}
+
+			fs := velerotest.NewFakeFileSystem().WithDirectories(tc.hostDirs...)
+
+			volumeType, path, err := getPodVolumePath(pod, "vol-1", pvcInformer.Lister(), pvInformer.Lister(), fs)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedType, volumeType)
+			assert.Equal(t, tc.expectedPath, path)
+		})
+	}
+}
@@ -45,6 +45,8 @@ import (
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	"github.com/vmware-tanzu/velero/pkg/uploader/provider"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
@@ -149,8 +151,8 @@ func (c *podVolumeRestoreController) pvrHandler(obj interface{}) {
 		return
 	}
 
-	if !isResticInitContainerRunning(pod) {
-		log.Debug("Restore's pod is not running restic-wait init container, not enqueuing")
+	if !isRestoreContainerRunning(pod) {
+		log.Debug("Restore's pod is not running restic-wait container, not enqueuing")
 		return
 	}
 
@@ -174,8 +176,8 @@ func (c *podVolumeRestoreController) podHandler(obj interface{}) {
 		return
 	}
 
-	if !isResticInitContainerRunning(pod) {
-		log.Debug("Pod is not running restic-wait init container, not enqueuing restores for pod")
+	if !isRestoreContainerRunning(pod) {
+		log.Debug("Pod is not running restic-wait container, not enqueuing restores for pod")
 		return
 	}
 
@@ -218,6 +220,22 @@ func isPodOnNode(pod *corev1api.Pod, node string) bool {
 	return pod.Spec.NodeName == node
 }
 
+// isRestoreContainerRunning returns whether the restic-wait container of the pod
+// is running. It's the only container of restore helper pods, and an init
+// container of other pods.
+func isRestoreContainerRunning(pod *corev1api.Pod) bool {
+	if pod.Labels[velerov1api.RestoreHelperPodLabel] == "" {
+		return isResticInitContainerRunning(pod)
+	}
+
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == restic.InitContainer {
+			return status.State.Running != nil
+		}
+	}
+	return false
+}
+
 func isResticInitContainerRunning(pod *corev1api.Pod) bool {
 	// Restic wait container can be anywhere in the list of init containers, but must be running.
 	i := getResticInitContainerIndex(pod)
@@ -297,14 +315,8 @@ func (c *podVolumeRestoreController) processRestore(req *velerov1api.PodVolumeRe
 		return c.failRestore(req, errors.Wrap(err, "error getting pod").Error(), log)
 	}
 
-	volumeDir, err := kube.GetVolumeDirectory(pod, req.Spec.Volume, c.pvcLister, c.pvLister)
-	if err != nil {
-		log.WithError(err).Error("Error getting volume directory name")
-		return c.failRestore(req, errors.Wrap(err, "error getting volume directory name").Error(), log)
-	}
-
 	// execute the restore process
-	if err := c.restorePodVolume(req, volumeDir, log); err != nil {
+	if err := c.restorePodVolume(req, pod, log); err != nil {
 		log.WithError(err).Error("Error restoring volume")
 		return c.failRestore(req, errors.Wrap(err, "error restoring volume").Error(), log)
 	}
@@ -323,28 +335,21 @@ func (c *podVolumeRestoreController) processRestore(req *velerov1api.PodVolumeRe
 	return nil
 }
 
-func (c *podVolumeRestoreController) restorePodVolume(req *velerov1api.PodVolumeRestore, volumeDir string, log logrus.FieldLogger) error {
-	// Get the full path of the new volume's directory as mounted in the daemonset pod, which
-	// will look like: /host_pods/<new-pod-uid>/volumes/<volume-plugin-name>/<volume-dir>
-	volumePath, err := singlePathMatch(fmt.Sprintf("/host_pods/%s/volumes/*/%s", string(req.Spec.Pod.UID), volumeDir))
+func (c *podVolumeRestoreController) restorePodVolume(req *velerov1api.PodVolumeRestore, pod *corev1api.Pod, log logrus.FieldLogger) error {
+	// Get the full path of the new volume as mounted in the daemonset pod, which for most
+	// volumes will look like: /host_pods/<new-pod-uid>/volumes/<volume-plugin-name>/<volume-dir>
+	volumeType, volumePath, err := getPodVolumePath(pod, req.Spec.Volume, c.pvcLister, c.pvLister, c.fileSystem)
 	if err != nil {
 		return errors.Wrap(err, "error identifying path of volume")
 	}
 
-	credsFile, err := c.credentialsFileStore.Path(restic.RepoKeySelector())
-	if err != nil {
-		log.WithError(err).Error("Error creating temp restic credentials file")
-		return c.failRestore(req, errors.Wrap(err, "error creating temp restic credentials file").Error(), log)
+	backupVolumeType := req.Spec.VolumeType
+	if backupVolumeType == "" {
+		backupVolumeType = velerov1api.PodVolumeTypeFilesystem
+	}
+	if volumeType != backupVolumeType {
+		return errors.Errorf("volume type %s doesn't match the volume type %s of the backup", volumeType, backupVolumeType)
 	}
-	// ignore error since there's nothing we can do and it's a temp file.
-	defer os.Remove(credsFile)
-
-	resticCmd := restic.RestoreCommand(
-		req.Spec.RepoIdentifier,
-		credsFile,
-		req.Spec.SnapshotID,
-		volumePath,
-	)
 
 	backupLocation := &velerov1api.BackupStorageLocation{}
 	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
@@ -354,37 +359,37 @@ func (c *podVolumeRestoreController) restorePodVolume(req *velerov1api.PodVolume
 		return c.failRestore(req, errors.Wrap(err, "error getting backup storage location").Error(), log)
 	}
 
-	// if there's a caCert on the ObjectStorage, write it to disk so that it can be passed to restic
-	var caCertFile string
-	if backupLocation.Spec.ObjectStorage != nil && backupLocation.Spec.ObjectStorage.CACert != nil {
-		caCertFile, err = restic.TempCACertFile(backupLocation.Spec.ObjectStorage.CACert, req.Spec.BackupStorageLocation, c.fileSystem)
-		if err != nil {
-			log.WithError(err).Error("Error creating temp cacert file")
-		}
-		// ignore error since there's nothing we can do and it's a temp file.
-		defer os.Remove(caCertFile)
-	}
-	resticCmd.CACertFile = caCertFile
-
-	env, err := restic.CmdEnv(backupLocation, c.credentialsFileStore)
+	uploaderProv, err := provider.NewUploaderProvider(req.Spec.UploaderType, req.Spec.RepoIdentifier, req.Spec.RepositoryKeySecret, backupLocation, uploader.GetTransferSettings(backupLocation, req.Spec.PodVolumeTransfer), c.credentialsFileStore, c.fileSystem, log)
 	if err != nil {
-		return c.failRestore(req, errors.Wrap(err, "error setting restic cmd env").Error(), log)
+		return c.failRestore(req, errors.Wrap(err, "error creating uploader provider").Error(), log)
 	}
-	resticCmd.Env = env
+	defer uploaderProv.Close(context.Background())
 
-	var stdout, stderr string
+	// the done file of a block volume is written to the emptyDir volume
+	// added to the pod for it, since the device can't hold files.
+	doneDir := volumePath
+	if volumeType == velerov1api.PodVolumeTypeBlock {
+		if len(req.Spec.IncludedPaths) > 0 {
+			return errors.New("paths can't be restored from a block volume's snapshot")
+		}
+		if err := uploaderProv.RunBlockRestore(context.Background(), req.Spec.SnapshotID, volumePath, c.updateRestoreProgressFunc(req, log)); err != nil {
+			return errors.Wrapf(err, "error running %s restore", uploader.GetUploaderType(req.Spec.UploaderType))
+		}
 
-	if stdout, stderr, err = restic.RunRestore(resticCmd, log, c.updateRestoreProgressFunc(req, log)); err != nil {
-		return errors.Wrapf(err, "error running restic restore, cmd=%s, stdout=%s, stderr=%s", resticCmd.String(), stdout, stderr)
+		doneDir, err = singlePathMatch(fmt.Sprintf("/host_pods/%s/volumes/*/%s", string(req.Spec.Pod.UID), restic.RestoreDoneVolumeName(req.Spec.Volume)))
+		if err != nil {
+			return errors.Wrap(err, "error identifying path of block volume's done file volume")
+		}
+	} else if err := uploaderProv.RunRestore(context.Background(), req.Spec.SnapshotID, volumePath, req.Spec.IncludedPaths, c.updateRestoreProgressFunc(req, log)); err != nil {
+		return errors.Wrapf(err, "error running %s restore", uploader.GetUploaderType(req.Spec.UploaderType))
 	}
-	log.Debugf("Ran command=%s, stdout=%s, stderr=%s", resticCmd.String(), stdout, stderr)
 
 	// Remove the .velero directory from the restored volume (it may contain done files from previous restores
 	// of this volume, which we don't want to carry over). If this fails for any reason, log and continue, since
 	// this is non-essential cleanup (the done files are named based on restore UID and the init container looks
 	// for the one specific to the restore being executed).
-	if err := os.RemoveAll(filepath.Join(volumePath, ".velero")); err != nil {
-		log.WithError(err).Warnf("error removing .velero directory from directory %s", volumePath)
+	if err := os.RemoveAll(filepath.Join(doneDir, ".velero")); err != nil {
+		log.WithError(err).Warnf("error removing .velero directory from directory %s", doneDir)
 	}
 
 	var restoreUID types.UID
@@ -397,14 +402,14 @@ func (c *podVolumeRestoreController) restorePodVolume(req *velerov1api.PodVolume
 
 	// Create the .velero directory within the volume dir so we can write a done file
 	// for this restore.
-	if err := os.MkdirAll(filepath.Join(volumePath, ".velero"), 0755); err != nil {
+	if err := os.MkdirAll(filepath.Join(doneDir, ".velero"), 0755); err != nil {
 		return errors.Wrap(err, "error creating .velero directory for done file")
 	}
 
 	// Write a done file with name=<restore-uid> into the just-created .velero dir
 	// within the volume. The velero restic init container on the pod is waiting
 	// for this file to exist in each restored volume before completing.
-	if err := ioutil.WriteFile(filepath.Join(volumePath, ".velero", string(restoreUID)), nil, 0644); err != nil {
+	if err := ioutil.WriteFile(filepath.Join(doneDir, ".velero", string(restoreUID)), nil, 0644); err != nil {
 		return errors.Wrap(err, "error writing done file")
 	}
 
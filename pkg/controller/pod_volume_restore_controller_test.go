@@ -619,6 +619,61 @@ func TestIsResticContainerRunning(t *testing.T) {
 	}
 }
 
+func TestIsRestoreContainerRunning(t *testing.T) {
+	helperPod := func(state corev1api.ContainerState) *corev1api.Pod {
+		return &corev1api.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns-1",
+				Name:      "velero-restore-helper-1",
+				Labels:    map[string]string{velerov1api.RestoreHelperPodLabel: "true"},
+			},
+			Spec: corev1api.PodSpec{
+				Containers: []corev1api.Container{{Name: restic.InitContainer}},
+			},
+			Status: corev1api.PodStatus{
+				ContainerStatuses: []corev1api.ContainerStatus{{Name: restic.InitContainer, State: state}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		pod      *corev1api.Pod
+		expected bool
+	}{
+		{
+			name:     "restore helper pod with running restic container should return true",
+			pod:      helperPod(corev1api.ContainerState{Running: &corev1api.ContainerStateRunning{StartedAt: metav1.Time{Time: time.Now()}}}),
+			expected: true,
+		},
+		{
+			name:     "restore helper pod with waiting restic container should return false",
+			pod:      helperPod(corev1api.ContainerState{Waiting: &corev1api.ContainerStateWaiting{}}),
+			expected: false,
+		},
+		{
+			name: "pod with running restic init container should return true",
+			pod: &corev1api.Pod{
+				Spec: corev1api.PodSpec{
+					InitContainers: []corev1api.Container{{Name: restic.InitContainer}},
+				},
+				Status: corev1api.PodStatus{
+					InitContainerStatuses: []corev1api.ContainerStatus{
+						{State: corev1api.ContainerState{Running: &corev1api.ContainerStateRunning{StartedAt: metav1.Time{Time: time.Now()}}}},
+					},
+				},
+			},
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, isRestoreContainerRunning(test.pod))
+		})
+	}
+}
+
 func TestGetResticInitContainerIndex(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -25,6 +25,7 @@ import (
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -49,6 +50,8 @@ type resticRepositoryController struct {
 	kbClient                    client.Client
 	repositoryManager           restic.RepositoryManager
 	defaultMaintenanceFrequency time.Duration
+	defaultCheckFrequency       time.Duration
+	keyRotationTimeout          time.Duration
 
 	clock clock.Clock
 }
@@ -61,6 +64,8 @@ func NewResticRepositoryController(
 	kbClient client.Client,
 	repositoryManager restic.RepositoryManager,
 	defaultMaintenanceFrequency time.Duration,
+	defaultCheckFrequency time.Duration,
+	keyRotationTimeout time.Duration,
 ) Interface {
 	c := &resticRepositoryController{
 		genericController:           newGenericController(ResticRepo, logger),
@@ -69,6 +74,8 @@ func NewResticRepositoryController(
 		kbClient:                    kbClient,
 		repositoryManager:           repositoryManager,
 		defaultMaintenanceFrequency: defaultMaintenanceFrequency,
+		defaultCheckFrequency:       defaultCheckFrequency,
+		keyRotationTimeout:          keyRotationTimeout,
 
 		clock: &clock.RealClock{},
 	}
@@ -78,11 +85,28 @@ func NewResticRepositoryController(
 		c.defaultMaintenanceFrequency = restic.DefaultMaintenanceFrequency
 	}
 
+	if c.defaultCheckFrequency < 0 {
+		logger.Infof("Invalid default restic check frequency, setting to %v", restic.DefaultCheckFrequency)
+		c.defaultCheckFrequency = restic.DefaultCheckFrequency
+	}
+
+	if c.keyRotationTimeout <= 0 {
+		logger.Infof("Invalid restic key rotation timeout, setting to %v", restic.DefaultKeyRotationTimeout)
+		c.keyRotationTimeout = restic.DefaultKeyRotationTimeout
+	}
+
 	c.syncHandler = c.processQueueItem
 
 	resticRepositoryInformer.Informer().AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc: c.enqueue,
+			UpdateFunc: func(_, obj interface{}) {
+				// only react to updates that request an operation, to avoid
+				// re-processing repositories every time their status is patched.
+				if hasRequestedOperations(obj.(*velerov1api.ResticRepository)) {
+					c.enqueue(obj)
+				}
+			},
 		},
 	)
 
@@ -136,6 +160,10 @@ func (c *resticRepositoryController) processQueueItem(key string) error {
 		return c.initializeRepo(reqCopy, log)
 	}
 
+	if err := c.runRequestedOperations(reqCopy, log); err != nil {
+		return err
+	}
+
 	// If the repository is ready or not-ready, check it for stale locks, but if
 	// this fails for any reason, it's non-critical so we still continue on to the
 	// rest of the "process" logic.
@@ -146,8 +174,19 @@ func (c *resticRepositoryController) processQueueItem(key string) error {
 
 	switch req.Status.Phase {
 	case velerov1api.ResticRepositoryPhaseReady:
-		return c.runMaintenanceIfDue(reqCopy, log)
+		if err := c.runMaintenanceIfDue(reqCopy, log); err != nil {
+			return err
+		}
+		if err := c.runCheckIfDue(reqCopy, log); err != nil {
+			return err
+		}
+		return c.rotateKeyIfRequested(reqCopy, log)
 	case velerov1api.ResticRepositoryPhaseNotReady:
+		// the new key can't be added to the repository while it's not
+		// ready, so don't let it hold up a rotation past its timeout.
+		if _, err := c.abortKeyRotationIfTimedOut(reqCopy, log); err != nil {
+			return err
+		}
 		return c.checkNotReadyRepo(reqCopy, log)
 	}
 
@@ -251,6 +290,292 @@ func dueForMaintenance(req *velerov1api.ResticRepository, now time.Time) bool {
 	return req.Status.LastMaintenanceTime == nil || req.Status.LastMaintenanceTime.Add(req.Spec.MaintenanceFrequency.Duration).Before(now)
 }
 
+func (c *resticRepositoryController) runCheckIfDue(req *velerov1api.ResticRepository, log logrus.FieldLogger) error {
+	log.Debug("resticRepositoryController.runCheckIfDue")
+
+	if !dueForCheck(req, c.checkFrequency(req), c.clock.Now()) {
+		log.Debug("not due for integrity check")
+		return nil
+	}
+
+	return c.runCheck(req, log)
+}
+
+func (c *resticRepositoryController) checkFrequency(req *velerov1api.ResticRepository) time.Duration {
+	if req.Spec.CheckFrequency != nil {
+		return req.Spec.CheckFrequency.Duration
+	}
+	return c.defaultCheckFrequency
+}
+
+func dueForCheck(req *velerov1api.ResticRepository, frequency time.Duration, now time.Time) bool {
+	if frequency <= 0 {
+		return false
+	}
+	return req.Status.LastCheckTime == nil || req.Status.LastCheckTime.Add(frequency).Before(now)
+}
+
+// runCheck checks the integrity of the repository and records the result, along with
+// statistics about the repository's contents, in its status. Check failures don't
+// cause the repo to move to `NotReady`, since it may still be usable.
+func (c *resticRepositoryController) runCheck(req *velerov1api.ResticRepository, log logrus.FieldLogger) error {
+	now := c.clock.Now()
+
+	log.Info("Checking integrity of restic repository")
+	checkErrors, err := c.repositoryManager.CheckRepo(req)
+	if err != nil {
+		log.WithError(err).Warn("error checking repository")
+		checkErrors = []string{err.Error()}
+	} else if len(checkErrors) > 0 {
+		log.Warnf("Integrity check found %d errors in repository", len(checkErrors))
+	}
+
+	stats, err := c.repositoryManager.GetRepoStats(req)
+	if err != nil {
+		log.WithError(err).Warn("error getting repository stats")
+	}
+
+	return c.patchResticRepository(req, func(r *velerov1api.ResticRepository) {
+		r.Status.LastCheckTime = &metav1.Time{Time: now}
+		r.Status.LastCheckErrors = checkErrors
+		if stats != nil {
+			r.Status.Stats = stats
+		}
+	})
+}
+
+func hasRequestedOperations(req *velerov1api.ResticRepository) bool {
+	_, checkRequested := req.Annotations[velerov1api.ResticRepositoryCheckRequestAnnotation]
+	_, unlockRequested := req.Annotations[velerov1api.ResticRepositoryUnlockRequestAnnotation]
+	return checkRequested || unlockRequested
+}
+
+// runRequestedOperations runs the operations requested through the repository's
+// annotations, e.g. by `velero restic repo check`. The annotations are removed
+// first so the operations are only attempted once.
+func (c *resticRepositoryController) runRequestedOperations(req *velerov1api.ResticRepository, log logrus.FieldLogger) error {
+	if !hasRequestedOperations(req) {
+		return nil
+	}
+
+	_, checkRequested := req.Annotations[velerov1api.ResticRepositoryCheckRequestAnnotation]
+	unlockMode, unlockRequested := req.Annotations[velerov1api.ResticRepositoryUnlockRequestAnnotation]
+
+	if err := c.patchResticRepository(req, func(r *velerov1api.ResticRepository) {
+		delete(r.Annotations, velerov1api.ResticRepositoryCheckRequestAnnotation)
+		delete(r.Annotations, velerov1api.ResticRepositoryUnlockRequestAnnotation)
+	}); err != nil {
+		return err
+	}
+
+	// no identifier: the repository was never initialized, so there's nothing to do
+	if req.Spec.ResticIdentifier == "" {
+		return nil
+	}
+
+	if unlockRequested {
+		var err error
+		if unlockMode == velerov1api.ResticRepositoryUnlockAll {
+			log.Info("Removing all locks from restic repository")
+			err = c.repositoryManager.RemoveAllLocks(req)
+		} else {
+			log.Info("Removing stale locks from restic repository")
+			err = c.repositoryManager.UnlockRepo(req)
+		}
+		if err != nil {
+			log.WithError(err).Warn("error removing locks from repository")
+			if patchErr := c.patchResticRepository(req, func(r *velerov1api.ResticRepository) {
+				r.Status.Message = err.Error()
+			}); patchErr != nil {
+				return patchErr
+			}
+		}
+	}
+
+	if checkRequested {
+		return c.runCheck(req, log)
+	}
+
+	return nil
+}
+
+// rotateKeyIfRequested moves the repository through the rotation of its key
+// that was started with `velero restic repo rotate-key`. The new key is added to
+// every repository that uses the key secret before it replaces the current key
+// in the secret, so that the repositories remain accessible throughout. The
+// previous key is removed from the repository afterwards.
+func (c *resticRepositoryController) rotateKeyIfRequested(req *velerov1api.ResticRepository, log logrus.FieldLogger) error {
+	secret, err := c.abortKeyRotationIfTimedOut(req, log)
+	if err != nil {
+		return err
+	}
+
+	rotationID, pending := restic.KeyRotationPending(secret)
+	if rotationID == "" {
+		return nil
+	}
+
+	rotation := req.Status.KeyRotation
+	current := rotation != nil && rotation.ID == rotationID
+	log = log.WithField("keyRotation", rotationID)
+
+	switch {
+	case pending && (!current || rotation.Phase == velerov1api.ResticRepositoryKeyRotationPhaseFailed):
+		log.Info("Adding new key to restic repository")
+		previousKeyID, newKeyID, err := c.repositoryManager.AddRepoKey(req)
+		if err != nil {
+			log.WithError(err).Warn("error adding new key to repository")
+			return c.patchResticRepository(req, func(r *velerov1api.ResticRepository) {
+				r.Status.KeyRotation = &velerov1api.ResticRepositoryKeyRotation{
+					ID:      rotationID,
+					Phase:   velerov1api.ResticRepositoryKeyRotationPhaseFailed,
+					Message: err.Error(),
+				}
+			})
+		}
+
+		if err := c.patchResticRepository(req, func(r *velerov1api.ResticRepository) {
+			r.Status.KeyRotation = &velerov1api.ResticRepositoryKeyRotation{
+				ID:            rotationID,
+				Phase:         velerov1api.ResticRepositoryKeyRotationPhaseKeyAdded,
+				PreviousKeyID: previousKeyID,
+				NewKeyID:      newKeyID,
+			}
+		}); err != nil {
+			return err
+		}
+
+		return c.completeKeyRotationIfReady(req, secret, rotationID, log)
+	case pending && rotation.Phase == velerov1api.ResticRepositoryKeyRotationPhaseKeyAdded:
+		return c.completeKeyRotationIfReady(req, secret, rotationID, log)
+	case !pending && restic.KeyRotationAborted(secret) && current && rotation.Phase == velerov1api.ResticRepositoryKeyRotationPhaseKeyAdded:
+		log.Info("Removing new key of aborted rotation from restic repository")
+		if err := c.repositoryManager.RemoveRepoKey(req, rotation.NewKeyID); err != nil {
+			// the new key can no longer be used, so keep the phase and try again later.
+			log.WithError(err).Warn("error removing new key from repository")
+			return c.patchResticRepository(req, func(r *velerov1api.ResticRepository) {
+				r.Status.KeyRotation.Message = err.Error()
+			})
+		}
+
+		return c.patchResticRepository(req, keyRotationTimedOut(rotationID))
+	case !pending && restic.KeyRotationAborted(secret) && (!current || rotation.Phase != velerov1api.ResticRepositoryKeyRotationPhaseFailed):
+		return c.patchResticRepository(req, keyRotationTimedOut(rotationID))
+	case !pending && current && rotation.Phase == velerov1api.ResticRepositoryKeyRotationPhaseKeyAdded:
+		log.Info("Removing previous key from restic repository")
+		if err := c.repositoryManager.RemoveRepoKey(req, rotation.PreviousKeyID); err != nil {
+			// the previous key still works, so keep the phase and try again later.
+			log.WithError(err).Warn("error removing previous key from repository")
+			return c.patchResticRepository(req, func(r *velerov1api.ResticRepository) {
+				r.Status.KeyRotation.Message = err.Error()
+			})
+		}
+
+		now := c.clock.Now()
+		return c.patchResticRepository(req, func(r *velerov1api.ResticRepository) {
+			r.Status.KeyRotation = &velerov1api.ResticRepositoryKeyRotation{
+				ID:                  rotationID,
+				Phase:               velerov1api.ResticRepositoryKeyRotationPhaseCompleted,
+				CompletionTimestamp: &metav1.Time{Time: now},
+			}
+		})
+	}
+
+	return nil
+}
+
+// abortKeyRotationIfTimedOut aborts the rotation of req's key if its new key
+// hasn't been added to all repositories that use the key secret within the key
+// rotation timeout, e.g. because one of them isn't ready. The current key stays
+// in use, and the new key is removed from the repositories it was added to. It
+// returns the key secret.
+func (c *resticRepositoryController) abortKeyRotationIfTimedOut(req *velerov1api.ResticRepository, log logrus.FieldLogger) (*corev1api.Secret, error) {
+	secret := &corev1api.Secret{}
+	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
+		Namespace: req.Namespace,
+		Name:      restic.KeySecretName(req.Spec.RepositoryKeySecret),
+	}, secret); err != nil {
+		return nil, errors.Wrap(err, "error getting repository key secret")
+	}
+
+	rotationID, pending := restic.KeyRotationPending(secret)
+	if !pending {
+		return secret, nil
+	}
+
+	startTime, err := restic.KeyRotationStartTime(rotationID)
+	if err != nil {
+		// rotations started with `velero restic repo rotate-key` are
+		// identified by their start time, so this one can't be timed out.
+		log.WithError(err).Debug("Unable to get start time of restic repository key rotation")
+		return secret, nil
+	}
+	if c.clock.Now().Sub(startTime) < c.keyRotationTimeout {
+		return secret, nil
+	}
+
+	log.WithField("keyRotation", rotationID).Warnf("New key wasn't added to all restic repositories using secret %s within %v, aborting key rotation", secret.Name, c.keyRotationTimeout)
+
+	restic.AbortKeyRotation(secret)
+	if err := c.kbClient.Update(context.Background(), secret); err != nil {
+		return nil, errors.Wrap(err, "error updating repository key secret")
+	}
+
+	// process all repositories so that the new key is removed from them
+	// without waiting for the next resync.
+	c.enqueueAllRepositories()
+
+	return secret, nil
+}
+
+func keyRotationTimedOut(rotationID string) func(*velerov1api.ResticRepository) {
+	return func(r *velerov1api.ResticRepository) {
+		r.Status.KeyRotation = &velerov1api.ResticRepositoryKeyRotation{
+			ID:      rotationID,
+			Phase:   velerov1api.ResticRepositoryKeyRotationPhaseFailed,
+			Message: "key rotation timed out before the new key was added to all restic repositories using the key secret",
+		}
+	}
+}
+
+// completeKeyRotationIfReady makes the new key in the secret the current one once
+// it has been added to all repositories that use the secret, including req.
+func (c *resticRepositoryController) completeKeyRotationIfReady(req *velerov1api.ResticRepository, secret *corev1api.Secret, rotationID string, log logrus.FieldLogger) error {
+	repos, err := c.resticRepositoryLister.ResticRepositories(req.Namespace).List(labels.Everything())
+	if err != nil {
+		return errors.Wrap(err, "error listing restic repositories")
+	}
+
+	for _, repo := range repos {
+		// req's status may not be reflected in the cache yet
+		if repo.Name == req.Name || restic.KeySecretName(repo.Spec.RepositoryKeySecret) != secret.Name {
+			continue
+		}
+		if !keyAdded(repo, rotationID) {
+			log.Debugf("Waiting for new key to be added to restic repository %s", repo.Name)
+			return nil
+		}
+	}
+
+	log.Infof("New key added to all restic repositories using secret %s, making it the current key", secret.Name)
+	restic.CompleteKeyRotation(secret)
+	if err := c.kbClient.Update(context.Background(), secret); err != nil {
+		return errors.Wrap(err, "error updating repository key secret")
+	}
+
+	// process all repositories so that their previous keys are removed
+	// without waiting for the next resync.
+	c.enqueueAllRepositories()
+
+	return nil
+}
+
+func keyAdded(repo *velerov1api.ResticRepository, rotationID string) bool {
+	return repo.Status.KeyRotation != nil &&
+		repo.Status.KeyRotation.ID == rotationID &&
+		repo.Status.KeyRotation.Phase == velerov1api.ResticRepositoryKeyRotationPhaseKeyAdded
+}
+
 func (c *resticRepositoryController) checkNotReadyRepo(req *velerov1api.ResticRepository, log logrus.FieldLogger) error {
 	// no identifier: can't possibly be ready, so just return
 	if req.Spec.ResticIdentifier == "" {
@@ -0,0 +1,259 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestDueForCheck(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name          string
+		lastCheckTime *metav1.Time
+		frequency     time.Duration
+		expected      bool
+	}{
+		{
+			name:      "never checked",
+			frequency: time.Hour,
+			expected:  true,
+		},
+		{
+			name:          "last check within frequency",
+			lastCheckTime: &metav1.Time{Time: now.Add(-30 * time.Minute)},
+			frequency:     time.Hour,
+			expected:      false,
+		},
+		{
+			name:          "last check older than frequency",
+			lastCheckTime: &metav1.Time{Time: now.Add(-2 * time.Hour)},
+			frequency:     time.Hour,
+			expected:      true,
+		},
+		{
+			name:      "zero frequency disables checks",
+			frequency: 0,
+			expected:  false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := &velerov1api.ResticRepository{
+				Status: velerov1api.ResticRepositoryStatus{LastCheckTime: test.lastCheckTime},
+			}
+			assert.Equal(t, test.expected, dueForCheck(req, test.frequency, now))
+		})
+	}
+}
+
+func TestHasRequestedOperations(t *testing.T) {
+	req := &velerov1api.ResticRepository{}
+	assert.False(t, hasRequestedOperations(req))
+
+	req.Annotations = map[string]string{velerov1api.ResticRepositoryCheckRequestAnnotation: "now"}
+	assert.True(t, hasRequestedOperations(req))
+
+	req.Annotations = map[string]string{velerov1api.ResticRepositoryUnlockRequestAnnotation: velerov1api.ResticRepositoryUnlockStale}
+	assert.True(t, hasRequestedOperations(req))
+}
+
+// fakeKeyRepositoryManager records the key operations run against repositories.
+type fakeKeyRepositoryManager struct {
+	restic.RepositoryManager
+
+	addedKeys   []string
+	removedKeys map[string]string
+}
+
+func (m *fakeKeyRepositoryManager) AddRepoKey(repo *velerov1api.ResticRepository) (string, string, error) {
+	m.addedKeys = append(m.addedKeys, repo.Name)
+	return repo.Name + "-old-key", repo.Name + "-new-key", nil
+}
+
+func (m *fakeKeyRepositoryManager) RemoveRepoKey(repo *velerov1api.ResticRepository, keyID string) error {
+	m.removedKeys[repo.Name] = keyID
+	return nil
+}
+
+func TestRotateKeyIfRequested(t *testing.T) {
+	newRepo := func(name string) *velerov1api.ResticRepository {
+		return &velerov1api.ResticRepository{
+			ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: name},
+			Status:     velerov1api.ResticRepositoryStatus{Phase: velerov1api.ResticRepositoryPhaseReady},
+		}
+	}
+	repo1, repo2 := newRepo("repo-1"), newRepo("repo-2")
+
+	secret := &corev1api.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: restic.KeySecretName("")},
+		Data:       map[string][]byte{"repository-password": []byte("old")},
+	}
+	require.NoError(t, restic.SetNewRepositoryKey(secret, "rotation-1"))
+
+	var (
+		client          = fake.NewSimpleClientset(repo1, repo2)
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+		kbClient        = velerotest.NewFakeControllerRuntimeClient(t, secret)
+		repoManager     = &fakeKeyRepositoryManager{removedKeys: map[string]string{}}
+		logger          = velerotest.NewLogger()
+	)
+	store := sharedInformers.Velero().V1().ResticRepositories().Informer().GetStore()
+	require.NoError(t, store.Add(repo1))
+	require.NoError(t, store.Add(repo2))
+
+	c := NewResticRepositoryController(
+		logger,
+		sharedInformers.Velero().V1().ResticRepositories(),
+		client.VeleroV1(),
+		kbClient,
+		repoManager,
+		0,
+		0,
+		0,
+	).(*resticRepositoryController)
+
+	getSecret := func() *corev1api.Secret {
+		res := &corev1api.Secret{}
+		require.NoError(t, kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: secret.Namespace, Name: secret.Name}, res))
+		return res
+	}
+
+	// the new key is added to the first repository, but isn't made current
+	// until it has been added to the second one.
+	req := repo1.DeepCopy()
+	require.NoError(t, c.rotateKeyIfRequested(req, logger))
+	assert.Equal(t, []string{"repo-1"}, repoManager.addedKeys)
+	assert.Equal(t, velerov1api.ResticRepositoryKeyRotationPhaseKeyAdded, req.Status.KeyRotation.Phase)
+	assert.Equal(t, "repo-1-old-key", req.Status.KeyRotation.PreviousKeyID)
+	_, pending := restic.KeyRotationPending(getSecret())
+	assert.True(t, pending)
+	require.NoError(t, store.Update(req))
+
+	req = repo2.DeepCopy()
+	require.NoError(t, c.rotateKeyIfRequested(req, logger))
+	assert.Equal(t, []string{"repo-1", "repo-2"}, repoManager.addedKeys)
+	updated := getSecret()
+	_, pending = restic.KeyRotationPending(updated)
+	assert.False(t, pending)
+	assert.Equal(t, secret.Data["new-repository-password"], updated.Data["repository-password"])
+
+	// once the new key is current, the previous key is removed.
+	require.NoError(t, c.rotateKeyIfRequested(req, logger))
+	assert.Equal(t, map[string]string{"repo-2": "repo-2-old-key"}, repoManager.removedKeys)
+	assert.Equal(t, velerov1api.ResticRepositoryKeyRotationPhaseCompleted, req.Status.KeyRotation.Phase)
+	assert.NotNil(t, req.Status.KeyRotation.CompletionTimestamp)
+}
+
+func TestRotateKeyIfRequestedTimeout(t *testing.T) {
+	newRepo := func(name string) *velerov1api.ResticRepository {
+		return &velerov1api.ResticRepository{
+			ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: name},
+			Status:     velerov1api.ResticRepositoryStatus{Phase: velerov1api.ResticRepositoryPhaseReady},
+		}
+	}
+	repo1, repo2 := newRepo("repo-1"), newRepo("repo-2")
+	repo2.Status.Phase = velerov1api.ResticRepositoryPhaseNotReady
+
+	now := time.Date(2021, 11, 1, 10, 0, 0, 0, time.UTC)
+	rotationID := now.Format(time.RFC3339)
+	secret := &corev1api.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: restic.KeySecretName("")},
+		Data:       map[string][]byte{"repository-password": []byte("old")},
+	}
+	require.NoError(t, restic.SetNewRepositoryKey(secret, rotationID))
+
+	var (
+		client          = fake.NewSimpleClientset(repo1, repo2)
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+		kbClient        = velerotest.NewFakeControllerRuntimeClient(t, secret)
+		repoManager     = &fakeKeyRepositoryManager{removedKeys: map[string]string{}}
+		logger          = velerotest.NewLogger()
+		fakeClock       = clock.NewFakeClock(now)
+	)
+	store := sharedInformers.Velero().V1().ResticRepositories().Informer().GetStore()
+	require.NoError(t, store.Add(repo1))
+	require.NoError(t, store.Add(repo2))
+
+	c := NewResticRepositoryController(
+		logger,
+		sharedInformers.Velero().V1().ResticRepositories(),
+		client.VeleroV1(),
+		kbClient,
+		repoManager,
+		0,
+		0,
+		time.Hour,
+	).(*resticRepositoryController)
+	c.clock = fakeClock
+
+	getSecret := func() *corev1api.Secret {
+		res := &corev1api.Secret{}
+		require.NoError(t, kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: secret.Namespace, Name: secret.Name}, res))
+		return res
+	}
+
+	// the new key is added to the ready repository, but can't be added to
+	// the one that isn't ready.
+	req := repo1.DeepCopy()
+	require.NoError(t, c.rotateKeyIfRequested(req, logger))
+	assert.Equal(t, velerov1api.ResticRepositoryKeyRotationPhaseKeyAdded, req.Status.KeyRotation.Phase)
+	assert.Equal(t, "repo-1-new-key", req.Status.KeyRotation.NewKeyID)
+	require.NoError(t, store.Update(req))
+
+	_, err := c.abortKeyRotationIfTimedOut(repo2.DeepCopy(), logger)
+	require.NoError(t, err)
+	_, pending := restic.KeyRotationPending(getSecret())
+	assert.True(t, pending)
+
+	// once the rotation times out, it's aborted and the current key is kept.
+	fakeClock.Step(2 * time.Hour)
+	_, err = c.abortKeyRotationIfTimedOut(repo2.DeepCopy(), logger)
+	require.NoError(t, err)
+	updated := getSecret()
+	_, pending = restic.KeyRotationPending(updated)
+	assert.False(t, pending)
+	assert.True(t, restic.KeyRotationAborted(updated))
+	assert.Equal(t, []byte("old"), updated.Data["repository-password"])
+
+	// the new key is removed from the repository it was added to.
+	require.NoError(t, c.rotateKeyIfRequested(req, logger))
+	assert.Equal(t, map[string]string{"repo-1": "repo-1-new-key"}, repoManager.removedKeys)
+	assert.Equal(t, velerov1api.ResticRepositoryKeyRotationPhaseFailed, req.Status.KeyRotation.Phase)
+
+	// a new rotation can be started.
+	updated = getSecret()
+	require.NoError(t, restic.SetNewRepositoryKey(updated, fakeClock.Now().Format(time.RFC3339)))
+}
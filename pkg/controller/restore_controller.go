@@ -31,6 +31,8 @@ import (
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
@@ -47,7 +49,9 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/restic"
 	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
@@ -312,6 +316,16 @@ func (c *restoreController) validateAndComplete(restore *api.Restore, pluginMana
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded namespace lists: %v", err))
 	}
 
+	// validate the pod volume file restore
+	if fileRestore := restore.Spec.PodVolumeFileRestore; fileRestore != nil {
+		if fileRestore.Namespace == "" || fileRestore.Pod == "" || fileRestore.Volume == "" || fileRestore.TargetPVC == "" {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "Pod volume file restores must specify the namespace, pod and volume to restore from, and the target PVC")
+		}
+		if err := restic.ValidatePathPatterns(fileRestore.Paths); err != nil {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid pod volume file restore paths: %v", err))
+		}
+	}
+
 	// validate that exactly one of BackupName and ScheduleName have been specified
 	if !backupXorScheduleProvided(restore) {
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "Either a backup or schedule must be specified as a source for the restore, but not both")
@@ -427,7 +441,7 @@ func (c *restoreController) fetchBackupInfo(backupName string, pluginManager cli
 // The log and results files are uploaded to backup storage. Any error returned from this function
 // means that the restore failed. This function updates the restore API object with warning and error
 // counts, but *does not* update its phase or patch it via the API.
-func (c *restoreController) runValidatedRestore(restore *api.Restore, info backupInfo) error {
+func (c *restoreController) runValidatedRestore(restore *api.Restore, info backupInfo) (err error) {
 	// instantiate the per-restore logger that will output both to a temp file
 	// (for upload to object storage) and to stdout.
 	restoreLog, err := newRestoreLogger(restore, c.logger, c.restoreLogLevel, c.logFormat)
@@ -436,6 +450,16 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 	}
 	defer restoreLog.closeAndRemove(c.logger)
 
+	// the restore's pod volume restores and hooks are canceled once the restore is done with them
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctx, span := tracing.Tracer().Start(ctx, "Restore", trace.WithAttributes(attribute.String("restore", kubeutil.NamespaceAndName(restore))))
+	defer func() { tracing.EndSpan(span, err) }()
+
+	// the ID of the restore's trace is logged with each of its log entries
+	restoreLog.FieldLogger = tracing.WithTraceID(ctx, restoreLog.FieldLogger)
+
 	pluginManager := c.newPluginManager(restoreLog)
 	defer pluginManager.CleanupClients()
 
@@ -444,7 +468,9 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 		return errors.Wrap(err, "error getting restore item actions")
 	}
 
+	_, downloadSpan := tracing.Tracer().Start(ctx, "DownloadBackup")
 	backupFile, err := downloadToTempFile(restore.Spec.BackupName, info.backupStore, restoreLog)
+	tracing.EndSpan(downloadSpan, err)
 	if err != nil {
 		return errors.Wrap(err, "error downloading backup")
 	}
@@ -476,7 +502,7 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 		VolumeSnapshots:  volumeSnapshots,
 		BackupReader:     backupFile,
 	}
-	restoreWarnings, restoreErrors := c.restorer.Restore(restoreReq, actions, c.snapshotLocationLister, pluginManager)
+	restoreWarnings, restoreErrors := c.restorer.Restore(ctx, restoreReq, actions, c.snapshotLocationLister, pluginManager)
 	restoreLog.Info("restore completed")
 
 	// re-instantiate the backup store because credentials could have changed since the original
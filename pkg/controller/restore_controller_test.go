@@ -271,6 +271,20 @@ func TestProcessQueueItem(t *testing.T) {
 			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
 			expectedValidationErrors: []string{"Invalid included/excluded resource lists: excludes list cannot contain an item in the includes list: a-resource"},
 		},
+		{
+			name:     "pod volume file restore without a target PVC and with invalid paths fails validation",
+			location: defaultStorageLocation,
+			restore: NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).
+				PodVolumeFileRestore(&velerov1api.PodVolumeFileRestoreSpec{Namespace: "ns-1", Pod: "pod-1", Volume: "data", Paths: []string{"../etc"}}).
+				Result(),
+			backup:        defaultBackup().StorageLocation("default").Result(),
+			expectedErr:   false,
+			expectedPhase: string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{
+				"Pod volume file restores must specify the namespace, pod and volume to restore from, and the target PVC",
+				"Invalid pod volume file restore paths: path pattern \"../etc\" must not refer to a parent directory",
+			},
+		},
 		{
 			name:                     "new restore with empty backup and schedule names fails validation",
 			restore:                  NewRestore("foo", "bar", "", "ns-1", "", velerov1api.RestorePhaseNew).Result(),
@@ -847,6 +861,7 @@ type fakeRestorer struct {
 }
 
 func (r *fakeRestorer) Restore(
+	ctx context.Context,
 	info pkgrestore.Request,
 	actions []velero.RestoreItemAction,
 	snapshotLocationLister listers.VolumeSnapshotLocationLister,
@@ -34,6 +34,7 @@ import (
 	"github.com/vmware-tanzu/velero/internal/velero"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/buildinfo"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 )
 
@@ -45,6 +46,9 @@ const (
 type PluginLister interface {
 	// List returns all PluginIdentifiers for kind.
 	List(kind framework.PluginKind) []framework.PluginIdentifier
+	// Status returns the capabilities and health that the plugin for kind and name
+	// reported when it was discovered.
+	Status(kind framework.PluginKind, name string) (clientmgmt.PluginStatus, error)
 }
 
 // ServerStatusRequestReconciler reconciles a ServerStatusRequest object
@@ -35,6 +35,7 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/buildinfo"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
@@ -257,3 +258,7 @@ func (l *fakePluginLister) List(kind framework.PluginKind) []framework.PluginIde
 
 	return plugins
 }
+
+func (l *fakePluginLister) Status(kind framework.PluginKind, name string) (clientmgmt.PluginStatus, error) {
+	return clientmgmt.PluginStatus{Health: framework.PluginHealth{Ready: true}}, nil
+}
@@ -25,6 +25,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/vmware-tanzu/velero/internal/velero"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
 
 func DaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
@@ -52,6 +53,7 @@ func DaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
 	}
 
 	userID := int64(0)
+	privileged := true
 	mountPropagationMode := corev1.MountPropagationHostToContainer
 
 	daemonSet := &appsv1.DaemonSet{
@@ -187,7 +189,53 @@ func DaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
 		}...)
 	}
 
+	// hostPath volumes aren't under /var/lib/kubelet/pods, so their data can only be
+	// reached through the node's root filesystem.
+	if hasFeature(c.features, velerov1api.ResticHostPathVolumesFeatureFlag) {
+		daemonSet.Spec.Template.Spec.Volumes = append(
+			daemonSet.Spec.Template.Spec.Volumes,
+			corev1.Volume{
+				Name: "host-root",
+				VolumeSource: corev1.VolumeSource{
+					HostPath: &corev1.HostPathVolumeSource{
+						Path: "/",
+					},
+				},
+			},
+		)
+
+		daemonSet.Spec.Template.Spec.Containers[0].VolumeMounts = append(
+			daemonSet.Spec.Template.Spec.Containers[0].VolumeMounts,
+			corev1.VolumeMount{
+				Name:             "host-root",
+				MountPath:        "/host_root",
+				MountPropagation: &mountPropagationMode,
+			},
+		)
+	}
+
+	// reading and writing the devices of block volumes requires access to
+	// the node's devices.
+	if hasFeature(c.features, velerov1api.ResticBlockVolumesFeatureFlag) {
+		daemonSet.Spec.Template.Spec.Containers[0].SecurityContext = &corev1.SecurityContext{
+			Privileged: &privileged,
+		}
+	}
+
 	daemonSet.Spec.Template.Spec.Containers[0].Env = append(daemonSet.Spec.Template.Spec.Containers[0].Env, c.envVars...)
 
 	return daemonSet
 }
+
+// hasFeature returns whether the feature is in the list of features, each of
+// which may be a comma-separated list.
+func hasFeature(features []string, feature string) bool {
+	for _, f := range features {
+		for _, name := range strings.Split(f, ",") {
+			if strings.TrimSpace(name) == feature {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -40,4 +40,16 @@ func TestDaemonSet(t *testing.T) {
 	ds = DaemonSet("velero", WithFeatures([]string{"foo,bar,baz"}))
 	assert.Len(t, ds.Spec.Template.Spec.Containers[0].Args, 3)
 	assert.Equal(t, "--features=foo,bar,baz", ds.Spec.Template.Spec.Containers[0].Args[2])
+	assert.Equal(t, 2, len(ds.Spec.Template.Spec.Volumes))
+	assert.Nil(t, ds.Spec.Template.Spec.Containers[0].SecurityContext)
+
+	ds = DaemonSet("velero", WithFeatures([]string{"foo,EnableResticHostPathVolumes"}))
+	assert.Equal(t, 3, len(ds.Spec.Template.Spec.Volumes))
+	assert.Equal(t, "/", ds.Spec.Template.Spec.Volumes[2].HostPath.Path)
+	assert.Equal(t, "/host_root", ds.Spec.Template.Spec.Containers[0].VolumeMounts[2].MountPath)
+	assert.Nil(t, ds.Spec.Template.Spec.Containers[0].SecurityContext)
+
+	ds = DaemonSet("velero", WithFeatures([]string{"EnableResticBlockVolumes"}))
+	assert.Equal(t, 2, len(ds.Spec.Template.Spec.Volumes))
+	assert.True(t, *ds.Spec.Template.Spec.Containers[0].SecurityContext.Privileged)
 }
@@ -52,6 +52,9 @@ const (
 	volumeSnapshotAttemptTotal    = "volume_snapshot_attempt_total"
 	volumeSnapshotSuccessTotal    = "volume_snapshot_success_total"
 	volumeSnapshotFailureTotal    = "volume_snapshot_failure_total"
+	pluginCallDurationSeconds     = "plugin_call_duration_seconds"
+	pluginCallFailureTotal        = "plugin_call_failure_total"
+	pluginProcessRestartTotal     = "plugin_process_restart_total"
 
 	// Restic metrics
 	podVolumeBackupEnqueueTotal        = "pod_volume_backup_enqueue_count"
@@ -65,6 +68,10 @@ const (
 	pvbNameLabel         = "pod_volume_backup"
 	scheduleLabel        = "schedule"
 	backupNameLabel      = "backupName"
+	pluginKindLabel      = "kind"
+	pluginNameLabel      = "name"
+	pluginMethodLabel    = "method"
+	pluginCommandLabel   = "command"
 
 	secondsInMinute = 60.0
 )
@@ -250,6 +257,42 @@ func NewServerMetrics() *ServerMetrics {
 				},
 				[]string{scheduleLabel},
 			),
+			pluginCallDurationSeconds: prometheus.NewHistogramVec(
+				prometheus.HistogramOpts{
+					Namespace: metricNamespace,
+					Name:      pluginCallDurationSeconds,
+					Help:      "Time taken by calls to plugins, in seconds",
+					Buckets: []float64{
+						0.01,
+						0.1,
+						0.5,
+						1,
+						5,
+						toSeconds(30 * time.Second),
+						toSeconds(1 * time.Minute),
+						toSeconds(5 * time.Minute),
+						toSeconds(15 * time.Minute),
+						toSeconds(1 * time.Hour),
+					},
+				},
+				[]string{pluginKindLabel, pluginNameLabel, pluginMethodLabel},
+			),
+			pluginCallFailureTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      pluginCallFailureTotal,
+					Help:      "Total number of calls to plugins that returned an error",
+				},
+				[]string{pluginKindLabel, pluginNameLabel, pluginMethodLabel},
+			),
+			pluginProcessRestartTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      pluginProcessRestartTotal,
+					Help:      "Total number of restarts of plugin processes that exited",
+				},
+				[]string{pluginCommandLabel},
+			),
 		},
 	}
 }
@@ -554,3 +597,24 @@ func (m *ServerMetrics) RegisterVolumeSnapshotFailures(backupSchedule string, vo
 		c.WithLabelValues(backupSchedule).Add(float64(volumeSnapshotsFailed))
 	}
 }
+
+// ObservePluginCallLatency records the time, in seconds, taken by a call of method to the plugin of the given kind and name.
+func (m *ServerMetrics) ObservePluginCallLatency(kind, name, method string, seconds float64) {
+	if h, ok := m.metrics[pluginCallDurationSeconds].(*prometheus.HistogramVec); ok {
+		h.WithLabelValues(kind, name, method).Observe(seconds)
+	}
+}
+
+// RegisterPluginCallFailure records a failed call of method to the plugin of the given kind and name.
+func (m *ServerMetrics) RegisterPluginCallFailure(kind, name, method string) {
+	if c, ok := m.metrics[pluginCallFailureTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(kind, name, method).Inc()
+	}
+}
+
+// RegisterPluginProcessRestart records a restart of the plugin process running command.
+func (m *ServerMetrics) RegisterPluginProcessRestart(command string) {
+	if c, ok := m.metrics[pluginProcessRestartTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(command).Inc()
+	}
+}
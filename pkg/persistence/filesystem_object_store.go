@@ -0,0 +1,330 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+const (
+	// FilesystemObjectStoreProvider is the name of the built-in object store
+	// that stores objects in a directory of the velero server's filesystem.
+	FilesystemObjectStoreProvider = "velero.io/filesystem"
+
+	// filesystemPathConfigKey is the backup storage location config key of the
+	// directory that the filesystem object store's buckets are subdirectories of.
+	filesystemPathConfigKey = "path"
+
+	// filesystemPublicURLConfigKey is the backup storage location config key of
+	// the URL that the velero server's filesystem object store endpoint is
+	// reachable at. It's required to create signed URLs.
+	filesystemPublicURLConfigKey = "publicUrl"
+
+	// filesystemTempFilePrefix is the prefix of the temporary files objects are
+	// written to before they're moved into place.
+	filesystemTempFilePrefix = ".velero-upload-"
+
+	filesystemExpiresParam   = "expires"
+	filesystemSignatureParam = "signature"
+)
+
+// filesystemObjectStore is an implementation of the ObjectStore interface that
+// stores objects as files in a local directory, such as the mount point of an
+// NFS-backed persistent volume claim. Each bucket is a subdirectory of the
+// configured path.
+type filesystemObjectStore struct {
+	root       string
+	publicURL  string
+	signingKey []byte
+}
+
+// NewFilesystemObjectStore returns an ObjectStore that stores objects in a
+// directory of the local filesystem.
+func NewFilesystemObjectStore() velero.ObjectStore {
+	return &filesystemObjectStore{}
+}
+
+func (o *filesystemObjectStore) Init(config map[string]string) error {
+	root := config[filesystemPathConfigKey]
+	if root == "" {
+		return errors.Errorf("missing required config key %q", filesystemPathConfigKey)
+	}
+	if !filepath.IsAbs(root) {
+		return errors.Errorf("config key %q must be an absolute path", filesystemPathConfigKey)
+	}
+
+	info, err := os.Stat(root)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if !info.IsDir() {
+		return errors.Errorf("%s is not a directory", root)
+	}
+
+	o.root = filepath.Clean(root)
+	o.publicURL = strings.TrimSuffix(config[filesystemPublicURLConfigKey], "/")
+
+	if credentialsFile := config["credentialsFile"]; credentialsFile != "" {
+		key, err := ioutil.ReadFile(credentialsFile)
+		if err != nil {
+			return errors.Wrap(err, "error reading signing key from credentials file")
+		}
+		o.signingKey = key
+	}
+
+	return nil
+}
+
+func (o *filesystemObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	objectPath, err := filesystemObjectPath(o.root, bucket, key)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(objectPath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return errors.WithStack(err)
+	}
+
+	// write the object to a temporary file in the same directory and rename it
+	// into place, so that readers never see a partially written object.
+	file, err := ioutil.TempFile(dir, filesystemTempFilePrefix)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := io.Copy(file, body); err != nil {
+		file.Close()
+		return errors.Wrapf(err, "error writing object %s", key)
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return errors.WithStack(err)
+	}
+	if err := file.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := os.Chmod(file.Name(), 0644); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(os.Rename(file.Name(), objectPath))
+}
+
+// PutObjectStream writes the object the same way as PutObject, which never
+// leaves a partially written object behind when reading the body fails.
+func (o *filesystemObjectStore) PutObjectStream(bucket, key string, body io.Reader) error {
+	return o.PutObject(bucket, key, body)
+}
+
+func (o *filesystemObjectStore) ObjectExists(bucket, key string) (bool, error) {
+	objectPath, err := filesystemObjectPath(o.root, bucket, key)
+	if err != nil {
+		return false, err
+	}
+
+	info, err := os.Stat(objectPath)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	return !info.IsDir(), nil
+}
+
+func (o *filesystemObjectStore) GetObject(bucket, key string) (io.ReadCloser, error) {
+	objectPath, err := filesystemObjectPath(o.root, bucket, key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(objectPath)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return file, nil
+}
+
+func (o *filesystemObjectStore) ListCommonPrefixes(bucket, prefix, delimiter string) ([]string, error) {
+	keys, err := o.ListObjects(bucket, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var prefixes []string
+	for _, key := range keys {
+		afterPrefix := key[len(prefix):]
+
+		delimiterStart := strings.Index(afterPrefix, delimiter)
+		if delimiterStart == -1 {
+			continue
+		}
+
+		fullPrefix := prefix + afterPrefix[0:delimiterStart] + delimiter
+		if !seen[fullPrefix] {
+			seen[fullPrefix] = true
+			prefixes = append(prefixes, fullPrefix)
+		}
+	}
+
+	return prefixes, nil
+}
+
+func (o *filesystemObjectStore) ListObjects(bucket, prefix string) ([]string, error) {
+	bucketDir, err := filesystemBucketPath(o.root, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := os.Stat(bucketDir); err != nil {
+		return nil, errors.Wrapf(err, "bucket %s not found", bucket)
+	}
+
+	var keys []string
+	err = filepath.Walk(bucketDir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || strings.HasPrefix(info.Name(), filesystemTempFilePrefix) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(bucketDir, filePath)
+		if err != nil {
+			return err
+		}
+
+		key := filepath.ToSlash(rel)
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (o *filesystemObjectStore) DeleteObject(bucket, key string) error {
+	objectPath, err := filesystemObjectPath(o.root, bucket, key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(objectPath); err != nil && !os.IsNotExist(err) {
+		return errors.WithStack(err)
+	}
+
+	// remove the directories that are left empty, up to the bucket's directory,
+	// so that deleted backups don't leave a tree of empty directories behind.
+	bucketDir, _ := filesystemBucketPath(o.root, bucket)
+	for dir := filepath.Dir(objectPath); dir != bucketDir && strings.HasPrefix(dir, bucketDir); dir = filepath.Dir(dir) {
+		if err := os.Remove(dir); err != nil {
+			// the directory isn't empty, or is gone
+			break
+		}
+	}
+
+	return nil
+}
+
+func (o *filesystemObjectStore) CreateSignedURL(bucket, key string, ttl time.Duration) (string, error) {
+	if o.publicURL == "" {
+		return "", errors.Errorf("config key %q must be set to create signed URLs", filesystemPublicURLConfigKey)
+	}
+	if len(o.signingKey) == 0 {
+		return "", errors.New("backup storage location must have a credential to sign URLs with")
+	}
+	if _, err := filesystemObjectPath(o.root, bucket, key); err != nil {
+		return "", err
+	}
+
+	expires := strconv.FormatInt(time.Now().Add(ttl).Unix(), 10)
+
+	query := url.Values{}
+	query.Set(filesystemExpiresParam, expires)
+	query.Set(filesystemSignatureParam, signFilesystemURL(o.signingKey, bucket, key, expires))
+
+	return o.publicURL + "/" + url.PathEscape(bucket) + "/" + escapeObjectKey(key) + "?" + query.Encode(), nil
+}
+
+// filesystemBucketPath returns the directory of the bucket under root.
+func filesystemBucketPath(root, bucket string) (string, error) {
+	if bucket == "" || bucket == "." || bucket == ".." || strings.ContainsAny(bucket, `/\`) {
+		return "", errors.Errorf("invalid bucket name %q", bucket)
+	}
+
+	return filepath.Join(root, bucket), nil
+}
+
+// filesystemObjectPath returns the path of the object with the given key in
+// the bucket under root, making sure that it doesn't point outside of the bucket.
+func filesystemObjectPath(root, bucket, key string) (string, error) {
+	bucketDir, err := filesystemBucketPath(root, bucket)
+	if err != nil {
+		return "", err
+	}
+
+	objectPath := filepath.Join(bucketDir, filepath.FromSlash(key))
+	if key == "" || !strings.HasPrefix(objectPath, bucketDir+string(filepath.Separator)) || strings.HasPrefix(path.Base(key), filesystemTempFilePrefix) {
+		return "", errors.Errorf("invalid object key %q", key)
+	}
+
+	return objectPath, nil
+}
+
+// signFilesystemURL returns the hex-encoded HMAC-SHA256 signature of a signed
+// URL for the object with the given bucket and key that expires at the given
+// Unix time.
+func signFilesystemURL(signingKey []byte, bucket, key, expires string) string {
+	mac := hmac.New(sha256.New, signingKey)
+	mac.Write([]byte(bucket + "\n" + key + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// escapeObjectKey escapes each segment of the object key for use in a URL path.
+func escapeObjectKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i := range segments {
+		segments[i] = url.PathEscape(segments[i])
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,293 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func newFilesystemObjectStoreTestHarness(t *testing.T, config map[string]string) (velero.ObjectStore, string) {
+	root, err := ioutil.TempDir("", "velero-filesystem-object-store")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(root) })
+
+	require.NoError(t, os.Mkdir(filepath.Join(root, "bucket"), 0755))
+
+	if config == nil {
+		config = make(map[string]string)
+	}
+	config["path"] = root
+
+	objectStore := NewFilesystemObjectStore()
+	require.NoError(t, objectStore.Init(config))
+
+	return objectStore, root
+}
+
+func TestFilesystemObjectStoreInit(t *testing.T) {
+	root, err := ioutil.TempDir("", "velero-filesystem-object-store")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	tests := []struct {
+		name    string
+		config  map[string]string
+		wantErr bool
+	}{
+		{
+			name:    "missing path is an error",
+			config:  map[string]string{},
+			wantErr: true,
+		},
+		{
+			name:    "relative path is an error",
+			config:  map[string]string{"path": "backups"},
+			wantErr: true,
+		},
+		{
+			name:    "nonexistent path is an error",
+			config:  map[string]string{"path": filepath.Join(root, "missing")},
+			wantErr: true,
+		},
+		{
+			name:    "nonexistent credentials file is an error",
+			config:  map[string]string{"path": root, "credentialsFile": filepath.Join(root, "missing")},
+			wantErr: true,
+		},
+		{
+			name:   "existing directory succeeds",
+			config: map[string]string{"path": root},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := NewFilesystemObjectStore().Init(tc.config)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFilesystemObjectStoreObjects(t *testing.T) {
+	objectStore, root := newFilesystemObjectStoreTestHarness(t, nil)
+
+	for _, key := range []string{"backups/backup-1/backup-1.tar.gz", "backups/backup-1/velero-backup.json", "backups/backup-2/velero-backup.json", "restores/restore-1/restore-1-logs.gz"} {
+		require.NoError(t, objectStore.PutObject("bucket", key, strings.NewReader(key)))
+	}
+
+	exists, err := objectStore.ObjectExists("bucket", "backups/backup-1/velero-backup.json")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	exists, err = objectStore.ObjectExists("bucket", "backups/backup-3/velero-backup.json")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	exists, err = objectStore.ObjectExists("bucket", "backups/backup-1")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	rc, err := objectStore.GetObject("bucket", "backups/backup-2/velero-backup.json")
+	require.NoError(t, err)
+	contents, err := ioutil.ReadAll(rc)
+	require.NoError(t, err)
+	require.NoError(t, rc.Close())
+	assert.Equal(t, "backups/backup-2/velero-backup.json", string(contents))
+
+	keys, err := objectStore.ListObjects("bucket", "backups/backup-1/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backups/backup-1/backup-1.tar.gz", "backups/backup-1/velero-backup.json"}, keys)
+
+	prefixes, err := objectStore.ListCommonPrefixes("bucket", "", "/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backups/", "restores/"}, prefixes)
+
+	prefixes, err = objectStore.ListCommonPrefixes("bucket", "backups/", "/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backups/backup-1/", "backups/backup-2/"}, prefixes)
+
+	// overwriting an object replaces its contents
+	require.NoError(t, objectStore.PutObject("bucket", "backups/backup-2/velero-backup.json", strings.NewReader("updated")))
+	contents, err = ioutil.ReadFile(filepath.Join(root, "bucket", "backups", "backup-2", "velero-backup.json"))
+	require.NoError(t, err)
+	assert.Equal(t, "updated", string(contents))
+
+	// deleting the last object in a directory removes the directory
+	require.NoError(t, objectStore.DeleteObject("bucket", "restores/restore-1/restore-1-logs.gz"))
+	_, err = os.Stat(filepath.Join(root, "bucket", "restores"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(root, "bucket"))
+	assert.NoError(t, err)
+
+	// deleting a nonexistent object isn't an error
+	assert.NoError(t, objectStore.DeleteObject("bucket", "restores/restore-1/restore-1-logs.gz"))
+
+	_, err = objectStore.ListObjects("missing", "")
+	assert.Error(t, err)
+}
+
+func TestFilesystemObjectStorePutObjectStream(t *testing.T) {
+	objectStore, root := newFilesystemObjectStoreTestHarness(t, nil)
+	streamer, ok := objectStore.(velero.ObjectStreamer)
+	require.True(t, ok)
+
+	require.NoError(t, streamer.PutObjectStream("bucket", "backups/backup-1/backup-1.tar.gz", ioutil.NopCloser(strings.NewReader("contents"))))
+	contents, err := ioutil.ReadFile(filepath.Join(root, "bucket", "backups", "backup-1", "backup-1.tar.gz"))
+	require.NoError(t, err)
+	assert.Equal(t, "contents", string(contents))
+
+	// a failure reading the body leaves neither the object nor a temporary file behind
+	body := io.MultiReader(strings.NewReader("contents"), iotest.ErrReader(errors.New("read error")))
+	assert.Error(t, streamer.PutObjectStream("bucket", "backups/backup-2/backup-2.tar.gz", body))
+	files, err := ioutil.ReadDir(filepath.Join(root, "bucket", "backups", "backup-2"))
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestFilesystemObjectStoreInvalidKeys(t *testing.T) {
+	objectStore, root := newFilesystemObjectStoreTestHarness(t, nil)
+
+	tests := []struct {
+		name   string
+		bucket string
+		key    string
+	}{
+		{name: "empty key", bucket: "bucket", key: ""},
+		{name: "key outside of bucket", bucket: "bucket", key: "../outside"},
+		{name: "key outside of root", bucket: "bucket", key: "../../outside"},
+		{name: "temporary file key", bucket: "bucket", key: "backups/.velero-upload-123"},
+		{name: "bucket outside of root", bucket: "..", key: "outside"},
+		{name: "bucket with a slash", bucket: "bucket/backups", key: "outside"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Error(t, objectStore.PutObject(tc.bucket, tc.key, strings.NewReader("data")))
+
+			_, err := objectStore.GetObject(tc.bucket, tc.key)
+			assert.Error(t, err)
+
+			assert.Error(t, objectStore.DeleteObject(tc.bucket, tc.key))
+		})
+	}
+
+	_, err := os.Stat(filepath.Join(filepath.Dir(root), "outside"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestFilesystemObjectStoreSignedURLs(t *testing.T) {
+	credentialsFile, err := ioutil.TempFile("", "velero-filesystem-credentials")
+	require.NoError(t, err)
+	defer os.Remove(credentialsFile.Name())
+	_, err = credentialsFile.WriteString("signing-key")
+	require.NoError(t, err)
+	require.NoError(t, credentialsFile.Close())
+
+	objectStore, root := newFilesystemObjectStoreTestHarness(t, map[string]string{
+		"credentialsFile": credentialsFile.Name(),
+	})
+	require.NoError(t, objectStore.PutObject("bucket", "backups/backup-1/backup-1-logs.gz", strings.NewReader("logs")))
+
+	location := builder.ForBackupStorageLocation("velero", "default").
+		Provider("filesystem").
+		Bucket("bucket").
+		Config(map[string]string{"path": root}).
+		Credential(&corev1api.SecretKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: "filesystem"}, Key: "signing-key"}).
+		Result()
+	otherLocation := builder.ForBackupStorageLocation("velero", "other").
+		Provider("velero.io/aws").
+		Bucket("bucket").
+		Result()
+
+	handler := NewFilesystemURLHandler(
+		velerotest.NewFakeControllerRuntimeClient(t, location, otherLocation),
+		"velero",
+		velerotest.NewFakeCredentialsFileStore(credentialsFile.Name(), nil),
+		velerotest.NewLogger(),
+	)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// signed URLs require a public URL
+	_, err = objectStore.CreateSignedURL("bucket", "backups/backup-1/backup-1-logs.gz", time.Minute)
+	assert.Error(t, err)
+
+	require.NoError(t, objectStore.Init(map[string]string{
+		"path":            root,
+		"credentialsFile": credentialsFile.Name(),
+		"publicUrl":       server.URL + "/",
+	}))
+
+	get := func(url string) (int, string) {
+		res, err := http.Get(url)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		body, err := ioutil.ReadAll(res.Body)
+		require.NoError(t, err)
+		return res.StatusCode, string(body)
+	}
+
+	url, err := objectStore.CreateSignedURL("bucket", "backups/backup-1/backup-1-logs.gz", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(url, server.URL+"/bucket/backups/backup-1/backup-1-logs.gz?"))
+
+	status, body := get(url)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "logs", body)
+
+	// a tampered signature is rejected
+	status, _ = get(strings.Replace(url, "signature=", "signature=0", 1))
+	assert.Equal(t, http.StatusForbidden, status)
+
+	// a URL for a different object is rejected
+	status, _ = get(strings.Replace(url, "backup-1-logs.gz", "backup-2-logs.gz", 1))
+	assert.Equal(t, http.StatusForbidden, status)
+
+	// an expired URL is rejected
+	url, err = objectStore.CreateSignedURL("bucket", "backups/backup-1/backup-1-logs.gz", -time.Minute)
+	require.NoError(t, err)
+	status, _ = get(url)
+	assert.Equal(t, http.StatusForbidden, status)
+
+	// a validly signed URL for a missing object is not found
+	url, err = objectStore.CreateSignedURL("bucket", "backups/backup-2/backup-2-logs.gz", time.Minute)
+	require.NoError(t, err)
+	status, _ = get(url)
+	assert.Equal(t, http.StatusNotFound, status)
+}
@@ -0,0 +1,157 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"context"
+	"crypto/hmac"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// filesystemURLHandler serves the objects of filesystem object stores that
+// signed URLs created by the stores point to.
+type filesystemURLHandler struct {
+	client          kbclient.Client
+	namespace       string
+	credentialStore credentials.FileStore
+	log             logrus.FieldLogger
+}
+
+// NewFilesystemURLHandler returns an http.Handler that serves the objects of the
+// namespace's backup storage locations that use the filesystem object store, for
+// requests with a valid signed URL. The URL path is /<bucket>/<key>.
+func NewFilesystemURLHandler(client kbclient.Client, namespace string, credentialStore credentials.FileStore, log logrus.FieldLogger) http.Handler {
+	return &filesystemURLHandler{
+		client:          client,
+		namespace:       namespace,
+		credentialStore: credentialStore,
+		log:             log,
+	}
+}
+
+func (h *filesystemURLHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	if len(parts) != 2 {
+		http.NotFound(w, r)
+		return
+	}
+	bucket, key := parts[0], parts[1]
+	log := h.log.WithField("bucket", bucket).WithField("key", key)
+
+	expires := r.URL.Query().Get(filesystemExpiresParam)
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().Unix() > expiresAt {
+		http.Error(w, "signed URL is invalid or expired", http.StatusForbidden)
+		return
+	}
+
+	root, err := h.authorize(bucket, key, expires, r.URL.Query().Get(filesystemSignatureParam))
+	if err != nil {
+		log.WithError(err).Info("Rejecting request for object of filesystem object store")
+		http.Error(w, "signed URL is invalid or expired", http.StatusForbidden)
+		return
+	}
+
+	objectPath, err := filesystemObjectPath(root, bucket, key)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	file, err := os.Open(objectPath)
+	if os.IsNotExist(err) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error opening object of filesystem object store")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil || info.IsDir() {
+		http.NotFound(w, r)
+		return
+	}
+
+	http.ServeContent(w, r, path.Base(key), info.ModTime(), file)
+}
+
+// authorize returns the directory of the filesystem backup storage location whose
+// bucket the object is in and whose credential the URL was signed with.
+func (h *filesystemURLHandler) authorize(bucket, key, expires, signature string) (string, error) {
+	if signature == "" {
+		return "", errors.New("signed URL has no signature")
+	}
+
+	locations := new(velerov1api.BackupStorageLocationList)
+	if err := h.client.List(context.Background(), locations, &kbclient.ListOptions{Namespace: h.namespace}); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	for i := range locations.Items {
+		location := &locations.Items[i]
+
+		provider := location.Spec.Provider
+		if !strings.Contains(provider, "/") {
+			provider = "velero.io/" + provider
+		}
+		if provider != FilesystemObjectStoreProvider || location.Spec.ObjectStorage == nil || location.Spec.Credential == nil {
+			continue
+		}
+		if strings.Trim(location.Spec.ObjectStorage.Bucket, "/") != bucket {
+			continue
+		}
+
+		credentialsFile, err := h.credentialStore.Path(location.Spec.Credential)
+		if err != nil {
+			h.log.WithError(err).WithField("backupStorageLocation", location.Name).Warn("Unable to get credential of backup storage location")
+			continue
+		}
+		signingKey, err := ioutil.ReadFile(credentialsFile)
+		if err != nil {
+			h.log.WithError(errors.WithStack(err)).WithField("backupStorageLocation", location.Name).Warn("Unable to read credential of backup storage location")
+			continue
+		}
+
+		if hmac.Equal([]byte(signFilesystemURL(signingKey, bucket, key, expires)), []byte(signature)) {
+			return location.Spec.Config[filesystemPathConfigKey], nil
+		}
+	}
+
+	return "", errors.New("signed URL doesn't match any filesystem backup storage location")
+}
@@ -19,12 +19,15 @@ package mocks
 import (
 	io "io"
 
+	time "time"
+
 	mock "github.com/stretchr/testify/mock"
 
 	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1beta1"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	persistence "github.com/vmware-tanzu/velero/pkg/persistence"
+	velero "github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	volume "github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -68,6 +71,20 @@ func (_m *BackupStore) DeleteBackup(name string) error {
 	return r0
 }
 
+// DeleteBackupContents provides a mock function with given fields: name
+func (_m *BackupStore) DeleteBackupContents(name string) error {
+	ret := _m.Called(name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteRestore provides a mock function with given fields: name
 func (_m *BackupStore) DeleteRestore(name string) error {
 	ret := _m.Called(name)
@@ -105,6 +122,52 @@ func (_m *BackupStore) GetBackupContents(name string) (io.ReadCloser, error) {
 	return r0, r1
 }
 
+// GetBackupFile provides a mock function with given fields: name, file
+func (_m *BackupStore) GetBackupFile(name string, file string) (io.ReadCloser, error) {
+	ret := _m.Called(name, file)
+
+	var r0 io.ReadCloser
+	if rf, ok := ret.Get(0).(func(string, string) io.ReadCloser); ok {
+		r0 = rf(name, file)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(name, file)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBackupLog provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupLog(name string) (io.ReadCloser, error) {
+	ret := _m.Called(name)
+
+	var r0 io.ReadCloser
+	if rf, ok := ret.Get(0).(func(string) io.ReadCloser); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBackupMetadata provides a mock function with given fields: name
 func (_m *BackupStore) GetBackupMetadata(name string) (*v1.Backup, error) {
 	ret := _m.Called(name)
@@ -128,6 +191,29 @@ func (_m *BackupStore) GetBackupMetadata(name string) (*v1.Backup, error) {
 	return r0, r1
 }
 
+// GetBackupObjectLock provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupObjectLock(name string) (*velero.ObjectLockedError, error) {
+	ret := _m.Called(name)
+
+	var r0 *velero.ObjectLockedError
+	if rf, ok := ret.Get(0).(func(string) *velero.ObjectLockedError); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*velero.ObjectLockedError)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBackupVolumeSnapshots provides a mock function with given fields: name
 func (_m *BackupStore) GetBackupVolumeSnapshots(name string) ([]*volume.Snapshot, error) {
 	ret := _m.Called(name)
@@ -209,6 +295,29 @@ func (_m *BackupStore) IsValid() error {
 	return r0
 }
 
+// ListBackupFiles provides a mock function with given fields: name
+func (_m *BackupStore) ListBackupFiles(name string) ([]string, error) {
+	ret := _m.Called(name)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListBackups provides a mock function with given fields:
 func (_m *BackupStore) ListBackups() ([]string, error) {
 	ret := _m.Called()
@@ -246,6 +355,76 @@ func (_m *BackupStore) PutBackup(info persistence.BackupInfo) error {
 	return r0
 }
 
+// PutBackupContents provides a mock function with given fields: name, contents
+func (_m *BackupStore) PutBackupContents(name string, contents io.Reader) error {
+	ret := _m.Called(name, contents)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(name, contents)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutBackupFile provides a mock function with given fields: name, file, contents
+func (_m *BackupStore) PutBackupFile(name string, file string, contents io.Reader) error {
+	ret := _m.Called(name, file, contents)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, io.Reader) error); ok {
+		r0 = rf(name, file, contents)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutBackupLog provides a mock function with given fields: name, log, retainUntil
+func (_m *BackupStore) PutBackupLog(name string, log io.Reader, retainUntil time.Time) error {
+	ret := _m.Called(name, log, retainUntil)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader, time.Time) error); ok {
+		r0 = rf(name, log, retainUntil)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutBackupMetadata provides a mock function with given fields: name, metadata, retainUntil
+func (_m *BackupStore) PutBackupMetadata(name string, metadata io.Reader, retainUntil time.Time) error {
+	ret := _m.Called(name, metadata, retainUntil)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader, time.Time) error); ok {
+		r0 = rf(name, metadata, retainUntil)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutBackupVolumeSnapshots provides a mock function with given fields: name, volumeSnapshots, retainUntil
+func (_m *BackupStore) PutBackupVolumeSnapshots(name string, volumeSnapshots io.Reader, retainUntil time.Time) error {
+	ret := _m.Called(name, volumeSnapshots, retainUntil)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader, time.Time) error); ok {
+		r0 = rf(name, volumeSnapshots, retainUntil)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutRestoreLog provides a mock function with given fields: backup, restore, log
 func (_m *BackupStore) PutRestoreLog(backup string, restore string, log io.Reader) error {
 	ret := _m.Called(backup, restore, log)
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"io"
 	"io/ioutil"
+	"sort"
 	"strings"
 	"time"
 
@@ -28,6 +29,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/clock"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
@@ -47,6 +49,11 @@ type BackupInfo struct {
 	BackupResourceList,
 	CSIVolumeSnapshots,
 	CSIVolumeSnapshotContents io.Reader
+
+	// RetainUntil is the time until which the backup's objects are locked, if
+	// the backup store locks objects for a retention period. If it's zero,
+	// they're locked for the retention period starting when they're put.
+	RetainUntil time.Time
 }
 
 // BackupStore defines operations for creating, retrieving, and deleting
@@ -57,16 +64,48 @@ type BackupStore interface {
 	ListBackups() ([]string, error)
 
 	PutBackup(info BackupInfo) error
+	// PutBackupContents streams the backup's contents to object storage as they're
+	// read. It returns velero.ErrObjectStreamingNotSupported, without reading the
+	// contents, if the object store doesn't support streaming uploads, or if the
+	// backup store locks objects.
+	PutBackupContents(name string, contents io.Reader) error
+	// DeleteBackupContents deletes the backup's contents, e.g. when they were streamed
+	// to object storage but the rest of the backup couldn't be put.
+	DeleteBackupContents(name string) error
+	// PutBackupMetadata and PutBackupVolumeSnapshots replace the backup's metadata
+	// and list of volume snapshots, to record changes to the backup after it was put.
+	// retainUntil is the time until which they're locked, as for BackupInfo.
+	PutBackupMetadata(name string, metadata io.Reader, retainUntil time.Time) error
+	PutBackupVolumeSnapshots(name string, volumeSnapshots io.Reader, retainUntil time.Time) error
+	// PutBackupLog replaces the backup's log, e.g. to append what was logged
+	// after the backup was put.
+	PutBackupLog(name string, log io.Reader, retainUntil time.Time) error
 	GetBackupMetadata(name string) (*velerov1api.Backup, error)
 	GetBackupVolumeSnapshots(name string) ([]*volume.Snapshot, error)
 	GetPodVolumeBackups(name string) ([]*velerov1api.PodVolumeBackup, error)
 	GetBackupContents(name string) (io.ReadCloser, error)
+	GetBackupLog(name string) (io.ReadCloser, error)
 	GetCSIVolumeSnapshots(name string) ([]*snapshotv1beta1api.VolumeSnapshot, error)
 	GetCSIVolumeSnapshotContents(name string) ([]*snapshotv1beta1api.VolumeSnapshotContent, error)
 
+	// ListBackupFiles returns the names of all of the files in the backup's
+	// directory in object storage.
+	ListBackupFiles(name string) ([]string, error)
+	GetBackupFile(name, file string) (io.ReadCloser, error)
+	PutBackupFile(name, file string, contents io.Reader) error
+
 	// BackupExists checks if the backup metadata file exists in object storage.
 	BackupExists(bucket, backupName string) (bool, error)
 
+	// GetBackupObjectLock returns how the backup's metadata, which is locked
+	// like the rest of the backup's objects, is locked in object storage now,
+	// or nil if it isn't locked. It returns velero.ErrObjectRetentionNotSupported
+	// if the object store can't check it.
+	GetBackupObjectLock(name string) (*velero.ObjectLockedError, error)
+
+	// DeleteBackup deletes all of the backup's files from object storage. If
+	// the only files that can't be deleted are locked, a *velero.ObjectLockedError
+	// describing the longest lock is returned.
 	DeleteBackup(name string) error
 
 	PutRestoreLog(backup, restore string, log io.Reader) error
@@ -83,6 +122,8 @@ type objectBackupStore struct {
 	objectStore velero.ObjectStore
 	bucket      string
 	layout      *ObjectStoreLayout
+	objectLock  *velerov1api.ObjectLockSettings
+	clock       clock.Clock
 	logger      logrus.FieldLogger
 }
 
@@ -171,6 +212,8 @@ func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocatio
 		objectStore: objectStore,
 		bucket:      bucket,
 		layout:      NewObjectStoreLayout(prefix),
+		objectLock:  location.Spec.ObjectLock,
+		clock:       clock.RealClock{},
 		logger:      log,
 	}, nil
 }
@@ -225,7 +268,7 @@ func (s *objectBackupStore) ListBackups() ([]string, error) {
 }
 
 func (s *objectBackupStore) PutBackup(info BackupInfo) error {
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupLogKey(info.Name), info.Log); err != nil {
+	if err := s.seekAndPutBackupObject(s.layout.getBackupLogKey(info.Name), info.Log, info.RetainUntil); err != nil {
 		// Uploading the log file is best-effort; if it fails, we log the error but it doesn't impact the
 		// backup's status.
 		s.logger.WithError(err).WithField("backup", info.Name).Error("Error uploading log file")
@@ -238,12 +281,12 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		return nil
 	}
 
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupMetadataKey(info.Name), info.Metadata); err != nil {
+	if err := s.seekAndPutBackupObject(s.layout.getBackupMetadataKey(info.Name), info.Metadata, info.RetainUntil); err != nil {
 		// failure to upload metadata file is a hard-stop
 		return err
 	}
 
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupContentsKey(info.Name), info.Contents); err != nil {
+	if err := s.seekAndPutBackupObject(s.layout.getBackupContentsKey(info.Name), info.Contents, info.RetainUntil); err != nil {
 		deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupMetadataKey(info.Name))
 		return kerrors.NewAggregate([]error{err, deleteErr})
 	}
@@ -259,7 +302,7 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 	}
 
 	for key, reader := range backupObjs {
-		if err := seekAndPutObject(s.objectStore, s.bucket, key, reader); err != nil {
+		if err := s.seekAndPutBackupObject(key, reader, info.RetainUntil); err != nil {
 			errs := []error{err}
 
 			// attempt to clean up the backup contents and metadata if we fail to upload and of the extra files.
@@ -275,6 +318,36 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 	return nil
 }
 
+func (s *objectBackupStore) PutBackupContents(name string, contents io.Reader) error {
+	// streamed objects can't be locked
+	if s.objectLock != nil {
+		return velero.ErrObjectStreamingNotSupported
+	}
+
+	streamer, ok := s.objectStore.(velero.ObjectStreamer)
+	if !ok {
+		return velero.ErrObjectStreamingNotSupported
+	}
+
+	return streamer.PutObjectStream(s.bucket, s.layout.getBackupContentsKey(name), contents)
+}
+
+func (s *objectBackupStore) DeleteBackupContents(name string) error {
+	return s.objectStore.DeleteObject(s.bucket, s.layout.getBackupContentsKey(name))
+}
+
+func (s *objectBackupStore) PutBackupMetadata(name string, metadata io.Reader, retainUntil time.Time) error {
+	return s.seekAndPutBackupObject(s.layout.getBackupMetadataKey(name), metadata, retainUntil)
+}
+
+func (s *objectBackupStore) PutBackupVolumeSnapshots(name string, volumeSnapshots io.Reader, retainUntil time.Time) error {
+	return s.seekAndPutBackupObject(s.layout.getBackupVolumeSnapshotsKey(name), volumeSnapshots, retainUntil)
+}
+
+func (s *objectBackupStore) PutBackupLog(name string, log io.Reader, retainUntil time.Time) error {
+	return s.seekAndPutBackupObject(s.layout.getBackupLogKey(name), log, retainUntil)
+}
+
 func (s *objectBackupStore) GetBackupMetadata(name string) (*velerov1api.Backup, error) {
 	metadataKey := s.layout.getBackupMetadataKey(name)
 
@@ -415,29 +488,206 @@ func (s *objectBackupStore) GetBackupContents(name string) (io.ReadCloser, error
 	return s.objectStore.GetObject(s.bucket, s.layout.getBackupContentsKey(name))
 }
 
+func (s *objectBackupStore) GetBackupLog(name string) (io.ReadCloser, error) {
+	return s.objectStore.GetObject(s.bucket, s.layout.getBackupLogKey(name))
+}
+
 func (s *objectBackupStore) BackupExists(bucket, backupName string) (bool, error) {
 	return s.objectStore.ObjectExists(bucket, s.layout.getBackupMetadataKey(backupName))
 }
 
+func (s *objectBackupStore) GetBackupObjectLock(name string) (*velero.ObjectLockedError, error) {
+	retainer, ok := s.objectStore.(velero.ObjectRetainer)
+	if !ok {
+		return nil, velero.ErrObjectRetentionNotSupported
+	}
+
+	err := retainer.CheckObjectLock(s.bucket, s.layout.getBackupMetadataKey(name))
+	if err == nil || err == velero.ErrObjectRetentionNotSupported {
+		return nil, err
+	}
+
+	var locked *velero.ObjectLockedError
+	if errors.As(err, &locked) {
+		return locked, nil
+	}
+	return nil, errors.Wrapf(err, "error checking lock of backup %s", name)
+}
+
+func (s *objectBackupStore) ListBackupFiles(name string) ([]string, error) {
+	dir := s.layout.getBackupDir(name)
+	keys, err := s.objectStore.ListObjects(s.bucket, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, key := range keys {
+		file := strings.TrimPrefix(key, dir)
+		// some providers return the pseudo-folder of the directory as an object
+		if file == "" || strings.HasSuffix(file, "/") {
+			continue
+		}
+		files = append(files, file)
+	}
+	sort.Strings(files)
+
+	return files, nil
+}
+
+func (s *objectBackupStore) GetBackupFile(name, file string) (io.ReadCloser, error) {
+	return s.objectStore.GetObject(s.bucket, s.layout.getBackupDir(name)+file)
+}
+
+func (s *objectBackupStore) PutBackupFile(name, file string, contents io.Reader) error {
+	return s.putBackupObject(s.layout.getBackupDir(name)+file, contents, time.Time{})
+}
+
+// putBackupObject puts an object of a backup, locking it if the backup store
+// locks objects. If retainUntil isn't zero, the object is retained until then
+// rather than for the retention period starting now.
+func (s *objectBackupStore) putBackupObject(key string, body io.Reader, retainUntil time.Time) error {
+	retention := s.objectRetention(retainUntil)
+	if retention == nil {
+		return s.objectStore.PutObject(s.bucket, key, body)
+	}
+
+	retainer, ok := s.objectStore.(velero.ObjectRetainer)
+	if !ok {
+		return errors.Wrapf(velero.ErrObjectRetentionNotSupported, "unable to lock object %s", key)
+	}
+	if err := retainer.PutObjectWithRetention(s.bucket, key, body, *retention); err != nil {
+		if err == velero.ErrObjectRetentionNotSupported {
+			return errors.Wrapf(err, "unable to lock object %s", key)
+		}
+		return err
+	}
+	return nil
+}
+
+// objectRetention returns the retention of the backup objects put now, or nil
+// if the backup store doesn't lock objects.
+func (s *objectBackupStore) objectRetention(retainUntil time.Time) *velero.ObjectRetention {
+	if s.objectLock == nil {
+		return nil
+	}
+
+	retention := &velero.ObjectRetention{LegalHold: s.objectLock.LegalHold}
+	if s.objectLock.RetentionPeriod != nil && s.objectLock.RetentionPeriod.Duration > 0 {
+		retention.Mode = velero.ObjectRetentionModeGovernance
+		if s.objectLock.Mode == velerov1api.ObjectLockModeCompliance {
+			retention.Mode = velero.ObjectRetentionModeCompliance
+		}
+		retention.RetainUntil = retainUntil
+		if retention.RetainUntil.IsZero() {
+			retention.RetainUntil = s.clock.Now().Add(s.objectLock.RetentionPeriod.Duration)
+		}
+	}
+	return retention
+}
+
+// CopyBackup copies all of the files of the backup in the source backup store to
+// the destination backup store. The backup's metadata is copied last, so that the
+// backup isn't synced from the destination before all of its files are there. If
+// copying fails, the files copied so far are deleted from the destination.
+func CopyBackup(name string, source, destination BackupStore) error {
+	files, err := source.ListBackupFiles(name)
+	if err != nil {
+		return errors.Wrap(err, "error listing backup files")
+	}
+
+	hasMetadata := false
+	var ordered []string
+	for _, file := range files {
+		if file == backupMetadataFile {
+			hasMetadata = true
+			continue
+		}
+		ordered = append(ordered, file)
+	}
+	if !hasMetadata {
+		return errors.Errorf("backup %s has no metadata file in object storage", name)
+	}
+	ordered = append(ordered, backupMetadataFile)
+
+	for _, file := range ordered {
+		if err := copyBackupFile(name, file, source, destination); err != nil {
+			deleteErr := destination.DeleteBackup(name)
+			return kerrors.NewAggregate([]error{err, deleteErr})
+		}
+	}
+
+	return nil
+}
+
+func copyBackupFile(name, file string, source, destination BackupStore) error {
+	contents, err := source.GetBackupFile(name, file)
+	if err != nil {
+		return errors.Wrapf(err, "error getting backup file %s", file)
+	}
+	defer contents.Close()
+
+	return errors.Wrapf(destination.PutBackupFile(name, file, contents), "error putting backup file %s", file)
+}
+
 func (s *objectBackupStore) DeleteBackup(name string) error {
 	objects, err := s.objectStore.ListObjects(s.bucket, s.layout.getBackupDir(name))
 	if err != nil {
 		return err
 	}
 
-	var errs []error
+	var (
+		errs   []error
+		locked *velero.ObjectLockedError
+	)
 	for _, key := range objects {
 		s.logger.WithFields(logrus.Fields{
 			"key": key,
 		}).Debug("Trying to delete object")
 		if err := s.objectStore.DeleteObject(s.bucket, key); err != nil {
 			errs = append(errs, err)
+
+			var objectLocked *velero.ObjectLockedError
+			if errors.As(err, &objectLocked) {
+				locked = LongestObjectLock(locked, objectLocked)
+			}
 		}
 	}
 
+	// if objects can't be deleted only because they're locked, report how
+	// long the backup is locked for rather than each object
+	if locked != nil && allObjectLockedErrors(errs) {
+		return locked
+	}
+
 	return errors.WithStack(kerrors.NewAggregate(errs))
 }
 
+// LongestObjectLock returns whichever of two object locks ends later. A legal
+// hold has no known end, so it's longer than any retention. a may be nil.
+func LongestObjectLock(a, b *velero.ObjectLockedError) *velero.ObjectLockedError {
+	switch {
+	case a == nil:
+		return b
+	case a.LegalHold:
+		return a
+	case b.LegalHold || b.RetainUntil.After(a.RetainUntil):
+		return b
+	default:
+		return a
+	}
+}
+
+func allObjectLockedErrors(errs []error) bool {
+	for _, err := range errs {
+		var locked *velero.ObjectLockedError
+		if !errors.As(err, &locked) {
+			return false
+		}
+	}
+	return true
+}
+
 func (s *objectBackupStore) DeleteRestore(name string) error {
 	objects, err := s.objectStore.ListObjects(s.bucket, s.layout.getRestoreDir(name))
 	if err != nil {
@@ -494,7 +744,7 @@ func seekToBeginning(r io.Reader) error {
 	return err
 }
 
-func seekAndPutObject(objectStore velero.ObjectStore, bucket, key string, file io.Reader) error {
+func (s *objectBackupStore) seekAndPutBackupObject(key string, file io.Reader, retainUntil time.Time) error {
 	if file == nil {
 		return nil
 	}
@@ -503,5 +753,5 @@ func seekAndPutObject(objectStore velero.ObjectStore, bucket, key string, file i
 		return errors.WithStack(err)
 	}
 
-	return objectStore.PutObject(bucket, key, file)
+	return s.putBackupObject(key, file, retainUntil)
 }
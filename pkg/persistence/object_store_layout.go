@@ -38,6 +38,7 @@ func NewObjectStoreLayout(prefix string) *ObjectStoreLayout {
 		"backups":  path.Join(prefix, "backups") + "/",
 		"restores": path.Join(prefix, "restores") + "/",
 		"restic":   path.Join(prefix, "restic") + "/",
+		"kopia":    path.Join(prefix, "kopia") + "/",
 		"metadata": path.Join(prefix, "metadata") + "/",
 		"plugins":  path.Join(prefix, "plugins") + "/",
 	}
@@ -55,11 +56,22 @@ func (l *ObjectStoreLayout) GetResticDir() string {
 	return l.subdirs["restic"]
 }
 
+// GetKopiaDir returns the full prefix representing the Kopia
+// directory within an object storage bucket containing a backup
+// store.
+func (l *ObjectStoreLayout) GetKopiaDir() string {
+	return l.subdirs["kopia"]
+}
+
 func (l *ObjectStoreLayout) isValidSubdir(name string) bool {
 	_, ok := l.subdirs[name]
 	return ok
 }
 
+// backupMetadataFile is the name of the file in a backup's directory that
+// contains the backup's metadata.
+const backupMetadataFile = "velero-backup.json"
+
 func (l *ObjectStoreLayout) getBackupDir(backup string) string {
 	return path.Join(l.subdirs["backups"], backup) + "/"
 }
@@ -69,7 +81,7 @@ func (l *ObjectStoreLayout) getRestoreDir(restore string) string {
 }
 
 func (l *ObjectStoreLayout) getBackupMetadataKey(backup string) string {
-	return path.Join(l.subdirs["backups"], backup, "velero-backup.json")
+	return path.Join(l.subdirs["backups"], backup, backupMetadataFile)
 }
 
 func (l *ObjectStoreLayout) getBackupContentsKey(backup string) string {
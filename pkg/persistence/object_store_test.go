@@ -27,11 +27,14 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -339,6 +342,263 @@ func TestPutBackup(t *testing.T) {
 	}
 }
 
+func TestPutBackupContents(t *testing.T) {
+	// the in-memory object store doesn't support streaming uploads
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+	err := harness.PutBackupContents("test-backup", newStringReadSeeker("contents"))
+	assert.Equal(t, velero.ErrObjectStreamingNotSupported, err)
+	assert.Empty(t, harness.objectStore.Data["test-bucket"])
+
+	objectStore := new(providermocks.ObjectStore)
+	objectStore.Test(t)
+	defer objectStore.AssertExpectations(t)
+
+	contents := newStringReadSeeker("contents")
+	objectStore.On("PutObjectStream", "test-bucket", "prefix/backups/test-backup/test-backup.tar.gz", contents).Return(nil)
+
+	backupStore := &objectBackupStore{
+		objectStore: objectStore,
+		bucket:      "test-bucket",
+		layout:      NewObjectStoreLayout("prefix"),
+		logger:      velerotest.NewLogger(),
+	}
+	assert.NoError(t, backupStore.PutBackupContents("test-backup", contents))
+}
+
+func TestDeleteBackupContents(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "prefix")
+	harness.objectStore.PutObject("test-bucket", "prefix/backups/test-backup/test-backup.tar.gz", newStringReadSeeker("contents"))
+	harness.objectStore.PutObject("test-bucket", "prefix/backups/test-backup/test-backup-logs.gz", newStringReadSeeker("logs"))
+
+	require.NoError(t, harness.DeleteBackupContents("test-backup"))
+
+	assert.Equal(t, BucketData{
+		"prefix/backups/test-backup/test-backup-logs.gz": []byte("logs"),
+	}, harness.objectStore.Data["test-bucket"])
+}
+
+func TestPutBackupMetadataVolumeSnapshotsAndLog(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "prefix")
+	harness.objectStore.PutObject("test-bucket", "prefix/backups/test-backup/velero-backup.json", newStringReadSeeker("metadata"))
+	harness.objectStore.PutObject("test-bucket", "prefix/backups/test-backup/test-backup.tar.gz", newStringReadSeeker("contents"))
+
+	require.NoError(t, harness.PutBackupMetadata("test-backup", newStringReadSeeker("updated metadata"), time.Time{}))
+	require.NoError(t, harness.PutBackupVolumeSnapshots("test-backup", newStringReadSeeker("volume snapshots"), time.Time{}))
+	require.NoError(t, harness.PutBackupLog("test-backup", newStringReadSeeker("updated logs"), time.Time{}))
+
+	assert.Equal(t, BucketData{
+		"prefix/backups/test-backup/velero-backup.json":                  []byte("updated metadata"),
+		"prefix/backups/test-backup/test-backup.tar.gz":                  []byte("contents"),
+		"prefix/backups/test-backup/test-backup-volumesnapshots.json.gz": []byte("volume snapshots"),
+		"prefix/backups/test-backup/test-backup-logs.gz":                 []byte("updated logs"),
+	}, harness.objectStore.Data["test-bucket"])
+
+	rc, err := harness.GetBackupLog("test-backup")
+	require.NoError(t, err)
+	defer rc.Close()
+	logs, err := ioutil.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "updated logs", string(logs))
+}
+
+func TestPutBackupWithObjectLock(t *testing.T) {
+	now := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name              string
+		objectLock        *velerov1api.ObjectLockSettings
+		expectedRetention velero.ObjectRetention
+	}{
+		{
+			name:       "retention defaults to governance mode",
+			objectLock: &velerov1api.ObjectLockSettings{RetentionPeriod: &metav1.Duration{Duration: time.Hour}},
+			expectedRetention: velero.ObjectRetention{
+				Mode:        velero.ObjectRetentionModeGovernance,
+				RetainUntil: now.Add(time.Hour),
+			},
+		},
+		{
+			name: "compliance mode retention and legal hold",
+			objectLock: &velerov1api.ObjectLockSettings{
+				Mode:            velerov1api.ObjectLockModeCompliance,
+				RetentionPeriod: &metav1.Duration{Duration: time.Hour},
+				LegalHold:       true,
+			},
+			expectedRetention: velero.ObjectRetention{
+				Mode:        velero.ObjectRetentionModeCompliance,
+				RetainUntil: now.Add(time.Hour),
+				LegalHold:   true,
+			},
+		},
+		{
+			name:              "legal hold without retention",
+			objectLock:        &velerov1api.ObjectLockSettings{LegalHold: true},
+			expectedRetention: velero.ObjectRetention{LegalHold: true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			objectStore := new(providermocks.ObjectStore)
+			objectStore.Test(t)
+			defer objectStore.AssertExpectations(t)
+
+			backupStore := &objectBackupStore{
+				objectStore: objectStore,
+				bucket:      "test-bucket",
+				layout:      NewObjectStoreLayout(""),
+				objectLock:  test.objectLock,
+				clock:       clock.NewFakeClock(now),
+				logger:      velerotest.NewLogger(),
+			}
+
+			for _, key := range []string{
+				"backups/backup-1/backup-1-logs.gz",
+				"backups/backup-1/velero-backup.json",
+				"backups/backup-1/backup-1.tar.gz",
+			} {
+				objectStore.On("PutObjectWithRetention", "test-bucket", key, mock.Anything, test.expectedRetention).Return(nil)
+			}
+
+			require.NoError(t, backupStore.PutBackup(BackupInfo{
+				Name:     "backup-1",
+				Metadata: newStringReadSeeker("metadata"),
+				Contents: newStringReadSeeker("contents"),
+				Log:      newStringReadSeeker("log"),
+			}))
+
+			// copied backup files are locked too
+			objectStore.On("PutObjectWithRetention", "test-bucket", "backups/backup-1/file", mock.Anything, test.expectedRetention).Return(nil)
+			require.NoError(t, backupStore.PutBackupFile("backup-1", "file", newStringReadSeeker("file")))
+
+			// streamed contents can't be locked
+			assert.Equal(t, velero.ErrObjectStreamingNotSupported, backupStore.PutBackupContents("backup-1", newStringReadSeeker("contents")))
+		})
+	}
+}
+
+func TestPutBackupWithObjectLockUntilGivenTime(t *testing.T) {
+	now := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	// the time was recorded on the backup a minute before its objects are put
+	retainUntil := now.Add(-time.Minute).Add(time.Hour)
+
+	objectStore := new(providermocks.ObjectStore)
+	objectStore.Test(t)
+	defer objectStore.AssertExpectations(t)
+
+	backupStore := &objectBackupStore{
+		objectStore: objectStore,
+		bucket:      "test-bucket",
+		layout:      NewObjectStoreLayout(""),
+		objectLock:  &velerov1api.ObjectLockSettings{RetentionPeriod: &metav1.Duration{Duration: time.Hour}},
+		clock:       clock.NewFakeClock(now),
+		logger:      velerotest.NewLogger(),
+	}
+
+	expectedRetention := velero.ObjectRetention{Mode: velero.ObjectRetentionModeGovernance, RetainUntil: retainUntil}
+	for _, key := range []string{
+		"backups/backup-1/velero-backup.json",
+		"backups/backup-1/backup-1.tar.gz",
+		"backups/backup-1/backup-1-volumesnapshots.json.gz",
+	} {
+		objectStore.On("PutObjectWithRetention", "test-bucket", key, mock.Anything, expectedRetention).Return(nil)
+	}
+
+	require.NoError(t, backupStore.PutBackup(BackupInfo{
+		Name:        "backup-1",
+		Metadata:    newStringReadSeeker("metadata"),
+		Contents:    newStringReadSeeker("contents"),
+		RetainUntil: retainUntil,
+	}))
+
+	// replaced objects are locked until the same time
+	require.NoError(t, backupStore.PutBackupMetadata("backup-1", newStringReadSeeker("updated metadata"), retainUntil))
+	require.NoError(t, backupStore.PutBackupVolumeSnapshots("backup-1", newStringReadSeeker("volume snapshots"), retainUntil))
+}
+
+func TestPutBackupWithObjectLockNotSupported(t *testing.T) {
+	objectStore := new(providermocks.ObjectStore)
+	objectStore.Test(t)
+	defer objectStore.AssertExpectations(t)
+
+	backupStore := &objectBackupStore{
+		objectStore: objectStore,
+		bucket:      "test-bucket",
+		layout:      NewObjectStoreLayout(""),
+		objectLock:  &velerov1api.ObjectLockSettings{LegalHold: true},
+		clock:       clock.NewFakeClock(time.Now()),
+		logger:      velerotest.NewLogger(),
+	}
+
+	objectStore.On("PutObjectWithRetention", "test-bucket", "backups/backup-1/file", mock.Anything, mock.Anything).Return(velero.ErrObjectRetentionNotSupported)
+	err := backupStore.PutBackupFile("backup-1", "file", newStringReadSeeker("file"))
+	assert.True(t, errors.Is(err, velero.ErrObjectRetentionNotSupported))
+	assert.EqualError(t, err, "unable to lock object backups/backup-1/file: object retention is not supported by this object store")
+
+	// the in-memory object store can't lock objects
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+	harness.objectLock = &velerov1api.ObjectLockSettings{LegalHold: true}
+	assert.Error(t, harness.PutBackupFile("backup-1", "file", newStringReadSeeker("file")))
+	assert.Empty(t, harness.objectStore.Data["test-bucket"])
+}
+
+func TestGetBackupObjectLock(t *testing.T) {
+	tests := []struct {
+		name       string
+		checkErr   error
+		wantLocked *velero.ObjectLockedError
+		wantErr    string
+	}{
+		{
+			name: "unlocked backup returns nil",
+		},
+		{
+			name:       "locked backup returns the lock",
+			checkErr:   &velero.ObjectLockedError{Key: "backups/backup-1/velero-backup.json", LegalHold: true},
+			wantLocked: &velero.ObjectLockedError{Key: "backups/backup-1/velero-backup.json", LegalHold: true},
+		},
+		{
+			name:     "unsupported object store returns ErrObjectRetentionNotSupported",
+			checkErr: velero.ErrObjectRetentionNotSupported,
+			wantErr:  velero.ErrObjectRetentionNotSupported.Error(),
+		},
+		{
+			name:     "other errors are returned",
+			checkErr: errors.New("access denied"),
+			wantErr:  "error checking lock of backup backup-1: access denied",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			objectStore := new(providermocks.ObjectStore)
+			objectStore.Test(t)
+			defer objectStore.AssertExpectations(t)
+
+			backupStore := &objectBackupStore{
+				objectStore: objectStore,
+				bucket:      "test-bucket",
+				layout:      NewObjectStoreLayout(""),
+				logger:      velerotest.NewLogger(),
+			}
+
+			objectStore.On("CheckObjectLock", "test-bucket", "backups/backup-1/velero-backup.json").Return(tc.checkErr)
+			locked, err := backupStore.GetBackupObjectLock("backup-1")
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.wantLocked, locked)
+		})
+	}
+
+	// the in-memory object store can't check locks
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+	_, err := harness.GetBackupObjectLock("backup-1")
+	assert.Equal(t, velero.ErrObjectRetentionNotSupported, err)
+}
+
 func TestGetBackupMetadata(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -440,6 +700,80 @@ func TestGetBackupContents(t *testing.T) {
 	assert.Equal(t, "foo", string(data))
 }
 
+// recordingObjectStore is an in-memory object store that records the keys of the
+// objects put in it, in order.
+type recordingObjectStore struct {
+	*inMemoryObjectStore
+	puts []string
+}
+
+func (o *recordingObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	o.puts = append(o.puts, key)
+	return o.inMemoryObjectStore.PutObject(bucket, key, body)
+}
+
+func TestCopyBackup(t *testing.T) {
+	source := newObjectBackupStoreTestHarness("source-bucket", "")
+	for key, data := range map[string]string{
+		"backups/test-backup/":                    "",
+		"backups/test-backup/velero-backup.json":  "metadata",
+		"backups/test-backup/test-backup.tar.gz":  "contents",
+		"backups/test-backup/test-backup-logs.gz": "log",
+		"backups/other-backup/velero-backup.json": "other metadata",
+	} {
+		require.NoError(t, source.objectStore.PutObject(source.bucket, key, newStringReadSeeker(data)))
+	}
+
+	files, err := source.ListBackupFiles("test-backup")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"test-backup-logs.gz", "test-backup.tar.gz", "velero-backup.json"}, files)
+
+	destinationStore := &recordingObjectStore{inMemoryObjectStore: newInMemoryObjectStore("destination-bucket")}
+	destination := &objectBackupStore{
+		objectStore: destinationStore,
+		bucket:      "destination-bucket",
+		layout:      NewObjectStoreLayout("prefix"),
+		logger:      velerotest.NewLogger(),
+	}
+
+	require.NoError(t, CopyBackup("test-backup", source, destination))
+	assert.Equal(t, []string{
+		"prefix/backups/test-backup/test-backup-logs.gz",
+		"prefix/backups/test-backup/test-backup.tar.gz",
+		// the metadata is copied last
+		"prefix/backups/test-backup/velero-backup.json",
+	}, destinationStore.puts)
+	assert.Equal(t, []byte("contents"), destinationStore.Data["destination-bucket"]["prefix/backups/test-backup/test-backup.tar.gz"])
+
+	// a backup without metadata can't be copied
+	assert.Error(t, CopyBackup("missing-backup", source, destination))
+
+	// a failed copy is cleaned up
+	destinationStore.puts = nil
+	brokenSource := &objectBackupStore{
+		objectStore: &failingGetObjectStore{inMemoryObjectStore: source.objectStore, key: "backups/test-backup/test-backup.tar.gz"},
+		bucket:      source.bucket,
+		layout:      NewObjectStoreLayout(""),
+		logger:      velerotest.NewLogger(),
+	}
+	assert.Error(t, CopyBackup("test-backup", brokenSource, destination))
+	assert.Equal(t, []string{"prefix/backups/test-backup/test-backup-logs.gz"}, destinationStore.puts)
+	assert.Empty(t, destinationStore.Data["destination-bucket"])
+}
+
+// failingGetObjectStore is an in-memory object store that fails to get one of its objects.
+type failingGetObjectStore struct {
+	*inMemoryObjectStore
+	key string
+}
+
+func (o *failingGetObjectStore) GetObject(bucket, key string) (io.ReadCloser, error) {
+	if key == o.key {
+		return nil, errors.New("get object error")
+	}
+	return o.inMemoryObjectStore.GetObject(bucket, key)
+}
+
 func TestDeleteBackup(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -460,6 +794,29 @@ func TestDeleteBackup(t *testing.T) {
 			deleteErrors: []error{errors.New("a"), nil, errors.New("c")},
 			expectedErr:  "[a, c]",
 		},
+		{
+			name: "locked objects, the longest lock is returned",
+			deleteErrors: []error{
+				&velero.ObjectLockedError{Key: "a", RetainUntil: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)},
+				&velero.ObjectLockedError{Key: "b", RetainUntil: time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)},
+				&velero.ObjectLockedError{Key: "c", RetainUntil: time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC)},
+			},
+			expectedErr: "object b is locked until 2031-01-01T00:00:00Z",
+		},
+		{
+			name: "locked objects under a legal hold",
+			deleteErrors: []error{
+				&velero.ObjectLockedError{Key: "a", RetainUntil: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)},
+				&velero.ObjectLockedError{Key: "b", LegalHold: true},
+				&velero.ObjectLockedError{Key: "c", RetainUntil: time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)},
+			},
+			expectedErr: "object b is locked by a legal hold",
+		},
+		{
+			name:         "locked objects and other delete errors",
+			deleteErrors: []error{&velero.ObjectLockedError{Key: "a", LegalHold: true}, errors.New("b")},
+			expectedErr:  "[object a is locked by a legal hold, b]",
+		},
 	}
 
 	for _, test := range tests {
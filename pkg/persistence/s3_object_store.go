@@ -0,0 +1,412 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/tls"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+const (
+	// S3ObjectStoreProvider is the name of the built-in object store for AWS S3
+	// and S3-compatible object storage.
+	S3ObjectStoreProvider = "velero.io/s3"
+
+	s3RegionConfigKey                = "region"
+	s3URLConfigKey                   = "s3Url"
+	s3PublicURLConfigKey             = "publicUrl"
+	s3ForcePathStyleConfigKey        = "s3ForcePathStyle"
+	s3InsecureSkipTLSVerifyConfigKey = "insecureSkipTLSVerify"
+	s3ProfileConfigKey               = "profile"
+	s3ServerSideEncryptionConfigKey  = "serverSideEncryption"
+	s3KMSKeyIDConfigKey              = "kmsKeyId"
+
+	// s3DefaultRegion is the region used for S3-compatible object storage that
+	// isn't given a region.
+	s3DefaultRegion = "us-east-1"
+)
+
+// s3ObjectStore is an implementation of the ObjectStore interface for AWS S3
+// and S3-compatible object storage, such as MinIO. Objects are uploaded with
+// multipart uploads when they're larger than a single part. Objects can be
+// locked with S3 Object Lock, if it's enabled for the bucket.
+type s3ObjectStore struct {
+	s3                   *s3.S3
+	preSignS3            *s3.S3
+	uploader             *s3manager.Uploader
+	serverSideEncryption string
+	kmsKeyID             string
+}
+
+// NewS3ObjectStore returns an ObjectStore for AWS S3 and S3-compatible object storage.
+func NewS3ObjectStore() velero.ObjectStore {
+	return &s3ObjectStore{}
+}
+
+func (o *s3ObjectStore) Init(config map[string]string) error {
+	var (
+		region          = config[s3RegionConfigKey]
+		s3URL           = config[s3URLConfigKey]
+		publicURL       = config[s3PublicURLConfigKey]
+		credentialsFile = config["credentialsFile"]
+		caCert          = config["caCert"]
+	)
+
+	forcePathStyle, err := parseBoolConfig(config, s3ForcePathStyleConfigKey)
+	if err != nil {
+		return err
+	}
+	insecureSkipTLSVerify, err := parseBoolConfig(config, s3InsecureSkipTLSVerifyConfigKey)
+	if err != nil {
+		return err
+	}
+
+	if config[s3KMSKeyIDConfigKey] != "" && config[s3ServerSideEncryptionConfigKey] != "" {
+		return errors.Errorf("config keys %q and %q can't both be set", s3KMSKeyIDConfigKey, s3ServerSideEncryptionConfigKey)
+	}
+	o.kmsKeyID = config[s3KMSKeyIDConfigKey]
+	o.serverSideEncryption = config[s3ServerSideEncryptionConfigKey]
+
+	// S3-compatible object storage doesn't have regions, so don't try to look
+	// up the bucket's region if it has a URL.
+	if region == "" && s3URL != "" {
+		region = s3DefaultRegion
+	}
+	if region == "" {
+		region, err = getS3BucketRegion(config["bucket"])
+		if err != nil {
+			return err
+		}
+	}
+
+	sess, err := newS3Session(region, s3URL, forcePathStyle, insecureSkipTLSVerify, caCert, credentialsFile, config[s3ProfileConfigKey])
+	if err != nil {
+		return err
+	}
+
+	o.s3 = s3.New(sess)
+	o.uploader = s3manager.NewUploader(sess)
+
+	o.preSignS3 = o.s3
+	if publicURL != "" {
+		publicSess, err := newS3Session(region, publicURL, forcePathStyle, insecureSkipTLSVerify, caCert, credentialsFile, config[s3ProfileConfigKey])
+		if err != nil {
+			return err
+		}
+		o.preSignS3 = s3.New(publicSess)
+	}
+
+	return nil
+}
+
+func newS3Session(region, url string, forcePathStyle, insecureSkipTLSVerify bool, caCert, credentialsFile, profile string) (*session.Session, error) {
+	config := aws.NewConfig().
+		WithRegion(region).
+		WithS3ForcePathStyle(forcePathStyle)
+
+	if url != "" {
+		config = config.WithEndpoint(url)
+	}
+
+	if insecureSkipTLSVerify {
+		config = config.WithHTTPClient(&http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+			},
+		})
+	}
+
+	opts := session.Options{
+		Config:            *config,
+		Profile:           profile,
+		SharedConfigState: session.SharedConfigEnable,
+	}
+	if credentialsFile != "" {
+		opts.SharedConfigFiles = []string{credentialsFile}
+	}
+	if caCert != "" {
+		opts.CustomCABundle = strings.NewReader(caCert)
+	}
+
+	sess, err := session.NewSessionWithOptions(opts)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return sess, nil
+}
+
+// getS3BucketRegion returns the AWS region that the bucket is in.
+func getS3BucketRegion(bucket string) (string, error) {
+	sess, err := session.NewSession()
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	region, err := s3manager.GetBucketRegion(context.Background(), sess, bucket, s3DefaultRegion)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to determine region of bucket %s, set the %q config key", bucket, s3RegionConfigKey)
+	}
+
+	return region, nil
+}
+
+func parseBoolConfig(config map[string]string, key string) (bool, error) {
+	val := config[key]
+	if val == "" {
+		return false, nil
+	}
+
+	parsed, err := strconv.ParseBool(val)
+	if err != nil {
+		return false, errors.Wrapf(err, "invalid value for config key %q", key)
+	}
+
+	return parsed, nil
+}
+
+func (o *s3ObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	return o.upload(o.uploadInput(bucket, key, body))
+}
+
+// PutObjectWithRetention uploads the object the same way as PutObject, locking
+// it with S3 Object Lock.
+func (o *s3ObjectStore) PutObjectWithRetention(bucket, key string, body io.Reader, retention velero.ObjectRetention) error {
+	input := o.uploadInput(bucket, key, body)
+
+	switch retention.Mode {
+	case "":
+	case velero.ObjectRetentionModeGovernance:
+		input.ObjectLockMode = aws.String(s3.ObjectLockModeGovernance)
+	case velero.ObjectRetentionModeCompliance:
+		input.ObjectLockMode = aws.String(s3.ObjectLockModeCompliance)
+	default:
+		return errors.Errorf("unsupported object retention mode %q", retention.Mode)
+	}
+	if !retention.RetainUntil.IsZero() {
+		input.ObjectLockRetainUntilDate = aws.Time(retention.RetainUntil)
+	}
+	if retention.LegalHold {
+		input.ObjectLockLegalHoldStatus = aws.String(s3.ObjectLockLegalHoldStatusOn)
+	}
+
+	// S3 requires the MD5 of the data of objects uploaded with object lock
+	// parameters
+	return o.upload(input, s3manager.WithUploaderRequestOptions(func(r *request.Request) {
+		r.Handlers.Build.PushBack(setS3ContentMD5)
+	}))
+}
+
+func (o *s3ObjectStore) uploadInput(bucket, key string, body io.Reader) *s3manager.UploadInput {
+	input := &s3manager.UploadInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   body,
+	}
+
+	switch {
+	case o.kmsKeyID != "":
+		input.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+		input.SSEKMSKeyId = aws.String(o.kmsKeyID)
+	case o.serverSideEncryption != "":
+		input.ServerSideEncryption = aws.String(o.serverSideEncryption)
+	}
+
+	return input
+}
+
+func (o *s3ObjectStore) upload(input *s3manager.UploadInput, options ...func(*s3manager.Uploader)) error {
+	_, err := o.uploader.Upload(input, options...)
+	return errors.Wrapf(err, "error putting object %s", aws.StringValue(input.Key))
+}
+
+// setS3ContentMD5 sets the Content-MD5 header of requests that upload data.
+func setS3ContentMD5(r *request.Request) {
+	if r.Operation.Name != "PutObject" && r.Operation.Name != "UploadPart" {
+		return
+	}
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, r.Body); err != nil {
+		r.Error = errors.Wrap(err, "error computing MD5 of request body")
+		return
+	}
+	if _, err := r.Body.Seek(0, io.SeekStart); err != nil {
+		r.Error = errors.Wrap(err, "error computing MD5 of request body")
+		return
+	}
+
+	r.HTTPRequest.Header.Set("Content-MD5", base64.StdEncoding.EncodeToString(hash.Sum(nil)))
+}
+
+// PutObjectStream uploads the object the same way as PutObject. The uploader
+// reads the body a part at a time, so memory use is bounded by the part size
+// and the number of concurrent part uploads, and it aborts the multipart
+// upload if reading the body fails.
+func (o *s3ObjectStore) PutObjectStream(bucket, key string, body io.Reader) error {
+	return o.PutObject(bucket, key, body)
+}
+
+func (o *s3ObjectStore) ObjectExists(bucket, key string) (bool, error) {
+	input := &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	if _, err := o.s3.HeadObject(input); err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "NotFound" {
+			return false, nil
+		}
+		return false, errors.WithStack(err)
+	}
+
+	return true, nil
+}
+
+func (o *s3ObjectStore) GetObject(bucket, key string) (io.ReadCloser, error) {
+	input := &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	output, err := o.s3.GetObject(input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting object %s", key)
+	}
+
+	return output.Body, nil
+}
+
+func (o *s3ObjectStore) ListCommonPrefixes(bucket, prefix, delimiter string) ([]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket:    aws.String(bucket),
+		Prefix:    aws.String(prefix),
+		Delimiter: aws.String(delimiter),
+	}
+
+	var ret []string
+	err := o.s3.ListObjectsV2Pages(input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, prefix := range page.CommonPrefixes {
+			ret = append(ret, *prefix.Prefix)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ret, nil
+}
+
+func (o *s3ObjectStore) ListObjects(bucket, prefix string) ([]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	}
+
+	var ret []string
+	err := o.s3.ListObjectsV2Pages(input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, obj := range page.Contents {
+			ret = append(ret, *obj.Key)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// ensure that returned objects are in a consistent order so that the deletion logic deletes the objects before
+	// the pseudo-folder prefix object for s3 providers (such as Quobyte) that return the pseudo-folder as an object.
+	// See https://github.com/vmware-tanzu/velero/pull/999
+	sort.Sort(sort.Reverse(sort.StringSlice(ret)))
+
+	return ret, nil
+}
+
+// DeleteObject deletes the object, unless it's locked. Deleting a locked object
+// from a bucket with S3 Object Lock enabled only hides it behind a delete
+// marker, so locked objects are left in place, and an error describing the
+// lock is returned instead.
+func (o *s3ObjectStore) DeleteObject(bucket, key string) error {
+	if err := o.CheckObjectLock(bucket, key); err != nil {
+		return err
+	}
+
+	input := &s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	_, err := o.s3.DeleteObject(input)
+	return errors.Wrapf(err, "error deleting object %s", key)
+}
+
+// CheckObjectLock returns a *velero.ObjectLockedError if the object exists and
+// is locked.
+func (o *s3ObjectStore) CheckObjectLock(bucket, key string) error {
+	output, err := o.s3.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "NotFound" {
+			return nil
+		}
+		return errors.Wrapf(err, "error getting object %s", key)
+	}
+
+	locked := &velero.ObjectLockedError{
+		Key:       key,
+		LegalHold: aws.StringValue(output.ObjectLockLegalHoldStatus) == s3.ObjectLockLegalHoldStatusOn,
+	}
+	if until := aws.TimeValue(output.ObjectLockRetainUntilDate); until.After(time.Now()) {
+		locked.RetainUntil = until
+	}
+	if locked.LegalHold || !locked.RetainUntil.IsZero() {
+		return locked
+	}
+
+	return nil
+}
+
+func (o *s3ObjectStore) CreateSignedURL(bucket, key string, ttl time.Duration) (string, error) {
+	req, _ := o.preSignS3.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	url, err := req.Presign(ttl)
+	return url, errors.WithStack(err)
+}
@@ -0,0 +1,323 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+type s3ObjectStoreGetter struct{}
+
+func (g *s3ObjectStoreGetter) GetObjectStore(provider string) (velero.ObjectStore, error) {
+	return NewS3ObjectStore(), nil
+}
+
+func newS3ObjectStoreTestHarness(t *testing.T, server *velerotest.FakeS3Server, config map[string]string) velero.ObjectStore {
+	credentialsFile, err := ioutil.TempFile("", "velero-s3-credentials")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.Remove(credentialsFile.Name()) })
+	_, err = credentialsFile.WriteString(server.CredentialsFile())
+	require.NoError(t, err)
+	require.NoError(t, credentialsFile.Close())
+
+	fullConfig := map[string]string{
+		"s3Url":            server.URL,
+		"s3ForcePathStyle": "true",
+		"credentialsFile":  credentialsFile.Name(),
+	}
+	for k, v := range config {
+		fullConfig[k] = v
+	}
+
+	objectStore := NewS3ObjectStore()
+	require.NoError(t, objectStore.Init(fullConfig))
+
+	return objectStore
+}
+
+func TestS3ObjectStoreInit(t *testing.T) {
+	server := velerotest.NewFakeS3Server("bucket")
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		config  map[string]string
+		wantErr bool
+	}{
+		{
+			name:    "invalid s3ForcePathStyle is an error",
+			config:  map[string]string{"s3Url": server.URL, "s3ForcePathStyle": "maybe"},
+			wantErr: true,
+		},
+		{
+			name:    "kmsKeyId and serverSideEncryption together are an error",
+			config:  map[string]string{"s3Url": server.URL, "kmsKeyId": "key", "serverSideEncryption": "AES256"},
+			wantErr: true,
+		},
+		{
+			name:   "s3Url without region succeeds",
+			config: map[string]string{"s3Url": server.URL, "s3ForcePathStyle": "true"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := NewS3ObjectStore().Init(tc.config)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestS3ObjectStoreObjects(t *testing.T) {
+	server := velerotest.NewFakeS3Server("bucket")
+	defer server.Close()
+	server.MaxKeys = 2
+
+	objectStore := newS3ObjectStoreTestHarness(t, server, nil)
+
+	for _, key := range []string{"backups/backup-1/velero-backup.json", "backups/backup-2/velero-backup.json", "backups/backup-3/velero-backup.json", "restores/restore-1/restore-1-logs.gz"} {
+		require.NoError(t, objectStore.PutObject("bucket", key, strings.NewReader(key)))
+	}
+	assert.Equal(t, 0, server.CompletedMultipartUploads())
+
+	// objects larger than a single part are uploaded with a multipart upload
+	large := bytes.Repeat([]byte("a"), 6*1024*1024)
+	require.NoError(t, objectStore.PutObject("bucket", "backups/backup-1/backup-1.tar.gz", bytes.NewReader(large)))
+	assert.Equal(t, 1, server.CompletedMultipartUploads())
+	assert.Equal(t, large, server.Objects("bucket")["backups/backup-1/backup-1.tar.gz"])
+
+	exists, err := objectStore.ObjectExists("bucket", "backups/backup-1/velero-backup.json")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	exists, err = objectStore.ObjectExists("bucket", "backups/backup-4/velero-backup.json")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	rc, err := objectStore.GetObject("bucket", "backups/backup-2/velero-backup.json")
+	require.NoError(t, err)
+	contents, err := ioutil.ReadAll(rc)
+	require.NoError(t, err)
+	require.NoError(t, rc.Close())
+	assert.Equal(t, "backups/backup-2/velero-backup.json", string(contents))
+
+	_, err = objectStore.GetObject("bucket", "backups/backup-4/velero-backup.json")
+	assert.Error(t, err)
+
+	keys, err := objectStore.ListObjects("bucket", "backups/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backups/backup-3/velero-backup.json", "backups/backup-2/velero-backup.json", "backups/backup-1/velero-backup.json", "backups/backup-1/backup-1.tar.gz"}, keys)
+
+	prefixes, err := objectStore.ListCommonPrefixes("bucket", "backups/", "/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backups/backup-1/", "backups/backup-2/", "backups/backup-3/"}, prefixes)
+
+	require.NoError(t, objectStore.DeleteObject("bucket", "restores/restore-1/restore-1-logs.gz"))
+	_, ok := server.Objects("bucket")["restores/restore-1/restore-1-logs.gz"]
+	assert.False(t, ok)
+
+	_, err = objectStore.ListObjects("missing", "")
+	assert.Error(t, err)
+}
+
+func TestS3ObjectStorePutObjectStream(t *testing.T) {
+	server := velerotest.NewFakeS3Server("bucket")
+	defer server.Close()
+
+	objectStore := newS3ObjectStoreTestHarness(t, server, nil)
+	streamer, ok := objectStore.(velero.ObjectStreamer)
+	require.True(t, ok)
+
+	large := bytes.Repeat([]byte("a"), 6*1024*1024)
+	require.NoError(t, streamer.PutObjectStream("bucket", "backups/backup-1/backup-1.tar.gz", ioutil.NopCloser(bytes.NewReader(large))))
+	assert.Equal(t, 1, server.CompletedMultipartUploads())
+	assert.Equal(t, large, server.Objects("bucket")["backups/backup-1/backup-1.tar.gz"])
+
+	// a failure reading the body aborts the upload
+	body := io.MultiReader(bytes.NewReader(large), iotest.ErrReader(errors.New("read error")))
+	assert.Error(t, streamer.PutObjectStream("bucket", "backups/backup-2/backup-2.tar.gz", body))
+	assert.Equal(t, 1, server.CompletedMultipartUploads())
+	_, ok = server.Objects("bucket")["backups/backup-2/backup-2.tar.gz"]
+	assert.False(t, ok)
+}
+
+func TestS3ObjectStorePutObjectWithRetention(t *testing.T) {
+	server := velerotest.NewFakeS3Server("bucket")
+	defer server.Close()
+
+	objectStore := newS3ObjectStoreTestHarness(t, server, nil)
+	retainer, ok := objectStore.(velero.ObjectRetainer)
+	require.True(t, ok)
+
+	retainUntil := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	retention := velero.ObjectRetention{Mode: velero.ObjectRetentionModeCompliance, RetainUntil: retainUntil}
+	require.NoError(t, retainer.PutObjectWithRetention("bucket", "backups/backup-1/velero-backup.json", strings.NewReader("metadata"), retention))
+	assert.Equal(t, []byte("metadata"), server.Objects("bucket")["backups/backup-1/velero-backup.json"])
+	assert.Equal(t, velerotest.FakeS3ObjectLock{Mode: "COMPLIANCE", RetainUntil: retainUntil}, server.ObjectLock("bucket", "backups/backup-1/velero-backup.json"))
+
+	// large objects are locked when their multipart upload completes
+	large := bytes.Repeat([]byte("a"), 6*1024*1024)
+	require.NoError(t, retainer.PutObjectWithRetention("bucket", "backups/backup-1/backup-1.tar.gz", bytes.NewReader(large), velero.ObjectRetention{LegalHold: true}))
+	assert.Equal(t, 1, server.CompletedMultipartUploads())
+	assert.Equal(t, large, server.Objects("bucket")["backups/backup-1/backup-1.tar.gz"])
+	assert.Equal(t, velerotest.FakeS3ObjectLock{LegalHold: true}, server.ObjectLock("bucket", "backups/backup-1/backup-1.tar.gz"))
+
+	// locked objects can't be overwritten
+	assert.Error(t, objectStore.PutObject("bucket", "backups/backup-1/velero-backup.json", strings.NewReader("other metadata")))
+
+	assert.EqualError(t, retainer.PutObjectWithRetention("bucket", "key", strings.NewReader("data"), velero.ObjectRetention{Mode: "Forever"}), `unsupported object retention mode "Forever"`)
+}
+
+func TestS3ObjectStoreDeleteLockedObject(t *testing.T) {
+	server := velerotest.NewFakeS3Server("bucket")
+	defer server.Close()
+
+	objectStore := newS3ObjectStoreTestHarness(t, server, nil)
+
+	retainUntil := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	server.PutObject("bucket", "retained", []byte("data"))
+	server.SetObjectLock("bucket", "retained", velerotest.FakeS3ObjectLock{Mode: "GOVERNANCE", RetainUntil: retainUntil})
+	server.PutObject("bucket", "held", []byte("data"))
+	server.SetObjectLock("bucket", "held", velerotest.FakeS3ObjectLock{LegalHold: true})
+	server.PutObject("bucket", "expired", []byte("data"))
+	server.SetObjectLock("bucket", "expired", velerotest.FakeS3ObjectLock{Mode: "GOVERNANCE", RetainUntil: time.Now().Add(-time.Hour)})
+
+	err := objectStore.DeleteObject("bucket", "retained")
+	locked, ok := err.(*velero.ObjectLockedError)
+	require.True(t, ok, "unexpected error %v", err)
+	assert.Equal(t, "retained", locked.Key)
+	assert.True(t, retainUntil.Equal(locked.RetainUntil))
+	assert.False(t, locked.LegalHold)
+
+	err = objectStore.DeleteObject("bucket", "held")
+	locked, ok = err.(*velero.ObjectLockedError)
+	require.True(t, ok, "unexpected error %v", err)
+	assert.True(t, locked.LegalHold)
+
+	require.NoError(t, objectStore.DeleteObject("bucket", "expired"))
+	require.NoError(t, objectStore.DeleteObject("bucket", "missing"))
+	objects := server.Objects("bucket")
+	assert.Len(t, objects, 2)
+	assert.Contains(t, objects, "held")
+	assert.Contains(t, objects, "retained")
+}
+
+func TestS3ObjectStoreCreateSignedURL(t *testing.T) {
+	server := velerotest.NewFakeS3Server("bucket")
+	defer server.Close()
+	server.PutObject("bucket", "backups/backup-1/backup-1-logs.gz", []byte("logs"))
+
+	objectStore := newS3ObjectStoreTestHarness(t, server, nil)
+
+	url, err := objectStore.CreateSignedURL("bucket", "backups/backup-1/backup-1-logs.gz", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(url, server.URL+"/bucket/backups/backup-1/backup-1-logs.gz?"))
+	assert.Contains(t, url, "X-Amz-Signature=")
+
+	res, err := http.Get(url)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "logs", string(body))
+
+	// signed URLs use the public URL when it's set
+	objectStore = newS3ObjectStoreTestHarness(t, server, map[string]string{"publicUrl": "https://s3.example.com"})
+	url, err = objectStore.CreateSignedURL("bucket", "backups/backup-1/backup-1-logs.gz", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(url, "https://s3.example.com/bucket/backups/backup-1/backup-1-logs.gz?"))
+}
+
+func TestS3ObjectBackupStore(t *testing.T) {
+	server := velerotest.NewFakeS3Server("bucket")
+	defer server.Close()
+
+	credentialsFile, err := ioutil.TempFile("", "velero-s3-credentials")
+	require.NoError(t, err)
+	defer os.Remove(credentialsFile.Name())
+	_, err = credentialsFile.WriteString(server.CredentialsFile())
+	require.NoError(t, err)
+	require.NoError(t, credentialsFile.Close())
+
+	location := builder.ForBackupStorageLocation("velero", "default").
+		Provider(S3ObjectStoreProvider).
+		Bucket("bucket").
+		Prefix("velero").
+		Config(map[string]string{"s3Url": server.URL, "s3ForcePathStyle": "true"}).
+		Credential(&corev1api.SecretKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: "s3"}, Key: "cloud"}).
+		Result()
+
+	backupStore, err := NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore(credentialsFile.Name(), nil)).Get(location, &s3ObjectStoreGetter{}, velerotest.NewLogger())
+	require.NoError(t, err)
+
+	require.NoError(t, backupStore.IsValid())
+
+	require.NoError(t, backupStore.PutBackup(BackupInfo{
+		Name:     "backup-1",
+		Metadata: strings.NewReader(`{"apiVersion": "velero.io/v1", "kind": "Backup", "metadata": {"namespace": "velero", "name": "backup-1"}}`),
+		Contents: strings.NewReader("contents"),
+		Log:      strings.NewReader("log"),
+	}))
+
+	backups, err := backupStore.ListBackups()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backup-1"}, backups)
+
+	metadata, err := backupStore.GetBackupMetadata("backup-1")
+	require.NoError(t, err)
+	assert.Equal(t, "backup-1", metadata.Name)
+
+	url, err := backupStore.GetDownloadURL(velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupContents, Name: "backup-1"})
+	require.NoError(t, err)
+	res, err := http.Get(url)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "contents", string(body))
+
+	require.NoError(t, backupStore.DeleteBackup("backup-1"))
+	backups, err = backupStore.ListBackups()
+	require.NoError(t, err)
+	assert.Empty(t, backups)
+
+	// a store with unknown top-level directories is invalid
+	server.PutObject("bucket", "velero/unknown/object", []byte("data"))
+	assert.Error(t, backupStore.IsValid())
+}
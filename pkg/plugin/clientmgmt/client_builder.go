@@ -18,6 +18,7 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"context"
 	"os"
 	"os/exec"
 
@@ -35,15 +36,19 @@ type clientBuilder struct {
 	commandArgs  []string
 	clientLogger logrus.FieldLogger
 	pluginLogger hclog.Logger
+	// ctx is the context of the clients' calls to plugins.
+	ctx context.Context
 }
 
 // newClientBuilder returns a new clientBuilder with commandName to name. If the command matches the currently running
-// process (i.e. velero), this also sets commandArgs to the internal Velero command to run plugins.
-func newClientBuilder(command string, logger logrus.FieldLogger, logLevel logrus.Level) *clientBuilder {
+// process (i.e. velero), this also sets commandArgs to the internal Velero command to run plugins. The calls that
+// the built clients make to plugins are canceled when ctx is done, and limited to its framework.CallTimeout.
+func newClientBuilder(ctx context.Context, command string, logger logrus.FieldLogger, logLevel logrus.Level) *clientBuilder {
 	b := &clientBuilder{
 		commandName:  command,
 		clientLogger: logger,
 		pluginLogger: newLogrusAdapter(logger, logLevel),
+		ctx:          ctx,
 	}
 	if command == os.Args[0] {
 		// For plugins compiled into the velero executable, we need to run "velero run-plugins"
@@ -66,16 +71,23 @@ func (b *clientBuilder) clientConfig() *hcplugin.ClientConfig {
 	return &hcplugin.ClientConfig{
 		HandshakeConfig:  framework.Handshake(),
 		AllowedProtocols: []hcplugin.Protocol{hcplugin.ProtocolGRPC},
-		Plugins: map[string]hcplugin.Plugin{
-			string(framework.PluginKindBackupItemAction):  framework.NewBackupItemActionPlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindVolumeSnapshotter): framework.NewVolumeSnapshotterPlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindObjectStore):       framework.NewObjectStorePlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindPluginLister):      &framework.PluginListerPlugin{},
-			string(framework.PluginKindRestoreItemAction): framework.NewRestoreItemActionPlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindDeleteItemAction):  framework.NewDeleteItemActionPlugin(framework.ClientLogger(b.clientLogger)),
-		},
-		Logger: b.pluginLogger,
-		Cmd:    exec.Command(b.commandName, b.commandArgs...),
+		Plugins:          newClientPlugins(b.ctx, b.clientLogger),
+		Logger:           b.pluginLogger,
+		Cmd:              exec.Command(b.commandName, b.commandArgs...),
+	}
+}
+
+// newClientPlugins returns the go-plugin Plugins for clients of all of Velero's plugin kinds. Their calls to plugins are
+// canceled when ctx is done, and limited to its framework.CallTimeout.
+func newClientPlugins(ctx context.Context, logger logrus.FieldLogger) map[string]hcplugin.Plugin {
+	return map[string]hcplugin.Plugin{
+		string(framework.PluginKindBackupItemAction):   framework.NewBackupItemActionPlugin(framework.ClientLogger(logger), framework.ClientContext(ctx)),
+		string(framework.PluginKindBackupItemActionV2): framework.NewBackupItemActionV2Plugin(framework.ClientLogger(logger), framework.ClientContext(ctx)),
+		string(framework.PluginKindVolumeSnapshotter):  framework.NewVolumeSnapshotterPlugin(framework.ClientLogger(logger), framework.ClientContext(ctx)),
+		string(framework.PluginKindObjectStore):        framework.NewObjectStorePlugin(framework.ClientLogger(logger), framework.ClientContext(ctx)),
+		string(framework.PluginKindPluginLister):       &framework.PluginListerPlugin{},
+		string(framework.PluginKindRestoreItemAction):  framework.NewRestoreItemActionPlugin(framework.ClientLogger(logger), framework.ClientContext(ctx)),
+		string(framework.PluginKindDeleteItemAction):   framework.NewDeleteItemActionPlugin(framework.ClientLogger(logger), framework.ClientContext(ctx)),
 	}
 }
 
@@ -17,6 +17,7 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"context"
 	"os"
 	"os/exec"
 	"testing"
@@ -33,18 +34,18 @@ import (
 func TestNewClientBuilder(t *testing.T) {
 	logger := test.NewLogger()
 	logLevel := logrus.InfoLevel
-	cb := newClientBuilder("velero", logger, logLevel)
+	cb := newClientBuilder(context.Background(), "velero", logger, logLevel)
 	assert.Equal(t, cb.commandName, "velero")
 	assert.Equal(t, []string{"--log-level", "info"}, cb.commandArgs)
 	assert.Equal(t, newLogrusAdapter(logger, logLevel), cb.pluginLogger)
 
-	cb = newClientBuilder(os.Args[0], logger, logLevel)
+	cb = newClientBuilder(context.Background(), os.Args[0], logger, logLevel)
 	assert.Equal(t, cb.commandName, os.Args[0])
 	assert.Equal(t, []string{"run-plugins", "--log-level", "info"}, cb.commandArgs)
 	assert.Equal(t, newLogrusAdapter(logger, logLevel), cb.pluginLogger)
 
 	features.NewFeatureFlagSet("feature1", "feature2")
-	cb = newClientBuilder(os.Args[0], logger, logLevel)
+	cb = newClientBuilder(context.Background(), os.Args[0], logger, logLevel)
 	assert.Equal(t, []string{"run-plugins", "--log-level", "info", "--features", "feature1,feature2"}, cb.commandArgs)
 	// Clear the features list in case other tests run in the same process.
 	features.NewFeatureFlagSet()
@@ -54,18 +55,19 @@ func TestNewClientBuilder(t *testing.T) {
 func TestClientConfig(t *testing.T) {
 	logger := test.NewLogger()
 	logLevel := logrus.InfoLevel
-	cb := newClientBuilder("velero", logger, logLevel)
+	cb := newClientBuilder(context.Background(), "velero", logger, logLevel)
 
 	expected := &hcplugin.ClientConfig{
 		HandshakeConfig:  framework.Handshake(),
 		AllowedProtocols: []hcplugin.Protocol{hcplugin.ProtocolGRPC},
 		Plugins: map[string]hcplugin.Plugin{
-			string(framework.PluginKindBackupItemAction):  framework.NewBackupItemActionPlugin(framework.ClientLogger(logger)),
-			string(framework.PluginKindVolumeSnapshotter): framework.NewVolumeSnapshotterPlugin(framework.ClientLogger(logger)),
-			string(framework.PluginKindObjectStore):       framework.NewObjectStorePlugin(framework.ClientLogger(logger)),
-			string(framework.PluginKindPluginLister):      &framework.PluginListerPlugin{},
-			string(framework.PluginKindRestoreItemAction): framework.NewRestoreItemActionPlugin(framework.ClientLogger(logger)),
-			string(framework.PluginKindDeleteItemAction):  framework.NewDeleteItemActionPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindBackupItemAction):   framework.NewBackupItemActionPlugin(framework.ClientLogger(logger), framework.ClientContext(context.Background())),
+			string(framework.PluginKindBackupItemActionV2): framework.NewBackupItemActionV2Plugin(framework.ClientLogger(logger), framework.ClientContext(context.Background())),
+			string(framework.PluginKindVolumeSnapshotter):  framework.NewVolumeSnapshotterPlugin(framework.ClientLogger(logger), framework.ClientContext(context.Background())),
+			string(framework.PluginKindObjectStore):        framework.NewObjectStorePlugin(framework.ClientLogger(logger), framework.ClientContext(context.Background())),
+			string(framework.PluginKindPluginLister):       &framework.PluginListerPlugin{},
+			string(framework.PluginKindRestoreItemAction):  framework.NewRestoreItemActionPlugin(framework.ClientLogger(logger), framework.ClientContext(context.Background())),
+			string(framework.PluginKindDeleteItemAction):   framework.NewDeleteItemActionPlugin(framework.ClientLogger(logger), framework.ClientContext(context.Background())),
 		},
 		Logger: cb.pluginLogger,
 		Cmd:    exec.Command(cb.commandName, cb.commandArgs...),
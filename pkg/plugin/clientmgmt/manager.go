@@ -17,9 +17,11 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"context"
 	"strings"
 	"sync"
 
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
@@ -40,6 +42,14 @@ type Manager interface {
 	// GetBackupItemAction returns the backup item action plugin for name.
 	GetBackupItemAction(name string) (velero.BackupItemAction, error)
 
+	// GetBackupItemActionsV2 returns all backup item action plugins, of any
+	// version, as BackupItemActionV2s.
+	GetBackupItemActionsV2() ([]velero.BackupItemActionV2, error)
+
+	// GetBackupItemActionV2 returns the backup item action plugin for name, of
+	// any version, as a BackupItemActionV2.
+	GetBackupItemActionV2(name string) (velero.BackupItemActionV2, error)
+
 	// GetRestoreItemActions returns all restore item action plugins.
 	GetRestoreItemActions() ([]velero.RestoreItemAction, error)
 
@@ -69,14 +79,15 @@ type manager struct {
 	restartableProcesses map[string]RestartableProcess
 }
 
-// NewManager constructs a manager for getting plugins.
-func NewManager(logger logrus.FieldLogger, level logrus.Level, registry Registry) Manager {
+// NewManager constructs a manager for getting plugins. The plugins' calls are canceled when ctx is done, and limited
+// to its framework.CallTimeout.
+func NewManager(ctx context.Context, logger logrus.FieldLogger, level logrus.Level, registry Registry) Manager {
 	return &manager{
 		logger:   logger,
 		logLevel: level,
 		registry: registry,
 
-		restartableProcessFactory: newRestartableProcessFactory(),
+		restartableProcessFactory: newRestartableProcessFactory(ctx),
 
 		restartableProcesses: make(map[string]RestartableProcess),
 	}
@@ -119,7 +130,11 @@ func (m *manager) getRestartableProcess(kind framework.PluginKind, name string)
 
 	logger.Debug("creating new restartable plugin process")
 
-	restartableProcess, err = m.restartableProcessFactory.newRestartableProcess(info.Command, m.logger, m.logLevel)
+	if server, ok := m.registry.RemoteServer(info.Command); ok {
+		restartableProcess, err = m.restartableProcessFactory.newRemoteRestartableProcess(server, m.logger)
+	} else {
+		restartableProcess, err = m.restartableProcessFactory.newRestartableProcess(info.Command, m.logger, m.logLevel)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -129,16 +144,81 @@ func (m *manager) getRestartableProcess(kind framework.PluginKind, name string)
 	return restartableProcess, nil
 }
 
+// getReadyRestartableProcess returns the restartableProcess for a plugin identified by kind and name, like
+// getRestartableProcess, unless the plugin isn't ready to serve requests. A plugin that wasn't ready when its
+// health was last checked is checked again, so that it can be used as soon as it's ready.
+func (m *manager) getReadyRestartableProcess(kind framework.PluginKind, name string) (RestartableProcess, error) {
+	restartableProcess, err := m.getRestartableProcess(kind, name)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := m.registry.Status(kind, name)
+	if err != nil || status.Health.Ready {
+		return restartableProcess, nil
+	}
+
+	health, err := checkHealth(restartableProcess, kind, name)
+	if err != nil {
+		return nil, err
+	}
+	m.registry.UpdateHealth(kind, name, health)
+
+	if !health.Ready {
+		return nil, newPluginNotReadyError(kind, name, health.Message)
+	}
+	return restartableProcess, nil
+}
+
+// checkHealth asks the process of the plugin identified by kind and name whether the plugin is ready. Plugins
+// whose process doesn't report their health are ready.
+func checkHealth(restartableProcess RestartableProcess, kind framework.PluginKind, name string) (framework.PluginHealth, error) {
+	if err := restartableProcess.resetIfNeeded(); err != nil {
+		return framework.PluginHealth{}, err
+	}
+
+	plugin, err := restartableProcess.getByKindAndName(kindAndName{kind: framework.PluginKindPluginLister})
+	if err != nil {
+		return framework.PluginHealth{}, err
+	}
+
+	lister, ok := plugin.(framework.PluginLister)
+	if !ok {
+		return framework.PluginHealth{}, errors.Errorf("%T is not a PluginLister", plugin)
+	}
+
+	health, err := lister.Health(kind, name)
+	switch {
+	case err == framework.ErrPluginStatusNotSupported:
+		return framework.PluginHealth{Ready: true}, nil
+	case err != nil:
+		return framework.PluginHealth{}, errors.Wrapf(err, "error checking health of %v plugin %s", kind, name)
+	default:
+		return health, nil
+	}
+}
+
+// capabilities returns the capabilities reported by the plugin identified by kind and name, or nil if
+// it didn't report them.
+func (m *manager) capabilities(kind framework.PluginKind, name string) *framework.PluginCapabilities {
+	status, err := m.registry.Status(kind, name)
+	if err != nil {
+		return nil
+	}
+	return status.Capabilities
+}
+
 // GetObjectStore returns a restartableObjectStore for name.
 func (m *manager) GetObjectStore(name string) (velero.ObjectStore, error) {
 	name = sanitizeName(name)
 
-	restartableProcess, err := m.getRestartableProcess(framework.PluginKindObjectStore, name)
+	restartableProcess, err := m.getReadyRestartableProcess(framework.PluginKindObjectStore, name)
 	if err != nil {
 		return nil, err
 	}
 
 	r := newRestartableObjectStore(name, restartableProcess)
+	r.capabilities = m.capabilities(framework.PluginKindObjectStore, name)
 
 	return r, nil
 }
@@ -147,12 +227,13 @@ func (m *manager) GetObjectStore(name string) (velero.ObjectStore, error) {
 func (m *manager) GetVolumeSnapshotter(name string) (velero.VolumeSnapshotter, error) {
 	name = sanitizeName(name)
 
-	restartableProcess, err := m.getRestartableProcess(framework.PluginKindVolumeSnapshotter, name)
+	restartableProcess, err := m.getReadyRestartableProcess(framework.PluginKindVolumeSnapshotter, name)
 	if err != nil {
 		return nil, err
 	}
 
 	r := newRestartableVolumeSnapshotter(name, restartableProcess)
+	r.capabilities = m.capabilities(framework.PluginKindVolumeSnapshotter, name)
 
 	return r, nil
 }
@@ -181,7 +262,7 @@ func (m *manager) GetBackupItemActions() ([]velero.BackupItemAction, error) {
 func (m *manager) GetBackupItemAction(name string) (velero.BackupItemAction, error) {
 	name = sanitizeName(name)
 
-	restartableProcess, err := m.getRestartableProcess(framework.PluginKindBackupItemAction, name)
+	restartableProcess, err := m.getReadyRestartableProcess(framework.PluginKindBackupItemAction, name)
 	if err != nil {
 		return nil, err
 	}
@@ -190,6 +271,54 @@ func (m *manager) GetBackupItemAction(name string) (velero.BackupItemAction, err
 	return r, nil
 }
 
+// GetBackupItemActionsV2 returns all backup item actions as BackupItemActionV2s. An action that's
+// registered with more than one version of the BackupItemAction kind is only returned once, using
+// the newest version.
+func (m *manager) GetBackupItemActionsV2() ([]velero.BackupItemActionV2, error) {
+	var actions []velero.BackupItemActionV2
+	found := make(map[string]bool)
+
+	for _, kind := range framework.PluginKindVersions(framework.PluginKindBackupItemAction) {
+		for _, id := range m.registry.List(kind) {
+			if found[id.Name] {
+				continue
+			}
+			found[id.Name] = true
+
+			r, err := m.GetBackupItemActionV2(id.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			actions = append(actions, r)
+		}
+	}
+
+	return actions, nil
+}
+
+// GetBackupItemActionV2 returns a restartableBackupItemActionV2 for name if it's registered as a
+// BackupItemAction/v2, or else its restartableBackupItemAction lifted into a BackupItemActionV2.
+func (m *manager) GetBackupItemActionV2(name string) (velero.BackupItemActionV2, error) {
+	name = sanitizeName(name)
+
+	if _, err := m.registry.Get(framework.PluginKindBackupItemActionV2, name); err != nil {
+		action, err := m.GetBackupItemAction(name)
+		if err != nil {
+			return nil, err
+		}
+		return velero.BackupItemActionV1ToV2(action), nil
+	}
+
+	restartableProcess, err := m.getReadyRestartableProcess(framework.PluginKindBackupItemActionV2, name)
+	if err != nil {
+		return nil, err
+	}
+
+	r := newRestartableBackupItemActionV2(name, restartableProcess)
+	return r, nil
+}
+
 // GetRestoreItemActions returns all restore item actions as restartableRestoreItemActions.
 func (m *manager) GetRestoreItemActions() ([]velero.RestoreItemAction, error) {
 	list := m.registry.List(framework.PluginKindRestoreItemAction)
@@ -214,7 +343,7 @@ func (m *manager) GetRestoreItemActions() ([]velero.RestoreItemAction, error) {
 func (m *manager) GetRestoreItemAction(name string) (velero.RestoreItemAction, error) {
 	name = sanitizeName(name)
 
-	restartableProcess, err := m.getRestartableProcess(framework.PluginKindRestoreItemAction, name)
+	restartableProcess, err := m.getReadyRestartableProcess(framework.PluginKindRestoreItemAction, name)
 	if err != nil {
 		return nil, err
 	}
@@ -247,7 +376,7 @@ func (m *manager) GetDeleteItemActions() ([]velero.DeleteItemAction, error) {
 func (m *manager) GetDeleteItemAction(name string) (velero.DeleteItemAction, error) {
 	name = sanitizeName(name)
 
-	restartableProcess, err := m.getRestartableProcess(framework.PluginKindDeleteItemAction, name)
+	restartableProcess, err := m.getReadyRestartableProcess(framework.PluginKindDeleteItemAction, name)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,7 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -27,11 +28,14 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/test"
 )
 
 type mockRegistry struct {
 	mock.Mock
+	// remoteServers are the remote plugin servers returned by RemoteServer.
+	remoteServers map[string]RemotePluginServer
 }
 
 func (r *mockRegistry) DiscoverPlugins() error {
@@ -39,6 +43,20 @@ func (r *mockRegistry) DiscoverPlugins() error {
 	return args.Error(0)
 }
 
+func (r *mockRegistry) DiscoverRemotePlugins(server RemotePluginServer) ([]framework.PluginIdentifier, error) {
+	args := r.Called(server)
+	return args.Get(0).([]framework.PluginIdentifier), args.Error(1)
+}
+
+func (r *mockRegistry) RemoveRemotePlugins(address string) {
+	r.Called(address)
+}
+
+func (r *mockRegistry) RemoteServer(command string) (RemotePluginServer, bool) {
+	server, found := r.remoteServers[command]
+	return server, found
+}
+
 func (r *mockRegistry) List(kind framework.PluginKind) []framework.PluginIdentifier {
 	args := r.Called(kind)
 	return args.Get(0).([]framework.PluginIdentifier)
@@ -53,6 +71,23 @@ func (r *mockRegistry) Get(kind framework.PluginKind, name string) (framework.Pl
 	return id, args.Error(1)
 }
 
+func (r *mockRegistry) Status(kind framework.PluginKind, name string) (PluginStatus, error) {
+	args := r.Called(kind, name)
+	return args.Get(0).(PluginStatus), args.Error(1)
+}
+
+func (r *mockRegistry) CheckHealth() error {
+	args := r.Called()
+	return args.Error(0)
+}
+
+func (r *mockRegistry) UpdateHealth(kind framework.PluginKind, name string, health framework.PluginHealth) {
+	r.Called(kind, name, health)
+}
+
+// readyPluginStatus is the status of a plugin that's ready.
+var readyPluginStatus = PluginStatus{Health: framework.PluginHealth{Ready: true}}
+
 func TestNewManager(t *testing.T) {
 	logger := test.NewLogger()
 	logLevel := logrus.InfoLevel
@@ -60,7 +95,7 @@ func TestNewManager(t *testing.T) {
 	registry := &mockRegistry{}
 	defer registry.AssertExpectations(t)
 
-	m := NewManager(logger, logLevel, registry).(*manager)
+	m := NewManager(context.Background(), logger, logLevel, registry).(*manager)
 	assert.Equal(t, logger, m.logger)
 	assert.Equal(t, logLevel, m.logLevel)
 	assert.Equal(t, registry, m.registry)
@@ -81,6 +116,15 @@ func (f *mockRestartableProcessFactory) newRestartableProcess(command string, lo
 	return rp, args.Error(1)
 }
 
+func (f *mockRestartableProcessFactory) newRemoteRestartableProcess(server RemotePluginServer, logger logrus.FieldLogger) (RestartableProcess, error) {
+	args := f.Called(server, logger)
+	var rp RestartableProcess
+	if args.Get(0) != nil {
+		rp = args.Get(0).(RestartableProcess)
+	}
+	return rp, args.Error(1)
+}
+
 type mockRestartableProcess struct {
 	mock.Mock
 }
@@ -115,7 +159,7 @@ func TestGetRestartableProcess(t *testing.T) {
 	registry := &mockRegistry{}
 	defer registry.AssertExpectations(t)
 
-	m := NewManager(logger, logLevel, registry).(*manager)
+	m := NewManager(context.Background(), logger, logLevel, registry).(*manager)
 	factory := &mockRestartableProcessFactory{}
 	defer factory.AssertExpectations(t)
 	m.restartableProcessFactory = factory
@@ -154,6 +198,36 @@ func TestGetRestartableProcess(t *testing.T) {
 	assert.Equal(t, restartableProcess, rp)
 }
 
+func TestGetRestartableProcessForRemotePlugin(t *testing.T) {
+	logger := test.NewLogger()
+	logLevel := logrus.InfoLevel
+
+	server := RemotePluginServer{Address: "plugins.velero.svc:8443"}
+	registry := &mockRegistry{remoteServers: map[string]RemotePluginServer{server.Address: server}}
+	defer registry.AssertExpectations(t)
+
+	m := NewManager(context.Background(), logger, logLevel, registry).(*manager)
+	factory := &mockRestartableProcessFactory{}
+	defer factory.AssertExpectations(t)
+	m.restartableProcessFactory = factory
+
+	pluginKind := framework.PluginKindObjectStore
+	pluginName := "example.io/remote"
+	registry.On("Get", pluginKind, pluginName).Return(framework.PluginIdentifier{
+		Command: server.Address,
+		Kind:    pluginKind,
+		Name:    pluginName,
+	}, nil)
+
+	restartableProcess := &mockRestartableProcess{}
+	defer restartableProcess.AssertExpectations(t)
+	factory.On("newRemoteRestartableProcess", server, logger).Return(restartableProcess, nil).Once()
+
+	rp, err := m.getRestartableProcess(pluginKind, pluginName)
+	require.NoError(t, err)
+	assert.Equal(t, restartableProcess, rp)
+}
+
 func TestCleanupClients(t *testing.T) {
 	logger := test.NewLogger()
 	logLevel := logrus.InfoLevel
@@ -161,7 +235,7 @@ func TestCleanupClients(t *testing.T) {
 	registry := &mockRegistry{}
 	defer registry.AssertExpectations(t)
 
-	m := NewManager(logger, logLevel, registry).(*manager)
+	m := NewManager(context.Background(), logger, logLevel, registry).(*manager)
 
 	for i := 0; i < 5; i++ {
 		rp := &mockRestartableProcess{}
@@ -255,7 +329,7 @@ func getPluginTest(
 	registry := &mockRegistry{}
 	defer registry.AssertExpectations(t)
 
-	m := NewManager(logger, logLevel, registry).(*manager)
+	m := NewManager(context.Background(), logger, logLevel, registry).(*manager)
 	factory := &mockRestartableProcessFactory{}
 	defer factory.AssertExpectations(t)
 	m.restartableProcessFactory = factory
@@ -268,6 +342,7 @@ func getPluginTest(
 		Name:    pluginName,
 	}
 	registry.On("Get", pluginKind, pluginName).Return(pluginID, nil)
+	registry.On("Status", pluginKind, pluginName).Return(readyPluginStatus, nil).Maybe()
 
 	restartableProcess := &mockRestartableProcess{}
 	defer restartableProcess.AssertExpectations(t)
@@ -292,6 +367,71 @@ func getPluginTest(
 	assert.Equal(t, expected, actual)
 }
 
+// fakeHealthPluginLister is a PluginLister that reports the same health for every plugin.
+type fakeHealthPluginLister struct {
+	framework.PluginLister
+	health framework.PluginHealth
+}
+
+func (l *fakeHealthPluginLister) Health(kind framework.PluginKind, name string) (framework.PluginHealth, error) {
+	return l.health, nil
+}
+
+func TestGetPluginThatWasNotReady(t *testing.T) {
+	tests := []struct {
+		name          string
+		health        framework.PluginHealth
+		expectedError string
+	}{
+		{
+			name:   "plugin that's ready now is dispensed",
+			health: framework.PluginHealth{Ready: true},
+		},
+		{
+			name:          "plugin that's still not ready isn't dispensed",
+			health:        framework.PluginHealth{Message: "no credentials"},
+			expectedError: "ObjectStore plugin named velero.io/aws is not ready: no credentials",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := test.NewLogger()
+			logLevel := logrus.InfoLevel
+
+			registry := &mockRegistry{}
+			defer registry.AssertExpectations(t)
+
+			m := NewManager(context.Background(), logger, logLevel, registry).(*manager)
+			factory := &mockRestartableProcessFactory{}
+			defer factory.AssertExpectations(t)
+			m.restartableProcessFactory = factory
+
+			kind := framework.PluginKindObjectStore
+			name := "velero.io/aws"
+			registry.On("Get", kind, name).Return(framework.PluginIdentifier{Command: "/command", Kind: kind, Name: name}, nil)
+			registry.On("Status", kind, name).Return(PluginStatus{Health: framework.PluginHealth{Message: "no credentials"}}, nil)
+			registry.On("UpdateHealth", kind, name, tc.health)
+
+			restartableProcess := &mockRestartableProcess{}
+			defer restartableProcess.AssertExpectations(t)
+			factory.On("newRestartableProcess", "/command", logger, logLevel).Return(restartableProcess, nil)
+			restartableProcess.On("resetIfNeeded").Return(nil)
+			restartableProcess.On("getByKindAndName", kindAndName{kind: framework.PluginKindPluginLister}).Return(&fakeHealthPluginLister{health: tc.health}, nil)
+			restartableProcess.On("addReinitializer", kindAndName{kind: kind, name: name}, mock.Anything).Maybe()
+
+			objectStore, err := m.GetObjectStore(name)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				assert.Nil(t, objectStore)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, objectStore)
+		})
+	}
+}
+
 func TestGetBackupItemActions(t *testing.T) {
 	tests := []struct {
 		name                       string
@@ -321,8 +461,9 @@ func TestGetBackupItemActions(t *testing.T) {
 
 			registry := &mockRegistry{}
 			defer registry.AssertExpectations(t)
+			registry.On("Status", mock.Anything, mock.Anything).Return(readyPluginStatus, nil).Maybe()
 
-			m := NewManager(logger, logLevel, registry).(*manager)
+			m := NewManager(context.Background(), logger, logLevel, registry).(*manager)
 			factory := &mockRestartableProcessFactory{}
 			defer factory.AssertExpectations(t)
 			m.restartableProcessFactory = factory
@@ -384,6 +525,51 @@ func TestGetBackupItemActions(t *testing.T) {
 	}
 }
 
+func TestGetBackupItemActionsV2(t *testing.T) {
+	logger := test.NewLogger()
+	logLevel := logrus.InfoLevel
+
+	registry := &mockRegistry{}
+	defer registry.AssertExpectations(t)
+	registry.On("Status", mock.Anything, mock.Anything).Return(readyPluginStatus, nil).Maybe()
+
+	m := NewManager(context.Background(), logger, logLevel, registry).(*manager)
+	factory := &mockRestartableProcessFactory{}
+	defer factory.AssertExpectations(t)
+	m.restartableProcessFactory = factory
+
+	v1 := framework.PluginKindBackupItemAction
+	v2 := framework.PluginKindBackupItemActionV2
+	bothV1 := framework.PluginIdentifier{Command: "/command", Kind: v1, Name: "velero.io/both"}
+	bothV2 := framework.PluginIdentifier{Command: "/command", Kind: v2, Name: "velero.io/both"}
+	onlyV1 := framework.PluginIdentifier{Command: "/command", Kind: v1, Name: "velero.io/v1"}
+
+	registry.On("List", v2).Return([]framework.PluginIdentifier{bothV2})
+	registry.On("List", v1).Return([]framework.PluginIdentifier{bothV1, onlyV1})
+	registry.On("Get", v2, bothV2.Name).Return(bothV2, nil)
+	registry.On("Get", v2, onlyV1.Name).Return(nil, errors.Errorf("not found"))
+	registry.On("Get", v1, onlyV1.Name).Return(onlyV1, nil)
+
+	restartableProcess := &mockRestartableProcess{}
+	defer restartableProcess.AssertExpectations(t)
+	factory.On("newRestartableProcess", "/command", logger, logLevel).Return(restartableProcess, nil).Once()
+
+	actions, err := m.GetBackupItemActionsV2()
+	require.NoError(t, err)
+
+	expected := []velero.BackupItemActionV2{
+		&restartableBackupItemActionV2{
+			key:                 kindAndName{kind: v2, name: bothV2.Name},
+			sharedPluginProcess: restartableProcess,
+		},
+		velero.BackupItemActionV1ToV2(&restartableBackupItemAction{
+			key:                 kindAndName{kind: v1, name: onlyV1.Name},
+			sharedPluginProcess: restartableProcess,
+		}),
+	}
+	assert.Equal(t, expected, actions)
+}
+
 func TestGetRestoreItemActions(t *testing.T) {
 	tests := []struct {
 		name                       string
@@ -413,8 +599,9 @@ func TestGetRestoreItemActions(t *testing.T) {
 
 			registry := &mockRegistry{}
 			defer registry.AssertExpectations(t)
+			registry.On("Status", mock.Anything, mock.Anything).Return(readyPluginStatus, nil).Maybe()
 
-			m := NewManager(logger, logLevel, registry).(*manager)
+			m := NewManager(context.Background(), logger, logLevel, registry).(*manager)
 			factory := &mockRestartableProcessFactory{}
 			defer factory.AssertExpectations(t)
 			m.restartableProcessFactory = factory
@@ -512,8 +699,9 @@ func TestGetDeleteItemActions(t *testing.T) {
 
 			registry := &mockRegistry{}
 			defer registry.AssertExpectations(t)
+			registry.On("Status", mock.Anything, mock.Anything).Return(readyPluginStatus, nil).Maybe()
 
-			m := NewManager(logger, logLevel, registry).(*manager)
+			m := NewManager(context.Background(), logger, logLevel, registry).(*manager)
 			factory := &mockRestartableProcessFactory{}
 			defer factory.AssertExpectations(t)
 			m.restartableProcessFactory = factory
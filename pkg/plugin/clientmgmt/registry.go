@@ -17,14 +17,18 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 )
 
@@ -32,10 +36,41 @@ import (
 type Registry interface {
 	// DiscoverPlugins discovers all available plugins.
 	DiscoverPlugins() error
+	// DiscoverRemotePlugins discovers the plugins served by server, and returns them. Their PluginIdentifiers'
+	// Command is the server's address.
+	DiscoverRemotePlugins(server RemotePluginServer) ([]framework.PluginIdentifier, error)
+	// RemoveRemotePlugins unregisters the plugins discovered from the remote plugin server at address.
+	RemoveRemotePlugins(address string)
+	// RemoteServer returns the remote plugin server that serves the plugins whose Command is command, if any.
+	RemoteServer(command string) (RemotePluginServer, bool)
 	// List returns all PluginIdentifiers for kind.
 	List(kind framework.PluginKind) []framework.PluginIdentifier
 	// Get returns the PluginIdentifier for kind and name.
 	Get(kind framework.PluginKind, name string) (framework.PluginIdentifier, error)
+	// Status returns the capabilities that the plugin for kind and name reported when it
+	// was discovered, and the health it reported when it was last checked.
+	Status(kind framework.PluginKind, name string) (PluginStatus, error)
+	// CheckHealth queries the health of all of the registered plugins again, from new processes
+	// of their commands.
+	CheckHealth() error
+	// UpdateHealth records the health of the plugin for kind and name, checked by the caller.
+	UpdateHealth(kind framework.PluginKind, name string, health framework.PluginHealth)
+}
+
+// PluginStatus is the capabilities that a plugin reported when it was discovered, and the health
+// it reported when it was last checked.
+type PluginStatus struct {
+	// Capabilities is nil if the plugin's process doesn't report the capabilities of its plugins.
+	Capabilities *framework.PluginCapabilities
+	// Health is the health of the plugin. Plugins whose process doesn't report their health are
+	// considered ready.
+	Health framework.PluginHealth
+}
+
+// supports returns whether a plugin with capabilities supports feature. A plugin that doesn't report its
+// capabilities may support any feature.
+func supports(capabilities *framework.PluginCapabilities, feature velero.PluginFeature) bool {
+	return capabilities == nil || capabilities.Supports(feature)
 }
 
 // kindAndName is a convenience struct that combines a PluginKind and a name.
@@ -44,7 +79,7 @@ type kindAndName struct {
 	name string
 }
 
-// registry implements Registry.
+// registry implements Registry. It's safe for concurrent use.
 type registry struct {
 	// dir is the directory to search for plugins.
 	dir      string
@@ -53,8 +88,13 @@ type registry struct {
 
 	processFactory ProcessFactory
 	fs             filesystem.Interface
-	pluginsByID    map[kindAndName]framework.PluginIdentifier
-	pluginsByKind  map[framework.PluginKind][]framework.PluginIdentifier
+
+	// lock guards the maps below
+	lock          sync.RWMutex
+	pluginsByID   map[kindAndName]framework.PluginIdentifier
+	pluginsByKind map[framework.PluginKind][]framework.PluginIdentifier
+	statusesByID  map[kindAndName]PluginStatus
+	remoteServers map[string]RemotePluginServer
 }
 
 // NewRegistry returns a new registry.
@@ -68,6 +108,8 @@ func NewRegistry(dir string, logger logrus.FieldLogger, logLevel logrus.Level) R
 		fs:             filesystem.NewFileSystem(),
 		pluginsByID:    make(map[kindAndName]framework.PluginIdentifier),
 		pluginsByKind:  make(map[framework.PluginKind][]framework.PluginIdentifier),
+		statusesByID:   make(map[kindAndName]PluginStatus),
+		remoteServers:  make(map[string]RemotePluginServer),
 	}
 }
 
@@ -87,36 +129,144 @@ func (r *registry) DiscoverPlugins() error {
 
 func (r *registry) discoverPlugins(commands []string) error {
 	for _, command := range commands {
-		plugins, err := r.listPlugins(command)
+		plugins, statuses, err := r.listPlugins(command)
+		if err != nil {
+			return err
+		}
+
+		r.lock.Lock()
+		err = r.registerAllLH(plugins, statuses)
+		r.lock.Unlock()
 		if err != nil {
 			return err
 		}
+	}
+
+	return nil
+}
 
-		for _, plugin := range plugins {
+// registerAllLH registers plugins, and the statuses they reported, with the registry. The caller must hold the
+// registry's lock.
+func (r *registry) registerAllLH(plugins []framework.PluginIdentifier, statuses []PluginStatus) error {
+	for i, plugin := range plugins {
+		r.logger.WithFields(logrus.Fields{
+			"kind":    plugin.Kind,
+			"name":    plugin.Name,
+			"command": plugin.Command,
+		}).Info("registering plugin")
+
+		if !statuses[i].Health.Ready {
 			r.logger.WithFields(logrus.Fields{
-				"kind":    plugin.Kind,
-				"name":    plugin.Name,
-				"command": command,
-			}).Info("registering plugin")
+				"kind": plugin.Kind,
+				"name": plugin.Name,
+			}).Warnf("plugin is not ready: %s", statuses[i].Health.Message)
+		}
 
-			if err := r.register(plugin); err != nil {
-				return err
-			}
+		if err := r.registerLH(plugin, statuses[i]); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
+func (r *registry) DiscoverRemotePlugins(server RemotePluginServer) ([]framework.PluginIdentifier, error) {
+	process, err := newRemoteProcess(context.Background(), server, r.logger)
+	if err != nil {
+		return nil, err
+	}
+	defer process.kill()
+
+	plugins, statuses, err := r.listProcessPlugins(process)
+	if err != nil {
+		return nil, err
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	// the plugins are all registered, or none of them
+	for i := range plugins {
+		plugins[i].Command = server.Address
+
+		if existing, found := r.pluginsByID[kindAndName{kind: plugins[i].Kind, name: plugins[i].Name}]; found {
+			return nil, newDuplicatePluginRegistrationError(existing, plugins[i])
+		}
+		if err := framework.ValidatePluginName(plugins[i].Name, nil); err != nil {
+			return nil, errors.Errorf("invalid plugin name %q: %s", plugins[i].Name, err)
+		}
+	}
+
+	if err := r.registerAllLH(plugins, statuses); err != nil {
+		return nil, err
+	}
+	r.remoteServers[server.Address] = server
+
+	return plugins, nil
+}
+
+// RemoveRemotePlugins unregisters the plugins discovered from the remote plugin server at address, so that
+// they're no longer dispensed, and the server's plugins can be discovered again.
+func (r *registry) RemoveRemotePlugins(address string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, found := r.remoteServers[address]; !found {
+		return
+	}
+	delete(r.remoteServers, address)
+
+	for key, id := range r.pluginsByID {
+		if id.Command != address {
+			continue
+		}
+
+		r.logger.WithFields(logrus.Fields{
+			"kind":    id.Kind,
+			"name":    id.Name,
+			"command": id.Command,
+		}).Info("unregistering plugin")
+
+		delete(r.pluginsByID, key)
+		delete(r.statusesByID, key)
+	}
+
+	// List returns the slices, so they're replaced rather than changed
+	for kind, ids := range r.pluginsByKind {
+		var remaining []framework.PluginIdentifier
+		for _, id := range ids {
+			if id.Command != address {
+				remaining = append(remaining, id)
+			}
+		}
+		r.pluginsByKind[kind] = remaining
+	}
+}
+
+// RemoteServer returns the remote plugin server whose address is command, if its plugins were discovered.
+func (r *registry) RemoteServer(command string) (RemotePluginServer, bool) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	server, found := r.remoteServers[command]
+	return server, found
+}
+
 // List returns info about all plugin binaries that implement the given
 // PluginKind.
 func (r *registry) List(kind framework.PluginKind) []framework.PluginIdentifier {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
 	return r.pluginsByKind[kind]
 }
 
 // Get returns info about a plugin with the given name and kind, or an
 // error if one cannot be found.
 func (r *registry) Get(kind framework.PluginKind, name string) (framework.PluginIdentifier, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
 	p, found := r.pluginsByID[kindAndName{kind: kind, name: name}]
 	if !found {
 		return framework.PluginIdentifier{}, newPluginNotFoundError(kind, name)
@@ -124,6 +274,108 @@ func (r *registry) Get(kind framework.PluginKind, name string) (framework.Plugin
 	return p, nil
 }
 
+// Status returns the capabilities that the plugin with the given name and kind reported
+// when it was discovered, and the health it reported when it was last checked, or an error
+// if it cannot be found.
+func (r *registry) Status(kind framework.PluginKind, name string) (PluginStatus, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	status, found := r.statusesByID[kindAndName{kind: kind, name: name}]
+	if !found {
+		return PluginStatus{}, newPluginNotFoundError(kind, name)
+	}
+
+	return status, nil
+}
+
+// CheckHealth queries the health of all of the registered plugins again. Each command's plugins are
+// checked by a new process of the command, so that a process that can no longer be started, or a
+// remote plugin server that can no longer be reached, makes its plugins not ready.
+func (r *registry) CheckHealth() error {
+	r.lock.RLock()
+	pluginsByCommand := make(map[string][]framework.PluginIdentifier)
+	for _, id := range r.pluginsByID {
+		pluginsByCommand[id.Command] = append(pluginsByCommand[id.Command], id)
+	}
+	r.lock.RUnlock()
+
+	var errs []error
+	for command, plugins := range pluginsByCommand {
+		if err := r.checkCommandHealth(command, plugins); err != nil {
+			for _, plugin := range plugins {
+				r.UpdateHealth(plugin.Kind, plugin.Name, framework.PluginHealth{Message: err.Error()})
+			}
+			errs = append(errs, err)
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+// checkCommandHealth queries the health of plugins from a new process of command, and records it.
+func (r *registry) checkCommandHealth(command string, plugins []framework.PluginIdentifier) error {
+	var (
+		process Process
+		err     error
+	)
+	if server, ok := r.RemoteServer(command); ok {
+		process, err = newRemoteProcess(context.Background(), server, r.logger)
+	} else {
+		process, err = r.processFactory.newProcess(command, r.logger, r.logLevel)
+	}
+	if err != nil {
+		return err
+	}
+	defer process.kill()
+
+	lister, err := dispensePluginLister(process)
+	if err != nil {
+		return err
+	}
+
+	for _, plugin := range plugins {
+		health, err := lister.Health(plugin.Kind, plugin.Name)
+		if err == framework.ErrPluginStatusNotSupported {
+			// plugins that don't report their health are always ready
+			continue
+		}
+		if err != nil {
+			health = framework.PluginHealth{Message: err.Error()}
+		}
+		r.UpdateHealth(plugin.Kind, plugin.Name, health)
+	}
+
+	return nil
+}
+
+// UpdateHealth records the health of the plugin with the given name and kind, logging when it
+// becomes ready or not ready. Plugins that aren't registered are ignored.
+func (r *registry) UpdateHealth(kind framework.PluginKind, name string, health framework.PluginHealth) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	key := kindAndName{kind: kind, name: name}
+	status, found := r.statusesByID[key]
+	if !found {
+		return
+	}
+
+	log := r.logger.WithFields(logrus.Fields{
+		"kind": kind,
+		"name": name,
+	})
+	switch {
+	case status.Health.Ready && !health.Ready:
+		log.Warnf("plugin is not ready: %s", health.Message)
+	case !status.Health.Ready && health.Ready:
+		log.Info("plugin is ready")
+	}
+
+	status.Health = health
+	r.statusesByID[key] = status
+}
+
 // readPluginsDir recursively reads dir looking for plugins.
 func (r *registry) readPluginsDir(dir string) ([]string, error) {
 	if _, err := r.fs.Stat(dir); err != nil {
@@ -174,14 +426,41 @@ func executable(info os.FileInfo) bool {
 	return (info.Mode() & 0111) != 0
 }
 
-// listPlugins executes command, queries it for registered plugins, and returns the list of PluginIdentifiers.
-func (r *registry) listPlugins(command string) ([]framework.PluginIdentifier, error) {
+// listPlugins executes command, queries it for registered plugins, and returns the list of PluginIdentifiers
+// along with the status of each plugin.
+func (r *registry) listPlugins(command string) ([]framework.PluginIdentifier, []PluginStatus, error) {
 	process, err := r.processFactory.newProcess(command, r.logger, r.logLevel)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer process.kill()
 
+	return r.listProcessPlugins(process)
+}
+
+// listProcessPlugins queries process for registered plugins, and returns the list of PluginIdentifiers along with the
+// status of each plugin.
+func (r *registry) listProcessPlugins(process Process) ([]framework.PluginIdentifier, []PluginStatus, error) {
+	lister, err := dispensePluginLister(process)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plugins, err := lister.ListPlugins()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statuses := make([]PluginStatus, len(plugins))
+	for i, plugin := range plugins {
+		statuses[i] = r.pluginStatus(lister, plugin)
+	}
+
+	return plugins, statuses, nil
+}
+
+// dispensePluginLister dispenses the PluginLister of process.
+func dispensePluginLister(process Process) (framework.PluginLister, error) {
 	plugin, err := process.dispense(kindAndName{kind: framework.PluginKindPluginLister})
 	if err != nil {
 		return nil, err
@@ -191,12 +470,44 @@ func (r *registry) listPlugins(command string) ([]framework.PluginIdentifier, er
 	if !ok {
 		return nil, errors.Errorf("%T is not a PluginLister", plugin)
 	}
+	return lister, nil
+}
+
+// pluginStatus queries lister for the capabilities and health of plugin. Plugins whose process doesn't
+// report them are considered ready, with unknown capabilities.
+func (r *registry) pluginStatus(lister framework.PluginLister, plugin framework.PluginIdentifier) PluginStatus {
+	log := r.logger.WithFields(logrus.Fields{
+		"kind":    plugin.Kind,
+		"name":    plugin.Name,
+		"command": plugin.Command,
+	})
+
+	status := PluginStatus{Health: framework.PluginHealth{Ready: true}}
+
+	capabilities, err := lister.Capabilities(plugin.Kind, plugin.Name)
+	switch {
+	case err == framework.ErrPluginStatusNotSupported:
+		log.Debug("plugin process doesn't report plugin capabilities or health")
+		return status
+	case err != nil:
+		log.WithError(err).Warn("unable to get plugin capabilities")
+	default:
+		status.Capabilities = &capabilities
+	}
+
+	health, err := lister.Health(plugin.Kind, plugin.Name)
+	if err != nil {
+		log.WithError(err).Warn("unable to get plugin health")
+		health = framework.PluginHealth{Message: err.Error()}
+	}
+	status.Health = health
 
-	return lister.ListPlugins()
+	return status
 }
 
-// register registers a PluginIdentifier with the registry.
-func (r *registry) register(id framework.PluginIdentifier) error {
+// registerLH registers a PluginIdentifier, and the status the plugin reported, with the registry. The caller must
+// hold the registry's lock.
+func (r *registry) registerLH(id framework.PluginIdentifier, status PluginStatus) error {
 	key := kindAndName{kind: id.Kind, name: id.Name}
 	if existing, found := r.pluginsByID[key]; found {
 		return newDuplicatePluginRegistrationError(existing, id)
@@ -209,6 +520,7 @@ func (r *registry) register(id framework.PluginIdentifier) error {
 
 	r.pluginsByID[key] = id
 	r.pluginsByKind[id.Kind] = append(r.pluginsByKind[id.Kind], id)
+	r.statusesByID[key] = status
 
 	return nil
 }
@@ -231,6 +543,27 @@ func (e *pluginNotFoundError) Error() string {
 	return fmt.Sprintf("unable to locate %v plugin named %s", e.kind, e.name)
 }
 
+// pluginNotReadyError indicates that the plugin for kind and name isn't ready to serve requests.
+type pluginNotReadyError struct {
+	kind    framework.PluginKind
+	name    string
+	message string
+}
+
+// newPluginNotReadyError returns a new pluginNotReadyError for kind and name, with the message
+// the plugin reported.
+func newPluginNotReadyError(kind framework.PluginKind, name, message string) *pluginNotReadyError {
+	return &pluginNotReadyError{
+		kind:    kind,
+		name:    name,
+		message: message,
+	}
+}
+
+func (e *pluginNotReadyError) Error() string {
+	return fmt.Sprintf("%v plugin named %s is not ready: %s", e.kind, e.name, e.message)
+}
+
 type duplicatePluginRegistrationError struct {
 	existing  framework.PluginIdentifier
 	duplicate framework.PluginIdentifier
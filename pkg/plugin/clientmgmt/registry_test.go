@@ -5,7 +5,7 @@ Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
 You may obtain a copy of the License at
 
-    http://www.apache.org/licenses/LICENSE-2.0
+	http://www.apache.org/licenses/LICENSE-2.0
 
 Unless required by applicable law or agreed to in writing, software
 distributed under the License is distributed on an "AS IS" BASIS,
@@ -20,10 +20,13 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/test"
 )
 
@@ -40,6 +43,8 @@ func TestNewRegistry(t *testing.T) {
 	assert.Empty(t, r.pluginsByID)
 	assert.NotNil(t, r.pluginsByKind)
 	assert.Empty(t, r.pluginsByKind)
+	assert.NotNil(t, r.statusesByID)
+	assert.Empty(t, r.statusesByID)
 }
 
 type fakeFileInfo struct {
@@ -128,3 +133,155 @@ func TestReadPluginsDir(t *testing.T) {
 	sort.Strings(expected)
 	assert.Equal(t, expected, plugins)
 }
+
+// fakePluginLister reports the same capabilities and health for every plugin.
+type fakePluginLister struct {
+	framework.PluginLister
+
+	capabilities    framework.PluginCapabilities
+	capabilitiesErr error
+	health          framework.PluginHealth
+	healthErr       error
+}
+
+func (l *fakePluginLister) Capabilities(framework.PluginKind, string) (framework.PluginCapabilities, error) {
+	return l.capabilities, l.capabilitiesErr
+}
+
+func (l *fakePluginLister) Health(framework.PluginKind, string) (framework.PluginHealth, error) {
+	return l.health, l.healthErr
+}
+
+func TestPluginStatus(t *testing.T) {
+	capabilities := framework.PluginCapabilities{
+		APIVersion: framework.PluginAPIVersion,
+		Features:   []velero.PluginFeature{velero.PluginFeatureStreamingUpload},
+	}
+
+	tests := []struct {
+		name     string
+		lister   *fakePluginLister
+		expected PluginStatus
+	}{
+		{
+			name:     "plugin process that doesn't report status is ready with unknown capabilities",
+			lister:   &fakePluginLister{capabilitiesErr: framework.ErrPluginStatusNotSupported, healthErr: framework.ErrPluginStatusNotSupported},
+			expected: PluginStatus{Health: framework.PluginHealth{Ready: true}},
+		},
+		{
+			name:     "reported capabilities and health are recorded",
+			lister:   &fakePluginLister{capabilities: capabilities, health: framework.PluginHealth{Message: "not configured"}},
+			expected: PluginStatus{Capabilities: &capabilities, Health: framework.PluginHealth{Message: "not configured"}},
+		},
+		{
+			name:     "error getting capabilities leaves them unknown",
+			lister:   &fakePluginLister{capabilitiesErr: errors.New("bad"), health: framework.PluginHealth{Ready: true}},
+			expected: PluginStatus{Health: framework.PluginHealth{Ready: true}},
+		},
+		{
+			name:     "error getting health means the plugin isn't ready",
+			lister:   &fakePluginLister{capabilities: capabilities, healthErr: errors.New("bad")},
+			expected: PluginStatus{Capabilities: &capabilities, Health: framework.PluginHealth{Message: "bad"}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := NewRegistry("/plugins", test.NewLogger(), logrus.InfoLevel).(*registry)
+			id := framework.PluginIdentifier{Command: "/plugins/aws", Kind: framework.PluginKindObjectStore, Name: "velero.io/aws"}
+
+			assert.Equal(t, tc.expected, r.pluginStatus(tc.lister, id))
+		})
+	}
+}
+
+// fakeProcessFactory starts processes that dispense lister, or fails to start them with err.
+type fakeProcessFactory struct {
+	lister framework.PluginLister
+	err    error
+}
+
+func (f *fakeProcessFactory) newProcess(string, logrus.FieldLogger, logrus.Level) (Process, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &fakeProcess{lister: f.lister}, nil
+}
+
+type fakeProcess struct {
+	lister framework.PluginLister
+}
+
+func (p *fakeProcess) dispense(kindAndName) (interface{}, error) { return p.lister, nil }
+func (p *fakeProcess) exited() bool                              { return false }
+func (p *fakeProcess) kill()                                     {}
+
+func TestCheckHealth(t *testing.T) {
+	tests := []struct {
+		name        string
+		factory     *fakeProcessFactory
+		initial     framework.PluginHealth
+		expected    framework.PluginHealth
+		expectedErr bool
+	}{
+		{
+			name:     "plugin that becomes not ready is recorded as not ready",
+			factory:  &fakeProcessFactory{lister: &fakePluginLister{health: framework.PluginHealth{Message: "not configured"}}},
+			initial:  framework.PluginHealth{Ready: true},
+			expected: framework.PluginHealth{Message: "not configured"},
+		},
+		{
+			name:     "plugin that becomes ready is recorded as ready",
+			factory:  &fakeProcessFactory{lister: &fakePluginLister{health: framework.PluginHealth{Ready: true}}},
+			initial:  framework.PluginHealth{Message: "not configured"},
+			expected: framework.PluginHealth{Ready: true},
+		},
+		{
+			name:     "plugin that doesn't report its health keeps its status",
+			factory:  &fakeProcessFactory{lister: &fakePluginLister{healthErr: framework.ErrPluginStatusNotSupported}},
+			initial:  framework.PluginHealth{Ready: true},
+			expected: framework.PluginHealth{Ready: true},
+		},
+		{
+			name:     "error getting health means the plugin isn't ready",
+			factory:  &fakeProcessFactory{lister: &fakePluginLister{healthErr: errors.New("bad")}},
+			initial:  framework.PluginHealth{Ready: true},
+			expected: framework.PluginHealth{Message: "bad"},
+		},
+		{
+			name:        "plugin whose process can't be started isn't ready",
+			factory:     &fakeProcessFactory{err: errors.New("bad")},
+			initial:     framework.PluginHealth{Ready: true},
+			expected:    framework.PluginHealth{Message: "bad"},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := NewRegistry("/plugins", test.NewLogger(), logrus.InfoLevel).(*registry)
+			r.processFactory = tc.factory
+			id := framework.PluginIdentifier{Command: "/plugins/aws", Kind: framework.PluginKindObjectStore, Name: "velero.io/aws"}
+			require.NoError(t, r.registerLH(id, PluginStatus{Health: tc.initial}))
+
+			err := r.CheckHealth()
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			status, err := r.Status(id.Kind, id.Name)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, status.Health)
+		})
+	}
+}
+
+func TestUpdateHealthIgnoresUnknownPlugins(t *testing.T) {
+	r := NewRegistry("/plugins", test.NewLogger(), logrus.InfoLevel).(*registry)
+	r.UpdateHealth(framework.PluginKindObjectStore, "velero.io/aws", framework.PluginHealth{Ready: true})
+
+	_, err := r.Status(framework.PluginKindObjectStore, "velero.io/aws")
+	assert.Error(t, err)
+}
@@ -0,0 +1,144 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"time"
+
+	hcplugin "github.com/hashicorp/go-plugin"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+)
+
+const (
+	// remoteDialTimeout is how long connecting to a remote plugin server may take.
+	remoteDialTimeout = 30 * time.Second
+
+	// remoteKeepaliveTime is how long a connection to a remote plugin server may be idle before it's pinged, and
+	// remoteKeepaliveTimeout how long the server has to answer before the connection is considered lost. Without
+	// them, a connection lost without being closed, e.g. by a network partition, is only noticed when a call times
+	// out. Remote plugin servers allow pings every 10 seconds.
+	remoteKeepaliveTime    = 30 * time.Second
+	remoteKeepaliveTimeout = 10 * time.Second
+)
+
+// RemotePluginServer is a plugin server that runs separately from the Velero server, e.g. as its own deployment, and
+// that Velero connects to over the network with mutual TLS, rather than running it from an executable.
+type RemotePluginServer struct {
+	// Address is the host and port of the server.
+	Address string
+	// LoadTLSConfig returns the TLS configuration with the certificate that Velero authenticates to the server with,
+	// and that verifies the server's. It's called each time the server is connected to, so that rotated certificates
+	// are used.
+	LoadTLSConfig func() (*tls.Config, error)
+}
+
+// remoteProcess is a Process for the connection to a remote plugin server.
+type remoteProcess struct {
+	ctx        context.Context
+	clientConn *grpc.ClientConn
+	plugins    map[string]hcplugin.Plugin
+	// disconnected is closed once the connection is no longer ready.
+	disconnected chan struct{}
+}
+
+// newRemoteProcess connects to server. Calls to its plugins are canceled when ctx is done.
+func newRemoteProcess(ctx context.Context, server RemotePluginServer, logger logrus.FieldLogger) (Process, error) {
+	tlsConfig, err := server.LoadTLSConfig()
+	if err != nil {
+		return nil, errors.Wrapf(err, "error loading TLS configuration of plugin server %s", server.Address)
+	}
+
+	dialCtx, cancel := context.WithTimeout(ctx, remoteDialTimeout)
+	defer cancel()
+
+	// gRPC retries failed TLS handshakes until the dial times out, without saying why they failed, so check the
+	// server can be reached and trusted first.
+	conn, err := (&tls.Dialer{NetDialer: &net.Dialer{}, Config: tlsConfig}).DialContext(dialCtx, "tcp", server.Address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error connecting to plugin server %s", server.Address)
+	}
+	conn.Close()
+
+	clientConn, err := grpc.DialContext(dialCtx, server.Address,
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                remoteKeepaliveTime,
+			Timeout:             remoteKeepaliveTimeout,
+			PermitWithoutStream: true,
+		}),
+		grpc.WithBlock(),
+		grpc.FailOnNonTempDialError(true),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error connecting to plugin server %s", server.Address)
+	}
+
+	p := &remoteProcess{
+		ctx:          ctx,
+		clientConn:   clientConn,
+		plugins:      newClientPlugins(ctx, logger.WithField("address", server.Address)),
+		disconnected: make(chan struct{}),
+	}
+	go p.closeWhenDisconnected()
+
+	return p, nil
+}
+
+// closeWhenDisconnected closes the connection once it's no longer ready, including when the server stops answering
+// keepalive pings, and the process is considered exited. gRPC
+// would reconnect, but possibly to a restarted server, or to another replica behind the same address, whose plugins
+// haven't been initialized for this client. A new connection is made, and the plugins reinitialized, instead.
+func (p *remoteProcess) closeWhenDisconnected() {
+	p.clientConn.WaitForStateChange(context.Background(), connectivity.Ready)
+	p.clientConn.Close()
+	close(p.disconnected)
+}
+
+func (p *remoteProcess) dispense(key kindAndName) (interface{}, error) {
+	plugin, ok := p.plugins[key.kind.String()].(hcplugin.GRPCPlugin)
+	if !ok {
+		return nil, errors.Errorf("unknown plugin kind %s", key.kind)
+	}
+
+	dispensed, err := plugin.GRPCClient(p.ctx, nil, p.clientConn)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return clientFor(dispensed, key)
+}
+
+func (p *remoteProcess) exited() bool {
+	select {
+	case <-p.disconnected:
+		return true
+	default:
+		return false
+	}
+}
+
+func (p *remoteProcess) kill() {
+	p.clientConn.Close()
+}
@@ -0,0 +1,145 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// newRemotePluginListerServer serves a PluginLister that lists plugins over mutual TLS, and returns the
+// RemotePluginServer for it, along with the gRPC server.
+func newRemotePluginListerServer(t *testing.T, plugins ...framework.PluginIdentifier) (RemotePluginServer, *grpc.Server) {
+	certs := test.NewTLSCertificates("127.0.0.1")
+
+	cert, err := tls.X509KeyPair(certs.ServerCert, certs.ServerKey)
+	require.NoError(t, err)
+	clientCAs := x509.NewCertPool()
+	require.True(t, clientCAs.AppendCertsFromPEM(certs.CA))
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+	})))
+	require.NoError(t, framework.NewPluginListerPlugin(framework.NewPluginLister(plugins...)).GRPCServer(nil, server))
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	tlsConfig, err := framework.ClientTLSConfig(certs.ClientCert, certs.ClientKey, certs.CA, "")
+	require.NoError(t, err)
+
+	return RemotePluginServer{Address: listener.Addr().String(), LoadTLSConfig: func() (*tls.Config, error) { return tlsConfig, nil }}, server
+}
+
+func TestDiscoverRemotePlugins(t *testing.T) {
+	server, _ := newRemotePluginListerServer(t,
+		framework.PluginIdentifier{Command: "/plugins/example", Kind: framework.PluginKindObjectStore, Name: "example.io/remote"},
+		framework.PluginIdentifier{Command: "/plugins/example", Kind: framework.PluginKindBackupItemAction, Name: "example.io/remote"},
+	)
+
+	r := NewRegistry("/plugins", test.NewLogger(), logrus.InfoLevel).(*registry)
+
+	plugins, err := r.DiscoverRemotePlugins(server)
+	require.NoError(t, err)
+	expected := []framework.PluginIdentifier{
+		{Command: server.Address, Kind: framework.PluginKindObjectStore, Name: "example.io/remote"},
+		{Command: server.Address, Kind: framework.PluginKindBackupItemAction, Name: "example.io/remote"},
+	}
+	assert.Equal(t, expected, plugins)
+
+	id, err := r.Get(framework.PluginKindObjectStore, "example.io/remote")
+	require.NoError(t, err)
+	assert.Equal(t, expected[0], id)
+
+	registered, found := r.RemoteServer(server.Address)
+	assert.True(t, found)
+	assert.Equal(t, server.Address, registered.Address)
+	_, found = r.RemoteServer("/plugins/example")
+	assert.False(t, found)
+
+	// none of a server's plugins are registered if any of them is already
+	other, _ := newRemotePluginListerServer(t,
+		framework.PluginIdentifier{Kind: framework.PluginKindRestoreItemAction, Name: "example.io/other"},
+		framework.PluginIdentifier{Kind: framework.PluginKindObjectStore, Name: "example.io/remote"},
+	)
+	_, err = r.DiscoverRemotePlugins(other)
+	assert.Error(t, err)
+	_, err = r.Get(framework.PluginKindRestoreItemAction, "example.io/other")
+	assert.Error(t, err)
+	_, found = r.RemoteServer(other.Address)
+	assert.False(t, found)
+
+	// the plugins of a removed server are unregistered, and can be discovered again
+	r.RemoveRemotePlugins(server.Address)
+	_, err = r.Get(framework.PluginKindObjectStore, "example.io/remote")
+	assert.Error(t, err)
+	assert.Empty(t, r.List(framework.PluginKindObjectStore))
+	_, found = r.RemoteServer(server.Address)
+	assert.False(t, found)
+
+	_, err = r.DiscoverRemotePlugins(other)
+	require.NoError(t, err)
+	_, err = r.Get(framework.PluginKindRestoreItemAction, "example.io/other")
+	assert.NoError(t, err)
+}
+
+func TestDiscoverRemotePluginsRequiresTrustedServer(t *testing.T) {
+	server, _ := newRemotePluginListerServer(t)
+
+	// a certificate authority that didn't sign the server's certificate
+	certs := test.NewTLSCertificates("127.0.0.1")
+	tlsConfig, err := framework.ClientTLSConfig(certs.ClientCert, certs.ClientKey, certs.CA, "")
+	require.NoError(t, err)
+	server.LoadTLSConfig = func() (*tls.Config, error) { return tlsConfig, nil }
+
+	r := NewRegistry("/plugins", test.NewLogger(), logrus.InfoLevel)
+	_, err = r.DiscoverRemotePlugins(server)
+	assert.Error(t, err)
+}
+
+func TestRemoteProcessExitsWhenDisconnected(t *testing.T) {
+	server, grpcServer := newRemotePluginListerServer(t)
+
+	process, err := newRemoteProcess(context.Background(), server, test.NewLogger())
+	require.NoError(t, err)
+	defer process.kill()
+
+	lister, err := process.dispense(kindAndName{kind: framework.PluginKindPluginLister})
+	require.NoError(t, err)
+	_, err = lister.(framework.PluginLister).ListPlugins()
+	require.NoError(t, err)
+	assert.False(t, process.exited())
+
+	grpcServer.Stop()
+	assert.Eventually(t, process.exited, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,145 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/backup/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+type mockBackupItemActionV2 struct {
+	mock.Mock
+}
+
+func (a *mockBackupItemActionV2) AppliesTo() (velero.ResourceSelector, error) {
+	args := a.Called()
+	return args.Get(0).(velero.ResourceSelector), args.Error(1)
+}
+
+func (a *mockBackupItemActionV2) Execute(ctx context.Context, item runtime.Unstructured, backup *v1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+	args := a.Called(ctx, item, backup)
+	var updated runtime.Unstructured
+	if args.Get(0) != nil {
+		updated = args.Get(0).(runtime.Unstructured)
+	}
+	var additionalItems []velero.ResourceIdentifier
+	if args.Get(1) != nil {
+		additionalItems = args.Get(1).([]velero.ResourceIdentifier)
+	}
+	return updated, additionalItems, args.Error(2)
+}
+
+func TestRestartableGetBackupItemActionV2(t *testing.T) {
+	tests := []struct {
+		name          string
+		plugin        interface{}
+		expectedError string
+	}{
+		{
+			name:          "version 1 plugin",
+			plugin:        new(mocks.ItemAction),
+			expectedError: "*mocks.ItemAction is not a BackupItemActionV2!",
+		},
+		{
+			name:   "happy path",
+			plugin: new(mockBackupItemActionV2),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := new(mockRestartableProcess)
+			defer p.AssertExpectations(t)
+
+			name := "pod"
+			key := kindAndName{kind: framework.PluginKindBackupItemActionV2, name: name}
+			p.On("getByKindAndName", key).Return(tc.plugin, nil)
+
+			r := newRestartableBackupItemActionV2(name, p)
+			a, err := r.getBackupItemAction()
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.plugin, a)
+		})
+	}
+}
+
+func TestRestartableBackupItemActionV2DelegatedFunctions(t *testing.T) {
+	ctx := context.Background()
+	b := new(v1.Backup)
+
+	pv := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"color": "blue",
+		},
+	}
+
+	pvToReturn := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"color": "green",
+		},
+	}
+
+	additionalItems := []velero.ResourceIdentifier{
+		{
+			GroupResource: schema.GroupResource{Group: "velero.io", Resource: "backups"},
+		},
+	}
+
+	runRestartableDelegateTests(
+		t,
+		framework.PluginKindBackupItemActionV2,
+		func(key kindAndName, p RestartableProcess) interface{} {
+			return &restartableBackupItemActionV2{
+				key:                 key,
+				sharedPluginProcess: p,
+			}
+		},
+		func() mockable {
+			return new(mockBackupItemActionV2)
+		},
+		restartableDelegateTest{
+			function:                "AppliesTo",
+			inputs:                  []interface{}{},
+			expectedErrorOutputs:    []interface{}{velero.ResourceSelector{}, errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{velero.ResourceSelector{IncludedNamespaces: []string{"a"}}, errors.Errorf("delegate error")},
+		},
+		restartableDelegateTest{
+			function:                "Execute",
+			inputs:                  []interface{}{ctx, pv, b},
+			expectedErrorOutputs:    []interface{}{nil, ([]velero.ResourceIdentifier)(nil), errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{pvToReturn, additionalItems, errors.Errorf("delegate error")},
+		},
+	)
+}
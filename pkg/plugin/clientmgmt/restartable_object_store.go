@@ -36,6 +36,9 @@ type restartableObjectStore struct {
 	// config contains the data used to initialize the plugin. It is used to reinitialize the plugin in the event its
 	// sharedPluginProcess gets restarted.
 	config map[string]string
+	// capabilities are the capabilities the plugin reported, if any. Optional methods for features the
+	// plugin doesn't support aren't called.
+	capabilities *framework.PluginCapabilities
 }
 
 // newRestartableObjectStore returns a new restartableObjectStore.
@@ -173,3 +176,60 @@ func (r *restartableObjectStore) CreateSignedURL(bucket string, key string, ttl
 	}
 	return delegate.CreateSignedURL(bucket, key, ttl)
 }
+
+// PutObjectStream restarts the plugin's process if needed, then delegates the call. If the delegate
+// doesn't support streaming uploads, velero.ErrObjectStreamingNotSupported is returned.
+func (r *restartableObjectStore) PutObjectStream(bucket string, key string, body io.Reader) error {
+	if !supports(r.capabilities, velero.PluginFeatureStreamingUpload) {
+		return velero.ErrObjectStreamingNotSupported
+	}
+
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return err
+	}
+
+	streamer, ok := delegate.(velero.ObjectStreamer)
+	if !ok {
+		return velero.ErrObjectStreamingNotSupported
+	}
+	return streamer.PutObjectStream(bucket, key, body)
+}
+
+// PutObjectWithRetention restarts the plugin's process if needed, then delegates the call. If the
+// delegate can't lock objects, velero.ErrObjectRetentionNotSupported is returned.
+func (r *restartableObjectStore) PutObjectWithRetention(bucket string, key string, body io.Reader, retention velero.ObjectRetention) error {
+	if !supports(r.capabilities, velero.PluginFeatureObjectRetention) {
+		return velero.ErrObjectRetentionNotSupported
+	}
+
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return err
+	}
+
+	retainer, ok := delegate.(velero.ObjectRetainer)
+	if !ok {
+		return velero.ErrObjectRetentionNotSupported
+	}
+	return retainer.PutObjectWithRetention(bucket, key, body, retention)
+}
+
+// CheckObjectLock restarts the plugin's process if needed, then delegates the call. If the
+// delegate can't lock objects, velero.ErrObjectRetentionNotSupported is returned.
+func (r *restartableObjectStore) CheckObjectLock(bucket string, key string) error {
+	if !supports(r.capabilities, velero.PluginFeatureObjectRetention) {
+		return velero.ErrObjectRetentionNotSupported
+	}
+
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return err
+	}
+
+	retainer, ok := delegate.(velero.ObjectRetainer)
+	if !ok {
+		return velero.ErrObjectRetentionNotSupported
+	}
+	return retainer.CheckObjectLock(bucket, key)
+}
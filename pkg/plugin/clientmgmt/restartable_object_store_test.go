@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
 )
 
@@ -202,6 +203,24 @@ func TestRestartableObjectStoreDelegatedFunctions(t *testing.T) {
 			expectedErrorOutputs:    []interface{}{errors.Errorf("reset error")},
 			expectedDelegateOutputs: []interface{}{errors.Errorf("delegate error")},
 		},
+		restartableDelegateTest{
+			function:                "PutObjectStream",
+			inputs:                  []interface{}{"bucket", "key", strings.NewReader("body")},
+			expectedErrorOutputs:    []interface{}{errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{errors.Errorf("delegate error")},
+		},
+		restartableDelegateTest{
+			function:                "PutObjectWithRetention",
+			inputs:                  []interface{}{"bucket", "key", strings.NewReader("body"), velero.ObjectRetention{LegalHold: true}},
+			expectedErrorOutputs:    []interface{}{errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{errors.Errorf("delegate error")},
+		},
+		restartableDelegateTest{
+			function:                "CheckObjectLock",
+			inputs:                  []interface{}{"bucket", "key"},
+			expectedErrorOutputs:    []interface{}{errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{errors.Errorf("delegate error")},
+		},
 		restartableDelegateTest{
 			function:                "GetObject",
 			inputs:                  []interface{}{"bucket", "key"},
@@ -234,3 +253,80 @@ func TestRestartableObjectStoreDelegatedFunctions(t *testing.T) {
 		},
 	)
 }
+
+// nonStreamingObjectStore hides every method of its ObjectStore other than
+// those of the ObjectStore interface.
+type nonStreamingObjectStore struct {
+	velero.ObjectStore
+}
+
+func TestRestartableObjectStorePutObjectStreamNotSupported(t *testing.T) {
+	p := new(mockRestartableProcess)
+	p.Test(t)
+	defer p.AssertExpectations(t)
+
+	name := "aws"
+	key := kindAndName{kind: framework.PluginKindObjectStore, name: name}
+	r := &restartableObjectStore{
+		key:                 key,
+		sharedPluginProcess: p,
+	}
+
+	objectStore := new(providermocks.ObjectStore)
+	objectStore.Test(t)
+	defer objectStore.AssertExpectations(t)
+
+	p.On("resetIfNeeded").Return(nil)
+	p.On("getByKindAndName", key).Return(&nonStreamingObjectStore{ObjectStore: objectStore}, nil)
+
+	err := r.PutObjectStream("bucket", "key", strings.NewReader("body"))
+	assert.Equal(t, velero.ErrObjectStreamingNotSupported, err)
+}
+
+func TestRestartableObjectStorePutObjectWithRetentionNotSupported(t *testing.T) {
+	p := new(mockRestartableProcess)
+	p.Test(t)
+	defer p.AssertExpectations(t)
+
+	name := "aws"
+	key := kindAndName{kind: framework.PluginKindObjectStore, name: name}
+	r := &restartableObjectStore{
+		key:                 key,
+		sharedPluginProcess: p,
+	}
+
+	objectStore := new(providermocks.ObjectStore)
+	objectStore.Test(t)
+	defer objectStore.AssertExpectations(t)
+
+	p.On("resetIfNeeded").Return(nil)
+	p.On("getByKindAndName", key).Return(&nonStreamingObjectStore{ObjectStore: objectStore}, nil)
+
+	err := r.PutObjectWithRetention("bucket", "key", strings.NewReader("body"), velero.ObjectRetention{LegalHold: true})
+	assert.Equal(t, velero.ErrObjectRetentionNotSupported, err)
+
+	err = r.CheckObjectLock("bucket", "key")
+	assert.Equal(t, velero.ErrObjectRetentionNotSupported, err)
+}
+
+func TestRestartableObjectStoreSkipsUnsupportedFeatures(t *testing.T) {
+	// the process isn't used, since the plugin's capabilities rule out the calls
+	p := new(mockRestartableProcess)
+	p.Test(t)
+	defer p.AssertExpectations(t)
+
+	r := &restartableObjectStore{
+		key:                 kindAndName{kind: framework.PluginKindObjectStore, name: "aws"},
+		sharedPluginProcess: p,
+		capabilities:        &framework.PluginCapabilities{APIVersion: framework.PluginAPIVersion},
+	}
+
+	err := r.PutObjectStream("bucket", "key", strings.NewReader("body"))
+	assert.Equal(t, velero.ErrObjectStreamingNotSupported, err)
+
+	err = r.PutObjectWithRetention("bucket", "key", strings.NewReader("body"), velero.ObjectRetention{LegalHold: true})
+	assert.Equal(t, velero.ErrObjectRetentionNotSupported, err)
+
+	err = r.CheckObjectLock("bucket", "key")
+	assert.Equal(t, velero.ErrObjectRetentionNotSupported, err)
+}
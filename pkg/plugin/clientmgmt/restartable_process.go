@@ -17,25 +17,36 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"context"
 	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 )
 
 type RestartableProcessFactory interface {
 	newRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error)
+	newRemoteRestartableProcess(server RemotePluginServer, logger logrus.FieldLogger) (RestartableProcess, error)
 }
 
 type restartableProcessFactory struct {
+	ctx context.Context
 }
 
-func newRestartableProcessFactory() RestartableProcessFactory {
-	return &restartableProcessFactory{}
+// newRestartableProcessFactory returns a RestartableProcessFactory whose processes' plugin calls are canceled when
+// ctx is done.
+func newRestartableProcessFactory(ctx context.Context) RestartableProcessFactory {
+	return &restartableProcessFactory{ctx: ctx}
 }
 
 func (rpf *restartableProcessFactory) newRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error) {
-	return newRestartableProcess(command, logger, logLevel)
+	return newRestartableProcess(rpf.ctx, command, logger, logLevel)
+}
+
+func (rpf *restartableProcessFactory) newRemoteRestartableProcess(server RemotePluginServer, logger logrus.FieldLogger) (RestartableProcess, error) {
+	return newRemoteRestartableProcess(rpf.ctx, server, logger)
 }
 
 type RestartableProcess interface {
@@ -46,13 +57,17 @@ type RestartableProcess interface {
 	stop()
 }
 
-// restartableProcess encapsulates the lifecycle for all plugins contained in a single executable file. It is able
-// to restart a plugin process if it is terminated for any reason. If this happens, all plugins are reinitialized using
-// the original configuration data.
+// restartableProcess encapsulates the lifecycle for all plugins contained in a single executable file, or served by a
+// single remote plugin server. It is able to restart a plugin process, or reconnect to a remote plugin server, if it is
+// terminated or disconnected for any reason. If this happens, all plugins are reinitialized using the original
+// configuration data.
 type restartableProcess struct {
+	ctx      context.Context
 	command  string
 	logger   logrus.FieldLogger
 	logLevel logrus.Level
+	// server is the remote plugin server that serves the plugins, if they aren't run from command.
+	server *RemotePluginServer
 
 	// lock guards all of the fields below
 	lock           sync.RWMutex
@@ -68,9 +83,11 @@ type reinitializer interface {
 	reinitialize(dispensed interface{}) error
 }
 
-// newRestartableProcess creates a new restartableProcess for the given command and options.
-func newRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error) {
+// newRestartableProcess creates a new restartableProcess for the given command and options. Calls to its plugins are
+// canceled, and it isn't restarted, once ctx is done.
+func newRestartableProcess(ctx context.Context, command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error) {
 	p := &restartableProcess{
+		ctx:            ctx,
 		command:        command,
 		logger:         logger,
 		logLevel:       logLevel,
@@ -84,6 +101,24 @@ func newRestartableProcess(command string, logger logrus.FieldLogger, logLevel l
 	return p, err
 }
 
+// newRemoteRestartableProcess creates a new restartableProcess for the plugins served by server, which is identified
+// by its address in place of a command. Calls to its plugins are canceled, and it isn't reconnected, once ctx is done.
+func newRemoteRestartableProcess(ctx context.Context, server RemotePluginServer, logger logrus.FieldLogger) (RestartableProcess, error) {
+	p := &restartableProcess{
+		ctx:            ctx,
+		command:        server.Address,
+		logger:         logger,
+		server:         &server,
+		plugins:        make(map[kindAndName]interface{}),
+		reinitializers: make(map[kindAndName]reinitializer),
+	}
+
+	// This connects to the server
+	err := p.reset()
+
+	return p, err
+}
+
 // addReinitializer registers the reinitializer r for key.
 func (p *restartableProcess) addReinitializer(key kindAndName, r reinitializer) {
 	p.lock.Lock()
@@ -108,8 +143,17 @@ func (p *restartableProcess) resetLH() error {
 	if p.resetFailures > 10 {
 		return errors.Errorf("unable to restart plugin process: exceeded maximum number of reset failures")
 	}
+	if err := p.ctx.Err(); err != nil {
+		return errors.Wrap(err, "unable to restart plugin process")
+	}
 
-	process, err := newProcess(p.command, p.logger, p.logLevel)
+	var process Process
+	var err error
+	if p.server != nil {
+		process, err = newRemoteProcess(p.ctx, *p.server, p.logger)
+	} else {
+		process, err = newProcess(p.ctx, p.command, p.logger, p.logLevel)
+	}
 	if err != nil {
 		p.resetFailures++
 		return err
@@ -153,6 +197,9 @@ func (p *restartableProcess) resetIfNeeded() error {
 
 	if p.process.exited() {
 		p.logger.Info("Plugin process exited - restarting.")
+		if metrics := framework.PluginMetricsFrom(p.ctx); metrics != nil {
+			metrics.RegisterPluginProcessRestart(p.command)
+		}
 		return p.resetLH()
 	}
 
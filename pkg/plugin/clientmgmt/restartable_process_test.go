@@ -0,0 +1,69 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestRestartableProcessIsNotRestartedOnceContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := newRestartableProcess(ctx, "/does/not/exist", test.NewLogger(), logrus.InfoLevel)
+	assert.EqualError(t, err, "unable to restart plugin process: context canceled")
+}
+
+// exitedProcess is a Process that has exited.
+type exitedProcess struct{}
+
+func (p *exitedProcess) dispense(key kindAndName) (interface{}, error) { return nil, nil }
+func (p *exitedProcess) exited() bool                                  { return true }
+func (p *exitedProcess) kill()                                         {}
+
+type restartCountingMetrics struct {
+	framework.PluginMetrics
+	restarts []string
+}
+
+func (m *restartCountingMetrics) RegisterPluginProcessRestart(command string) {
+	m.restarts = append(m.restarts, command)
+}
+
+func TestRestartableProcessRecordsRestarts(t *testing.T) {
+	metrics := new(restartCountingMetrics)
+	// the context is done so that the process isn't actually restarted
+	ctx, cancel := context.WithCancel(framework.WithPluginMetrics(context.Background(), metrics))
+	cancel()
+
+	p := &restartableProcess{
+		ctx:     ctx,
+		command: "/velero-plugins",
+		logger:  test.NewLogger(),
+		process: new(exitedProcess),
+	}
+
+	assert.Error(t, p.resetIfNeeded())
+	assert.Equal(t, []string{"/velero-plugins"}, metrics.restarts)
+}
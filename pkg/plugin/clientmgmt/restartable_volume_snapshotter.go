@@ -32,6 +32,9 @@ type restartableVolumeSnapshotter struct {
 	key                 kindAndName
 	sharedPluginProcess RestartableProcess
 	config              map[string]string
+	// capabilities are the capabilities the plugin reported, if any. Optional methods for features the
+	// plugin doesn't support aren't called.
+	capabilities *framework.PluginCapabilities
 }
 
 // newRestartableVolumeSnapshotter returns a new restartableVolumeSnapshotter.
@@ -151,6 +154,63 @@ func (r *restartableVolumeSnapshotter) CreateSnapshot(volumeID string, volumeAZ
 	return delegate.CreateSnapshot(volumeID, volumeAZ, tags)
 }
 
+// CreateGroupSnapshot restarts the plugin's process if needed, then delegates the call. If the delegate
+// doesn't support group snapshots, velero.ErrGroupSnapshotNotSupported is returned.
+func (r *restartableVolumeSnapshotter) CreateGroupSnapshot(volumeAZs map[string]string, tags map[string]string) (map[string]string, error) {
+	if !supports(r.capabilities, velero.PluginFeatureGroupSnapshots) {
+		return nil, velero.ErrGroupSnapshotNotSupported
+	}
+
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+
+	groupSnapshotter, ok := delegate.(velero.GroupVolumeSnapshotter)
+	if !ok {
+		return nil, velero.ErrGroupSnapshotNotSupported
+	}
+	return groupSnapshotter.CreateGroupSnapshot(volumeAZs, tags)
+}
+
+// SnapshotProgress restarts the plugin's process if needed, then delegates the call. If the delegate
+// doesn't track the progress of its snapshots, velero.ErrSnapshotProgressNotSupported is returned.
+func (r *restartableVolumeSnapshotter) SnapshotProgress(snapshotID string) (*velero.SnapshotProgress, error) {
+	if !supports(r.capabilities, velero.PluginFeatureAsyncSnapshots) {
+		return nil, velero.ErrSnapshotProgressNotSupported
+	}
+
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+
+	progressReporter, ok := delegate.(velero.SnapshotProgressReporter)
+	if !ok {
+		return nil, velero.ErrSnapshotProgressNotSupported
+	}
+	return progressReporter.SnapshotProgress(snapshotID)
+}
+
+// CopySnapshot restarts the plugin's process if needed, then delegates the call. If the delegate
+// doesn't copy snapshots, velero.ErrCopySnapshotNotSupported is returned.
+func (r *restartableVolumeSnapshotter) CopySnapshot(snapshotID, volumeAZ string, config map[string]string) (string, error) {
+	if !supports(r.capabilities, velero.PluginFeatureSnapshotCopy) {
+		return "", velero.ErrCopySnapshotNotSupported
+	}
+
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return "", err
+	}
+
+	copier, ok := delegate.(velero.SnapshotCopier)
+	if !ok {
+		return "", velero.ErrCopySnapshotNotSupported
+	}
+	return copier.CopySnapshot(snapshotID, volumeAZ, config)
+}
+
 // DeleteSnapshot restarts the plugin's process if needed, then delegates the call.
 func (r *restartableVolumeSnapshotter) DeleteSnapshot(snapshotID string) error {
 	delegate, err := r.getDelegate()
@@ -26,7 +26,9 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
 func TestRestartableGetVolumeSnapshotter(t *testing.T) {
@@ -237,6 +239,24 @@ func TestRestartableVolumeSnapshotterDelegatedFunctions(t *testing.T) {
 			expectedErrorOutputs:    []interface{}{"", errors.Errorf("reset error")},
 			expectedDelegateOutputs: []interface{}{"snapshotID", errors.Errorf("delegate error")},
 		},
+		restartableDelegateTest{
+			function:                "CreateGroupSnapshot",
+			inputs:                  []interface{}{map[string]string{"volumeID": "volumeAZ"}, map[string]string{"a": "b"}},
+			expectedErrorOutputs:    []interface{}{map[string]string(nil), errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{map[string]string{"volumeID": "snapshotID"}, errors.Errorf("delegate error")},
+		},
+		restartableDelegateTest{
+			function:                "SnapshotProgress",
+			inputs:                  []interface{}{"snapshotID"},
+			expectedErrorOutputs:    []interface{}{(*velero.SnapshotProgress)(nil), errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{&velero.SnapshotProgress{Completed: true}, errors.Errorf("delegate error")},
+		},
+		restartableDelegateTest{
+			function:                "CopySnapshot",
+			inputs:                  []interface{}{"snapshotID", "volumeAZ", map[string]string{"region": "us-west-2"}},
+			expectedErrorOutputs:    []interface{}{"", errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{"copyID", errors.Errorf("delegate error")},
+		},
 		restartableDelegateTest{
 			function:                "DeleteSnapshot",
 			inputs:                  []interface{}{"snapshotID"},
@@ -245,3 +265,63 @@ func TestRestartableVolumeSnapshotterDelegatedFunctions(t *testing.T) {
 		},
 	)
 }
+
+func TestRestartableVolumeSnapshotterCreateGroupSnapshotNotSupported(t *testing.T) {
+	p := new(mockRestartableProcess)
+	p.Test(t)
+	defer p.AssertExpectations(t)
+
+	name := "aws"
+	key := kindAndName{kind: framework.PluginKindVolumeSnapshotter, name: name}
+	r := &restartableVolumeSnapshotter{
+		key:                 key,
+		sharedPluginProcess: p,
+	}
+
+	p.On("resetIfNeeded").Return(nil)
+	p.On("getByKindAndName", key).Return(new(velerotest.FakeVolumeSnapshotter), nil)
+
+	snapshotIDs, err := r.CreateGroupSnapshot(map[string]string{"volumeID": "volumeAZ"}, nil)
+	assert.Nil(t, snapshotIDs)
+	assert.Equal(t, velero.ErrGroupSnapshotNotSupported, err)
+}
+
+func TestRestartableVolumeSnapshotterSnapshotProgressNotSupported(t *testing.T) {
+	p := new(mockRestartableProcess)
+	p.Test(t)
+	defer p.AssertExpectations(t)
+
+	name := "aws"
+	key := kindAndName{kind: framework.PluginKindVolumeSnapshotter, name: name}
+	r := &restartableVolumeSnapshotter{
+		key:                 key,
+		sharedPluginProcess: p,
+	}
+
+	p.On("resetIfNeeded").Return(nil)
+	p.On("getByKindAndName", key).Return(new(velerotest.FakeVolumeSnapshotter), nil)
+
+	progress, err := r.SnapshotProgress("snapshotID")
+	assert.Nil(t, progress)
+	assert.Equal(t, velero.ErrSnapshotProgressNotSupported, err)
+}
+
+func TestRestartableVolumeSnapshotterCopySnapshotNotSupported(t *testing.T) {
+	p := new(mockRestartableProcess)
+	p.Test(t)
+	defer p.AssertExpectations(t)
+
+	name := "aws"
+	key := kindAndName{kind: framework.PluginKindVolumeSnapshotter, name: name}
+	r := &restartableVolumeSnapshotter{
+		key:                 key,
+		sharedPluginProcess: p,
+	}
+
+	p.On("resetIfNeeded").Return(nil)
+	p.On("getByKindAndName", key).Return(new(velerotest.FakeVolumeSnapshotter), nil)
+
+	copyID, err := r.CopySnapshot("snapshotID", "volumeAZ", map[string]string{"region": "us-west-2"})
+	assert.Empty(t, copyID)
+	assert.Equal(t, velero.ErrCopySnapshotNotSupported, err)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -53,11 +54,27 @@ func newBackupItemActionGRPCClient(base *clientBase, clientConn *grpc.ClientConn
 }
 
 func (c *BackupItemActionGRPCClient) AppliesTo() (velero.ResourceSelector, error) {
+	ctx, done := c.callContext("AppliesTo")
+	selector, err := callBackupItemActionAppliesTo(ctx, c.grpcClient, c.plugin)
+	done(err)
+	return selector, err
+}
+
+func (c *BackupItemActionGRPCClient) Execute(item runtime.Unstructured, backup *api.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+	ctx, done := c.callContext("Execute")
+	updatedItem, additionalItems, err := callBackupItemActionExecute(ctx, c.grpcClient, c.plugin, item, backup)
+	done(err)
+	return updatedItem, additionalItems, err
+}
+
+// callBackupItemActionAppliesTo calls AppliesTo on grpcClient, which may be a client
+// for any version of the BackupItemAction service since they share their messages.
+func callBackupItemActionAppliesTo(ctx context.Context, grpcClient proto.BackupItemActionClient, plugin string) (velero.ResourceSelector, error) {
 	req := &proto.BackupItemActionAppliesToRequest{
-		Plugin: c.plugin,
+		Plugin: plugin,
 	}
 
-	res, err := c.grpcClient.AppliesTo(context.Background(), req)
+	res, err := grpcClient.AppliesTo(ctx, req)
 	if err != nil {
 		return velero.ResourceSelector{}, fromGRPCError(err)
 	}
@@ -75,7 +92,9 @@ func (c *BackupItemActionGRPCClient) AppliesTo() (velero.ResourceSelector, error
 	}, nil
 }
 
-func (c *BackupItemActionGRPCClient) Execute(item runtime.Unstructured, backup *api.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+// callBackupItemActionExecute calls Execute on grpcClient, which may be a client
+// for any version of the BackupItemAction service since they share their messages.
+func callBackupItemActionExecute(ctx context.Context, grpcClient proto.BackupItemActionClient, plugin string, item runtime.Unstructured, backup *api.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
 	itemJSON, err := json.Marshal(item.UnstructuredContent())
 	if err != nil {
 		return nil, nil, errors.WithStack(err)
@@ -87,13 +106,18 @@ func (c *BackupItemActionGRPCClient) Execute(item runtime.Unstructured, backup *
 	}
 
 	req := &proto.ExecuteRequest{
-		Plugin: c.plugin,
+		Plugin: plugin,
 		Item:   itemJSON,
 		Backup: backupJSON,
 	}
 
-	res, err := c.grpcClient.Execute(context.Background(), req)
+	res, err := grpcClient.Execute(ctx, req)
 	if err != nil {
+		// actions may fail the call themselves once its context is done, which
+		// is reported as the context's error
+		if ctx.Err() != nil {
+			err = status.FromContextError(ctx.Err()).Err()
+		}
 		return nil, nil, fromGRPCError(err)
 	}
 
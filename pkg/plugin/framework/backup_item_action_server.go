@@ -34,8 +34,8 @@ type BackupItemActionGRPCServer struct {
 	mux *serverMux
 }
 
-func (s *BackupItemActionGRPCServer) getImpl(name string) (velero.BackupItemAction, error) {
-	impl, err := s.mux.getHandler(name)
+func (s *BackupItemActionGRPCServer) getImpl(ctx context.Context, name string) (velero.BackupItemAction, error) {
+	impl, err := s.mux.getHandler(ctx, name)
 	if err != nil {
 		return nil, err
 	}
@@ -55,11 +55,34 @@ func (s *BackupItemActionGRPCServer) AppliesTo(ctx context.Context, req *proto.B
 		}
 	}()
 
-	impl, err := s.getImpl(req.Plugin)
+	impl, err := s.getImpl(ctx, req.Plugin)
 	if err != nil {
 		return nil, newGRPCError(err)
 	}
 
+	return serveBackupItemActionAppliesTo(impl)
+}
+
+func (s *BackupItemActionGRPCServer) Execute(ctx context.Context, req *proto.ExecuteRequest) (response *proto.ExecuteResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(ctx, req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return serveBackupItemActionExecute(ctx, velero.BackupItemActionV1ToV2(impl), req)
+}
+
+// serveBackupItemActionAppliesTo calls AppliesTo on impl, and converts the result for
+// any version of the BackupItemAction service.
+func serveBackupItemActionAppliesTo(impl interface {
+	AppliesTo() (velero.ResourceSelector, error)
+}) (*proto.BackupItemActionAppliesToResponse, error) {
 	resourceSelector, err := impl.AppliesTo()
 	if err != nil {
 		return nil, newGRPCError(err)
@@ -76,18 +99,9 @@ func (s *BackupItemActionGRPCServer) AppliesTo(ctx context.Context, req *proto.B
 	}, nil
 }
 
-func (s *BackupItemActionGRPCServer) Execute(ctx context.Context, req *proto.ExecuteRequest) (response *proto.ExecuteResponse, err error) {
-	defer func() {
-		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
-			err = recoveredErr
-		}
-	}()
-
-	impl, err := s.getImpl(req.Plugin)
-	if err != nil {
-		return nil, newGRPCError(err)
-	}
-
+// serveBackupItemActionExecute calls Execute on impl with the request of any version
+// of the BackupItemAction service, and converts the result.
+func serveBackupItemActionExecute(ctx context.Context, impl velero.BackupItemActionV2, req *proto.ExecuteRequest) (*proto.ExecuteResponse, error) {
 	var item unstructured.Unstructured
 	var backup api.Backup
 
@@ -98,7 +112,7 @@ func (s *BackupItemActionGRPCServer) Execute(ctx context.Context, req *proto.Exe
 		return nil, newGRPCError(errors.WithStack(err))
 	}
 
-	updatedItem, additionalItems, err := impl.Execute(&item, &backup)
+	updatedItem, additionalItems, err := impl.Execute(ctx, &item, &backup)
 	if err != nil {
 		return nil, newGRPCError(err)
 	}
@@ -109,6 +123,7 @@ func (s *BackupItemActionGRPCServer) Execute(ctx context.Context, req *proto.Exe
 	if updatedItem == nil {
 		updatedItemJSON = req.Item
 	} else {
+		var err error
 		updatedItemJSON, err = json.Marshal(updatedItem.UnstructuredContent())
 		if err != nil {
 			return nil, newGRPCError(errors.WithStack(err))
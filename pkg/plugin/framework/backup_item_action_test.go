@@ -155,8 +155,8 @@ func TestBackupItemActionGRPCServerExecute(t *testing.T) {
 
 			s := &BackupItemActionGRPCServer{mux: &serverMux{
 				serverLog: velerotest.NewLogger(),
-				handlers: map[string]interface{}{
-					"xyz": itemAction,
+				handlers: map[handlerKey]interface{}{
+					{name: "xyz"}: itemAction,
 				},
 			}}
 
@@ -0,0 +1,64 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// NewBackupItemActionV2Plugin constructs a BackupItemActionV2Plugin.
+func NewBackupItemActionV2Plugin(options ...PluginOption) *BackupItemActionV2Plugin {
+	return &BackupItemActionV2Plugin{
+		pluginBase: newPluginBase(options...),
+	}
+}
+
+// BackupItemActionV2GRPCClient implements version 2 of the backup/ItemAction
+// interface and uses a gRPC client to make calls to the plugin server.
+type BackupItemActionV2GRPCClient struct {
+	*clientBase
+	grpcClient proto.BackupItemActionV2Client
+}
+
+func newBackupItemActionV2GRPCClient(base *clientBase, clientConn *grpc.ClientConn) interface{} {
+	return &BackupItemActionV2GRPCClient{
+		clientBase: base,
+		grpcClient: proto.NewBackupItemActionV2Client(clientConn),
+	}
+}
+
+func (c *BackupItemActionV2GRPCClient) AppliesTo() (velero.ResourceSelector, error) {
+	ctx, done := c.callContext("AppliesTo")
+	selector, err := callBackupItemActionAppliesTo(ctx, c.grpcClient, c.plugin)
+	done(err)
+	return selector, err
+}
+
+// Execute calls the plugin server with ctx, so the call is canceled on the
+// server when ctx or the client's context is done.
+func (c *BackupItemActionV2GRPCClient) Execute(ctx context.Context, item runtime.Unstructured, backup *api.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+	ctx, done := c.callContextFrom(ctx, "Execute")
+	updatedItem, additionalItems, err := callBackupItemActionExecute(ctx, c.grpcClient, c.plugin, item, backup)
+	done(err)
+	return updatedItem, additionalItems, err
+}
@@ -0,0 +1,75 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// BackupItemActionV2GRPCServer implements the proto-generated BackupItemActionV2 interface, and accepts
+// gRPC calls and forwards them to an implementation of the pluggable interface.
+type BackupItemActionV2GRPCServer struct {
+	mux *serverMux
+}
+
+func (s *BackupItemActionV2GRPCServer) getImpl(ctx context.Context, name string) (velero.BackupItemActionV2, error) {
+	impl, err := s.mux.getHandler(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	itemAction, ok := impl.(velero.BackupItemActionV2)
+	if !ok {
+		return nil, errors.Errorf("%T is not a backup item action v2", impl)
+	}
+
+	return itemAction, nil
+}
+
+func (s *BackupItemActionV2GRPCServer) AppliesTo(ctx context.Context, req *proto.BackupItemActionAppliesToRequest) (response *proto.BackupItemActionAppliesToResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(ctx, req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return serveBackupItemActionAppliesTo(impl)
+}
+
+func (s *BackupItemActionV2GRPCServer) Execute(ctx context.Context, req *proto.ExecuteRequest) (response *proto.ExecuteResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(ctx, req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return serveBackupItemActionExecute(ctx, impl, req)
+}
@@ -0,0 +1,144 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// blockingBackupItemAction is a BackupItemActionV2 whose Execute blocks until its
+// context is done, unless the item is named "nonblocking".
+type blockingBackupItemAction struct{}
+
+func (a *blockingBackupItemAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{IncludedResources: []string{"pods"}}, nil
+}
+
+func (a *blockingBackupItemAction) Execute(ctx context.Context, item runtime.Unstructured, backup *v1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+	if item.(*unstructured.Unstructured).GetName() == "nonblocking" {
+		return item, nil, nil
+	}
+	<-ctx.Done()
+	return nil, nil, ctx.Err()
+}
+
+// newBackupItemActionV2GRPCTestClient serves plugin over an in-memory connection, and
+// returns a client for the backup item action registered with name.
+func newBackupItemActionV2GRPCTestClient(t *testing.T, name string, initializer HandlerInitializer) *BackupItemActionV2GRPCClient {
+	p := NewBackupItemActionV2Plugin(serverLogger(velerotest.NewLogger()))
+	p.register(name, initializer)
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := newGRPCServer(nil)
+	require.NoError(t, p.GRPCServer(nil, server))
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	dialer := func(context.Context, string) (net.Conn, error) {
+		return listener.Dial()
+	}
+	clientConn, err := grpc.Dial("bufnet", grpc.WithContextDialer(dialer), grpc.WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(func() { clientConn.Close() })
+
+	base := &clientBase{kind: PluginKindBackupItemActionV2, plugin: name, logger: velerotest.NewLogger()}
+	return newBackupItemActionV2GRPCClient(base, clientConn).(*BackupItemActionV2GRPCClient)
+}
+
+func TestBackupItemActionV2GRPC(t *testing.T) {
+	client := newBackupItemActionV2GRPCTestClient(t, "velero.io/blocking", func(logrus.FieldLogger) (interface{}, error) {
+		return &blockingBackupItemAction{}, nil
+	})
+
+	selector, err := client.AppliesTo()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pods"}, selector.IncludedResources)
+
+	item := builder.ForPod("ns-1", "nonblocking").Result()
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(item)
+	require.NoError(t, err)
+	updated, _, err := client.Execute(context.Background(), &unstructured.Unstructured{Object: obj}, new(v1.Backup))
+	require.NoError(t, err)
+	assert.Equal(t, "nonblocking", updated.(*unstructured.Unstructured).GetName())
+
+	item = builder.ForPod("ns-1", "blocking").Result()
+	obj, err = runtime.DefaultUnstructuredConverter.ToUnstructured(item)
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, _, err = client.Execute(ctx, &unstructured.Unstructured{Object: obj}, new(v1.Backup))
+	assert.Equal(t, ErrPluginCallTimeout, errors.Cause(err))
+
+	// the client's call timeout applies to calls made with contexts that can't be canceled
+	client.ctx = WithCallTimeout(context.Background(), 100*time.Millisecond)
+	_, _, err = client.Execute(context.TODO(), &unstructured.Unstructured{Object: obj}, new(v1.Backup))
+	assert.Equal(t, ErrPluginCallTimeout, errors.Cause(err))
+
+	// calls are canceled when the client's context is
+	clientCtx, cancelClient := context.WithCancel(context.Background())
+	client.ctx = clientCtx
+	time.AfterFunc(100*time.Millisecond, cancelClient)
+	_, _, err = client.Execute(context.TODO(), &unstructured.Unstructured{Object: obj}, new(v1.Backup))
+	assert.Equal(t, context.Canceled, errors.Cause(err))
+}
+
+// v1BackupItemAction is a version 1 BackupItemAction.
+type v1BackupItemAction struct{}
+
+func (a *v1BackupItemAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{}, nil
+}
+
+func (a *v1BackupItemAction) Execute(item runtime.Unstructured, backup *v1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+	return item, nil, nil
+}
+
+func TestBackupItemActionV2GRPCServerRejectsV1Actions(t *testing.T) {
+	client := newBackupItemActionV2GRPCTestClient(t, "velero.io/v1", func(logrus.FieldLogger) (interface{}, error) {
+		return &v1BackupItemAction{}, nil
+	})
+
+	_, err := client.AppliesTo()
+	assert.Contains(t, err.Error(), "*framework.v1BackupItemAction is not a backup item action v2")
+}
+
+func TestPluginKindVersions(t *testing.T) {
+	assert.Equal(t, PluginKindBackupItemAction, PluginKindBackupItemActionV2.Unversioned())
+	assert.Equal(t, "v2", PluginKindBackupItemActionV2.Version())
+	assert.Equal(t, PluginKindObjectStore, PluginKindObjectStore.Unversioned())
+	assert.Equal(t, PluginAPIVersion, PluginKindObjectStore.Version())
+
+	assert.Equal(t, []PluginKind{PluginKindBackupItemActionV2, PluginKindBackupItemAction}, PluginKindVersions(PluginKindBackupItemAction))
+	assert.Equal(t, []PluginKind{PluginKindObjectStore}, PluginKindVersions(PluginKindObjectStore))
+}
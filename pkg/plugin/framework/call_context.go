@@ -0,0 +1,122 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/context"
+)
+
+// ErrPluginCallTimeout is returned by plugin clients when a call to the plugin
+// server doesn't complete within its timeout.
+var ErrPluginCallTimeout = errors.New("plugin call timed out")
+
+type callTimeoutKey struct{}
+
+// WithCallTimeout returns a copy of ctx that limits each call that plugin clients
+// using it make to the plugin server to timeout. A timeout of zero doesn't limit
+// calls. Streaming calls, which take as long as their data takes to transfer,
+// aren't limited.
+func WithCallTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, callTimeoutKey{}, timeout)
+}
+
+// CallTimeout returns the timeout of calls made by plugin clients using ctx, or
+// zero if they aren't limited.
+func CallTimeout(ctx context.Context) time.Duration {
+	timeout, _ := ctx.Value(callTimeoutKey{}).(time.Duration)
+	return timeout
+}
+
+// ClientContext sets the context of the plugin's clients. Their calls to the
+// plugin server are canceled when ctx is done, and limited to its CallTimeout.
+func ClientContext(ctx context.Context) PluginOption {
+	return func(base *pluginBase) {
+		base.clientContext = ctx
+	}
+}
+
+// context returns the client's context.
+func (c *clientBase) context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
+// callContext returns the context for a unary call of method to the plugin
+// server, which is done when the client's context is done or the call timeout
+// elapses, and a function that must be called with the call's error once it
+// returns. The function releases the context and records the call's span and
+// metrics.
+func (c *clientBase) callContext(method string) (context.Context, func(error)) {
+	return c.callContextFrom(nil, method)
+}
+
+// callContextFrom is like callContext, but the returned context is also done when
+// ctx is. ctx may be nil.
+func (c *clientBase) callContextFrom(ctx context.Context, method string) (context.Context, func(error)) {
+	callCtx, cancel := c.newCallContext(ctx)
+	if ctx != nil {
+		// the call's span is a child of the span of ctx, even if ctx can't be canceled
+		callCtx = trace.ContextWithSpan(callCtx, trace.SpanFromContext(ctx))
+	}
+	callCtx, endSpan := c.startCallSpan(callCtx, method)
+	start := time.Now()
+
+	return callCtx, func(err error) {
+		cancel()
+		endSpan(err)
+		c.observeCall(method, start, err)
+	}
+}
+
+// newCallContext returns a context that is done when ctx or the client's context
+// is done, or the call timeout elapses. ctx may be nil.
+func (c *clientBase) newCallContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	clientCtx := c.context()
+
+	var callCtx context.Context
+	var cancel context.CancelFunc
+	if ctx == nil || ctx.Done() == nil {
+		// ctx can't be canceled, so the client's context is all that matters
+		callCtx, cancel = context.WithCancel(clientCtx)
+	} else {
+		callCtx, cancel = context.WithCancel(ctx)
+		if clientCtx.Done() != nil {
+			go func() {
+				select {
+				case <-clientCtx.Done():
+					cancel()
+				case <-callCtx.Done():
+				}
+			}()
+		}
+	}
+
+	if timeout := CallTimeout(clientCtx); timeout > 0 {
+		timeoutCtx, cancelTimeout := context.WithTimeout(callCtx, timeout)
+		return timeoutCtx, func() {
+			cancelTimeout()
+			cancel()
+		}
+	}
+	return callCtx, cancel
+}
@@ -18,13 +18,17 @@ package framework
 
 import (
 	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 )
 
 // clientBase implements client and contains shared fields common to all clients.
 type clientBase struct {
+	kind   PluginKind
 	plugin string
 	logger logrus.FieldLogger
+	// ctx is the context of calls to the plugin server, see ClientContext.
+	ctx context.Context
 }
 
 type ClientDispenser interface {
@@ -34,8 +38,12 @@ type ClientDispenser interface {
 // clientDispenser supports the initialization and retrieval of multiple implementations for a single plugin kind, such as
 // "aws" and "azure" implementations of the object store plugin.
 type clientDispenser struct {
+	// kind is the kind of the plugin.
+	kind PluginKind
 	// logger is the log the plugin should use.
 	logger logrus.FieldLogger
+	// ctx is the context of the plugin's calls.
+	ctx context.Context
 	// clienConn is shared among all implementations for this client.
 	clientConn *grpc.ClientConn
 	// initFunc returns a client that implements a plugin interface, such as ObjectStore.
@@ -47,10 +55,12 @@ type clientDispenser struct {
 type clientInitFunc func(base *clientBase, clientConn *grpc.ClientConn) interface{}
 
 // newClientDispenser creates a new clientDispenser.
-func newClientDispenser(logger logrus.FieldLogger, clientConn *grpc.ClientConn, initFunc clientInitFunc) *clientDispenser {
+func newClientDispenser(ctx context.Context, kind PluginKind, logger logrus.FieldLogger, clientConn *grpc.ClientConn, initFunc clientInitFunc) *clientDispenser {
 	return &clientDispenser{
 		clientConn: clientConn,
+		kind:       kind,
 		logger:     logger,
+		ctx:        ctx,
 		initFunc:   initFunc,
 		clients:    make(map[string]interface{}),
 	}
@@ -64,8 +74,10 @@ func (cd *clientDispenser) ClientFor(name string) interface{} {
 	}
 
 	base := &clientBase{
+		kind:   cd.kind,
 		plugin: name,
 		logger: cd.logger,
+		ctx:    cd.ctx,
 	}
 	// Initialize the plugin (e.g. newBackupItemActionGRPCClient())
 	client := cd.initFunc(base, cd.clientConn)
@@ -20,6 +20,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 
 	"github.com/vmware-tanzu/velero/pkg/test"
@@ -40,7 +41,7 @@ func TestNewClientDispenser(t *testing.T) {
 		return c
 	}
 
-	cd := newClientDispenser(logger, clientConn, initFunc)
+	cd := newClientDispenser(context.Background(), PluginKindBackupItemAction, logger, clientConn, initFunc)
 	assert.Equal(t, clientConn, cd.clientConn)
 	assert.NotNil(t, cd.clients)
 	assert.Empty(t, cd.clients)
@@ -59,7 +60,7 @@ func TestClientFor(t *testing.T) {
 		return c
 	}
 
-	cd := newClientDispenser(logger, clientConn, initFunc)
+	cd := newClientDispenser(context.Background(), PluginKindBackupItemAction, logger, clientConn, initFunc)
 
 	actual := cd.ClientFor("pod")
 	require.IsType(t, &fakeClient{}, actual)
@@ -67,8 +68,10 @@ func TestClientFor(t *testing.T) {
 	assert.Equal(t, 1, count)
 	assert.Equal(t, &typed, &c)
 	expectedBase := &clientBase{
+		kind:   PluginKindBackupItemAction,
 		plugin: "pod",
 		logger: logger,
+		ctx:    context.Background(),
 	}
 	assert.Equal(t, expectedBase, typed.base)
 	assert.Equal(t, clientConn, typed.clientConn)
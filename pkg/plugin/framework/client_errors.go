@@ -17,9 +17,13 @@ limitations under the License.
 package framework
 
 import (
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
 // fromGRPCError takes a gRPC status error, extracts a stack trace
@@ -36,8 +40,21 @@ func fromGRPCError(err error) error {
 		return statusErr.Err()
 	}
 
+	switch statusErr.Code() {
+	case codes.DeadlineExceeded:
+		return errors.WithStack(ErrPluginCallTimeout)
+	case codes.Canceled:
+		return errors.WithStack(context.Canceled)
+	}
+
 	for _, detail := range statusErr.Details() {
 		switch t := detail.(type) {
+		case *proto.ObjectLocked:
+			return &velero.ObjectLockedError{
+				Key:         t.Key,
+				RetainUntil: timeFromUnixNano(t.RetainUntil),
+				LegalHold:   t.LegalHold,
+			}
 		case *proto.Stack:
 			return &protoStackError{
 				error: err,
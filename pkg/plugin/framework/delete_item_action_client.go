@@ -20,8 +20,8 @@ import (
 	"encoding/json"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
@@ -51,7 +51,9 @@ func newDeleteItemActionGRPCClient(base *clientBase, clientConn *grpc.ClientConn
 }
 
 func (c *DeleteItemActionGRPCClient) AppliesTo() (velero.ResourceSelector, error) {
-	res, err := c.grpcClient.AppliesTo(context.Background(), &proto.DeleteItemActionAppliesToRequest{Plugin: c.plugin})
+	ctx, done := c.callContext("AppliesTo")
+	res, err := c.grpcClient.AppliesTo(ctx, &proto.DeleteItemActionAppliesToRequest{Plugin: c.plugin})
+	done(err)
 	if err != nil {
 		return velero.ResourceSelector{}, fromGRPCError(err)
 	}
@@ -87,7 +89,15 @@ func (c *DeleteItemActionGRPCClient) Execute(input *velero.DeleteItemActionExecu
 	}
 
 	// First return item is just an empty struct no matter what.
-	if _, err = c.grpcClient.Execute(context.Background(), req); err != nil {
+	ctx, done := c.callContext("Execute")
+	_, err = c.grpcClient.Execute(ctx, req)
+	if err != nil && ctx.Err() != nil {
+		// actions may fail the call themselves once its context is done, which
+		// is reported as the context's error
+		err = status.FromContextError(ctx.Err()).Err()
+	}
+	done(err)
+	if err != nil {
 		return fromGRPCError(err)
 	}
 
@@ -34,8 +34,8 @@ type DeleteItemActionGRPCServer struct {
 	mux *serverMux
 }
 
-func (s *DeleteItemActionGRPCServer) getImpl(name string) (velero.DeleteItemAction, error) {
-	impl, err := s.mux.getHandler(name)
+func (s *DeleteItemActionGRPCServer) getImpl(ctx context.Context, name string) (velero.DeleteItemAction, error) {
+	impl, err := s.mux.getHandler(ctx, name)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +55,7 @@ func (s *DeleteItemActionGRPCServer) AppliesTo(ctx context.Context, req *proto.D
 		}
 	}()
 
-	impl, err := s.getImpl(req.Plugin)
+	impl, err := s.getImpl(ctx, req.Plugin)
 	if err != nil {
 		return nil, newGRPCError(err)
 	}
@@ -83,7 +83,7 @@ func (s *DeleteItemActionGRPCServer) Execute(ctx context.Context, req *proto.Del
 		}
 	}()
 
-	impl, err := s.getImpl(req.Plugin)
+	impl, err := s.getImpl(ctx, req.Plugin)
 	if err != nil {
 		return nil, newGRPCError(err)
 	}
@@ -0,0 +1,66 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// slowDeleteItemAction is a DeleteItemAction whose Execute fails after a while.
+type slowDeleteItemAction struct{}
+
+func (a *slowDeleteItemAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{}, nil
+}
+
+func (a *slowDeleteItemAction) Execute(*velero.DeleteItemActionExecuteInput) error {
+	time.Sleep(500 * time.Millisecond)
+	return errors.New("failed")
+}
+
+func TestDeleteItemActionGRPCCallTimeout(t *testing.T) {
+	p := NewDeleteItemActionPlugin(serverLogger(velerotest.NewLogger()))
+	p.register("velero.io/slow", func(logrus.FieldLogger) (interface{}, error) {
+		return &slowDeleteItemAction{}, nil
+	})
+
+	base := &clientBase{
+		kind:   PluginKindDeleteItemAction,
+		plugin: "velero.io/slow",
+		logger: velerotest.NewLogger(),
+		ctx:    WithCallTimeout(context.Background(), 100*time.Millisecond),
+	}
+	pod := &unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": "v1", "kind": "Pod"}}
+	client := newDeleteItemActionGRPCClient(base, serveTestPlugin(t, p)).(*DeleteItemActionGRPCClient)
+
+	err := client.Execute(&velero.DeleteItemActionExecuteInput{
+		Item:   pod,
+		Backup: new(v1.Backup),
+	})
+	assert.Equal(t, ErrPluginCallTimeout, errors.Cause(err))
+}
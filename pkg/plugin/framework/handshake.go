@@ -24,6 +24,8 @@ func Handshake() plugin.HandshakeConfig {
 		// The ProtocolVersion is the version that must match between Velero framework
 		// and Velero client plugins. This should be bumped whenever a change happens in
 		// one or the other that makes it so that they can't safely communicate.
+		// Changes to the interface of a plugin kind don't require a bump: they're
+		// made by adding a new version of the kind (see PluginKind).
 		ProtocolVersion: 2,
 
 		MagicCookieKey:   "VELERO_PLUGIN",
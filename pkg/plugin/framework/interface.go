@@ -16,7 +16,10 @@ limitations under the License.
 
 package framework
 
-import plugin "github.com/hashicorp/go-plugin"
+import (
+	plugin "github.com/hashicorp/go-plugin"
+	"golang.org/x/net/context"
+)
 
 // Interface represents a Velero plugin.
 type Interface interface {
@@ -25,4 +28,11 @@ type Interface interface {
 	// names returns a list of all the registered implementations for this plugin (such as "pod" and "pvc" for
 	// BackupItemAction).
 	names() []string
+
+	// getHandler returns the instance of the registered implementation with the given name, for the connection of
+	// the call whose context is ctx.
+	getHandler(ctx context.Context, name string) (interface{}, error)
+
+	// forgetConnection discards the instances of the connection with the given ID, once it's closed.
+	forgetConnection(connection uint64)
 }
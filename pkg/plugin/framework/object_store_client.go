@@ -23,8 +23,11 @@ import (
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
 const byteChunkSize = 16384
@@ -59,7 +62,10 @@ func (c *ObjectStoreGRPCClient) Init(config map[string]string) error {
 		Config: config,
 	}
 
-	if _, err := c.grpcClient.Init(context.Background(), req); err != nil {
+	ctx, done := c.callContext("Init")
+	_, err := c.grpcClient.Init(ctx, req)
+	done(err)
+	if err != nil {
 		return fromGRPCError(err)
 	}
 
@@ -68,8 +74,15 @@ func (c *ObjectStoreGRPCClient) Init(config map[string]string) error {
 
 // PutObject creates a new object using the data in body within the specified
 // object storage bucket with the given key.
-func (c *ObjectStoreGRPCClient) PutObject(bucket, key string, body io.Reader) error {
-	stream, err := c.grpcClient.PutObject(context.Background())
+func (c *ObjectStoreGRPCClient) PutObject(bucket, key string, body io.Reader) (err error) {
+	ctx, endSpan := c.startCallSpan(c.context(), "PutObject")
+	start := time.Now()
+	defer func() {
+		endSpan(err)
+		c.observeCall("PutObject", start, err)
+	}()
+
+	stream, err := c.grpcClient.PutObject(ctx)
 	if err != nil {
 		return fromGRPCError(err)
 	}
@@ -104,7 +117,9 @@ func (c *ObjectStoreGRPCClient) ObjectExists(bucket, key string) (bool, error) {
 		Key:    key,
 	}
 
-	res, err := c.grpcClient.ObjectExists(context.Background(), req)
+	ctx, done := c.callContext("ObjectExists")
+	res, err := c.grpcClient.ObjectExists(ctx, req)
+	done(err)
 	if err != nil {
 		return false, err
 	}
@@ -121,7 +136,9 @@ func (c *ObjectStoreGRPCClient) GetObject(bucket, key string) (io.ReadCloser, er
 		Key:    key,
 	}
 
-	stream, err := c.grpcClient.GetObject(context.Background(), req)
+	// the object is streamed after GetObject returns, so the call isn't limited
+	// to the call timeout, and its metrics aren't recorded
+	stream, err := c.grpcClient.GetObject(c.context(), req)
 	if err != nil {
 		return nil, fromGRPCError(err)
 	}
@@ -162,7 +179,9 @@ func (c *ObjectStoreGRPCClient) ListCommonPrefixes(bucket, prefix, delimiter str
 		Delimiter: delimiter,
 	}
 
-	res, err := c.grpcClient.ListCommonPrefixes(context.Background(), req)
+	ctx, done := c.callContext("ListCommonPrefixes")
+	res, err := c.grpcClient.ListCommonPrefixes(ctx, req)
+	done(err)
 	if err != nil {
 		return nil, fromGRPCError(err)
 	}
@@ -178,7 +197,9 @@ func (c *ObjectStoreGRPCClient) ListObjects(bucket, prefix string) ([]string, er
 		Prefix: prefix,
 	}
 
-	res, err := c.grpcClient.ListObjects(context.Background(), req)
+	ctx, done := c.callContext("ListObjects")
+	res, err := c.grpcClient.ListObjects(ctx, req)
+	done(err)
 	if err != nil {
 		return nil, fromGRPCError(err)
 	}
@@ -195,7 +216,10 @@ func (c *ObjectStoreGRPCClient) DeleteObject(bucket, key string) error {
 		Key:    key,
 	}
 
-	if _, err := c.grpcClient.DeleteObject(context.Background(), req); err != nil {
+	ctx, done := c.callContext("DeleteObject")
+	_, err := c.grpcClient.DeleteObject(ctx, req)
+	done(err)
+	if err != nil {
 		return fromGRPCError(err)
 	}
 
@@ -211,10 +235,188 @@ func (c *ObjectStoreGRPCClient) CreateSignedURL(bucket, key string, ttl time.Dur
 		Ttl:    int64(ttl),
 	}
 
-	res, err := c.grpcClient.CreateSignedURL(context.Background(), req)
+	ctx, done := c.callContext("CreateSignedURL")
+	res, err := c.grpcClient.CreateSignedURL(ctx, req)
+	done(err)
 	if err != nil {
 		return "", fromGRPCError(err)
 	}
 
 	return res.Url, nil
 }
+
+// PutObjectStream creates a new object using the data in body, as it's read, within the
+// specified object storage bucket with the given key. If reading body fails, the upload is
+// aborted. It returns velero.ErrObjectStreamingNotSupported, without reading body, if the
+// object store doesn't support streaming uploads.
+func (c *ObjectStoreGRPCClient) PutObjectStream(bucket, key string, body io.Reader) (err error) {
+	ctx, endSpan := c.startCallSpan(c.context(), "PutObjectStream")
+	start := time.Now()
+	defer func() {
+		// object stores that don't support streaming uploads haven't failed
+		if err == velero.ErrObjectStreamingNotSupported {
+			endSpan(nil)
+			return
+		}
+		endSpan(err)
+		c.observeCall("PutObjectStream", start, err)
+	}()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.grpcClient.PutObjectStream(ctx)
+	if err != nil {
+		return fromGRPCError(err)
+	}
+
+	// the first message identifies the object, and the server replies once the
+	// object store starts reading its data
+	if err := stream.Send(&proto.PutObjectRequest{Plugin: c.plugin, Bucket: bucket, Key: key}); err != nil {
+		return putObjectStreamError(stream, err)
+	}
+	if _, err := stream.Recv(); err != nil {
+		if err == io.EOF {
+			return nil
+		}
+		if status.Code(err) == codes.Unimplemented {
+			return velero.ErrObjectStreamingNotSupported
+		}
+		return fromGRPCError(err)
+	}
+
+	chunk := make([]byte, byteChunkSize)
+	for {
+		n, err := body.Read(chunk)
+		if n > 0 {
+			if sendErr := stream.Send(&proto.PutObjectRequest{Body: chunk[0:n]}); sendErr != nil {
+				return putObjectStreamError(stream, sendErr)
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// cancel the stream rather than closing it, so that the object
+			// store aborts the upload instead of creating a truncated object
+			cancel()
+			return errors.WithStack(err)
+		}
+	}
+
+	if err := stream.CloseSend(); err != nil {
+		return fromGRPCError(err)
+	}
+	if _, err := stream.Recv(); err != io.EOF {
+		if err == nil {
+			return errors.New("unexpected message from object store after streaming upload")
+		}
+		return fromGRPCError(err)
+	}
+
+	return nil
+}
+
+// putObjectStreamError returns the error of a PutObjectStream stream that failed
+// to send a message. Send returns io.EOF when the server ended the stream, and
+// the server's error has to be received.
+func putObjectStreamError(stream proto.ObjectStore_PutObjectStreamClient, err error) error {
+	if err == io.EOF {
+		_, err = stream.Recv()
+	}
+	return fromGRPCError(err)
+}
+
+// PutObjectWithRetention creates a new object using the data in body within the specified
+// object storage bucket with the given key, and locks it as described by retention. It
+// returns velero.ErrObjectRetentionNotSupported if the object store can't lock objects.
+func (c *ObjectStoreGRPCClient) PutObjectWithRetention(bucket, key string, body io.Reader, retention velero.ObjectRetention) (err error) {
+	ctx, endSpan := c.startCallSpan(c.context(), "PutObjectWithRetention")
+	start := time.Now()
+	defer func() {
+		// object stores that can't lock objects haven't failed
+		if err == velero.ErrObjectRetentionNotSupported {
+			endSpan(nil)
+			return
+		}
+		endSpan(err)
+		c.observeCall("PutObjectWithRetention", start, err)
+	}()
+
+	stream, err := c.grpcClient.PutObjectWithRetention(ctx)
+	if err != nil {
+		return fromGRPCError(err)
+	}
+
+	// the first message identifies the object and its retention, and is sent
+	// even if body is empty
+	req := &proto.PutObjectWithRetentionRequest{
+		Plugin:        c.plugin,
+		Bucket:        bucket,
+		Key:           key,
+		RetentionMode: string(retention.Mode),
+		RetainUntil:   unixNanoOrZero(retention.RetainUntil),
+		LegalHold:     retention.LegalHold,
+	}
+
+	// read from the provider io.Reader into chunks, and send each one over
+	// the gRPC stream
+	chunk := make([]byte, byteChunkSize)
+	for {
+		n, err := body.Read(chunk)
+		if n > 0 || req != nil {
+			if req == nil {
+				req = &proto.PutObjectWithRetentionRequest{}
+			}
+			req.Body = chunk[0:n]
+			if sendErr := stream.Send(req); sendErr != nil {
+				if sendErr != io.EOF {
+					return fromGRPCError(sendErr)
+				}
+				// the server ended the stream, so receive its error
+				break
+			}
+			req = nil
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			stream.CloseSend()
+			return errors.WithStack(err)
+		}
+	}
+
+	if _, err := stream.CloseAndRecv(); err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return velero.ErrObjectRetentionNotSupported
+		}
+		return fromGRPCError(err)
+	}
+
+	return nil
+}
+
+// CheckObjectLock returns a *velero.ObjectLockedError if the object exists and is locked. It
+// returns velero.ErrObjectRetentionNotSupported if the object store can't lock objects.
+func (c *ObjectStoreGRPCClient) CheckObjectLock(bucket, key string) error {
+	req := &proto.CheckObjectLockRequest{
+		Plugin: c.plugin,
+		Bucket: bucket,
+		Key:    key,
+	}
+
+	ctx, done := c.callContext("CheckObjectLock")
+	_, err := c.grpcClient.CheckObjectLock(ctx, req)
+	if status.Code(err) == codes.Unimplemented {
+		// object stores that can't lock objects haven't failed
+		done(nil)
+		return velero.ErrObjectRetentionNotSupported
+	}
+	done(err)
+	if err != nil {
+		return fromGRPCError(err)
+	}
+
+	return nil
+}
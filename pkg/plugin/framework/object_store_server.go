@@ -22,6 +22,7 @@ import (
 
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
@@ -33,8 +34,8 @@ type ObjectStoreGRPCServer struct {
 	mux *serverMux
 }
 
-func (s *ObjectStoreGRPCServer) getImpl(name string) (velero.ObjectStore, error) {
-	impl, err := s.mux.getHandler(name)
+func (s *ObjectStoreGRPCServer) getImpl(ctx context.Context, name string) (velero.ObjectStore, error) {
+	impl, err := s.mux.getHandler(ctx, name)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +58,7 @@ func (s *ObjectStoreGRPCServer) Init(ctx context.Context, req *proto.ObjectStore
 		}
 	}()
 
-	impl, err := s.getImpl(req.Plugin)
+	impl, err := s.getImpl(ctx, req.Plugin)
 	if err != nil {
 		return nil, newGRPCError(err)
 	}
@@ -85,7 +86,7 @@ func (s *ObjectStoreGRPCServer) PutObject(stream proto.ObjectStore_PutObjectServ
 		return newGRPCError(errors.WithStack(err))
 	}
 
-	impl, err := s.getImpl(firstChunk.Plugin)
+	impl, err := s.getImpl(stream.Context(), firstChunk.Plugin)
 	if err != nil {
 		return newGRPCError(err)
 	}
@@ -136,7 +137,7 @@ func (s *ObjectStoreGRPCServer) ObjectExists(ctx context.Context, req *proto.Obj
 		}
 	}()
 
-	impl, err := s.getImpl(req.Plugin)
+	impl, err := s.getImpl(ctx, req.Plugin)
 	if err != nil {
 		return nil, newGRPCError(err)
 	}
@@ -158,7 +159,7 @@ func (s *ObjectStoreGRPCServer) GetObject(req *proto.GetObjectRequest, stream pr
 		}
 	}()
 
-	impl, err := s.getImpl(req.Plugin)
+	impl, err := s.getImpl(stream.Context(), req.Plugin)
 	if err != nil {
 		return newGRPCError(err)
 	}
@@ -195,7 +196,7 @@ func (s *ObjectStoreGRPCServer) ListCommonPrefixes(ctx context.Context, req *pro
 		}
 	}()
 
-	impl, err := s.getImpl(req.Plugin)
+	impl, err := s.getImpl(ctx, req.Plugin)
 	if err != nil {
 		return nil, newGRPCError(err)
 	}
@@ -216,7 +217,7 @@ func (s *ObjectStoreGRPCServer) ListObjects(ctx context.Context, req *proto.List
 		}
 	}()
 
-	impl, err := s.getImpl(req.Plugin)
+	impl, err := s.getImpl(ctx, req.Plugin)
 	if err != nil {
 		return nil, newGRPCError(err)
 	}
@@ -238,12 +239,20 @@ func (s *ObjectStoreGRPCServer) DeleteObject(ctx context.Context, req *proto.Del
 		}
 	}()
 
-	impl, err := s.getImpl(req.Plugin)
+	impl, err := s.getImpl(ctx, req.Plugin)
 	if err != nil {
 		return nil, newGRPCError(err)
 	}
 
 	if err := impl.DeleteObject(req.Bucket, req.Key); err != nil {
+		var locked *velero.ObjectLockedError
+		if errors.As(err, &locked) {
+			return nil, newGRPCErrorWithCode(err, codes.FailedPrecondition, &proto.ObjectLocked{
+				Key:         locked.Key,
+				RetainUntil: unixNanoOrZero(locked.RetainUntil),
+				LegalHold:   locked.LegalHold,
+			})
+		}
 		return nil, newGRPCError(err)
 	}
 
@@ -258,7 +267,7 @@ func (s *ObjectStoreGRPCServer) CreateSignedURL(ctx context.Context, req *proto.
 		}
 	}()
 
-	impl, err := s.getImpl(req.Plugin)
+	impl, err := s.getImpl(ctx, req.Plugin)
 	if err != nil {
 		return nil, newGRPCError(err)
 	}
@@ -270,3 +279,197 @@ func (s *ObjectStoreGRPCServer) CreateSignedURL(ctx context.Context, req *proto.
 
 	return &proto.CreateSignedURLResponse{Url: url}, nil
 }
+
+// PutObjectStream creates a new object using the data streamed by the client, as it's
+// received. The first message identifies the object, and the server replies with an empty
+// message when the implementation starts reading the object's data, so that the client
+// starts sending it. If the client cancels the stream, reading the data returns an error,
+// so that the implementation aborts the upload. If the implementation doesn't support
+// streaming uploads, an Unimplemented error is returned.
+func (s *ObjectStoreGRPCServer) PutObjectStream(stream proto.ObjectStore_PutObjectStreamServer) (err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	first, err := stream.Recv()
+	if err != nil {
+		return newGRPCError(errors.WithStack(err))
+	}
+
+	impl, err := s.getImpl(stream.Context(), first.Plugin)
+	if err != nil {
+		return newGRPCError(err)
+	}
+
+	streamer, ok := impl.(velero.ObjectStreamer)
+	if !ok {
+		return newGRPCErrorWithCode(velero.ErrObjectStreamingNotSupported, codes.Unimplemented)
+	}
+
+	ready := false
+	receive := func() ([]byte, error) {
+		if !ready {
+			if err := stream.Send(&proto.Empty{}); err != nil {
+				return nil, errors.WithStack(err)
+			}
+			ready = true
+		}
+
+		data, err := stream.Recv()
+		if err == io.EOF {
+			// we need to return io.EOF errors unwrapped so that
+			// calling code sees them as io.EOF and knows to stop
+			// reading.
+			return nil, err
+		}
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return data.Body, nil
+	}
+
+	close := func() error {
+		return nil
+	}
+
+	err = streamer.PutObjectStream(first.Bucket, first.Key, &StreamReadCloser{receive: receive, close: close})
+	if err == velero.ErrObjectStreamingNotSupported && !ready {
+		return newGRPCErrorWithCode(err, codes.Unimplemented)
+	}
+	if err != nil {
+		return newGRPCError(err)
+	}
+
+	return nil
+}
+
+// PutObjectWithRetention creates a new object using the data in body within the specified
+// object storage bucket with the given key, and locks it with the retention given in the
+// first message. If the implementation can't lock objects, an Unimplemented error is returned.
+func (s *ObjectStoreGRPCServer) PutObjectWithRetention(stream proto.ObjectStore_PutObjectWithRetentionServer) (err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	// we need to read the first chunk ahead of time to get the bucket, key
+	// and retention; in our receive method, we'll use `first` on the first call
+	firstChunk, err := stream.Recv()
+	if err != nil {
+		return newGRPCError(errors.WithStack(err))
+	}
+
+	impl, err := s.getImpl(stream.Context(), firstChunk.Plugin)
+	if err != nil {
+		return newGRPCError(err)
+	}
+
+	retainer, ok := impl.(velero.ObjectRetainer)
+	if !ok {
+		return newGRPCErrorWithCode(velero.ErrObjectRetentionNotSupported, codes.Unimplemented)
+	}
+
+	bucket := firstChunk.Bucket
+	key := firstChunk.Key
+	retention := velero.ObjectRetention{
+		Mode:        velero.ObjectRetentionMode(firstChunk.RetentionMode),
+		RetainUntil: timeFromUnixNano(firstChunk.RetainUntil),
+		LegalHold:   firstChunk.LegalHold,
+	}
+
+	receive := func() ([]byte, error) {
+		if firstChunk != nil {
+			res := firstChunk.Body
+			firstChunk = nil
+			return res, nil
+		}
+
+		data, err := stream.Recv()
+		if err == io.EOF {
+			// we need to return io.EOF errors unwrapped so that
+			// calling code sees them as io.EOF and knows to stop
+			// reading.
+			return nil, err
+		}
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return data.Body, nil
+	}
+
+	close := func() error {
+		return nil
+	}
+
+	err = retainer.PutObjectWithRetention(bucket, key, &StreamReadCloser{receive: receive, close: close}, retention)
+	if err == velero.ErrObjectRetentionNotSupported {
+		return newGRPCErrorWithCode(err, codes.Unimplemented)
+	}
+	if err != nil {
+		return newGRPCError(err)
+	}
+
+	if err := stream.SendAndClose(&proto.Empty{}); err != nil {
+		return newGRPCError(errors.WithStack(err))
+	}
+
+	return nil
+}
+
+// CheckObjectLock returns a FailedPrecondition error describing the lock of the object with
+// the specified key in the given bucket if it's locked. If the implementation can't lock
+// objects, an Unimplemented error is returned.
+func (s *ObjectStoreGRPCServer) CheckObjectLock(ctx context.Context, req *proto.CheckObjectLockRequest) (response *proto.Empty, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(ctx, req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	retainer, ok := impl.(velero.ObjectRetainer)
+	if !ok {
+		return nil, newGRPCErrorWithCode(velero.ErrObjectRetentionNotSupported, codes.Unimplemented)
+	}
+
+	if err := retainer.CheckObjectLock(req.Bucket, req.Key); err != nil {
+		if err == velero.ErrObjectRetentionNotSupported {
+			return nil, newGRPCErrorWithCode(err, codes.Unimplemented)
+		}
+		var locked *velero.ObjectLockedError
+		if errors.As(err, &locked) {
+			return nil, newGRPCErrorWithCode(err, codes.FailedPrecondition, &proto.ObjectLocked{
+				Key:         locked.Key,
+				RetainUntil: unixNanoOrZero(locked.RetainUntil),
+				LegalHold:   locked.LegalHold,
+			})
+		}
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.Empty{}, nil
+}
+
+// unixNanoOrZero returns t as a Unix time in nanoseconds, or zero if t is zero.
+func unixNanoOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+// timeFromUnixNano returns the time of a Unix time in nanoseconds, or the zero
+// time if it's zero.
+func timeFromUnixNano(nsec int64) time.Time {
+	if nsec == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, nsec)
+}
@@ -0,0 +1,209 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// streamingObjectStore is an object store whose PutObjectStream reads the whole
+// body and reports the result on a channel.
+type streamingObjectStore struct {
+	velero.ObjectStore
+
+	results chan streamingObjectStoreResult
+}
+
+type streamingObjectStoreResult struct {
+	bucket, key string
+	data        []byte
+	err         error
+}
+
+func (o *streamingObjectStore) PutObjectStream(bucket, key string, body io.Reader) error {
+	data, err := ioutil.ReadAll(body)
+	o.results <- streamingObjectStoreResult{bucket: bucket, key: key, data: data, err: err}
+	return err
+}
+
+// newObjectStoreGRPCTestClient serves an ObjectStoreGRPCServer for the object store
+// over an in-memory connection, and returns a client for it.
+func newObjectStoreGRPCTestClient(t *testing.T, objectStore velero.ObjectStore) *ObjectStoreGRPCClient {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	proto.RegisterObjectStoreServer(server, &ObjectStoreGRPCServer{mux: &serverMux{
+		serverLog: velerotest.NewLogger(),
+		handlers: map[handlerKey]interface{}{
+			{name: "xyz"}: objectStore,
+		},
+	}})
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	dialer := func(context.Context, string) (net.Conn, error) {
+		return listener.Dial()
+	}
+	clientConn, err := grpc.Dial("bufnet", grpc.WithContextDialer(dialer), grpc.WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(func() { clientConn.Close() })
+
+	return newObjectStoreGRPCClient(&clientBase{plugin: "xyz", logger: velerotest.NewLogger()}, clientConn).(*ObjectStoreGRPCClient)
+}
+
+func TestObjectStoreGRPCPutObjectStream(t *testing.T) {
+	objectStore := &streamingObjectStore{results: make(chan streamingObjectStoreResult, 1)}
+	client := newObjectStoreGRPCTestClient(t, objectStore)
+
+	// bodies larger than a chunk are sent in multiple messages
+	data := bytes.Repeat([]byte("a"), 3*byteChunkSize+1)
+	require.NoError(t, client.PutObjectStream("bucket", "key", bytes.NewReader(data)))
+	result := <-objectStore.results
+	assert.Equal(t, "bucket", result.bucket)
+	assert.Equal(t, "key", result.key)
+	assert.Equal(t, data, result.data)
+	assert.NoError(t, result.err)
+
+	// a failure reading the body is seen by the object store as a read error,
+	// rather than as the end of the body
+	body := io.MultiReader(bytes.NewReader(data), iotest.ErrReader(errors.New("read error")))
+	assert.Error(t, client.PutObjectStream("bucket", "key", body))
+	result = <-objectStore.results
+	assert.Error(t, result.err)
+}
+
+func TestObjectStoreGRPCPutObjectStreamNotSupported(t *testing.T) {
+	client := newObjectStoreGRPCTestClient(t, &nonStreamingObjectStore{})
+
+	err := client.PutObjectStream("bucket", "key", iotest.ErrReader(errors.New("body shouldn't be read")))
+	assert.Equal(t, velero.ErrObjectStreamingNotSupported, err)
+}
+
+// nonStreamingObjectStore is an object store that doesn't support streaming uploads.
+type nonStreamingObjectStore struct {
+	velero.ObjectStore
+}
+
+// retainingObjectStore is an object store that records the objects put with
+// retention, and whose objects are all locked.
+type retainingObjectStore struct {
+	velero.ObjectStore
+
+	data      []byte
+	retention velero.ObjectRetention
+	locked    *velero.ObjectLockedError
+}
+
+func (o *retainingObjectStore) PutObjectWithRetention(bucket, key string, body io.Reader, retention velero.ObjectRetention) error {
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	o.data = data
+	o.retention = retention
+	return nil
+}
+
+func (o *retainingObjectStore) DeleteObject(bucket, key string) error {
+	return errors.WithStack(o.locked)
+}
+
+func (o *retainingObjectStore) CheckObjectLock(bucket, key string) error {
+	if o.locked == nil {
+		return nil
+	}
+	return o.locked
+}
+
+func TestObjectStoreGRPCPutObjectWithRetention(t *testing.T) {
+	objectStore := &retainingObjectStore{}
+	client := newObjectStoreGRPCTestClient(t, objectStore)
+
+	retention := velero.ObjectRetention{
+		Mode:        velero.ObjectRetentionModeCompliance,
+		RetainUntil: time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC),
+		LegalHold:   true,
+	}
+	data := bytes.Repeat([]byte("a"), 2*byteChunkSize+1)
+	require.NoError(t, client.PutObjectWithRetention("bucket", "key", bytes.NewReader(data), retention))
+	assert.Equal(t, data, objectStore.data)
+	assert.Equal(t, retention.Mode, objectStore.retention.Mode)
+	assert.True(t, retention.RetainUntil.Equal(objectStore.retention.RetainUntil))
+	assert.True(t, objectStore.retention.LegalHold)
+
+	// empty objects and retention are sent too
+	require.NoError(t, client.PutObjectWithRetention("bucket", "key", bytes.NewReader(nil), velero.ObjectRetention{}))
+	assert.Empty(t, objectStore.data)
+	assert.Equal(t, velero.ObjectRetention{}, objectStore.retention)
+}
+
+func TestObjectStoreGRPCPutObjectWithRetentionNotSupported(t *testing.T) {
+	client := newObjectStoreGRPCTestClient(t, &nonStreamingObjectStore{})
+
+	err := client.PutObjectWithRetention("bucket", "key", bytes.NewReader(bytes.Repeat([]byte("a"), 4*byteChunkSize)), velero.ObjectRetention{LegalHold: true})
+	assert.Equal(t, velero.ErrObjectRetentionNotSupported, err)
+}
+
+func TestObjectStoreGRPCDeleteObjectLocked(t *testing.T) {
+	locked := &velero.ObjectLockedError{Key: "key", RetainUntil: time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)}
+	client := newObjectStoreGRPCTestClient(t, &retainingObjectStore{locked: locked})
+
+	err := client.DeleteObject("bucket", "key")
+	var clientLocked *velero.ObjectLockedError
+	require.True(t, errors.As(err, &clientLocked), "unexpected error %v", err)
+	assert.Equal(t, "key", clientLocked.Key)
+	assert.True(t, locked.RetainUntil.Equal(clientLocked.RetainUntil))
+	assert.False(t, clientLocked.LegalHold)
+}
+
+func TestObjectStoreGRPCCheckObjectLock(t *testing.T) {
+	locked := &velero.ObjectLockedError{Key: "key", LegalHold: true}
+	client := newObjectStoreGRPCTestClient(t, &retainingObjectStore{locked: locked})
+
+	err := client.CheckObjectLock("bucket", "key")
+	var clientLocked *velero.ObjectLockedError
+	require.True(t, errors.As(err, &clientLocked), "unexpected error %v", err)
+	assert.Equal(t, "key", clientLocked.Key)
+	assert.True(t, clientLocked.RetainUntil.IsZero())
+	assert.True(t, clientLocked.LegalHold)
+
+	// once the legal hold is removed, the object isn't locked anymore
+	client = newObjectStoreGRPCTestClient(t, &retainingObjectStore{})
+	assert.NoError(t, client.CheckObjectLock("bucket", "key"))
+}
+
+func TestObjectStoreGRPCCheckObjectLockNotSupported(t *testing.T) {
+	client := newObjectStoreGRPCTestClient(t, &nonStreamingObjectStore{})
+
+	assert.Equal(t, velero.ErrObjectRetentionNotSupported, client.CheckObjectLock("bucket", "key"))
+}
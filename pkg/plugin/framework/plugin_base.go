@@ -18,10 +18,12 @@ package framework
 
 import (
 	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
 )
 
 type pluginBase struct {
-	clientLogger logrus.FieldLogger
+	clientLogger  logrus.FieldLogger
+	clientContext context.Context
 	*serverMux
 }
 
@@ -16,8 +16,14 @@ limitations under the License.
 
 package framework
 
+import "strings"
+
 // PluginKind is a type alias for a string that describes
 // the kind of a Velero-supported plugin.
+//
+// Each kind has a frozen interface. When the interface of a kind has to change,
+// a new version of the kind is added alongside it (e.g. BackupItemAction/v2),
+// so that plugins built against earlier versions keep working.
 type PluginKind string
 
 // String returns the string for k.
@@ -25,6 +31,24 @@ func (k PluginKind) String() string {
 	return string(k)
 }
 
+// Unversioned returns the kind that k is a version of, e.g. BackupItemAction
+// for BackupItemAction/v2. Unversioned kinds are returned unchanged.
+func (k PluginKind) Unversioned() PluginKind {
+	if i := strings.Index(string(k), "/"); i >= 0 {
+		return k[:i]
+	}
+	return k
+}
+
+// Version returns the version of the plugin API that plugins of kind k
+// implement. Unversioned kinds implement PluginAPIVersion.
+func (k PluginKind) Version() string {
+	if i := strings.Index(string(k), "/"); i >= 0 {
+		return string(k[i+1:])
+	}
+	return PluginAPIVersion
+}
+
 const (
 	// PluginKindObjectStore represents an object store plugin.
 	PluginKindObjectStore PluginKind = "ObjectStore"
@@ -35,6 +59,10 @@ const (
 	// PluginKindBackupItemAction represents a backup item action plugin.
 	PluginKindBackupItemAction PluginKind = "BackupItemAction"
 
+	// PluginKindBackupItemActionV2 represents a backup item action plugin that
+	// implements version 2 of the backup item action API.
+	PluginKindBackupItemActionV2 PluginKind = "BackupItemAction/v2"
+
 	// PluginKindRestoreItemAction represents a restore item action plugin.
 	PluginKindRestoreItemAction PluginKind = "RestoreItemAction"
 
@@ -52,7 +80,19 @@ func AllPluginKinds() map[string]PluginKind {
 	allPluginKinds[PluginKindObjectStore.String()] = PluginKindObjectStore
 	allPluginKinds[PluginKindVolumeSnapshotter.String()] = PluginKindVolumeSnapshotter
 	allPluginKinds[PluginKindBackupItemAction.String()] = PluginKindBackupItemAction
+	allPluginKinds[PluginKindBackupItemActionV2.String()] = PluginKindBackupItemActionV2
 	allPluginKinds[PluginKindRestoreItemAction.String()] = PluginKindRestoreItemAction
 	allPluginKinds[PluginKindDeleteItemAction.String()] = PluginKindDeleteItemAction
 	return allPluginKinds
 }
+
+// PluginKindVersions returns the versions of kind that Velero supports, newest
+// first. kind must be unversioned.
+func PluginKindVersions(kind PluginKind) []PluginKind {
+	switch kind {
+	case PluginKindBackupItemAction:
+		return []PluginKind{PluginKindBackupItemActionV2, PluginKindBackupItemAction}
+	default:
+		return []PluginKind{kind}
+	}
+}
@@ -21,10 +21,22 @@ import (
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
+// PluginAPIVersion is the version of the plugin API implemented by plugins of
+// unversioned kinds.
+const PluginAPIVersion = "v1"
+
+// ErrPluginStatusNotSupported is returned by PluginLister.Capabilities and
+// PluginLister.Health when the plugin process was built with a version of this
+// package that doesn't report the capabilities or health of its plugins.
+var ErrPluginStatusNotSupported = errors.New("the plugin process doesn't report the capabilities or health of its plugins")
+
 // PluginIdentifier uniquely identifies a plugin by command, kind, and name.
 type PluginIdentifier struct {
 	Command string
@@ -32,14 +44,46 @@ type PluginIdentifier struct {
 	Name    string
 }
 
-// PluginLister lists plugins.
+// PluginCapabilities describes the version of the plugin API that a plugin
+// implements, and the optional features of it that the plugin supports.
+type PluginCapabilities struct {
+	APIVersion string
+	Features   []velero.PluginFeature
+}
+
+// Supports returns whether the plugin supports feature.
+func (c PluginCapabilities) Supports(feature velero.PluginFeature) bool {
+	for _, f := range c.Features {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// PluginHealth describes whether a plugin is ready to serve requests.
+type PluginHealth struct {
+	Ready bool
+	// Message explains why the plugin isn't ready.
+	Message string
+}
+
+// PluginLister lists plugins, and reports their capabilities and health.
 type PluginLister interface {
 	ListPlugins() ([]PluginIdentifier, error)
+
+	// Capabilities returns the capabilities of the plugin of kind and name.
+	Capabilities(kind PluginKind, name string) (PluginCapabilities, error)
+
+	// Health returns whether the plugin of kind and name is ready to serve
+	// requests.
+	Health(kind PluginKind, name string) (PluginHealth, error)
 }
 
 // pluginLister implements PluginLister.
 type pluginLister struct {
 	plugins []PluginIdentifier
+	servers map[PluginKind]Interface
 }
 
 // NewPluginLister returns a new PluginLister for plugins.
@@ -47,11 +91,81 @@ func NewPluginLister(plugins ...PluginIdentifier) PluginLister {
 	return &pluginLister{plugins: plugins}
 }
 
+// newServingPluginLister returns a new PluginLister for plugins, which inspects
+// the plugin instances of servers to report their capabilities and health.
+func newServingPluginLister(plugins []PluginIdentifier, servers map[PluginKind]Interface) PluginLister {
+	return &pluginLister{plugins: plugins, servers: servers}
+}
+
 // ListPlugins returns the pluginLister's plugins.
 func (pl *pluginLister) ListPlugins() ([]PluginIdentifier, error) {
 	return pl.plugins, nil
 }
 
+// Capabilities returns the version of the plugin API implemented by the plugin's
+// kind, and the features whose optional interfaces the plugin implements.
+func (pl *pluginLister) Capabilities(kind PluginKind, name string) (PluginCapabilities, error) {
+	instance, err := pl.getHandler(kind, name)
+	if err != nil {
+		return PluginCapabilities{}, err
+	}
+
+	return PluginCapabilities{
+		APIVersion: kind.Version(),
+		Features:   pluginFeatures(instance),
+	}, nil
+}
+
+// Health returns whether the plugin is ready, as reported by the plugin if it
+// implements velero.HealthChecker. Plugins that can't be created aren't ready.
+func (pl *pluginLister) Health(kind PluginKind, name string) (PluginHealth, error) {
+	instance, err := pl.getHandler(kind, name)
+	if err != nil {
+		return PluginHealth{Message: err.Error()}, nil
+	}
+
+	if checker, ok := instance.(velero.HealthChecker); ok {
+		if err := checker.Health(); err != nil {
+			return PluginHealth{Message: err.Error()}, nil
+		}
+	}
+
+	return PluginHealth{Ready: true}, nil
+}
+
+func (pl *pluginLister) getHandler(kind PluginKind, name string) (interface{}, error) {
+	server, ok := pl.servers[kind]
+	if !ok {
+		return nil, errors.Errorf("%v plugin: %s was not found", kind, name)
+	}
+	// the instances inspected aren't those of any particular connection
+	return server.getHandler(context.Background(), name)
+}
+
+// pluginFeatures returns the optional features of the plugin API that a plugin
+// instance supports.
+func pluginFeatures(instance interface{}) []velero.PluginFeature {
+	var features []velero.PluginFeature
+
+	if _, ok := instance.(velero.ObjectStreamer); ok {
+		features = append(features, velero.PluginFeatureStreamingUpload)
+	}
+	if _, ok := instance.(velero.ObjectRetainer); ok {
+		features = append(features, velero.PluginFeatureObjectRetention)
+	}
+	if _, ok := instance.(velero.GroupVolumeSnapshotter); ok {
+		features = append(features, velero.PluginFeatureGroupSnapshots)
+	}
+	if _, ok := instance.(velero.SnapshotProgressReporter); ok {
+		features = append(features, velero.PluginFeatureAsyncSnapshots)
+	}
+	if _, ok := instance.(velero.SnapshotCopier); ok {
+		features = append(features, velero.PluginFeatureSnapshotCopy)
+	}
+
+	return features
+}
+
 // PluginListerPlugin is a go-plugin Plugin for a PluginLister.
 type PluginListerPlugin struct {
 	plugin.NetRPCUnsupportedPlugin
@@ -101,6 +215,39 @@ func (c *PluginListerGRPCClient) ListPlugins() ([]PluginIdentifier, error) {
 	return ret, nil
 }
 
+// Capabilities uses the gRPC client to request the capabilities of a plugin from the server. It returns
+// ErrPluginStatusNotSupported if the server doesn't report them.
+func (c *PluginListerGRPCClient) Capabilities(kind PluginKind, name string) (PluginCapabilities, error) {
+	resp, err := c.grpcClient.Capabilities(context.Background(), &proto.PluginIdentifier{Kind: kind.String(), Name: name})
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return PluginCapabilities{}, ErrPluginStatusNotSupported
+		}
+		return PluginCapabilities{}, fromGRPCError(err)
+	}
+
+	capabilities := PluginCapabilities{APIVersion: resp.ApiVersion}
+	for _, feature := range resp.Features {
+		capabilities.Features = append(capabilities.Features, velero.PluginFeature(feature))
+	}
+
+	return capabilities, nil
+}
+
+// Health uses the gRPC client to request the health of a plugin from the server. It returns
+// ErrPluginStatusNotSupported if the server doesn't report it.
+func (c *PluginListerGRPCClient) Health(kind PluginKind, name string) (PluginHealth, error) {
+	resp, err := c.grpcClient.Health(context.Background(), &proto.PluginIdentifier{Kind: kind.String(), Name: name})
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return PluginHealth{}, ErrPluginStatusNotSupported
+		}
+		return PluginHealth{}, fromGRPCError(err)
+	}
+
+	return PluginHealth{Ready: resp.Ready, Message: resp.Message}, nil
+}
+
 //////////////////////////////////////////////////////////////////////////////
 // server code
 //////////////////////////////////////////////////////////////////////////////
@@ -141,3 +288,28 @@ func (s *PluginListerGRPCServer) ListPlugins(ctx context.Context, req *proto.Emp
 	}
 	return ret, nil
 }
+
+// Capabilities returns the capabilities of a plugin, delegating to s.impl to inspect it.
+func (s *PluginListerGRPCServer) Capabilities(ctx context.Context, req *proto.PluginIdentifier) (*proto.PluginCapabilitiesResponse, error) {
+	capabilities, err := s.impl.Capabilities(PluginKind(req.Kind), req.Name)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	resp := &proto.PluginCapabilitiesResponse{ApiVersion: capabilities.APIVersion}
+	for _, feature := range capabilities.Features {
+		resp.Features = append(resp.Features, string(feature))
+	}
+
+	return resp, nil
+}
+
+// Health returns the health of a plugin, delegating to s.impl to check it.
+func (s *PluginListerGRPCServer) Health(ctx context.Context, req *proto.PluginIdentifier) (*proto.PluginHealthResponse, error) {
+	health, err := s.impl.Health(PluginKind(req.Kind), req.Name)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.PluginHealthResponse{Ready: health.Ready, Message: health.Message}, nil
+}
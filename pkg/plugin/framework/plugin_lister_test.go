@@ -0,0 +1,118 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"net"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// unhealthyObjectStore is an object store that reports it isn't ready.
+type unhealthyObjectStore struct {
+	velero.ObjectStore
+}
+
+func (o *unhealthyObjectStore) Health() error {
+	return errors.New("credentials are missing")
+}
+
+// newPluginListerGRPCTestClient serves lister over an in-memory connection, and returns
+// a client for it. If lister is nil, no PluginLister service is served.
+func newPluginListerGRPCTestClient(t *testing.T, lister PluginLister) *PluginListerGRPCClient {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	if lister != nil {
+		proto.RegisterPluginListerServer(server, &PluginListerGRPCServer{impl: lister})
+	}
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	dialer := func(context.Context, string) (net.Conn, error) {
+		return listener.Dial()
+	}
+	clientConn, err := grpc.Dial("bufnet", grpc.WithContextDialer(dialer), grpc.WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(func() { clientConn.Close() })
+
+	return &PluginListerGRPCClient{grpcClient: proto.NewPluginListerClient(clientConn)}
+}
+
+func TestPluginListerGRPCCapabilitiesAndHealth(t *testing.T) {
+	objectStores := NewObjectStorePlugin(serverLogger(velerotest.NewLogger()))
+	objectStores.register("velero.io/streaming", func(logrus.FieldLogger) (interface{}, error) {
+		return &streamingObjectStore{}, nil
+	})
+	objectStores.register("velero.io/retaining", func(logrus.FieldLogger) (interface{}, error) {
+		return &retainingObjectStore{}, nil
+	})
+	objectStores.register("velero.io/unhealthy", func(logrus.FieldLogger) (interface{}, error) {
+		return &unhealthyObjectStore{}, nil
+	})
+	objectStores.register("velero.io/broken", func(logrus.FieldLogger) (interface{}, error) {
+		return nil, errors.New("unable to create plugin")
+	})
+
+	client := newPluginListerGRPCTestClient(t, newServingPluginLister(nil, map[PluginKind]Interface{
+		PluginKindObjectStore: objectStores,
+	}))
+
+	capabilities, err := client.Capabilities(PluginKindObjectStore, "velero.io/streaming")
+	require.NoError(t, err)
+	assert.Equal(t, PluginCapabilities{APIVersion: PluginAPIVersion, Features: []velero.PluginFeature{velero.PluginFeatureStreamingUpload}}, capabilities)
+
+	capabilities, err = client.Capabilities(PluginKindObjectStore, "velero.io/retaining")
+	require.NoError(t, err)
+	assert.True(t, capabilities.Supports(velero.PluginFeatureObjectRetention))
+	assert.False(t, capabilities.Supports(velero.PluginFeatureStreamingUpload))
+
+	_, err = client.Capabilities(PluginKindVolumeSnapshotter, "velero.io/streaming")
+	assert.Error(t, err)
+
+	health, err := client.Health(PluginKindObjectStore, "velero.io/streaming")
+	require.NoError(t, err)
+	assert.Equal(t, PluginHealth{Ready: true}, health)
+
+	health, err = client.Health(PluginKindObjectStore, "velero.io/unhealthy")
+	require.NoError(t, err)
+	assert.Equal(t, PluginHealth{Message: "credentials are missing"}, health)
+
+	health, err = client.Health(PluginKindObjectStore, "velero.io/broken")
+	require.NoError(t, err)
+	assert.Equal(t, PluginHealth{Message: "unable to create plugin"}, health)
+}
+
+func TestPluginListerGRPCStatusNotSupported(t *testing.T) {
+	client := newPluginListerGRPCTestClient(t, nil)
+
+	_, err := client.Capabilities(PluginKindObjectStore, "velero.io/aws")
+	assert.Equal(t, ErrPluginStatusNotSupported, err)
+
+	_, err = client.Health(PluginKindObjectStore, "velero.io/aws")
+	assert.Equal(t, ErrPluginStatusNotSupported, err)
+}
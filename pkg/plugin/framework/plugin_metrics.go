@@ -0,0 +1,65 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// PluginMetrics records metrics about calls to plugins and the processes that run
+// them. Plugins are identified by their kind and name, and calls by the plugin
+// method, e.g. "ObjectStore", "velero.io/aws" and "PutObject".
+type PluginMetrics interface {
+	// ObservePluginCallLatency records the time a call took.
+	ObservePluginCallLatency(kind, name, method string, seconds float64)
+	// RegisterPluginCallFailure records a call that returned an error.
+	RegisterPluginCallFailure(kind, name, method string)
+	// RegisterPluginProcessRestart records a restart of the plugin process
+	// running command, after it exited.
+	RegisterPluginProcessRestart(command string)
+}
+
+type pluginMetricsKey struct{}
+
+// WithPluginMetrics returns a copy of ctx that records the calls that plugin
+// clients using it make to the plugin server, and restarts of their plugin
+// processes, in metrics.
+func WithPluginMetrics(ctx context.Context, metrics PluginMetrics) context.Context {
+	return context.WithValue(ctx, pluginMetricsKey{}, metrics)
+}
+
+// PluginMetricsFrom returns the PluginMetrics of ctx, or nil if it has none.
+func PluginMetricsFrom(ctx context.Context) PluginMetrics {
+	metrics, _ := ctx.Value(pluginMetricsKey{}).(PluginMetrics)
+	return metrics
+}
+
+// observeCall records a call of method to the plugin server that started at start
+// and returned err in the PluginMetrics of the client's context, if any.
+func (c *clientBase) observeCall(method string, start time.Time, err error) {
+	metrics := PluginMetricsFrom(c.context())
+	if metrics == nil {
+		return
+	}
+
+	metrics.ObservePluginCallLatency(string(c.kind), c.plugin, method, time.Since(start).Seconds())
+	if err != nil {
+		metrics.RegisterPluginCallFailure(string(c.kind), c.plugin, method)
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+type fakePluginMetrics struct {
+	calls    []string
+	failures []string
+	restarts []string
+}
+
+func (m *fakePluginMetrics) ObservePluginCallLatency(kind, name, method string, seconds float64) {
+	m.calls = append(m.calls, kind+" "+name+" "+method)
+}
+
+func (m *fakePluginMetrics) RegisterPluginCallFailure(kind, name, method string) {
+	m.failures = append(m.failures, kind+" "+name+" "+method)
+}
+
+func (m *fakePluginMetrics) RegisterPluginProcessRestart(command string) {
+	m.restarts = append(m.restarts, command)
+}
+
+func TestPluginCallMetrics(t *testing.T) {
+	client := newBackupItemActionV2GRPCTestClient(t, "velero.io/blocking", func(logrus.FieldLogger) (interface{}, error) {
+		return &blockingBackupItemAction{}, nil
+	})
+	metrics := new(fakePluginMetrics)
+	client.ctx = WithPluginMetrics(context.Background(), metrics)
+
+	_, err := client.AppliesTo()
+	require.NoError(t, err)
+
+	item := builder.ForPod("ns-1", "blocking").Result()
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(item)
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, _, err = client.Execute(ctx, &unstructured.Unstructured{Object: obj}, new(v1.Backup))
+	require.Error(t, err)
+
+	assert.Equal(t, []string{
+		"BackupItemAction/v2 velero.io/blocking AppliesTo",
+		"BackupItemAction/v2 velero.io/blocking Execute",
+	}, metrics.calls)
+	assert.Equal(t, []string{"BackupItemAction/v2 velero.io/blocking Execute"}, metrics.failures)
+	assert.Empty(t, metrics.restarts)
+}
+
+func TestPluginMetricsFrom(t *testing.T) {
+	assert.Nil(t, PluginMetricsFrom(context.Background()))
+
+	metrics := new(fakePluginMetrics)
+	assert.Equal(t, metrics, PluginMetricsFrom(WithPluginMetrics(context.Background(), metrics)))
+}
@@ -27,6 +27,7 @@ func TestPluginImplementationsAreGRPCPlugins(t *testing.T) {
 	pluginImpls := []interface{}{
 		new(VolumeSnapshotterPlugin),
 		new(BackupItemActionPlugin),
+		new(BackupItemActionV2Plugin),
 		new(ObjectStorePlugin),
 		new(PluginListerPlugin),
 		new(RestoreItemActionPlugin),
@@ -0,0 +1,221 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"sync/atomic"
+	"time"
+
+	plugin "github.com/hashicorp/go-plugin"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/stats"
+)
+
+// Plugin servers that run separately from the Velero server, e.g. as their own
+// deployment, serve their plugins over the network with mutual TLS, rather than
+// to the Velero server that runs them from an executable. Several Velero
+// servers, and several clients of each, may call a remote plugin server, and
+// initialize the same plugins with different configurations, so each connection
+// to the server gets its own plugin instances.
+
+// remoteKeepaliveMinTime is how often clients may ping a remote plugin server
+// to keep their connections alive. Velero servers ping every 30 seconds, so
+// that they detect lost connections, and the default minimum of 5 minutes
+// would make the plugin server close their connections.
+const remoteKeepaliveMinTime = 10 * time.Second
+
+type connectionIDKey struct{}
+
+// connectionID returns the ID of the connection of the call whose context is
+// ctx, or zero if the plugin server doesn't identify connections.
+func connectionID(ctx context.Context) uint64 {
+	id, _ := ctx.Value(connectionIDKey{}).(uint64)
+	return id
+}
+
+// connectionTracker is a stats.Handler that identifies the connections to a
+// remote plugin server, and discards the plugin instances of connections that
+// are closed.
+type connectionTracker struct {
+	lastID  uint64
+	servers []Interface
+}
+
+func (t *connectionTracker) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return context.WithValue(ctx, connectionIDKey{}, atomic.AddUint64(&t.lastID, 1))
+}
+
+func (t *connectionTracker) HandleConn(ctx context.Context, s stats.ConnStats) {
+	if _, ok := s.(*stats.ConnEnd); !ok {
+		return
+	}
+
+	for _, server := range t.servers {
+		server.forgetConnection(connectionID(ctx))
+	}
+}
+
+func (t *connectionTracker) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+func (t *connectionTracker) HandleRPC(context.Context, stats.RPCStats) {}
+
+// newRemoteGRPCServer returns a gRPC server for plugins that accepts the
+// connections tlsConfig allows, and gives each connection its own plugin
+// instances.
+func newRemoteGRPCServer(tlsConfig *tls.Config, plugins map[string]plugin.Plugin) (*grpc.Server, error) {
+	tracker := new(connectionTracker)
+	server := newGRPCServer([]grpc.ServerOption{
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.StatsHandler(tracker),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             remoteKeepaliveMinTime,
+			PermitWithoutStream: true,
+		}),
+	})
+
+	for kind, p := range plugins {
+		grpcPlugin, ok := p.(plugin.GRPCPlugin)
+		if !ok {
+			return nil, errors.Errorf("%s plugin doesn't support gRPC", kind)
+		}
+		if err := grpcPlugin.GRPCServer(nil, server); err != nil {
+			return nil, errors.Wrapf(err, "error registering %s plugin", kind)
+		}
+
+		if i, ok := p.(Interface); ok {
+			tracker.servers = append(tracker.servers, i)
+		}
+	}
+
+	return server, nil
+}
+
+// serveRemote serves plugins over the network at the server's listen address,
+// to clients that authenticate with a certificate signed by its client
+// certificate authority. It returns when serving fails.
+func (s *server) serveRemote(plugins map[string]plugin.Plugin) error {
+	tlsConfig, err := reloadingServerTLSConfig(s.tlsCertFile, s.tlsKeyFile, s.tlsClientCAFile)
+	if err != nil {
+		return err
+	}
+
+	server, err := newRemoteGRPCServer(tlsConfig, plugins)
+	if err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", s.listenAddress)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	s.log.Infof("Serving plugins at %s", listener.Addr())
+	return errors.WithStack(server.Serve(listener))
+}
+
+// reloadingServerTLSConfig returns the TLS configuration of a remote plugin
+// server that reads its certificate, key and client certificate authority
+// from their files again for each connection, so that rotated certificates,
+// e.g. from a mounted secret, are used without restarting the server. The
+// files are read once first, so that invalid ones are reported right away.
+func reloadingServerTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	load := func() (*tls.Config, error) {
+		certPEM, err := ioutil.ReadFile(certFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "error reading TLS certificate")
+		}
+		keyPEM, err := ioutil.ReadFile(keyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "error reading TLS key")
+		}
+		clientCAPEM, err := ioutil.ReadFile(clientCAFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "error reading TLS client certificate authority")
+		}
+
+		return serverTLSConfig(certPEM, keyPEM, clientCAPEM)
+	}
+
+	tlsConfig, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		return load()
+	}
+	return tlsConfig, nil
+}
+
+// serverTLSConfig returns the TLS configuration of a remote plugin server with
+// the certificate certPEM and its key keyPEM, that requires clients to
+// authenticate with a certificate signed by a certificate authority in
+// clientCAPEM.
+func serverTLSConfig(certPEM, keyPEM, clientCAPEM []byte) (*tls.Config, error) {
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, errors.Wrap(err, "error loading TLS certificate")
+	}
+
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(clientCAPEM) {
+		return nil, errors.New("no TLS client certificate authority certificates found")
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+		MinVersion:   tls.VersionTLS12,
+		// gRPC negotiates HTTP/2 with ALPN
+		NextProtos: []string{"h2"},
+	}, nil
+}
+
+// ClientTLSConfig returns the TLS configuration of a client of a remote plugin
+// server that authenticates with the certificate certPEM and its key keyPEM,
+// and verifies that the server's certificate is valid for serverName and signed
+// by a certificate authority in caPEM. If serverName is empty, the certificate
+// is verified for the host the client connects to.
+func ClientTLSConfig(certPEM, keyPEM, caPEM []byte, serverName string) (*tls.Config, error) {
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, errors.Wrap(err, "error loading TLS client certificate")
+	}
+
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(caPEM) {
+		return nil, errors.New("no TLS certificate authority certificates found")
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      rootCAs,
+		ServerName:   serverName,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
@@ -0,0 +1,174 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	plugin "github.com/hashicorp/go-plugin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// configObjectStore is an ObjectStore that lists the bucket it was initialized with.
+type configObjectStore struct {
+	velero.ObjectStore
+	bucket string
+}
+
+func (o *configObjectStore) Init(config map[string]string) error {
+	o.bucket = config["bucket"]
+	return nil
+}
+
+func (o *configObjectStore) ListObjects(bucket, prefix string) ([]string, error) {
+	return []string{o.bucket}, nil
+}
+
+// dialRemoteObjectStore returns a client of the object store named name served at address,
+// which authenticates with tlsConfig.
+func dialRemoteObjectStore(t *testing.T, address, name string, tlsConfig *tls.Config) (*ObjectStoreGRPCClient, *grpc.ClientConn) {
+	clientConn, err := grpc.Dial(address, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	require.NoError(t, err)
+	t.Cleanup(func() { clientConn.Close() })
+
+	base := &clientBase{kind: PluginKindObjectStore, plugin: name, logger: velerotest.NewLogger()}
+	return newObjectStoreGRPCClient(base, clientConn).(*ObjectStoreGRPCClient), clientConn
+}
+
+func TestRemoteGRPCServer(t *testing.T) {
+	certs := velerotest.NewTLSCertificates("127.0.0.1")
+
+	objectStore := NewObjectStorePlugin(serverLogger(velerotest.NewLogger()))
+	objectStore.register("velero.io/config", func(logrus.FieldLogger) (interface{}, error) {
+		return new(configObjectStore), nil
+	})
+
+	tlsConfig, err := serverTLSConfig(certs.ServerCert, certs.ServerKey, certs.CA)
+	require.NoError(t, err)
+	server, err := newRemoteGRPCServer(tlsConfig, map[string]plugin.Plugin{string(PluginKindObjectStore): objectStore})
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	clientTLSConfig, err := ClientTLSConfig(certs.ClientCert, certs.ClientKey, certs.CA, "")
+	require.NoError(t, err)
+
+	// each connection gets its own instance of the object store
+	client1, clientConn1 := dialRemoteObjectStore(t, listener.Addr().String(), "velero.io/config", clientTLSConfig)
+	client2, _ := dialRemoteObjectStore(t, listener.Addr().String(), "velero.io/config", clientTLSConfig)
+	require.NoError(t, client1.Init(map[string]string{"bucket": "bucket-1"}))
+	require.NoError(t, client2.Init(map[string]string{"bucket": "bucket-2"}))
+
+	objects, err := client1.ListObjects("", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bucket-1"}, objects)
+	objects, err = client2.ListObjects("", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bucket-2"}, objects)
+
+	// the instances of closed connections are discarded
+	clientConn1.Close()
+	assert.Eventually(t, func() bool {
+		objectStore.lock.Lock()
+		defer objectStore.lock.Unlock()
+		return len(objectStore.handlers) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// clients without a certificate signed by the client certificate authority are rejected
+	rootCAs := x509.NewCertPool()
+	require.True(t, rootCAs.AppendCertsFromPEM(certs.CA))
+	anonymous, _ := dialRemoteObjectStore(t, listener.Addr().String(), "velero.io/config", &tls.Config{RootCAs: rootCAs})
+	assert.Error(t, anonymous.Init(nil))
+}
+
+// writeServerTLSFiles writes the server certificate, key and client certificate authority of certs to dir, and
+// returns their paths.
+func writeServerTLSFiles(t *testing.T, dir string, certs velerotest.TLSCertificates) (string, string, string) {
+	certFile, keyFile, clientCAFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key"), filepath.Join(dir, "ca.crt")
+	require.NoError(t, ioutil.WriteFile(certFile, certs.ServerCert, 0600))
+	require.NoError(t, ioutil.WriteFile(keyFile, certs.ServerKey, 0600))
+	require.NoError(t, ioutil.WriteFile(clientCAFile, certs.CA, 0600))
+	return certFile, keyFile, clientCAFile
+}
+
+func TestReloadingServerTLSConfig(t *testing.T) {
+	dir := t.TempDir()
+	certs := velerotest.NewTLSCertificates("127.0.0.1")
+	certFile, keyFile, clientCAFile := writeServerTLSFiles(t, dir, certs)
+
+	_, err := reloadingServerTLSConfig(certFile, keyFile, filepath.Join(dir, "missing.crt"))
+	assert.Error(t, err)
+
+	tlsConfig, err := reloadingServerTLSConfig(certFile, keyFile, clientCAFile)
+	require.NoError(t, err)
+	server, err := newRemoteGRPCServer(tlsConfig, map[string]plugin.Plugin{
+		string(PluginKindObjectStore): NewObjectStorePlugin(serverLogger(velerotest.NewLogger())),
+	})
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	connect := func(certs velerotest.TLSCertificates) error {
+		clientTLSConfig, err := ClientTLSConfig(certs.ClientCert, certs.ClientKey, certs.CA, "")
+		require.NoError(t, err)
+		conn, err := tls.Dial("tcp", listener.Addr().String(), clientTLSConfig)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		// the client's handshake completes before the server verifies its certificate, and the server
+		// only sends its HTTP/2 settings once it has
+		_, err = conn.Read(make([]byte, 1))
+		return err
+	}
+	require.NoError(t, connect(certs))
+
+	// rotated certificates are used for new connections without restarting the server
+	rotated := velerotest.NewTLSCertificates("127.0.0.1")
+	writeServerTLSFiles(t, dir, rotated)
+	assert.NoError(t, connect(rotated))
+	assert.Error(t, connect(certs))
+}
+
+func TestClientTLSConfigRequiresCertificateAuthority(t *testing.T) {
+	certs := velerotest.NewTLSCertificates("127.0.0.1")
+
+	_, err := ClientTLSConfig(certs.ClientCert, certs.ClientKey, nil, "")
+	assert.EqualError(t, err, "no TLS certificate authority certificates found")
+
+	_, err = ClientTLSConfig(certs.ClientCert, certs.ServerKey, certs.CA, "")
+	assert.Error(t, err)
+}
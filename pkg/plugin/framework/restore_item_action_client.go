@@ -20,8 +20,8 @@ import (
 	"encoding/json"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -53,7 +53,9 @@ func newRestoreItemActionGRPCClient(base *clientBase, clientConn *grpc.ClientCon
 }
 
 func (c *RestoreItemActionGRPCClient) AppliesTo() (velero.ResourceSelector, error) {
-	res, err := c.grpcClient.AppliesTo(context.Background(), &proto.RestoreItemActionAppliesToRequest{Plugin: c.plugin})
+	ctx, done := c.callContext("AppliesTo")
+	res, err := c.grpcClient.AppliesTo(ctx, &proto.RestoreItemActionAppliesToRequest{Plugin: c.plugin})
+	done(err)
 	if err != nil {
 		return velero.ResourceSelector{}, fromGRPCError(err)
 	}
@@ -94,7 +96,14 @@ func (c *RestoreItemActionGRPCClient) Execute(input *velero.RestoreItemActionExe
 		Restore:        restoreJSON,
 	}
 
-	res, err := c.grpcClient.Execute(context.Background(), req)
+	ctx, done := c.callContext("Execute")
+	res, err := c.grpcClient.Execute(ctx, req)
+	if err != nil && ctx.Err() != nil {
+		// actions may fail the call themselves once its context is done, which
+		// is reported as the context's error
+		err = status.FromContextError(ctx.Err()).Err()
+	}
+	done(err)
 	if err != nil {
 		return nil, fromGRPCError(err)
 	}
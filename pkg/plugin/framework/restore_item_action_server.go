@@ -34,8 +34,8 @@ type RestoreItemActionGRPCServer struct {
 	mux *serverMux
 }
 
-func (s *RestoreItemActionGRPCServer) getImpl(name string) (velero.RestoreItemAction, error) {
-	impl, err := s.mux.getHandler(name)
+func (s *RestoreItemActionGRPCServer) getImpl(ctx context.Context, name string) (velero.RestoreItemAction, error) {
+	impl, err := s.mux.getHandler(ctx, name)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +55,7 @@ func (s *RestoreItemActionGRPCServer) AppliesTo(ctx context.Context, req *proto.
 		}
 	}()
 
-	impl, err := s.getImpl(req.Plugin)
+	impl, err := s.getImpl(ctx, req.Plugin)
 	if err != nil {
 		return nil, newGRPCError(err)
 	}
@@ -83,7 +83,7 @@ func (s *RestoreItemActionGRPCServer) Execute(ctx context.Context, req *proto.Re
 		}
 	}()
 
-	impl, err := s.getImpl(req.Plugin)
+	impl, err := s.getImpl(ctx, req.Plugin)
 	if err != nil {
 		return nil, newGRPCError(err)
 	}
@@ -0,0 +1,92 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// serveTestPlugin serves p over an in-memory connection, and returns a connection to it.
+func serveTestPlugin(t *testing.T, p interface {
+	GRPCServer(*plugin.GRPCBroker, *grpc.Server) error
+}) *grpc.ClientConn {
+	listener := bufconn.Listen(1024 * 1024)
+	server := newGRPCServer(nil)
+	require.NoError(t, p.GRPCServer(nil, server))
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	dialer := func(context.Context, string) (net.Conn, error) {
+		return listener.Dial()
+	}
+	clientConn, err := grpc.Dial("bufnet", grpc.WithContextDialer(dialer), grpc.WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(func() { clientConn.Close() })
+
+	return clientConn
+}
+
+// slowRestoreItemAction is a RestoreItemAction whose Execute fails after a while.
+type slowRestoreItemAction struct{}
+
+func (a *slowRestoreItemAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{}, nil
+}
+
+func (a *slowRestoreItemAction) Execute(*velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	time.Sleep(500 * time.Millisecond)
+	return nil, errors.New("failed")
+}
+
+func TestRestoreItemActionGRPCCallTimeout(t *testing.T) {
+	p := NewRestoreItemActionPlugin(serverLogger(velerotest.NewLogger()))
+	p.register("velero.io/slow", func(logrus.FieldLogger) (interface{}, error) {
+		return &slowRestoreItemAction{}, nil
+	})
+
+	base := &clientBase{
+		kind:   PluginKindRestoreItemAction,
+		plugin: "velero.io/slow",
+		logger: velerotest.NewLogger(),
+		ctx:    WithCallTimeout(context.Background(), 100*time.Millisecond),
+	}
+	pod := &unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": "v1", "kind": "Pod"}}
+	client := newRestoreItemActionGRPCClient(base, serveTestPlugin(t, p)).(*RestoreItemActionGRPCClient)
+
+	_, err := client.Execute(&velero.RestoreItemActionExecuteInput{
+		Item:           pod,
+		ItemFromBackup: pod,
+		Restore:        new(v1.Restore),
+	})
+	assert.Equal(t, ErrPluginCallTimeout, errors.Cause(err))
+}
@@ -46,6 +46,15 @@ type Server interface {
 	// RegisterBackupItemActions registers multiple backup item actions.
 	RegisterBackupItemActions(map[string]HandlerInitializer) Server
 
+	// RegisterBackupItemActionV2 registers a backup item action that implements
+	// velero.BackupItemActionV2. Accepted format for the plugin name is
+	// <DNS subdomain>/<non-empty name>.
+	RegisterBackupItemActionV2(pluginName string, initializer HandlerInitializer) Server
+
+	// RegisterBackupItemActionsV2 registers multiple backup item actions that
+	// implement velero.BackupItemActionV2.
+	RegisterBackupItemActionsV2(map[string]HandlerInitializer) Server
+
 	// RegisterVolumeSnapshotter registers a volume snapshotter. Accepted format
 	// for the plugin name is <DNS subdomain>/<non-empty name>.
 	RegisterVolumeSnapshotter(pluginName string, initializer HandlerInitializer) Server
@@ -80,15 +89,23 @@ type Server interface {
 
 // server implements Server.
 type server struct {
-	log               *logrus.Logger
-	logLevelFlag      *logging.LevelFlag
-	flagSet           *pflag.FlagSet
-	featureSet        *veleroflag.StringArray
-	backupItemAction  *BackupItemActionPlugin
-	volumeSnapshotter *VolumeSnapshotterPlugin
-	objectStore       *ObjectStorePlugin
-	restoreItemAction *RestoreItemActionPlugin
-	deleteItemAction  *DeleteItemActionPlugin
+	log                *logrus.Logger
+	logLevelFlag       *logging.LevelFlag
+	flagSet            *pflag.FlagSet
+	featureSet         *veleroflag.StringArray
+	backupItemAction   *BackupItemActionPlugin
+	backupItemActionV2 *BackupItemActionV2Plugin
+	volumeSnapshotter  *VolumeSnapshotterPlugin
+	objectStore        *ObjectStorePlugin
+	restoreItemAction  *RestoreItemActionPlugin
+	deleteItemAction   *DeleteItemActionPlugin
+
+	// listenAddress is the address to serve plugins at over the network, if they
+	// aren't served to the Velero server that runs the plugin executable.
+	listenAddress   string
+	tlsCertFile     string
+	tlsKeyFile      string
+	tlsClientCAFile string
 }
 
 // NewServer returns a new Server
@@ -97,20 +114,25 @@ func NewServer() Server {
 	features := veleroflag.NewStringArray()
 
 	return &server{
-		log:               log,
-		logLevelFlag:      logging.LogLevelFlag(log.Level),
-		featureSet:        &features,
-		backupItemAction:  NewBackupItemActionPlugin(serverLogger(log)),
-		volumeSnapshotter: NewVolumeSnapshotterPlugin(serverLogger(log)),
-		objectStore:       NewObjectStorePlugin(serverLogger(log)),
-		restoreItemAction: NewRestoreItemActionPlugin(serverLogger(log)),
-		deleteItemAction:  NewDeleteItemActionPlugin(serverLogger(log)),
+		log:                log,
+		logLevelFlag:       logging.LogLevelFlag(log.Level),
+		featureSet:         &features,
+		backupItemAction:   NewBackupItemActionPlugin(serverLogger(log)),
+		backupItemActionV2: NewBackupItemActionV2Plugin(serverLogger(log)),
+		volumeSnapshotter:  NewVolumeSnapshotterPlugin(serverLogger(log)),
+		objectStore:        NewObjectStorePlugin(serverLogger(log)),
+		restoreItemAction:  NewRestoreItemActionPlugin(serverLogger(log)),
+		deleteItemAction:   NewDeleteItemActionPlugin(serverLogger(log)),
 	}
 }
 
 func (s *server) BindFlags(flags *pflag.FlagSet) Server {
 	flags.Var(s.logLevelFlag, "log-level", fmt.Sprintf("The level at which to log. Valid values are %s.", strings.Join(s.logLevelFlag.AllowedValues(), ", ")))
 	flags.Var(s.featureSet, "features", "List of feature flags for this plugin")
+	flags.StringVar(&s.listenAddress, "listen-address", s.listenAddress, "The address to serve the plugins at over the network with mutual TLS, for Velero servers that register this plugin server with a PluginRegistration. If not set, the plugins are served to the Velero server that runs this executable.")
+	flags.StringVar(&s.tlsCertFile, "tls-cert-file", s.tlsCertFile, "The file containing the TLS certificate of the plugin server, when serving at --listen-address.")
+	flags.StringVar(&s.tlsKeyFile, "tls-key-file", s.tlsKeyFile, "The file containing the key of the TLS certificate of the plugin server, when serving at --listen-address.")
+	flags.StringVar(&s.tlsClientCAFile, "tls-client-ca-file", s.tlsClientCAFile, "The file containing the certificate authority that the certificates of Velero servers are verified with, when serving at --listen-address.")
 	s.flagSet = flags
 	s.flagSet.ParseErrorsWhitelist.UnknownFlags = true
 
@@ -129,6 +151,18 @@ func (s *server) RegisterBackupItemActions(m map[string]HandlerInitializer) Serv
 	return s
 }
 
+func (s *server) RegisterBackupItemActionV2(name string, initializer HandlerInitializer) Server {
+	s.backupItemActionV2.register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterBackupItemActionsV2(m map[string]HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterBackupItemActionV2(name, m[name])
+	}
+	return s
+}
+
 func (s *server) RegisterVolumeSnapshotter(name string, initializer HandlerInitializer) Server {
 	s.volumeSnapshotter.register(name, initializer)
 	return s
@@ -202,23 +236,41 @@ func (s *server) Serve() {
 
 	var pluginIdentifiers []PluginIdentifier
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindBackupItemAction, s.backupItemAction)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindBackupItemActionV2, s.backupItemActionV2)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindVolumeSnapshotter, s.volumeSnapshotter)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindObjectStore, s.objectStore)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindRestoreItemAction, s.restoreItemAction)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindDeleteItemAction, s.deleteItemAction)...)
 
-	pluginLister := NewPluginLister(pluginIdentifiers...)
+	pluginLister := newServingPluginLister(pluginIdentifiers, map[PluginKind]Interface{
+		PluginKindBackupItemAction:   s.backupItemAction,
+		PluginKindBackupItemActionV2: s.backupItemActionV2,
+		PluginKindVolumeSnapshotter:  s.volumeSnapshotter,
+		PluginKindObjectStore:        s.objectStore,
+		PluginKindRestoreItemAction:  s.restoreItemAction,
+		PluginKindDeleteItemAction:   s.deleteItemAction,
+	})
+
+	plugins := map[string]plugin.Plugin{
+		string(PluginKindBackupItemAction):   s.backupItemAction,
+		string(PluginKindBackupItemActionV2): s.backupItemActionV2,
+		string(PluginKindVolumeSnapshotter):  s.volumeSnapshotter,
+		string(PluginKindObjectStore):        s.objectStore,
+		string(PluginKindPluginLister):       NewPluginListerPlugin(pluginLister),
+		string(PluginKindRestoreItemAction):  s.restoreItemAction,
+		string(PluginKindDeleteItemAction):   s.deleteItemAction,
+	}
+
+	if s.listenAddress != "" {
+		if err := s.serveRemote(plugins); err != nil {
+			s.log.Fatalf("Error serving plugins: %v", err)
+		}
+		return
+	}
 
 	plugin.Serve(&plugin.ServeConfig{
 		HandshakeConfig: Handshake(),
-		Plugins: map[string]plugin.Plugin{
-			string(PluginKindBackupItemAction):  s.backupItemAction,
-			string(PluginKindVolumeSnapshotter): s.volumeSnapshotter,
-			string(PluginKindObjectStore):       s.objectStore,
-			string(PluginKindPluginLister):      NewPluginListerPlugin(pluginLister),
-			string(PluginKindRestoreItemAction): s.restoreItemAction,
-			string(PluginKindDeleteItemAction):  s.deleteItemAction,
-		},
-		GRPCServer: plugin.DefaultGRPCServer,
+		Plugins:         plugins,
+		GRPCServer:      newGRPCServer,
 	})
 }
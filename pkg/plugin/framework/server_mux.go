@@ -18,9 +18,11 @@ package framework
 
 import (
 	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 )
@@ -33,15 +35,24 @@ type HandlerInitializer func(logger logrus.FieldLogger) (interface{}, error)
 type serverMux struct {
 	kind         PluginKind
 	initializers map[string]HandlerInitializer
-	handlers     map[string]interface{}
 	serverLog    logrus.FieldLogger
+
+	// lock guards handlers
+	lock     sync.Mutex
+	handlers map[handlerKey]interface{}
+}
+
+// handlerKey identifies the instance of a plugin used by a connection to the plugin server.
+type handlerKey struct {
+	connection uint64
+	name       string
 }
 
 // newServerMux returns a new serverMux.
 func newServerMux(logger logrus.FieldLogger) *serverMux {
 	return &serverMux{
 		initializers: make(map[string]HandlerInitializer),
-		handlers:     make(map[string]interface{}),
+		handlers:     make(map[handlerKey]interface{}),
 		serverLog:    logger,
 	}
 }
@@ -61,10 +72,15 @@ func (m *serverMux) names() []string {
 	return sets.StringKeySet(m.initializers).List()
 }
 
-// getHandler returns the instance for a plugin with the given name. If an instance has already been initialized,
-// that is returned. Otherwise, the instance is initialized by calling its initialization function.
-func (m *serverMux) getHandler(name string) (interface{}, error) {
-	if instance, found := m.handlers[name]; found {
+// getHandler returns the instance for a plugin with the given name, for the connection of the call whose context is
+// ctx. If an instance has already been initialized, that is returned. Otherwise, the instance is initialized by calling
+// its initialization function.
+func (m *serverMux) getHandler(ctx context.Context, name string) (interface{}, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	key := handlerKey{connection: connectionID(ctx), name: name}
+	if instance, found := m.handlers[key]; found {
 		return instance, nil
 	}
 
@@ -78,9 +94,21 @@ func (m *serverMux) getHandler(name string) (interface{}, error) {
 		return nil, err
 	}
 
-	m.handlers[name] = instance
+	m.handlers[key] = instance
 
-	return m.handlers[name], nil
+	return instance, nil
+}
+
+// forgetConnection discards the instances of the connection with the given ID, once it's closed.
+func (m *serverMux) forgetConnection(connection uint64) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for key := range m.handlers {
+		if key.connection == connection {
+			delete(m.handlers, key)
+		}
+	}
 }
 
 // ValidatePluginName checks if the given name:
@@ -0,0 +1,101 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const tracerName = "github.com/vmware-tanzu/velero/pkg/plugin/framework"
+
+// traceContext propagates the trace of plugin calls to plugin servers in the W3C
+// traceparent and tracestate gRPC metadata.
+var traceContext = propagation.TraceContext{}
+
+// startCallSpan starts the span of a call of method to the plugin server, as a
+// child of the span of ctx, and returns a context that carries it to the plugin
+// server, and a function that must be called with the call's error once it
+// returns to end the span. Spans are only recorded when a tracer provider is set
+// with otel.SetTracerProvider.
+func (c *clientBase) startCallSpan(ctx context.Context, method string) (context.Context, func(error)) {
+	ctx, span := otel.Tracer(tracerName).Start(ctx, string(c.kind)+"/"+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("plugin.kind", string(c.kind)),
+			attribute.String("plugin.name", c.plugin),
+		),
+	)
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	traceContext.Inject(ctx, metadataCarrier(md))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	return ctx, func(err error) {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}
+}
+
+// extractTraceContext is a gRPC server interceptor that sets the span of the
+// plugin call, as propagated by the client, as the remote span of the context of
+// the call, so that plugins whose methods take a context can continue its trace.
+func extractTraceContext(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = traceContext.Extract(ctx, metadataCarrier(md))
+	}
+	return handler(ctx, req)
+}
+
+// newGRPCServer returns a gRPC server for plugins with opts that extracts the
+// trace context of plugin calls.
+func newGRPCServer(opts []grpc.ServerOption) *grpc.Server {
+	return grpc.NewServer(append(opts, grpc.UnaryInterceptor(extractTraceContext))...)
+}
+
+// metadataCarrier adapts gRPC metadata to a propagation.TextMapCarrier.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -0,0 +1,80 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/context"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// spanContextBackupItemAction is a BackupItemActionV2 that records the span context
+// of the context of its Execute calls.
+type spanContextBackupItemAction struct {
+	spanContext trace.SpanContext
+}
+
+func (a *spanContextBackupItemAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{}, nil
+}
+
+func (a *spanContextBackupItemAction) Execute(ctx context.Context, item runtime.Unstructured, backup *v1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+	a.spanContext = trace.SpanContextFromContext(ctx)
+	return item, nil, nil
+}
+
+func TestPluginCallTracing(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	defer otel.SetTracerProvider(previous)
+
+	action := new(spanContextBackupItemAction)
+	client := newBackupItemActionV2GRPCTestClient(t, "velero.io/span-context", func(logrus.FieldLogger) (interface{}, error) {
+		return action, nil
+	})
+
+	ctx, backupSpan := otel.Tracer("test").Start(context.Background(), "Backup")
+	item := &unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": "v1", "kind": "Pod"}}
+	_, _, err := client.Execute(ctx, item, new(v1.Backup))
+	require.NoError(t, err)
+	backupSpan.End()
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+
+	callSpan := spans[0]
+	assert.Equal(t, "BackupItemAction/v2/Execute", callSpan.Name)
+	assert.Equal(t, backupSpan.SpanContext().SpanID(), callSpan.Parent.SpanID())
+
+	// the action continues the trace of the call
+	assert.True(t, action.spanContext.IsRemote())
+	assert.Equal(t, callSpan.SpanContext.TraceID(), action.spanContext.TraceID())
+	assert.Equal(t, callSpan.SpanContext.SpanID(), action.spanContext.SpanID())
+}
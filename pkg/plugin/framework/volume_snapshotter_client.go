@@ -20,12 +20,14 @@ import (
 	"encoding/json"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
 // NewVolumeSnapshotterPlugin constructs a VolumeSnapshotterPlugin.
@@ -58,7 +60,10 @@ func (c *VolumeSnapshotterGRPCClient) Init(config map[string]string) error {
 		Config: config,
 	}
 
-	if _, err := c.grpcClient.Init(context.Background(), req); err != nil {
+	ctx, done := c.callContext("Init")
+	_, err := c.grpcClient.Init(ctx, req)
+	done(err)
+	if err != nil {
 		return fromGRPCError(err)
 	}
 
@@ -81,7 +86,9 @@ func (c *VolumeSnapshotterGRPCClient) CreateVolumeFromSnapshot(snapshotID, volum
 		req.Iops = *iops
 	}
 
-	res, err := c.grpcClient.CreateVolumeFromSnapshot(context.Background(), req)
+	ctx, done := c.callContext("CreateVolumeFromSnapshot")
+	res, err := c.grpcClient.CreateVolumeFromSnapshot(ctx, req)
+	done(err)
 	if err != nil {
 		return "", fromGRPCError(err)
 	}
@@ -98,7 +105,9 @@ func (c *VolumeSnapshotterGRPCClient) GetVolumeInfo(volumeID, volumeAZ string) (
 		VolumeAZ: volumeAZ,
 	}
 
-	res, err := c.grpcClient.GetVolumeInfo(context.Background(), req)
+	ctx, done := c.callContext("GetVolumeInfo")
+	res, err := c.grpcClient.GetVolumeInfo(ctx, req)
+	done(err)
 	if err != nil {
 		return "", nil, fromGRPCError(err)
 	}
@@ -121,8 +130,86 @@ func (c *VolumeSnapshotterGRPCClient) CreateSnapshot(volumeID, volumeAZ string,
 		Tags:     tags,
 	}
 
-	res, err := c.grpcClient.CreateSnapshot(context.Background(), req)
+	ctx, done := c.callContext("CreateSnapshot")
+	res, err := c.grpcClient.CreateSnapshot(ctx, req)
+	done(err)
+	if err != nil {
+		return "", fromGRPCError(err)
+	}
+
+	return res.SnapshotID, nil
+}
+
+// CreateGroupSnapshot creates a snapshot of each of the specified block volumes at a single
+// point in time, and applies the provided set of tags to the snapshots. Plugins that don't
+// support group snapshots, including ones built before the call was added, return
+// velero.ErrGroupSnapshotNotSupported.
+func (c *VolumeSnapshotterGRPCClient) CreateGroupSnapshot(volumeAZs map[string]string, tags map[string]string) (map[string]string, error) {
+	req := &proto.CreateGroupSnapshotRequest{
+		Plugin:    c.plugin,
+		VolumeAZs: volumeAZs,
+		Tags:      tags,
+	}
+
+	ctx, done := c.callContext("CreateGroupSnapshot")
+	res, err := c.grpcClient.CreateGroupSnapshot(ctx, req)
+	done(err)
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return nil, velero.ErrGroupSnapshotNotSupported
+		}
+		return nil, fromGRPCError(err)
+	}
+
+	return res.SnapshotIDs, nil
+}
+
+// SnapshotProgress returns the progress of uploading the data of the specified snapshot. Plugins
+// that don't track the progress of their snapshots, including ones built before the call was
+// added, return velero.ErrSnapshotProgressNotSupported.
+func (c *VolumeSnapshotterGRPCClient) SnapshotProgress(snapshotID string) (*velero.SnapshotProgress, error) {
+	req := &proto.SnapshotProgressRequest{
+		Plugin:     c.plugin,
+		SnapshotID: snapshotID,
+	}
+
+	ctx, done := c.callContext("SnapshotProgress")
+	res, err := c.grpcClient.SnapshotProgress(ctx, req)
+	done(err)
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return nil, velero.ErrSnapshotProgressNotSupported
+		}
+		return nil, fromGRPCError(err)
+	}
+
+	return &velero.SnapshotProgress{
+		Completed:     res.Completed,
+		FailureReason: res.FailureReason,
+		BytesDone:     res.BytesDone,
+		BytesTotal:    res.BytesTotal,
+		Description:   res.Description,
+	}, nil
+}
+
+// CopySnapshot copies the specified snapshot to the region or location described by the provided
+// config, and returns the ID of the copy. Plugins that don't copy snapshots, including ones built
+// before the call was added, return velero.ErrCopySnapshotNotSupported.
+func (c *VolumeSnapshotterGRPCClient) CopySnapshot(snapshotID, volumeAZ string, config map[string]string) (string, error) {
+	req := &proto.CopySnapshotRequest{
+		Plugin:     c.plugin,
+		SnapshotID: snapshotID,
+		VolumeAZ:   volumeAZ,
+		Config:     config,
+	}
+
+	ctx, done := c.callContext("CopySnapshot")
+	res, err := c.grpcClient.CopySnapshot(ctx, req)
+	done(err)
 	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return "", velero.ErrCopySnapshotNotSupported
+		}
 		return "", fromGRPCError(err)
 	}
 
@@ -136,7 +223,10 @@ func (c *VolumeSnapshotterGRPCClient) DeleteSnapshot(snapshotID string) error {
 		SnapshotID: snapshotID,
 	}
 
-	if _, err := c.grpcClient.DeleteSnapshot(context.Background(), req); err != nil {
+	ctx, done := c.callContext("DeleteSnapshot")
+	_, err := c.grpcClient.DeleteSnapshot(ctx, req)
+	done(err)
+	if err != nil {
 		return fromGRPCError(err)
 	}
 
@@ -154,7 +244,9 @@ func (c *VolumeSnapshotterGRPCClient) GetVolumeID(pv runtime.Unstructured) (stri
 		PersistentVolume: encodedPV,
 	}
 
-	resp, err := c.grpcClient.GetVolumeID(context.Background(), req)
+	ctx, done := c.callContext("GetVolumeID")
+	resp, err := c.grpcClient.GetVolumeID(ctx, req)
+	done(err)
 	if err != nil {
 		return "", fromGRPCError(err)
 	}
@@ -174,7 +266,9 @@ func (c *VolumeSnapshotterGRPCClient) SetVolumeID(pv runtime.Unstructured, volum
 		VolumeID:         volumeID,
 	}
 
-	resp, err := c.grpcClient.SetVolumeID(context.Background(), req)
+	ctx, done := c.callContext("SetVolumeID")
+	resp, err := c.grpcClient.SetVolumeID(ctx, req)
+	done(err)
 	if err != nil {
 		return nil, fromGRPCError(err)
 	}
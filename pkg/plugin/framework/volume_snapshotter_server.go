@@ -21,6 +21,7 @@ import (
 
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
@@ -33,8 +34,8 @@ type VolumeSnapshotterGRPCServer struct {
 	mux *serverMux
 }
 
-func (s *VolumeSnapshotterGRPCServer) getImpl(name string) (velero.VolumeSnapshotter, error) {
-	impl, err := s.mux.getHandler(name)
+func (s *VolumeSnapshotterGRPCServer) getImpl(ctx context.Context, name string) (velero.VolumeSnapshotter, error) {
+	impl, err := s.mux.getHandler(ctx, name)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +58,7 @@ func (s *VolumeSnapshotterGRPCServer) Init(ctx context.Context, req *proto.Volum
 		}
 	}()
 
-	impl, err := s.getImpl(req.Plugin)
+	impl, err := s.getImpl(ctx, req.Plugin)
 	if err != nil {
 		return nil, newGRPCError(err)
 	}
@@ -78,7 +79,7 @@ func (s *VolumeSnapshotterGRPCServer) CreateVolumeFromSnapshot(ctx context.Conte
 		}
 	}()
 
-	impl, err := s.getImpl(req.Plugin)
+	impl, err := s.getImpl(ctx, req.Plugin)
 	if err != nil {
 		return nil, newGRPCError(err)
 	}
@@ -109,7 +110,7 @@ func (s *VolumeSnapshotterGRPCServer) GetVolumeInfo(ctx context.Context, req *pr
 		}
 	}()
 
-	impl, err := s.getImpl(req.Plugin)
+	impl, err := s.getImpl(ctx, req.Plugin)
 	if err != nil {
 		return nil, newGRPCError(err)
 	}
@@ -139,7 +140,7 @@ func (s *VolumeSnapshotterGRPCServer) CreateSnapshot(ctx context.Context, req *p
 		}
 	}()
 
-	impl, err := s.getImpl(req.Plugin)
+	impl, err := s.getImpl(ctx, req.Plugin)
 	if err != nil {
 		return nil, newGRPCError(err)
 	}
@@ -152,6 +153,104 @@ func (s *VolumeSnapshotterGRPCServer) CreateSnapshot(ctx context.Context, req *p
 	return &proto.CreateSnapshotResponse{SnapshotID: snapshotID}, nil
 }
 
+// CreateGroupSnapshot creates a snapshot of each of the specified block volumes at a single
+// point in time, and applies the provided set of tags to the snapshots. If the implementation
+// doesn't support group snapshots, an Unimplemented error is returned.
+func (s *VolumeSnapshotterGRPCServer) CreateGroupSnapshot(ctx context.Context, req *proto.CreateGroupSnapshotRequest) (response *proto.CreateGroupSnapshotResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(ctx, req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	groupSnapshotter, ok := impl.(velero.GroupVolumeSnapshotter)
+	if !ok {
+		return nil, newGRPCErrorWithCode(velero.ErrGroupSnapshotNotSupported, codes.Unimplemented)
+	}
+
+	snapshotIDs, err := groupSnapshotter.CreateGroupSnapshot(req.VolumeAZs, req.Tags)
+	if err == velero.ErrGroupSnapshotNotSupported {
+		return nil, newGRPCErrorWithCode(err, codes.Unimplemented)
+	}
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.CreateGroupSnapshotResponse{SnapshotIDs: snapshotIDs}, nil
+}
+
+// SnapshotProgress returns the progress of uploading the data of the specified snapshot. If the
+// implementation doesn't track the progress of its snapshots, an Unimplemented error is returned.
+func (s *VolumeSnapshotterGRPCServer) SnapshotProgress(ctx context.Context, req *proto.SnapshotProgressRequest) (response *proto.SnapshotProgressResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(ctx, req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	progressReporter, ok := impl.(velero.SnapshotProgressReporter)
+	if !ok {
+		return nil, newGRPCErrorWithCode(velero.ErrSnapshotProgressNotSupported, codes.Unimplemented)
+	}
+
+	progress, err := progressReporter.SnapshotProgress(req.SnapshotID)
+	if err == velero.ErrSnapshotProgressNotSupported {
+		return nil, newGRPCErrorWithCode(err, codes.Unimplemented)
+	}
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.SnapshotProgressResponse{
+		Completed:     progress.Completed,
+		FailureReason: progress.FailureReason,
+		BytesDone:     progress.BytesDone,
+		BytesTotal:    progress.BytesTotal,
+		Description:   progress.Description,
+	}, nil
+}
+
+// CopySnapshot copies the specified snapshot to the region or location described by the provided
+// config, and returns the ID of the copy. If the implementation doesn't copy snapshots, an
+// Unimplemented error is returned.
+func (s *VolumeSnapshotterGRPCServer) CopySnapshot(ctx context.Context, req *proto.CopySnapshotRequest) (response *proto.CopySnapshotResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(ctx, req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	copier, ok := impl.(velero.SnapshotCopier)
+	if !ok {
+		return nil, newGRPCErrorWithCode(velero.ErrCopySnapshotNotSupported, codes.Unimplemented)
+	}
+
+	snapshotID, err := copier.CopySnapshot(req.SnapshotID, req.VolumeAZ, req.Config)
+	if err == velero.ErrCopySnapshotNotSupported {
+		return nil, newGRPCErrorWithCode(err, codes.Unimplemented)
+	}
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.CopySnapshotResponse{SnapshotID: snapshotID}, nil
+}
+
 // DeleteSnapshot deletes the specified volume snapshot.
 func (s *VolumeSnapshotterGRPCServer) DeleteSnapshot(ctx context.Context, req *proto.DeleteSnapshotRequest) (response *proto.Empty, err error) {
 	defer func() {
@@ -160,7 +259,7 @@ func (s *VolumeSnapshotterGRPCServer) DeleteSnapshot(ctx context.Context, req *p
 		}
 	}()
 
-	impl, err := s.getImpl(req.Plugin)
+	impl, err := s.getImpl(ctx, req.Plugin)
 	if err != nil {
 		return nil, newGRPCError(err)
 	}
@@ -179,7 +278,7 @@ func (s *VolumeSnapshotterGRPCServer) GetVolumeID(ctx context.Context, req *prot
 		}
 	}()
 
-	impl, err := s.getImpl(req.Plugin)
+	impl, err := s.getImpl(ctx, req.Plugin)
 	if err != nil {
 		return nil, newGRPCError(err)
 	}
@@ -205,7 +304,7 @@ func (s *VolumeSnapshotterGRPCServer) SetVolumeID(ctx context.Context, req *prot
 		}
 	}()
 
-	impl, err := s.getImpl(req.Plugin)
+	impl, err := s.getImpl(ctx, req.Plugin)
 	if err != nil {
 		return nil, newGRPCError(err)
 	}
@@ -0,0 +1,375 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestVolumeSnapshotterGRPCServerCreateGroupSnapshot(t *testing.T) {
+	volumeAZs := map[string]string{"vol-1": "zone-1", "vol-2": "zone-1"}
+	tags := map[string]string{"velero.io/backup": "backup-1"}
+
+	tests := []struct {
+		name                string
+		impl                func() velero.VolumeSnapshotter
+		expectedSnapshotIDs map[string]string
+		expectedCode        codes.Code
+	}{
+		{
+			name: "snapshot IDs returned by the impl are returned",
+			impl: func() velero.VolumeSnapshotter {
+				impl := new(mocks.VolumeSnapshotter)
+				impl.On("CreateGroupSnapshot", volumeAZs, tags).Return(map[string]string{"vol-1": "snap-1", "vol-2": "snap-2"}, nil)
+				return impl
+			},
+			expectedSnapshotIDs: map[string]string{"vol-1": "snap-1", "vol-2": "snap-2"},
+		},
+		{
+			name: "impl that doesn't implement group snapshots returns an Unimplemented error",
+			impl: func() velero.VolumeSnapshotter {
+				return new(velerotest.FakeVolumeSnapshotter)
+			},
+			expectedCode: codes.Unimplemented,
+		},
+		{
+			name: "impl returning ErrGroupSnapshotNotSupported returns an Unimplemented error",
+			impl: func() velero.VolumeSnapshotter {
+				impl := new(mocks.VolumeSnapshotter)
+				impl.On("CreateGroupSnapshot", volumeAZs, tags).Return(nil, velero.ErrGroupSnapshotNotSupported)
+				return impl
+			},
+			expectedCode: codes.Unimplemented,
+		},
+		{
+			name: "other errors returned by the impl return an Unknown error",
+			impl: func() velero.VolumeSnapshotter {
+				impl := new(mocks.VolumeSnapshotter)
+				impl.On("CreateGroupSnapshot", volumeAZs, tags).Return(nil, errors.New("impl error"))
+				return impl
+			},
+			expectedCode: codes.Unknown,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &VolumeSnapshotterGRPCServer{mux: &serverMux{
+				serverLog: velerotest.NewLogger(),
+				handlers: map[handlerKey]interface{}{
+					{name: "xyz"}: test.impl(),
+				},
+			}}
+
+			res, err := s.CreateGroupSnapshot(context.Background(), &proto.CreateGroupSnapshotRequest{
+				Plugin:    "xyz",
+				VolumeAZs: volumeAZs,
+				Tags:      tags,
+			})
+			if test.expectedCode != codes.OK {
+				assert.Equal(t, test.expectedCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedSnapshotIDs, res.SnapshotIDs)
+		})
+	}
+}
+
+// fakeVolumeSnapshotterClient is a proto.VolumeSnapshotterClient that returns the configured
+// responses and errors from CreateGroupSnapshot, SnapshotProgress and CopySnapshot.
+type fakeVolumeSnapshotterClient struct {
+	proto.VolumeSnapshotterClient
+	groupSnapshotResponse    *proto.CreateGroupSnapshotResponse
+	groupSnapshotErr         error
+	snapshotProgressResponse *proto.SnapshotProgressResponse
+	snapshotProgressErr      error
+	copySnapshotResponse     *proto.CopySnapshotResponse
+	copySnapshotErr          error
+}
+
+func (c *fakeVolumeSnapshotterClient) CreateGroupSnapshot(ctx context.Context, in *proto.CreateGroupSnapshotRequest, opts ...grpc.CallOption) (*proto.CreateGroupSnapshotResponse, error) {
+	return c.groupSnapshotResponse, c.groupSnapshotErr
+}
+
+func (c *fakeVolumeSnapshotterClient) SnapshotProgress(ctx context.Context, in *proto.SnapshotProgressRequest, opts ...grpc.CallOption) (*proto.SnapshotProgressResponse, error) {
+	return c.snapshotProgressResponse, c.snapshotProgressErr
+}
+
+func (c *fakeVolumeSnapshotterClient) CopySnapshot(ctx context.Context, in *proto.CopySnapshotRequest, opts ...grpc.CallOption) (*proto.CopySnapshotResponse, error) {
+	return c.copySnapshotResponse, c.copySnapshotErr
+}
+
+func TestVolumeSnapshotterGRPCClientCreateGroupSnapshot(t *testing.T) {
+	tests := []struct {
+		name                string
+		client              *fakeVolumeSnapshotterClient
+		expectedSnapshotIDs map[string]string
+		expectedErr         error
+	}{
+		{
+			name: "snapshot IDs are returned",
+			client: &fakeVolumeSnapshotterClient{
+				groupSnapshotResponse: &proto.CreateGroupSnapshotResponse{SnapshotIDs: map[string]string{"vol-1": "snap-1"}},
+			},
+			expectedSnapshotIDs: map[string]string{"vol-1": "snap-1"},
+		},
+		{
+			name: "Unimplemented errors are returned as ErrGroupSnapshotNotSupported",
+			client: &fakeVolumeSnapshotterClient{
+				groupSnapshotErr: status.Error(codes.Unimplemented, "unknown method CreateGroupSnapshot"),
+			},
+			expectedErr: velero.ErrGroupSnapshotNotSupported,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &VolumeSnapshotterGRPCClient{
+				clientBase: &clientBase{plugin: "xyz"},
+				grpcClient: test.client,
+			}
+
+			snapshotIDs, err := c.CreateGroupSnapshot(map[string]string{"vol-1": "zone-1"}, nil)
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.expectedSnapshotIDs, snapshotIDs)
+		})
+	}
+}
+
+func TestVolumeSnapshotterGRPCServerSnapshotProgress(t *testing.T) {
+	tests := []struct {
+		name             string
+		impl             func() velero.VolumeSnapshotter
+		expectedResponse *proto.SnapshotProgressResponse
+		expectedCode     codes.Code
+	}{
+		{
+			name: "progress returned by the impl is returned",
+			impl: func() velero.VolumeSnapshotter {
+				impl := new(mocks.VolumeSnapshotter)
+				impl.On("SnapshotProgress", "snap-1").Return(&velero.SnapshotProgress{BytesDone: 10, BytesTotal: 100, Description: "uploading"}, nil)
+				return impl
+			},
+			expectedResponse: &proto.SnapshotProgressResponse{BytesDone: 10, BytesTotal: 100, Description: "uploading"},
+		},
+		{
+			name: "impl that doesn't track snapshot progress returns an Unimplemented error",
+			impl: func() velero.VolumeSnapshotter {
+				return new(velerotest.FakeVolumeSnapshotter)
+			},
+			expectedCode: codes.Unimplemented,
+		},
+		{
+			name: "impl returning ErrSnapshotProgressNotSupported returns an Unimplemented error",
+			impl: func() velero.VolumeSnapshotter {
+				impl := new(mocks.VolumeSnapshotter)
+				impl.On("SnapshotProgress", "snap-1").Return(nil, velero.ErrSnapshotProgressNotSupported)
+				return impl
+			},
+			expectedCode: codes.Unimplemented,
+		},
+		{
+			name: "other errors returned by the impl return an Unknown error",
+			impl: func() velero.VolumeSnapshotter {
+				impl := new(mocks.VolumeSnapshotter)
+				impl.On("SnapshotProgress", "snap-1").Return(nil, errors.New("impl error"))
+				return impl
+			},
+			expectedCode: codes.Unknown,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &VolumeSnapshotterGRPCServer{mux: &serverMux{
+				serverLog: velerotest.NewLogger(),
+				handlers: map[handlerKey]interface{}{
+					{name: "xyz"}: test.impl(),
+				},
+			}}
+
+			res, err := s.SnapshotProgress(context.Background(), &proto.SnapshotProgressRequest{
+				Plugin:     "xyz",
+				SnapshotID: "snap-1",
+			})
+			if test.expectedCode != codes.OK {
+				assert.Equal(t, test.expectedCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, res)
+		})
+	}
+}
+
+func TestVolumeSnapshotterGRPCClientSnapshotProgress(t *testing.T) {
+	tests := []struct {
+		name             string
+		client           *fakeVolumeSnapshotterClient
+		expectedProgress *velero.SnapshotProgress
+		expectedErr      error
+	}{
+		{
+			name: "progress is returned",
+			client: &fakeVolumeSnapshotterClient{
+				snapshotProgressResponse: &proto.SnapshotProgressResponse{Completed: true, BytesDone: 100, BytesTotal: 100},
+			},
+			expectedProgress: &velero.SnapshotProgress{Completed: true, BytesDone: 100, BytesTotal: 100},
+		},
+		{
+			name: "Unimplemented errors are returned as ErrSnapshotProgressNotSupported",
+			client: &fakeVolumeSnapshotterClient{
+				snapshotProgressErr: status.Error(codes.Unimplemented, "unknown method SnapshotProgress"),
+			},
+			expectedErr: velero.ErrSnapshotProgressNotSupported,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &VolumeSnapshotterGRPCClient{
+				clientBase: &clientBase{plugin: "xyz"},
+				grpcClient: test.client,
+			}
+
+			progress, err := c.SnapshotProgress("snap-1")
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.expectedProgress, progress)
+		})
+	}
+}
+
+func TestVolumeSnapshotterGRPCServerCopySnapshot(t *testing.T) {
+	config := map[string]string{"region": "us-west-2"}
+
+	tests := []struct {
+		name           string
+		impl           func() velero.VolumeSnapshotter
+		expectedCopyID string
+		expectedCode   codes.Code
+	}{
+		{
+			name: "copy ID returned by the impl is returned",
+			impl: func() velero.VolumeSnapshotter {
+				impl := new(mocks.VolumeSnapshotter)
+				impl.On("CopySnapshot", "snap-1", "zone-1", config).Return("copy-1", nil)
+				return impl
+			},
+			expectedCopyID: "copy-1",
+		},
+		{
+			name: "impl that doesn't copy snapshots returns an Unimplemented error",
+			impl: func() velero.VolumeSnapshotter {
+				return new(velerotest.FakeVolumeSnapshotter)
+			},
+			expectedCode: codes.Unimplemented,
+		},
+		{
+			name: "impl returning ErrCopySnapshotNotSupported returns an Unimplemented error",
+			impl: func() velero.VolumeSnapshotter {
+				impl := new(mocks.VolumeSnapshotter)
+				impl.On("CopySnapshot", "snap-1", "zone-1", config).Return("", velero.ErrCopySnapshotNotSupported)
+				return impl
+			},
+			expectedCode: codes.Unimplemented,
+		},
+		{
+			name: "other errors returned by the impl return an Unknown error",
+			impl: func() velero.VolumeSnapshotter {
+				impl := new(mocks.VolumeSnapshotter)
+				impl.On("CopySnapshot", "snap-1", "zone-1", config).Return("", errors.New("impl error"))
+				return impl
+			},
+			expectedCode: codes.Unknown,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &VolumeSnapshotterGRPCServer{mux: &serverMux{
+				serverLog: velerotest.NewLogger(),
+				handlers: map[handlerKey]interface{}{
+					{name: "xyz"}: test.impl(),
+				},
+			}}
+
+			res, err := s.CopySnapshot(context.Background(), &proto.CopySnapshotRequest{
+				Plugin:     "xyz",
+				SnapshotID: "snap-1",
+				VolumeAZ:   "zone-1",
+				Config:     config,
+			})
+			if test.expectedCode != codes.OK {
+				assert.Equal(t, test.expectedCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedCopyID, res.SnapshotID)
+		})
+	}
+}
+
+func TestVolumeSnapshotterGRPCClientCopySnapshot(t *testing.T) {
+	tests := []struct {
+		name           string
+		client         *fakeVolumeSnapshotterClient
+		expectedCopyID string
+		expectedErr    error
+	}{
+		{
+			name: "copy ID is returned",
+			client: &fakeVolumeSnapshotterClient{
+				copySnapshotResponse: &proto.CopySnapshotResponse{SnapshotID: "copy-1"},
+			},
+			expectedCopyID: "copy-1",
+		},
+		{
+			name: "Unimplemented errors are returned as ErrCopySnapshotNotSupported",
+			client: &fakeVolumeSnapshotterClient{
+				copySnapshotErr: status.Error(codes.Unimplemented, "unknown method CopySnapshot"),
+			},
+			expectedErr: velero.ErrCopySnapshotNotSupported,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &VolumeSnapshotterGRPCClient{
+				clientBase: &clientBase{plugin: "xyz"},
+				grpcClient: test.client,
+			}
+
+			copyID, err := c.CopySnapshot("snap-1", "zone-1", map[string]string{"region": "us-west-2"})
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.expectedCopyID, copyID)
+		})
+	}
+}
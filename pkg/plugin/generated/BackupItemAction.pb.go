@@ -6,6 +6,7 @@ Package generated is a generated protocol buffer package.
 
 It is generated from these files:
 	BackupItemAction.proto
+	BackupItemActionV2.proto
 	DeleteItemAction.proto
 	ObjectStore.proto
 	PluginLister.proto
@@ -33,9 +34,13 @@ It has these top-level messages:
 	DeleteObjectRequest
 	CreateSignedURLRequest
 	CreateSignedURLResponse
+	PutObjectWithRetentionRequest
+	ObjectLocked
 	ObjectStoreInitRequest
 	PluginIdentifier
 	ListPluginsResponse
+	PluginCapabilitiesResponse
+	PluginHealthResponse
 	RestoreItemActionExecuteRequest
 	RestoreItemActionExecuteResponse
 	RestoreItemActionAppliesToRequest
@@ -51,6 +56,12 @@ It has these top-level messages:
 	GetVolumeInfoResponse
 	CreateSnapshotRequest
 	CreateSnapshotResponse
+	CreateGroupSnapshotRequest
+	CreateGroupSnapshotResponse
+	SnapshotProgressRequest
+	SnapshotProgressResponse
+	CopySnapshotRequest
+	CopySnapshotResponse
 	DeleteSnapshotRequest
 	GetVolumeIDRequest
 	GetVolumeIDResponse
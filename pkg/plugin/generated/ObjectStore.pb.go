@@ -27,7 +27,7 @@ type PutObjectRequest struct {
 func (m *PutObjectRequest) Reset()                    { *m = PutObjectRequest{} }
 func (m *PutObjectRequest) String() string            { return proto.CompactTextString(m) }
 func (*PutObjectRequest) ProtoMessage()               {}
-func (*PutObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{0} }
+func (*PutObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{0} }
 
 func (m *PutObjectRequest) GetPlugin() string {
 	if m != nil {
@@ -66,7 +66,7 @@ type ObjectExistsRequest struct {
 func (m *ObjectExistsRequest) Reset()                    { *m = ObjectExistsRequest{} }
 func (m *ObjectExistsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ObjectExistsRequest) ProtoMessage()               {}
-func (*ObjectExistsRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{1} }
+func (*ObjectExistsRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{1} }
 
 func (m *ObjectExistsRequest) GetPlugin() string {
 	if m != nil {
@@ -96,7 +96,7 @@ type ObjectExistsResponse struct {
 func (m *ObjectExistsResponse) Reset()                    { *m = ObjectExistsResponse{} }
 func (m *ObjectExistsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ObjectExistsResponse) ProtoMessage()               {}
-func (*ObjectExistsResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{2} }
+func (*ObjectExistsResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{2} }
 
 func (m *ObjectExistsResponse) GetExists() bool {
 	if m != nil {
@@ -114,7 +114,7 @@ type GetObjectRequest struct {
 func (m *GetObjectRequest) Reset()                    { *m = GetObjectRequest{} }
 func (m *GetObjectRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetObjectRequest) ProtoMessage()               {}
-func (*GetObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{3} }
+func (*GetObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{3} }
 
 func (m *GetObjectRequest) GetPlugin() string {
 	if m != nil {
@@ -144,7 +144,7 @@ type Bytes struct {
 func (m *Bytes) Reset()                    { *m = Bytes{} }
 func (m *Bytes) String() string            { return proto.CompactTextString(m) }
 func (*Bytes) ProtoMessage()               {}
-func (*Bytes) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{4} }
+func (*Bytes) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{4} }
 
 func (m *Bytes) GetData() []byte {
 	if m != nil {
@@ -163,7 +163,7 @@ type ListCommonPrefixesRequest struct {
 func (m *ListCommonPrefixesRequest) Reset()                    { *m = ListCommonPrefixesRequest{} }
 func (m *ListCommonPrefixesRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListCommonPrefixesRequest) ProtoMessage()               {}
-func (*ListCommonPrefixesRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{5} }
+func (*ListCommonPrefixesRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{5} }
 
 func (m *ListCommonPrefixesRequest) GetPlugin() string {
 	if m != nil {
@@ -200,7 +200,7 @@ type ListCommonPrefixesResponse struct {
 func (m *ListCommonPrefixesResponse) Reset()                    { *m = ListCommonPrefixesResponse{} }
 func (m *ListCommonPrefixesResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListCommonPrefixesResponse) ProtoMessage()               {}
-func (*ListCommonPrefixesResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{6} }
+func (*ListCommonPrefixesResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{6} }
 
 func (m *ListCommonPrefixesResponse) GetPrefixes() []string {
 	if m != nil {
@@ -218,7 +218,7 @@ type ListObjectsRequest struct {
 func (m *ListObjectsRequest) Reset()                    { *m = ListObjectsRequest{} }
 func (m *ListObjectsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListObjectsRequest) ProtoMessage()               {}
-func (*ListObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{7} }
+func (*ListObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{7} }
 
 func (m *ListObjectsRequest) GetPlugin() string {
 	if m != nil {
@@ -248,7 +248,7 @@ type ListObjectsResponse struct {
 func (m *ListObjectsResponse) Reset()                    { *m = ListObjectsResponse{} }
 func (m *ListObjectsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListObjectsResponse) ProtoMessage()               {}
-func (*ListObjectsResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{8} }
+func (*ListObjectsResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{8} }
 
 func (m *ListObjectsResponse) GetKeys() []string {
 	if m != nil {
@@ -266,7 +266,7 @@ type DeleteObjectRequest struct {
 func (m *DeleteObjectRequest) Reset()                    { *m = DeleteObjectRequest{} }
 func (m *DeleteObjectRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteObjectRequest) ProtoMessage()               {}
-func (*DeleteObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{9} }
+func (*DeleteObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{9} }
 
 func (m *DeleteObjectRequest) GetPlugin() string {
 	if m != nil {
@@ -299,7 +299,7 @@ type CreateSignedURLRequest struct {
 func (m *CreateSignedURLRequest) Reset()                    { *m = CreateSignedURLRequest{} }
 func (m *CreateSignedURLRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateSignedURLRequest) ProtoMessage()               {}
-func (*CreateSignedURLRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{10} }
+func (*CreateSignedURLRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{10} }
 
 func (m *CreateSignedURLRequest) GetPlugin() string {
 	if m != nil {
@@ -336,7 +336,7 @@ type CreateSignedURLResponse struct {
 func (m *CreateSignedURLResponse) Reset()                    { *m = CreateSignedURLResponse{} }
 func (m *CreateSignedURLResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateSignedURLResponse) ProtoMessage()               {}
-func (*CreateSignedURLResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{11} }
+func (*CreateSignedURLResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{11} }
 
 func (m *CreateSignedURLResponse) GetUrl() string {
 	if m != nil {
@@ -345,6 +345,102 @@ func (m *CreateSignedURLResponse) GetUrl() string {
 	return ""
 }
 
+type PutObjectWithRetentionRequest struct {
+	Plugin        string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Bucket        string `protobuf:"bytes,2,opt,name=bucket" json:"bucket,omitempty"`
+	Key           string `protobuf:"bytes,3,opt,name=key" json:"key,omitempty"`
+	Body          []byte `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	RetentionMode string `protobuf:"bytes,5,opt,name=retentionMode" json:"retentionMode,omitempty"`
+	RetainUntil   int64  `protobuf:"varint,6,opt,name=retainUntil" json:"retainUntil,omitempty"`
+	LegalHold     bool   `protobuf:"varint,7,opt,name=legalHold" json:"legalHold,omitempty"`
+}
+
+func (m *PutObjectWithRetentionRequest) Reset()                    { *m = PutObjectWithRetentionRequest{} }
+func (m *PutObjectWithRetentionRequest) String() string            { return proto.CompactTextString(m) }
+func (*PutObjectWithRetentionRequest) ProtoMessage()               {}
+func (*PutObjectWithRetentionRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{12} }
+
+func (m *PutObjectWithRetentionRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *PutObjectWithRetentionRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *PutObjectWithRetentionRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *PutObjectWithRetentionRequest) GetBody() []byte {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (m *PutObjectWithRetentionRequest) GetRetentionMode() string {
+	if m != nil {
+		return m.RetentionMode
+	}
+	return ""
+}
+
+func (m *PutObjectWithRetentionRequest) GetRetainUntil() int64 {
+	if m != nil {
+		return m.RetainUntil
+	}
+	return 0
+}
+
+func (m *PutObjectWithRetentionRequest) GetLegalHold() bool {
+	if m != nil {
+		return m.LegalHold
+	}
+	return false
+}
+
+type ObjectLocked struct {
+	Key         string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	RetainUntil int64  `protobuf:"varint,2,opt,name=retainUntil" json:"retainUntil,omitempty"`
+	LegalHold   bool   `protobuf:"varint,3,opt,name=legalHold" json:"legalHold,omitempty"`
+}
+
+func (m *ObjectLocked) Reset()                    { *m = ObjectLocked{} }
+func (m *ObjectLocked) String() string            { return proto.CompactTextString(m) }
+func (*ObjectLocked) ProtoMessage()               {}
+func (*ObjectLocked) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{13} }
+
+func (m *ObjectLocked) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ObjectLocked) GetRetainUntil() int64 {
+	if m != nil {
+		return m.RetainUntil
+	}
+	return 0
+}
+
+func (m *ObjectLocked) GetLegalHold() bool {
+	if m != nil {
+		return m.LegalHold
+	}
+	return false
+}
+
 type ObjectStoreInitRequest struct {
 	Plugin string            `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
 	Config map[string]string `protobuf:"bytes,2,rep,name=config" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
@@ -353,7 +449,7 @@ type ObjectStoreInitRequest struct {
 func (m *ObjectStoreInitRequest) Reset()                    { *m = ObjectStoreInitRequest{} }
 func (m *ObjectStoreInitRequest) String() string            { return proto.CompactTextString(m) }
 func (*ObjectStoreInitRequest) ProtoMessage()               {}
-func (*ObjectStoreInitRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{12} }
+func (*ObjectStoreInitRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{14} }
 
 func (m *ObjectStoreInitRequest) GetPlugin() string {
 	if m != nil {
@@ -369,6 +465,38 @@ func (m *ObjectStoreInitRequest) GetConfig() map[string]string {
 	return nil
 }
 
+type CheckObjectLockRequest struct {
+	Plugin string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Bucket string `protobuf:"bytes,2,opt,name=bucket" json:"bucket,omitempty"`
+	Key    string `protobuf:"bytes,3,opt,name=key" json:"key,omitempty"`
+}
+
+func (m *CheckObjectLockRequest) Reset()                    { *m = CheckObjectLockRequest{} }
+func (m *CheckObjectLockRequest) String() string            { return proto.CompactTextString(m) }
+func (*CheckObjectLockRequest) ProtoMessage()               {}
+func (*CheckObjectLockRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{15} }
+
+func (m *CheckObjectLockRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *CheckObjectLockRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *CheckObjectLockRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*PutObjectRequest)(nil), "generated.PutObjectRequest")
 	proto.RegisterType((*ObjectExistsRequest)(nil), "generated.ObjectExistsRequest")
@@ -382,7 +510,10 @@ func init() {
 	proto.RegisterType((*DeleteObjectRequest)(nil), "generated.DeleteObjectRequest")
 	proto.RegisterType((*CreateSignedURLRequest)(nil), "generated.CreateSignedURLRequest")
 	proto.RegisterType((*CreateSignedURLResponse)(nil), "generated.CreateSignedURLResponse")
+	proto.RegisterType((*PutObjectWithRetentionRequest)(nil), "generated.PutObjectWithRetentionRequest")
+	proto.RegisterType((*ObjectLocked)(nil), "generated.ObjectLocked")
 	proto.RegisterType((*ObjectStoreInitRequest)(nil), "generated.ObjectStoreInitRequest")
+	proto.RegisterType((*CheckObjectLockRequest)(nil), "generated.CheckObjectLockRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -404,6 +535,9 @@ type ObjectStoreClient interface {
 	ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (*ListObjectsResponse, error)
 	DeleteObject(ctx context.Context, in *DeleteObjectRequest, opts ...grpc.CallOption) (*Empty, error)
 	CreateSignedURL(ctx context.Context, in *CreateSignedURLRequest, opts ...grpc.CallOption) (*CreateSignedURLResponse, error)
+	PutObjectStream(ctx context.Context, opts ...grpc.CallOption) (ObjectStore_PutObjectStreamClient, error)
+	PutObjectWithRetention(ctx context.Context, opts ...grpc.CallOption) (ObjectStore_PutObjectWithRetentionClient, error)
+	CheckObjectLock(ctx context.Context, in *CheckObjectLockRequest, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type objectStoreClient struct {
@@ -534,6 +668,80 @@ func (c *objectStoreClient) CreateSignedURL(ctx context.Context, in *CreateSigne
 	return out, nil
 }
 
+func (c *objectStoreClient) PutObjectStream(ctx context.Context, opts ...grpc.CallOption) (ObjectStore_PutObjectStreamClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ObjectStore_serviceDesc.Streams[2], c.cc, "/generated.ObjectStore/PutObjectStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &objectStorePutObjectStreamClient{stream}
+	return x, nil
+}
+
+type ObjectStore_PutObjectStreamClient interface {
+	Send(*PutObjectRequest) error
+	Recv() (*Empty, error)
+	grpc.ClientStream
+}
+
+type objectStorePutObjectStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *objectStorePutObjectStreamClient) Send(m *PutObjectRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *objectStorePutObjectStreamClient) Recv() (*Empty, error) {
+	m := new(Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *objectStoreClient) PutObjectWithRetention(ctx context.Context, opts ...grpc.CallOption) (ObjectStore_PutObjectWithRetentionClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ObjectStore_serviceDesc.Streams[3], c.cc, "/generated.ObjectStore/PutObjectWithRetention", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &objectStorePutObjectWithRetentionClient{stream}
+	return x, nil
+}
+
+type ObjectStore_PutObjectWithRetentionClient interface {
+	Send(*PutObjectWithRetentionRequest) error
+	CloseAndRecv() (*Empty, error)
+	grpc.ClientStream
+}
+
+type objectStorePutObjectWithRetentionClient struct {
+	grpc.ClientStream
+}
+
+func (x *objectStorePutObjectWithRetentionClient) Send(m *PutObjectWithRetentionRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *objectStorePutObjectWithRetentionClient) CloseAndRecv() (*Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *objectStoreClient) CheckObjectLock(ctx context.Context, in *CheckObjectLockRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/generated.ObjectStore/CheckObjectLock", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for ObjectStore service
 
 type ObjectStoreServer interface {
@@ -545,6 +753,9 @@ type ObjectStoreServer interface {
 	ListObjects(context.Context, *ListObjectsRequest) (*ListObjectsResponse, error)
 	DeleteObject(context.Context, *DeleteObjectRequest) (*Empty, error)
 	CreateSignedURL(context.Context, *CreateSignedURLRequest) (*CreateSignedURLResponse, error)
+	PutObjectStream(ObjectStore_PutObjectStreamServer) error
+	PutObjectWithRetention(ObjectStore_PutObjectWithRetentionServer) error
+	CheckObjectLock(context.Context, *CheckObjectLockRequest) (*Empty, error)
 }
 
 func RegisterObjectStoreServer(s *grpc.Server, srv ObjectStoreServer) {
@@ -706,6 +917,76 @@ func _ObjectStore_CreateSignedURL_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ObjectStore_PutObjectStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ObjectStoreServer).PutObjectStream(&objectStorePutObjectStreamServer{stream})
+}
+
+type ObjectStore_PutObjectStreamServer interface {
+	Send(*Empty) error
+	Recv() (*PutObjectRequest, error)
+	grpc.ServerStream
+}
+
+type objectStorePutObjectStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *objectStorePutObjectStreamServer) Send(m *Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *objectStorePutObjectStreamServer) Recv() (*PutObjectRequest, error) {
+	m := new(PutObjectRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ObjectStore_PutObjectWithRetention_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ObjectStoreServer).PutObjectWithRetention(&objectStorePutObjectWithRetentionServer{stream})
+}
+
+type ObjectStore_PutObjectWithRetentionServer interface {
+	SendAndClose(*Empty) error
+	Recv() (*PutObjectWithRetentionRequest, error)
+	grpc.ServerStream
+}
+
+type objectStorePutObjectWithRetentionServer struct {
+	grpc.ServerStream
+}
+
+func (x *objectStorePutObjectWithRetentionServer) SendAndClose(m *Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *objectStorePutObjectWithRetentionServer) Recv() (*PutObjectWithRetentionRequest, error) {
+	m := new(PutObjectWithRetentionRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ObjectStore_CheckObjectLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckObjectLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStoreServer).CheckObjectLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.ObjectStore/CheckObjectLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStoreServer).CheckObjectLock(ctx, req.(*CheckObjectLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ObjectStore_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "generated.ObjectStore",
 	HandlerType: (*ObjectStoreServer)(nil),
@@ -734,6 +1015,10 @@ var _ObjectStore_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSignedURL",
 			Handler:    _ObjectStore_CreateSignedURL_Handler,
 		},
+		{
+			MethodName: "CheckObjectLock",
+			Handler:    _ObjectStore_CheckObjectLock_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -746,49 +1031,68 @@ var _ObjectStore_serviceDesc = grpc.ServiceDesc{
 			Handler:       _ObjectStore_GetObject_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "PutObjectStream",
+			Handler:       _ObjectStore_PutObjectStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "PutObjectWithRetention",
+			Handler:       _ObjectStore_PutObjectWithRetention_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "ObjectStore.proto",
 }
 
-func init() { proto.RegisterFile("ObjectStore.proto", fileDescriptor2) }
-
-var fileDescriptor2 = []byte{
-	// 577 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xd6, 0xc6, 0x69, 0x54, 0x4f, 0x22, 0x61, 0xb6, 0x55, 0x30, 0x2e, 0x94, 0xb0, 0x02, 0x29,
-	0x08, 0x11, 0xa1, 0x72, 0x29, 0xd0, 0x03, 0x22, 0x44, 0x08, 0x29, 0x52, 0x2b, 0x07, 0x04, 0x07,
-	0x2e, 0x4e, 0x3c, 0x4d, 0x4d, 0x1c, 0x3b, 0xd8, 0x6b, 0x54, 0x1f, 0x79, 0x25, 0x9e, 0x89, 0x07,
-	0x41, 0xbb, 0xde, 0x26, 0xde, 0xfc, 0x10, 0xa9, 0xca, 0x6d, 0x66, 0x76, 0xe7, 0x9b, 0x6f, 0x66,
-	0xfd, 0x8d, 0xe1, 0xee, 0xf9, 0xf0, 0x07, 0x8e, 0xf8, 0x80, 0xc7, 0x09, 0x76, 0x66, 0x49, 0xcc,
-	0x63, 0x6a, 0x8e, 0x31, 0xc2, 0xc4, 0xe3, 0xe8, 0x3b, 0x8d, 0xc1, 0x95, 0x97, 0xa0, 0x5f, 0x1c,
-	0xb0, 0x2b, 0xb0, 0x2e, 0x32, 0x5e, 0x24, 0xb8, 0xf8, 0x33, 0xc3, 0x94, 0xd3, 0x26, 0xd4, 0x66,
-	0x61, 0x36, 0x0e, 0x22, 0x9b, 0xb4, 0x48, 0xdb, 0x74, 0x95, 0x27, 0xe2, 0xc3, 0x6c, 0x34, 0x41,
-	0x6e, 0x57, 0x8a, 0x78, 0xe1, 0x51, 0x0b, 0x8c, 0x09, 0xe6, 0xb6, 0x21, 0x83, 0xc2, 0xa4, 0x14,
-	0xaa, 0xc3, 0xd8, 0xcf, 0xed, 0x6a, 0x8b, 0xb4, 0x1b, 0xae, 0xb4, 0xd9, 0x57, 0x38, 0x28, 0xca,
-	0xf4, 0xae, 0x83, 0x94, 0xa7, 0x3b, 0x2b, 0xc6, 0x3a, 0x70, 0xa8, 0x03, 0xa7, 0xb3, 0x38, 0x4a,
-	0x51, 0x20, 0xa0, 0x8c, 0x48, 0xe4, 0x7d, 0x57, 0x79, 0xec, 0x33, 0x58, 0x1f, 0x71, 0xd7, 0x2d,
-	0xb3, 0x23, 0xd8, 0x7b, 0x9f, 0x73, 0x4c, 0x45, 0xef, 0xbe, 0xc7, 0x3d, 0x09, 0xd4, 0x70, 0xa5,
-	0xcd, 0x7e, 0x13, 0xb8, 0xdf, 0x0f, 0x52, 0xde, 0x8d, 0xa7, 0xd3, 0x38, 0xba, 0x48, 0xf0, 0x32,
-	0xb8, 0xc6, 0x5b, 0x8f, 0xe0, 0x01, 0x98, 0x3e, 0x86, 0xc1, 0x34, 0xe0, 0x98, 0x28, 0x0a, 0x8b,
-	0x80, 0x44, 0x93, 0x05, 0xe4, 0xf4, 0x05, 0x9a, 0xf4, 0xd8, 0x29, 0x38, 0xeb, 0x28, 0xa8, 0x61,
-	0x39, 0xb0, 0x3f, 0x53, 0x31, 0x9b, 0xb4, 0x8c, 0xb6, 0xe9, 0xce, 0x7d, 0xf6, 0x1d, 0xa8, 0xc8,
-	0x2c, 0x26, 0x76, 0x6b, 0xd6, 0x0b, 0x5e, 0x86, 0xc6, 0xeb, 0x19, 0x1c, 0x68, 0xe8, 0x8a, 0x10,
-	0x85, 0xea, 0x04, 0xf3, 0x1b, 0x32, 0xd2, 0x16, 0x9f, 0xd0, 0x07, 0x0c, 0x91, 0xe3, 0xae, 0x1f,
-	0x2f, 0x84, 0x66, 0x37, 0x41, 0x8f, 0xe3, 0x20, 0x18, 0x47, 0xe8, 0x7f, 0x71, 0xfb, 0xbb, 0xd3,
-	0x82, 0x05, 0x06, 0xe7, 0xa1, 0x7c, 0x0c, 0xc3, 0x15, 0x26, 0x7b, 0x0e, 0xf7, 0x56, 0xaa, 0xa9,
-	0xae, 0x2d, 0x30, 0xb2, 0x24, 0x54, 0xb5, 0x84, 0xc9, 0xfe, 0x10, 0x68, 0x96, 0xf4, 0xfc, 0x29,
-	0x0a, 0xb6, 0xf6, 0xdd, 0x83, 0xda, 0x28, 0x8e, 0x2e, 0x83, 0xb1, 0x5d, 0x69, 0x19, 0xed, 0xfa,
-	0xc9, 0x8b, 0xce, 0x5c, 0xfd, 0x9d, 0xf5, 0x50, 0x9d, 0xae, 0xbc, 0xdf, 0x8b, 0x78, 0x92, 0xbb,
-	0x2a, 0xd9, 0x79, 0x0d, 0xf5, 0x52, 0xf8, 0xa6, 0x33, 0xb2, 0xe8, 0xec, 0x10, 0xf6, 0x7e, 0x79,
-	0x61, 0x86, 0x6a, 0x04, 0x85, 0xf3, 0xa6, 0x72, 0x4a, 0x4e, 0xfe, 0x56, 0xa1, 0x5e, 0xaa, 0x44,
-	0xdf, 0x42, 0x55, 0x54, 0xa3, 0x8f, 0xb7, 0x32, 0x71, 0xac, 0xd2, 0x95, 0xde, 0x74, 0xc6, 0x73,
-	0x7a, 0x06, 0xe6, 0x7c, 0x45, 0xd1, 0xa3, 0xd2, 0xf1, 0xf2, 0xe2, 0x5a, 0xcd, 0x6d, 0x13, 0x7a,
-	0x0e, 0x8d, 0xf2, 0x76, 0xa0, 0xc7, 0x2b, 0x14, 0xb4, 0x7d, 0xe4, 0x3c, 0xda, 0x78, 0xae, 0x9e,
-	0xe8, 0x0c, 0xcc, 0xf9, 0xfa, 0xd0, 0xe8, 0x2c, 0x2f, 0x15, 0x8d, 0x8e, 0xdc, 0x0d, 0x2f, 0x09,
-	0xf5, 0x0a, 0x2d, 0xe9, 0x2a, 0xa4, 0x4f, 0x4a, 0x37, 0x37, 0xee, 0x09, 0xe7, 0xe9, 0x96, 0x5b,
-	0x8a, 0x60, 0x1f, 0xea, 0x25, 0x41, 0xd1, 0x87, 0x4b, 0x59, 0xba, 0x8c, 0x9d, 0xe3, 0x4d, 0xc7,
-	0x0a, 0xed, 0x1d, 0x34, 0xca, 0x9a, 0xd3, 0xe6, 0xb7, 0x46, 0x8c, 0x6b, 0xde, 0xef, 0x1b, 0xdc,
-	0x59, 0xfa, 0xdc, 0xb5, 0xef, 0x60, 0xbd, 0xf0, 0x1c, 0xf6, 0xbf, 0x2b, 0x05, 0xb7, 0x61, 0x4d,
-	0xfe, 0xc3, 0x5e, 0xfd, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x4e, 0x76, 0xa3, 0xf1, 0x06, 0x00,
-	0x00,
+func init() { proto.RegisterFile("ObjectStore.proto", fileDescriptor3) }
+
+var fileDescriptor3 = []byte{
+	// 714 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x51, 0x6f, 0x12, 0x41,
+	0x10, 0xce, 0x71, 0x14, 0xcb, 0x80, 0x29, 0x6e, 0x1b, 0x3c, 0xaf, 0xb6, 0xe2, 0xa6, 0x26, 0x18,
+	0x23, 0x69, 0xea, 0x4b, 0xd5, 0x3e, 0x18, 0x29, 0x56, 0x13, 0x4c, 0x9b, 0xc3, 0x5a, 0x63, 0x7c,
+	0xf0, 0xe0, 0xa6, 0xb0, 0x72, 0xdc, 0xe1, 0xdd, 0x62, 0xca, 0xa3, 0x7f, 0xc9, 0x9f, 0xe1, 0x5f,
+	0xf0, 0xcf, 0x98, 0xdb, 0xdb, 0xc2, 0x1d, 0x6c, 0x4b, 0x6c, 0xf0, 0x6d, 0x67, 0x76, 0xf6, 0x9b,
+	0x6f, 0x76, 0x67, 0xbe, 0x3b, 0xb8, 0x73, 0xdc, 0xfe, 0x86, 0x1d, 0xde, 0xe2, 0x7e, 0x80, 0xb5,
+	0x61, 0xe0, 0x73, 0x9f, 0xe4, 0xbb, 0xe8, 0x61, 0x60, 0x73, 0x74, 0xcc, 0x62, 0xab, 0x67, 0x07,
+	0xe8, 0xc4, 0x1b, 0xb4, 0x07, 0xa5, 0x93, 0x11, 0x8f, 0x0f, 0x58, 0xf8, 0x7d, 0x84, 0x21, 0x27,
+	0x65, 0xc8, 0x0d, 0xdd, 0x51, 0x97, 0x79, 0x86, 0x56, 0xd1, 0xaa, 0x79, 0x4b, 0x5a, 0x91, 0xbf,
+	0x3d, 0xea, 0xf4, 0x91, 0x1b, 0x99, 0xd8, 0x1f, 0x5b, 0xa4, 0x04, 0x7a, 0x1f, 0xc7, 0x86, 0x2e,
+	0x9c, 0xd1, 0x92, 0x10, 0xc8, 0xb6, 0x7d, 0x67, 0x6c, 0x64, 0x2b, 0x5a, 0xb5, 0x68, 0x89, 0x35,
+	0x3d, 0x83, 0xf5, 0x38, 0x4d, 0xe3, 0x82, 0x85, 0x3c, 0x5c, 0x5a, 0x32, 0x5a, 0x83, 0x8d, 0x34,
+	0x70, 0x38, 0xf4, 0xbd, 0x10, 0x23, 0x04, 0x14, 0x1e, 0x81, 0xbc, 0x6a, 0x49, 0x8b, 0x7e, 0x80,
+	0xd2, 0x11, 0x2e, 0xbb, 0x64, 0xba, 0x09, 0x2b, 0xaf, 0xc7, 0x1c, 0xc3, 0xa8, 0x76, 0xc7, 0xe6,
+	0xb6, 0x00, 0x2a, 0x5a, 0x62, 0x4d, 0x7f, 0x6a, 0x70, 0xaf, 0xc9, 0x42, 0x5e, 0xf7, 0x07, 0x03,
+	0xdf, 0x3b, 0x09, 0xf0, 0x9c, 0x5d, 0xe0, 0x8d, 0xaf, 0xe0, 0x3e, 0xe4, 0x1d, 0x74, 0xd9, 0x80,
+	0x71, 0x0c, 0x24, 0x85, 0xa9, 0x43, 0xa0, 0x89, 0x04, 0x46, 0x56, 0xa2, 0x09, 0x8b, 0xee, 0x83,
+	0xa9, 0xa2, 0x20, 0x2f, 0xcb, 0x84, 0xd5, 0xa1, 0xf4, 0x19, 0x5a, 0x45, 0xaf, 0xe6, 0xad, 0x89,
+	0x4d, 0xbf, 0x00, 0x89, 0x4e, 0xc6, 0x37, 0x76, 0x63, 0xd6, 0x53, 0x5e, 0x7a, 0x8a, 0xd7, 0x63,
+	0x58, 0x4f, 0xa1, 0x4b, 0x42, 0x04, 0xb2, 0x7d, 0x1c, 0x5f, 0x92, 0x11, 0xeb, 0xa8, 0x85, 0x0e,
+	0xd1, 0x45, 0x8e, 0xcb, 0x7e, 0x3c, 0x17, 0xca, 0xf5, 0x00, 0x6d, 0x8e, 0x2d, 0xd6, 0xf5, 0xd0,
+	0x39, 0xb5, 0x9a, 0xcb, 0x9b, 0x85, 0x12, 0xe8, 0x9c, 0xbb, 0xe2, 0x31, 0x74, 0x2b, 0x5a, 0xd2,
+	0x27, 0x70, 0x77, 0x2e, 0x9b, 0xac, 0xba, 0x04, 0xfa, 0x28, 0x70, 0x65, 0xae, 0x68, 0x49, 0xff,
+	0x68, 0xb0, 0x35, 0x99, 0xd0, 0x33, 0xc6, 0x7b, 0x16, 0x72, 0xf4, 0x38, 0xf3, 0xbd, 0xff, 0x3a,
+	0xae, 0x64, 0x07, 0x6e, 0x07, 0x97, 0x99, 0xde, 0xfb, 0x0e, 0x1a, 0x2b, 0x22, 0x3e, 0xed, 0x24,
+	0x15, 0x28, 0x04, 0xc8, 0x6d, 0xe6, 0x9d, 0x7a, 0x9c, 0xb9, 0x46, 0x4e, 0x14, 0x99, 0x74, 0x45,
+	0xcd, 0xea, 0x62, 0xd7, 0x76, 0xdf, 0xfa, 0xae, 0x63, 0xdc, 0x12, 0x83, 0x38, 0x75, 0xd0, 0xaf,
+	0x50, 0x8c, 0x2b, 0x6b, 0xfa, 0x9d, 0x3e, 0x3a, 0x97, 0xdc, 0xb4, 0x29, 0xb7, 0x99, 0x0c, 0x99,
+	0x05, 0x19, 0xf4, 0xd9, 0x0c, 0xbf, 0x34, 0x28, 0x27, 0xf4, 0xf0, 0x9d, 0xc7, 0x16, 0xf6, 0x4d,
+	0x03, 0x72, 0x1d, 0xdf, 0x3b, 0x67, 0x5d, 0x23, 0x53, 0xd1, 0xab, 0x85, 0xbd, 0xa7, 0xb5, 0x89,
+	0x7a, 0xd6, 0xd4, 0x50, 0xb5, 0xba, 0x88, 0x6f, 0x78, 0x3c, 0x18, 0x5b, 0xf2, 0xb0, 0xf9, 0x1c,
+	0x0a, 0x09, 0xb7, 0xa2, 0xb4, 0x0d, 0x58, 0xf9, 0x61, 0xbb, 0x23, 0x94, 0xef, 0x13, 0x1b, 0x2f,
+	0x32, 0xfb, 0x1a, 0xfd, 0x0c, 0xe5, 0x7a, 0x0f, 0x3b, 0xfd, 0xe9, 0xdd, 0x2c, 0xed, 0xb1, 0xf7,
+	0x7e, 0xe7, 0xa0, 0x90, 0xa8, 0x82, 0xbc, 0x84, 0x6c, 0x54, 0x09, 0x79, 0xb8, 0xb0, 0x4a, 0xb3,
+	0x94, 0x08, 0x69, 0x0c, 0x86, 0x7c, 0x4c, 0x0e, 0x20, 0x3f, 0x69, 0x4e, 0xb2, 0x99, 0xd8, 0x9e,
+	0xfd, 0xa8, 0xcc, 0x9f, 0xad, 0x6a, 0xe4, 0x18, 0x8a, 0x49, 0xe5, 0x26, 0xdb, 0x73, 0x14, 0x52,
+	0xdf, 0x0a, 0xf3, 0xc1, 0x95, 0xfb, 0x72, 0x7c, 0x0e, 0x20, 0x7f, 0x84, 0x2a, 0x3a, 0x47, 0x78,
+	0x0d, 0x1d, 0xa1, 0xdb, 0xbb, 0x1a, 0xb1, 0x81, 0xcc, 0x2b, 0x24, 0xd9, 0x49, 0x44, 0x5e, 0xa9,
+	0xe1, 0xe6, 0xa3, 0x05, 0x51, 0x92, 0x60, 0x13, 0x0a, 0x09, 0xb1, 0x23, 0x5b, 0x33, 0xa7, 0xd2,
+	0x12, 0x6b, 0x6e, 0x5f, 0xb5, 0x2d, 0xd1, 0x5e, 0x41, 0x31, 0xa9, 0x87, 0xa9, 0xfb, 0x53, 0x08,
+	0xa5, 0xe2, 0xfd, 0x3e, 0xc1, 0xda, 0x8c, 0x14, 0xa5, 0xfa, 0x40, 0x2d, 0x8a, 0x26, 0xbd, 0x2e,
+	0x44, 0x72, 0x3b, 0x84, 0xb5, 0x49, 0x0f, 0xb4, 0x78, 0x80, 0xf6, 0xe0, 0x9f, 0xfb, 0x63, 0x57,
+	0x23, 0x1f, 0xa1, 0xac, 0x16, 0x3f, 0x52, 0x55, 0x81, 0xa9, 0xf4, 0x51, 0xd9, 0x79, 0x6f, 0x60,
+	0x6d, 0x66, 0xc0, 0xd2, 0x75, 0x2b, 0x87, 0x6f, 0x1e, 0xa9, 0x9d, 0x13, 0x7f, 0x51, 0xcf, 0xfe,
+	0x0e, 0x00, 0x86, 0x5f, 0x20, 0x77, 0x73, 0x09, 0x00, 0x00,
 }
@@ -26,7 +26,7 @@ type PluginIdentifier struct {
 func (m *PluginIdentifier) Reset()                    { *m = PluginIdentifier{} }
 func (m *PluginIdentifier) String() string            { return proto.CompactTextString(m) }
 func (*PluginIdentifier) ProtoMessage()               {}
-func (*PluginIdentifier) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{0} }
+func (*PluginIdentifier) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{0} }
 
 func (m *PluginIdentifier) GetCommand() string {
 	if m != nil {
@@ -56,7 +56,7 @@ type ListPluginsResponse struct {
 func (m *ListPluginsResponse) Reset()                    { *m = ListPluginsResponse{} }
 func (m *ListPluginsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListPluginsResponse) ProtoMessage()               {}
-func (*ListPluginsResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{1} }
+func (*ListPluginsResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{1} }
 
 func (m *ListPluginsResponse) GetPlugins() []*PluginIdentifier {
 	if m != nil {
@@ -65,9 +65,59 @@ func (m *ListPluginsResponse) GetPlugins() []*PluginIdentifier {
 	return nil
 }
 
+type PluginCapabilitiesResponse struct {
+	ApiVersion string   `protobuf:"bytes,1,opt,name=apiVersion" json:"apiVersion,omitempty"`
+	Features   []string `protobuf:"bytes,2,rep,name=features" json:"features,omitempty"`
+}
+
+func (m *PluginCapabilitiesResponse) Reset()                    { *m = PluginCapabilitiesResponse{} }
+func (m *PluginCapabilitiesResponse) String() string            { return proto.CompactTextString(m) }
+func (*PluginCapabilitiesResponse) ProtoMessage()               {}
+func (*PluginCapabilitiesResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{2} }
+
+func (m *PluginCapabilitiesResponse) GetApiVersion() string {
+	if m != nil {
+		return m.ApiVersion
+	}
+	return ""
+}
+
+func (m *PluginCapabilitiesResponse) GetFeatures() []string {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+type PluginHealthResponse struct {
+	Ready   bool   `protobuf:"varint,1,opt,name=ready" json:"ready,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *PluginHealthResponse) Reset()                    { *m = PluginHealthResponse{} }
+func (m *PluginHealthResponse) String() string            { return proto.CompactTextString(m) }
+func (*PluginHealthResponse) ProtoMessage()               {}
+func (*PluginHealthResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{3} }
+
+func (m *PluginHealthResponse) GetReady() bool {
+	if m != nil {
+		return m.Ready
+	}
+	return false
+}
+
+func (m *PluginHealthResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*PluginIdentifier)(nil), "generated.PluginIdentifier")
 	proto.RegisterType((*ListPluginsResponse)(nil), "generated.ListPluginsResponse")
+	proto.RegisterType((*PluginCapabilitiesResponse)(nil), "generated.PluginCapabilitiesResponse")
+	proto.RegisterType((*PluginHealthResponse)(nil), "generated.PluginHealthResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -82,6 +132,8 @@ const _ = grpc.SupportPackageIsVersion4
 
 type PluginListerClient interface {
 	ListPlugins(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListPluginsResponse, error)
+	Capabilities(ctx context.Context, in *PluginIdentifier, opts ...grpc.CallOption) (*PluginCapabilitiesResponse, error)
+	Health(ctx context.Context, in *PluginIdentifier, opts ...grpc.CallOption) (*PluginHealthResponse, error)
 }
 
 type pluginListerClient struct {
@@ -101,10 +153,30 @@ func (c *pluginListerClient) ListPlugins(ctx context.Context, in *Empty, opts ..
 	return out, nil
 }
 
+func (c *pluginListerClient) Capabilities(ctx context.Context, in *PluginIdentifier, opts ...grpc.CallOption) (*PluginCapabilitiesResponse, error) {
+	out := new(PluginCapabilitiesResponse)
+	err := grpc.Invoke(ctx, "/generated.PluginLister/Capabilities", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginListerClient) Health(ctx context.Context, in *PluginIdentifier, opts ...grpc.CallOption) (*PluginHealthResponse, error) {
+	out := new(PluginHealthResponse)
+	err := grpc.Invoke(ctx, "/generated.PluginLister/Health", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for PluginLister service
 
 type PluginListerServer interface {
 	ListPlugins(context.Context, *Empty) (*ListPluginsResponse, error)
+	Capabilities(context.Context, *PluginIdentifier) (*PluginCapabilitiesResponse, error)
+	Health(context.Context, *PluginIdentifier) (*PluginHealthResponse, error)
 }
 
 func RegisterPluginListerServer(s *grpc.Server, srv PluginListerServer) {
@@ -129,6 +201,42 @@ func _PluginLister_ListPlugins_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PluginLister_Capabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginListerServer).Capabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.PluginLister/Capabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginListerServer).Capabilities(ctx, req.(*PluginIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginLister_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginListerServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.PluginLister/Health",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginListerServer).Health(ctx, req.(*PluginIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PluginLister_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "generated.PluginLister",
 	HandlerType: (*PluginListerServer)(nil),
@@ -137,26 +245,41 @@ var _PluginLister_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListPlugins",
 			Handler:    _PluginLister_ListPlugins_Handler,
 		},
+		{
+			MethodName: "Capabilities",
+			Handler:    _PluginLister_Capabilities_Handler,
+		},
+		{
+			MethodName: "Health",
+			Handler:    _PluginLister_Health_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "PluginLister.proto",
 }
 
-func init() { proto.RegisterFile("PluginLister.proto", fileDescriptor3) }
-
-var fileDescriptor3 = []byte{
-	// 201 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x0a, 0xc8, 0x29, 0x4d,
-	0xcf, 0xcc, 0xf3, 0xc9, 0x2c, 0x2e, 0x49, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2,
-	0x4c, 0x4f, 0xcd, 0x4b, 0x2d, 0x4a, 0x2c, 0x49, 0x4d, 0x91, 0xe2, 0x09, 0xce, 0x48, 0x2c, 0x4a,
-	0x4d, 0x81, 0x48, 0x28, 0x85, 0x70, 0x09, 0x40, 0x94, 0x7b, 0xa6, 0xa4, 0xe6, 0x95, 0x64, 0xa6,
-	0x65, 0xa6, 0x16, 0x09, 0x49, 0x70, 0xb1, 0x27, 0xe7, 0xe7, 0xe6, 0x26, 0xe6, 0xa5, 0x48, 0x30,
-	0x2a, 0x30, 0x6a, 0x70, 0x06, 0xc1, 0xb8, 0x42, 0x42, 0x5c, 0x2c, 0xd9, 0x99, 0x79, 0x29, 0x12,
-	0x4c, 0x60, 0x61, 0x30, 0x1b, 0x24, 0x96, 0x97, 0x98, 0x9b, 0x2a, 0xc1, 0x0c, 0x11, 0x03, 0xb1,
-	0x95, 0x7c, 0xb8, 0x84, 0x41, 0xd6, 0x43, 0x4c, 0x2e, 0x0e, 0x4a, 0x2d, 0x2e, 0xc8, 0xcf, 0x2b,
-	0x4e, 0x15, 0x32, 0xe5, 0x62, 0x2f, 0x80, 0x08, 0x49, 0x30, 0x2a, 0x30, 0x6b, 0x70, 0x1b, 0x49,
-	0xeb, 0xc1, 0xdd, 0xa5, 0x87, 0xee, 0x8c, 0x20, 0x98, 0x5a, 0x23, 0x7f, 0x2e, 0x1e, 0x64, 0x2f,
-	0x09, 0xd9, 0x73, 0x71, 0x23, 0x99, 0x2e, 0x24, 0x80, 0x64, 0x88, 0x6b, 0x6e, 0x41, 0x49, 0xa5,
-	0x94, 0x1c, 0x92, 0x08, 0x16, 0x77, 0x24, 0xb1, 0x81, 0xfd, 0x6e, 0x0c, 0x08, 0x00, 0x00, 0xff,
-	0xff, 0x0e, 0xb5, 0xe4, 0x0c, 0x2a, 0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("PluginLister.proto", fileDescriptor4) }
+
+var fileDescriptor4 = []byte{
+	// 311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0x4a, 0x33, 0x31,
+	0x14, 0xc5, 0x99, 0xf6, 0xfb, 0xfa, 0xe7, 0xb6, 0x8b, 0x12, 0xbb, 0x08, 0x23, 0xd4, 0x32, 0x20,
+	0x74, 0xd5, 0x45, 0xc5, 0xb5, 0x0b, 0xb1, 0x28, 0x74, 0x21, 0x51, 0xc4, 0x6d, 0x6a, 0x6e, 0xa7,
+	0xc1, 0x99, 0x4c, 0x48, 0xd2, 0x45, 0x9f, 0xd9, 0x97, 0x90, 0x99, 0x74, 0xc6, 0x68, 0x4b, 0x77,
+	0x39, 0xe7, 0x5c, 0xce, 0xdc, 0xfb, 0x63, 0x80, 0x3c, 0x67, 0xbb, 0x54, 0xaa, 0x95, 0xb4, 0x0e,
+	0xcd, 0x5c, 0x9b, 0xc2, 0x15, 0xa4, 0x9f, 0xa2, 0x42, 0xc3, 0x1d, 0x8a, 0x78, 0xf8, 0xb2, 0xe5,
+	0x06, 0x85, 0x0f, 0x92, 0x57, 0x18, 0xf9, 0xf1, 0x27, 0x81, 0xca, 0xc9, 0x8d, 0x44, 0x43, 0x28,
+	0x74, 0x3f, 0x8a, 0x3c, 0xe7, 0x4a, 0xd0, 0x68, 0x1a, 0xcd, 0xfa, 0xac, 0x96, 0x84, 0xc0, 0xbf,
+	0x4f, 0xa9, 0x04, 0x6d, 0x55, 0x76, 0xf5, 0x2e, 0x3d, 0xc5, 0x73, 0xa4, 0x6d, 0xef, 0x95, 0xef,
+	0x64, 0x05, 0x17, 0xe5, 0xe7, 0x7d, 0xb3, 0x65, 0x68, 0x75, 0xa1, 0x2c, 0x92, 0x5b, 0xe8, 0x6a,
+	0x6f, 0xd1, 0x68, 0xda, 0x9e, 0x0d, 0x16, 0x97, 0xf3, 0x66, 0xaf, 0xf9, 0xdf, 0x35, 0x58, 0x3d,
+	0x9b, 0xbc, 0x43, 0xec, 0xc3, 0x7b, 0xae, 0xf9, 0x5a, 0x66, 0xd2, 0x49, 0xfc, 0x29, 0x9d, 0x00,
+	0x70, 0x2d, 0xdf, 0xd0, 0x58, 0x59, 0xa8, 0xc3, 0xc2, 0x81, 0x43, 0x62, 0xe8, 0x6d, 0x90, 0xbb,
+	0x9d, 0x41, 0x4b, 0x5b, 0xd3, 0xf6, 0xac, 0xcf, 0x1a, 0x9d, 0x2c, 0x61, 0xec, 0x9b, 0x1f, 0x91,
+	0x67, 0x6e, 0xdb, 0x74, 0x8e, 0xe1, 0xbf, 0x41, 0x2e, 0xf6, 0x55, 0x5d, 0x8f, 0x79, 0x51, 0x72,
+	0xc9, 0xd1, 0x5a, 0x9e, 0xe2, 0x01, 0x40, 0x2d, 0x17, 0x5f, 0x11, 0x0c, 0x43, 0xea, 0xe4, 0x0e,
+	0x06, 0x01, 0x00, 0x32, 0x0a, 0xee, 0x7c, 0xc8, 0xb5, 0xdb, 0xc7, 0x93, 0xc0, 0x39, 0x85, 0x8a,
+	0xc1, 0x30, 0xbc, 0x96, 0x9c, 0x23, 0x15, 0x5f, 0x1f, 0x85, 0x27, 0x49, 0x2d, 0xa1, 0xe3, 0xef,
+	0x3c, 0xdf, 0x76, 0x75, 0x14, 0xfe, 0xa6, 0xb3, 0xee, 0x54, 0xbf, 0xce, 0xcd, 0xf7, 0x00, 0x0f,
+	0xa9, 0x77, 0x86, 0x69, 0x02, 0x00, 0x00,
 }
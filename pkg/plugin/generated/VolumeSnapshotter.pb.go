@@ -28,7 +28,7 @@ type CreateVolumeRequest struct {
 func (m *CreateVolumeRequest) Reset()                    { *m = CreateVolumeRequest{} }
 func (m *CreateVolumeRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateVolumeRequest) ProtoMessage()               {}
-func (*CreateVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{0} }
+func (*CreateVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{0} }
 
 func (m *CreateVolumeRequest) GetPlugin() string {
 	if m != nil {
@@ -72,7 +72,7 @@ type CreateVolumeResponse struct {
 func (m *CreateVolumeResponse) Reset()                    { *m = CreateVolumeResponse{} }
 func (m *CreateVolumeResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateVolumeResponse) ProtoMessage()               {}
-func (*CreateVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{1} }
+func (*CreateVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{1} }
 
 func (m *CreateVolumeResponse) GetVolumeID() string {
 	if m != nil {
@@ -90,7 +90,7 @@ type GetVolumeInfoRequest struct {
 func (m *GetVolumeInfoRequest) Reset()                    { *m = GetVolumeInfoRequest{} }
 func (m *GetVolumeInfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetVolumeInfoRequest) ProtoMessage()               {}
-func (*GetVolumeInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{2} }
+func (*GetVolumeInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{2} }
 
 func (m *GetVolumeInfoRequest) GetPlugin() string {
 	if m != nil {
@@ -121,7 +121,7 @@ type GetVolumeInfoResponse struct {
 func (m *GetVolumeInfoResponse) Reset()                    { *m = GetVolumeInfoResponse{} }
 func (m *GetVolumeInfoResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetVolumeInfoResponse) ProtoMessage()               {}
-func (*GetVolumeInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{3} }
+func (*GetVolumeInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{3} }
 
 func (m *GetVolumeInfoResponse) GetVolumeType() string {
 	if m != nil {
@@ -147,7 +147,7 @@ type CreateSnapshotRequest struct {
 func (m *CreateSnapshotRequest) Reset()                    { *m = CreateSnapshotRequest{} }
 func (m *CreateSnapshotRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateSnapshotRequest) ProtoMessage()               {}
-func (*CreateSnapshotRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{4} }
+func (*CreateSnapshotRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{4} }
 
 func (m *CreateSnapshotRequest) GetPlugin() string {
 	if m != nil {
@@ -184,7 +184,7 @@ type CreateSnapshotResponse struct {
 func (m *CreateSnapshotResponse) Reset()                    { *m = CreateSnapshotResponse{} }
 func (m *CreateSnapshotResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateSnapshotResponse) ProtoMessage()               {}
-func (*CreateSnapshotResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{5} }
+func (*CreateSnapshotResponse) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{5} }
 
 func (m *CreateSnapshotResponse) GetSnapshotID() string {
 	if m != nil {
@@ -193,6 +193,182 @@ func (m *CreateSnapshotResponse) GetSnapshotID() string {
 	return ""
 }
 
+type CreateGroupSnapshotRequest struct {
+	Plugin    string            `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	VolumeAZs map[string]string `protobuf:"bytes,2,rep,name=volumeAZs" json:"volumeAZs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Tags      map[string]string `protobuf:"bytes,3,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *CreateGroupSnapshotRequest) Reset()                    { *m = CreateGroupSnapshotRequest{} }
+func (m *CreateGroupSnapshotRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateGroupSnapshotRequest) ProtoMessage()               {}
+func (*CreateGroupSnapshotRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{6} }
+
+func (m *CreateGroupSnapshotRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *CreateGroupSnapshotRequest) GetVolumeAZs() map[string]string {
+	if m != nil {
+		return m.VolumeAZs
+	}
+	return nil
+}
+
+func (m *CreateGroupSnapshotRequest) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type CreateGroupSnapshotResponse struct {
+	SnapshotIDs map[string]string `protobuf:"bytes,1,rep,name=snapshotIDs" json:"snapshotIDs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *CreateGroupSnapshotResponse) Reset()                    { *m = CreateGroupSnapshotResponse{} }
+func (m *CreateGroupSnapshotResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateGroupSnapshotResponse) ProtoMessage()               {}
+func (*CreateGroupSnapshotResponse) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{7} }
+
+func (m *CreateGroupSnapshotResponse) GetSnapshotIDs() map[string]string {
+	if m != nil {
+		return m.SnapshotIDs
+	}
+	return nil
+}
+
+type SnapshotProgressRequest struct {
+	Plugin     string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	SnapshotID string `protobuf:"bytes,2,opt,name=snapshotID" json:"snapshotID,omitempty"`
+}
+
+func (m *SnapshotProgressRequest) Reset()                    { *m = SnapshotProgressRequest{} }
+func (m *SnapshotProgressRequest) String() string            { return proto.CompactTextString(m) }
+func (*SnapshotProgressRequest) ProtoMessage()               {}
+func (*SnapshotProgressRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{8} }
+
+func (m *SnapshotProgressRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *SnapshotProgressRequest) GetSnapshotID() string {
+	if m != nil {
+		return m.SnapshotID
+	}
+	return ""
+}
+
+type SnapshotProgressResponse struct {
+	Completed     bool   `protobuf:"varint,1,opt,name=completed" json:"completed,omitempty"`
+	FailureReason string `protobuf:"bytes,2,opt,name=failureReason" json:"failureReason,omitempty"`
+	BytesDone     int64  `protobuf:"varint,3,opt,name=bytesDone" json:"bytesDone,omitempty"`
+	BytesTotal    int64  `protobuf:"varint,4,opt,name=bytesTotal" json:"bytesTotal,omitempty"`
+	Description   string `protobuf:"bytes,5,opt,name=description" json:"description,omitempty"`
+}
+
+func (m *SnapshotProgressResponse) Reset()                    { *m = SnapshotProgressResponse{} }
+func (m *SnapshotProgressResponse) String() string            { return proto.CompactTextString(m) }
+func (*SnapshotProgressResponse) ProtoMessage()               {}
+func (*SnapshotProgressResponse) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{9} }
+
+func (m *SnapshotProgressResponse) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
+	}
+	return false
+}
+
+func (m *SnapshotProgressResponse) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
+	}
+	return ""
+}
+
+func (m *SnapshotProgressResponse) GetBytesDone() int64 {
+	if m != nil {
+		return m.BytesDone
+	}
+	return 0
+}
+
+func (m *SnapshotProgressResponse) GetBytesTotal() int64 {
+	if m != nil {
+		return m.BytesTotal
+	}
+	return 0
+}
+
+func (m *SnapshotProgressResponse) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type CopySnapshotRequest struct {
+	Plugin     string            `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	SnapshotID string            `protobuf:"bytes,2,opt,name=snapshotID" json:"snapshotID,omitempty"`
+	VolumeAZ   string            `protobuf:"bytes,3,opt,name=volumeAZ" json:"volumeAZ,omitempty"`
+	Config     map[string]string `protobuf:"bytes,4,rep,name=config" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *CopySnapshotRequest) Reset()                    { *m = CopySnapshotRequest{} }
+func (m *CopySnapshotRequest) String() string            { return proto.CompactTextString(m) }
+func (*CopySnapshotRequest) ProtoMessage()               {}
+func (*CopySnapshotRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{10} }
+
+func (m *CopySnapshotRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *CopySnapshotRequest) GetSnapshotID() string {
+	if m != nil {
+		return m.SnapshotID
+	}
+	return ""
+}
+
+func (m *CopySnapshotRequest) GetVolumeAZ() string {
+	if m != nil {
+		return m.VolumeAZ
+	}
+	return ""
+}
+
+func (m *CopySnapshotRequest) GetConfig() map[string]string {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type CopySnapshotResponse struct {
+	SnapshotID string `protobuf:"bytes,1,opt,name=snapshotID" json:"snapshotID,omitempty"`
+}
+
+func (m *CopySnapshotResponse) Reset()                    { *m = CopySnapshotResponse{} }
+func (m *CopySnapshotResponse) String() string            { return proto.CompactTextString(m) }
+func (*CopySnapshotResponse) ProtoMessage()               {}
+func (*CopySnapshotResponse) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{11} }
+
+func (m *CopySnapshotResponse) GetSnapshotID() string {
+	if m != nil {
+		return m.SnapshotID
+	}
+	return ""
+}
+
 type DeleteSnapshotRequest struct {
 	Plugin     string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
 	SnapshotID string `protobuf:"bytes,2,opt,name=snapshotID" json:"snapshotID,omitempty"`
@@ -201,7 +377,7 @@ type DeleteSnapshotRequest struct {
 func (m *DeleteSnapshotRequest) Reset()                    { *m = DeleteSnapshotRequest{} }
 func (m *DeleteSnapshotRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteSnapshotRequest) ProtoMessage()               {}
-func (*DeleteSnapshotRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{6} }
+func (*DeleteSnapshotRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{12} }
 
 func (m *DeleteSnapshotRequest) GetPlugin() string {
 	if m != nil {
@@ -225,7 +401,7 @@ type GetVolumeIDRequest struct {
 func (m *GetVolumeIDRequest) Reset()                    { *m = GetVolumeIDRequest{} }
 func (m *GetVolumeIDRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetVolumeIDRequest) ProtoMessage()               {}
-func (*GetVolumeIDRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{7} }
+func (*GetVolumeIDRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{13} }
 
 func (m *GetVolumeIDRequest) GetPlugin() string {
 	if m != nil {
@@ -248,7 +424,7 @@ type GetVolumeIDResponse struct {
 func (m *GetVolumeIDResponse) Reset()                    { *m = GetVolumeIDResponse{} }
 func (m *GetVolumeIDResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetVolumeIDResponse) ProtoMessage()               {}
-func (*GetVolumeIDResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{8} }
+func (*GetVolumeIDResponse) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{14} }
 
 func (m *GetVolumeIDResponse) GetVolumeID() string {
 	if m != nil {
@@ -266,7 +442,7 @@ type SetVolumeIDRequest struct {
 func (m *SetVolumeIDRequest) Reset()                    { *m = SetVolumeIDRequest{} }
 func (m *SetVolumeIDRequest) String() string            { return proto.CompactTextString(m) }
 func (*SetVolumeIDRequest) ProtoMessage()               {}
-func (*SetVolumeIDRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{9} }
+func (*SetVolumeIDRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{15} }
 
 func (m *SetVolumeIDRequest) GetPlugin() string {
 	if m != nil {
@@ -296,7 +472,7 @@ type SetVolumeIDResponse struct {
 func (m *SetVolumeIDResponse) Reset()                    { *m = SetVolumeIDResponse{} }
 func (m *SetVolumeIDResponse) String() string            { return proto.CompactTextString(m) }
 func (*SetVolumeIDResponse) ProtoMessage()               {}
-func (*SetVolumeIDResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{10} }
+func (*SetVolumeIDResponse) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{16} }
 
 func (m *SetVolumeIDResponse) GetPersistentVolume() []byte {
 	if m != nil {
@@ -313,7 +489,7 @@ type VolumeSnapshotterInitRequest struct {
 func (m *VolumeSnapshotterInitRequest) Reset()                    { *m = VolumeSnapshotterInitRequest{} }
 func (m *VolumeSnapshotterInitRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeSnapshotterInitRequest) ProtoMessage()               {}
-func (*VolumeSnapshotterInitRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{11} }
+func (*VolumeSnapshotterInitRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{17} }
 
 func (m *VolumeSnapshotterInitRequest) GetPlugin() string {
 	if m != nil {
@@ -336,6 +512,12 @@ func init() {
 	proto.RegisterType((*GetVolumeInfoResponse)(nil), "generated.GetVolumeInfoResponse")
 	proto.RegisterType((*CreateSnapshotRequest)(nil), "generated.CreateSnapshotRequest")
 	proto.RegisterType((*CreateSnapshotResponse)(nil), "generated.CreateSnapshotResponse")
+	proto.RegisterType((*CreateGroupSnapshotRequest)(nil), "generated.CreateGroupSnapshotRequest")
+	proto.RegisterType((*CreateGroupSnapshotResponse)(nil), "generated.CreateGroupSnapshotResponse")
+	proto.RegisterType((*SnapshotProgressRequest)(nil), "generated.SnapshotProgressRequest")
+	proto.RegisterType((*SnapshotProgressResponse)(nil), "generated.SnapshotProgressResponse")
+	proto.RegisterType((*CopySnapshotRequest)(nil), "generated.CopySnapshotRequest")
+	proto.RegisterType((*CopySnapshotResponse)(nil), "generated.CopySnapshotResponse")
 	proto.RegisterType((*DeleteSnapshotRequest)(nil), "generated.DeleteSnapshotRequest")
 	proto.RegisterType((*GetVolumeIDRequest)(nil), "generated.GetVolumeIDRequest")
 	proto.RegisterType((*GetVolumeIDResponse)(nil), "generated.GetVolumeIDResponse")
@@ -359,6 +541,9 @@ type VolumeSnapshotterClient interface {
 	CreateVolumeFromSnapshot(ctx context.Context, in *CreateVolumeRequest, opts ...grpc.CallOption) (*CreateVolumeResponse, error)
 	GetVolumeInfo(ctx context.Context, in *GetVolumeInfoRequest, opts ...grpc.CallOption) (*GetVolumeInfoResponse, error)
 	CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error)
+	CreateGroupSnapshot(ctx context.Context, in *CreateGroupSnapshotRequest, opts ...grpc.CallOption) (*CreateGroupSnapshotResponse, error)
+	SnapshotProgress(ctx context.Context, in *SnapshotProgressRequest, opts ...grpc.CallOption) (*SnapshotProgressResponse, error)
+	CopySnapshot(ctx context.Context, in *CopySnapshotRequest, opts ...grpc.CallOption) (*CopySnapshotResponse, error)
 	DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*Empty, error)
 	GetVolumeID(ctx context.Context, in *GetVolumeIDRequest, opts ...grpc.CallOption) (*GetVolumeIDResponse, error)
 	SetVolumeID(ctx context.Context, in *SetVolumeIDRequest, opts ...grpc.CallOption) (*SetVolumeIDResponse, error)
@@ -408,6 +593,33 @@ func (c *volumeSnapshotterClient) CreateSnapshot(ctx context.Context, in *Create
 	return out, nil
 }
 
+func (c *volumeSnapshotterClient) CreateGroupSnapshot(ctx context.Context, in *CreateGroupSnapshotRequest, opts ...grpc.CallOption) (*CreateGroupSnapshotResponse, error) {
+	out := new(CreateGroupSnapshotResponse)
+	err := grpc.Invoke(ctx, "/generated.VolumeSnapshotter/CreateGroupSnapshot", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeSnapshotterClient) SnapshotProgress(ctx context.Context, in *SnapshotProgressRequest, opts ...grpc.CallOption) (*SnapshotProgressResponse, error) {
+	out := new(SnapshotProgressResponse)
+	err := grpc.Invoke(ctx, "/generated.VolumeSnapshotter/SnapshotProgress", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeSnapshotterClient) CopySnapshot(ctx context.Context, in *CopySnapshotRequest, opts ...grpc.CallOption) (*CopySnapshotResponse, error) {
+	out := new(CopySnapshotResponse)
+	err := grpc.Invoke(ctx, "/generated.VolumeSnapshotter/CopySnapshot", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *volumeSnapshotterClient) DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := grpc.Invoke(ctx, "/generated.VolumeSnapshotter/DeleteSnapshot", in, out, c.cc, opts...)
@@ -442,6 +654,9 @@ type VolumeSnapshotterServer interface {
 	CreateVolumeFromSnapshot(context.Context, *CreateVolumeRequest) (*CreateVolumeResponse, error)
 	GetVolumeInfo(context.Context, *GetVolumeInfoRequest) (*GetVolumeInfoResponse, error)
 	CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotResponse, error)
+	CreateGroupSnapshot(context.Context, *CreateGroupSnapshotRequest) (*CreateGroupSnapshotResponse, error)
+	SnapshotProgress(context.Context, *SnapshotProgressRequest) (*SnapshotProgressResponse, error)
+	CopySnapshot(context.Context, *CopySnapshotRequest) (*CopySnapshotResponse, error)
 	DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*Empty, error)
 	GetVolumeID(context.Context, *GetVolumeIDRequest) (*GetVolumeIDResponse, error)
 	SetVolumeID(context.Context, *SetVolumeIDRequest) (*SetVolumeIDResponse, error)
@@ -523,6 +738,60 @@ func _VolumeSnapshotter_CreateSnapshot_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VolumeSnapshotter_CreateGroupSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeSnapshotterServer).CreateGroupSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.VolumeSnapshotter/CreateGroupSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeSnapshotterServer).CreateGroupSnapshot(ctx, req.(*CreateGroupSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeSnapshotter_SnapshotProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeSnapshotterServer).SnapshotProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.VolumeSnapshotter/SnapshotProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeSnapshotterServer).SnapshotProgress(ctx, req.(*SnapshotProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeSnapshotter_CopySnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopySnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeSnapshotterServer).CopySnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.VolumeSnapshotter/CopySnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeSnapshotterServer).CopySnapshot(ctx, req.(*CopySnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VolumeSnapshotter_DeleteSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteSnapshotRequest)
 	if err := dec(in); err != nil {
@@ -597,6 +866,18 @@ var _VolumeSnapshotter_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSnapshot",
 			Handler:    _VolumeSnapshotter_CreateSnapshot_Handler,
 		},
+		{
+			MethodName: "CreateGroupSnapshot",
+			Handler:    _VolumeSnapshotter_CreateGroupSnapshot_Handler,
+		},
+		{
+			MethodName: "SnapshotProgress",
+			Handler:    _VolumeSnapshotter_SnapshotProgress_Handler,
+		},
+		{
+			MethodName: "CopySnapshot",
+			Handler:    _VolumeSnapshotter_CopySnapshot_Handler,
+		},
 		{
 			MethodName: "DeleteSnapshot",
 			Handler:    _VolumeSnapshotter_DeleteSnapshot_Handler,
@@ -614,44 +895,61 @@ var _VolumeSnapshotter_serviceDesc = grpc.ServiceDesc{
 	Metadata: "VolumeSnapshotter.proto",
 }
 
-func init() { proto.RegisterFile("VolumeSnapshotter.proto", fileDescriptor6) }
-
-var fileDescriptor6 = []byte{
-	// 566 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xd5, 0xda, 0x6e, 0x44, 0x26, 0xa5, 0x0a, 0x9b, 0xa4, 0x58, 0x16, 0x04, 0xe3, 0x0b, 0x51,
-	0x0f, 0x96, 0x48, 0x0f, 0x14, 0x0e, 0x48, 0x51, 0x5d, 0x50, 0xd4, 0x4a, 0x48, 0x76, 0x41, 0x08,
-	0x4e, 0x86, 0x6e, 0x5c, 0x8b, 0xc4, 0x36, 0xde, 0x4d, 0xa5, 0x7c, 0x0c, 0xbf, 0x82, 0xf8, 0x14,
-	0x3e, 0x05, 0xc5, 0xde, 0x24, 0xbb, 0xb1, 0x1d, 0x87, 0x43, 0x6f, 0xde, 0x99, 0x9d, 0x37, 0x6f,
-	0x66, 0xdf, 0x8c, 0xe1, 0xf1, 0xa7, 0x78, 0x3a, 0x9f, 0x11, 0x2f, 0xf2, 0x13, 0x7a, 0x1b, 0x33,
-	0x46, 0x52, 0x3b, 0x49, 0x63, 0x16, 0xe3, 0x66, 0x40, 0x22, 0x92, 0xfa, 0x8c, 0xdc, 0x18, 0x87,
-	0xde, 0xad, 0x9f, 0x92, 0x9b, 0xdc, 0x61, 0xfd, 0x42, 0xd0, 0x39, 0x4f, 0x89, 0xcf, 0x48, 0x1e,
-	0xea, 0x92, 0x9f, 0x73, 0x42, 0x19, 0x3e, 0x86, 0x46, 0x32, 0x9d, 0x07, 0x61, 0xa4, 0x23, 0x13,
-	0x0d, 0x9a, 0x2e, 0x3f, 0xe1, 0x3e, 0x00, 0xe5, 0xe8, 0x63, 0x47, 0x57, 0x32, 0x9f, 0x60, 0x59,
-	0xfa, 0xef, 0x32, 0xa0, 0xeb, 0x45, 0x42, 0x74, 0x35, 0xf7, 0x6f, 0x2c, 0xd8, 0x80, 0x07, 0xf9,
-	0x69, 0xf4, 0x45, 0xd7, 0x32, 0xef, 0xfa, 0x8c, 0x31, 0x68, 0x61, 0x9c, 0x50, 0xfd, 0xc0, 0x44,
-	0x03, 0xd5, 0xcd, 0xbe, 0xad, 0x21, 0x74, 0x65, 0x7a, 0x34, 0x89, 0x23, 0x2a, 0xe0, 0x8c, 0x1d,
-	0xce, 0x70, 0x7d, 0xb6, 0x26, 0xd0, 0x7d, 0x4f, 0x58, 0x1e, 0x30, 0x8e, 0x26, 0x71, 0x5d, 0x4d,
-	0x22, 0x96, 0x22, 0x63, 0x49, 0x7c, 0x55, 0x99, 0xaf, 0x75, 0x09, 0xbd, 0xad, 0x3c, 0x9c, 0x9c,
-	0xdc, 0x04, 0x54, 0x68, 0xc2, 0xaa, 0x50, 0x45, 0x28, 0xf4, 0x2f, 0x82, 0x5e, 0x5e, 0xe9, 0xea,
-	0xf5, 0xee, 0x89, 0x36, 0x7e, 0x0b, 0x1a, 0xf3, 0x03, 0xaa, 0x6b, 0xa6, 0x3a, 0x68, 0x0d, 0x4f,
-	0xec, 0xb5, 0x34, 0xec, 0xd2, 0xfc, 0xf6, 0xb5, 0x1f, 0xd0, 0x8b, 0x88, 0xa5, 0x0b, 0x37, 0x8b,
-	0x33, 0x5e, 0x41, 0x73, 0x6d, 0xc2, 0x6d, 0x50, 0x7f, 0x90, 0x05, 0x67, 0xb6, 0xfc, 0xc4, 0x5d,
-	0x38, 0xb8, 0xf3, 0xa7, 0x73, 0xc2, 0x39, 0xe5, 0x87, 0x37, 0xca, 0x19, 0xb2, 0xce, 0xe0, 0x78,
-	0x3b, 0xc3, 0xa6, 0x61, 0x82, 0xaa, 0xd0, 0xb6, 0xaa, 0xac, 0x0f, 0xd0, 0x73, 0xc8, 0x94, 0xec,
-	0xdf, 0x9b, 0x1a, 0x99, 0x5a, 0x9f, 0x01, 0x6f, 0x9e, 0xce, 0xa9, 0x43, 0x3b, 0x81, 0x76, 0x42,
-	0x52, 0x1a, 0x52, 0x46, 0x22, 0x1e, 0x94, 0x61, 0x1e, 0xba, 0x05, 0xbb, 0xf5, 0x12, 0x3a, 0x12,
-	0xf2, 0x1e, 0x7a, 0x65, 0x80, 0xbd, 0x7b, 0x21, 0x23, 0x65, 0x55, 0xb7, 0xb2, 0x8e, 0xa0, 0xe3,
-	0x95, 0x10, 0x2d, 0x83, 0x47, 0x15, 0xb5, 0xfe, 0x46, 0xf0, 0xa4, 0xb0, 0x71, 0xc6, 0x51, 0x58,
-	0xfb, 0x3c, 0x97, 0xd0, 0xf8, 0x1e, 0x47, 0x93, 0x30, 0xd0, 0x95, 0x4c, 0x84, 0xa7, 0x82, 0x08,
-	0x77, 0x01, 0xda, 0xe7, 0x59, 0x54, 0xae, 0x46, 0x0e, 0x61, 0xbc, 0x86, 0x96, 0x60, 0xfe, 0x1f,
-	0x45, 0x0e, 0xff, 0x68, 0xf0, 0xa8, 0x90, 0x0f, 0x8f, 0x40, 0x5b, 0xe6, 0xc4, 0x2f, 0xf6, 0x64,
-	0x65, 0xb4, 0x85, 0x8b, 0x17, 0xb3, 0x84, 0x2d, 0xf0, 0x57, 0xd0, 0xc5, 0xb5, 0xf5, 0x2e, 0x8d,
-	0x67, 0xab, 0x58, 0xdc, 0x2f, 0x4c, 0x9c, 0xb4, 0x7a, 0x8d, 0x67, 0x95, 0x7e, 0xfe, 0x44, 0x2e,
-	0x3c, 0x94, 0xf6, 0x0e, 0x16, 0x23, 0xca, 0x36, 0x9f, 0x61, 0x56, 0x5f, 0xe0, 0x98, 0x1f, 0xe1,
-	0x48, 0x9e, 0x4d, 0x6c, 0xd6, 0x2d, 0x06, 0xe3, 0xf9, 0x8e, 0x1b, 0x1c, 0xd6, 0x81, 0x23, 0x79,
-	0x70, 0x25, 0xd8, 0xd2, 0x99, 0x2e, 0xe9, 0xe6, 0x15, 0xb4, 0x84, 0x99, 0xc2, 0x4f, 0x4b, 0xab,
-	0x59, 0x0d, 0x8e, 0xd1, 0xaf, 0x72, 0x73, 0x4e, 0x57, 0xd0, 0xf2, 0x2a, 0xd0, 0xbc, 0xdd, 0x68,
-	0x25, 0xf3, 0xf2, 0xad, 0x91, 0xfd, 0x47, 0x4f, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xc3, 0xf2,
-	0xb8, 0x2f, 0x7b, 0x07, 0x00, 0x00,
+func init() { proto.RegisterFile("VolumeSnapshotter.proto", fileDescriptor7) }
+
+var fileDescriptor7 = []byte{
+	// 842 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x96, 0xe3, 0x10, 0x91, 0x13, 0x40, 0xb9, 0x93, 0x00, 0x96, 0x2f, 0x17, 0x72, 0x7d, 0x6f,
+	0x5b, 0xc4, 0x22, 0x55, 0xa1, 0x2a, 0xb4, 0xaa, 0x90, 0x28, 0xa1, 0x28, 0x02, 0x89, 0xd6, 0xa1,
+	0xa8, 0x2d, 0x2b, 0x43, 0x26, 0xc1, 0x6a, 0xe2, 0x71, 0x3d, 0x13, 0xa4, 0x3c, 0x4c, 0xdf, 0xa3,
+	0x2b, 0x56, 0x7d, 0x84, 0x3e, 0x40, 0x97, 0x7d, 0x8c, 0xca, 0xf6, 0x24, 0x9e, 0x89, 0x9d, 0xc4,
+	0xb4, 0xb0, 0xf3, 0x9c, 0x99, 0xf3, 0x9d, 0xef, 0xfc, 0xe4, 0x9b, 0x09, 0x2c, 0x9f, 0x91, 0x4e,
+	0xaf, 0x8b, 0x1b, 0x8e, 0xe5, 0xd2, 0x2b, 0xc2, 0x18, 0xf6, 0xaa, 0xae, 0x47, 0x18, 0x41, 0xf9,
+	0x36, 0x76, 0xb0, 0x67, 0x31, 0xdc, 0xd4, 0xe7, 0x1a, 0x57, 0x96, 0x87, 0x9b, 0xe1, 0x86, 0xf1,
+	0x45, 0x81, 0xd2, 0xbe, 0x87, 0x2d, 0x86, 0x43, 0x57, 0x13, 0x7f, 0xee, 0x61, 0xca, 0xd0, 0x12,
+	0xe4, 0xdc, 0x4e, 0xaf, 0x6d, 0x3b, 0x9a, 0x52, 0x51, 0xd6, 0xf3, 0x26, 0x5f, 0xa1, 0x55, 0x00,
+	0xca, 0xd1, 0xeb, 0x35, 0x2d, 0x13, 0xec, 0x09, 0x16, 0x7f, 0xff, 0x3a, 0x00, 0x3a, 0xed, 0xbb,
+	0x58, 0x53, 0xc3, 0xfd, 0xc8, 0x82, 0x74, 0x98, 0x0d, 0x57, 0x7b, 0x1f, 0xb5, 0x6c, 0xb0, 0x3b,
+	0x5c, 0x23, 0x04, 0x59, 0x9b, 0xb8, 0x54, 0x9b, 0xa9, 0x28, 0xeb, 0xaa, 0x19, 0x7c, 0x1b, 0x9b,
+	0x50, 0x96, 0xe9, 0x51, 0x97, 0x38, 0x54, 0xc0, 0xa9, 0xd7, 0x38, 0xc3, 0xe1, 0xda, 0x68, 0x41,
+	0xf9, 0x10, 0xb3, 0xd0, 0xa1, 0xee, 0xb4, 0xc8, 0xb4, 0x9c, 0x44, 0xac, 0x8c, 0x8c, 0x25, 0xf1,
+	0x55, 0x65, 0xbe, 0xc6, 0x11, 0x2c, 0x8e, 0xc4, 0xe1, 0xe4, 0xe4, 0x22, 0x28, 0xb1, 0x22, 0x0c,
+	0x12, 0xcd, 0x08, 0x89, 0xfe, 0x50, 0x60, 0x31, 0xcc, 0x74, 0xd0, 0xbd, 0x7b, 0xa2, 0x8d, 0x76,
+	0x21, 0xcb, 0xac, 0x36, 0xd5, 0xb2, 0x15, 0x75, 0xbd, 0xb0, 0xb9, 0x51, 0x1d, 0x8e, 0x46, 0x35,
+	0x31, 0x7e, 0xf5, 0xd4, 0x6a, 0xd3, 0x03, 0x87, 0x79, 0x7d, 0x33, 0xf0, 0xd3, 0xb7, 0x21, 0x3f,
+	0x34, 0xa1, 0x22, 0xa8, 0x9f, 0x70, 0x9f, 0x33, 0xf3, 0x3f, 0x51, 0x19, 0x66, 0xae, 0xad, 0x4e,
+	0x0f, 0x73, 0x4e, 0xe1, 0xe2, 0x45, 0x66, 0x47, 0x31, 0x76, 0x60, 0x69, 0x34, 0x42, 0x54, 0x30,
+	0x61, 0xaa, 0x94, 0xd1, 0xa9, 0x32, 0xbe, 0x65, 0x40, 0x0f, 0x5d, 0x0f, 0x3d, 0xd2, 0x73, 0xd3,
+	0x56, 0xc8, 0x84, 0xfc, 0x20, 0x6b, 0xbf, 0xd8, 0x7e, 0xba, 0x4f, 0x63, 0xe9, 0x26, 0x21, 0x56,
+	0xcf, 0x06, 0x6e, 0x61, 0xe2, 0x11, 0x0c, 0xda, 0xe7, 0xd5, 0x53, 0x03, 0xb8, 0xc7, 0xe9, 0xe0,
+	0x46, 0x4b, 0xf8, 0x12, 0x16, 0xe4, 0x08, 0xb7, 0xa9, 0xe3, 0xef, 0x37, 0xe0, 0xab, 0x02, 0x7f,
+	0x27, 0xb2, 0xe4, 0x6d, 0xf8, 0x00, 0x85, 0xa8, 0xe8, 0x54, 0x53, 0x82, 0x14, 0xb7, 0xa7, 0xa5,
+	0x18, 0x3a, 0x57, 0x1b, 0x91, 0x67, 0x98, 0xaa, 0x88, 0xa5, 0xef, 0x42, 0x71, 0xf4, 0xc0, 0xad,
+	0xa8, 0xbf, 0x85, 0xe5, 0x81, 0xff, 0x1b, 0x8f, 0xb4, 0x3d, 0x4c, 0xe9, 0x1f, 0x4a, 0x95, 0x71,
+	0xa3, 0x80, 0x16, 0xc7, 0xe4, 0xa5, 0x58, 0x81, 0xfc, 0x25, 0xe9, 0xba, 0x1d, 0xcc, 0x70, 0x33,
+	0xc0, 0x9d, 0x35, 0x23, 0x03, 0xfa, 0x1f, 0xe6, 0x5b, 0x96, 0xdd, 0xe9, 0x79, 0xd8, 0xc4, 0x16,
+	0x25, 0x0e, 0x47, 0x97, 0x8d, 0x3e, 0xc6, 0x45, 0x9f, 0x61, 0x5a, 0x23, 0x4e, 0x28, 0x85, 0xaa,
+	0x19, 0x19, 0x7c, 0x7a, 0xc1, 0xe2, 0x94, 0x30, 0xab, 0x13, 0x68, 0xa1, 0x6a, 0x0a, 0x16, 0x54,
+	0x81, 0x42, 0x13, 0xd3, 0x4b, 0xcf, 0x76, 0x99, 0x4d, 0x9c, 0x40, 0x14, 0xf3, 0xa6, 0x68, 0x32,
+	0x7e, 0xfa, 0xda, 0x4d, 0xdc, 0x7e, 0xda, 0x9f, 0xc3, 0x34, 0xed, 0x9e, 0x24, 0x1a, 0xaf, 0x20,
+	0x77, 0x49, 0x9c, 0x96, 0xdd, 0x4e, 0x92, 0x8d, 0x38, 0x87, 0xea, 0x7e, 0x70, 0x38, 0x1c, 0x04,
+	0xee, 0xa9, 0x3f, 0x87, 0x82, 0x60, 0xbe, 0x55, 0xfb, 0x9f, 0x41, 0x59, 0x8e, 0x92, 0x52, 0x38,
+	0x4e, 0x60, 0xb1, 0x86, 0xfd, 0x9e, 0xdd, 0x51, 0x8d, 0x8c, 0xf7, 0x80, 0x22, 0xcd, 0xaf, 0x4d,
+	0x43, 0xdb, 0x80, 0xa2, 0x8b, 0x3d, 0x6a, 0x53, 0x86, 0x1d, 0xee, 0x14, 0x60, 0xce, 0x99, 0x31,
+	0xbb, 0xf1, 0x04, 0x4a, 0x12, 0x72, 0x8a, 0x8b, 0x8e, 0x01, 0x6a, 0xdc, 0x0b, 0x19, 0x29, 0xaa,
+	0x3a, 0x12, 0x75, 0x0f, 0x4a, 0x8d, 0x04, 0xa2, 0x49, 0xf0, 0xca, 0x98, 0x5c, 0x6f, 0x14, 0x58,
+	0x89, 0x3d, 0x55, 0xea, 0x8e, 0x3d, 0xb5, 0x3d, 0x47, 0xc3, 0x31, 0x0c, 0xe5, 0x7c, 0x4b, 0x18,
+	0xc3, 0x49, 0x80, 0x77, 0x3c, 0x8f, 0x9b, 0xdf, 0x73, 0xf0, 0x57, 0x2c, 0x1e, 0xda, 0x83, 0xac,
+	0x1f, 0x13, 0x3d, 0x4a, 0xc9, 0x4a, 0x2f, 0x0a, 0x07, 0x0f, 0xba, 0x2e, 0xeb, 0xa3, 0x73, 0xd0,
+	0xc4, 0xf7, 0xce, 0x6b, 0x8f, 0x74, 0x07, 0xbe, 0x68, 0x35, 0xa6, 0xc4, 0xd2, 0x9b, 0x4d, 0x5f,
+	0x1b, 0xbb, 0xcf, 0x5b, 0x64, 0xc2, 0xbc, 0xf4, 0x60, 0x41, 0xa2, 0x47, 0xd2, 0x93, 0x49, 0xaf,
+	0x8c, 0x3f, 0xc0, 0x31, 0xdf, 0xc1, 0x82, 0x7c, 0xa9, 0xa3, 0xca, 0xb4, 0x17, 0x85, 0xfe, 0xef,
+	0x84, 0x13, 0x1c, 0xb6, 0x09, 0xa5, 0x84, 0xcb, 0x06, 0x3d, 0x48, 0x75, 0xdf, 0xea, 0x0f, 0xd3,
+	0xdd, 0x59, 0xe8, 0x1c, 0x8a, 0xa3, 0x37, 0x00, 0x32, 0x04, 0xdf, 0x31, 0x57, 0x8e, 0xfe, 0xdf,
+	0xc4, 0x33, 0x1c, 0xfc, 0x04, 0xe6, 0x44, 0xcd, 0x92, 0xdb, 0x17, 0x97, 0x4c, 0x7d, 0x6d, 0xec,
+	0x3e, 0x07, 0xac, 0xc1, 0x82, 0x2c, 0x66, 0x52, 0xa9, 0x13, 0x75, 0x2e, 0x61, 0xc2, 0x8e, 0xa1,
+	0x20, 0xe8, 0x0c, 0xfa, 0x27, 0xb1, 0xc3, 0x03, 0x31, 0xd1, 0x57, 0xc7, 0x6d, 0x73, 0x4e, 0xc7,
+	0x50, 0x68, 0x8c, 0x41, 0x6b, 0x4c, 0x46, 0x4b, 0xd0, 0x90, 0x8b, 0x5c, 0xf0, 0xa7, 0x64, 0xeb,
+	0xd7, 0x00, 0xab, 0x7d, 0x8c, 0xcf, 0xc8, 0x0c, 0x00, 0x00,
 }
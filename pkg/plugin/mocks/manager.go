@@ -63,6 +63,52 @@ func (_m *Manager) GetBackupItemActions() ([]velero.BackupItemAction, error) {
 	return r0, r1
 }
 
+// GetBackupItemActionV2 provides a mock function with given fields: name
+func (_m *Manager) GetBackupItemActionV2(name string) (velero.BackupItemActionV2, error) {
+	ret := _m.Called(name)
+
+	var r0 velero.BackupItemActionV2
+	if rf, ok := ret.Get(0).(func(string) velero.BackupItemActionV2); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(velero.BackupItemActionV2)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBackupItemActionsV2 provides a mock function with given fields:
+func (_m *Manager) GetBackupItemActionsV2() ([]velero.BackupItemActionV2, error) {
+	ret := _m.Called()
+
+	var r0 []velero.BackupItemActionV2
+	if rf, ok := ret.Get(0).(func() []velero.BackupItemActionV2); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]velero.BackupItemActionV2)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDeleteItemAction provides a mock function with given fields: name
 func (_m *Manager) GetDeleteItemAction(name string) (velero.DeleteItemAction, error) {
 	ret := _m.Called(name)
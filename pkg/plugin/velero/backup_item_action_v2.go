@@ -0,0 +1,67 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package velero
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// BackupItemActionV2 is version 2 of the BackupItemAction API, implemented by
+// plugins registered as BackupItemAction/v2. It differs from version 1 in that
+// Execute is passed a context, which is canceled when the backup no longer
+// needs the result of the call.
+type BackupItemActionV2 interface {
+	// AppliesTo returns information about which resources this action should be invoked for.
+	// A BackupItemActionV2's Execute function will only be invoked on items that match the returned
+	// selector. A zero-valued ResourceSelector matches all resources.
+	AppliesTo() (ResourceSelector, error)
+
+	// Execute allows the ItemAction to perform arbitrary logic with the item being backed up,
+	// including mutating the item itself prior to backup. The item (unmodified or modified)
+	// should be returned, along with an optional slice of ResourceIdentifiers specifying
+	// additional related items that should be backed up. Execute should return promptly
+	// once ctx is done.
+	Execute(ctx context.Context, item runtime.Unstructured, backup *api.Backup) (runtime.Unstructured, []ResourceIdentifier, error)
+}
+
+// BackupItemActionV1ToV2 lifts a version 1 BackupItemAction into a
+// BackupItemActionV2. Since version 1 actions can't be interrupted, the
+// returned action's Execute only checks whether ctx is done before calling
+// action.
+func BackupItemActionV1ToV2(action BackupItemAction) BackupItemActionV2 {
+	return &backupItemActionV1Adapter{action: action}
+}
+
+// backupItemActionV1Adapter implements BackupItemActionV2 for a BackupItemAction.
+type backupItemActionV1Adapter struct {
+	action BackupItemAction
+}
+
+func (a *backupItemActionV1Adapter) AppliesTo() (ResourceSelector, error) {
+	return a.action.AppliesTo()
+}
+
+func (a *backupItemActionV1Adapter) Execute(ctx context.Context, item runtime.Unstructured, backup *api.Backup) (runtime.Unstructured, []ResourceIdentifier, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	return a.action.Execute(item, backup)
+}
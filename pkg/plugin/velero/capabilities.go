@@ -0,0 +1,48 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package velero
+
+// PluginFeature is an optional part of the plugin API that a plugin supports.
+// A plugin supports a feature if it implements the optional interface for it.
+type PluginFeature string
+
+const (
+	// PluginFeatureStreamingUpload means an ObjectStore implements ObjectStreamer.
+	PluginFeatureStreamingUpload PluginFeature = "StreamingUpload"
+
+	// PluginFeatureObjectRetention means an ObjectStore implements ObjectRetainer.
+	PluginFeatureObjectRetention PluginFeature = "ObjectRetention"
+
+	// PluginFeatureGroupSnapshots means a VolumeSnapshotter implements
+	// GroupVolumeSnapshotter.
+	PluginFeatureGroupSnapshots PluginFeature = "GroupSnapshots"
+
+	// PluginFeatureAsyncSnapshots means a VolumeSnapshotter implements
+	// SnapshotProgressReporter.
+	PluginFeatureAsyncSnapshots PluginFeature = "AsyncSnapshots"
+
+	// PluginFeatureSnapshotCopy means a VolumeSnapshotter implements SnapshotCopier.
+	PluginFeatureSnapshotCopy PluginFeature = "SnapshotCopy"
+)
+
+// HealthChecker is an optional interface that can be implemented by any plugin
+// to report whether it's ready to serve requests.
+type HealthChecker interface {
+	// Health returns nil if the plugin is ready, or an error describing why it
+	// isn't. It may be called before Init.
+	Health() error
+}
@@ -5,12 +5,27 @@ package mocks
 import io "io"
 import mock "github.com/stretchr/testify/mock"
 import time "time"
+import velero "github.com/vmware-tanzu/velero/pkg/plugin/velero"
 
 // ObjectStore is an autogenerated mock type for the ObjectStore type
 type ObjectStore struct {
 	mock.Mock
 }
 
+// CheckObjectLock provides a mock function with given fields: bucket, key
+func (_m *ObjectStore) CheckObjectLock(bucket string, key string) error {
+	ret := _m.Called(bucket, key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(bucket, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateSignedURL provides a mock function with given fields: bucket, key, ttl
 func (_m *ObjectStore) CreateSignedURL(bucket string, key string, ttl time.Duration) (string, error) {
 	ret := _m.Called(bucket, key, ttl)
@@ -163,3 +178,31 @@ func (_m *ObjectStore) PutObject(bucket string, key string, body io.Reader) erro
 
 	return r0
 }
+
+// PutObjectStream provides a mock function with given fields: bucket, key, body
+func (_m *ObjectStore) PutObjectStream(bucket string, key string, body io.Reader) error {
+	ret := _m.Called(bucket, key, body)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, io.Reader) error); ok {
+		r0 = rf(bucket, key, body)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutObjectWithRetention provides a mock function with given fields: bucket, key, body, retention
+func (_m *ObjectStore) PutObjectWithRetention(bucket string, key string, body io.Reader, retention velero.ObjectRetention) error {
+	ret := _m.Called(bucket, key, body, retention)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, io.Reader, velero.ObjectRetention) error); ok {
+		r0 = rf(bucket, key, body, retention)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -4,12 +4,57 @@ package mocks
 
 import mock "github.com/stretchr/testify/mock"
 import runtime "k8s.io/apimachinery/pkg/runtime"
+import velero "github.com/vmware-tanzu/velero/pkg/plugin/velero"
 
 // VolumeSnapshotter is an autogenerated mock type for the VolumeSnapshotter type
 type VolumeSnapshotter struct {
 	mock.Mock
 }
 
+// CopySnapshot provides a mock function with given fields: snapshotID, volumeAZ, config
+func (_m *VolumeSnapshotter) CopySnapshot(snapshotID string, volumeAZ string, config map[string]string) (string, error) {
+	ret := _m.Called(snapshotID, volumeAZ, config)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string, map[string]string) string); ok {
+		r0 = rf(snapshotID, volumeAZ, config)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, map[string]string) error); ok {
+		r1 = rf(snapshotID, volumeAZ, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateGroupSnapshot provides a mock function with given fields: volumeAZs, tags
+func (_m *VolumeSnapshotter) CreateGroupSnapshot(volumeAZs map[string]string, tags map[string]string) (map[string]string, error) {
+	ret := _m.Called(volumeAZs, tags)
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func(map[string]string, map[string]string) map[string]string); ok {
+		r0 = rf(volumeAZs, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(map[string]string, map[string]string) error); ok {
+		r1 = rf(volumeAZs, tags)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateSnapshot provides a mock function with given fields: volumeID, volumeAZ, tags
 func (_m *VolumeSnapshotter) CreateSnapshot(volumeID string, volumeAZ string, tags map[string]string) (string, error) {
 	ret := _m.Called(volumeID, volumeAZ, tags)
@@ -153,3 +198,26 @@ func (_m *VolumeSnapshotter) SetVolumeID(pv runtime.Unstructured, volumeID strin
 
 	return r0, r1
 }
+
+// SnapshotProgress provides a mock function with given fields: snapshotID
+func (_m *VolumeSnapshotter) SnapshotProgress(snapshotID string) (*velero.SnapshotProgress, error) {
+	ret := _m.Called(snapshotID)
+
+	var r0 *velero.SnapshotProgress
+	if rf, ok := ret.Get(0).(func(string) *velero.SnapshotProgress); ok {
+		r0 = rf(snapshotID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*velero.SnapshotProgress)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(snapshotID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -17,10 +17,21 @@ limitations under the License.
 package velero
 
 import (
+	"errors"
+	"fmt"
 	"io"
 	"time"
 )
 
+// ErrObjectStreamingNotSupported is returned by PutObjectStream, before reading
+// anything from body, when the object store can't upload objects as they're
+// streamed. Velero then buffers the object in a temporary file and uses PutObject.
+var ErrObjectStreamingNotSupported = errors.New("streaming uploads are not supported by this object store")
+
+// ErrObjectRetentionNotSupported is returned by PutObjectWithRetention when the
+// object store can't lock the objects it creates.
+var ErrObjectRetentionNotSupported = errors.New("object retention is not supported by this object store")
+
 // ObjectStore exposes basic object-storage operations required
 // by Velero.
 type ObjectStore interface {
@@ -63,3 +74,90 @@ type ObjectStore interface {
 	// CreateSignedURL creates a pre-signed URL for the given bucket and key that expires after ttl.
 	CreateSignedURL(bucket, key string, ttl time.Duration) (string, error)
 }
+
+// ObjectStreamer is an optional interface that can be implemented by an
+// ObjectStore that can upload objects of unknown size as they're produced,
+// with bounded memory, e.g. with a multipart upload. Velero uses it to upload
+// backup tarballs without writing them to a temporary file first.
+type ObjectStreamer interface {
+	// PutObjectStream creates a new object with the given key within the specified
+	// object storage bucket using the data in body, as it's read. The size of body
+	// isn't known in advance and it can't be seeked. If reading body returns an
+	// error other than io.EOF, the upload must be aborted without creating or
+	// replacing the object. It returns ErrObjectStreamingNotSupported, without
+	// reading body, if the object can't be uploaded this way.
+	PutObjectStream(bucket, key string, body io.Reader) error
+}
+
+// ObjectRetentionMode is the mode of an object's retention, which determines
+// who can shorten or remove it before it ends.
+type ObjectRetentionMode string
+
+const (
+	// ObjectRetentionModeGovernance means that users with special permissions
+	// can shorten or remove the object's retention.
+	ObjectRetentionModeGovernance ObjectRetentionMode = "Governance"
+
+	// ObjectRetentionModeCompliance means that nobody can shorten or remove the
+	// object's retention.
+	ObjectRetentionModeCompliance ObjectRetentionMode = "Compliance"
+)
+
+// ObjectRetention describes how an object is locked when it's created, to keep
+// it from being deleted or overwritten.
+type ObjectRetention struct {
+	// Mode is the mode of the object's retention. It's empty if the object
+	// isn't retained until a given time.
+	Mode ObjectRetentionMode
+
+	// RetainUntil is the time until which the object is retained. It's zero if
+	// the object isn't retained until a given time.
+	RetainUntil time.Time
+
+	// LegalHold is whether a legal hold is placed on the object, which keeps it
+	// from being deleted until the hold is removed, regardless of its retention.
+	LegalHold bool
+}
+
+// ObjectRetainer is an optional interface that can be implemented by an
+// ObjectStore whose object storage can lock objects, e.g. with S3 Object Lock,
+// so that backups can't be deleted or overwritten until their retention ends.
+type ObjectRetainer interface {
+	// PutObjectWithRetention creates a new object using the data in body within
+	// the specified object storage bucket with the given key, and locks it as
+	// described by retention. It returns ErrObjectRetentionNotSupported if the
+	// object can't be locked.
+	PutObjectWithRetention(bucket, key string, body io.Reader, retention ObjectRetention) error
+
+	// CheckObjectLock returns an *ObjectLockedError describing the object's
+	// lock if the object exists and is locked, or nil otherwise. Legal holds
+	// can be removed at any time, so this is used to tell whether objects that
+	// were put with one are still held. It returns ErrObjectRetentionNotSupported
+	// if the lock can't be checked.
+	CheckObjectLock(bucket, key string) error
+}
+
+// ObjectLockedError is returned by DeleteObject when the object can't be deleted
+// yet because it's locked, and by CheckObjectLock when the object is locked.
+type ObjectLockedError struct {
+	// Key is the key of the locked object.
+	Key string
+
+	// RetainUntil is the time until which the object is retained. It's zero if
+	// the object is only locked by a legal hold, or if the time isn't known.
+	RetainUntil time.Time
+
+	// LegalHold is whether the object is locked by a legal hold.
+	LegalHold bool
+}
+
+func (e *ObjectLockedError) Error() string {
+	switch {
+	case e.LegalHold:
+		return fmt.Sprintf("object %s is locked by a legal hold", e.Key)
+	case !e.RetainUntil.IsZero():
+		return fmt.Sprintf("object %s is locked until %s", e.Key, e.RetainUntil.UTC().Format(time.RFC3339))
+	default:
+		return fmt.Sprintf("object %s is locked", e.Key)
+	}
+}
@@ -17,9 +17,25 @@ limitations under the License.
 package velero
 
 import (
+	"errors"
+
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// ErrGroupSnapshotNotSupported is returned by CreateGroupSnapshot when the
+// volume snapshotter can't take a group snapshot of the given volumes. Velero
+// then snapshots each of the volumes individually.
+var ErrGroupSnapshotNotSupported = errors.New("group snapshots are not supported by this volume snapshotter")
+
+// ErrSnapshotProgressNotSupported is returned by SnapshotProgress when the volume
+// snapshotter doesn't track the progress of its snapshots. Velero then considers
+// the snapshots complete as soon as they're created.
+var ErrSnapshotProgressNotSupported = errors.New("snapshot progress is not supported by this volume snapshotter")
+
+// ErrCopySnapshotNotSupported is returned by CopySnapshot when the volume
+// snapshotter can't copy snapshots to another region or location.
+var ErrCopySnapshotNotSupported = errors.New("copying snapshots is not supported by this volume snapshotter")
+
 // VolumeSnapshotter defines the operations needed by Velero to
 // take snapshots of persistent volumes during backup, and to restore
 // persistent volumes from snapshots during restore.
@@ -51,3 +67,57 @@ type VolumeSnapshotter interface {
 	// DeleteSnapshot deletes the specified volume snapshot.
 	DeleteSnapshot(snapshotID string) error
 }
+
+// GroupVolumeSnapshotter is an optional interface that can be implemented by
+// a VolumeSnapshotter to take crash-consistent snapshots of multiple volumes
+// at once, e.g. all of the volumes of a multi-volume database.
+type GroupVolumeSnapshotter interface {
+	// CreateGroupSnapshot creates a snapshot of each of the specified volumes, which are
+	// given as a map of volume ID to availability zone, at a single point in time, and
+	// applies the provided set of tags to the snapshots. It returns a map of volume ID to
+	// snapshot ID, or ErrGroupSnapshotNotSupported if the volumes can't be snapshotted
+	// as a group.
+	CreateGroupSnapshot(volumeAZs map[string]string, tags map[string]string) (snapshotIDs map[string]string, err error)
+}
+
+// SnapshotProgress is the progress of uploading the data of a volume snapshot
+// after it's been created.
+type SnapshotProgress struct {
+	// Completed is true once the snapshot's data has been uploaded and the
+	// snapshot can be restored from.
+	Completed bool
+
+	// FailureReason describes why uploading the snapshot's data failed. It's
+	// empty unless the upload failed.
+	FailureReason string
+
+	// BytesDone is the number of bytes of the snapshot's data uploaded so far.
+	BytesDone int64
+
+	// BytesTotal is the total number of bytes of the snapshot's data, or 0
+	// if it isn't known.
+	BytesTotal int64
+
+	// Description is an optional human-readable description of the progress.
+	Description string
+}
+
+// SnapshotProgressReporter is an optional interface that can be implemented by
+// a VolumeSnapshotter whose snapshots are created asynchronously, i.e. whose
+// CreateSnapshot returns before the snapshot's data has been uploaded.
+type SnapshotProgressReporter interface {
+	// SnapshotProgress returns the progress of uploading the data of the specified
+	// snapshot, or ErrSnapshotProgressNotSupported if it isn't tracked.
+	SnapshotProgress(snapshotID string) (*SnapshotProgress, error)
+}
+
+// SnapshotCopier is an optional interface that can be implemented by a
+// VolumeSnapshotter that can copy snapshots to a secondary region or location,
+// so that they survive the loss of the region or location they were taken in.
+type SnapshotCopier interface {
+	// CopySnapshot copies the specified snapshot, of a volume in the specified
+	// availability zone, to the region or location described by the provided
+	// configuration key-value pairs, and returns the ID of the copy there. It
+	// returns ErrCopySnapshotNotSupported if the snapshot can't be copied.
+	CopySnapshot(snapshotID, volumeAZ string, config map[string]string) (string, error)
+}
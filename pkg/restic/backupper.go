@@ -29,7 +29,9 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/features"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 )
 
@@ -145,15 +147,24 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 			}
 		}
 
-		// hostPath volumes are not supported because they're not mounted into /var/lib/kubelet/pods, so our
-		// daemonset pod has no way to access their data.
+		// hostPath volumes are not mounted into /var/lib/kubelet/pods, so our daemonset pod can only access
+		// their data if it mounts the node's root filesystem, which it only does when the feature is enabled.
 		isHostPath, err := isHostPathVolume(&volume, pvc, b.pvClient.PersistentVolumes())
 		if err != nil {
 			errs = append(errs, errors.Wrap(err, "error checking if volume is a hostPath volume"))
 			continue
 		}
-		if isHostPath {
-			log.Warnf("Volume %s in pod %s/%s is a hostPath volume which is not supported for restic backup, skipping", volumeName, pod.Namespace, pod.Name)
+		if isHostPath && !features.IsEnabled(velerov1api.ResticHostPathVolumesFeatureFlag) {
+			log.Warnf("Volume %s in pod %s/%s is a hostPath volume which is not supported for restic backup unless the %s feature flag is enabled, skipping",
+				volumeName, pod.Namespace, pod.Name, velerov1api.ResticHostPathVolumesFeatureFlag)
+			continue
+		}
+
+		// block volumes have no files to back up, so their device is streamed to restic, which requires
+		// our daemonset pod to be privileged.
+		if isBlockVolume(pvc) && !features.IsEnabled(velerov1api.ResticBlockVolumesFeatureFlag) {
+			log.Warnf("Volume %s in pod %s/%s is a block volume which is not supported for restic backup unless the %s feature flag is enabled, skipping",
+				volumeName, pod.Namespace, pod.Name, velerov1api.ResticBlockVolumesFeatureFlag)
 			continue
 		}
 
@@ -162,7 +173,19 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 			continue
 		}
 
-		volumeBackup := newPodVolumeBackup(backup, pod, volume, repo.Spec.ResticIdentifier, pvc)
+		includedPaths, excludedPaths := GetPodVolumePathFilters(backup, pod, volumeName)
+		if err := ValidatePathPatterns(includedPaths); err != nil {
+			errs = append(errs, errors.Wrapf(err, "invalid included paths for volume %s in pod %s/%s", volumeName, pod.Namespace, pod.Name))
+			continue
+		}
+		if err := ValidatePathPatterns(excludedPaths); err != nil {
+			errs = append(errs, errors.Wrapf(err, "invalid excluded paths for volume %s in pod %s/%s", volumeName, pod.Namespace, pod.Name))
+			continue
+		}
+
+		volumeBackup := newPodVolumeBackup(backup, pod, volume, repo, pvc)
+		volumeBackup.Spec.IncludedPaths = includedPaths
+		volumeBackup.Spec.ExcludedPaths = excludedPaths
 		if volumeBackup, err = b.repoManager.veleroClient.VeleroV1().PodVolumeBackups(volumeBackup.Namespace).Create(context.TODO(), volumeBackup, metav1.CreateOptions{}); err != nil {
 			errs = append(errs, err)
 			continue
@@ -221,7 +244,13 @@ func isHostPathVolume(volume *corev1api.Volume, pvc *corev1api.PersistentVolumeC
 	return pv.Spec.HostPath != nil, nil
 }
 
-func newPodVolumeBackup(backup *velerov1api.Backup, pod *corev1api.Pod, volume corev1api.Volume, repoIdentifier string, pvc *corev1api.PersistentVolumeClaim) *velerov1api.PodVolumeBackup {
+// isBlockVolume returns true if the persistent volume claim is for a raw block
+// persistent volume, or false otherwise.
+func isBlockVolume(pvc *corev1api.PersistentVolumeClaim) bool {
+	return pvc != nil && pvc.Spec.VolumeMode != nil && *pvc.Spec.VolumeMode == corev1api.PersistentVolumeBlock
+}
+
+func newPodVolumeBackup(backup *velerov1api.Backup, pod *corev1api.Pod, volume corev1api.Volume, repo *velerov1api.ResticRepository, pvc *corev1api.PersistentVolumeClaim) *velerov1api.PodVolumeBackup {
 	pvb := &velerov1api.PodVolumeBackup{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace:    backup.Namespace,
@@ -258,7 +287,10 @@ func newPodVolumeBackup(backup *velerov1api.Backup, pod *corev1api.Pod, volume c
 				"volume":     volume.Name,
 			},
 			BackupStorageLocation: backup.Spec.StorageLocation,
-			RepoIdentifier:        repoIdentifier,
+			RepoIdentifier:        repo.Spec.ResticIdentifier,
+			RepositoryKeySecret:   repo.Spec.RepositoryKeySecret,
+			UploaderType:          uploader.GetUploaderType(backup.Spec.UploaderType),
+			PodVolumeTransfer:     backup.Spec.PodVolumeTransfer.DeepCopy(),
 		},
 	}
 
@@ -18,10 +18,13 @@ package restic
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
 
 // Command represents a restic command.
@@ -34,6 +37,14 @@ type Command struct {
 	Args           []string
 	ExtraFlags     []string
 	Env            []string
+
+	// Stdin, if set, is read by the command as its standard input.
+	Stdin io.Reader
+
+	// Nice and IOPriorityClass, if set, lower the CPU and I/O scheduling
+	// priority of the restic process, which is started with them.
+	Nice            int
+	IOPriorityClass velerov1api.IOPriorityClass
 }
 
 func (c *Command) RepoName() string {
@@ -79,6 +90,7 @@ func (c *Command) Cmd() *exec.Cmd {
 	parts := c.StringSlice()
 	cmd := exec.Command(parts[0], parts[1:]...)
 	cmd.Dir = c.Dir
+	cmd.Stdin = c.Stdin
 
 	if len(c.Env) > 0 {
 		cmd.Env = c.Env
@@ -21,20 +21,38 @@ import (
 	"strings"
 )
 
-// BackupCommand returns a Command for running a restic backup.
-func BackupCommand(repoIdentifier, passwordFile, path string, tags map[string]string) *Command {
+// BackupCommand returns a Command for running a restic backup of the given
+// paths, relative to path, or of all of path if there are none. Paths matching
+// any of excludedPaths are excluded from the backup.
+func BackupCommand(repoIdentifier, passwordFile, path string, paths, excludedPaths []string, tags map[string]string) *Command {
 	// --host flag is provided with a generic value because restic uses the host
 	// to find a parent snapshot, and by default it will be the name of the daemonset pod
 	// where the `restic backup` command is run. If this pod is recreated, we want to continue
 	// taking incremental backups rather than triggering a full one due to a new pod name.
 
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+
 	return &Command{
 		Command:        "backup",
 		RepoIdentifier: repoIdentifier,
 		PasswordFile:   passwordFile,
 		Dir:            path,
-		Args:           []string{"."},
-		ExtraFlags:     append(backupTagFlags(tags), "--host=velero", "--json"),
+		Args:           paths,
+		ExtraFlags:     append(append(backupTagFlags(tags), "--host=velero", "--json"), ExcludeFlags(path, excludedPaths)...),
+	}
+}
+
+// BlockBackupCommand returns a Command for running a restic backup of the
+// contents of a block device read from the command's stdin. The device is
+// stored in the snapshot as a single file.
+func BlockBackupCommand(repoIdentifier, passwordFile string, tags map[string]string) *Command {
+	return &Command{
+		Command:        "backup",
+		RepoIdentifier: repoIdentifier,
+		PasswordFile:   passwordFile,
+		ExtraFlags:     append(backupTagFlags(tags), "--host=velero", "--json", "--stdin", fmt.Sprintf("--stdin-filename=%s", BlockVolumeFileName)),
 	}
 }
 
@@ -46,15 +64,27 @@ func backupTagFlags(tags map[string]string) []string {
 	return flags
 }
 
-// RestoreCommand returns a Command for running a restic restore.
-func RestoreCommand(repoIdentifier, passwordFile, snapshotID, target string) *Command {
+// RestoreCommand returns a Command for running a restic restore. If
+// includedPaths isn't empty, only the paths matching them are restored.
+func RestoreCommand(repoIdentifier, passwordFile, snapshotID, target string, includedPaths []string) *Command {
 	return &Command{
 		Command:        "restore",
 		RepoIdentifier: repoIdentifier,
 		PasswordFile:   passwordFile,
 		Dir:            target,
 		Args:           []string{snapshotID},
-		ExtraFlags:     []string{"--target=."},
+		ExtraFlags:     append([]string{"--target=."}, IncludeFlags(includedPaths)...),
+	}
+}
+
+// DumpCommand returns a Command for writing the block device contents stored
+// in a restic snapshot to the command's stdout.
+func DumpCommand(repoIdentifier, passwordFile, snapshotID string) *Command {
+	return &Command{
+		Command:        "dump",
+		RepoIdentifier: repoIdentifier,
+		PasswordFile:   passwordFile,
+		Args:           []string{snapshotID, "/" + BlockVolumeFileName},
 	}
 }
 
@@ -113,6 +143,70 @@ func UnlockCommand(repoIdentifier string) *Command {
 	}
 }
 
+// UnlockAllCommand returns a Command for removing all locks, including
+// ones that aren't stale, from a restic repository.
+func UnlockAllCommand(repoIdentifier string) *Command {
+	return &Command{
+		Command:        "unlock",
+		RepoIdentifier: repoIdentifier,
+		ExtraFlags:     []string{"--remove-all"},
+	}
+}
+
+// CheckCommand returns a Command for checking the integrity of a restic
+// repository. If readDataSubset is not empty, that subset of the repository's
+// data is also read and verified.
+func CheckCommand(repoIdentifier, readDataSubset string) *Command {
+	cmd := &Command{
+		Command:        "check",
+		RepoIdentifier: repoIdentifier,
+	}
+	if readDataSubset != "" {
+		cmd.ExtraFlags = append(cmd.ExtraFlags, fmt.Sprintf("--read-data-subset=%s", readDataSubset))
+	}
+	return cmd
+}
+
+// RepoStatsCommand returns a Command for getting the size of the data stored
+// in a restic repository.
+func RepoStatsCommand(repoIdentifier string) *Command {
+	return &Command{
+		Command:        "stats",
+		RepoIdentifier: repoIdentifier,
+		ExtraFlags:     []string{"--json", "--mode=raw-data"},
+	}
+}
+
+// KeyListCommand returns a Command for listing the keys of a restic repository.
+func KeyListCommand(repoIdentifier string) *Command {
+	return &Command{
+		Command:        "key",
+		RepoIdentifier: repoIdentifier,
+		Args:           []string{"list"},
+		ExtraFlags:     []string{"--json"},
+	}
+}
+
+// KeyAddCommand returns a Command for adding a key with the password in
+// newPasswordFile to a restic repository.
+func KeyAddCommand(repoIdentifier, newPasswordFile string) *Command {
+	return &Command{
+		Command:        "key",
+		RepoIdentifier: repoIdentifier,
+		Args:           []string{"add"},
+		ExtraFlags:     []string{fmt.Sprintf("--new-password-file=%s", newPasswordFile)},
+	}
+}
+
+// KeyRemoveCommand returns a Command for removing a key from a restic repository.
+func KeyRemoveCommand(repoIdentifier, keyID string) *Command {
+	return &Command{
+		Command:        "key",
+		RepoIdentifier: repoIdentifier,
+		Args:           []string{"remove", keyID},
+	}
+}
+
 func StatsCommand(repoIdentifier, passwordFile, snapshotID string) *Command {
 	return &Command{
 		Command:        "stats",
@@ -24,7 +24,7 @@ import (
 )
 
 func TestBackupCommand(t *testing.T) {
-	c := BackupCommand("repo-id", "password-file", "path", map[string]string{"foo": "bar", "c": "d"})
+	c := BackupCommand("repo-id", "password-file", "path", nil, nil, map[string]string{"foo": "bar", "c": "d"})
 
 	assert.Equal(t, "backup", c.Command)
 	assert.Equal(t, "repo-id", c.RepoIdentifier)
@@ -38,8 +38,31 @@ func TestBackupCommand(t *testing.T) {
 	assert.Equal(t, expected, c.ExtraFlags)
 }
 
+func TestBackupCommandWithPathFilters(t *testing.T) {
+	c := BackupCommand("repo-id", "password-file", "/host_pods/pod-uid/volumes/vol", []string{"data", "logs/app.log"}, []string{"cache", "/tmp/*"}, nil)
+
+	assert.Equal(t, "/host_pods/pod-uid/volumes/vol", c.Dir)
+	assert.Equal(t, []string{"data", "logs/app.log"}, c.Args)
+	assert.Equal(t, []string{"--host=velero", "--json", "--exclude=cache", "--exclude=/host_pods/pod-uid/volumes/vol/tmp/*"}, c.ExtraFlags)
+}
+
+func TestBlockBackupCommand(t *testing.T) {
+	c := BlockBackupCommand("repo-id", "password-file", map[string]string{"foo": "bar", "c": "d"})
+
+	assert.Equal(t, "backup", c.Command)
+	assert.Equal(t, "repo-id", c.RepoIdentifier)
+	assert.Equal(t, "password-file", c.PasswordFile)
+	assert.Empty(t, c.Dir)
+	assert.Empty(t, c.Args)
+
+	expected := []string{"--tag=foo=bar", "--tag=c=d", "--host=velero", "--json", "--stdin", "--stdin-filename=block-device"}
+	sort.Strings(expected)
+	sort.Strings(c.ExtraFlags)
+	assert.Equal(t, expected, c.ExtraFlags)
+}
+
 func TestRestoreCommand(t *testing.T) {
-	c := RestoreCommand("repo-id", "password-file", "snapshot-id", "target")
+	c := RestoreCommand("repo-id", "password-file", "snapshot-id", "target", nil)
 
 	assert.Equal(t, "restore", c.Command)
 	assert.Equal(t, "repo-id", c.RepoIdentifier)
@@ -49,6 +72,13 @@ func TestRestoreCommand(t *testing.T) {
 	assert.Equal(t, []string{"--target=."}, c.ExtraFlags)
 }
 
+func TestRestoreCommandWithIncludedPaths(t *testing.T) {
+	c := RestoreCommand("repo-id", "password-file", "snapshot-id", "target", []string{"/db/data", "config.yaml"})
+
+	assert.Equal(t, []string{"snapshot-id"}, c.Args)
+	assert.Equal(t, []string{"--target=.", "--include=/db/data", "--include=/config.yaml"}, c.ExtraFlags)
+}
+
 func TestGetSnapshotCommand(t *testing.T) {
 	expectedTags := map[string]string{"foo": "bar", "c": "d"}
 	c := GetSnapshotCommand("repo-id", "password-file", expectedTags)
@@ -127,3 +157,56 @@ func TestStatsCommand(t *testing.T) {
 	assert.Equal(t, []string{"snapshot-id"}, c.Args)
 	assert.Equal(t, []string{"--json"}, c.ExtraFlags)
 }
+
+func TestDumpCommand(t *testing.T) {
+	c := DumpCommand("repo-id", "password-file", "snapshot-id")
+
+	assert.Equal(t, "dump", c.Command)
+	assert.Equal(t, "repo-id", c.RepoIdentifier)
+	assert.Equal(t, "password-file", c.PasswordFile)
+	assert.Equal(t, []string{"snapshot-id", "/block-device"}, c.Args)
+}
+
+func TestUnlockAllCommand(t *testing.T) {
+	c := UnlockAllCommand("repo-id")
+
+	assert.Equal(t, "unlock", c.Command)
+	assert.Equal(t, "repo-id", c.RepoIdentifier)
+	assert.Equal(t, []string{"--remove-all"}, c.ExtraFlags)
+}
+
+func TestCheckCommand(t *testing.T) {
+	c := CheckCommand("repo-id", "")
+
+	assert.Equal(t, "check", c.Command)
+	assert.Equal(t, "repo-id", c.RepoIdentifier)
+	assert.Empty(t, c.ExtraFlags)
+
+	c = CheckCommand("repo-id", "5%")
+	assert.Equal(t, []string{"--read-data-subset=5%"}, c.ExtraFlags)
+}
+
+func TestRepoStatsCommand(t *testing.T) {
+	c := RepoStatsCommand("repo-id")
+
+	assert.Equal(t, "stats", c.Command)
+	assert.Equal(t, "repo-id", c.RepoIdentifier)
+	assert.Equal(t, []string{"--json", "--mode=raw-data"}, c.ExtraFlags)
+}
+
+func TestKeyCommands(t *testing.T) {
+	c := KeyListCommand("repo-id")
+	assert.Equal(t, "key", c.Command)
+	assert.Equal(t, "repo-id", c.RepoIdentifier)
+	assert.Equal(t, []string{"list"}, c.Args)
+	assert.Equal(t, []string{"--json"}, c.ExtraFlags)
+
+	c = KeyAddCommand("repo-id", "new-password-file")
+	assert.Equal(t, "key", c.Command)
+	assert.Equal(t, []string{"add"}, c.Args)
+	assert.Equal(t, []string{"--new-password-file=new-password-file"}, c.ExtraFlags)
+
+	c = KeyRemoveCommand("repo-id", "key-id")
+	assert.Equal(t, "key", c.Command)
+	assert.Equal(t, []string{"remove", "key-id"}, c.Args)
+}
@@ -29,6 +29,7 @@ import (
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/features"
 	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
@@ -46,6 +47,14 @@ const (
 	// at which restic prune is run.
 	DefaultMaintenanceFrequency = 7 * 24 * time.Hour
 
+	// DefaultCheckFrequency is the default time interval
+	// at which restic check is run.
+	DefaultCheckFrequency = 7 * 24 * time.Hour
+
+	// DefaultKeyRotationTimeout is the default time the new key of a
+	// rotation has to be added to all repositories within.
+	DefaultKeyRotationTimeout = 24 * time.Hour
+
 	// DefaultVolumesToRestic specifies whether restic should be used, by default, to
 	// take backup of all pod volumes.
 	DefaultVolumesToRestic = false
@@ -62,6 +71,20 @@ const (
 	// should be excluded from restic backup.
 	VolumesToExcludeAnnotation = "backup.velero.io/backup-volumes-excludes"
 
+	// IncludedPathsAnnotationPrefix is the prefix of the annotations on a pod,
+	// suffixed with the name of one of its volumes, that list comma-separated
+	// glob patterns of the paths within the volume to back up using restic.
+	IncludedPathsAnnotationPrefix = "include-paths.backup.velero.io/"
+
+	// ExcludedPathsAnnotationPrefix is the prefix of the annotations on a pod,
+	// suffixed with the name of one of its volumes, that list comma-separated
+	// restic exclude patterns of the paths within the volume not to back up.
+	ExcludedPathsAnnotationPrefix = "exclude-paths.backup.velero.io/"
+
+	// BlockVolumeFileName is the name of the file holding the contents of
+	// a block volume's device in its restic snapshot.
+	BlockVolumeFileName = "block-device"
+
 	// credentialsFileKey is the key within a BSL config that is checked to see if
 	// the BSL is using its own credentials, rather than those in the environment
 	credentialsFileKey = "credentialsFile"
@@ -100,6 +123,18 @@ func isPVBMatchPod(pvb *velerov1api.PodVolumeBackup, podName string, namespace s
 	return podName == pvb.Spec.Pod.Name && namespace == pvb.Spec.Pod.Namespace
 }
 
+// getPodVolumeBackupsByVolume returns a map of volume name -> pod volume backup
+// for the pod volume backups of the given pod.
+func getPodVolumeBackupsByVolume(podVolumeBackups []*velerov1api.PodVolumeBackup, pod *corev1api.Pod, sourcePodNs string) map[string]*velerov1api.PodVolumeBackup {
+	backups := make(map[string]*velerov1api.PodVolumeBackup)
+	for _, pvb := range podVolumeBackups {
+		if isPVBMatchPod(pvb, pod.GetName(), sourcePodNs) {
+			backups[pvb.Spec.Volume] = pvb
+		}
+	}
+	return backups
+}
+
 // volumeHasNonRestorableSource checks if the given volume exists in the list of podVolumes
 // and returns true if the volume's source is not restorable. This is true for volumes with
 // a Projected or DownwardAPI source.
@@ -147,6 +182,58 @@ func GetVolumeBackupsForPod(podVolumeBackups []*velerov1api.PodVolumeBackup, pod
 	return getPodSnapshotAnnotations(pod)
 }
 
+// GetVolumeTypesForPod returns a map, of volume name -> volume type, of the
+// PodVolumeBackups that exist for the provided pod. Volumes backed up before
+// volume types were recorded are omitted.
+func GetVolumeTypesForPod(podVolumeBackups []*velerov1api.PodVolumeBackup, pod *corev1api.Pod, sourcePodNs string) map[string]velerov1api.PodVolumeType {
+	types := make(map[string]velerov1api.PodVolumeType)
+
+	for _, pvb := range podVolumeBackups {
+		if !isPVBMatchPod(pvb, pod.GetName(), sourcePodNs) || pvb.Status.VolumeType == "" {
+			continue
+		}
+		types[pvb.Spec.Volume] = pvb.Status.VolumeType
+	}
+
+	return types
+}
+
+// RestoreDoneVolumeName returns the name of the emptyDir volume added to a
+// restored pod to hold the done file of the restore of the given block volume,
+// since the done file can't be written to the volume itself.
+func RestoreDoneVolumeName(volumeName string) string {
+	return label.GetValidName("velero-restore-" + volumeName)
+}
+
+// RestoredWithHelperPod returns whether the given volume of a restored pod is
+// restored using a restore helper pod rather than an init container. Block
+// volumes are always restored using an init container, and the volumes of
+// pod volume file restores always using a helper pod.
+func RestoredWithHelperPod(restore *velerov1api.Restore, volume corev1api.Volume, volumeType velerov1api.PodVolumeType) bool {
+	helperPodMode := restore.Spec.PodVolumeRestoreMode == velerov1api.PodVolumeRestoreModeHelperPod || restore.Spec.PodVolumeFileRestore != nil
+	return helperPodMode &&
+		volume.PersistentVolumeClaim != nil &&
+		volumeType != velerov1api.PodVolumeTypeBlock
+}
+
+// FilterVolumesForRestoreMode returns the volumes of the given map of volume
+// name to snapshot ID that are restored using a restore helper pod if helperPod
+// is true, or using an init container otherwise.
+func FilterVolumesForRestoreMode(restore *velerov1api.Restore, pod *corev1api.Pod, volumes map[string]string, volumeTypes map[string]velerov1api.PodVolumeType, helperPod bool) map[string]string {
+	podVolumes := make(map[string]corev1api.Volume)
+	for _, volume := range pod.Spec.Volumes {
+		podVolumes[volume.Name] = volume
+	}
+
+	res := make(map[string]string)
+	for volume, snapshot := range volumes {
+		if RestoredWithHelperPod(restore, podVolumes[volume], volumeTypes[volume]) == helperPod {
+			res[volume] = snapshot
+		}
+	}
+	return res
+}
+
 // GetVolumesToBackup returns a list of volume names to backup for
 // the provided pod.
 // Deprecated: Use GetPodVolumesUsingRestic instead.
@@ -191,9 +278,9 @@ func GetPodVolumesUsingRestic(pod *corev1api.Pod, defaultVolumesToRestic bool) [
 	volsToExclude := getVolumesToExclude(pod)
 	podVolumes := []string{}
 	for _, pv := range pod.Spec.Volumes {
-		// cannot backup hostpath volumes as they are not mounted into /var/lib/kubelet/pods
-		// and therefore not accessible to the restic daemon set.
-		if pv.HostPath != nil {
+		// hostpath volumes are not mounted into /var/lib/kubelet/pods and therefore only
+		// accessible to the restic daemon set if it mounts the node's root filesystem.
+		if pv.HostPath != nil && !features.IsEnabled(velerov1api.ResticHostPathVolumesFeatureFlag) {
 			continue
 		}
 		// don't backup volumes mounting secrets. Secrets will be backed up separately.
@@ -236,8 +323,20 @@ type SnapshotIdentifier struct {
 	// name.
 	BackupStorageLocation string
 
-	// SnapshotID is the short ID of the restic snapshot.
+	// SnapshotID is the short ID of the restic snapshot, or the ID of
+	// the Kopia snapshot.
 	SnapshotID string
+
+	// UploaderType is the type of the uploader that took the snapshot.
+	UploaderType string
+
+	// RepoIdentifier identifies the restic repository of the snapshot,
+	// and the Kopia repository next to it.
+	RepoIdentifier string
+
+	// RepositoryKeySecret is the name of the secret containing the
+	// repository's password, or empty for the common one.
+	RepositoryKeySecret string
 }
 
 // GetSnapshotsInBackup returns a list of all restic snapshot ids associated with
@@ -261,6 +360,9 @@ func GetSnapshotsInBackup(backup *velerov1api.Backup, podVolumeBackupLister vele
 			VolumeNamespace:       item.Spec.Pod.Namespace,
 			BackupStorageLocation: backup.Spec.StorageLocation,
 			SnapshotID:            item.Status.SnapshotID,
+			UploaderType:          item.Spec.UploaderType,
+			RepoIdentifier:        item.Spec.RepoIdentifier,
+			RepositoryKeySecret:   item.Spec.RepositoryKeySecret,
 		})
 	}
 
@@ -321,10 +423,10 @@ func CmdEnv(backupLocation *velerov1api.BackupStorageLocation, credentialFileSto
 		config[credentialsFileKey] = credsFile
 	}
 
-	backendType := getBackendType(backupLocation.Spec.Provider)
+	backendType := GetBackendType(backupLocation.Spec.Provider)
 
 	switch backendType {
-	case AWSBackend:
+	case AWSBackend, S3Backend:
 		customEnv, err = getS3ResticEnvVars(config)
 		if err != nil {
 			return []string{}, err
@@ -294,7 +294,10 @@ func TestGetSnapshotsInBackup(t *testing.T) {
 				{
 					ObjectMeta: metav1.ObjectMeta{Name: "completed-pvb-2", Labels: map[string]string{velerov1api.BackupNameLabel: "backup-1"}},
 					Spec: velerov1api.PodVolumeBackupSpec{
-						Pod: corev1api.ObjectReference{Name: "pod-1", Namespace: "ns-1"},
+						Pod:                 corev1api.ObjectReference{Name: "pod-1", Namespace: "ns-1"},
+						RepoIdentifier:      "repo-1",
+						RepositoryKeySecret: "ns-1-repo-key",
+						UploaderType:        "kopia",
 					},
 					Status: velerov1api.PodVolumeBackupStatus{SnapshotID: "snap-4"},
 				},
@@ -312,8 +315,11 @@ func TestGetSnapshotsInBackup(t *testing.T) {
 					SnapshotID:      "snap-3",
 				},
 				{
-					VolumeNamespace: "ns-1",
-					SnapshotID:      "snap-4",
+					VolumeNamespace:     "ns-1",
+					SnapshotID:          "snap-4",
+					UploaderType:        "kopia",
+					RepoIdentifier:      "repo-1",
+					RepositoryKeySecret: "ns-1-repo-key",
 				},
 			},
 		},
@@ -424,6 +430,50 @@ func TestTempCACertFile(t *testing.T) {
 	os.Remove(fileName)
 }
 
+func TestCmdEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		location *velerov1api.BackupStorageLocation
+		expected []string
+	}{
+		{
+			name: "AWS BSL with a credential and profile",
+			location: builder.ForBackupStorageLocation("velero", "default").
+				Provider("aws").
+				Config(map[string]string{"profile": "velero"}).
+				Credential(builder.ForSecretKeySelector("cloud-credentials", "cloud").Result()).
+				Result(),
+			expected: []string{"AWS_SHARED_CREDENTIALS_FILE=/tmp/credentials/velero/cloud-credentials-cloud", "AWS_PROFILE=velero"},
+		},
+		{
+			name: "built-in S3 BSL with a credential",
+			location: builder.ForBackupStorageLocation("velero", "default").
+				Provider("velero.io/s3").
+				Credential(builder.ForSecretKeySelector("cloud-credentials", "cloud").Result()).
+				Result(),
+			expected: []string{"AWS_SHARED_CREDENTIALS_FILE=/tmp/credentials/velero/cloud-credentials-cloud"},
+		},
+		{
+			name: "unknown provider",
+			location: builder.ForBackupStorageLocation("velero", "default").
+				Provider("velero.io/filesystem").
+				Credential(builder.ForSecretKeySelector("cloud-credentials", "cloud").Result()).
+				Result(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			env, err := CmdEnv(test.location, velerotest.NewFakeCredentialsFileStore("/tmp/credentials/velero/cloud-credentials-cloud", nil))
+			require.NoError(t, err)
+
+			// the current environment is included first
+			customEnv := env[len(os.Environ()):]
+			assert.ElementsMatch(t, test.expected, customEnv)
+		})
+	}
+}
+
 func TestGetPodVolumesUsingRestic(t *testing.T) {
 	testCases := []struct {
 		name                   string
@@ -830,3 +880,82 @@ func TestVolumeHasNonRestorableSource(t *testing.T) {
 
 	}
 }
+
+func TestGetVolumeTypesForPod(t *testing.T) {
+	podVolumeBackups := []*velerov1api.PodVolumeBackup{
+		builder.ForPodVolumeBackup("velero", "pvb-1").PodName("pod-1").PodNamespace("ns-1").Volume("filesystem").VolumeType(velerov1api.PodVolumeTypeFilesystem).Result(),
+		builder.ForPodVolumeBackup("velero", "pvb-2").PodName("pod-1").PodNamespace("ns-1").Volume("block").VolumeType(velerov1api.PodVolumeTypeBlock).Result(),
+		builder.ForPodVolumeBackup("velero", "pvb-3").PodName("pod-1").PodNamespace("ns-1").Volume("untyped").Result(),
+		builder.ForPodVolumeBackup("velero", "pvb-4").PodName("pod-2").PodNamespace("ns-1").Volume("hostpath").VolumeType(velerov1api.PodVolumeTypeHostPath).Result(),
+	}
+	pod := builder.ForPod("ns-2", "pod-1").Result()
+
+	expected := map[string]velerov1api.PodVolumeType{
+		"filesystem": velerov1api.PodVolumeTypeFilesystem,
+		"block":      velerov1api.PodVolumeTypeBlock,
+	}
+	assert.Equal(t, expected, GetVolumeTypesForPod(podVolumeBackups, pod, "ns-1"))
+}
+
+func TestFilterVolumesForRestoreMode(t *testing.T) {
+	pod := builder.ForPod("ns-1", "pod-1").
+		Volumes(
+			&corev1api.Volume{Name: "pvc-volume", VolumeSource: corev1api.VolumeSource{PersistentVolumeClaim: &corev1api.PersistentVolumeClaimVolumeSource{ClaimName: "pvc-1"}}},
+			&corev1api.Volume{Name: "emptydir-volume", VolumeSource: corev1api.VolumeSource{EmptyDir: &corev1api.EmptyDirVolumeSource{}}},
+			&corev1api.Volume{Name: "block-volume", VolumeSource: corev1api.VolumeSource{PersistentVolumeClaim: &corev1api.PersistentVolumeClaimVolumeSource{ClaimName: "pvc-2"}}},
+		).
+		Result()
+	volumes := map[string]string{
+		"pvc-volume":      "snapshot-1",
+		"emptydir-volume": "snapshot-2",
+		"block-volume":    "snapshot-3",
+	}
+	volumeTypes := map[string]velerov1api.PodVolumeType{
+		"pvc-volume":   velerov1api.PodVolumeTypeFilesystem,
+		"block-volume": velerov1api.PodVolumeTypeBlock,
+	}
+
+	tests := []struct {
+		name        string
+		mode        velerov1api.PodVolumeRestoreMode
+		fileRestore *velerov1api.PodVolumeFileRestoreSpec
+		helperPod   bool
+		expected    map[string]string
+	}{
+		{
+			name:      "all volumes use the init container when the mode is not set",
+			helperPod: false,
+			expected:  volumes,
+		},
+		{
+			name:      "no volumes use a helper pod when the mode is not set",
+			helperPod: true,
+			expected:  map[string]string{},
+		},
+		{
+			name:      "PVC volumes use a helper pod in helper pod mode",
+			mode:      velerov1api.PodVolumeRestoreModeHelperPod,
+			helperPod: true,
+			expected:  map[string]string{"pvc-volume": "snapshot-1"},
+		},
+		{
+			name:      "non-PVC and block volumes use the init container in helper pod mode",
+			mode:      velerov1api.PodVolumeRestoreModeHelperPod,
+			helperPod: false,
+			expected:  map[string]string{"emptydir-volume": "snapshot-2", "block-volume": "snapshot-3"},
+		},
+		{
+			name:        "PVC volumes use a helper pod for pod volume file restores",
+			fileRestore: &velerov1api.PodVolumeFileRestoreSpec{Namespace: "ns-1", Pod: "pod-1", Volume: "pvc-volume", TargetPVC: "pvc-1"},
+			helperPod:   true,
+			expected:    map[string]string{"pvc-volume": "snapshot-1"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").PodVolumeRestoreMode(test.mode).PodVolumeFileRestore(test.fileRestore).Result()
+			assert.Equal(t, test.expected, FilterVolumesForRestoreMode(restore, pod, volumes, volumeTypes, test.helperPod))
+		})
+	}
+}
@@ -35,6 +35,7 @@ type BackendType string
 
 const (
 	AWSBackend   BackendType = "velero.io/aws"
+	S3Backend    BackendType = "velero.io/s3"
 	AzureBackend BackendType = "velero.io/azure"
 	GCPBackend   BackendType = "velero.io/gcp"
 )
@@ -55,14 +56,14 @@ func getRepoPrefix(location *velerov1api.BackupStorageLocation) (string, error)
 		prefix = layout.GetResticDir()
 	}
 
-	backendType := getBackendType(location.Spec.Provider)
+	backendType := GetBackendType(location.Spec.Provider)
 
 	if repoPrefix := location.Spec.Config["resticRepoPrefix"]; repoPrefix != "" {
 		return repoPrefix, nil
 	}
 
 	switch backendType {
-	case AWSBackend:
+	case AWSBackend, S3Backend:
 		var url string
 		// non-AWS, S3-compatible object store
 		if s3Url := location.Spec.Config["s3Url"]; s3Url != "" {
@@ -90,7 +91,9 @@ func getRepoPrefix(location *velerov1api.BackupStorageLocation) (string, error)
 	return "", errors.New("restic repository prefix (resticRepoPrefix) not specified in backup storage location's config")
 }
 
-func getBackendType(provider string) BackendType {
+// GetBackendType returns the backend type of the given object store provider,
+// which defaults to a velero.io one if it's not qualified.
+func GetBackendType(provider string) BackendType {
 	if !strings.Contains(provider, "/") {
 		provider = "velero.io/" + provider
 	}
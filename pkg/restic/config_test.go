@@ -146,6 +146,25 @@ func TestGetRepoIdentifier(t *testing.T) {
 			},
 			expected: "s3:alternate-url/bucket/prefix/restic/repo-1",
 		},
+		{
+			name: "s3Url is used in repo identifier if set for built-in S3 BSL",
+			bsl: &velerov1api.BackupStorageLocation{
+				Spec: velerov1api.BackupStorageLocationSpec{
+					Provider: "velero.io/s3",
+					Config: map[string]string{
+						"s3Url": "http://minio.velero.svc:9000/",
+					},
+					StorageType: velerov1api.StorageType{
+						ObjectStorage: &velerov1api.ObjectStorageLocation{
+							Bucket: "bucket",
+							Prefix: "prefix",
+						},
+					},
+				},
+			},
+			repoName: "repo-1",
+			expected: "s3:http://minio.velero.svc:9000/bucket/prefix/restic/repo-1",
+		},
 		{
 			name: "region is used in repo identifier if set for AWS BSL",
 			bsl: &velerov1api.BackupStorageLocation{
@@ -20,6 +20,10 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
+	osexec "os/exec"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -83,7 +87,7 @@ func RunBackup(backupCmd *Command, log logrus.FieldLogger, updateFunc func(veler
 	cmd.Stdout = stdoutBuf
 	cmd.Stderr = stderrBuf
 
-	err := cmd.Start()
+	err := startCommand(backupCmd, cmd, log)
 	if err != nil {
 		return stdoutBuf.String(), stderrBuf.String(), err
 	}
@@ -218,7 +222,7 @@ func RunRestore(restoreCmd *Command, log logrus.FieldLogger, updateFunc func(vel
 		}
 	}()
 
-	stdout, stderr, err := exec.RunCommand(restoreCmd.Cmd())
+	stdout, stderr, err := runCommand(restoreCmd, log)
 	quit <- struct{}{}
 
 	// update progress to 100%
@@ -230,6 +234,82 @@ func RunRestore(restoreCmd *Command, log logrus.FieldLogger, updateFunc func(vel
 	return stdout, stderr, err
 }
 
+// RunDump runs a `restic dump` command, writing its output to target, and
+// monitors the number of bytes written to provide progress updates to the
+// caller. It returns the command's stderr and its returned error (if any).
+func RunDump(dumpCmd *Command, target io.Writer, log logrus.FieldLogger, updateFunc func(velerov1api.PodVolumeOperationProgress)) (string, error) {
+	snapshotSize, err := getSnapshotSize(dumpCmd.RepoIdentifier, dumpCmd.PasswordFile, dumpCmd.CACertFile, dumpCmd.Args[0], dumpCmd.Env)
+	if err != nil {
+		return "", errors.Wrap(err, "error getting snapshot size")
+	}
+
+	updateFunc(velerov1api.PodVolumeOperationProgress{
+		TotalBytes: snapshotSize,
+	})
+
+	stdout := &countingWriter{writer: target}
+	stderrBuf := new(bytes.Buffer)
+
+	cmd := dumpCmd.Cmd()
+	cmd.Stdout = stdout
+	cmd.Stderr = stderrBuf
+
+	if err := startCommand(dumpCmd, cmd, log); err != nil {
+		return stderrBuf.String(), err
+	}
+
+	// create a channel to signal when to end the goroutine reporting progress
+	// updates
+	quit := make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(restoreProgressCheckInterval)
+		for {
+			select {
+			case <-ticker.C:
+				updateFunc(velerov1api.PodVolumeOperationProgress{
+					TotalBytes: snapshotSize,
+					BytesDone:  stdout.count(),
+				})
+			case <-quit:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+
+	err = cmd.Wait()
+	quit <- struct{}{}
+	if err != nil {
+		return stderrBuf.String(), err
+	}
+
+	// update progress to 100%
+	updateFunc(velerov1api.PodVolumeOperationProgress{
+		TotalBytes: snapshotSize,
+		BytesDone:  snapshotSize,
+	})
+
+	return stderrBuf.String(), nil
+}
+
+// countingWriter is an io.Writer that counts the bytes written to the
+// underlying writer.
+type countingWriter struct {
+	writer  io.Writer
+	written int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	atomic.AddInt64(&w.written, int64(n))
+	return n, err
+}
+
+func (w *countingWriter) count() int64 {
+	return atomic.LoadInt64(&w.written)
+}
+
 func getSnapshotSize(repoIdentifier, passwordFile, caCertFile, snapshotID string, env []string) (int64, error) {
 	cmd := StatsCommand(repoIdentifier, passwordFile, snapshotID)
 	cmd.Env = env
@@ -273,3 +353,132 @@ func getVolumeSize(path string) (int64, error) {
 
 	return size, nil
 }
+
+// runCommand runs the restic command, applying its scheduling priority, and
+// returns its stdout, stderr, and its returned error (if any).
+func runCommand(c *Command, log logrus.FieldLogger) (string, string, error) {
+	stdoutBuf := new(bytes.Buffer)
+	stderrBuf := new(bytes.Buffer)
+
+	cmd := c.Cmd()
+	cmd.Stdout = stdoutBuf
+	cmd.Stderr = stderrBuf
+
+	if err := startCommand(c, cmd, log); err != nil {
+		return stdoutBuf.String(), stderrBuf.String(), err
+	}
+
+	err := cmd.Wait()
+	return stdoutBuf.String(), stderrBuf.String(), err
+}
+
+// startCommand starts the process of the restic command with the command's
+// scheduling priority, if it has one.
+func startCommand(c *Command, cmd *osexec.Cmd, log logrus.FieldLogger) error {
+	if c.Nice == 0 && c.IOPriorityClass == "" {
+		return cmd.Start()
+	}
+	return startWithPriority(cmd, c.Nice, c.IOPriorityClass, log)
+}
+
+// checkFailedMessage is written to stderr by restic check when the repository
+// contains errors.
+const checkFailedMessage = "Fatal: repository contains errors"
+
+// maxCheckErrors is the maximum number of errors reported from restic check.
+const maxCheckErrors = 10
+
+// getCheckErrors returns the errors reported by restic check in its stderr.
+func getCheckErrors(stderr string) []string {
+	var checkErrors []string
+	for _, line := range strings.Split(stderr, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, checkFailedMessage) {
+			continue
+		}
+		if len(checkErrors) == maxCheckErrors {
+			checkErrors = append(checkErrors, "...")
+			break
+		}
+		checkErrors = append(checkErrors, line)
+	}
+
+	if len(checkErrors) == 0 {
+		return []string{checkFailedMessage}
+	}
+	return checkErrors
+}
+
+// decodeRepoStats returns the statistics of a repository from the JSON output of
+// restic stats in raw-data mode and restic snapshots.
+func decodeRepoStats(statsOutput, snapshotsOutput string) (*velerov1api.ResticRepositoryStats, error) {
+	var stats struct {
+		TotalSize int64 `json:"total_size"`
+	}
+	if err := json.Unmarshal([]byte(statsOutput), &stats); err != nil {
+		return nil, errors.Wrap(err, "error unmarshalling restic stats result")
+	}
+
+	var snapshots []json.RawMessage
+	if err := json.Unmarshal([]byte(snapshotsOutput), &snapshots); err != nil {
+		return nil, errors.Wrap(err, "error unmarshalling restic snapshots result")
+	}
+
+	return &velerov1api.ResticRepositoryStats{
+		TotalSize:     stats.TotalSize,
+		SnapshotCount: len(snapshots),
+	}, nil
+}
+
+// getCurrentKeyID returns the ID of the key used to open the repository from
+// the JSON output of restic key list.
+type resticKey struct {
+	Current bool   `json:"current"`
+	ID      string `json:"id"`
+}
+
+func decodeKeyList(keyListOutput string) ([]resticKey, error) {
+	var keys []resticKey
+	if err := json.Unmarshal([]byte(keyListOutput), &keys); err != nil {
+		return nil, errors.Wrap(err, "error unmarshalling restic key list result")
+	}
+	return keys, nil
+}
+
+func getCurrentKeyID(keyListOutput string) (string, error) {
+	keys, err := decodeKeyList(keyListOutput)
+	if err != nil {
+		return "", err
+	}
+
+	for _, key := range keys {
+		if key.Current {
+			return key.ID, nil
+		}
+	}
+	return "", errors.New("current key not found in restic key list result")
+}
+
+// getAddedKeyID returns the ID of the key in the output of restic key list that
+// wasn't in the previous output.
+func getAddedKeyID(previousKeyListOutput, keyListOutput string) (string, error) {
+	previousKeys, err := decodeKeyList(previousKeyListOutput)
+	if err != nil {
+		return "", err
+	}
+	keys, err := decodeKeyList(keyListOutput)
+	if err != nil {
+		return "", err
+	}
+
+	previousIDs := make(map[string]bool)
+	for _, key := range previousKeys {
+		previousIDs[key.ID] = true
+	}
+	for _, key := range keys {
+		if !previousIDs[key.ID] {
+			return key.ID, nil
+		}
+	}
+	return "", errors.New("added key not found in restic key list result")
+}
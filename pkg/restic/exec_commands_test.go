@@ -108,3 +108,50 @@ func Test_getVolumeSize(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expectedSize, actualSize)
 }
+
+func Test_getCheckErrors(t *testing.T) {
+	stderr := `error for tree 5b6f6f0c:
+  tree 5b6f6f0c: file "foo" blob 0 size could not be found
+Fatal: repository contains errors
+`
+	assert.Equal(t, []string{
+		"error for tree 5b6f6f0c:",
+		`tree 5b6f6f0c: file "foo" blob 0 size could not be found`,
+	}, getCheckErrors(stderr))
+
+	assert.Equal(t, []string{checkFailedMessage}, getCheckErrors(checkFailedMessage+"\n"))
+}
+
+func Test_decodeRepoStats(t *testing.T) {
+	stats, err := decodeRepoStats(`{"total_size":1024,"total_blob_count":3}`, `[{"id":"a"},{"id":"b"}]`)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1024), stats.TotalSize)
+	assert.Equal(t, 2, stats.SnapshotCount)
+
+	_, err = decodeRepoStats(`not json`, `[]`)
+	assert.Error(t, err)
+}
+
+func Test_getCurrentKeyID(t *testing.T) {
+	id, err := getCurrentKeyID(`[{"current":false,"id":"old"},{"current":true,"id":"cur"}]`)
+	assert.NoError(t, err)
+	assert.Equal(t, "cur", id)
+
+	_, err = getCurrentKeyID(`[{"current":false,"id":"old"}]`)
+	assert.Error(t, err)
+
+	_, err = getCurrentKeyID(`not json`)
+	assert.Error(t, err)
+}
+
+func Test_getAddedKeyID(t *testing.T) {
+	id, err := getAddedKeyID(`[{"current":true,"id":"cur"}]`, `[{"current":true,"id":"cur"},{"current":false,"id":"new"}]`)
+	assert.NoError(t, err)
+	assert.Equal(t, "new", id)
+
+	_, err = getAddedKeyID(`[{"current":true,"id":"cur"}]`, `[{"current":true,"id":"cur"}]`)
+	assert.Error(t, err)
+
+	_, err = getAddedKeyID(`[{"current":true,"id":"cur"}]`, `not json`)
+	assert.Error(t, err)
+}
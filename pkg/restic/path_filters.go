@@ -0,0 +1,144 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// GetPodVolumePathFilters returns the patterns of the paths within the given
+// pod volume to include in and exclude from its restic backup. The included
+// paths come from the pod's annotation for the volume if it's set, or from the
+// backup's path filters for the volume otherwise. The excluded paths come from
+// both.
+func GetPodVolumePathFilters(backup *velerov1api.Backup, pod *corev1api.Pod, volumeName string) (includedPaths, excludedPaths []string) {
+	for _, filter := range backup.Spec.PodVolumePathFilters {
+		if len(filter.Volumes) > 0 && !contains(filter.Volumes, volumeName) {
+			continue
+		}
+		includedPaths = append(includedPaths, filter.IncludedPaths...)
+		excludedPaths = append(excludedPaths, filter.ExcludedPaths...)
+	}
+
+	if value, ok := pod.Annotations[IncludedPathsAnnotationPrefix+volumeName]; ok {
+		includedPaths = splitPatterns(value)
+	}
+	if value, ok := pod.Annotations[ExcludedPathsAnnotationPrefix+volumeName]; ok {
+		excludedPaths = append(excludedPaths, splitPatterns(value)...)
+	}
+
+	return includedPaths, excludedPaths
+}
+
+func splitPatterns(value string) []string {
+	var patterns []string
+	for _, pattern := range strings.Split(value, ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			patterns = append(patterns, pattern)
+		}
+	}
+	return patterns
+}
+
+// ValidatePathPatterns returns an error if any of the given include or
+// exclude path patterns is malformed or refers to a path outside of the
+// volume.
+func ValidatePathPatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		if strings.TrimSpace(pattern) == "" {
+			return errors.New("path patterns must not be empty")
+		}
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return errors.Wrapf(err, "invalid path pattern %q", pattern)
+		}
+		for _, element := range strings.Split(pattern, "/") {
+			if element == ".." {
+				return errors.Errorf("path pattern %q must not refer to a parent directory", pattern)
+			}
+		}
+	}
+	return nil
+}
+
+// ResolveIncludedPaths returns the paths, relative to volumePath, that match
+// the given glob patterns of the paths to back up, or "." for the whole volume
+// if there are no patterns. It returns an error if no paths match.
+func ResolveIncludedPaths(volumePath string, patterns []string) ([]string, error) {
+	if len(patterns) == 0 {
+		return []string{"."}, nil
+	}
+
+	seen := make(map[string]struct{})
+	var paths []string
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(filepath.Join(volumePath, strings.TrimPrefix(pattern, "/")))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid path pattern %q", pattern)
+		}
+
+		for _, match := range matches {
+			path, err := filepath.Rel(volumePath, match)
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			if _, ok := seen[path]; !ok {
+				seen[path] = struct{}{}
+				paths = append(paths, path)
+			}
+		}
+	}
+
+	if len(paths) == 0 {
+		return nil, errors.Errorf("no paths in the volume match the included paths %s", strings.Join(patterns, ","))
+	}
+
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// ExcludeFlags returns the restic flags that exclude the paths matching the
+// given patterns from a backup of volumePath. restic matches patterns starting
+// with "/" against absolute paths, so they're made relative to the volume's
+// root.
+func ExcludeFlags(volumePath string, patterns []string) []string {
+	var flags []string
+	for _, pattern := range patterns {
+		if strings.HasPrefix(pattern, "/") {
+			pattern = filepath.Join(volumePath, pattern)
+		}
+		flags = append(flags, fmt.Sprintf("--exclude=%s", pattern))
+	}
+	return flags
+}
+
+// IncludeFlags returns the restic flags that restrict a restore to the paths
+// matching the given patterns, which are relative to the volume's root.
+func IncludeFlags(patterns []string) []string {
+	var flags []string
+	for _, pattern := range patterns {
+		flags = append(flags, fmt.Sprintf("--include=%s", filepath.Join("/", pattern)))
+	}
+	return flags
+}
@@ -0,0 +1,119 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestGetPodVolumePathFilters(t *testing.T) {
+	tests := []struct {
+		name         string
+		filters      []velerov1api.PodVolumePathFilter
+		annotations  []string
+		wantIncluded []string
+		wantExcluded []string
+	}{
+		{
+			name: "no filters or annotations",
+		},
+		{
+			name: "backup filters for all volumes and for the volume are combined",
+			filters: []velerov1api.PodVolumePathFilter{
+				{ExcludedPaths: []string{"cache"}},
+				{Volumes: []string{"data"}, IncludedPaths: []string{"db"}, ExcludedPaths: []string{"/db/tmp"}},
+				{Volumes: []string{"logs"}, IncludedPaths: []string{"app"}},
+			},
+			wantIncluded: []string{"db"},
+			wantExcluded: []string{"cache", "/db/tmp"},
+		},
+		{
+			name: "annotations override the backup's included paths and add to its excluded paths",
+			filters: []velerov1api.PodVolumePathFilter{
+				{IncludedPaths: []string{"db"}, ExcludedPaths: []string{"cache"}},
+			},
+			annotations:  []string{IncludedPathsAnnotationPrefix + "data", "uploads, media", ExcludedPathsAnnotationPrefix + "data", "*.tmp,"},
+			wantIncluded: []string{"uploads", "media"},
+			wantExcluded: []string{"cache", "*.tmp"},
+		},
+		{
+			name:         "annotations for other volumes are ignored",
+			annotations:  []string{IncludedPathsAnnotationPrefix + "logs", "app", ExcludedPathsAnnotationPrefix + "logs", "old"},
+			wantIncluded: nil,
+			wantExcluded: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backup := builder.ForBackup("velero", "backup-1").PodVolumePathFilters(test.filters...).Result()
+			pod := builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithAnnotations(test.annotations...)).Result()
+
+			included, excluded := GetPodVolumePathFilters(backup, pod, "data")
+			assert.Equal(t, test.wantIncluded, included)
+			assert.Equal(t, test.wantExcluded, excluded)
+		})
+	}
+}
+
+func TestValidatePathPatterns(t *testing.T) {
+	assert.NoError(t, ValidatePathPatterns(nil))
+	assert.NoError(t, ValidatePathPatterns([]string{"cache", "/db/*.tmp", "**/node_modules"}))
+	assert.EqualError(t, ValidatePathPatterns([]string{" "}), "path patterns must not be empty")
+	assert.Error(t, ValidatePathPatterns([]string{"[cache"}))
+	assert.EqualError(t, ValidatePathPatterns([]string{"data/../../etc"}), `path pattern "data/../../etc" must not refer to a parent directory`)
+}
+
+func TestResolveIncludedPaths(t *testing.T) {
+	volumePath, err := ioutil.TempDir("", "volume")
+	require.NoError(t, err)
+	defer os.RemoveAll(volumePath)
+
+	for _, dir := range []string{"db", "logs/app", "logs/web"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(volumePath, dir), 0755))
+	}
+
+	paths, err := ResolveIncludedPaths(volumePath, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"."}, paths)
+
+	paths, err = ResolveIncludedPaths(volumePath, []string{"/logs/*", "db", "logs/app", "nonexistent"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"db", "logs/app", "logs/web"}, paths)
+
+	_, err = ResolveIncludedPaths(volumePath, []string{"nonexistent"})
+	assert.EqualError(t, err, "no paths in the volume match the included paths nonexistent")
+}
+
+func TestExcludeFlags(t *testing.T) {
+	assert.Nil(t, ExcludeFlags("/volume", nil))
+	assert.Equal(t, []string{"--exclude=cache", "--exclude=/volume/db/tmp"}, ExcludeFlags("/volume", []string{"cache", "/db/tmp"}))
+}
+
+func TestIncludeFlags(t *testing.T) {
+	assert.Nil(t, IncludeFlags(nil))
+	assert.Equal(t, []string{"--include=/db", "--include=/logs/*.log"}, IncludeFlags([]string{"db", "/logs/*.log"}))
+}
@@ -0,0 +1,100 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"os/exec"
+	"runtime"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// these values are from linux/ioprio.h.
+const (
+	ioprioClassShift      = 13
+	ioprioClassBestEffort = 2
+	ioprioClassIdle       = 3
+	ioprioWhoProcess      = 1
+
+	// ioprioBestEffortLevel is the default priority level within the
+	// best-effort class.
+	ioprioBestEffortLevel = 4
+)
+
+// startWithPriority starts cmd with the given CPU and I/O scheduling priority.
+// On Linux both are per-thread attributes that a process inherits from the
+// thread that forks it, and its threads from the thread that creates them, so
+// they're set on a locked thread that then starts the process. The thread is
+// never unlocked, so it exits with its goroutine instead of running other
+// goroutines with a lower priority, which it couldn't raise back. Failing to set
+// the priority isn't fatal since the command can still complete, so errors
+// doing so are only logged.
+func startWithPriority(cmd *exec.Cmd, nice int, ioClass velerov1api.IOPriorityClass, log logrus.FieldLogger) error {
+	errs := make(chan error, 1)
+	go func() {
+		runtime.LockOSThread()
+
+		if syscall.Gettid() == syscall.Getpid() {
+			// the main thread doesn't exit with its goroutine, so it's kept
+			// locked, and unchanged, while another thread starts the process.
+			errs <- startWithPriority(cmd, nice, ioClass, log)
+			runtime.UnlockOSThread()
+			return
+		}
+
+		if err := setThreadPriority(syscall.Gettid(), nice, ioClass); err != nil {
+			log.WithError(err).Warn("Error setting scheduling priority of restic process")
+		}
+		errs <- cmd.Start()
+	}()
+	return <-errs
+}
+
+// setThreadPriority sets the CPU and I/O scheduling priority of the given
+// thread.
+func setThreadPriority(tid int, nice int, ioClass velerov1api.IOPriorityClass) error {
+	var ioprio int
+	switch ioClass {
+	case "":
+	case velerov1api.IOPriorityClassBestEffort:
+		ioprio = ioprioClassBestEffort<<ioprioClassShift | ioprioBestEffortLevel
+	case velerov1api.IOPriorityClassIdle:
+		ioprio = ioprioClassIdle << ioprioClassShift
+	default:
+		return errors.Errorf("unsupported I/O priority class %q", ioClass)
+	}
+
+	if nice != 0 {
+		if err := syscall.Setpriority(syscall.PRIO_PROCESS, tid, nice); err != nil {
+			return errors.Wrap(err, "error setting nice value")
+		}
+	}
+	if ioprio != 0 {
+		if _, _, errno := syscall.Syscall(syscall.SYS_IOPRIO_SET, ioprioWhoProcess, uintptr(tid), uintptr(ioprio)); errno != 0 {
+			return errors.Wrap(errno, "error setting I/O priority")
+		}
+	}
+
+	return nil
+}
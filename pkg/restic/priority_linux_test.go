@@ -0,0 +1,78 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// niceValue returns the nice value of the given thread or process.
+func niceValue(t *testing.T, path string) int {
+	stat, err := ioutil.ReadFile(path + "/stat")
+	require.NoError(t, err)
+
+	// the fields after the command name, which is in parentheses, start with
+	// the state, the third field, and the nice value is the 19th.
+	fields := strings.Fields(string(stat[strings.LastIndex(string(stat), ")")+1:]))
+	nice, err := strconv.Atoi(fields[19-3])
+	require.NoError(t, err)
+	return nice
+}
+
+func TestStartWithPriority(t *testing.T) {
+	cmd := exec.Command("sleep", "10")
+	require.NoError(t, startWithPriority(cmd, 5, velerov1api.IOPriorityClassIdle, velerotest.NewLogger()))
+	defer func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	}()
+
+	assert.Equal(t, 5, niceValue(t, fmt.Sprintf("/proc/%d", cmd.Process.Pid)))
+
+	ioprio, _, errno := syscall.Syscall(syscall.SYS_IOPRIO_GET, ioprioWhoProcess, uintptr(cmd.Process.Pid), 0)
+	require.Zero(t, errno)
+	assert.Equal(t, ioprioClassIdle, int(ioprio)>>ioprioClassShift)
+
+	// the thread that started the process exits, so none of Velero's threads
+	// keep the lower priority.
+	assert.Eventually(t, func() bool {
+		tasks, err := ioutil.ReadDir("/proc/self/task")
+		require.NoError(t, err)
+		for _, task := range tasks {
+			if niceValue(t, "/proc/self/task/"+task.Name()) != 0 {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+}
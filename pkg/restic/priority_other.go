@@ -0,0 +1,35 @@
+//go:build !linux
+// +build !linux
+
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"os/exec"
+
+	"github.com/sirupsen/logrus"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// startWithPriority starts cmd without changing its scheduling priority, which
+// is only supported on Linux, where the restic daemonset runs.
+func startWithPriority(cmd *exec.Cmd, nice int, ioClass velerov1api.IOPriorityClass, log logrus.FieldLogger) error {
+	log.Warn("Setting the scheduling priority of restic processes is only supported on linux")
+	return cmd.Start()
+}
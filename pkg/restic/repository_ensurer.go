@@ -41,6 +41,11 @@ type repositoryEnsurer struct {
 	repoLister velerov1listers.ResticRepositoryLister
 	repoClient velerov1client.ResticRepositoriesGetter
 
+	// perNamespaceKeys indicates whether new repositories get a key of
+	// their own, shared only with the other repositories for the same
+	// volume namespace.
+	perNamespaceKeys bool
+
 	repoChansLock sync.Mutex
 	repoChans     map[string]chan *velerov1api.ResticRepository
 
@@ -55,13 +60,14 @@ type repoKey struct {
 	backupLocation  string
 }
 
-func newRepositoryEnsurer(repoInformer velerov1informers.ResticRepositoryInformer, repoClient velerov1client.ResticRepositoriesGetter, log logrus.FieldLogger) *repositoryEnsurer {
+func newRepositoryEnsurer(repoInformer velerov1informers.ResticRepositoryInformer, repoClient velerov1client.ResticRepositoriesGetter, perNamespaceKeys bool, log logrus.FieldLogger) *repositoryEnsurer {
 	r := &repositoryEnsurer{
-		log:        log,
-		repoLister: repoInformer.Lister(),
-		repoClient: repoClient,
-		repoChans:  make(map[string]chan *velerov1api.ResticRepository),
-		repoLocks:  make(map[repoKey]*sync.Mutex),
+		log:              log,
+		repoLister:       repoInformer.Lister(),
+		repoClient:       repoClient,
+		perNamespaceKeys: perNamespaceKeys,
+		repoChans:        make(map[string]chan *velerov1api.ResticRepository),
+		repoLocks:        make(map[repoKey]*sync.Mutex),
 	}
 
 	repoInformer.Informer().AddEventHandler(
@@ -163,6 +169,9 @@ func (r *repositoryEnsurer) EnsureRepo(ctx context.Context, namespace, volumeNam
 			MaintenanceFrequency:  metav1.Duration{Duration: DefaultMaintenanceFrequency},
 		},
 	}
+	if r.perNamespaceKeys {
+		repo.Spec.RepositoryKeySecret = RepositoryKeySecretName(volumeNamespace)
+	}
 
 	repoChan := r.getRepoChan(selector.String())
 	defer func() {
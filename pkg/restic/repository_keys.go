@@ -18,6 +18,10 @@ package restic
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	corev1api "k8s.io/api/core/v1"
@@ -25,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 )
 
@@ -32,11 +37,40 @@ const (
 	credentialsSecretName = "velero-restic-credentials"
 	credentialsKey        = "repository-password"
 
+	// newCredentialsKey holds the new password while the key is rotated.
+	newCredentialsKey = "new-repository-password"
+
 	encryptionKey = "static-passw0rd"
+
+	// repoNotFoundMessage is part of restic's error when there's no
+	// repository at the given location.
+	repoNotFoundMessage = "Is there a repository at the following location?"
+
+	// wrongPasswordMessage is part of restic's error when none of the
+	// repository's keys can be opened with the given password.
+	wrongPasswordMessage = "wrong password or no key found"
+
+	// generatedPasswordBytes is the number of random bytes in a generated
+	// repository password.
+	generatedPasswordBytes = 32
 )
 
 func EnsureCommonRepositoryKey(secretClient corev1client.SecretsGetter, namespace string) error {
-	_, err := secretClient.Secrets(namespace).Get(context.TODO(), credentialsSecretName, metav1.GetOptions{})
+	return ensureRepositoryKey(secretClient, namespace, credentialsSecretName, []byte(encryptionKey))
+}
+
+// EnsureRepositoryKey creates the named repository key secret with a randomly
+// generated password if it doesn't already exist.
+func EnsureRepositoryKey(secretClient corev1client.SecretsGetter, namespace, name string) error {
+	password, err := GenerateRepositoryPassword()
+	if err != nil {
+		return err
+	}
+	return ensureRepositoryKey(secretClient, namespace, name, []byte(password))
+}
+
+func ensureRepositoryKey(secretClient corev1client.SecretsGetter, namespace, name string, password []byte) error {
+	_, err := secretClient.Secrets(namespace).Get(context.TODO(), name, metav1.GetOptions{})
 	if err != nil && !apierrors.IsNotFound(err) {
 		return errors.WithStack(err)
 	}
@@ -49,27 +83,132 @@ func EnsureCommonRepositoryKey(secretClient corev1client.SecretsGetter, namespac
 	secret := &corev1api.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: namespace,
-			Name:      credentialsSecretName,
+			Name:      name,
+			Labels: map[string]string{
+				velerov1api.ResticRepositoryKeyLabel: "true",
+			},
 		},
 		Type: corev1api.SecretTypeOpaque,
 		Data: map[string][]byte{
-			credentialsKey: []byte(encryptionKey),
+			credentialsKey: password,
 		},
 	}
 
 	if _, err = secretClient.Secrets(namespace).Create(context.TODO(), secret, metav1.CreateOptions{}); err != nil {
-		return errors.Wrapf(err, "error creating %s secret", credentialsSecretName)
+		return errors.Wrapf(err, "error creating %s secret", name)
 	}
 
 	return nil
 }
 
+// checkRepoKeyProbe checks the result of opening a repository whose key secret
+// is missing with a newly generated password. It returns nil if the repository
+// doesn't exist yet, so the secret can be created with that password, and an
+// error otherwise.
+func checkRepoKeyProbe(keySecret, stderr string, err error) error {
+	switch {
+	case err == nil || strings.Contains(stderr, wrongPasswordMessage):
+		return errors.Errorf("restic repository exists but its key secret %s is missing, restore the secret from a backup of it", keySecret)
+	case strings.Contains(stderr, repoNotFoundMessage):
+		return nil
+	default:
+		return errors.Wrapf(err, "error checking whether restic repository with missing key secret %s exists", keySecret)
+	}
+}
+
+// GenerateRepositoryPassword returns a new random repository password.
+func GenerateRepositoryPassword() (string, error) {
+	b := make([]byte, generatedPasswordBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "error generating repository password")
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// RepositoryKeySecretName returns the name of the secret holding the key of the
+// repositories for the given volume namespace when per-namespace keys are used.
+func RepositoryKeySecretName(volumeNamespace string) string {
+	return credentialsSecretName + "-" + volumeNamespace
+}
+
+// KeySecretName returns the name of the secret holding the key of a repository
+// whose key secret is keySecret. If it's empty, that's the secret holding the key
+// shared by all repositories.
+func KeySecretName(keySecret string) string {
+	if keySecret == "" {
+		return credentialsSecretName
+	}
+	return keySecret
+}
+
 // RepoKeySelector returns the SecretKeySelector which can be used to fetch
-// the restic repository key.
-func RepoKeySelector() *corev1api.SecretKeySelector {
-	// For now, all restic repos share the same key so we don't need the repoName to fetch it.
-	// When we move to full-backup encryption, we'll likely have a separate key per restic repo
-	// (all within the Velero server's namespace) so RepoKeySelector will need to select the key
-	// for that repo.
-	return builder.ForSecretKeySelector(credentialsSecretName, credentialsKey).Result()
+// the key of a restic repository that uses the given key secret. If keySecret
+// is empty, the key shared by all repositories is selected.
+func RepoKeySelector(keySecret string) *corev1api.SecretKeySelector {
+	return builder.ForSecretKeySelector(KeySecretName(keySecret), credentialsKey).Result()
+}
+
+// NewRepoKeySelector returns the SecretKeySelector which can be used to fetch
+// the new key of a restic repository while its key is rotated.
+func NewRepoKeySelector(keySecret string) *corev1api.SecretKeySelector {
+	return builder.ForSecretKeySelector(KeySecretName(keySecret), newCredentialsKey).Result()
+}
+
+// SetNewRepositoryKey starts a rotation of the key in the given secret by adding
+// a new random password to it. The rotation is identified by rotationID.
+func SetNewRepositoryKey(secret *corev1api.Secret, rotationID string) error {
+	if _, ok := secret.Data[newCredentialsKey]; ok {
+		return errors.Errorf("a rotation of the key in secret %s is already in progress", secret.Name)
+	}
+
+	password, err := GenerateRepositoryPassword()
+	if err != nil {
+		return err
+	}
+
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data[newCredentialsKey] = []byte(password)
+
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[velerov1api.ResticRepositoryKeyRotationAnnotation] = rotationID
+
+	return nil
+}
+
+// KeyRotationPending returns the ID of the rotation of the key in the given
+// secret, and whether the new key is still waiting to be added to all
+// repositories.
+func KeyRotationPending(secret *corev1api.Secret) (string, bool) {
+	_, pending := secret.Data[newCredentialsKey]
+	return secret.Annotations[velerov1api.ResticRepositoryKeyRotationAnnotation], pending
+}
+
+// CompleteKeyRotation makes the new key in the given secret the current one.
+func CompleteKeyRotation(secret *corev1api.Secret) {
+	secret.Data[credentialsKey] = secret.Data[newCredentialsKey]
+	delete(secret.Data, newCredentialsKey)
+}
+
+// KeyRotationStartTime returns when the rotation with the given ID was started.
+func KeyRotationStartTime(rotationID string) (time.Time, error) {
+	startTime, err := time.Parse(time.RFC3339, rotationID)
+	return startTime, errors.Wrapf(err, "error parsing start time of key rotation %s", rotationID)
+}
+
+// AbortKeyRotation discards the new key in the given secret, so that the
+// current key stays in use, and records that its rotation was aborted.
+func AbortKeyRotation(secret *corev1api.Secret) {
+	delete(secret.Data, newCredentialsKey)
+	secret.Annotations[velerov1api.ResticRepositoryKeyRotationAbortedAnnotation] = secret.Annotations[velerov1api.ResticRepositoryKeyRotationAnnotation]
+}
+
+// KeyRotationAborted returns whether the latest rotation of the key in the
+// given secret was aborted.
+func KeyRotationAborted(secret *corev1api.Secret) bool {
+	rotationID := secret.Annotations[velerov1api.ResticRepositoryKeyRotationAnnotation]
+	return rotationID != "" && secret.Annotations[velerov1api.ResticRepositoryKeyRotationAbortedAnnotation] == rotationID
 }
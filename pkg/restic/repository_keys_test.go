@@ -19,12 +19,114 @@ package restic
 import (
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
 )
 
 func TestRepoKeySelector(t *testing.T) {
-	selector := RepoKeySelector()
+	selector := RepoKeySelector("")
 
 	require.Equal(t, credentialsSecretName, selector.Name)
 	require.Equal(t, credentialsKey, selector.Key)
+
+	selector = RepoKeySelector(RepositoryKeySecretName("ns-1"))
+
+	require.Equal(t, "velero-restic-credentials-ns-1", selector.Name)
+	require.Equal(t, credentialsKey, selector.Key)
+
+	selector = NewRepoKeySelector("")
+
+	require.Equal(t, credentialsSecretName, selector.Name)
+	require.Equal(t, newCredentialsKey, selector.Key)
+}
+
+func TestKeyRotation(t *testing.T) {
+	secret := &corev1api.Secret{
+		Data: map[string][]byte{credentialsKey: []byte("old")},
+	}
+
+	_, pending := KeyRotationPending(secret)
+	require.False(t, pending)
+
+	require.NoError(t, SetNewRepositoryKey(secret, "rotation-1"))
+	id, pending := KeyRotationPending(secret)
+	require.True(t, pending)
+	require.Equal(t, "rotation-1", id)
+	newPassword := secret.Data[newCredentialsKey]
+	require.Len(t, newPassword, 2*generatedPasswordBytes)
+
+	// a rotation can't be started while one is in progress
+	require.Error(t, SetNewRepositoryKey(secret, "rotation-2"))
+
+	CompleteKeyRotation(secret)
+	id, pending = KeyRotationPending(secret)
+	require.False(t, pending)
+	require.Equal(t, "rotation-1", id)
+	require.Equal(t, newPassword, secret.Data[credentialsKey])
+}
+
+func TestAbortKeyRotation(t *testing.T) {
+	secret := &corev1api.Secret{
+		Data: map[string][]byte{credentialsKey: []byte("old")},
+	}
+
+	require.NoError(t, SetNewRepositoryKey(secret, "2021-11-01T10:00:00Z"))
+	require.False(t, KeyRotationAborted(secret))
+
+	AbortKeyRotation(secret)
+	id, pending := KeyRotationPending(secret)
+	require.False(t, pending)
+	require.Equal(t, "2021-11-01T10:00:00Z", id)
+	require.True(t, KeyRotationAborted(secret))
+	require.Equal(t, []byte("old"), secret.Data[credentialsKey])
+
+	// a new rotation can be started after one was aborted
+	require.NoError(t, SetNewRepositoryKey(secret, "2021-11-02T10:00:00Z"))
+	require.False(t, KeyRotationAborted(secret))
+
+	startTime, err := KeyRotationStartTime("2021-11-02T10:00:00Z")
+	require.NoError(t, err)
+	require.Equal(t, 2, startTime.Day())
+
+	_, err = KeyRotationStartTime("rotation-1")
+	require.Error(t, err)
+}
+
+func TestCheckRepoKeyProbe(t *testing.T) {
+	tests := []struct {
+		name      string
+		stderr    string
+		err       error
+		expectErr bool
+	}{
+		{
+			name:   "repository doesn't exist",
+			stderr: "Fatal: unable to open config file: Stat: The specified key does not exist.\nIs there a repository at the following location?",
+			err:    errors.New("exit status 1"),
+		},
+		{
+			name:      "repository exists",
+			stderr:    "Fatal: wrong password or no key found",
+			err:       errors.New("exit status 1"),
+			expectErr: true,
+		},
+		{
+			name:      "repository can't be reached",
+			stderr:    "Fatal: unable to open repository: connection refused",
+			err:       errors.New("exit status 1"),
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkRepoKeyProbe("velero-restic-credentials-ns-1", test.stderr, test.err)
+			if test.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
 }
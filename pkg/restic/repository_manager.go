@@ -20,9 +20,11 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/cache"
@@ -56,6 +58,25 @@ type RepositoryManager interface {
 	// UnlockRepo removes stale locks from a repo.
 	UnlockRepo(repo *velerov1api.ResticRepository) error
 
+	// RemoveAllLocks removes all locks from a repo, including ones
+	// that aren't stale.
+	RemoveAllLocks(repo *velerov1api.ResticRepository) error
+
+	// CheckRepo checks the integrity of a repo. It returns the errors
+	// found by the check, or an error if the check couldn't be run.
+	CheckRepo(repo *velerov1api.ResticRepository) ([]string, error)
+
+	// GetRepoStats returns statistics about the contents of a repo.
+	GetRepoStats(repo *velerov1api.ResticRepository) (*velerov1api.ResticRepositoryStats, error)
+
+	// AddRepoKey adds the new key of a rotation of the repo's key secret
+	// to the repo. It returns the ID of the key currently used to open the
+	// repo, and the ID of the added key.
+	AddRepoKey(repo *velerov1api.ResticRepository) (string, string, error)
+
+	// RemoveRepoKey removes the key with the given ID from a repo.
+	RemoveRepoKey(repo *velerov1api.ResticRepository, keyID string) error
+
 	// Forget removes a snapshot from the list of
 	// available snapshots in a repo.
 	Forget(context.Context, SnapshotIdentifier) error
@@ -92,6 +113,7 @@ type repositoryManager struct {
 	ctx                  context.Context
 	pvcClient            corev1client.PersistentVolumeClaimsGetter
 	pvClient             corev1client.PersistentVolumesGetter
+	secretClient         corev1client.SecretsGetter
 	credentialsFileStore credentials.FileStore
 }
 
@@ -105,7 +127,9 @@ func NewRepositoryManager(
 	kbClient kbclient.Client,
 	pvcClient corev1client.PersistentVolumeClaimsGetter,
 	pvClient corev1client.PersistentVolumesGetter,
+	secretClient corev1client.SecretsGetter,
 	credentialFileStore credentials.FileStore,
+	perNamespaceKeys bool,
 	log logrus.FieldLogger,
 ) (RepositoryManager, error) {
 	rm := &repositoryManager{
@@ -116,12 +140,13 @@ func NewRepositoryManager(
 		kbClient:             kbClient,
 		pvcClient:            pvcClient,
 		pvClient:             pvClient,
+		secretClient:         secretClient,
 		credentialsFileStore: credentialFileStore,
 		log:                  log,
 		ctx:                  ctx,
 
 		repoLocker:  newRepoLocker(),
-		repoEnsurer: newRepositoryEnsurer(repoInformer, repoClient, log),
+		repoEnsurer: newRepositoryEnsurer(repoInformer, repoClient, perNamespaceKeys, log),
 		fileSystem:  filesystem.NewFileSystem(),
 	}
 
@@ -175,7 +200,13 @@ func (rm *repositoryManager) InitRepo(repo *velerov1api.ResticRepository) error
 	rm.repoLocker.LockExclusive(repo.Name)
 	defer rm.repoLocker.UnlockExclusive(repo.Name)
 
-	return rm.exec(InitCommand(repo.Spec.ResticIdentifier), repo.Spec.BackupStorageLocation)
+	if repo.Spec.RepositoryKeySecret != "" {
+		if err := EnsureRepositoryKey(rm.secretClient, rm.namespace, repo.Spec.RepositoryKeySecret); err != nil {
+			return err
+		}
+	}
+
+	return rm.exec(InitCommand(repo.Spec.ResticIdentifier), repo)
 }
 
 func (rm *repositoryManager) ConnectToRepo(repo *velerov1api.ResticRepository) error {
@@ -189,7 +220,69 @@ func (rm *repositoryManager) ConnectToRepo(repo *velerov1api.ResticRepository) e
 	// to.
 	snapshotsCmd.ExtraFlags = append(snapshotsCmd.ExtraFlags, "--last")
 
-	return rm.exec(snapshotsCmd, repo.Spec.BackupStorageLocation)
+	if repo.Spec.RepositoryKeySecret != "" {
+		if err := rm.ensureRepoKeySecret(snapshotsCmd, repo); err != nil {
+			return err
+		}
+	}
+
+	return rm.exec(snapshotsCmd, repo)
+}
+
+// ensureRepoKeySecret creates the key secret of a repo that doesn't exist yet
+// if the secret is missing. If the repo already exists, its key can't be
+// generated again, so an error asking to restore the secret is returned rather
+// than a new key that can't open the repo.
+func (rm *repositoryManager) ensureRepoKeySecret(cmd *Command, repo *velerov1api.ResticRepository) error {
+	_, err := rm.secretClient.Secrets(rm.namespace).Get(context.TODO(), repo.Spec.RepositoryKeySecret, metav1.GetOptions{})
+	if err == nil {
+		return nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return errors.WithStack(err)
+	}
+
+	// probe the repo with the password the secret would be created with: it
+	// either doesn't exist, or the password is rejected.
+	password, err := GenerateRepositoryPassword()
+	if err != nil {
+		return err
+	}
+	passwordFile, err := rm.tempPasswordFile(repo.Name, password)
+	if err != nil {
+		return err
+	}
+	// ignore error since there's nothing we can do and it's a temp file.
+	defer os.Remove(passwordFile)
+
+	_, stderr, err := rm.execWithPasswordFile(cmd, repo, passwordFile)
+	if err := checkRepoKeyProbe(repo.Spec.RepositoryKeySecret, stderr, err); err != nil {
+		return err
+	}
+
+	return ensureRepositoryKey(rm.secretClient, rm.namespace, repo.Spec.RepositoryKeySecret, []byte(password))
+}
+
+func (rm *repositoryManager) tempPasswordFile(repoName, password string) (string, error) {
+	file, err := rm.fileSystem.TempFile("", fmt.Sprintf("password-%s", repoName))
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	if _, err := file.Write([]byte(password)); err != nil {
+		// nothing we can do about an error closing the file here, and we're
+		// already returning an error about the write failing.
+		file.Close()
+		return "", errors.WithStack(err)
+	}
+
+	name := file.Name()
+
+	if err := file.Close(); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return name, nil
 }
 
 func (rm *repositoryManager) PruneRepo(repo *velerov1api.ResticRepository) error {
@@ -197,7 +290,7 @@ func (rm *repositoryManager) PruneRepo(repo *velerov1api.ResticRepository) error
 	rm.repoLocker.LockExclusive(repo.Name)
 	defer rm.repoLocker.UnlockExclusive(repo.Name)
 
-	return rm.exec(PruneCommand(repo.Spec.ResticIdentifier), repo.Spec.BackupStorageLocation)
+	return rm.exec(PruneCommand(repo.Spec.ResticIdentifier), repo)
 }
 
 func (rm *repositoryManager) UnlockRepo(repo *velerov1api.ResticRepository) error {
@@ -205,7 +298,92 @@ func (rm *repositoryManager) UnlockRepo(repo *velerov1api.ResticRepository) erro
 	rm.repoLocker.Lock(repo.Name)
 	defer rm.repoLocker.Unlock(repo.Name)
 
-	return rm.exec(UnlockCommand(repo.Spec.ResticIdentifier), repo.Spec.BackupStorageLocation)
+	return rm.exec(UnlockCommand(repo.Spec.ResticIdentifier), repo)
+}
+
+func (rm *repositoryManager) RemoveAllLocks(repo *velerov1api.ResticRepository) error {
+	// restic unlock requires a non-exclusive lock
+	rm.repoLocker.Lock(repo.Name)
+	defer rm.repoLocker.Unlock(repo.Name)
+
+	return rm.exec(UnlockAllCommand(repo.Spec.ResticIdentifier), repo)
+}
+
+func (rm *repositoryManager) CheckRepo(repo *velerov1api.ResticRepository) ([]string, error) {
+	// restic check requires an exclusive lock
+	rm.repoLocker.LockExclusive(repo.Name)
+	defer rm.repoLocker.UnlockExclusive(repo.Name)
+
+	_, stderr, err := rm.execWithOutput(CheckCommand(repo.Spec.ResticIdentifier, repo.Spec.CheckReadDataSubset), repo)
+	if err != nil && strings.Contains(stderr, checkFailedMessage) {
+		return getCheckErrors(stderr), nil
+	}
+	return nil, err
+}
+
+func (rm *repositoryManager) GetRepoStats(repo *velerov1api.ResticRepository) (*velerov1api.ResticRepositoryStats, error) {
+	// restic stats and snapshots require a non-exclusive lock
+	rm.repoLocker.Lock(repo.Name)
+	defer rm.repoLocker.Unlock(repo.Name)
+
+	statsOut, _, err := rm.execWithOutput(RepoStatsCommand(repo.Spec.ResticIdentifier), repo)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotsCmd := SnapshotsCommand(repo.Spec.ResticIdentifier)
+	snapshotsCmd.ExtraFlags = append(snapshotsCmd.ExtraFlags, "--json")
+	snapshotsOut, _, err := rm.execWithOutput(snapshotsCmd, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeRepoStats(statsOut, snapshotsOut)
+}
+
+func (rm *repositoryManager) AddRepoKey(repo *velerov1api.ResticRepository) (string, string, error) {
+	// restic key list and add require a non-exclusive lock
+	rm.repoLocker.Lock(repo.Name)
+	defer rm.repoLocker.Unlock(repo.Name)
+
+	keysOut, _, err := rm.execWithOutput(KeyListCommand(repo.Spec.ResticIdentifier), repo)
+	if err != nil {
+		return "", "", err
+	}
+	currentKeyID, err := getCurrentKeyID(keysOut)
+	if err != nil {
+		return "", "", err
+	}
+
+	newPasswordFile, err := rm.credentialsFileStore.Path(NewRepoKeySelector(repo.Spec.RepositoryKeySecret))
+	if err != nil {
+		return "", "", err
+	}
+	// ignore error since there's nothing we can do and it's a temp file.
+	defer os.Remove(newPasswordFile)
+
+	if err := rm.exec(KeyAddCommand(repo.Spec.ResticIdentifier, newPasswordFile), repo); err != nil {
+		return "", "", err
+	}
+
+	newKeysOut, _, err := rm.execWithOutput(KeyListCommand(repo.Spec.ResticIdentifier), repo)
+	if err != nil {
+		return "", "", err
+	}
+	newKeyID, err := getAddedKeyID(keysOut, newKeysOut)
+	if err != nil {
+		return "", "", err
+	}
+
+	return currentKeyID, newKeyID, nil
+}
+
+func (rm *repositoryManager) RemoveRepoKey(repo *velerov1api.ResticRepository, keyID string) error {
+	// restic key remove requires an exclusive lock
+	rm.repoLocker.LockExclusive(repo.Name)
+	defer rm.repoLocker.UnlockExclusive(repo.Name)
+
+	return rm.exec(KeyRemoveCommand(repo.Spec.ResticIdentifier, keyID), repo)
 }
 
 func (rm *repositoryManager) Forget(ctx context.Context, snapshot SnapshotIdentifier) error {
@@ -226,33 +404,49 @@ func (rm *repositoryManager) Forget(ctx context.Context, snapshot SnapshotIdenti
 	rm.repoLocker.LockExclusive(repo.Name)
 	defer rm.repoLocker.UnlockExclusive(repo.Name)
 
-	return rm.exec(ForgetCommand(repo.Spec.ResticIdentifier, snapshot.SnapshotID), repo.Spec.BackupStorageLocation)
+	return rm.exec(ForgetCommand(repo.Spec.ResticIdentifier, snapshot.SnapshotID), repo)
+}
+
+func (rm *repositoryManager) exec(cmd *Command, repo *velerov1api.ResticRepository) error {
+	_, _, err := rm.execWithOutput(cmd, repo)
+	return err
 }
 
-func (rm *repositoryManager) exec(cmd *Command, backupLocation string) error {
-	file, err := rm.credentialsFileStore.Path(RepoKeySelector())
+// execWithOutput runs the command against the repository and returns its
+// stdout and stderr.
+func (rm *repositoryManager) execWithOutput(cmd *Command, repo *velerov1api.ResticRepository) (string, string, error) {
+	file, err := rm.credentialsFileStore.Path(RepoKeySelector(repo.Spec.RepositoryKeySecret))
 	if err != nil {
-		return err
+		return "", "", err
 	}
 	// ignore error since there's nothing we can do and it's a temp file.
 	defer os.Remove(file)
 
-	cmd.PasswordFile = file
+	return rm.execWithPasswordFile(cmd, repo, file)
+}
+
+// execWithPasswordFile runs the command against the repository, opening it
+// with the password in the given file, and returns its stdout and stderr.
+func (rm *repositoryManager) execWithPasswordFile(cmd *Command, repo *velerov1api.ResticRepository, passwordFile string) (string, string, error) {
+	backupLocation := repo.Spec.BackupStorageLocation
+
+	cmd.PasswordFile = passwordFile
 
 	loc := &velerov1api.BackupStorageLocation{}
 	if err := rm.kbClient.Get(context.Background(), kbclient.ObjectKey{
 		Namespace: rm.namespace,
 		Name:      backupLocation,
 	}, loc); err != nil {
-		return errors.Wrap(err, "error getting backup storage location")
+		return "", "", errors.Wrap(err, "error getting backup storage location")
 	}
 
 	// if there's a caCert on the ObjectStorage, write it to disk so that it can be passed to restic
 	var caCertFile string
 	if loc.Spec.ObjectStorage != nil && loc.Spec.ObjectStorage.CACert != nil {
+		var err error
 		caCertFile, err = TempCACertFile(loc.Spec.ObjectStorage.CACert, backupLocation, rm.fileSystem)
 		if err != nil {
-			return errors.Wrap(err, "error creating temp cacert file")
+			return "", "", errors.Wrap(err, "error creating temp cacert file")
 		}
 		// ignore error since there's nothing we can do and it's a temp file.
 		defer os.Remove(caCertFile)
@@ -261,7 +455,7 @@ func (rm *repositoryManager) exec(cmd *Command, backupLocation string) error {
 
 	env, err := CmdEnv(loc, rm.credentialsFileStore)
 	if err != nil {
-		return err
+		return "", "", err
 	}
 	cmd.Env = env
 
@@ -273,8 +467,8 @@ func (rm *repositoryManager) exec(cmd *Command, backupLocation string) error {
 		"stderr":     stderr,
 	}).Debugf("Ran restic command")
 	if err != nil {
-		return errors.Wrapf(err, "error running command=%s, stdout=%s, stderr=%s", cmd.String(), stdout, stderr)
+		return stdout, stderr, errors.Wrapf(err, "error running command=%s, stdout=%s, stderr=%s", cmd.String(), stdout, stderr)
 	}
 
-	return nil
+	return stdout, stderr, nil
 }
@@ -37,6 +37,11 @@ type RestoreData struct {
 	Pod                             *corev1api.Pod
 	PodVolumeBackups                []*velerov1api.PodVolumeBackup
 	SourceNamespace, BackupLocation string
+
+	// HelperPod, if set, is the restore helper pod that the volumes of Pod
+	// backed by persistent volume claims are restored into before Pod is
+	// created. Only those volumes are restored.
+	HelperPod *corev1api.Pod
 }
 
 // Restorer can execute restic restores of volumes in a pod.
@@ -97,10 +102,20 @@ func newRestorer(
 
 func (r *restorer) RestorePodVolumes(data RestoreData) []error {
 	volumesToRestore := GetVolumeBackupsForPod(data.PodVolumeBackups, data.Pod, data.SourceNamespace)
+	volumeTypes := GetVolumeTypesForPod(data.PodVolumeBackups, data.Pod, data.SourceNamespace)
+	volumesToRestore = FilterVolumesForRestoreMode(data.Restore, data.Pod, volumesToRestore, volumeTypes, data.HelperPod != nil)
 	if len(volumesToRestore) == 0 {
 		return nil
 	}
 
+	// the PodVolumeRestores are for the pod whose volumes the data is
+	// restored into.
+	targetPod := data.Pod
+	if data.HelperPod != nil {
+		targetPod = data.HelperPod
+	}
+	podVolumeBackups := getPodVolumeBackupsByVolume(data.PodVolumeBackups, data.Pod, data.SourceNamespace)
+
 	repo, err := r.repoEnsurer.EnsureRepo(r.ctx, data.Restore.Namespace, data.SourceNamespace, data.BackupLocation)
 	if err != nil {
 		return []error{err}
@@ -114,7 +129,7 @@ func (r *restorer) RestorePodVolumes(data RestoreData) []error {
 	resultsChan := make(chan *velerov1api.PodVolumeRestore)
 
 	r.resultsLock.Lock()
-	r.results[resultsKey(data.Pod.Namespace, data.Pod.Name)] = resultsChan
+	r.results[resultsKey(targetPod.Namespace, targetPod.Name)] = resultsChan
 	r.resultsLock.Unlock()
 
 	var (
@@ -140,7 +155,7 @@ func (r *restorer) RestorePodVolumes(data RestoreData) []error {
 			}
 		}
 
-		volumeRestore := newPodVolumeRestore(data.Restore, data.Pod, data.BackupLocation, volume, snapshot, repo.Spec.ResticIdentifier, pvc)
+		volumeRestore := newPodVolumeRestore(data.Restore, targetPod, data.BackupLocation, volume, snapshot, repo, podVolumeBackups[volume], pvc)
 
 		if err := errorOnly(r.repoManager.veleroClient.VeleroV1().PodVolumeRestores(volumeRestore.Namespace).Create(context.TODO(), volumeRestore, metav1.CreateOptions{})); err != nil {
 			errs = append(errs, errors.WithStack(err))
@@ -163,13 +178,13 @@ ForEachVolume:
 	}
 
 	r.resultsLock.Lock()
-	delete(r.results, resultsKey(data.Pod.Namespace, data.Pod.Name))
+	delete(r.results, resultsKey(targetPod.Namespace, targetPod.Name))
 	r.resultsLock.Unlock()
 
 	return errs
 }
 
-func newPodVolumeRestore(restore *velerov1api.Restore, pod *corev1api.Pod, backupLocation, volume, snapshot, repoIdentifier string, pvc *corev1api.PersistentVolumeClaim) *velerov1api.PodVolumeRestore {
+func newPodVolumeRestore(restore *velerov1api.Restore, pod *corev1api.Pod, backupLocation, volume, snapshot string, repo *velerov1api.ResticRepository, pvb *velerov1api.PodVolumeBackup, pvc *corev1api.PersistentVolumeClaim) *velerov1api.PodVolumeRestore {
 	pvr := &velerov1api.PodVolumeRestore{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace:    restore.Namespace,
@@ -199,9 +214,19 @@ func newPodVolumeRestore(restore *velerov1api.Restore, pod *corev1api.Pod, backu
 			Volume:                volume,
 			SnapshotID:            snapshot,
 			BackupStorageLocation: backupLocation,
-			RepoIdentifier:        repoIdentifier,
+			RepoIdentifier:        repo.Spec.ResticIdentifier,
+			RepositoryKeySecret:   repo.Spec.RepositoryKeySecret,
 		},
 	}
+	// volumes restored from pod snapshot annotations don't have a pod volume backup.
+	if pvb != nil {
+		pvr.Spec.UploaderType = pvb.Spec.UploaderType
+		pvr.Spec.VolumeType = pvb.Status.VolumeType
+		pvr.Spec.PodVolumeTransfer = pvb.Spec.PodVolumeTransfer.DeepCopy()
+	}
+	if restore.Spec.PodVolumeFileRestore != nil {
+		pvr.Spec.IncludedPaths = restore.Spec.PodVolumeFileRestore.Paths
+	}
 	if pvc != nil {
 		// this label is not used by velero, but useful for debugging.
 		pvr.Labels[velerov1api.PVCUIDLabel] = string(pvc.UID)
@@ -0,0 +1,162 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// restorePodVolumeFiles executes a pod volume file restore, restoring files
+// from the restic backup of a single pod volume into the target persistent
+// volume claim using a restore helper pod. No Kubernetes objects are restored.
+func (ctx *restoreContext) restorePodVolumeFiles() (Result, Result) {
+	warnings, errs := Result{}, Result{}
+
+	spec := ctx.restore.Spec.PodVolumeFileRestore
+	targetNamespace := spec.Namespace
+	if target, ok := ctx.restore.Spec.NamespaceMapping[spec.Namespace]; ok {
+		targetNamespace = target
+	}
+
+	log := ctx.log.WithField("pod", spec.Namespace+"/"+spec.Pod).WithField("volume", spec.Volume).WithField("targetPVC", targetNamespace+"/"+spec.TargetPVC)
+	log.Infof("Starting pod volume file restore of backup %s", kube.NamespaceAndName(ctx.backup))
+
+	pvb, err := getPodVolumeBackupForFileRestore(ctx.podVolumeBackups, spec)
+	if err != nil {
+		errs.Add(targetNamespace, err)
+		return warnings, errs
+	}
+
+	if ctx.resticRestorer == nil {
+		errs.AddVeleroError(errors.New("restic is not enabled, pod volume files can't be restored"))
+		return warnings, errs
+	}
+
+	// the data is restored into a helper pod that mounts the target PVC
+	// as the backed up volume, and has the name of the backed up pod so
+	// that its pod volume backups are found for it.
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: targetNamespace,
+			Name:      spec.Pod,
+		},
+		Spec: v1.PodSpec{
+			Volumes: []v1.Volume{
+				{
+					Name: spec.Volume,
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: spec.TargetPVC},
+					},
+				},
+			},
+		},
+	}
+	// a claim that's mounted by a running pod may only be attachable to
+	// that pod's node, e.g. if it's ReadWriteOnce, so schedule the helper
+	// pod there.
+	affinity, err := nodeAffinityForClaim(ctx.podClient, targetNamespace, spec.TargetPVC)
+	if err != nil {
+		errs.Add(targetNamespace, err)
+		return warnings, errs
+	}
+	pod.Spec.Affinity = affinity
+
+	volumes := map[string]string{spec.Volume: pvb.Status.SnapshotID}
+	volumeTypes := map[string]velerov1api.PodVolumeType{spec.Volume: pvb.Status.VolumeType}
+
+	if err := ctx.runRestoreHelperPod(pod, volumes, volumeTypes, spec.Namespace, log); err != nil {
+		log.WithError(err).Error("Error restoring pod volume files")
+		errs.Add(targetNamespace, err)
+		return warnings, errs
+	}
+
+	log.Info("Pod volume file restore completed")
+	return warnings, errs
+}
+
+// nodeAffinityForClaim returns a node affinity for the node of a pod that
+// mounts the given persistent volume claim, or nil if no scheduled pod that
+// hasn't terminated mounts it.
+func nodeAffinityForClaim(podClient corev1.PodsGetter, namespace, claim string) (*v1.Affinity, error) {
+	pods, err := podClient.Pods(namespace).List(go_context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing pods in namespace %s", namespace)
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Spec.NodeName == "" || pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+			continue
+		}
+
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim == nil || volume.PersistentVolumeClaim.ClaimName != claim {
+				continue
+			}
+
+			return &v1.Affinity{
+				NodeAffinity: &v1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+						NodeSelectorTerms: []v1.NodeSelectorTerm{
+							{
+								MatchFields: []v1.NodeSelectorRequirement{
+									{
+										Key:      "metadata.name",
+										Operator: v1.NodeSelectorOpIn,
+										Values:   []string{pod.Spec.NodeName},
+									},
+								},
+							},
+						},
+					},
+				},
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// getPodVolumeBackupForFileRestore returns the completed pod volume backup of
+// the volume that the pod volume file restore restores files from.
+func getPodVolumeBackupForFileRestore(podVolumeBackups []*velerov1api.PodVolumeBackup, spec *velerov1api.PodVolumeFileRestoreSpec) (*velerov1api.PodVolumeBackup, error) {
+	for _, pvb := range podVolumeBackups {
+		if pvb.Spec.Pod.Namespace != spec.Namespace || pvb.Spec.Pod.Name != spec.Pod || pvb.Spec.Volume != spec.Volume {
+			continue
+		}
+
+		if pvb.Status.Phase != velerov1api.PodVolumeBackupPhaseCompleted {
+			return nil, errors.Errorf("the backup of volume %s of pod %s/%s didn't complete", spec.Volume, spec.Namespace, spec.Pod)
+		}
+		if pvb.Status.SnapshotID == "" {
+			return nil, errors.Errorf("the backup of volume %s of pod %s/%s contains no data", spec.Volume, spec.Namespace, spec.Pod)
+		}
+		if pvb.Status.VolumeType == velerov1api.PodVolumeTypeBlock {
+			return nil, errors.Errorf("volume %s of pod %s/%s is a block volume, files can't be restored from it", spec.Volume, spec.Namespace, spec.Pod)
+		}
+		return pvb, nil
+	}
+
+	return nil, errors.Errorf("no pod volume backup found for volume %s of pod %s/%s", spec.Volume, spec.Namespace, spec.Pod)
+}
@@ -0,0 +1,122 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestGetPodVolumeBackupForFileRestore(t *testing.T) {
+	pvb := func(name, volume string) *builder.PodVolumeBackupBuilder {
+		return builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, name).
+			PodNamespace("ns-1").
+			PodName("pod-1").
+			Volume(volume).
+			Phase(velerov1api.PodVolumeBackupPhaseCompleted).
+			SnapshotID("snapshot-" + name)
+	}
+	podVolumeBackups := []*velerov1api.PodVolumeBackup{
+		pvb("pvb-1", "data").Result(),
+		pvb("pvb-2", "failed").Phase(velerov1api.PodVolumeBackupPhaseFailed).Result(),
+		pvb("pvb-3", "empty").SnapshotID("").Result(),
+		pvb("pvb-4", "block").VolumeType(velerov1api.PodVolumeTypeBlock).Result(),
+	}
+
+	tests := []struct {
+		name        string
+		spec        velerov1api.PodVolumeFileRestoreSpec
+		expected    string
+		expectedErr string
+	}{
+		{
+			name:     "the volume's completed pod volume backup is returned",
+			spec:     velerov1api.PodVolumeFileRestoreSpec{Namespace: "ns-1", Pod: "pod-1", Volume: "data"},
+			expected: "pvb-1",
+		},
+		{
+			name:        "a volume of another pod isn't found",
+			spec:        velerov1api.PodVolumeFileRestoreSpec{Namespace: "ns-1", Pod: "pod-2", Volume: "data"},
+			expectedErr: "no pod volume backup found for volume data of pod ns-1/pod-2",
+		},
+		{
+			name:        "a failed pod volume backup is an error",
+			spec:        velerov1api.PodVolumeFileRestoreSpec{Namespace: "ns-1", Pod: "pod-1", Volume: "failed"},
+			expectedErr: "the backup of volume failed of pod ns-1/pod-1 didn't complete",
+		},
+		{
+			name:        "an empty pod volume backup is an error",
+			spec:        velerov1api.PodVolumeFileRestoreSpec{Namespace: "ns-1", Pod: "pod-1", Volume: "empty"},
+			expectedErr: "the backup of volume empty of pod ns-1/pod-1 contains no data",
+		},
+		{
+			name:        "a block volume's pod volume backup is an error",
+			spec:        velerov1api.PodVolumeFileRestoreSpec{Namespace: "ns-1", Pod: "pod-1", Volume: "block"},
+			expectedErr: "volume block of pod ns-1/pod-1 is a block volume, files can't be restored from it",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := getPodVolumeBackupForFileRestore(podVolumeBackups, &test.spec)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, res.Name)
+		})
+	}
+}
+
+func TestNodeAffinityForClaim(t *testing.T) {
+	pod := func(name, node, claim string, phase corev1api.PodPhase) *corev1api.Pod {
+		return builder.ForPod("ns-1", name).
+			NodeName(node).
+			Phase(phase).
+			Volumes(builder.ForVolume("data").PersistentVolumeClaimSource(claim).Result()).
+			Result()
+	}
+
+	client := fake.NewSimpleClientset(
+		pod("pending", "", "pvc-1", corev1api.PodPending),
+		pod("completed", "node-1", "pvc-1", corev1api.PodSucceeded),
+		pod("other-claim", "node-2", "pvc-2", corev1api.PodRunning),
+		pod("running", "node-3", "pvc-1", corev1api.PodRunning),
+	)
+
+	affinity, err := nodeAffinityForClaim(client.CoreV1(), "ns-1", "pvc-1")
+	require.NoError(t, err)
+	require.NotNil(t, affinity)
+	terms := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	require.Len(t, terms, 1)
+	assert.Equal(t, []corev1api.NodeSelectorRequirement{
+		{Key: "metadata.name", Operator: corev1api.NodeSelectorOpIn, Values: []string{"node-3"}},
+	}, terms[0].MatchFields)
+
+	// a claim that isn't mounted by a running pod doesn't restrict the node.
+	affinity, err = nodeAffinityForClaim(client.CoreV1(), "ns-1", "pvc-3")
+	require.NoError(t, err)
+	assert.Nil(t, affinity)
+}
@@ -0,0 +1,208 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// restoreHelperPodDeletionTimeout is how long to wait for a restore helper pod
+// to be deleted before creating the restored pod.
+const restoreHelperPodDeletionTimeout = time.Minute
+
+// restorePodVolumesWithHelperPod restores the restic backups of the volumes of
+// the given pod that are backed by persistent volume claims, using a temporary
+// helper pod that mounts the claims. This is done before the pod is created, so
+// that it doesn't need the restic init container. The volumes are restored, and
+// the pod created, in the background, like the restores of the restic init
+// container are waited for, so that the restore of other items continues. It
+// returns whether the pod is created in the background.
+func (ctx *restoreContext) restorePodVolumesWithHelperPod(obj *unstructured.Unstructured, resourceClient client.Dynamic, originalNamespace string) (bool, error) {
+	pod := new(v1.Pod)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pod); err != nil {
+		return false, errors.Wrap(err, "error converting unstructured pod")
+	}
+
+	volumes := restic.GetVolumeBackupsForPod(ctx.podVolumeBackups, pod, originalNamespace)
+	volumeTypes := restic.GetVolumeTypesForPod(ctx.podVolumeBackups, pod, originalNamespace)
+	volumes = restic.FilterVolumesForRestoreMode(ctx.restore, pod, volumes, volumeTypes, true)
+	if len(volumes) == 0 {
+		return false, nil
+	}
+
+	if ctx.resticRestorer == nil {
+		ctx.log.Warn("No restic restorer, not restoring pod's volumes")
+		return false, nil
+	}
+
+	// existing pods aren't restored, so neither are their volumes.
+	if _, err := resourceClient.Get(pod.Name, metav1.GetOptions{}); err == nil {
+		return false, nil
+	} else if !apierrors.IsNotFound(err) {
+		return false, errors.Wrap(err, "error getting pod")
+	}
+
+	ctx.resticWaitGroup.Add(1)
+	go func() {
+		// Done() will only be called after all errors have been successfully
+		// sent on the ctx.resticErrs channel
+		defer ctx.resticWaitGroup.Done()
+
+		log := ctx.log.WithField("pod", kube.NamespaceAndName(pod))
+		if err := ctx.runRestoreHelperPod(pod, volumes, volumeTypes, originalNamespace, log); err != nil {
+			log.WithError(err).Error("unable to successfully complete restic restores of pod's volumes using a helper pod")
+			ctx.resticErrs <- err
+		}
+
+		// the pod is created even if not all of its volumes could be restored,
+		// like it is when they're restored by the restic init container.
+		log.Info("Attempting to restore Pod")
+		createdObj, err := resourceClient.Create(obj)
+		if err != nil {
+			log.WithError(err).Error("error restoring pod")
+			ctx.resticErrs <- errors.Wrapf(err, "error restoring pod %s", kube.NamespaceAndName(pod))
+			return
+		}
+
+		ctx.waitExec(createdObj)
+	}()
+
+	return true, nil
+}
+
+// runRestoreHelperPod restores the restic backups of the given volumes of the
+// pod, which was backed up in originalNamespace, using a temporary helper pod
+// that mounts the volumes, and deletes the helper pod once they're restored.
+func (ctx *restoreContext) runRestoreHelperPod(pod *v1.Pod, volumes map[string]string, volumeTypes map[string]velerov1api.PodVolumeType, originalNamespace string, log logrus.FieldLogger) error {
+	config, err := getPluginConfig(framework.PluginKindRestoreItemAction, "velero.io/restic", ctx.configMapClient)
+	if err != nil {
+		return err
+	}
+	container := resticRestoreHelperContainer(log, config, string(ctx.restore.UID), volumes, volumeTypes)
+
+	helperPod, err := ctx.podClient.Pods(pod.Namespace).Create(go_context.TODO(), newRestoreHelperPod(ctx.restore, pod, volumes, container), metav1.CreateOptions{})
+	if err != nil {
+		return errors.Wrap(err, "error creating restore helper pod")
+	}
+	log.Infof("Restoring volumes of pod using restore helper pod %s", helperPod.Name)
+
+	errs := ctx.resticRestorer.RestorePodVolumes(restic.RestoreData{
+		Restore:          ctx.restore,
+		Pod:              pod,
+		PodVolumeBackups: ctx.podVolumeBackups,
+		SourceNamespace:  originalNamespace,
+		BackupLocation:   ctx.backup.Spec.StorageLocation,
+		HelperPod:        helperPod,
+	})
+
+	if err := ctx.deleteRestoreHelperPod(helperPod, log); err != nil {
+		errs = append(errs, err)
+	}
+
+	return kubeerrs.NewAggregate(errs)
+}
+
+// deleteRestoreHelperPod deletes the helper pod and waits for it to be gone, so
+// that volumes that can only be attached to one node are released before the
+// restored pod is created.
+func (ctx *restoreContext) deleteRestoreHelperPod(helperPod *v1.Pod, log logrus.FieldLogger) error {
+	podClient := ctx.podClient.Pods(helperPod.Namespace)
+
+	if err := podClient.Delete(go_context.TODO(), helperPod.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "error deleting restore helper pod %s", helperPod.Name)
+	}
+
+	err := wait.PollImmediate(time.Second, restoreHelperPodDeletionTimeout, func() (bool, error) {
+		if _, err := podClient.Get(go_context.TODO(), helperPod.Name, metav1.GetOptions{}); err != nil {
+			if apierrors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		}
+		return false, nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error waiting for restore helper pod %s to be deleted", helperPod.Name)
+	}
+
+	log.Debugf("Restore helper pod %s deleted", helperPod.Name)
+	return nil
+}
+
+// newRestoreHelperPod returns a pod that mounts the given volumes of the pod, and
+// whose only container waits for their restic restores to complete.
+func newRestoreHelperPod(restore *velerov1api.Restore, pod *v1.Pod, volumes map[string]string, container v1.Container) *v1.Pod {
+	helperPod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    pod.Namespace,
+			GenerateName: "velero-restore-helper-",
+			Labels: map[string]string{
+				velerov1api.RestoreNameLabel:      label.GetValidName(restore.Name),
+				velerov1api.RestoreHelperPodLabel: "true",
+			},
+		},
+		Spec: v1.PodSpec{
+			Containers:    []v1.Container{container},
+			RestartPolicy: v1.RestartPolicyNever,
+			// schedule the helper pod where the restored pod can run, so that
+			// volumes provisioned for the helper pod can be used by it.
+			NodeSelector: pod.Spec.NodeSelector,
+			Tolerations:  pod.Spec.Tolerations,
+			// run the helper pod like the restored pod, so that it's allowed
+			// wherever the restored pod is, e.g. by the pod security admission.
+			SecurityContext:    pod.Spec.SecurityContext,
+			ServiceAccountName: pod.Spec.ServiceAccountName,
+		},
+	}
+
+	// unless a security context is configured for the helper container, use
+	// the one of the pod's first container.
+	if (container.SecurityContext == nil || equality.Semantic.DeepEqual(*container.SecurityContext, v1.SecurityContext{})) && len(pod.Spec.Containers) > 0 {
+		helperPod.Spec.Containers[0].SecurityContext = pod.Spec.Containers[0].SecurityContext
+	}
+
+	if pod.Spec.Affinity != nil && pod.Spec.Affinity.NodeAffinity != nil {
+		helperPod.Spec.Affinity = &v1.Affinity{NodeAffinity: pod.Spec.Affinity.NodeAffinity}
+	}
+
+	for _, volume := range pod.Spec.Volumes {
+		if _, ok := volumes[volume.Name]; ok {
+			helperPod.Spec.Volumes = append(helperPod.Spec.Volumes, volume)
+		}
+	}
+
+	return helperPod
+}
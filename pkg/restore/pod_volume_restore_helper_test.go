@@ -0,0 +1,75 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1api "k8s.io/api/core/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestNewRestoreHelperPod(t *testing.T) {
+	restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result()
+	pod := builder.ForPod("ns-1", "pod-1").
+		Volumes(
+			builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result(),
+			builder.ForVolume("vol-2").Result(),
+		).
+		Result()
+	pod.Spec.NodeSelector = map[string]string{"zone": "a"}
+	pod.Spec.ServiceAccountName = "sa-1"
+	runAsNonRoot := true
+	pod.Spec.SecurityContext = &corev1api.PodSecurityContext{RunAsNonRoot: &runAsNonRoot}
+	allowPrivilegeEscalation := false
+	containerSecurityContext := &corev1api.SecurityContext{AllowPrivilegeEscalation: &allowPrivilegeEscalation}
+	pod.Spec.Containers = []corev1api.Container{{Name: "app", SecurityContext: containerSecurityContext}}
+	pod.Spec.Affinity = &corev1api.Affinity{
+		NodeAffinity: &corev1api.NodeAffinity{},
+		PodAffinity:  &corev1api.PodAffinity{},
+	}
+	container := *builder.ForContainer("restic-wait", "image").Result()
+
+	helperPod := newRestoreHelperPod(restore, pod, map[string]string{"vol-1": "snapshot-1"}, container)
+
+	assert.Equal(t, "ns-1", helperPod.Namespace)
+	assert.Equal(t, "true", helperPod.Labels[velerov1api.RestoreHelperPodLabel])
+	assert.Equal(t, "restore-1", helperPod.Labels[velerov1api.RestoreNameLabel])
+	assert.Len(t, helperPod.Spec.Containers, 1)
+	assert.Equal(t, container.Image, helperPod.Spec.Containers[0].Image)
+	assert.Equal(t, corev1api.RestartPolicyNever, helperPod.Spec.RestartPolicy)
+	assert.Equal(t, []corev1api.Volume{*builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result()}, helperPod.Spec.Volumes)
+	assert.Equal(t, pod.Spec.NodeSelector, helperPod.Spec.NodeSelector)
+
+	// pod affinity isn't copied, since the pods it refers to may not exist yet.
+	assert.Equal(t, &corev1api.Affinity{NodeAffinity: &corev1api.NodeAffinity{}}, helperPod.Spec.Affinity)
+
+	// the helper pod runs like the restored pod, so that it's allowed where
+	// the restored pod is.
+	assert.Equal(t, "sa-1", helperPod.Spec.ServiceAccountName)
+	assert.Equal(t, pod.Spec.SecurityContext, helperPod.Spec.SecurityContext)
+	assert.Equal(t, containerSecurityContext, helperPod.Spec.Containers[0].SecurityContext)
+
+	// a security context configured for the helper container is kept.
+	runAsUser := int64(1000)
+	container.SecurityContext = &corev1api.SecurityContext{RunAsUser: &runAsUser}
+	helperPod = newRestoreHelperPod(restore, pod, map[string]string{"vol-1": "snapshot-1"}, container)
+	assert.Equal(t, container.SecurityContext, helperPod.Spec.Containers[0].SecurityContext)
+}
@@ -17,9 +17,13 @@ limitations under the License.
 package restore
 
 import (
+	"strings"
+
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
@@ -39,6 +43,7 @@ type pvRestorer struct {
 	volumeSnapshots         []*volume.Snapshot
 	volumeSnapshotterGetter VolumeSnapshotterGetter
 	snapshotLocationLister  listers.VolumeSnapshotLocationLister
+	volumeMapping           *api.PersistentVolumeMappingSpec
 }
 
 func (r *pvRestorer) executePVAction(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
@@ -68,21 +73,31 @@ func (r *pvRestorer) executePVAction(obj *unstructured.Unstructured) (*unstructu
 		return obj, nil
 	}
 
+	providerSnapshotID, config := snapshotInfo.providerSnapshotID, snapshotInfo.location.Spec.Config
+	if r.volumeMapping != nil && boolptr.IsSetToTrue(r.volumeMapping.FromSnapshotCopies) {
+		if snapshotInfo.copy == nil || snapshotInfo.copy.Phase != volume.SnapshotPhaseCompleted {
+			return nil, errors.Errorf("snapshot %s of persistent volume %s has no completed copy to restore from", snapshotInfo.providerSnapshotID, pvName)
+		}
+		providerSnapshotID, config = snapshotInfo.copy.ProviderSnapshotID, snapshotInfo.copy.Config
+	}
+
+	volumeType, volumeAZ, volumeIOPS := r.mapVolume(snapshotInfo)
+
 	volumeSnapshotter, err := r.volumeSnapshotterGetter.GetVolumeSnapshotter(snapshotInfo.location.Spec.Provider)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
-	if err := volumeSnapshotter.Init(snapshotInfo.location.Spec.Config); err != nil {
+	if err := volumeSnapshotter.Init(config); err != nil {
 		return nil, errors.WithStack(err)
 	}
 
-	volumeID, err := volumeSnapshotter.CreateVolumeFromSnapshot(snapshotInfo.providerSnapshotID, snapshotInfo.volumeType, snapshotInfo.volumeAZ, snapshotInfo.volumeIOPS)
+	volumeID, err := volumeSnapshotter.CreateVolumeFromSnapshot(providerSnapshotID, volumeType, volumeAZ, volumeIOPS)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
-	log.WithField("providerSnapshotID", snapshotInfo.providerSnapshotID).Info("successfully restored persistent volume from snapshot")
+	log.WithField("providerSnapshotID", providerSnapshotID).Info("successfully restored persistent volume from snapshot")
 
 	updated1, err := volumeSnapshotter.SetVolumeID(obj, volumeID)
 	if err != nil {
@@ -92,15 +107,88 @@ func (r *pvRestorer) executePVAction(obj *unstructured.Unstructured) (*unstructu
 	if !ok {
 		return nil, errors.Errorf("unexpected type %T", updated1)
 	}
+
+	if volumeAZ != snapshotInfo.volumeAZ {
+		log.Infof("Rewriting availability zone of persistent volume from %s to %s", snapshotInfo.volumeAZ, volumeAZ)
+		return rewritePVAvailabilityZone(updated2, snapshotInfo.volumeAZ, volumeAZ)
+	}
 	return updated2, nil
 }
 
+// mapVolume returns the type, availability zone and IOPS to create the volume from the
+// snapshot with, applying the restore's persistent volume mapping to the snapshotted volume's.
+func (r *pvRestorer) mapVolume(snapshotInfo *snapshotInfo) (string, string, *int64) {
+	volumeType, volumeAZ, volumeIOPS := snapshotInfo.volumeType, snapshotInfo.volumeAZ, snapshotInfo.volumeIOPS
+	if r.volumeMapping == nil {
+		return volumeType, volumeAZ, volumeIOPS
+	}
+
+	if mapped, ok := r.volumeMapping.VolumeTypes[volumeType]; ok {
+		volumeType = mapped
+	}
+	if mapped, ok := r.volumeMapping.AvailabilityZones[volumeAZ]; ok {
+		volumeAZ = mapped
+	}
+	if iops, ok := r.volumeMapping.IOPS[volumeType]; ok {
+		volumeIOPS = &iops
+	}
+
+	return volumeType, volumeAZ, volumeIOPS
+}
+
+// rewritePVAvailabilityZone replaces the old availability zone with the new one in the
+// persistent volume's zone labels, and in the zone requirements of its node affinity, so
+// that pods using it are scheduled into the zone the volume was created in.
+func rewritePVAvailabilityZone(obj *unstructured.Unstructured, oldAZ, newAZ string) (*unstructured.Unstructured, error) {
+	pv := new(corev1api.PersistentVolume)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pv); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for _, label := range []string{zoneLabel, zoneLabelDeprecated} {
+		if az, ok := pv.Labels[label]; ok && az == oldAZ {
+			pv.Labels[label] = newAZ
+		}
+	}
+
+	if pv.Spec.NodeAffinity != nil && pv.Spec.NodeAffinity.Required != nil {
+		for i := range pv.Spec.NodeAffinity.Required.NodeSelectorTerms {
+			term := &pv.Spec.NodeAffinity.Required.NodeSelectorTerms[i]
+			for j := range term.MatchExpressions {
+				expr := &term.MatchExpressions[j]
+				if !strings.HasSuffix(expr.Key, "/zone") {
+					continue
+				}
+				for k := range expr.Values {
+					if expr.Values[k] == oldAZ {
+						expr.Values[k] = newAZ
+					}
+				}
+			}
+		}
+	}
+
+	res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pv)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &unstructured.Unstructured{Object: res}, nil
+}
+
+// zoneLabel and zoneLabelDeprecated are the labels that store the availability zone
+// of persistent volumes.
+const (
+	zoneLabel           = "topology.kubernetes.io/zone"
+	zoneLabelDeprecated = "failure-domain.beta.kubernetes.io/zone"
+)
+
 type snapshotInfo struct {
 	providerSnapshotID string
 	volumeType         string
 	volumeAZ           string
 	volumeIOPS         *int64
 	location           *api.VolumeSnapshotLocation
+	copy               *volume.SnapshotCopyStatus
 }
 
 func getSnapshotInfo(pvName string, backup *api.Backup, volumeSnapshots []*volume.Snapshot, snapshotLocationLister listers.VolumeSnapshotLocationLister) (*snapshotInfo, error) {
@@ -127,5 +215,6 @@ func getSnapshotInfo(pvName string, backup *api.Backup, volumeSnapshots []*volum
 		volumeAZ:           pvSnapshot.Spec.VolumeAZ,
 		volumeIOPS:         pvSnapshot.Spec.VolumeIOPS,
 		location:           loc,
+		copy:               pvSnapshot.Status.Copy,
 	}, nil
 }
@@ -23,7 +23,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
@@ -32,6 +34,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -160,6 +163,7 @@ func TestExecutePVAction_SnapshotRestores(t *testing.T) {
 		expectedVolumeType string
 		expectedVolumeAZ   string
 		expectedVolumeIOPS *int64
+		expectedConfig     map[string]string
 		expectedSnapshot   *volume.Snapshot
 	}{
 		{
@@ -181,6 +185,68 @@ func TestExecutePVAction_SnapshotRestores(t *testing.T) {
 			expectedVolumeAZ:   "az-1",
 			expectedVolumeIOPS: int64Ptr(1),
 		},
+		{
+			name: "restore with a persistent volume mapping creates the volume with the mapped type, AZ and IOPS",
+			obj:  NewTestUnstructured().WithName("pv-1").WithSpec().Unstructured,
+			restore: builder.ForRestore(api.DefaultNamespace, "").RestorePVs(true).PersistentVolumeMapping(&api.PersistentVolumeMappingSpec{
+				AvailabilityZones: map[string]string{"az-1": "az-2"},
+				VolumeTypes:       map[string]string{"type-1": "type-2"},
+				IOPS:              map[string]int64{"type-2": 3000},
+			}).Result(),
+			backup: defaultBackup().Result(),
+			locations: []*api.VolumeSnapshotLocation{
+				builder.ForVolumeSnapshotLocation(api.DefaultNamespace, "loc-1").Provider("provider-1").Result(),
+			},
+			volumeSnapshots: []*volume.Snapshot{
+				newSnapshot("pv-1", "loc-1", "type-1", "az-1", "snap-1", 1),
+			},
+			expectedProvider:   "provider-1",
+			expectedSnapshotID: "snap-1",
+			expectedVolumeType: "type-2",
+			expectedVolumeAZ:   "az-2",
+			expectedVolumeIOPS: int64Ptr(3000),
+		},
+		{
+			name: "volumes not in the persistent volume mapping are created like the snapshotted volume",
+			obj:  NewTestUnstructured().WithName("pv-1").WithSpec().Unstructured,
+			restore: builder.ForRestore(api.DefaultNamespace, "").RestorePVs(true).PersistentVolumeMapping(&api.PersistentVolumeMappingSpec{
+				AvailabilityZones: map[string]string{"az-3": "az-2"},
+				VolumeTypes:       map[string]string{"type-3": "type-2"},
+				IOPS:              map[string]int64{"type-2": 3000},
+			}).Result(),
+			backup: defaultBackup().Result(),
+			locations: []*api.VolumeSnapshotLocation{
+				builder.ForVolumeSnapshotLocation(api.DefaultNamespace, "loc-1").Provider("provider-1").Result(),
+			},
+			volumeSnapshots: []*volume.Snapshot{
+				newSnapshot("pv-1", "loc-1", "type-1", "az-1", "snap-1", 1),
+			},
+			expectedProvider:   "provider-1",
+			expectedSnapshotID: "snap-1",
+			expectedVolumeType: "type-1",
+			expectedVolumeAZ:   "az-1",
+			expectedVolumeIOPS: int64Ptr(1),
+		},
+		{
+			name: "restore from snapshot copies creates the volume from the copy using the copy's config",
+			obj:  NewTestUnstructured().WithName("pv-1").WithSpec().Unstructured,
+			restore: builder.ForRestore(api.DefaultNamespace, "").RestorePVs(true).PersistentVolumeMapping(&api.PersistentVolumeMappingSpec{
+				FromSnapshotCopies: boolptr.True(),
+			}).Result(),
+			backup: defaultBackup().Result(),
+			locations: []*api.VolumeSnapshotLocation{
+				builder.ForVolumeSnapshotLocation(api.DefaultNamespace, "loc-1").Provider("provider-1").Config(map[string]string{"region": "region-1"}).Result(),
+			},
+			volumeSnapshots: []*volume.Snapshot{
+				withSnapshotCopy(newSnapshot("pv-1", "loc-1", "type-1", "az-1", "snap-1", 1), "copy-1", map[string]string{"region": "region-2"}),
+			},
+			expectedProvider:   "provider-1",
+			expectedSnapshotID: "copy-1",
+			expectedVolumeType: "type-1",
+			expectedVolumeAZ:   "az-1",
+			expectedVolumeIOPS: int64Ptr(1),
+			expectedConfig:     map[string]string{"region": "region-2"},
+		},
 	}
 
 	for _, tc := range tests {
@@ -203,9 +269,14 @@ func TestExecutePVAction_SnapshotRestores(t *testing.T) {
 				volumeSnapshots:         tc.volumeSnapshots,
 				snapshotLocationLister:  locationsInformer.Lister(),
 				volumeSnapshotterGetter: volumeSnapshotterGetter,
+				volumeMapping:           tc.restore.Spec.PersistentVolumeMapping,
 			}
 
-			volumeSnapshotter.On("Init", mock.Anything).Return(nil)
+			if tc.expectedConfig != nil {
+				volumeSnapshotter.On("Init", tc.expectedConfig).Return(nil)
+			} else {
+				volumeSnapshotter.On("Init", mock.Anything).Return(nil)
+			}
 			volumeSnapshotter.On("CreateVolumeFromSnapshot", tc.expectedSnapshotID, tc.expectedVolumeType, tc.expectedVolumeAZ, tc.expectedVolumeIOPS).Return("volume-1", nil)
 			volumeSnapshotter.On("SetVolumeID", tc.obj, "volume-1").Return(tc.obj, nil)
 
@@ -217,6 +288,54 @@ func TestExecutePVAction_SnapshotRestores(t *testing.T) {
 	}
 }
 
+func TestExecutePVAction_FromSnapshotCopiesWithoutCopy(t *testing.T) {
+	locationsInformer := informers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0).Velero().V1().VolumeSnapshotLocations()
+	require.NoError(t, locationsInformer.Informer().GetStore().Add(builder.ForVolumeSnapshotLocation(api.DefaultNamespace, "loc-1").Provider("provider-1").Result()))
+
+	r := &pvRestorer{
+		logger:                  velerotest.NewLogger(),
+		backup:                  defaultBackup().Result(),
+		volumeSnapshots:         []*volume.Snapshot{newSnapshot("pv-1", "loc-1", "type-1", "az-1", "snap-1", 1)},
+		snapshotLocationLister:  locationsInformer.Lister(),
+		volumeSnapshotterGetter: providerToVolumeSnapshotterMap(map[string]velero.VolumeSnapshotter{}),
+		volumeMapping:           &api.PersistentVolumeMappingSpec{FromSnapshotCopies: boolptr.True()},
+	}
+
+	_, err := r.executePVAction(NewTestUnstructured().WithName("pv-1").WithSpec().Unstructured)
+	assert.EqualError(t, err, "snapshot snap-1 of persistent volume pv-1 has no completed copy to restore from")
+}
+
+func TestRewritePVAvailabilityZone(t *testing.T) {
+	pv := builder.ForPersistentVolume("pv-1").
+		ObjectMeta(builder.WithLabelsMap(map[string]string{zoneLabel: "az-1", zoneLabelDeprecated: "az-1", "app": "az-1"})).
+		Result()
+	pv.Spec.NodeAffinity = &corev1api.VolumeNodeAffinity{
+		Required: &corev1api.NodeSelector{
+			NodeSelectorTerms: []corev1api.NodeSelectorTerm{
+				{
+					MatchExpressions: []corev1api.NodeSelectorRequirement{
+						{Key: "topology.ebs.csi.aws.com/zone", Operator: corev1api.NodeSelectorOpIn, Values: []string{"az-1", "az-3"}},
+						{Key: "kubernetes.io/hostname", Operator: corev1api.NodeSelectorOpIn, Values: []string{"az-1"}},
+					},
+				},
+			},
+		},
+	}
+
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pv)
+	require.NoError(t, err)
+
+	res, err := rewritePVAvailabilityZone(&unstructured.Unstructured{Object: obj}, "az-1", "az-2")
+	require.NoError(t, err)
+
+	rewritten := new(corev1api.PersistentVolume)
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(res.Object, rewritten))
+
+	assert.Equal(t, map[string]string{zoneLabel: "az-2", zoneLabelDeprecated: "az-2", "app": "az-1"}, rewritten.Labels)
+	assert.Equal(t, []string{"az-2", "az-3"}, rewritten.Spec.NodeAffinity.Required.NodeSelectorTerms[0].MatchExpressions[0].Values)
+	assert.Equal(t, []string{"az-1"}, rewritten.Spec.NodeAffinity.Required.NodeSelectorTerms[0].MatchExpressions[1].Values)
+}
+
 type providerToVolumeSnapshotterMap map[string]velero.VolumeSnapshotter
 
 func (g providerToVolumeSnapshotterMap) GetVolumeSnapshotter(provider string) (velero.VolumeSnapshotter, error) {
@@ -242,6 +361,15 @@ func newSnapshot(pvName, location, volumeType, volumeAZ, snapshotID string, volu
 	}
 }
 
+func withSnapshotCopy(snapshot *volume.Snapshot, copyID string, config map[string]string) *volume.Snapshot {
+	snapshot.Status.Copy = &volume.SnapshotCopyStatus{
+		Config:             config,
+		ProviderSnapshotID: copyID,
+		Phase:              volume.SnapshotPhaseCompleted,
+	}
+	return snapshot
+}
+
 func int64Ptr(val int) *int64 {
 	r := int64(val)
 	return &r
@@ -0,0 +1,246 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+const (
+	// defaultReadinessTimeout is how long a restore waits for restored items
+	// to become ready when the restore's spec doesn't specify a timeout.
+	defaultReadinessTimeout = 10 * time.Minute
+
+	// readinessPollInterval is how often restored items are re-evaluated
+	// while waiting for them to become ready.
+	readinessPollInterval = 5 * time.Second
+)
+
+// readinessEvaluator determines whether a restored item is ready, and if it
+// isn't, whether it failed, so that it will never become ready. If the item
+// isn't ready, the returned string describes why.
+type readinessEvaluator func(obj *unstructured.Unstructured) (ready bool, failed bool, reason string, err error)
+
+// readinessEvaluators holds the evaluators for resources that have well-known
+// readiness semantics. All other resources are evaluated using their status
+// conditions.
+var readinessEvaluators = map[schema.GroupResource]readinessEvaluator{
+	kuberesource.Deployments:            deploymentReady,
+	kuberesource.StatefulSets:           statefulSetReady,
+	kuberesource.PersistentVolumeClaims: persistentVolumeClaimReady,
+	kuberesource.Jobs:                   jobReady,
+}
+
+// readinessItem is a restored item whose readiness is checked once all items
+// have been restored.
+type readinessItem struct {
+	groupResource schema.GroupResource
+	namespace     string
+	name          string
+	client        client.Dynamic
+}
+
+func getReadinessEvaluator(groupResource schema.GroupResource) readinessEvaluator {
+	if evaluator, ok := readinessEvaluators[groupResource]; ok {
+		return evaluator
+	}
+	return conditionsReady
+}
+
+func deploymentReady(obj *unstructured.Unstructured) (bool, bool, string, error) {
+	deployment := new(appsv1.Deployment)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), deployment); err != nil {
+		return false, false, "", errors.Wrap(err, "error converting deployment from unstructured")
+	}
+
+	if deployment.Status.ObservedGeneration < deployment.Generation {
+		return false, false, "deployment spec update has not been observed", nil
+	}
+
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	if deployment.Status.AvailableReplicas < replicas {
+		return false, false, fmt.Sprintf("%d of %d replicas are available", deployment.Status.AvailableReplicas, replicas), nil
+	}
+
+	return true, false, "", nil
+}
+
+func statefulSetReady(obj *unstructured.Unstructured) (bool, bool, string, error) {
+	statefulSet := new(appsv1.StatefulSet)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), statefulSet); err != nil {
+		return false, false, "", errors.Wrap(err, "error converting statefulset from unstructured")
+	}
+
+	if statefulSet.Status.ObservedGeneration < statefulSet.Generation {
+		return false, false, "statefulset spec update has not been observed", nil
+	}
+
+	replicas := int32(1)
+	if statefulSet.Spec.Replicas != nil {
+		replicas = *statefulSet.Spec.Replicas
+	}
+	if statefulSet.Status.ReadyReplicas < replicas {
+		return false, false, fmt.Sprintf("%d of %d replicas are ready", statefulSet.Status.ReadyReplicas, replicas), nil
+	}
+
+	return true, false, "", nil
+}
+
+func persistentVolumeClaimReady(obj *unstructured.Unstructured) (bool, bool, string, error) {
+	pvc := new(corev1api.PersistentVolumeClaim)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pvc); err != nil {
+		return false, false, "", errors.Wrap(err, "error converting persistent volume claim from unstructured")
+	}
+
+	if pvc.Status.Phase != corev1api.ClaimBound {
+		return false, false, fmt.Sprintf("persistent volume claim phase is %q", pvc.Status.Phase), nil
+	}
+
+	return true, false, "", nil
+}
+
+func jobReady(obj *unstructured.Unstructured) (bool, bool, string, error) {
+	job := new(batchv1.Job)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), job); err != nil {
+		return false, false, "", errors.Wrap(err, "error converting job from unstructured")
+	}
+
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1api.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return true, false, "", nil
+		case batchv1.JobFailed:
+			return false, true, fmt.Sprintf("job failed: %s", condition.Message), nil
+		}
+	}
+
+	return false, false, "job has not succeeded", nil
+}
+
+// conditionsReady evaluates an item using a "Ready" condition in its status,
+// which is the convention followed by most custom resources. Items that don't
+// report a "Ready" condition are considered ready.
+func conditionsReady(obj *unstructured.Unstructured) (bool, bool, string, error) {
+	conditions, found, err := unstructured.NestedSlice(obj.UnstructuredContent(), "status", "conditions")
+	if err != nil {
+		return false, false, "", errors.Wrap(err, "error getting status conditions")
+	}
+	if !found {
+		return true, false, "", nil
+	}
+
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if condition["type"] != "Ready" {
+			continue
+		}
+		if condition["status"] != string(metav1.ConditionTrue) {
+			return false, false, fmt.Sprintf("Ready condition is %v: %v", condition["status"], condition["message"]), nil
+		}
+		return true, false, "", nil
+	}
+
+	return true, false, "", nil
+}
+
+// waitForReadiness waits for all items restored by this restore to become ready,
+// up to the timeout specified in the restore's spec. Items that failed, and
+// items that aren't ready when the timeout expires, are returned as warnings.
+// Failed items aren't waited for.
+func (ctx *restoreContext) waitForReadiness() Result {
+	warnings := Result{}
+
+	timeout := ctx.restore.Spec.WaitForReadiness.Timeout.Duration
+	if timeout <= 0 {
+		timeout = defaultReadinessTimeout
+	}
+
+	ctx.log.Infof("Waiting up to %s for %d restored items to become ready", timeout, len(ctx.readinessItems))
+
+	pending := ctx.readinessItems
+	reasons := make(map[string]string)
+	var failed []readinessItem
+	err := wait.PollImmediate(ctx.readinessPollInterval, timeout, func() (bool, error) {
+		var notReady []readinessItem
+		for _, item := range pending {
+			resourceID := getResourceID(item.groupResource, item.namespace, item.name)
+
+			obj, err := item.client.Get(item.name, metav1.GetOptions{})
+			if err != nil {
+				reasons[resourceID] = err.Error()
+				notReady = append(notReady, item)
+				continue
+			}
+
+			ready, itemFailed, reason, err := getReadinessEvaluator(item.groupResource)(obj)
+			if err != nil {
+				reason = err.Error()
+			}
+			if itemFailed {
+				reasons[resourceID] = reason
+				failed = append(failed, item)
+				continue
+			}
+			if !ready {
+				reasons[resourceID] = reason
+				notReady = append(notReady, item)
+			}
+		}
+
+		pending = notReady
+		return len(pending) == 0, nil
+	})
+	if err != nil && err != wait.ErrWaitTimeout {
+		warnings.AddVeleroError(errors.Wrap(err, "error waiting for restored items to become ready"))
+		return warnings
+	}
+
+	for _, item := range failed {
+		resourceID := getResourceID(item.groupResource, item.namespace, item.name)
+		warnings.Add(item.namespace, errors.Errorf("%s will not become ready: %s", resourceID, reasons[resourceID]))
+	}
+	for _, item := range pending {
+		resourceID := getResourceID(item.groupResource, item.namespace, item.name)
+		warnings.Add(item.namespace, errors.Errorf("%s did not become ready within %s: %s", resourceID, timeout, reasons[resourceID]))
+	}
+
+	ctx.log.Infof("Done waiting for restored items to become ready, %d items failed, %d items not ready", len(failed), len(pending))
+	return warnings
+}
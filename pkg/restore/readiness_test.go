@@ -0,0 +1,185 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestReadinessEvaluators(t *testing.T) {
+	tests := []struct {
+		name       string
+		resource   string
+		obj        string
+		wantReady  bool
+		wantFailed bool
+	}{
+		{
+			name:      "deployment with all replicas available is ready",
+			resource:  "deployments.apps",
+			obj:       `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"d","generation":2},"spec":{"replicas":3},"status":{"observedGeneration":2,"availableReplicas":3}}`,
+			wantReady: true,
+		},
+		{
+			name:      "deployment with unavailable replicas is not ready",
+			resource:  "deployments.apps",
+			obj:       `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"d","generation":2},"spec":{"replicas":3},"status":{"observedGeneration":2,"availableReplicas":1}}`,
+			wantReady: false,
+		},
+		{
+			name:      "deployment with unobserved generation is not ready",
+			resource:  "deployments.apps",
+			obj:       `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"d","generation":2},"spec":{"replicas":1},"status":{"observedGeneration":1,"availableReplicas":1}}`,
+			wantReady: false,
+		},
+		{
+			name:      "statefulset with all replicas ready is ready",
+			resource:  "statefulsets.apps",
+			obj:       `{"apiVersion":"apps/v1","kind":"StatefulSet","metadata":{"name":"s"},"spec":{"replicas":2},"status":{"readyReplicas":2}}`,
+			wantReady: true,
+		},
+		{
+			name:      "statefulset with default replicas and none ready is not ready",
+			resource:  "statefulsets.apps",
+			obj:       `{"apiVersion":"apps/v1","kind":"StatefulSet","metadata":{"name":"s"},"spec":{}}`,
+			wantReady: false,
+		},
+		{
+			name:      "bound pvc is ready",
+			resource:  "persistentvolumeclaims",
+			obj:       `{"apiVersion":"v1","kind":"PersistentVolumeClaim","metadata":{"name":"p"},"status":{"phase":"Bound"}}`,
+			wantReady: true,
+		},
+		{
+			name:      "pending pvc is not ready",
+			resource:  "persistentvolumeclaims",
+			obj:       `{"apiVersion":"v1","kind":"PersistentVolumeClaim","metadata":{"name":"p"},"status":{"phase":"Pending"}}`,
+			wantReady: false,
+		},
+		{
+			name:      "completed job is ready",
+			resource:  "jobs.batch",
+			obj:       `{"apiVersion":"batch/v1","kind":"Job","metadata":{"name":"j"},"status":{"conditions":[{"type":"Complete","status":"True"}]}}`,
+			wantReady: true,
+		},
+		{
+			name:       "failed job is not ready, and failed",
+			resource:   "jobs.batch",
+			obj:        `{"apiVersion":"batch/v1","kind":"Job","metadata":{"name":"j"},"status":{"conditions":[{"type":"Failed","status":"True","message":"BackoffLimitExceeded"}]}}`,
+			wantReady:  false,
+			wantFailed: true,
+		},
+		{
+			name:      "running job is not ready",
+			resource:  "jobs.batch",
+			obj:       `{"apiVersion":"batch/v1","kind":"Job","metadata":{"name":"j"},"status":{"active":1}}`,
+			wantReady: false,
+		},
+		{
+			name:      "custom resource with Ready=True condition is ready",
+			resource:  "widgets.example.com",
+			obj:       `{"apiVersion":"example.com/v1","kind":"Widget","metadata":{"name":"w"},"status":{"conditions":[{"type":"Ready","status":"True"}]}}`,
+			wantReady: true,
+		},
+		{
+			name:      "custom resource with Ready=False condition is not ready",
+			resource:  "widgets.example.com",
+			obj:       `{"apiVersion":"example.com/v1","kind":"Widget","metadata":{"name":"w"},"status":{"conditions":[{"type":"Ready","status":"False","message":"reconciling"}]}}`,
+			wantReady: false,
+		},
+		{
+			name:      "custom resource without status conditions is ready",
+			resource:  "widgets.example.com",
+			obj:       `{"apiVersion":"example.com/v1","kind":"Widget","metadata":{"name":"w"}}`,
+			wantReady: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			groupResource := schema.ParseGroupResource(tc.resource)
+
+			ready, failed, reason, err := getReadinessEvaluator(groupResource)(velerotest.UnstructuredOrDie(tc.obj))
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantReady, ready)
+			assert.Equal(t, tc.wantFailed, failed)
+			if !ready {
+				assert.NotEmpty(t, reason)
+			}
+		})
+	}
+}
+
+func TestWaitForReadiness(t *testing.T) {
+	readyClient := new(velerotest.FakeDynamicClient)
+	readyClient.On("Get", "ready-pvc", metav1.GetOptions{}).Return(
+		velerotest.UnstructuredOrDie(`{"apiVersion":"v1","kind":"PersistentVolumeClaim","metadata":{"namespace":"ns-1","name":"ready-pvc"},"status":{"phase":"Bound"}}`), nil)
+
+	pendingClient := new(velerotest.FakeDynamicClient)
+	pendingClient.On("Get", "pending-pvc", metav1.GetOptions{}).Return(
+		velerotest.UnstructuredOrDie(`{"apiVersion":"v1","kind":"PersistentVolumeClaim","metadata":{"namespace":"ns-1","name":"pending-pvc"},"status":{"phase":"Pending"}}`), nil)
+
+	ctx := &restoreContext{
+		restore:               builder.ForRestore("velero", "restore-1").WaitForReadiness(50 * time.Millisecond).Result(),
+		log:                   velerotest.NewLogger(),
+		readinessPollInterval: 10 * time.Millisecond,
+		readinessItems: []readinessItem{
+			{groupResource: kuberesource.PersistentVolumeClaims, namespace: "ns-1", name: "ready-pvc", client: readyClient},
+			{groupResource: kuberesource.PersistentVolumeClaims, namespace: "ns-1", name: "pending-pvc", client: pendingClient},
+		},
+	}
+
+	warnings := ctx.waitForReadiness()
+
+	assert.Empty(t, warnings.Velero)
+	require.Len(t, warnings.Namespaces["ns-1"], 1)
+	assert.Contains(t, warnings.Namespaces["ns-1"][0], "persistentvolumeclaims/ns-1/pending-pvc did not become ready")
+}
+
+func TestWaitForReadinessDoesNotWaitForFailedItems(t *testing.T) {
+	failedClient := new(velerotest.FakeDynamicClient)
+	failedClient.On("Get", "failed-job", metav1.GetOptions{}).Return(
+		velerotest.UnstructuredOrDie(`{"apiVersion":"batch/v1","kind":"Job","metadata":{"namespace":"ns-1","name":"failed-job"},"status":{"conditions":[{"type":"Failed","status":"True","message":"BackoffLimitExceeded"}]}}`), nil)
+
+	ctx := &restoreContext{
+		restore:               builder.ForRestore("velero", "restore-1").WaitForReadiness(time.Hour).Result(),
+		log:                   velerotest.NewLogger(),
+		readinessPollInterval: 10 * time.Millisecond,
+		readinessItems: []readinessItem{
+			{groupResource: kuberesource.Jobs, namespace: "ns-1", name: "failed-job", client: failedClient},
+		},
+	}
+
+	start := time.Now()
+	warnings := ctx.waitForReadiness()
+
+	assert.Less(t, int64(time.Since(start)), int64(time.Minute))
+	assert.Empty(t, warnings.Velero)
+	require.Len(t, warnings.Namespaces["ns-1"], 1)
+	assert.Equal(t, "jobs.batch/ns-1/failed-job will not become ready: job failed: BackoffLimitExceeded", warnings.Namespaces["ns-1"][0])
+	failedClient.AssertNumberOfCalls(t, "Get", 1)
+}
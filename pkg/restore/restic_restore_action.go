@@ -102,6 +102,15 @@ func (a *ResticRestoreAction) Execute(input *velero.RestoreItemActionExecuteInpu
 		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
 	}
 
+	// volumes restored using a helper pod before the pod is created don't
+	// need the init container.
+	volumeTypes := restic.GetVolumeTypesForPod(podVolumeBackups, &pod, podFromBackup.Namespace)
+	volumeSnapshots = restic.FilterVolumesForRestoreMode(input.Restore, &pod, volumeSnapshots, volumeTypes, false)
+	if len(volumeSnapshots) == 0 {
+		log.Debug("All of the pod's restic backups are restored using a helper pod")
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
 	log.Info("Restic backups for pod found")
 
 	// TODO we might want/need to get plugin config at the top of this method at some point; for now, wait
@@ -112,6 +121,52 @@ func (a *ResticRestoreAction) Execute(input *velero.RestoreItemActionExecuteInpu
 		return nil, err
 	}
 
+	initContainer := resticRestoreHelperContainer(log, config, string(input.Restore.UID), volumeSnapshots, volumeTypes)
+	addRestoreDoneVolumes(&pod, volumeSnapshots, volumeTypes)
+	if len(pod.Spec.InitContainers) == 0 || pod.Spec.InitContainers[0].Name != restic.InitContainer {
+		pod.Spec.InitContainers = append([]corev1.Container{initContainer}, pod.Spec.InitContainers...)
+	} else {
+		pod.Spec.InitContainers[0] = initContainer
+	}
+
+	res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&pod)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to convert pod to runtime.Unstructured")
+	}
+
+	return velero.NewRestoreItemActionExecuteOutput(&unstructured.Unstructured{Object: res}), nil
+}
+
+// addRestoreDoneVolumes adds to the pod the emptyDir volumes that hold the done
+// files of the restores of its block volumes.
+func addRestoreDoneVolumes(pod *corev1.Pod, volumeSnapshots map[string]string, volumeTypes map[string]velerov1api.PodVolumeType) {
+	existing := make(map[string]bool)
+	for _, volume := range pod.Spec.Volumes {
+		existing[volume.Name] = true
+	}
+
+	for volumeName := range volumeSnapshots {
+		if volumeTypes[volumeName] != velerov1api.PodVolumeTypeBlock {
+			continue
+		}
+		doneVolume := restic.RestoreDoneVolumeName(volumeName)
+		if existing[doneVolume] {
+			continue
+		}
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+			Name: doneVolume,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		})
+	}
+}
+
+// resticRestoreHelperContainer returns the container that waits for the restic
+// restores of the given volumes to complete, as configured by the restic plugin
+// config. Block volumes can't be mounted, so the container waits for their done
+// files in the volumes added by addRestoreDoneVolumes instead.
+func resticRestoreHelperContainer(log logrus.FieldLogger, config *corev1.ConfigMap, restoreUID string, volumeSnapshots map[string]string, volumeTypes map[string]velerov1api.PodVolumeType) corev1.Container {
 	image := getImage(log, config)
 	log.Infof("Using image %q", image)
 
@@ -146,32 +201,23 @@ func (a *ResticRestoreAction) Execute(input *velero.RestoreItemActionExecuteInpu
 		log.Errorf("Using default resource values, couldn't parse resource requirements: %s.", err)
 	}
 
-	initContainerBuilder := newResticInitContainerBuilder(image, string(input.Restore.UID))
-	initContainerBuilder.Resources(&resourceReqs)
-	initContainerBuilder.SecurityContext(&securityContext)
+	containerBuilder := newResticInitContainerBuilder(image, restoreUID)
+	containerBuilder.Resources(&resourceReqs)
+	containerBuilder.SecurityContext(&securityContext)
 
 	for volumeName := range volumeSnapshots {
 		mount := &corev1.VolumeMount{
 			Name:      volumeName,
 			MountPath: "/restores/" + volumeName,
 		}
-		initContainerBuilder.VolumeMounts(mount)
-	}
-	initContainerBuilder.Command(getCommand(log, config))
-
-	initContainer := *initContainerBuilder.Result()
-	if len(pod.Spec.InitContainers) == 0 || pod.Spec.InitContainers[0].Name != restic.InitContainer {
-		pod.Spec.InitContainers = append([]corev1.Container{initContainer}, pod.Spec.InitContainers...)
-	} else {
-		pod.Spec.InitContainers[0] = initContainer
-	}
-
-	res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&pod)
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to convert pod to runtime.Unstructured")
+		if volumeTypes[volumeName] == velerov1api.PodVolumeTypeBlock {
+			mount.Name = restic.RestoreDoneVolumeName(volumeName)
+		}
+		containerBuilder.VolumeMounts(mount)
 	}
+	containerBuilder.Command(getCommand(log, config))
 
-	return velero.NewRestoreItemActionExecuteOutput(&unstructured.Unstructured{Object: res}), nil
+	return *containerBuilder.Result()
 }
 
 func getCommand(log logrus.FieldLogger, config *corev1.ConfigMap) []string {
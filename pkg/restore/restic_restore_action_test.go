@@ -132,6 +132,7 @@ func TestResticRestoreActionExecute(t *testing.T) {
 		pod              *corev1api.Pod
 		podFromBackup    *corev1api.Pod
 		podVolumeBackups []*velerov1api.PodVolumeBackup
+		restoreMode      velerov1api.PodVolumeRestoreMode
 		want             *corev1api.Pod
 	}{
 		{
@@ -254,6 +255,92 @@ func TestResticRestoreActionExecute(t *testing.T) {
 						Command([]string{"/velero-restic-restore-helper"}).Result()).
 				Result(),
 		},
+		{
+			name: "Restoring pod in helper pod mode only adds the restic initContainer for volumes not backed by a PVC",
+			pod: builder.ForPod("ns-1", "my-pod").
+				Volumes(
+					builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result(),
+					builder.ForVolume("vol-2").Result(),
+				).
+				Result(),
+			podVolumeBackups: []*velerov1api.PodVolumeBackup{
+				builder.ForPodVolumeBackup(veleroNs, "pvb-1").
+					PodName("my-pod").
+					PodNamespace("ns-1").
+					Volume("vol-1").
+					ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, backupName)).
+					SnapshotID("foo").
+					Result(),
+				builder.ForPodVolumeBackup(veleroNs, "pvb-2").
+					PodName("my-pod").
+					PodNamespace("ns-1").
+					Volume("vol-2").
+					ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, backupName)).
+					SnapshotID("foo").
+					Result(),
+			},
+			restoreMode: velerov1api.PodVolumeRestoreModeHelperPod,
+			want: builder.ForPod("ns-1", "my-pod").
+				Volumes(
+					builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result(),
+					builder.ForVolume("vol-2").Result(),
+				).
+				InitContainers(
+					newResticInitContainerBuilder(defaultResticRestoreHelperImage, "").
+						Resources(&resourceReqs).
+						SecurityContext(&securityContext).
+						VolumeMounts(builder.ForVolumeMount("vol-2", "/restores/vol-2").Result()).
+						Command([]string{"/velero-restic-restore-helper"}).Result()).
+				Result(),
+		},
+		{
+			name: "Restoring pod in helper pod mode with only PVC volumes doesn't add the restic initContainer",
+			pod: builder.ForPod("ns-1", "my-pod").
+				Volumes(builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result()).
+				Result(),
+			podVolumeBackups: []*velerov1api.PodVolumeBackup{
+				builder.ForPodVolumeBackup(veleroNs, "pvb-1").
+					PodName("my-pod").
+					PodNamespace("ns-1").
+					Volume("vol-1").
+					ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, backupName)).
+					SnapshotID("foo").
+					Result(),
+			},
+			restoreMode: velerov1api.PodVolumeRestoreModeHelperPod,
+			want: builder.ForPod("ns-1", "my-pod").
+				Volumes(builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result()).
+				Result(),
+		},
+		{
+			name: "Restoring pod with a block volume adds a volume for its done file to the pod and the restic initContainer",
+			pod: builder.ForPod("ns-1", "my-pod").
+				Volumes(builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result()).
+				Result(),
+			podVolumeBackups: []*velerov1api.PodVolumeBackup{
+				builder.ForPodVolumeBackup(veleroNs, "pvb-1").
+					PodName("my-pod").
+					PodNamespace("ns-1").
+					Volume("vol-1").
+					ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, backupName)).
+					SnapshotID("foo").
+					VolumeType(velerov1api.PodVolumeTypeBlock).
+					Result(),
+			},
+			restoreMode: velerov1api.PodVolumeRestoreModeHelperPod,
+			want: builder.ForPod("ns-1", "my-pod").
+				Volumes(
+					builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result(),
+					builder.ForVolume("velero-restore-vol-1").EmptyDirSource().Result(),
+				).
+				InitContainers(
+					newResticInitContainerBuilder(defaultResticRestoreHelperImage, "").
+						Resources(&resourceReqs).
+						SecurityContext(&securityContext).
+						VolumeMounts(builder.ForVolumeMount("velero-restore-vol-1", "/restores/vol-1").Result()).
+						Command([]string{"/velero-restic-restore-helper"}).Result()).
+				Result(),
+		},
 	}
 
 	for _, tc := range tests {
@@ -288,6 +375,7 @@ func TestResticRestoreActionExecute(t *testing.T) {
 				Restore: builder.ForRestore(veleroNs, restoreName).
 					Backup(backupName).
 					Phase(velerov1api.RestorePhaseInProgress).
+					PodVolumeRestoreMode(tc.restoreMode).
 					Result(),
 			}
 
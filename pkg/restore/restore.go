@@ -59,6 +59,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
@@ -90,8 +91,10 @@ type Request struct {
 
 // Restorer knows how to restore a backup.
 type Restorer interface {
-	// Restore restores the backup data from backupReader, returning warnings and errors.
-	Restore(req Request,
+	// Restore restores the backup data from backupReader, returning warnings and errors. Its pod
+	// volume restores and hooks are canceled when ctx is done.
+	Restore(ctx go_context.Context,
+		req Request,
 		actions []velero.RestoreItemAction,
 		snapshotLocationLister listers.VolumeSnapshotLocationLister,
 		volumeSnapshotterGetter VolumeSnapshotterGetter,
@@ -113,6 +116,8 @@ type kubernetesRestorer struct {
 	logger                     logrus.FieldLogger
 	podCommandExecutor         podexec.PodCommandExecutor
 	podGetter                  cache.Getter
+	podClient                  corev1.PodsGetter
+	configMapClient            corev1.ConfigMapInterface
 }
 
 // NewKubernetesRestorer creates a new kubernetesRestorer.
@@ -128,6 +133,8 @@ func NewKubernetesRestorer(
 	logger logrus.FieldLogger,
 	podCommandExecutor podexec.PodCommandExecutor,
 	podGetter cache.Getter,
+	podClient corev1.PodsGetter,
+	configMapClient corev1.ConfigMapInterface,
 ) (Restorer, error) {
 	return &kubernetesRestorer{
 		restoreClient:              restoreClient,
@@ -150,6 +157,8 @@ func NewKubernetesRestorer(
 		fileSystem:         filesystem.NewFileSystem(),
 		podCommandExecutor: podCommandExecutor,
 		podGetter:          podGetter,
+		podClient:          podClient,
+		configMapClient:    configMapClient,
 	}, nil
 }
 
@@ -157,6 +166,7 @@ func NewKubernetesRestorer(
 // and using data from the provided backup/backup reader. Returns a warnings and errors RestoreResult,
 // respectively, summarizing info about the restore.
 func (kr *kubernetesRestorer) Restore(
+	ctx go_context.Context,
 	req Request,
 	actions []velero.RestoreItemAction,
 	snapshotLocationLister listers.VolumeSnapshotLocationLister,
@@ -206,12 +216,12 @@ func (kr *kubernetesRestorer) Restore(
 		}
 	}
 
-	ctx, cancelFunc := go_context.WithTimeout(go_context.Background(), podVolumeTimeout)
+	podVolumeCtx, cancelFunc := go_context.WithTimeout(ctx, podVolumeTimeout)
 	defer cancelFunc()
 
 	var resticRestorer restic.Restorer
 	if kr.resticRestorerFactory != nil {
-		resticRestorer, err = kr.resticRestorerFactory.NewRestorer(ctx, req.Restore)
+		resticRestorer, err = kr.resticRestorerFactory.NewRestorer(podVolumeCtx, req.Restore)
 		if err != nil {
 			return Result{}, Result{Velero: []string{err.Error()}}
 		}
@@ -221,7 +231,7 @@ func (kr *kubernetesRestorer) Restore(
 	if err != nil {
 		return Result{}, Result{Velero: []string{err.Error()}}
 	}
-	hooksCtx, hooksCancelFunc := go_context.WithCancel(go_context.Background())
+	hooksCtx, hooksCancelFunc := go_context.WithCancel(ctx)
 	waitExecHookHandler := &hook.DefaultWaitExecHookHandler{
 		PodCommandExecutor: kr.podCommandExecutor,
 		ListWatchFactory: &hook.DefaultListWatchFactory{
@@ -237,6 +247,7 @@ func (kr *kubernetesRestorer) Restore(
 		volumeSnapshots:         req.VolumeSnapshots,
 		volumeSnapshotterGetter: volumeSnapshotterGetter,
 		snapshotLocationLister:  snapshotLocationLister,
+		volumeMapping:           req.Restore.Spec.PersistentVolumeMapping,
 	}
 
 	restoreCtx := &restoreContext{
@@ -251,6 +262,8 @@ func (kr *kubernetesRestorer) Restore(
 		dynamicFactory:             kr.dynamicFactory,
 		fileSystem:                 kr.fileSystem,
 		namespaceClient:            kr.namespaceClient,
+		podClient:                  kr.podClient,
+		configMapClient:            kr.configMapClient,
 		actions:                    resolvedActions,
 		volumeSnapshotterGetter:    volumeSnapshotterGetter,
 		resticRestorer:             resticRestorer,
@@ -272,6 +285,14 @@ func (kr *kubernetesRestorer) Restore(
 		hooksContext:               hooksCtx,
 		hooksCancelFunc:            hooksCancelFunc,
 		restoreClient:              kr.restoreClient,
+		readinessPollInterval:      readinessPollInterval,
+	}
+
+	_, span := tracing.Tracer().Start(ctx, "RestoreItems")
+	defer span.End()
+
+	if req.Restore.Spec.PodVolumeFileRestore != nil {
+		return restoreCtx.restorePodVolumeFiles()
 	}
 
 	return restoreCtx.execute()
@@ -331,6 +352,8 @@ type restoreContext struct {
 	dynamicFactory             client.DynamicFactory
 	fileSystem                 filesystem.Interface
 	namespaceClient            corev1.NamespaceInterface
+	podClient                  corev1.PodsGetter
+	configMapClient            corev1.ConfigMapInterface
 	actions                    []resolvedAction
 	volumeSnapshotterGetter    VolumeSnapshotterGetter
 	resticRestorer             restic.Restorer
@@ -353,6 +376,8 @@ type restoreContext struct {
 	waitExecHookHandler        hook.WaitExecHookHandler
 	hooksContext               go_context.Context
 	hooksCancelFunc            go_context.CancelFunc
+	readinessItems             []readinessItem
+	readinessPollInterval      time.Duration
 }
 
 type resourceClientKey struct {
@@ -573,6 +598,11 @@ func (ctx *restoreContext) execute() (Result, Result) {
 	}
 	ctx.log.Info("Done waiting for all post-restore exec hooks to complete")
 
+	if ctx.restore.Spec.WaitForReadiness != nil {
+		w := ctx.waitForReadiness()
+		warnings.Merge(&w)
+	}
+
 	return warnings, errs
 }
 
@@ -1236,6 +1266,26 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	// and which backup they came from.
 	addRestoreLabels(obj, ctx.restore.Name, ctx.restore.Spec.BackupName)
 
+	if groupResource == kuberesource.Pods && ctx.restore.Spec.PodVolumeRestoreMode == velerov1api.PodVolumeRestoreModeHelperPod {
+		restoring, err := ctx.restorePodVolumesWithHelperPod(obj, resourceClient, originalNamespace)
+		if err != nil {
+			ctx.log.WithError(err).Errorf("error restoring volumes of pod %s using a helper pod", kube.NamespaceAndName(obj))
+			errs.Add(namespace, err)
+		}
+		if restoring {
+			// the pod is created once its volumes have been restored.
+			if ctx.restore.Spec.WaitForReadiness != nil {
+				ctx.readinessItems = append(ctx.readinessItems, readinessItem{
+					groupResource: groupResource,
+					namespace:     namespace,
+					name:          name,
+					client:        resourceClient,
+				})
+			}
+			return warnings, errs
+		}
+	}
+
 	ctx.log.Infof("Attempting to restore %s: %v", obj.GroupVersionKind().Kind, name)
 	createdObj, restoreErr := resourceClient.Create(obj)
 	if apierrors.IsAlreadyExists(restoreErr) {
@@ -1321,6 +1371,15 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		ctx.waitExec(createdObj)
 	}
 
+	if ctx.restore.Spec.WaitForReadiness != nil {
+		ctx.readinessItems = append(ctx.readinessItems, readinessItem{
+			groupResource: groupResource,
+			namespace:     namespace,
+			name:          name,
+			client:        resourceClient,
+		})
+	}
+
 	// Wait for a CRD to be available for instantiating resources
 	// before continuing.
 	if groupResource == kuberesource.CustomResourceDefinitions {
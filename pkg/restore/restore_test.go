@@ -543,6 +543,7 @@ func TestRestoreResourceFiltering(t *testing.T) {
 				BackupReader:     tc.tarball,
 			}
 			warnings, errs := h.restorer.Restore(
+				context.Background(),
 				data,
 				nil, // actions
 				nil, // snapshot location lister
@@ -624,6 +625,7 @@ func TestRestoreNamespaceMapping(t *testing.T) {
 				BackupReader:     tc.tarball,
 			}
 			warnings, errs := h.restorer.Restore(
+				context.Background(),
 				data,
 				nil, // actions
 				nil, // snapshot location lister
@@ -706,6 +708,7 @@ func TestRestoreResourcePriorities(t *testing.T) {
 			BackupReader:     tc.tarball,
 		}
 		warnings, errs := h.restorer.Restore(
+			context.Background(),
 			data,
 			nil, // actions
 			nil, // snapshot location lister
@@ -783,6 +786,7 @@ func TestInvalidTarballContents(t *testing.T) {
 				BackupReader:     tc.tarball,
 			}
 			warnings, errs := h.restorer.Restore(
+				context.Background(),
 				data,
 				nil, // actions
 				nil, // snapshot location lister
@@ -998,6 +1002,7 @@ func TestRestoreItems(t *testing.T) {
 				BackupReader:     tc.tarball,
 			}
 			warnings, errs := h.restorer.Restore(
+				context.Background(),
 				data,
 				nil, // actions
 				nil, // snapshot location lister
@@ -1208,6 +1213,7 @@ func TestRestoreActionsRunForCorrectItems(t *testing.T) {
 				BackupReader:     tc.tarball,
 			}
 			warnings, errs := h.restorer.Restore(
+				context.Background(),
 				data,
 				actions,
 				nil, // snapshot location lister
@@ -1347,6 +1353,7 @@ func TestRestoreActionModifications(t *testing.T) {
 				BackupReader:     tc.tarball,
 			}
 			warnings, errs := h.restorer.Restore(
+				context.Background(),
 				data,
 				tc.actions,
 				nil, // snapshot location lister
@@ -1514,6 +1521,7 @@ func TestRestoreActionAdditionalItems(t *testing.T) {
 				BackupReader:     tc.tarball,
 			}
 			warnings, errs := h.restorer.Restore(
+				context.Background(),
 				data,
 				tc.actions,
 				nil, // snapshot location lister
@@ -2508,6 +2516,7 @@ func TestRestorePersistentVolumes(t *testing.T) {
 				BackupReader:    tc.tarball,
 			}
 			warnings, errs := h.restorer.Restore(
+				context.Background(),
 				data,
 				nil, // actions
 				vslInformer.Lister(),
@@ -2644,6 +2653,7 @@ func TestRestoreWithRestic(t *testing.T) {
 			}
 
 			warnings, errs := h.restorer.Restore(
+				context.Background(),
 				data,
 				nil, // actions
 				nil, // snapshot location lister
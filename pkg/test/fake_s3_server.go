@@ -0,0 +1,491 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	fakeS3Namespace = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+	// fakeS3TimeFormat is the format of the times in S3 headers.
+	fakeS3TimeFormat = "2006-01-02T15:04:05.999999999Z"
+)
+
+// FakeS3Server is an in-process HTTP server implementing the subset of the S3 API
+// that velero uses: getting, putting, heading and deleting objects, multipart
+// uploads, listing objects with ListObjectsV2, and locking objects with S3 Object
+// Lock. Buckets are addressed path-style, so clients must be configured with
+// s3ForcePathStyle. Requests, including presigned ones, aren't authenticated.
+type FakeS3Server struct {
+	*httptest.Server
+
+	// Region is returned as the region of every bucket.
+	Region string
+
+	// MaxKeys is the maximum number of keys returned by a single list request.
+	MaxKeys int
+
+	mu                        sync.Mutex
+	buckets                   map[string]map[string][]byte
+	locks                     map[string]FakeS3ObjectLock
+	uploads                   map[string]*fakeS3MultipartUpload
+	nextUploadID              int
+	completedMultipartUploads int
+}
+
+// FakeS3ObjectLock is the S3 Object Lock of an object in a FakeS3Server. Locked
+// objects can't be deleted.
+type FakeS3ObjectLock struct {
+	// Mode is the object's retention mode, GOVERNANCE or COMPLIANCE.
+	Mode string
+
+	// RetainUntil is the time until which the object is retained.
+	RetainUntil time.Time
+
+	// LegalHold is whether a legal hold is placed on the object.
+	LegalHold bool
+}
+
+func (l FakeS3ObjectLock) locked() bool {
+	return l.LegalHold || l.RetainUntil.After(time.Now())
+}
+
+type fakeS3MultipartUpload struct {
+	bucket string
+	key    string
+	lock   FakeS3ObjectLock
+	parts  map[int][]byte
+}
+
+// NewFakeS3Server starts a FakeS3Server with the given empty buckets. Callers
+// should call Close when finished, to shut it down.
+func NewFakeS3Server(buckets ...string) *FakeS3Server {
+	s := &FakeS3Server{
+		Region:  "us-east-1",
+		MaxKeys: 1000,
+		buckets: make(map[string]map[string][]byte),
+		locks:   make(map[string]FakeS3ObjectLock),
+		uploads: make(map[string]*fakeS3MultipartUpload),
+	}
+	for _, bucket := range buckets {
+		s.buckets[bucket] = make(map[string][]byte)
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Objects returns a copy of the objects in the bucket, by key.
+func (s *FakeS3Server) Objects(bucket string) map[string][]byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	objects := make(map[string][]byte, len(s.buckets[bucket]))
+	for key, data := range s.buckets[bucket] {
+		objects[key] = data
+	}
+	return objects
+}
+
+// PutObject stores an object in the bucket, creating the bucket if it doesn't exist.
+func (s *FakeS3Server) PutObject(bucket, key string, data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.buckets[bucket] == nil {
+		s.buckets[bucket] = make(map[string][]byte)
+	}
+	s.buckets[bucket][key] = data
+}
+
+// ObjectLock returns the lock of the object.
+func (s *FakeS3Server) ObjectLock(bucket, key string) FakeS3ObjectLock {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.locks[bucket+"/"+key]
+}
+
+// SetObjectLock sets the lock of the object.
+func (s *FakeS3Server) SetObjectLock(bucket, key string, lock FakeS3ObjectLock) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.locks[bucket+"/"+key] = lock
+}
+
+// CompletedMultipartUploads returns the number of multipart uploads that have
+// been completed.
+func (s *FakeS3Server) CompletedMultipartUploads() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.completedMultipartUploads
+}
+
+// CredentialsFile returns the contents of an AWS shared credentials file that
+// clients of the server can use.
+func (s *FakeS3Server) CredentialsFile() string {
+	return "[default]\naws_access_key_id = velero\naws_secret_access_key = velero-secret\n"
+}
+
+func (s *FakeS3Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	bucket := parts[0]
+	objects, ok := s.buckets[bucket]
+	if !ok {
+		writeFakeS3Error(w, r, http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist")
+		return
+	}
+
+	query := r.URL.Query()
+	if len(parts) == 1 || parts[1] == "" {
+		switch r.Method {
+		case http.MethodHead:
+			w.Header().Set("X-Amz-Bucket-Region", s.Region)
+		case http.MethodGet:
+			if hasQueryKey(query, "location") {
+				writeFakeS3XML(w, struct {
+					XMLName xml.Name `xml:"LocationConstraint"`
+					Region  string   `xml:",chardata"`
+				}{Region: s.Region})
+				return
+			}
+			s.listObjects(w, bucket, objects, query.Get("prefix"), query.Get("delimiter"), query.Get("continuation-token"))
+		default:
+			writeFakeS3Error(w, r, http.StatusMethodNotAllowed, "MethodNotAllowed", "The specified method is not allowed")
+		}
+		return
+	}
+	key := parts[1]
+
+	switch {
+	case r.Method == http.MethodPost && query.Get("uploadId") == "" && hasQueryKey(query, "uploads"):
+		lock, err := parseFakeS3ObjectLock(r)
+		if err != nil {
+			writeFakeS3Error(w, r, http.StatusBadRequest, "InvalidArgument", err.Error())
+			return
+		}
+
+		s.nextUploadID++
+		uploadID := strconv.Itoa(s.nextUploadID)
+		s.uploads[uploadID] = &fakeS3MultipartUpload{bucket: bucket, key: key, lock: lock, parts: make(map[int][]byte)}
+
+		writeFakeS3XML(w, struct {
+			XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+			Xmlns    string   `xml:"xmlns,attr"`
+			Bucket   string
+			Key      string
+			UploadID string `xml:"UploadId"`
+		}{Xmlns: fakeS3Namespace, Bucket: bucket, Key: key, UploadID: uploadID})
+
+	case r.Method == http.MethodPut && query.Get("uploadId") != "":
+		upload, ok := s.uploads[query.Get("uploadId")]
+		if !ok {
+			writeFakeS3Error(w, r, http.StatusNotFound, "NoSuchUpload", "The specified upload does not exist")
+			return
+		}
+		partNumber, err := strconv.Atoi(query.Get("partNumber"))
+		if err != nil {
+			writeFakeS3Error(w, r, http.StatusBadRequest, "InvalidArgument", "Invalid part number")
+			return
+		}
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeFakeS3Error(w, r, http.StatusInternalServerError, "InternalError", err.Error())
+			return
+		}
+		if !checkFakeS3ContentMD5(w, r, data, upload.lock) {
+			return
+		}
+		upload.parts[partNumber] = data
+		w.Header().Set("ETag", fakeS3ETag(data))
+
+	case r.Method == http.MethodPost && query.Get("uploadId") != "":
+		upload, ok := s.uploads[query.Get("uploadId")]
+		if !ok {
+			writeFakeS3Error(w, r, http.StatusNotFound, "NoSuchUpload", "The specified upload does not exist")
+			return
+		}
+		var complete struct {
+			Parts []struct {
+				PartNumber int
+			} `xml:"Part"`
+		}
+		if err := xml.NewDecoder(r.Body).Decode(&complete); err != nil {
+			writeFakeS3Error(w, r, http.StatusBadRequest, "MalformedXML", err.Error())
+			return
+		}
+		var data []byte
+		for _, part := range complete.Parts {
+			partData, ok := upload.parts[part.PartNumber]
+			if !ok {
+				writeFakeS3Error(w, r, http.StatusBadRequest, "InvalidPart", fmt.Sprintf("Part %d was not uploaded", part.PartNumber))
+				return
+			}
+			data = append(data, partData...)
+		}
+		if s.locks[bucket+"/"+upload.key].locked() {
+			writeFakeS3Error(w, r, http.StatusForbidden, "AccessDenied", "Access Denied")
+			return
+		}
+		objects[upload.key] = data
+		s.locks[bucket+"/"+upload.key] = upload.lock
+		delete(s.uploads, query.Get("uploadId"))
+		s.completedMultipartUploads++
+
+		writeFakeS3XML(w, struct {
+			XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+			Xmlns   string   `xml:"xmlns,attr"`
+			Bucket  string
+			Key     string
+			ETag    string
+		}{Xmlns: fakeS3Namespace, Bucket: bucket, Key: key, ETag: fakeS3ETag(data)})
+
+	case r.Method == http.MethodDelete && query.Get("uploadId") != "":
+		delete(s.uploads, query.Get("uploadId"))
+		w.WriteHeader(http.StatusNoContent)
+
+	case r.Method == http.MethodPut:
+		lock, err := parseFakeS3ObjectLock(r)
+		if err != nil {
+			writeFakeS3Error(w, r, http.StatusBadRequest, "InvalidArgument", err.Error())
+			return
+		}
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeFakeS3Error(w, r, http.StatusInternalServerError, "InternalError", err.Error())
+			return
+		}
+		if !checkFakeS3ContentMD5(w, r, data, lock) {
+			return
+		}
+		if s.locks[bucket+"/"+key].locked() {
+			writeFakeS3Error(w, r, http.StatusForbidden, "AccessDenied", "Access Denied")
+			return
+		}
+		objects[key] = data
+		s.locks[bucket+"/"+key] = lock
+		w.Header().Set("ETag", fakeS3ETag(data))
+
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		data, ok := objects[key]
+		if !ok {
+			writeFakeS3Error(w, r, http.StatusNotFound, "NoSuchKey", "The specified key does not exist.")
+			return
+		}
+		w.Header().Set("ETag", fakeS3ETag(data))
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		lock := s.locks[bucket+"/"+key]
+		if lock.Mode != "" {
+			w.Header().Set("X-Amz-Object-Lock-Mode", lock.Mode)
+		}
+		if !lock.RetainUntil.IsZero() {
+			w.Header().Set("X-Amz-Object-Lock-Retain-Until-Date", lock.RetainUntil.UTC().Format(fakeS3TimeFormat))
+		}
+		if lock.LegalHold {
+			w.Header().Set("X-Amz-Object-Lock-Legal-Hold", "ON")
+		}
+		if r.Method == http.MethodGet {
+			w.Write(data)
+		}
+
+	case r.Method == http.MethodDelete:
+		if s.locks[bucket+"/"+key].locked() {
+			writeFakeS3Error(w, r, http.StatusForbidden, "AccessDenied", "Access Denied")
+			return
+		}
+		delete(objects, key)
+		delete(s.locks, bucket+"/"+key)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		writeFakeS3Error(w, r, http.StatusMethodNotAllowed, "MethodNotAllowed", "The specified method is not allowed")
+	}
+}
+
+func (s *FakeS3Server) listObjects(w http.ResponseWriter, bucket string, objects map[string][]byte, prefix, delimiter, continuationToken string) {
+	type object struct {
+		Key          string
+		LastModified string
+		ETag         string
+		Size         int
+	}
+	type commonPrefix struct {
+		Prefix string
+	}
+	result := struct {
+		XMLName               xml.Name `xml:"ListBucketResult"`
+		Xmlns                 string   `xml:"xmlns,attr"`
+		Name                  string
+		Prefix                string
+		Delimiter             string `xml:",omitempty"`
+		MaxKeys               int
+		KeyCount              int
+		IsTruncated           bool
+		ContinuationToken     string         `xml:",omitempty"`
+		NextContinuationToken string         `xml:",omitempty"`
+		Contents              []object       `xml:"Contents"`
+		CommonPrefixes        []commonPrefix `xml:"CommonPrefixes"`
+	}{
+		Xmlns:             fakeS3Namespace,
+		Name:              bucket,
+		Prefix:            prefix,
+		Delimiter:         delimiter,
+		MaxKeys:           s.MaxKeys,
+		ContinuationToken: continuationToken,
+	}
+
+	keys := make([]string, 0, len(objects))
+	for key := range objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	// the continuation token is the last key or common prefix of the previous page
+	seenPrefixes := make(map[string]bool)
+	for _, key := range keys {
+		entry := key
+		if delimiter != "" {
+			if i := strings.Index(key[len(prefix):], delimiter); i != -1 {
+				entry = key[:len(prefix)+i+len(delimiter)]
+			}
+		}
+		if entry <= continuationToken || seenPrefixes[entry] {
+			continue
+		}
+
+		if result.KeyCount == s.MaxKeys {
+			result.IsTruncated = true
+			break
+		}
+		result.KeyCount++
+		result.NextContinuationToken = entry
+
+		if entry != key {
+			seenPrefixes[entry] = true
+			result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix{Prefix: entry})
+			continue
+		}
+		result.Contents = append(result.Contents, object{
+			Key:          key,
+			LastModified: time.Now().UTC().Format(time.RFC3339),
+			ETag:         fakeS3ETag(objects[key]),
+			Size:         len(objects[key]),
+		})
+	}
+	if !result.IsTruncated {
+		result.NextContinuationToken = ""
+	}
+
+	writeFakeS3XML(w, result)
+}
+
+// parseFakeS3ObjectLock returns the object lock given by the headers of a
+// request that creates an object.
+func parseFakeS3ObjectLock(r *http.Request) (FakeS3ObjectLock, error) {
+	lock := FakeS3ObjectLock{
+		Mode:      r.Header.Get("X-Amz-Object-Lock-Mode"),
+		LegalHold: r.Header.Get("X-Amz-Object-Lock-Legal-Hold") == "ON",
+	}
+
+	if until := r.Header.Get("X-Amz-Object-Lock-Retain-Until-Date"); until != "" {
+		retainUntil, err := time.Parse(time.RFC3339Nano, until)
+		if err != nil {
+			return FakeS3ObjectLock{}, err
+		}
+		lock.RetainUntil = retainUntil
+	}
+
+	if (lock.Mode == "") != lock.RetainUntil.IsZero() {
+		return FakeS3ObjectLock{}, fmt.Errorf("x-amz-object-lock-mode and x-amz-object-lock-retain-until-date must both be specified")
+	}
+
+	return lock, nil
+}
+
+// checkFakeS3ContentMD5 checks that the request's Content-MD5 header is valid,
+// since it's required by uploads of locked objects. If it isn't, an error is
+// written and false is returned.
+func checkFakeS3ContentMD5(w http.ResponseWriter, r *http.Request, data []byte, lock FakeS3ObjectLock) bool {
+	contentMD5 := r.Header.Get("Content-MD5")
+	if contentMD5 == "" {
+		if lock.Mode != "" || lock.LegalHold {
+			writeFakeS3Error(w, r, http.StatusBadRequest, "InvalidRequest", "Content-MD5 HTTP header is required for Put Object requests with Object Lock parameters")
+			return false
+		}
+		return true
+	}
+
+	sum := md5.Sum(data)
+	if contentMD5 != base64.StdEncoding.EncodeToString(sum[:]) {
+		writeFakeS3Error(w, r, http.StatusBadRequest, "BadDigest", "The Content-MD5 you specified did not match what we received.")
+		return false
+	}
+	return true
+}
+
+func hasQueryKey(query map[string][]string, key string) bool {
+	_, ok := query[key]
+	return ok
+}
+
+func fakeS3ETag(data []byte) string {
+	sum := md5.Sum(data)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+func writeFakeS3XML(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.Write([]byte(xml.Header))
+	xml.NewEncoder(w).Encode(v)
+}
+
+func writeFakeS3Error(w http.ResponseWriter, r *http.Request, status int, code, message string) {
+	if r.Method == http.MethodHead {
+		w.WriteHeader(status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	w.Write([]byte(xml.Header))
+	xml.NewEncoder(w).Encode(struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string
+		Message string
+	}{Code: code, Message: message})
+}
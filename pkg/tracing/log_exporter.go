@@ -0,0 +1,80 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// logExporter exports spans as log entries.
+type logExporter struct {
+	lock    sync.Mutex
+	logger  logrus.FieldLogger
+	stopped bool
+}
+
+// NewLogExporter returns a span exporter that logs each span, with the IDs of its
+// trace, itself and its parent, its duration, status and attributes, to logger.
+func NewLogExporter(logger logrus.FieldLogger) sdktrace.SpanExporter {
+	return &logExporter{logger: logger}
+}
+
+func (e *logExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.stopped {
+		return nil
+	}
+
+	for _, span := range spans {
+		fields := logrus.Fields{
+			"traceID":  span.SpanContext().TraceID().String(),
+			"spanID":   span.SpanContext().SpanID().String(),
+			"span":     span.Name(),
+			"duration": span.EndTime().Sub(span.StartTime()).String(),
+		}
+		if span.Parent().IsValid() {
+			fields["parentSpanID"] = span.Parent().SpanID().String()
+		}
+		for _, attr := range span.Attributes() {
+			fields[string(attr.Key)] = attr.Value.AsInterface()
+		}
+
+		log := e.logger.WithFields(fields)
+		if status := span.Status(); status.Code == codes.Error {
+			log.WithField("error", status.Description).Info("Span ended with error")
+		} else {
+			log.Info("Span ended")
+		}
+	}
+
+	return nil
+}
+
+func (e *logExporter) Shutdown(ctx context.Context) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.stopped = true
+	return nil
+}
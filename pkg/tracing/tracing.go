@@ -0,0 +1,79 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing traces the backups and restores of the Velero server with
+// OpenTelemetry.
+package tracing
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/vmware-tanzu/velero"
+
+// Tracer returns the tracer of the Velero server's spans, from the global
+// tracer provider. Until one is set with otel.SetTracerProvider, its spans
+// aren't recorded.
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// NewTracerProvider returns a tracer provider that samples sampleRatio of the
+// traces the Velero server starts, and exports their spans with exporter.
+func NewTracerProvider(exporter sdktrace.SpanExporter, sampleRatio float64) *sdktrace.TracerProvider {
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(sdkresource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String("velero"))),
+	)
+}
+
+// TraceID returns the ID of the trace of the span of ctx, or an empty string if
+// the span isn't sampled.
+func TraceID(ctx context.Context) string {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsSampled() {
+		return ""
+	}
+	return spanContext.TraceID().String()
+}
+
+// WithTraceID returns log with a traceID field of the ID of the trace of the span
+// of ctx, so that the trace can be found from the log. log is returned as is if
+// the span isn't sampled.
+func WithTraceID(ctx context.Context, log logrus.FieldLogger) logrus.FieldLogger {
+	if traceID := TraceID(ctx); traceID != "" {
+		return log.WithField("traceID", traceID)
+	}
+	return log
+}
+
+// EndSpan ends span, with an error status if err isn't nil.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,72 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestLogExporter(t *testing.T) {
+	logger, hook := logtest.NewNullLogger()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(NewLogExporter(logger)))
+	tracer := provider.Tracer("test")
+
+	ctx, parent := tracer.Start(context.Background(), "Backup")
+	log := WithTraceID(ctx, logger)
+	_, child := tracer.Start(ctx, "BackUpItems")
+	child.SetAttributes(attribute.Int("items", 3))
+	EndSpan(child, errors.New("bad"))
+	EndSpan(parent, nil)
+
+	entries := hook.AllEntries()
+	require.Len(t, entries, 2)
+
+	traceID := parent.SpanContext().TraceID().String()
+	assert.Equal(t, logrus.Fields{"traceID": traceID}, log.(*logrus.Entry).Data)
+
+	assert.Equal(t, "Span ended with error", entries[0].Message)
+	assert.Equal(t, traceID, entries[0].Data["traceID"])
+	assert.Equal(t, child.SpanContext().SpanID().String(), entries[0].Data["spanID"])
+	assert.Equal(t, parent.SpanContext().SpanID().String(), entries[0].Data["parentSpanID"])
+	assert.Equal(t, "BackUpItems", entries[0].Data["span"])
+	assert.Equal(t, int64(3), entries[0].Data["items"])
+	assert.Equal(t, "bad", entries[0].Data["error"])
+
+	assert.Equal(t, "Span ended", entries[1].Message)
+	assert.Equal(t, "Backup", entries[1].Data["span"])
+	assert.NotContains(t, entries[1].Data, "parentSpanID")
+}
+
+func TestWithTraceIDOfUnsampledSpan(t *testing.T) {
+	logger, _ := logtest.NewNullLogger()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSampler(sdktrace.NeverSample()))
+
+	ctx, span := provider.Tracer("test").Start(context.Background(), "Backup")
+	defer span.End()
+
+	assert.Equal(t, "", TraceID(ctx))
+	assert.Equal(t, logger, WithTraceID(ctx, logger))
+}
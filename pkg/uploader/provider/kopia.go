@@ -0,0 +1,798 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/kopia/kopia/fs"
+	"github.com/kopia/kopia/fs/localfs"
+	"github.com/kopia/kopia/fs/virtualfs"
+	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/repo/blob"
+	"github.com/kopia/kopia/repo/blob/azure"
+	kopiafs "github.com/kopia/kopia/repo/blob/filesystem"
+	"github.com/kopia/kopia/repo/blob/gcs"
+	"github.com/kopia/kopia/repo/blob/s3"
+	"github.com/kopia/kopia/repo/blob/throttling"
+	"github.com/kopia/kopia/repo/content"
+	"github.com/kopia/kopia/repo/maintenance"
+	"github.com/kopia/kopia/repo/manifest"
+	"github.com/kopia/kopia/snapshot"
+	"github.com/kopia/kopia/snapshot/policy"
+	"github.com/kopia/kopia/snapshot/restore"
+	"github.com/kopia/kopia/snapshot/snapshotfs"
+	"github.com/kopia/kopia/snapshot/snapshotmaintenance"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+)
+
+const (
+	// kopiaSnapshotUser and kopiaSnapshotHost are the user and host of the
+	// sources of all snapshots. They're generic so that a snapshot doesn't
+	// depend on the node agent pod that took it.
+	kopiaSnapshotUser = "velero"
+	kopiaSnapshotHost = "velero"
+
+	// kopiaTagPrefix is the prefix Kopia expects on the labels of a snapshot's
+	// manifest that are user-defined tags.
+	kopiaTagPrefix = "tag:"
+
+	// kopiaLocalRepoDir is the directory, within a restic repository prefix
+	// that's a local path, containing the Kopia repositories. Namespace names
+	// can't start with a dot, so it can't clash with a restic repository.
+	kopiaLocalRepoDir = ".kopia"
+)
+
+// kopiaProgressInterval is how often the progress of a Kopia backup or
+// restore is reported. It's a variable so it can be changed in tests.
+var kopiaProgressInterval = 10 * time.Second
+
+// kopiaProvider is a Provider that uses the Kopia library to back up and
+// restore pod volumes in-process.
+type kopiaProvider struct {
+	repo      repo.Repository
+	configDir string
+	log       logrus.FieldLogger
+}
+
+func newKopiaUploaderProvider(
+	repoIdentifier string,
+	repoKeySecret string,
+	bsl *velerov1api.BackupStorageLocation,
+	transferSettings *velerov1api.PodVolumeTransferSettings,
+	credentialsFileStore credentials.FileStore,
+	log logrus.FieldLogger,
+) (Provider, error) {
+	provider, err := openKopiaRepository(context.Background(), repoIdentifier, repoKeySecret, bsl, transferSettings, credentialsFileStore, log)
+	if err != nil {
+		return nil, err
+	}
+	return provider, nil
+}
+
+// openKopiaRepository connects to the Kopia repository of the volume namespace
+// of the restic repository identified by repoIdentifier, initializing it if
+// it doesn't exist yet, and opens it.
+func openKopiaRepository(
+	ctx context.Context,
+	repoIdentifier string,
+	repoKeySecret string,
+	bsl *velerov1api.BackupStorageLocation,
+	transferSettings *velerov1api.PodVolumeTransferSettings,
+	credentialsFileStore credentials.FileStore,
+	log logrus.FieldLogger,
+) (*kopiaProvider, error) {
+	password, err := kopiaRepositoryPassword(repoKeySecret, credentialsFileStore)
+	if err != nil {
+		return nil, err
+	}
+
+	env, err := restic.CmdEnv(bsl, credentialsFileStore)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting the backup storage location's credentials")
+	}
+
+	st, err := newKopiaStorage(ctx, bsl, path.Base(repoIdentifier), envMap(env), kopiaThrottlingLimits(transferSettings))
+	if err != nil {
+		return nil, err
+	}
+	defer st.Close(ctx)
+
+	if err := repo.Initialize(ctx, st, &repo.NewRepositoryOptions{}, password); err != nil && !errors.Is(err, repo.ErrAlreadyInitialized) {
+		return nil, errors.Wrap(err, "error initializing kopia repository")
+	}
+
+	configDir, err := ioutil.TempDir("", "kopia-"+bsl.Name)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating kopia config directory")
+	}
+
+	provider := &kopiaProvider{
+		configDir: configDir,
+		log:       log,
+	}
+
+	configFile := filepath.Join(configDir, "repository.config")
+	connectOptions := &repo.ConnectOptions{
+		CachingOptions: content.CachingOptions{CacheDirectory: filepath.Join(configDir, "cache")},
+	}
+	if err := repo.Connect(ctx, configFile, st, password, connectOptions); err != nil {
+		provider.Close(ctx)
+		return nil, errors.Wrap(err, "error connecting to kopia repository")
+	}
+
+	provider.repo, err = repo.Open(ctx, configFile, password, &repo.Options{})
+	if err != nil {
+		provider.Close(ctx)
+		return nil, errors.Wrap(err, "error opening kopia repository")
+	}
+
+	return provider, nil
+}
+
+// DeleteKopiaSnapshot deletes the Kopia snapshot with the given ID from the
+// repository of the volume namespace of the restic repository identified by
+// repoIdentifier, then runs the repository's maintenance if it's due so that
+// the data no longer used by any snapshot is eventually removed.
+func DeleteKopiaSnapshot(
+	ctx context.Context,
+	snapshotID string,
+	repoIdentifier string,
+	repoKeySecret string,
+	bsl *velerov1api.BackupStorageLocation,
+	credentialsFileStore credentials.FileStore,
+	log logrus.FieldLogger,
+) error {
+	provider, err := openKopiaRepository(ctx, repoIdentifier, repoKeySecret, bsl, nil, credentialsFileStore, log)
+	if err != nil {
+		return err
+	}
+	defer provider.Close(ctx)
+
+	dr, ok := provider.repo.(repo.DirectRepository)
+	if !ok {
+		return errors.New("kopia repository doesn't support maintenance")
+	}
+
+	return repo.DirectWriteSession(ctx, dr, repo.WriteSessionOptions{Purpose: "velero delete snapshot"}, func(ctx context.Context, w repo.DirectRepositoryWriter) error {
+		if err := w.DeleteManifest(ctx, manifest.ID(snapshotID)); err != nil {
+			return errors.Wrapf(err, "error deleting kopia snapshot %s", snapshotID)
+		}
+
+		// maintenance is normally only run by the repository's owner, but the
+		// repository is shared by all the Velero servers using it.
+		if err := snapshotmaintenance.Run(ctx, w, maintenance.ModeAuto, true, maintenance.SafetyFull); err != nil {
+			log.WithError(err).Warn("Error running kopia repository maintenance")
+		}
+		return nil
+	})
+}
+
+func (p *kopiaProvider) RunBackup(ctx context.Context, path string, includedPaths, excludedPaths []string, tags map[string]string, parentSnapshot string, updater ProgressUpdater) (string, bool, error) {
+	if _, err := restic.ResolveIncludedPaths(path, includedPaths); err != nil {
+		return "", false, err
+	}
+
+	dir, err := localfs.Directory(path)
+	if err != nil {
+		return "", false, errors.Wrap(err, "error opening volume directory")
+	}
+
+	// Kopia's ignore rules follow the .gitignore syntax, in which patterns
+	// starting with "/" are relative to the volume's root like restic's.
+	override := &policy.Policy{
+		FilesPolicy: policy.FilesPolicy{IgnoreRules: excludedPaths},
+	}
+
+	man, err := p.upload(ctx, newIncludedPathsDirectory(dir, includedPaths), path, override, parentSnapshot, updater)
+	if err != nil {
+		return "", false, err
+	}
+
+	if summary := man.RootEntry.DirSummary; summary != nil && summary.TotalFileCount == 0 && summary.TotalSymlinkCount == 0 && summary.TotalDirCount <= 1 {
+		return "", true, nil
+	}
+
+	snapshotID, err := p.saveSnapshot(ctx, man, tags)
+	if err != nil {
+		return "", false, err
+	}
+	return snapshotID, false, nil
+}
+
+func (p *kopiaProvider) RunBlockBackup(ctx context.Context, devicePath string, tags map[string]string, parentSnapshot string, updater ProgressUpdater) (string, error) {
+	device, err := openDeviceFunc(devicePath, os.O_RDONLY, 0)
+	if err != nil {
+		return "", errors.Wrap(err, "error opening block device")
+	}
+	defer device.Close()
+
+	// the device is stored as a single file in the snapshot, like restic
+	// does, so that it can be found by name when it's restored.
+	dir := virtualfs.NewStaticDirectory(filepath.Base(devicePath), fs.Entries{
+		virtualfs.StreamingFileFromReader(restic.BlockVolumeFileName, device),
+	})
+
+	man, err := p.upload(ctx, dir, devicePath, nil, parentSnapshot, updater)
+	if err != nil {
+		return "", err
+	}
+
+	return p.saveSnapshot(ctx, man, tags)
+}
+
+// upload uploads the contents of root, which is read from sourcePath, and
+// returns the manifest of the resulting snapshot, which isn't saved yet.
+func (p *kopiaProvider) upload(ctx context.Context, root fs.Entry, sourcePath string, override *policy.Policy, parentSnapshot string, updater ProgressUpdater) (*snapshot.Manifest, error) {
+	source := snapshot.SourceInfo{
+		Host:     kopiaSnapshotHost,
+		UserName: kopiaSnapshotUser,
+		Path:     sourcePath,
+	}
+
+	policyTree, err := policy.TreeForSourceWithOverride(ctx, p.repo, source, override)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting kopia snapshot policy")
+	}
+
+	var previous []*snapshot.Manifest
+	if parentSnapshot != "" {
+		parent, err := snapshot.LoadSnapshot(ctx, p.repo, manifest.ID(parentSnapshot))
+		if err != nil {
+			// the backup is still correct without a parent, it just has to
+			// read all the data again.
+			p.log.WithError(err).WithField("parentSnapshotID", parentSnapshot).Warn("Error loading parent snapshot, backing up all the data")
+		} else {
+			previous = append(previous, parent)
+		}
+	}
+
+	var man *snapshot.Manifest
+	err = repo.WriteSession(ctx, p.repo, repo.WriteSessionOptions{Purpose: "velero backup"}, func(ctx context.Context, w repo.RepositoryWriter) error {
+		progress := &kopiaUploadProgress{reporter: newKopiaProgressReporter(updater)}
+		uploader := snapshotfs.NewUploader(w)
+		uploader.Progress = progress
+
+		stop := cancelOnDone(ctx, uploader.Cancel)
+		defer stop()
+
+		progress.reporter.start()
+		man, err = uploader.Upload(ctx, root, policyTree, source, previous...)
+		progress.reporter.stop()
+		if err != nil {
+			return errors.Wrap(err, "error uploading kopia snapshot")
+		}
+
+		if man.IncompleteReason != "" {
+			return errors.Errorf("kopia snapshot is incomplete: %s", man.IncompleteReason)
+		}
+		if summary := man.RootEntry.DirSummary; summary != nil && summary.FatalErrorCount > 0 {
+			var failed []string
+			for _, entry := range summary.FailedEntries {
+				failed = append(failed, entry.EntryPath+": "+entry.Error)
+			}
+			return errors.Errorf("error uploading %d entries of kopia snapshot, including: %s", summary.FatalErrorCount, strings.Join(failed, "; "))
+		}
+
+		progress.reporter.finish(man.Stats.TotalFileSize)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return man, nil
+}
+
+// saveSnapshot saves the manifest of a snapshot tagged with tags, and returns
+// the snapshot's ID.
+func (p *kopiaProvider) saveSnapshot(ctx context.Context, man *snapshot.Manifest, tags map[string]string) (string, error) {
+	man.Tags = make(map[string]string, len(tags))
+	for k, v := range tags {
+		man.Tags[kopiaTagPrefix+k] = v
+	}
+
+	var snapshotID manifest.ID
+	err := repo.WriteSession(ctx, p.repo, repo.WriteSessionOptions{Purpose: "velero backup"}, func(ctx context.Context, w repo.RepositoryWriter) error {
+		var err error
+		snapshotID, err = snapshot.SaveSnapshot(ctx, w, man)
+		return errors.Wrap(err, "error saving kopia snapshot")
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(snapshotID), nil
+}
+
+func (p *kopiaProvider) RunRestore(ctx context.Context, snapshotID, volumePath string, includedPaths []string, updater ProgressUpdater) error {
+	man, root, err := p.snapshotRoot(ctx, snapshotID)
+	if err != nil {
+		return err
+	}
+
+	reporter := newKopiaProgressReporter(updater)
+	if summary := man.RootEntry.DirSummary; summary != nil {
+		reporter.setTotal(summary.TotalFileSize)
+	}
+
+	cancel := make(chan struct{})
+	stop := cancelOnDone(ctx, func() { close(cancel) })
+	defer stop()
+
+	output := &restore.FilesystemOutput{
+		TargetPath:           volumePath,
+		OverwriteDirectories: true,
+		OverwriteFiles:       true,
+		OverwriteSymlinks:    true,
+	}
+
+	reporter.start()
+	stats, err := restore.Entry(ctx, p.repo, output, newIncludedPathsDirectory(root, includedPaths), restore.Options{
+		// restore all the contents of the snapshot instead of placeholders.
+		RestoreDirEntryAtDepth: math.MaxInt32,
+		ProgressCallback: func(ctx context.Context, stats restore.Stats) {
+			reporter.setDone(stats.RestoredTotalFileSize)
+		},
+		Cancel: cancel,
+	})
+	reporter.stop()
+	if err != nil {
+		return errors.Wrap(err, "error restoring kopia snapshot")
+	}
+	if ctx.Err() != nil {
+		return errors.Wrap(ctx.Err(), "error restoring kopia snapshot")
+	}
+
+	reporter.finish(stats.RestoredTotalFileSize)
+	return nil
+}
+
+func (p *kopiaProvider) RunBlockRestore(ctx context.Context, snapshotID, devicePath string, updater ProgressUpdater) error {
+	_, root, err := p.snapshotRoot(ctx, snapshotID)
+	if err != nil {
+		return err
+	}
+
+	entry, err := root.Child(ctx, restic.BlockVolumeFileName)
+	if err != nil {
+		return errors.Wrap(err, "error finding block device contents in kopia snapshot")
+	}
+	file, ok := entry.(fs.File)
+	if !ok {
+		return errors.Errorf("block device contents in kopia snapshot %s aren't a file", snapshotID)
+	}
+
+	reader, err := file.Open(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error reading block device contents from kopia snapshot")
+	}
+	defer reader.Close()
+
+	device, err := openDeviceFunc(devicePath, os.O_WRONLY, 0)
+	if err != nil {
+		return errors.Wrap(err, "error opening block device")
+	}
+	defer device.Close()
+
+	reporter := newKopiaProgressReporter(updater)
+	reporter.setTotal(file.Size())
+
+	reporter.start()
+	n, err := io.Copy(device, &kopiaProgressReader{ctx: ctx, reader: reader, reporter: reporter})
+	reporter.stop()
+	if err != nil {
+		return errors.Wrap(err, "error writing block device contents")
+	}
+
+	// make sure the data is on the device before the volume is used.
+	if err := device.Sync(); err != nil {
+		return errors.Wrap(err, "error syncing block device")
+	}
+
+	reporter.finish(n)
+	return nil
+}
+
+// snapshotRoot returns the manifest and the root directory of the snapshot
+// with the given ID.
+func (p *kopiaProvider) snapshotRoot(ctx context.Context, snapshotID string) (*snapshot.Manifest, fs.Directory, error) {
+	man, err := snapshot.LoadSnapshot(ctx, p.repo, manifest.ID(snapshotID))
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "error loading kopia snapshot %s", snapshotID)
+	}
+
+	root, err := snapshotfs.SnapshotRoot(p.repo, man)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "error opening kopia snapshot %s", snapshotID)
+	}
+
+	dir, ok := root.(fs.Directory)
+	if !ok {
+		return nil, nil, errors.Errorf("root of kopia snapshot %s isn't a directory", snapshotID)
+	}
+	return man, dir, nil
+}
+
+func (p *kopiaProvider) Close(ctx context.Context) error {
+	if p.repo != nil {
+		if err := p.repo.Close(ctx); err != nil {
+			p.log.WithError(err).Warn("Error closing kopia repository")
+		}
+	}
+	// ignore errors since there's nothing we can do and they're temp files.
+	os.RemoveAll(p.configDir)
+	return nil
+}
+
+// kopiaRepositoryPassword returns the password of the repository, which is
+// the same as restic's.
+func kopiaRepositoryPassword(repoKeySecret string, credentialsFileStore credentials.FileStore) (string, error) {
+	file, err := credentialsFileStore.Path(restic.RepoKeySelector(repoKeySecret))
+	if err != nil {
+		return "", errors.Wrap(err, "error getting repository password")
+	}
+
+	password, err := ioutil.ReadFile(file)
+	if err != nil {
+		return "", errors.Wrap(err, "error reading repository password")
+	}
+
+	return strings.TrimRight(string(password), "\r\n"), nil
+}
+
+// newKopiaStorage returns the storage of the Kopia repository of the given
+// volume namespace. In object storage, it's in the kopia directory next to
+// the restic one. Other providers need a restic repository prefix that's a
+// local path, like a mounted volume, and it's in a directory within it.
+func newKopiaStorage(ctx context.Context, bsl *velerov1api.BackupStorageLocation, volumeNamespace string, env map[string]string, limits throttling.Limits) (blob.Storage, error) {
+	var bucket, prefix string
+	if bsl.Spec.ObjectStorage != nil {
+		bucket = bsl.Spec.ObjectStorage.Bucket
+		prefix = path.Join(persistence.NewObjectStoreLayout(bsl.Spec.ObjectStorage.Prefix).GetKopiaDir(), volumeNamespace) + "/"
+	}
+	config := bsl.Spec.Config
+
+	switch restic.GetBackendType(bsl.Spec.Provider) {
+	case restic.AWSBackend, restic.S3Backend:
+		opts := &s3.Options{
+			BucketName:     bucket,
+			Prefix:         prefix,
+			Endpoint:       "s3.amazonaws.com",
+			Region:         config["region"],
+			DoNotVerifyTLS: config["insecureSkipTLSVerify"] == "true",
+			Limits:         limits,
+		}
+		if s3URL := config["s3Url"]; s3URL != "" {
+			u, err := url.Parse(s3URL)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid s3Url %q", s3URL)
+			}
+			opts.Endpoint = u.Host
+			opts.DoNotUseTLS = u.Scheme == "http"
+		}
+
+		sess, err := session.NewSessionWithOptions(session.Options{
+			Profile:           env["AWS_PROFILE"],
+			SharedConfigFiles: sharedConfigFiles(env["AWS_SHARED_CREDENTIALS_FILE"]),
+			SharedConfigState: session.SharedConfigEnable,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "error getting AWS credentials")
+		}
+		creds, err := sess.Config.Credentials.Get()
+		if err != nil {
+			return nil, errors.Wrap(err, "error getting AWS credentials")
+		}
+		opts.AccessKeyID = creds.AccessKeyID
+		opts.SecretAccessKey = creds.SecretAccessKey
+		opts.SessionToken = creds.SessionToken
+
+		st, err := s3.New(ctx, opts)
+		return st, errors.Wrap(err, "error connecting to kopia repository storage")
+	case restic.AzureBackend:
+		st, err := azure.New(ctx, &azure.Options{
+			Container:      bucket,
+			Prefix:         prefix,
+			StorageAccount: env["AZURE_ACCOUNT_NAME"],
+			StorageKey:     env["AZURE_ACCOUNT_KEY"],
+			Limits:         limits,
+		})
+		return st, errors.Wrap(err, "error connecting to kopia repository storage")
+	case restic.GCPBackend:
+		st, err := gcs.New(ctx, &gcs.Options{
+			BucketName:                    bucket,
+			Prefix:                        prefix,
+			ServiceAccountCredentialsFile: env["GOOGLE_APPLICATION_CREDENTIALS"],
+			Limits:                        limits,
+		})
+		return st, errors.Wrap(err, "error connecting to kopia repository storage")
+	}
+
+	repoPrefix := config["resticRepoPrefix"]
+	if !filepath.IsAbs(repoPrefix) {
+		return nil, errors.Errorf("the kopia uploader needs a restic repository prefix (resticRepoPrefix) that's a local path for provider %q", bsl.Spec.Provider)
+	}
+
+	st, err := kopiafs.New(ctx, &kopiafs.Options{
+		Path:   filepath.Join(repoPrefix, kopiaLocalRepoDir, volumeNamespace),
+		Limits: limits,
+	}, true)
+	return st, errors.Wrap(err, "error opening kopia repository storage")
+}
+
+// sharedConfigFiles returns the AWS shared config files to read the
+// credentials from, or nil to use the default ones.
+func sharedConfigFiles(credentialsFile string) []string {
+	if credentialsFile == "" {
+		return nil
+	}
+	return []string{credentialsFile}
+}
+
+// envMap returns the variables of the given environment, in which a variable
+// overrides earlier ones with the same name, as a map.
+func envMap(env []string) map[string]string {
+	vars := make(map[string]string, len(env))
+	for _, v := range env {
+		if i := strings.Index(v, "="); i > 0 {
+			vars[v[:i]] = v[i+1:]
+		}
+	}
+	return vars
+}
+
+// kopiaThrottlingLimits returns the limits of Kopia's transfers for the given
+// transfer settings. Nice and IOPriorityClass only apply to the restic binary.
+func kopiaThrottlingLimits(transferSettings *velerov1api.PodVolumeTransferSettings) throttling.Limits {
+	var limits throttling.Limits
+	if transferSettings == nil {
+		return limits
+	}
+	if transferSettings.UploadLimitKiBps > 0 {
+		limits.UploadBytesPerSecond = float64(transferSettings.UploadLimitKiBps) * 1024
+	}
+	if transferSettings.DownloadLimitKiBps > 0 {
+		limits.DownloadBytesPerSecond = float64(transferSettings.DownloadLimitKiBps) * 1024
+	}
+	return limits
+}
+
+// cancelOnDone calls cancel if ctx is done before the returned function is
+// called.
+func cancelOnDone(ctx context.Context, cancel func()) func() {
+	stop := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			cancel()
+		case <-stop:
+		}
+	}()
+	return func() { close(stop) }
+}
+
+// includedPathsDirectory is a directory that only contains the entries whose
+// paths, relative to the root directory, match the patterns of the included
+// paths, and the directories leading to them.
+type includedPathsDirectory struct {
+	fs.Directory
+	relativePath string
+	patterns     [][]string
+}
+
+// newIncludedPathsDirectory returns dir filtered by the given glob patterns of
+// the included paths, or dir itself if there are none.
+func newIncludedPathsDirectory(dir fs.Directory, includedPaths []string) fs.Directory {
+	if len(includedPaths) == 0 {
+		return dir
+	}
+
+	var patterns [][]string
+	for _, includedPath := range includedPaths {
+		pattern := strings.Trim(path.Clean("/"+includedPath), "/")
+		if pattern == "" {
+			// the whole directory is included.
+			return dir
+		}
+		patterns = append(patterns, strings.Split(pattern, "/"))
+	}
+
+	return &includedPathsDirectory{Directory: dir, patterns: patterns}
+}
+
+func (d *includedPathsDirectory) Child(ctx context.Context, name string) (fs.Entry, error) {
+	entry, err := d.Directory.Child(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, ok := d.filter(entry)
+	if !ok {
+		return nil, fs.ErrEntryNotFound
+	}
+	return entry, nil
+}
+
+func (d *includedPathsDirectory) Readdir(ctx context.Context) (fs.Entries, error) {
+	entries, err := d.Directory.Readdir(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var included fs.Entries
+	for _, entry := range entries {
+		if entry, ok := d.filter(entry); ok {
+			included = append(included, entry)
+		}
+	}
+	return included, nil
+}
+
+// filter returns the entry to use for the given entry of the directory, and
+// false if it's not included.
+func (d *includedPathsDirectory) filter(entry fs.Entry) (fs.Entry, bool) {
+	relativePath := path.Join(d.relativePath, entry.Name())
+	elements := strings.Split(relativePath, "/")
+
+	leadsToMatch := false
+	for _, pattern := range d.patterns {
+		if !matchPathElements(pattern, elements) {
+			continue
+		}
+		if len(elements) >= len(pattern) {
+			// everything within an included path is included.
+			return entry, true
+		}
+		leadsToMatch = true
+	}
+
+	if dir, ok := entry.(fs.Directory); ok && leadsToMatch {
+		return &includedPathsDirectory{Directory: dir, relativePath: relativePath, patterns: d.patterns}, true
+	}
+	return nil, false
+}
+
+// matchPathElements returns whether the leading elements of a path and of a
+// pattern, up to the shorter of the two, match.
+func matchPathElements(pattern, elements []string) bool {
+	for i := 0; i < len(pattern) && i < len(elements); i++ {
+		if ok, err := path.Match(pattern[i], elements[i]); err != nil || !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// kopiaProgressReporter reports the progress of a backup or restore to a
+// ProgressUpdater every kopiaProgressInterval.
+type kopiaProgressReporter struct {
+	updater    ProgressUpdater
+	totalBytes int64
+	bytesDone  int64
+	quit       chan struct{}
+	wg         sync.WaitGroup
+}
+
+func newKopiaProgressReporter(updater ProgressUpdater) *kopiaProgressReporter {
+	return &kopiaProgressReporter{
+		updater: updater,
+		quit:    make(chan struct{}),
+	}
+}
+
+func (r *kopiaProgressReporter) setTotal(bytes int64) {
+	atomic.StoreInt64(&r.totalBytes, bytes)
+}
+
+func (r *kopiaProgressReporter) setDone(bytes int64) {
+	atomic.StoreInt64(&r.bytesDone, bytes)
+}
+
+func (r *kopiaProgressReporter) addDone(bytes int64) {
+	atomic.AddInt64(&r.bytesDone, bytes)
+}
+
+func (r *kopiaProgressReporter) start() {
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(kopiaProgressInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if bytesDone := atomic.LoadInt64(&r.bytesDone); bytesDone != 0 {
+					r.updater(velerov1api.PodVolumeOperationProgress{
+						TotalBytes: atomic.LoadInt64(&r.totalBytes),
+						BytesDone:  bytesDone,
+					})
+				}
+			case <-r.quit:
+				return
+			}
+		}
+	}()
+}
+
+func (r *kopiaProgressReporter) stop() {
+	close(r.quit)
+	r.wg.Wait()
+}
+
+// finish reports that all the given bytes are done.
+func (r *kopiaProgressReporter) finish(bytes int64) {
+	r.updater(velerov1api.PodVolumeOperationProgress{
+		TotalBytes: bytes,
+		BytesDone:  bytes,
+	})
+}
+
+// kopiaUploadProgress counts the bytes of a Kopia backup that are done,
+// either read or found unchanged since the parent snapshot.
+type kopiaUploadProgress struct {
+	snapshotfs.NullUploadProgress
+	reporter *kopiaProgressReporter
+}
+
+func (p *kopiaUploadProgress) EstimatedDataSize(fileCount int, totalBytes int64) {
+	p.reporter.setTotal(totalBytes)
+}
+
+func (p *kopiaUploadProgress) HashedBytes(numBytes int64) {
+	p.reporter.addDone(numBytes)
+}
+
+func (p *kopiaUploadProgress) CachedFile(fname string, numBytes int64) {
+	p.reporter.addDone(numBytes)
+}
+
+// kopiaProgressReader counts the bytes read from a Kopia snapshot, and stops
+// reading when its context is done.
+type kopiaProgressReader struct {
+	ctx      context.Context
+	reader   io.Reader
+	reporter *kopiaProgressReporter
+}
+
+func (r *kopiaProgressReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	n, err := r.reader.Read(p)
+	r.reporter.addDone(int64(n))
+	return n, err
+}
@@ -0,0 +1,309 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/kopia/kopia/repo/manifest"
+	"github.com/kopia/kopia/snapshot"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+)
+
+// kopiaTestRepo is a Kopia repository in a local filesystem directory.
+type kopiaTestRepo struct {
+	bsl                  *velerov1api.BackupStorageLocation
+	repoIdentifier       string
+	credentialsFileStore velerotest.FileStore
+}
+
+func newKopiaTestRepo(t *testing.T) *kopiaTestRepo {
+	repoPrefix := t.TempDir()
+
+	passwordFile := filepath.Join(t.TempDir(), "password")
+	require.NoError(t, ioutil.WriteFile(passwordFile, []byte("static-passw0rd"), 0600))
+
+	bsl := builder.ForBackupStorageLocation("velero", "default").Provider("local").Result()
+	bsl.Spec.Config = map[string]string{"resticRepoPrefix": repoPrefix}
+
+	return &kopiaTestRepo{
+		bsl:                  bsl,
+		repoIdentifier:       repoPrefix + "/ns-1",
+		credentialsFileStore: velerotest.NewFakeCredentialsFileStore(passwordFile, nil),
+	}
+}
+
+func (r *kopiaTestRepo) provider(t *testing.T) Provider {
+	prov, err := NewUploaderProvider(uploader.KopiaType, r.repoIdentifier, "", r.bsl, nil, r.credentialsFileStore, velerotest.NewFakeFileSystem(), velerotest.NewLogger())
+	require.NoError(t, err)
+	t.Cleanup(func() { prov.Close(context.Background()) })
+	return prov
+}
+
+// writeFiles writes files with the given contents, by path relative to dir.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	for name, contents := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0644))
+	}
+}
+
+// readFiles returns the contents of the files within dir, by path relative
+// to it.
+func readFiles(t *testing.T, dir string) map[string]string {
+	files := map[string]string{}
+	require.NoError(t, filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = string(contents)
+		return nil
+	}))
+	return files
+}
+
+func TestNewUploaderProviderKopia(t *testing.T) {
+	prov := newKopiaTestRepo(t).provider(t)
+	assert.IsType(t, &kopiaProvider{}, prov)
+}
+
+func TestKopiaRunBackupAndRestore(t *testing.T) {
+	repo := newKopiaTestRepo(t)
+	prov := repo.provider(t)
+
+	files := map[string]string{
+		"a.txt":        "aaa",
+		"data/b.txt":   "bbbbbb",
+		"data/c/d.txt": "ddddddddd",
+	}
+	volume := t.TempDir()
+	writeFiles(t, volume, files)
+
+	var progress []velerov1api.PodVolumeOperationProgress
+	updater := func(p velerov1api.PodVolumeOperationProgress) { progress = append(progress, p) }
+
+	snapshotID, empty, err := prov.RunBackup(context.Background(), volume, nil, nil, map[string]string{"backup": "backup-1"}, "", updater)
+	require.NoError(t, err)
+	assert.False(t, empty)
+	require.NotEmpty(t, snapshotID)
+	require.NotEmpty(t, progress)
+	assert.Equal(t, velerov1api.PodVolumeOperationProgress{TotalBytes: 18, BytesDone: 18}, progress[len(progress)-1])
+
+	man, err := snapshot.LoadSnapshot(context.Background(), prov.(*kopiaProvider).repo, manifest.ID(snapshotID))
+	require.NoError(t, err)
+	assert.Equal(t, "backup-1", man.Tags["tag:backup"])
+
+	target := t.TempDir()
+	progress = nil
+	require.NoError(t, prov.RunRestore(context.Background(), snapshotID, target, nil, updater))
+	assert.Equal(t, files, readFiles(t, target))
+	require.NotEmpty(t, progress)
+	assert.Equal(t, velerov1api.PodVolumeOperationProgress{TotalBytes: 18, BytesDone: 18}, progress[len(progress)-1])
+
+	// an incremental backup, by another provider for the same repository,
+	// only reads the changed files.
+	writeFiles(t, volume, map[string]string{"data/b.txt": "changed"})
+	files["data/b.txt"] = "changed"
+
+	var uploaded int64
+	updater = func(p velerov1api.PodVolumeOperationProgress) { uploaded = p.BytesDone }
+	prov = repo.provider(t)
+
+	parentID := snapshotID
+	snapshotID, _, err = prov.RunBackup(context.Background(), volume, nil, nil, nil, parentID, updater)
+	require.NoError(t, err)
+	assert.NotEqual(t, parentID, snapshotID)
+	assert.EqualValues(t, 19, uploaded)
+
+	man, err = snapshot.LoadSnapshot(context.Background(), prov.(*kopiaProvider).repo, manifest.ID(snapshotID))
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, man.Stats.CachedFiles)
+	assert.EqualValues(t, 1, man.Stats.NonCachedFiles)
+
+	target = t.TempDir()
+	require.NoError(t, prov.RunRestore(context.Background(), snapshotID, target, nil, func(velerov1api.PodVolumeOperationProgress) {}))
+	assert.Equal(t, files, readFiles(t, target))
+}
+
+func TestKopiaRunBackupPathFilters(t *testing.T) {
+	prov := newKopiaTestRepo(t).provider(t)
+
+	volume := t.TempDir()
+	writeFiles(t, volume, map[string]string{
+		"app.log":              "a",
+		"data/db.txt":          "b",
+		"data/cache/tmp.txt":   "c",
+		"data/app.log":         "d",
+		"logs/2022/app.log":    "e",
+		"logs/2022/other.txt":  "f",
+		"other/unrelated.txt":  "g",
+		"data-backup/copy.txt": "h",
+	})
+
+	tests := []struct {
+		name          string
+		includedPaths []string
+		excludedPaths []string
+		wantFiles     []string
+		wantErr       bool
+	}{
+		{
+			name:          "included directories are backed up with all their contents",
+			includedPaths: []string{"data"},
+			wantFiles:     []string{"data/app.log", "data/cache/tmp.txt", "data/db.txt"},
+		},
+		{
+			name:          "included path patterns match nested paths",
+			includedPaths: []string{"/logs/*/*.log", "other"},
+			wantFiles:     []string{"logs/2022/app.log", "other/unrelated.txt"},
+		},
+		{
+			name:          "excluded path patterns match anywhere unless anchored",
+			excludedPaths: []string{"*.log", "/data/cache", "other"},
+			wantFiles:     []string{"data-backup/copy.txt", "data/db.txt", "logs/2022/other.txt"},
+		},
+		{
+			name:          "excluded paths apply to included paths",
+			includedPaths: []string{"data"},
+			excludedPaths: []string{"cache"},
+			wantFiles:     []string{"data/app.log", "data/db.txt"},
+		},
+		{
+			name:          "included paths matching no paths return an error",
+			includedPaths: []string{"nonexistent"},
+			wantErr:       true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			snapshotID, _, err := prov.RunBackup(context.Background(), volume, tc.includedPaths, tc.excludedPaths, nil, "", func(velerov1api.PodVolumeOperationProgress) {})
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			target := t.TempDir()
+			require.NoError(t, prov.RunRestore(context.Background(), snapshotID, target, nil, func(velerov1api.PodVolumeOperationProgress) {}))
+
+			var files []string
+			for name := range readFiles(t, target) {
+				files = append(files, name)
+			}
+			sort.Strings(files)
+			assert.Equal(t, tc.wantFiles, files)
+		})
+	}
+}
+
+func TestKopiaRunRestoreIncludedPaths(t *testing.T) {
+	prov := newKopiaTestRepo(t).provider(t)
+
+	volume := t.TempDir()
+	writeFiles(t, volume, map[string]string{
+		"a.txt":        "a",
+		"data/b.txt":   "b",
+		"data/c/d.txt": "d",
+		"other/e.txt":  "e",
+	})
+
+	snapshotID, _, err := prov.RunBackup(context.Background(), volume, nil, nil, nil, "", func(velerov1api.PodVolumeOperationProgress) {})
+	require.NoError(t, err)
+
+	target := t.TempDir()
+	writeFiles(t, target, map[string]string{"data/b.txt": "overwritten", "existing.txt": "kept"})
+	require.NoError(t, prov.RunRestore(context.Background(), snapshotID, target, []string{"/data/c", "*.txt"}, func(velerov1api.PodVolumeOperationProgress) {}))
+
+	assert.Equal(t, map[string]string{
+		"a.txt":        "a",
+		"data/b.txt":   "overwritten",
+		"data/c/d.txt": "d",
+		"existing.txt": "kept",
+	}, readFiles(t, target))
+}
+
+func TestKopiaRunBackupEmptyVolume(t *testing.T) {
+	prov := newKopiaTestRepo(t).provider(t)
+
+	snapshotID, empty, err := prov.RunBackup(context.Background(), t.TempDir(), nil, nil, nil, "", func(velerov1api.PodVolumeOperationProgress) {})
+	require.NoError(t, err)
+	assert.True(t, empty)
+	assert.Empty(t, snapshotID)
+}
+
+func TestKopiaRunBlockBackupAndRestore(t *testing.T) {
+	prov := newKopiaTestRepo(t).provider(t)
+
+	// regular files stand in for the block devices.
+	dir := t.TempDir()
+	device := filepath.Join(dir, "device")
+	contents := make([]byte, 3*1024*1024)
+	for i := range contents {
+		contents[i] = byte(i % 251)
+	}
+	require.NoError(t, ioutil.WriteFile(device, contents, 0600))
+
+	var progress velerov1api.PodVolumeOperationProgress
+	snapshotID, err := prov.RunBlockBackup(context.Background(), device, map[string]string{"volume": "vol-1"}, "", func(p velerov1api.PodVolumeOperationProgress) { progress = p })
+	require.NoError(t, err)
+	assert.EqualValues(t, len(contents), progress.BytesDone)
+
+	target := filepath.Join(dir, "target")
+	require.NoError(t, ioutil.WriteFile(target, nil, 0600))
+	require.NoError(t, prov.RunBlockRestore(context.Background(), snapshotID, target, func(p velerov1api.PodVolumeOperationProgress) { progress = p }))
+	assert.Equal(t, velerov1api.PodVolumeOperationProgress{TotalBytes: int64(len(contents)), BytesDone: int64(len(contents))}, progress)
+
+	restored, err := ioutil.ReadFile(target)
+	require.NoError(t, err)
+	assert.Equal(t, contents, restored)
+}
+
+func TestDeleteKopiaSnapshot(t *testing.T) {
+	repo := newKopiaTestRepo(t)
+	prov := repo.provider(t)
+
+	volume := t.TempDir()
+	writeFiles(t, volume, map[string]string{"a.txt": "a"})
+	snapshotID, _, err := prov.RunBackup(context.Background(), volume, nil, nil, nil, "", func(velerov1api.PodVolumeOperationProgress) {})
+	require.NoError(t, err)
+
+	require.NoError(t, DeleteKopiaSnapshot(context.Background(), snapshotID, repo.repoIdentifier, "", repo.bsl, repo.credentialsFileStore, velerotest.NewLogger()))
+
+	err = repo.provider(t).RunRestore(context.Background(), snapshotID, t.TempDir(), nil, func(velerov1api.PodVolumeOperationProgress) {})
+	assert.Error(t, err)
+}
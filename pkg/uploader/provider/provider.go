@@ -0,0 +1,89 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+// ProgressUpdater is called by a Provider to report the progress of a backup
+// or restore.
+type ProgressUpdater func(velerov1api.PodVolumeOperationProgress)
+
+// Provider backs up and restores the contents of a pod volume to and from
+// a repository.
+type Provider interface {
+	// RunBackup backs up the contents of path, tagging the resulting snapshot
+	// with tags. If includedPaths is not empty, only the paths matching its
+	// glob patterns, relative to path, are backed up, and the paths matching
+	// the patterns of excludedPaths are never backed up. If parentSnapshot is
+	// not empty, it's used as the base for an incremental backup. It returns
+	// the ID of the snapshot, or true for emptySnapshot if path contained no
+	// data and no snapshot was taken.
+	RunBackup(ctx context.Context, path string, includedPaths, excludedPaths []string, tags map[string]string, parentSnapshot string, updater ProgressUpdater) (snapshotID string, emptySnapshot bool, err error)
+
+	// RunRestore restores the snapshot with the given ID into volumePath. If
+	// includedPaths isn't empty, only the paths of the snapshot matching its
+	// patterns are restored.
+	RunRestore(ctx context.Context, snapshotID, volumePath string, includedPaths []string, updater ProgressUpdater) error
+
+	// RunBlockBackup backs up the contents of the block device at devicePath,
+	// tagging the resulting snapshot with tags. If parentSnapshot is not empty,
+	// it's used as the base for an incremental backup. It returns the ID of the
+	// snapshot.
+	RunBlockBackup(ctx context.Context, devicePath string, tags map[string]string, parentSnapshot string, updater ProgressUpdater) (snapshotID string, err error)
+
+	// RunBlockRestore writes the block device contents of the snapshot with the
+	// given ID to the block device at devicePath.
+	RunBlockRestore(ctx context.Context, snapshotID, devicePath string, updater ProgressUpdater) error
+
+	// Close releases any resources held by the provider.
+	Close(ctx context.Context) error
+}
+
+// NewUploaderProvider returns a Provider of the given uploader type for the
+// repository identified by repoIdentifier in the given backup storage location,
+// whose password is in the repoKeySecret secret, or the common repository key
+// secret if it's empty. Transfers are throttled according to transferSettings, if specified. The
+// returned provider must be closed when it's no longer needed.
+func NewUploaderProvider(
+	uploaderType string,
+	repoIdentifier string,
+	repoKeySecret string,
+	bsl *velerov1api.BackupStorageLocation,
+	transferSettings *velerov1api.PodVolumeTransferSettings,
+	credentialsFileStore credentials.FileStore,
+	fs filesystem.Interface,
+	log logrus.FieldLogger,
+) (Provider, error) {
+	switch uploader.GetUploaderType(uploaderType) {
+	case uploader.ResticType:
+		return newResticUploaderProvider(repoIdentifier, repoKeySecret, bsl, transferSettings, credentialsFileStore, fs, log)
+	case uploader.KopiaType:
+		return newKopiaUploaderProvider(repoIdentifier, repoKeySecret, bsl, transferSettings, credentialsFileStore, log)
+	default:
+		return nil, errors.Errorf("unsupported uploader type %q", uploaderType)
+	}
+}
@@ -0,0 +1,228 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+// these are set as variables so they can be replaced in tests.
+var (
+	resticBackupFunc        = restic.RunBackup
+	resticRestoreFunc       = restic.RunRestore
+	resticGetSnapshotIDFunc = restic.GetSnapshotID
+	resticDumpFunc          = restic.RunDump
+	openDeviceFunc          = os.OpenFile
+)
+
+// resticProvider is a Provider that runs the restic binary.
+type resticProvider struct {
+	repoIdentifier   string
+	credentialsFile  string
+	caCertFile       string
+	env              []string
+	transferSettings *velerov1api.PodVolumeTransferSettings
+	log              logrus.FieldLogger
+}
+
+func newResticUploaderProvider(
+	repoIdentifier string,
+	repoKeySecret string,
+	bsl *velerov1api.BackupStorageLocation,
+	transferSettings *velerov1api.PodVolumeTransferSettings,
+	credentialsFileStore credentials.FileStore,
+	fs filesystem.Interface,
+	log logrus.FieldLogger,
+) (Provider, error) {
+	provider := &resticProvider{
+		repoIdentifier:   repoIdentifier,
+		transferSettings: transferSettings,
+		log:              log,
+	}
+
+	var err error
+	provider.credentialsFile, err = credentialsFileStore.Path(restic.RepoKeySelector(repoKeySecret))
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating temp restic credentials file")
+	}
+
+	// if there's a caCert on the ObjectStorage, write it to disk so that it can be passed to restic
+	if bsl.Spec.ObjectStorage != nil && bsl.Spec.ObjectStorage.CACert != nil {
+		provider.caCertFile, err = restic.TempCACertFile(bsl.Spec.ObjectStorage.CACert, bsl.Name, fs)
+		if err != nil {
+			log.WithError(err).Error("Error creating temp cacert file")
+		}
+	}
+
+	provider.env, err = restic.CmdEnv(bsl, credentialsFileStore)
+	if err != nil {
+		provider.Close(context.Background())
+		return nil, errors.Wrap(err, "error setting restic cmd env")
+	}
+
+	return provider, nil
+}
+
+func (p *resticProvider) RunBackup(ctx context.Context, path string, includedPaths, excludedPaths []string, tags map[string]string, parentSnapshot string, updater ProgressUpdater) (string, bool, error) {
+	paths, err := restic.ResolveIncludedPaths(path, includedPaths)
+	if err != nil {
+		return "", false, err
+	}
+
+	backupCmd := restic.BackupCommand(p.repoIdentifier, p.credentialsFile, path, paths, excludedPaths, tags)
+	backupCmd.Env = p.env
+	backupCmd.CACertFile = p.caCertFile
+	p.applyTransferSettings(backupCmd)
+	if parentSnapshot != "" {
+		backupCmd.ExtraFlags = append(backupCmd.ExtraFlags, fmt.Sprintf("--parent=%s", parentSnapshot))
+	}
+
+	stdout, stderr, err := resticBackupFunc(backupCmd, p.log, updater)
+	if err != nil {
+		if strings.Contains(stderr, "snapshot is empty") {
+			p.log.Debugf("Ran command=%s, stdout=%s, stderr=%s", backupCmd.String(), stdout, stderr)
+			return "", true, nil
+		}
+		return "", false, errors.Wrapf(err, "error running restic backup, stderr=%s", stderr)
+	}
+	p.log.Debugf("Ran command=%s, stdout=%s, stderr=%s", backupCmd.String(), stdout, stderr)
+
+	snapshotID, err := p.getSnapshotID(tags)
+	if err != nil {
+		return "", false, err
+	}
+
+	return snapshotID, false, nil
+}
+
+func (p *resticProvider) RunBlockBackup(ctx context.Context, devicePath string, tags map[string]string, parentSnapshot string, updater ProgressUpdater) (string, error) {
+	device, err := openDeviceFunc(devicePath, os.O_RDONLY, 0)
+	if err != nil {
+		return "", errors.Wrap(err, "error opening block device")
+	}
+	defer device.Close()
+
+	backupCmd := restic.BlockBackupCommand(p.repoIdentifier, p.credentialsFile, tags)
+	backupCmd.Env = p.env
+	backupCmd.CACertFile = p.caCertFile
+	backupCmd.Stdin = device
+	p.applyTransferSettings(backupCmd)
+	if parentSnapshot != "" {
+		backupCmd.ExtraFlags = append(backupCmd.ExtraFlags, fmt.Sprintf("--parent=%s", parentSnapshot))
+	}
+
+	stdout, stderr, err := resticBackupFunc(backupCmd, p.log, updater)
+	if err != nil {
+		return "", errors.Wrapf(err, "error running restic backup, stderr=%s", stderr)
+	}
+	p.log.Debugf("Ran command=%s, stdout=%s, stderr=%s", backupCmd.String(), stdout, stderr)
+
+	return p.getSnapshotID(tags)
+}
+
+// getSnapshotID returns the ID of the latest snapshot with the given tags.
+func (p *resticProvider) getSnapshotID(tags map[string]string) (string, error) {
+	snapshotIDCmd := restic.GetSnapshotCommand(p.repoIdentifier, p.credentialsFile, tags)
+	snapshotIDCmd.Env = p.env
+	snapshotIDCmd.CACertFile = p.caCertFile
+
+	snapshotID, err := resticGetSnapshotIDFunc(snapshotIDCmd)
+	if err != nil {
+		return "", errors.Wrap(err, "error getting snapshot id")
+	}
+
+	return snapshotID, nil
+}
+
+func (p *resticProvider) RunRestore(ctx context.Context, snapshotID, volumePath string, includedPaths []string, updater ProgressUpdater) error {
+	restoreCmd := restic.RestoreCommand(p.repoIdentifier, p.credentialsFile, snapshotID, volumePath, includedPaths)
+	restoreCmd.Env = p.env
+	restoreCmd.CACertFile = p.caCertFile
+	p.applyTransferSettings(restoreCmd)
+
+	stdout, stderr, err := resticRestoreFunc(restoreCmd, p.log, updater)
+	if err != nil {
+		return errors.Wrapf(err, "error running restic restore, cmd=%s, stdout=%s, stderr=%s", restoreCmd.String(), stdout, stderr)
+	}
+	p.log.Debugf("Ran command=%s, stdout=%s, stderr=%s", restoreCmd.String(), stdout, stderr)
+
+	return nil
+}
+
+func (p *resticProvider) RunBlockRestore(ctx context.Context, snapshotID, devicePath string, updater ProgressUpdater) error {
+	device, err := openDeviceFunc(devicePath, os.O_WRONLY, 0)
+	if err != nil {
+		return errors.Wrap(err, "error opening block device")
+	}
+	defer device.Close()
+
+	dumpCmd := restic.DumpCommand(p.repoIdentifier, p.credentialsFile, snapshotID)
+	dumpCmd.Env = p.env
+	dumpCmd.CACertFile = p.caCertFile
+	p.applyTransferSettings(dumpCmd)
+
+	stderr, err := resticDumpFunc(dumpCmd, device, p.log, updater)
+	if err != nil {
+		return errors.Wrapf(err, "error running restic dump, cmd=%s, stderr=%s", dumpCmd.String(), stderr)
+	}
+	p.log.Debugf("Ran command=%s, stderr=%s", dumpCmd.String(), stderr)
+
+	// make sure the data is on the device before the volume is used.
+	if err := device.Sync(); err != nil {
+		return errors.Wrap(err, "error syncing block device")
+	}
+
+	return nil
+}
+
+// applyTransferSettings adds restic's bandwidth limit flags to the command and
+// sets its scheduling priority. restic applies both limits to all commands.
+func (p *resticProvider) applyTransferSettings(cmd *restic.Command) {
+	if p.transferSettings == nil {
+		return
+	}
+	if p.transferSettings.UploadLimitKiBps > 0 {
+		cmd.ExtraFlags = append(cmd.ExtraFlags, fmt.Sprintf("--limit-upload=%d", p.transferSettings.UploadLimitKiBps))
+	}
+	if p.transferSettings.DownloadLimitKiBps > 0 {
+		cmd.ExtraFlags = append(cmd.ExtraFlags, fmt.Sprintf("--limit-download=%d", p.transferSettings.DownloadLimitKiBps))
+	}
+	cmd.Nice = p.transferSettings.Nice
+	cmd.IOPriorityClass = p.transferSettings.IOPriorityClass
+}
+
+func (p *resticProvider) Close(ctx context.Context) error {
+	// ignore errors since there's nothing we can do and they're temp files.
+	if p.credentialsFile != "" {
+		os.Remove(p.credentialsFile)
+	}
+	if p.caCertFile != "" {
+		os.Remove(p.caCertFile)
+	}
+	return nil
+}
@@ -0,0 +1,283 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestNewUploaderProvider(t *testing.T) {
+	bsl := builder.ForBackupStorageLocation("velero", "default").Provider("aws").Bucket("bucket").Result()
+	credentialsFileStore := velerotest.NewFakeCredentialsFileStore("/tmp/credentials", nil)
+
+	tests := []struct {
+		name         string
+		uploaderType string
+		wantErr      bool
+	}{
+		{
+			name:         "empty uploader type uses restic",
+			uploaderType: "",
+		},
+		{
+			name:         "restic uploader type",
+			uploaderType: "restic",
+		},
+		{
+			name:         "unsupported uploader type returns an error",
+			uploaderType: "foo",
+			wantErr:      true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			prov, err := NewUploaderProvider(tc.uploaderType, "repo", "", bsl, nil, credentialsFileStore, velerotest.NewFakeFileSystem(), velerotest.NewLogger())
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.IsType(t, &resticProvider{}, prov)
+		})
+	}
+}
+
+func TestResticRunBackup(t *testing.T) {
+	defer func() {
+		resticBackupFunc = restic.RunBackup
+		resticGetSnapshotIDFunc = restic.GetSnapshotID
+	}()
+
+	tests := []struct {
+		name              string
+		parentSnapshot    string
+		includedPaths     []string
+		excludedPaths     []string
+		backupStderr      string
+		backupErr         error
+		wantParentFlag    bool
+		wantArgs          []string
+		wantExcludeFlags  []string
+		wantSnapshotID    string
+		wantEmptySnapshot bool
+		wantErr           bool
+	}{
+		{
+			name:           "successful backup returns the snapshot ID",
+			wantSnapshotID: "snapshot-1",
+		},
+		{
+			name:           "parent snapshot is passed to restic",
+			parentSnapshot: "parent-1",
+			wantParentFlag: true,
+			wantSnapshotID: "snapshot-1",
+		},
+		{
+			name:             "included paths are resolved and excluded paths are passed to restic",
+			includedPaths:    []string{"data", "/logs/*.log"},
+			excludedPaths:    []string{"cache"},
+			wantArgs:         []string{"data", "logs/app.log"},
+			wantExcludeFlags: []string{"--exclude=cache"},
+			wantSnapshotID:   "snapshot-1",
+		},
+		{
+			name:          "included paths matching no paths return an error",
+			includedPaths: []string{"nonexistent"},
+			wantErr:       true,
+		},
+		{
+			name:              "empty volume returns an empty snapshot",
+			backupStderr:      "Fatal: unable to save snapshot: snapshot is empty",
+			backupErr:         errors.New("exit status 1"),
+			wantEmptySnapshot: true,
+		},
+		{
+			name:         "restic error is returned",
+			backupStderr: "Fatal: wrong password",
+			backupErr:    errors.New("exit status 1"),
+			wantErr:      true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var backupCmd *restic.Command
+			resticBackupFunc = func(cmd *restic.Command, _ logrus.FieldLogger, _ func(velerov1api.PodVolumeOperationProgress)) (string, string, error) {
+				backupCmd = cmd
+				return "", tc.backupStderr, tc.backupErr
+			}
+			resticGetSnapshotIDFunc = func(*restic.Command) (string, error) {
+				return "snapshot-1", nil
+			}
+
+			prov := &resticProvider{
+				repoIdentifier:  "repo",
+				credentialsFile: "/tmp/credentials",
+				log:             velerotest.NewLogger(),
+			}
+
+			volumePath, err := ioutil.TempDir("", "volume")
+			require.NoError(t, err)
+			defer os.RemoveAll(volumePath)
+			require.NoError(t, os.MkdirAll(filepath.Join(volumePath, "data"), 0755))
+			require.NoError(t, os.MkdirAll(filepath.Join(volumePath, "logs"), 0755))
+			require.NoError(t, ioutil.WriteFile(filepath.Join(volumePath, "logs", "app.log"), nil, 0644))
+
+			snapshotID, emptySnapshot, err := prov.RunBackup(context.Background(), volumePath, tc.includedPaths, tc.excludedPaths, map[string]string{"foo": "bar"}, tc.parentSnapshot, nil)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantSnapshotID, snapshotID)
+			assert.Equal(t, tc.wantEmptySnapshot, emptySnapshot)
+			assert.Equal(t, volumePath, backupCmd.Dir)
+			if tc.wantArgs != nil {
+				assert.Equal(t, tc.wantArgs, backupCmd.Args)
+			} else {
+				assert.Equal(t, []string{"."}, backupCmd.Args)
+			}
+			for _, flag := range tc.wantExcludeFlags {
+				assert.Contains(t, backupCmd.ExtraFlags, flag)
+			}
+			if tc.wantParentFlag {
+				assert.Contains(t, backupCmd.ExtraFlags, "--parent="+tc.parentSnapshot)
+			}
+		})
+	}
+}
+
+func TestResticRunBlockBackup(t *testing.T) {
+	defer func() {
+		resticBackupFunc = restic.RunBackup
+		resticGetSnapshotIDFunc = restic.GetSnapshotID
+	}()
+
+	device, err := ioutil.TempFile("", "device")
+	require.NoError(t, err)
+	defer os.Remove(device.Name())
+	_, err = device.WriteString("device contents")
+	require.NoError(t, err)
+	require.NoError(t, device.Close())
+
+	var (
+		backupCmd *restic.Command
+		stdin     []byte
+	)
+	resticBackupFunc = func(cmd *restic.Command, _ logrus.FieldLogger, _ func(velerov1api.PodVolumeOperationProgress)) (string, string, error) {
+		backupCmd = cmd
+		stdin, err = ioutil.ReadAll(cmd.Stdin)
+		return "", "", err
+	}
+	resticGetSnapshotIDFunc = func(*restic.Command) (string, error) {
+		return "snapshot-1", nil
+	}
+
+	prov := &resticProvider{
+		repoIdentifier:  "repo",
+		credentialsFile: "/tmp/credentials",
+		log:             velerotest.NewLogger(),
+	}
+
+	snapshotID, err := prov.RunBlockBackup(context.Background(), device.Name(), map[string]string{"foo": "bar"}, "parent-1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "snapshot-1", snapshotID)
+	assert.Equal(t, "device contents", string(stdin))
+	assert.Contains(t, backupCmd.ExtraFlags, "--stdin")
+	assert.Contains(t, backupCmd.ExtraFlags, "--parent=parent-1")
+
+	_, err = prov.RunBlockBackup(context.Background(), "/nonexistent/device", nil, "", nil)
+	assert.Error(t, err)
+}
+
+func TestResticRunBlockRestore(t *testing.T) {
+	defer func() {
+		resticDumpFunc = restic.RunDump
+	}()
+
+	device, err := ioutil.TempFile("", "device")
+	require.NoError(t, err)
+	defer os.Remove(device.Name())
+	require.NoError(t, device.Close())
+
+	var dumpCmd *restic.Command
+	resticDumpFunc = func(cmd *restic.Command, target io.Writer, _ logrus.FieldLogger, _ func(velerov1api.PodVolumeOperationProgress)) (string, error) {
+		dumpCmd = cmd
+		_, err := target.Write([]byte("snapshot contents"))
+		return "", err
+	}
+
+	prov := &resticProvider{
+		repoIdentifier:  "repo",
+		credentialsFile: "/tmp/credentials",
+		log:             velerotest.NewLogger(),
+	}
+
+	require.NoError(t, prov.RunBlockRestore(context.Background(), "snapshot-1", device.Name(), nil))
+	assert.Equal(t, []string{"snapshot-1", "/" + restic.BlockVolumeFileName}, dumpCmd.Args)
+
+	contents, err := ioutil.ReadFile(device.Name())
+	require.NoError(t, err)
+	assert.Equal(t, "snapshot contents", string(contents))
+
+	resticDumpFunc = func(*restic.Command, io.Writer, logrus.FieldLogger, func(velerov1api.PodVolumeOperationProgress)) (string, error) {
+		return "Fatal: no such snapshot", errors.New("exit status 1")
+	}
+	assert.Error(t, prov.RunBlockRestore(context.Background(), "snapshot-1", device.Name(), nil))
+}
+
+func TestResticApplyTransferSettings(t *testing.T) {
+	prov := &resticProvider{
+		transferSettings: &velerov1api.PodVolumeTransferSettings{
+			UploadLimitKiBps:   1024,
+			DownloadLimitKiBps: 2048,
+			Nice:               10,
+			IOPriorityClass:    velerov1api.IOPriorityClassIdle,
+		},
+	}
+
+	cmd := restic.BackupCommand("repo", "/tmp/credentials", "/path", nil, nil, nil)
+	prov.applyTransferSettings(cmd)
+
+	assert.Contains(t, cmd.ExtraFlags, "--limit-upload=1024")
+	assert.Contains(t, cmd.ExtraFlags, "--limit-download=2048")
+	assert.Equal(t, 10, cmd.Nice)
+	assert.Equal(t, velerov1api.IOPriorityClassIdle, cmd.IOPriorityClass)
+
+	// no settings leaves the command unchanged
+	cmd = restic.BackupCommand("repo", "/tmp/credentials", "/path", nil, nil, nil)
+	flags := append([]string{}, cmd.ExtraFlags...)
+	(&resticProvider{}).applyTransferSettings(cmd)
+	assert.Equal(t, flags, cmd.ExtraFlags)
+	assert.Zero(t, cmd.Nice)
+}
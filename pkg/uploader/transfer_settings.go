@@ -0,0 +1,54 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package uploader
+
+import (
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// GetTransferSettings returns the pod volume transfer settings to use for a
+// transfer to or from the given backup storage location. Settings that are
+// specified in override take precedence over those of the location. It returns
+// nil if neither specifies any settings.
+func GetTransferSettings(location *velerov1api.BackupStorageLocation, override *velerov1api.PodVolumeTransferSettings) *velerov1api.PodVolumeTransferSettings {
+	var settings *velerov1api.PodVolumeTransferSettings
+	if location != nil && location.Spec.PodVolumeTransfer != nil {
+		settings = location.Spec.PodVolumeTransfer.DeepCopy()
+	}
+
+	if override == nil {
+		return settings
+	}
+	if settings == nil {
+		return override.DeepCopy()
+	}
+
+	if override.UploadLimitKiBps != 0 {
+		settings.UploadLimitKiBps = override.UploadLimitKiBps
+	}
+	if override.DownloadLimitKiBps != 0 {
+		settings.DownloadLimitKiBps = override.DownloadLimitKiBps
+	}
+	if override.Nice != 0 {
+		settings.Nice = override.Nice
+	}
+	if override.IOPriorityClass != "" {
+		settings.IOPriorityClass = override.IOPriorityClass
+	}
+
+	return settings
+}
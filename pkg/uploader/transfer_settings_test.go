@@ -0,0 +1,77 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package uploader
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestGetTransferSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		location *velerov1api.BackupStorageLocation
+		override *velerov1api.PodVolumeTransferSettings
+		want     *velerov1api.PodVolumeTransferSettings
+	}{
+		{
+			name:     "no settings returns nil",
+			location: builder.ForBackupStorageLocation("velero", "default").Result(),
+			want:     nil,
+		},
+		{
+			name: "location settings are used when there's no override",
+			location: builder.ForBackupStorageLocation("velero", "default").PodVolumeTransfer(&velerov1api.PodVolumeTransferSettings{
+				UploadLimitKiBps: 1024,
+				Nice:             10,
+			}).Result(),
+			want: &velerov1api.PodVolumeTransferSettings{UploadLimitKiBps: 1024, Nice: 10},
+		},
+		{
+			name:     "override settings are used when the location has none",
+			location: builder.ForBackupStorageLocation("velero", "default").Result(),
+			override: &velerov1api.PodVolumeTransferSettings{DownloadLimitKiBps: 2048},
+			want:     &velerov1api.PodVolumeTransferSettings{DownloadLimitKiBps: 2048},
+		},
+		{
+			name: "override settings take precedence over location settings",
+			location: builder.ForBackupStorageLocation("velero", "default").PodVolumeTransfer(&velerov1api.PodVolumeTransferSettings{
+				UploadLimitKiBps: 1024,
+				Nice:             10,
+			}).Result(),
+			override: &velerov1api.PodVolumeTransferSettings{
+				UploadLimitKiBps: 512,
+				IOPriorityClass:  velerov1api.IOPriorityClassIdle,
+			},
+			want: &velerov1api.PodVolumeTransferSettings{
+				UploadLimitKiBps: 512,
+				Nice:             10,
+				IOPriorityClass:  velerov1api.IOPriorityClassIdle,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, GetTransferSettings(tc.location, tc.override))
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package uploader
+
+import (
+	"github.com/pkg/errors"
+)
+
+const (
+	// ResticType is the uploader that backs up and restores pod volume data
+	// using the restic binary.
+	ResticType = "restic"
+
+	// KopiaType is the uploader that backs up and restores pod volume data
+	// in-process using the Kopia library.
+	KopiaType = "kopia"
+
+	// DefaultType is the uploader used when a backup doesn't specify one.
+	DefaultType = ResticType
+)
+
+// GetUploaderType returns the uploader type to use for the given value,
+// falling back to the default when it's empty.
+func GetUploaderType(uploaderType string) string {
+	if uploaderType == "" {
+		return DefaultType
+	}
+	return uploaderType
+}
+
+// ValidateUploaderType returns an error if the given uploader type is not
+// one supported by Velero. An empty value is valid and means the default.
+func ValidateUploaderType(uploaderType string) error {
+	switch GetUploaderType(uploaderType) {
+	case ResticType, KopiaType:
+		return nil
+	default:
+		return errors.Errorf("invalid uploader type %q, valid uploader types are: %s, %s", uploaderType, ResticType, KopiaType)
+	}
+}
@@ -62,6 +62,32 @@ type SnapshotStatus struct {
 
 	// Phase is the current state of the VolumeSnapshot.
 	Phase SnapshotPhase `json:"phase,omitempty"`
+
+	// UploadProgressError is the first error getting the upload progress of
+	// the snapshot's data from its volume snapshotter, if there was one.
+	UploadProgressError string `json:"uploadProgressError,omitempty"`
+
+	// UploadFailureReason is why uploading the snapshot's data failed, if it
+	// did.
+	UploadFailureReason string `json:"uploadFailureReason,omitempty"`
+
+	// Copy is the status of the copy of this snapshot in its location's
+	// secondary region or location, if it has one.
+	Copy *SnapshotCopyStatus `json:"copy,omitempty"`
+}
+
+// SnapshotCopyStatus stores information about the copy of a volume snapshot
+// in a secondary region or location.
+type SnapshotCopyStatus struct {
+	// Config is the provider config of the region or location the snapshot
+	// was copied to.
+	Config map[string]string `json:"config,omitempty"`
+
+	// ProviderSnapshotID is the ID of the copy in the cloud provider API.
+	ProviderSnapshotID string `json:"providerSnapshotID,omitempty"`
+
+	// Phase is the current state of the copy.
+	Phase SnapshotPhase `json:"phase,omitempty"`
 }
 
 // SnapshotPhase is the lifecycle phase of a Velero volume snapshot.